@@ -0,0 +1,184 @@
+package pqt
+
+// Role is a database role that privileges are granted to and policies apply to.
+// Roles are shared by all databases of a cluster, so they are created only if missing.
+type Role struct {
+	Name   string
+	Login  bool
+	InRole []*Role
+}
+
+// NewRole ...
+func NewRole(name string, opts ...RoleOption) *Role {
+	r := &Role{
+		Name: name,
+	}
+
+	for _, opt := range opts {
+		opt(r)
+	}
+
+	return r
+}
+
+// RoleOption configures how we set up a role.
+type RoleOption func(*Role)
+
+// WithLogin allows role to be used as the initial session authorization name.
+func WithLogin() RoleOption {
+	return func(r *Role) {
+		r.Login = true
+	}
+}
+
+// WithInRole makes the role a member of given roles.
+func WithInRole(roles ...*Role) RoleOption {
+	return func(r *Role) {
+		r.InRole = append(r.InRole, roles...)
+	}
+}
+
+// Privilege is a table privilege that can be granted to a role.
+type Privilege string
+
+const (
+	// PrivilegeAll ...
+	PrivilegeAll Privilege = "ALL"
+	// PrivilegeSelect ...
+	PrivilegeSelect Privilege = "SELECT"
+	// PrivilegeInsert ...
+	PrivilegeInsert Privilege = "INSERT"
+	// PrivilegeUpdate ...
+	PrivilegeUpdate Privilege = "UPDATE"
+	// PrivilegeDelete ...
+	PrivilegeDelete Privilege = "DELETE"
+	// PrivilegeTruncate ...
+	PrivilegeTruncate Privilege = "TRUNCATE"
+	// PrivilegeReferences ...
+	PrivilegeReferences Privilege = "REFERENCES"
+	// PrivilegeTrigger ...
+	PrivilegeTrigger Privilege = "TRIGGER"
+)
+
+// Grant gives privileges on a table, or on some of its columns, to roles.
+type Grant struct {
+	Privileges []Privilege
+	Roles      []*Role
+	// Columns, if not empty, restrict privileges to given columns.
+	// Only SELECT, INSERT, UPDATE and REFERENCES can be granted on columns.
+	Columns         Columns
+	WithGrantOption bool
+}
+
+// NewGrant ...
+func NewGrant(privileges []Privilege, roles []*Role, opts ...GrantOption) *Grant {
+	g := &Grant{
+		Privileges: privileges,
+		Roles:      roles,
+	}
+
+	for _, opt := range opts {
+		opt(g)
+	}
+
+	return g
+}
+
+// GrantOption configures how we set up a grant.
+type GrantOption func(*Grant)
+
+// WithGrantColumns restricts privileges to given columns.
+func WithGrantColumns(columns ...*Column) GrantOption {
+	return func(g *Grant) {
+		g.Columns = append(g.Columns, columns...)
+	}
+}
+
+// WithGrantOption allows grantees to grant privileges to others.
+func WithGrantOption() GrantOption {
+	return func(g *Grant) {
+		g.WithGrantOption = true
+	}
+}
+
+// PolicyCommand is a command row-level security policy applies to.
+type PolicyCommand string
+
+const (
+	// PolicyCommandAll ...
+	PolicyCommandAll PolicyCommand = "ALL"
+	// PolicyCommandSelect ...
+	PolicyCommandSelect PolicyCommand = "SELECT"
+	// PolicyCommandInsert ...
+	PolicyCommandInsert PolicyCommand = "INSERT"
+	// PolicyCommandUpdate ...
+	PolicyCommandUpdate PolicyCommand = "UPDATE"
+	// PolicyCommandDelete ...
+	PolicyCommandDelete PolicyCommand = "DELETE"
+)
+
+// Policy is a row-level security policy.
+// Using expression filters existing rows, WithCheck expression validates new ones.
+type Policy struct {
+	Name  string
+	Table *Table
+	// Restrictive policies are combined using AND, permissive ones (default) using OR.
+	Restrictive bool
+	// Command, if empty, is ALL.
+	Command PolicyCommand
+	// Roles, if empty, policy applies to PUBLIC.
+	Roles            []*Role
+	Using, WithCheck string
+}
+
+// NewPolicy ...
+func NewPolicy(name string, opts ...PolicyOption) *Policy {
+	p := &Policy{
+		Name: name,
+	}
+
+	for _, opt := range opts {
+		opt(p)
+	}
+
+	return p
+}
+
+// PolicyOption configures how we set up a policy.
+type PolicyOption func(*Policy)
+
+// WithRestrictive ...
+func WithRestrictive() PolicyOption {
+	return func(p *Policy) {
+		p.Restrictive = true
+	}
+}
+
+// WithPolicyCommand ...
+func WithPolicyCommand(cmd PolicyCommand) PolicyOption {
+	return func(p *Policy) {
+		p.Command = cmd
+	}
+}
+
+// WithPolicyRoles ...
+func WithPolicyRoles(roles ...*Role) PolicyOption {
+	return func(p *Policy) {
+		p.Roles = append(p.Roles, roles...)
+	}
+}
+
+// WithUsing sets expression existing rows have to match to be visible or modified.
+// Session settings can be read using current_setting, e.g. current_setting('app.user_id')::BIGINT.
+func WithUsing(expr string) PolicyOption {
+	return func(p *Policy) {
+		p.Using = expr
+	}
+}
+
+// WithPolicyCheck sets expression new or updated rows have to match.
+func WithPolicyCheck(expr string) PolicyOption {
+	return func(p *Policy) {
+		p.WithCheck = expr
+	}
+}
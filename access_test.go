@@ -0,0 +1,74 @@
+package pqt_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/piotrkowalczuk/pqt"
+)
+
+func TestNewRole(t *testing.T) {
+	reader := pqt.NewRole("reader")
+	got := pqt.NewRole("app", pqt.WithLogin(), pqt.WithInRole(reader))
+	expected := &pqt.Role{
+		Name:   "app",
+		Login:  true,
+		InRole: []*pqt.Role{reader},
+	}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("wrong role, expected %v but got %v", expected, got)
+	}
+}
+
+func TestNewGrant(t *testing.T) {
+	role := pqt.NewRole("app")
+	column := pqt.NewColumn("name", pqt.TypeText())
+	got := pqt.NewGrant([]pqt.Privilege{pqt.PrivilegeUpdate}, []*pqt.Role{role}, pqt.WithGrantColumns(column), pqt.WithGrantOption())
+	expected := &pqt.Grant{
+		Privileges:      []pqt.Privilege{pqt.PrivilegeUpdate},
+		Roles:           []*pqt.Role{role},
+		Columns:         pqt.Columns{column},
+		WithGrantOption: true,
+	}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("wrong grant, expected %v but got %v", expected, got)
+	}
+}
+
+func TestTable_AddPolicy(t *testing.T) {
+	role := pqt.NewRole("app")
+	p := pqt.NewPolicy("owner",
+		pqt.WithRestrictive(),
+		pqt.WithPolicyCommand(pqt.PolicyCommandUpdate),
+		pqt.WithPolicyRoles(role),
+		pqt.WithUsing("owner = current_user"),
+		pqt.WithPolicyCheck("owner = current_user"),
+	)
+	tbl := pqt.NewTable("table").AddPolicy(p)
+
+	if !tbl.RowLevelSecurity {
+		t.Error("row-level security expected to be enabled")
+	}
+	if tbl.ForceRowLevelSecurity {
+		t.Error("row-level security expected not to be forced")
+	}
+	expected := &pqt.Policy{
+		Name:        "owner",
+		Table:       tbl,
+		Restrictive: true,
+		Command:     pqt.PolicyCommandUpdate,
+		Roles:       []*pqt.Role{role},
+		Using:       "owner = current_user",
+		WithCheck:   "owner = current_user",
+	}
+	if !reflect.DeepEqual(tbl.Policies, []*pqt.Policy{expected}) {
+		t.Errorf("wrong policies, expected %v but got %v", expected, tbl.Policies)
+	}
+}
+
+func TestWithForceRowLevelSecurity(t *testing.T) {
+	tbl := pqt.NewTable("table", pqt.WithForceRowLevelSecurity())
+	if !tbl.RowLevelSecurity || !tbl.ForceRowLevelSecurity {
+		t.Error("row-level security expected to be enabled and forced")
+	}
+}
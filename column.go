@@ -5,6 +5,18 @@ import (
 	"strings"
 )
 
+const (
+	// IdentityAlways makes the column GENERATED ALWAYS AS IDENTITY.
+	// Its value cannot be provided explicitly.
+	IdentityAlways Identity = "ALWAYS"
+	// IdentityByDefault makes the column GENERATED BY DEFAULT AS IDENTITY.
+	// Explicitly provided value takes precedence over the generated one.
+	IdentityByDefault Identity = "BY DEFAULT"
+)
+
+// Identity ...
+type Identity string
+
 const (
 	// EventInsert ...
 	EventInsert Event = "INSERT"
@@ -26,6 +38,13 @@ type Column struct {
 	ReferenceOptions                                                     []RelationshipOption
 	Match, OnDelete, OnUpdate                                            int32
 	NoInherit, DeferrableInitiallyDeferred, DeferrableInitiallyImmediate bool
+	Identity                                                             Identity
+	// Generated is an expression of GENERATED ALWAYS AS (expr) STORED column.
+	Generated string
+	// Sequence, if set, provides default value of the column.
+	Sequence *Sequence
+	// TextSearch, if set, makes the column searchable document.
+	TextSearch *TextSearch
 	// Dynamic
 	IsDynamic bool
 	Func      *Function
@@ -111,10 +130,22 @@ func (c Column) DefaultOn(e ...Event) (string, bool) {
 			}
 		}
 	}
+	if c.Sequence != nil {
+		for _, ee := range e {
+			if ee == EventInsert {
+				return c.Sequence.NextVal(), true
+			}
+		}
+	}
 
 	return "", false
 }
 
+// IsGeneratedAlways returns true if value of the column is always computed by the database and cannot be written.
+func (c *Column) IsGeneratedAlways() bool {
+	return c.Identity == IdentityAlways || c.Generated != ""
+}
+
 // Columns is a slice of columns that implements few handy methods.
 type Columns []*Column
 
@@ -283,6 +314,27 @@ func WithOnUpdate(on int32) ColumnOption {
 	}
 }
 
+// WithIdentity makes the column an identity column, available since Postgres 10.
+func WithIdentity(i Identity) ColumnOption {
+	return func(c *Column) {
+		c.Identity = i
+	}
+}
+
+// WithGenerated makes the column GENERATED ALWAYS AS (expr) STORED, available since Postgres 12.
+func WithGenerated(expr string) ColumnOption {
+	return func(c *Column) {
+		c.Generated = expr
+	}
+}
+
+// WithSequence sets default value of the column to the next value of the sequence.
+func WithSequence(s *Sequence) ColumnOption {
+	return func(c *Column) {
+		c.Sequence = s
+	}
+}
+
 // WithColumnShortName ...
 func WithColumnShortName(s string) ColumnOption {
 	return func(c *Column) {
@@ -137,6 +137,37 @@ func TestColumn_DefaultOn(t *testing.T) {
 	}
 }
 
+func TestColumn_DefaultOn_sequence(t *testing.T) {
+	seq := pqt.NewSequence("sequence")
+	c := pqt.NewColumn("column", pqt.TypeIntegerBig(), pqt.WithSequence(seq))
+	if d, ok := c.DefaultOn(pqt.EventInsert); !ok || d != seq.NextVal() {
+		t.Errorf("wrong insert default: %s", d)
+	}
+	if _, ok := c.DefaultOn(pqt.EventUpdate); ok {
+		t.Error("update default not expected")
+	}
+}
+
+func TestColumn_IsGeneratedAlways(t *testing.T) {
+	cases := map[string]struct {
+		column   *pqt.Column
+		expected bool
+	}{
+		"plain":               {column: pqt.NewColumn("c", pqt.TypeIntegerBig()), expected: false},
+		"identity-by-default": {column: pqt.NewColumn("c", pqt.TypeIntegerBig(), pqt.WithIdentity(pqt.IdentityByDefault)), expected: false},
+		"identity-always":     {column: pqt.NewColumn("c", pqt.TypeIntegerBig(), pqt.WithIdentity(pqt.IdentityAlways)), expected: true},
+		"generated":           {column: pqt.NewColumn("c", pqt.TypeIntegerBig(), pqt.WithGenerated("a + b")), expected: true},
+	}
+
+	for hint, c := range cases {
+		t.Run(hint, func(t *testing.T) {
+			if got := c.column.IsGeneratedAlways(); got != c.expected {
+				t.Errorf("expected %t but got %t", c.expected, got)
+			}
+		})
+	}
+}
+
 func TestWithIndex(t *testing.T) {
 	c := pqt.NewColumn("with_index", pqt.TypeText(), pqt.WithIndex())
 	if !c.Index {
@@ -28,6 +28,22 @@ const (
 
 type ConstraintType string
 
+// IndexMethod is an access method used by an index.
+type IndexMethod string
+
+const (
+	// IndexMethodBTree is the default index method.
+	IndexMethodBTree IndexMethod = "BTREE"
+	// IndexMethodHash ...
+	IndexMethodHash IndexMethod = "HASH"
+	// IndexMethodGiST ...
+	IndexMethodGiST IndexMethod = "GIST"
+	// IndexMethodGIN is suited for composite values like arrays, jsonb or tsvector.
+	IndexMethodGIN IndexMethod = "GIN"
+	// IndexMethodBRIN ...
+	IndexMethodBRIN IndexMethod = "BRIN"
+)
+
 // ConstraintOption ...
 type ConstraintOption func(*Constraint)
 
@@ -41,6 +57,8 @@ type Constraint struct {
 	Match, OnDelete, OnUpdate                                            int32
 	NoInherit, DeferrableInitiallyDeferred, DeferrableInitiallyImmediate bool
 	MethodSuffix                                                         string
+	// Using is the index method, if empty database default is used.
+	Using IndexMethod
 }
 
 // Name ...
@@ -172,6 +190,16 @@ func Index(table *Table, columns ...*Column) *Constraint {
 	}
 }
 
+// IndexUsing creates index that uses given method.
+func IndexUsing(table *Table, method IndexMethod, columns ...*Column) *Constraint {
+	return &Constraint{
+		Type:           ConstraintTypeIndex,
+		PrimaryTable:   table,
+		PrimaryColumns: columns,
+		Using:          method,
+	}
+}
+
 // UniqueIndex ...
 func UniqueIndex(table *Table, methodSuffix, where string, columns ...*Column) *Constraint {
 	return &Constraint{
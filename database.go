@@ -0,0 +1,136 @@
+package pqt
+
+import "fmt"
+
+// Database groups schemas that are deployed together, they can reference each other.
+type Database struct {
+	Name       string
+	Schemas    []*Schema
+	Extensions []*Extension
+}
+
+// NewDatabase ...
+func NewDatabase(name string, opts ...DatabaseOption) *Database {
+	d := &Database{
+		Name: name,
+	}
+
+	for _, opt := range opts {
+		opt(d)
+	}
+
+	return d
+}
+
+// AddSchema ...
+func (d *Database) AddSchema(s *Schema) *Database {
+	d.Schemas = append(d.Schemas, s)
+
+	return d
+}
+
+// AddExtension ...
+func (d *Database) AddExtension(e *Extension) *Database {
+	d.Extensions = append(d.Extensions, e)
+
+	return d
+}
+
+// SortedSchemas returns schemas in dependency order, schema that holds referenced tables goes first.
+// Otherwise, order in which schemas were added is preserved.
+// It returns an error if schemas reference each other in a cycle.
+func (d *Database) SortedSchemas() ([]*Schema, error) {
+	deps := make(map[*Schema][]*Schema, len(d.Schemas))
+	for _, s := range d.Schemas {
+		for _, t := range s.Tables {
+			for _, c := range t.Constraints {
+				if c.Type != ConstraintTypeForeignKey || c.Table == nil || c.Table.Schema == nil || c.Table.Schema == s {
+					continue
+				}
+				deps[s] = append(deps[s], c.Table.Schema)
+			}
+		}
+	}
+
+	const (
+		visiting = 1
+		visited  = 2
+	)
+	var (
+		sorted []*Schema
+		state  = make(map[*Schema]int, len(d.Schemas))
+		visit  func(s *Schema) error
+	)
+	visit = func(s *Schema) error {
+		switch state[s] {
+		case visiting:
+			return fmt.Errorf("schema %s is part of a reference cycle", s.Name)
+		case visited:
+			return nil
+		}
+		state[s] = visiting
+		for _, dep := range deps[s] {
+			if err := visit(dep); err != nil {
+				return err
+			}
+		}
+		state[s] = visited
+		sorted = append(sorted, s)
+		return nil
+	}
+	for _, s := range d.Schemas {
+		if err := visit(s); err != nil {
+			return nil, err
+		}
+	}
+
+	return sorted, nil
+}
+
+// DatabaseOption configures how we set up a database.
+type DatabaseOption func(*Database)
+
+// WithExtensions ...
+func WithExtensions(extensions ...*Extension) DatabaseOption {
+	return func(d *Database) {
+		d.Extensions = append(d.Extensions, extensions...)
+	}
+}
+
+// Extension is a Postgres extension, e.g. pgcrypto or postgis.
+type Extension struct {
+	Name string
+	// Schema, if set, is the schema extension objects are installed into.
+	Schema  *Schema
+	Version string
+}
+
+// NewExtension ...
+func NewExtension(name string, opts ...ExtensionOption) *Extension {
+	e := &Extension{
+		Name: name,
+	}
+
+	for _, opt := range opts {
+		opt(e)
+	}
+
+	return e
+}
+
+// ExtensionOption configures how we set up an extension.
+type ExtensionOption func(*Extension)
+
+// WithExtensionSchema ...
+func WithExtensionSchema(s *Schema) ExtensionOption {
+	return func(e *Extension) {
+		e.Schema = s
+	}
+}
+
+// WithExtensionVersion ...
+func WithExtensionVersion(v string) ExtensionOption {
+	return func(e *Extension) {
+		e.Version = v
+	}
+}
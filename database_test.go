@@ -0,0 +1,61 @@
+package pqt_test
+
+import (
+	"testing"
+
+	"github.com/piotrkowalczuk/pqt"
+)
+
+func TestDatabase_SortedSchemas(t *testing.T) {
+	customerID := pqt.NewColumn("id", pqt.TypeSerialBig(), pqt.WithPrimaryKey())
+	customer := pqt.NewTable("customer").AddColumn(customerID)
+	invoice := pqt.NewTable("invoice").
+		AddColumn(pqt.NewColumn("id", pqt.TypeSerialBig(), pqt.WithPrimaryKey())).
+		AddColumn(pqt.NewColumn("customer_id", pqt.TypeIntegerBig(), pqt.WithReference(customerID)))
+
+	audit := pqt.NewSchema("audit")
+	billing := pqt.NewSchema("billing").AddTable(invoice)
+	public := pqt.NewSchema("public").AddTable(customer)
+
+	d := pqt.NewDatabase("app").AddSchema(audit).AddSchema(billing).AddSchema(public)
+	got, err := d.SortedSchemas()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	expected := []*pqt.Schema{audit, public, billing}
+	if len(got) != len(expected) {
+		t.Fatalf("wrong number of schemas, expected %d but got %d", len(expected), len(got))
+	}
+	for i := range expected {
+		if got[i] != expected[i] {
+			t.Errorf("wrong schema at position %d, expected %s but got %s", i, expected[i].Name, got[i].Name)
+		}
+	}
+}
+
+func TestDatabase_SortedSchemas_cycle(t *testing.T) {
+	aID := pqt.NewColumn("id", pqt.TypeSerialBig(), pqt.WithPrimaryKey())
+	bID := pqt.NewColumn("id", pqt.TypeSerialBig(), pqt.WithPrimaryKey())
+	a := pqt.NewTable("a").AddColumn(aID)
+	b := pqt.NewTable("b").AddColumn(bID).AddColumn(pqt.NewColumn("a_id", pqt.TypeIntegerBig(), pqt.WithReference(aID)))
+	a.AddColumn(pqt.NewColumn("b_id", pqt.TypeIntegerBig(), pqt.WithReference(bID)))
+
+	d := pqt.NewDatabase("app").
+		AddSchema(pqt.NewSchema("first").AddTable(a)).
+		AddSchema(pqt.NewSchema("second").AddTable(b))
+	if _, err := d.SortedSchemas(); err == nil {
+		t.Fatal("expected error")
+	}
+}
+
+func TestNewExtension(t *testing.T) {
+	s := pqt.NewSchema("extensions")
+	e := pqt.NewExtension("pgcrypto", pqt.WithExtensionSchema(s), pqt.WithExtensionVersion("1.3"))
+	if e.Name != "pgcrypto" || e.Schema != s || e.Version != "1.3" {
+		t.Errorf("wrong extension: %#v", e)
+	}
+	d := pqt.NewDatabase("app", pqt.WithExtensions(e))
+	if len(d.Extensions) != 1 || d.Extensions[0] != e {
+		t.Errorf("wrong extensions: %v", d.Extensions)
+	}
+}
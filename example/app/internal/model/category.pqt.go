@@ -0,0 +1,2325 @@
+// Code generated by pqt. DO NOT EDIT.
+
+package model
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/lib/pq"
+)
+
+const (
+	TableCategoryConstraintPrimaryKey         = "example.category_id_pkey"
+	TableCategoryConstraintParentIDForeignKey = "example.category_parent_id_fkey"
+	TableCategoryConstraintNameIndex          = "example.category_name_idx"
+)
+
+const (
+	TableCategory                = "example.category"
+	TableCategoryColumnContent   = "content"
+	TableCategoryColumnCreatedAt = "created_at"
+	TableCategoryColumnID        = "id"
+	TableCategoryColumnName      = "name"
+	TableCategoryColumnParentID  = "parent_id"
+	TableCategoryColumnUpdatedAt = "updated_at"
+)
+
+var TableCategoryColumns = []string{
+	TableCategoryColumnContent,
+	TableCategoryColumnCreatedAt,
+	TableCategoryColumnID,
+	TableCategoryColumnName,
+	TableCategoryColumnParentID,
+	TableCategoryColumnUpdatedAt,
+}
+
+// CategoryEntity ...
+type CategoryEntity struct {
+	// Content ...
+	Content string
+	// CreatedAt ...
+	CreatedAt time.Time
+	// ID ...
+	ID int64
+	// Name ...
+	Name string
+	// ParentID ...
+	ParentID sql.NullInt64
+	// UpdatedAt ...
+	UpdatedAt pq.NullTime
+	// ChildCategory ...
+	ChildCategory []*CategoryEntity
+	// ParentCategory ...
+	ParentCategory *CategoryEntity
+	// Packages ...
+	Packages []*PackageEntity
+	// Newss ...
+	Newss []*NewsEntity
+	// fields holds fields loaded by a projection, see Loaded.
+	fields CategoryFields
+}
+
+func (e *CategoryEntity) Prop(cn string) (interface{}, bool) {
+	switch cn {
+
+	case TableCategoryColumnContent:
+		return &e.Content, true
+	case TableCategoryColumnCreatedAt:
+		return &e.CreatedAt, true
+	case TableCategoryColumnID:
+		return &e.ID, true
+	case TableCategoryColumnName:
+		return &e.Name, true
+	case TableCategoryColumnParentID:
+		return &e.ParentID, true
+	case TableCategoryColumnUpdatedAt:
+		return &e.UpdatedAt, true
+	default:
+		return nil, false
+	}
+}
+
+func (e *CategoryEntity) Props(cns ...string) ([]interface{}, error) {
+	projection := len(cns) > 0
+	if !projection {
+		cns = TableCategoryColumns
+	}
+	e.fields = CategoryFields{}
+	res := make([]interface{}, 0, len(cns))
+	for _, cn := range cns {
+		if prop, ok := e.Prop(cn); ok {
+			res = append(res, prop)
+		} else {
+			return nil, fmt.Errorf("unexpected column provided: %s", cn)
+		}
+		if projection {
+			e.fields.add(cn)
+		}
+	}
+	return res, nil
+}
+
+// CategoryFields is a set of CategoryEntity fields, identified by their columns.
+type CategoryFields [1]uint64
+
+// Has reports whether the set contains field of given column.
+func (f CategoryFields) Has(cn string) bool {
+	i := categoryFieldIndex(cn)
+	return i >= 0 && f[i/64]&(1<<uint(i%64)) != 0
+}
+
+func (f *CategoryFields) add(cn string) {
+	if i := categoryFieldIndex(cn); i >= 0 {
+		f[i/64] |= 1 << uint(i%64)
+	}
+}
+
+// categoryFieldIndex returns position of the column in TableCategoryColumns, or -1 if there is no such column.
+func categoryFieldIndex(cn string) int {
+	switch cn {
+	case TableCategoryColumnContent:
+		return 0
+	case TableCategoryColumnCreatedAt:
+		return 1
+	case TableCategoryColumnID:
+		return 2
+	case TableCategoryColumnName:
+		return 3
+	case TableCategoryColumnParentID:
+		return 4
+	case TableCategoryColumnUpdatedAt:
+		return 5
+	}
+	return -1
+}
+
+// Loaded reports whether field of given column was loaded by the query that returned the entity.
+// Entities loaded with all columns, as well as entities built by hand, report every field as loaded.
+func (e *CategoryEntity) Loaded(cn string) bool {
+	if e.fields == (CategoryFields{}) {
+		return categoryFieldIndex(cn) >= 0
+	}
+	return e.fields.Has(cn)
+}
+
+// Validate checks if entity meets NOT NULL, length, precision, enum, array size and simple check constraints of the table.
+// It returns ValidationError that lists all violations, if any.
+func (e *CategoryEntity) Validate() error {
+	return nil
+}
+
+const (
+	TableCategoryHistory                       = "example.category_history"
+	TableCategoryHistoryColumnHistoryOperation = "history_operation"
+	TableCategoryHistoryColumnHistoryAt        = "history_at"
+	TableCategoryHistoryColumnHistoryActor     = "history_actor"
+)
+
+// CategoryHistoryEntity is a single version of a category row stored in the history table.
+type CategoryHistoryEntity struct {
+	CategoryEntity
+	// HistoryOperation is a type of the change (INSERT, UPDATE or DELETE) that produced this version.
+	HistoryOperation string
+	// HistoryAt is a time of the change.
+	HistoryAt time.Time
+	// HistoryActor is an author of the change, if it was provided.
+	HistoryActor sql.NullString
+}
+
+// ScanCategoryRows helps to scan rows straight to the slice of entities.
+func ScanCategoryRows(rows Rows) (entities []*CategoryEntity, err error) {
+	for rows.Next() {
+		var ent CategoryEntity
+		err = rows.Scan(
+			&ent.Content,
+			&ent.CreatedAt,
+			&ent.ID,
+			&ent.Name,
+			&ent.ParentID,
+			&ent.UpdatedAt,
+		)
+		if err != nil {
+			return
+		}
+
+		entities = append(entities, &ent)
+	}
+	if err = rows.Err(); err != nil {
+		return
+	}
+
+	return
+}
+
+// CategoryIterator is not thread safe.
+type CategoryIterator struct {
+	rows Rows
+	cols []string
+	expr *CategoryFindExpr
+}
+
+func (i *CategoryIterator) Next() bool {
+	return i.rows.Next()
+}
+
+func (i *CategoryIterator) Close() error {
+	return i.rows.Close()
+}
+
+func (i *CategoryIterator) Err() error {
+	return i.rows.Err()
+}
+
+// Columns is wrapper around sql.Rows.Columns method, that also cache output inside iterator.
+func (i *CategoryIterator) Columns() ([]string, error) {
+	if i.cols == nil {
+		cols, err := i.rows.Columns()
+		if err != nil {
+			return nil, err
+		}
+		i.cols = cols
+	}
+	return i.cols, nil
+}
+
+// Ent is wrapper around Category method that makes iterator more generic.
+func (i *CategoryIterator) Ent() (interface{}, error) {
+	return i.Category()
+}
+
+func (i *CategoryIterator) Category() (*CategoryEntity, error) {
+	var ent CategoryEntity
+	cols, err := i.Columns()
+	if err != nil {
+		return nil, err
+	}
+
+	props, err := ent.Props(cols...)
+	if err != nil {
+		return nil, err
+	}
+	if err := i.rows.Scan(props...); err != nil {
+		return nil, err
+	}
+	return &ent, nil
+}
+
+type CategoryCriteria struct {
+	Content                                sql.NullString
+	CreatedAt                              pq.NullTime
+	ID                                     sql.NullInt64
+	Name                                   sql.NullString
+	ParentID                               sql.NullInt64
+	UpdatedAt                              pq.NullTime
+	hasParentCategory, hasNoParentCategory *CategoryCriteria
+	hasPackages, hasNoPackages             *PackageCriteria
+	hasNewss, hasNoNewss                   *NewsCriteria
+	operator                               string
+	child, sibling, parent                 *CategoryCriteria
+}
+
+// HasParentCategory matches category rows that have at least one related category that meets given criteria, nil criteria matches any.
+func (c *CategoryCriteria) HasParentCategory(where *CategoryCriteria) *CategoryCriteria {
+	if where == nil {
+		where = &CategoryCriteria{}
+	}
+	c.hasParentCategory = where
+	return c
+}
+
+// HasNoParentCategory matches category rows that have no related category that meets given criteria, nil criteria matches any.
+func (c *CategoryCriteria) HasNoParentCategory(where *CategoryCriteria) *CategoryCriteria {
+	if where == nil {
+		where = &CategoryCriteria{}
+	}
+	c.hasNoParentCategory = where
+	return c
+}
+
+// HasPackages matches category rows that have at least one related package that meets given criteria, nil criteria matches any.
+func (c *CategoryCriteria) HasPackages(where *PackageCriteria) *CategoryCriteria {
+	if where == nil {
+		where = &PackageCriteria{}
+	}
+	c.hasPackages = where
+	return c
+}
+
+// HasNoPackages matches category rows that have no related package that meets given criteria, nil criteria matches any.
+func (c *CategoryCriteria) HasNoPackages(where *PackageCriteria) *CategoryCriteria {
+	if where == nil {
+		where = &PackageCriteria{}
+	}
+	c.hasNoPackages = where
+	return c
+}
+
+// HasNewss matches category rows that have at least one related news that meets given criteria, nil criteria matches any.
+func (c *CategoryCriteria) HasNewss(where *NewsCriteria) *CategoryCriteria {
+	if where == nil {
+		where = &NewsCriteria{}
+	}
+	c.hasNewss = where
+	return c
+}
+
+// HasNoNewss matches category rows that have no related news that meets given criteria, nil criteria matches any.
+func (c *CategoryCriteria) HasNoNewss(where *NewsCriteria) *CategoryCriteria {
+	if where == nil {
+		where = &NewsCriteria{}
+	}
+	c.hasNoNewss = where
+	return c
+}
+
+func CategoryOperand(operator string, operands ...*CategoryCriteria) *CategoryCriteria {
+	if len(operands) == 0 {
+		return &CategoryCriteria{operator: operator}
+	}
+
+	parent := &CategoryCriteria{
+		operator: operator,
+		child:    operands[0],
+	}
+
+	for i := 0; i < len(operands); i++ {
+		if i < len(operands)-1 {
+			operands[i].sibling = operands[i+1]
+		}
+		operands[i].parent = parent
+	}
+
+	return parent
+}
+
+func CategoryOr(operands ...*CategoryCriteria) *CategoryCriteria {
+	return CategoryOperand("OR", operands...)
+}
+
+func CategoryAnd(operands ...*CategoryCriteria) *CategoryCriteria {
+	return CategoryOperand("AND", operands...)
+}
+
+// CategoryCriteriaWhereClause writes conditions of the criteria tree into the composer.
+// The id is the alias of the table named table, tables of related rows are resolved relative to its schema.
+func CategoryCriteriaWhereClause(comp *Composer, c *CategoryCriteria, id int, table string) error {
+	if c.child == nil {
+		return _CategoryCriteriaWhereClause(comp, c, id, table)
+	}
+	node := c
+	sibling := false
+	for {
+		if !sibling {
+			if node.child != nil {
+				if node.parent != nil {
+					comp.WriteString("(")
+				}
+				node = node.child
+				continue
+			} else {
+				comp.Dirty = false
+				comp.WriteString("(")
+				if err := _CategoryCriteriaWhereClause(comp, node, id, table); err != nil {
+					return err
+				}
+				comp.WriteString(")")
+			}
+		}
+		if node.sibling != nil {
+			sibling = false
+			comp.WriteString(" ")
+			comp.WriteString(node.parent.operator)
+			comp.WriteString(" ")
+			node = node.sibling
+			continue
+		}
+		if node.parent != nil {
+			sibling = true
+			if node.parent.parent != nil {
+				comp.WriteString(")")
+			}
+			node = node.parent
+			continue
+		}
+
+		break
+	}
+	return nil
+}
+
+func _CategoryCriteriaWhereClause(comp *Composer, c *CategoryCriteria, id int, table string) error {
+	if c.Content.Valid {
+		if comp.Dirty {
+			comp.WriteString(" AND ")
+		}
+		if err := comp.WriteAlias(id); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(TableCategoryColumnContent); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString("="); err != nil {
+			return err
+		}
+		if err := comp.WritePlaceholder(); err != nil {
+			return err
+		}
+		comp.Add(c.Content)
+		comp.Dirty = true
+	}
+	if c.CreatedAt.Valid {
+		if comp.Dirty {
+			comp.WriteString(" AND ")
+		}
+		if err := comp.WriteAlias(id); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(TableCategoryColumnCreatedAt); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString("="); err != nil {
+			return err
+		}
+		if err := comp.WritePlaceholder(); err != nil {
+			return err
+		}
+		comp.Add(c.CreatedAt)
+		comp.Dirty = true
+	}
+	// id is an empty struct, ignore
+
+	if c.Name.Valid {
+		if comp.Dirty {
+			comp.WriteString(" AND ")
+		}
+		if err := comp.WriteAlias(id); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(TableCategoryColumnName); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString("="); err != nil {
+			return err
+		}
+		if err := comp.WritePlaceholder(); err != nil {
+			return err
+		}
+		comp.Add(c.Name)
+		comp.Dirty = true
+	}
+	if c.ParentID.Valid {
+		if comp.Dirty {
+			comp.WriteString(" AND ")
+		}
+		if err := comp.WriteAlias(id); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(TableCategoryColumnParentID); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString("="); err != nil {
+			return err
+		}
+		if err := comp.WritePlaceholder(); err != nil {
+			return err
+		}
+		comp.Add(c.ParentID)
+		comp.Dirty = true
+	}
+	if c.UpdatedAt.Valid {
+		if comp.Dirty {
+			comp.WriteString(" AND ")
+		}
+		if err := comp.WriteAlias(id); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(TableCategoryColumnUpdatedAt); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString("="); err != nil {
+			return err
+		}
+		if err := comp.WritePlaceholder(); err != nil {
+			return err
+		}
+		comp.Add(c.UpdatedAt)
+		comp.Dirty = true
+	}
+	for _, rel := range []struct {
+		where *CategoryCriteria
+		not   bool
+	}{{where: c.hasParentCategory}, {where: c.hasNoParentCategory, not: true}} {
+		if rel.where == nil {
+			continue
+		}
+		sub := id + subqueryAliasOffset
+		if err := existsClause(comp, rel.not, table, sub); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(" WHERE "); err != nil {
+			return err
+		}
+		if err := correlate(comp, sub, TableCategoryColumnParentID, id, TableCategoryColumnID); err != nil {
+			return err
+		}
+		comp.Dirty = true
+		if rel.where.child != nil {
+			if _, err := comp.WriteString(" AND ("); err != nil {
+				return err
+			}
+		}
+		if err := CategoryCriteriaWhereClause(comp, rel.where, sub, table); err != nil {
+			return err
+		}
+		if rel.where.child != nil {
+			if _, err := comp.WriteString(")"); err != nil {
+				return err
+			}
+		}
+		if _, err := comp.WriteString(")"); err != nil {
+			return err
+		}
+		comp.Dirty = true
+	}
+	for _, rel := range []struct {
+		where *PackageCriteria
+		not   bool
+	}{{where: c.hasPackages}, {where: c.hasNoPackages, not: true}} {
+		if rel.where == nil {
+			continue
+		}
+		sub := id + subqueryAliasOffset
+		if err := existsClause(comp, rel.not, inSchemaOf(TablePackage, table), sub); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(" WHERE "); err != nil {
+			return err
+		}
+		if err := correlate(comp, sub, TablePackageColumnCategoryID, id, TableCategoryColumnID); err != nil {
+			return err
+		}
+		comp.Dirty = true
+		if rel.where.child != nil {
+			if _, err := comp.WriteString(" AND ("); err != nil {
+				return err
+			}
+		}
+		if err := PackageCriteriaWhereClause(comp, rel.where, sub, inSchemaOf(TablePackage, table)); err != nil {
+			return err
+		}
+		if rel.where.child != nil {
+			if _, err := comp.WriteString(")"); err != nil {
+				return err
+			}
+		}
+		if _, err := comp.WriteString(")"); err != nil {
+			return err
+		}
+		comp.Dirty = true
+	}
+	for _, rel := range []struct {
+		where *NewsCriteria
+		not   bool
+	}{{where: c.hasNewss}, {where: c.hasNoNewss, not: true}} {
+		if rel.where == nil {
+			continue
+		}
+		sub := id + subqueryAliasOffset
+		if err := existsClause(comp, rel.not, inSchemaOf(TableCategoryNews, table), sub); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(" INNER JOIN " + inSchemaOf(TableNews, table) + " AS t" + strconv.Itoa(sub+1) + " ON "); err != nil {
+			return err
+		}
+		if err := correlate(comp, sub, TableCategoryNewsColumnNewsID, sub+1, TableNewsColumnID); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(" WHERE "); err != nil {
+			return err
+		}
+		if err := correlate(comp, sub, TableCategoryNewsColumnCategoryID, id, TableCategoryColumnID); err != nil {
+			return err
+		}
+		comp.Dirty = true
+		if rel.where.child != nil {
+			if _, err := comp.WriteString(" AND ("); err != nil {
+				return err
+			}
+		}
+		if err := NewsCriteriaWhereClause(comp, rel.where, sub+1, inSchemaOf(TableNews, table)); err != nil {
+			return err
+		}
+		if rel.where.child != nil {
+			if _, err := comp.WriteString(")"); err != nil {
+				return err
+			}
+		}
+		if _, err := comp.WriteString(")"); err != nil {
+			return err
+		}
+		comp.Dirty = true
+	}
+	return nil
+}
+
+type CategoryFindExpr struct {
+	Where         *CategoryCriteria
+	Offset, Limit int64
+	Columns       []CategoryColumn
+	OrderBy       []RowOrder
+	Lock          RowLock
+}
+
+// CategoryColumn is a column of the category table, one of TableCategoryColumns, that find expression selects.
+type CategoryColumn string
+
+// categoryColumnCount selects number of rows instead of columns, it is used by count queries.
+const categoryColumnCount CategoryColumn = "COUNT(*)"
+
+// selectExpr returns select list expression of the column, or an error if the table has no such column.
+func (c CategoryColumn) selectExpr() (string, error) {
+	switch c {
+	case categoryColumnCount:
+		return string(c), nil
+	case TableCategoryColumnContent:
+		return "t0.content", nil
+	case TableCategoryColumnCreatedAt:
+		return "t0.created_at", nil
+	case TableCategoryColumnID:
+		return "t0.id", nil
+	case TableCategoryColumnName:
+		return "t0.name", nil
+	case TableCategoryColumnParentID:
+		return "t0.parent_id", nil
+	case TableCategoryColumnUpdatedAt:
+		return "t0.updated_at", nil
+	}
+	return "", fmt.Errorf("unexpected column provided: %q", string(c))
+}
+
+func categoryColumnNames(cs []CategoryColumn) []string {
+	if len(cs) == 0 {
+		return nil
+	}
+	names := make([]string, 0, len(cs))
+	for _, c := range cs {
+		names = append(names, string(c))
+	}
+	return names
+}
+
+type CategoryJoin struct {
+	On, Where *CategoryCriteria
+	Fetch     bool
+	Kind      JoinType
+}
+
+type CategoryCountExpr struct {
+	Where *CategoryCriteria
+}
+
+type CategoryPatch struct {
+	Content   sql.NullString
+	CreatedAt pq.NullTime
+	Name      sql.NullString
+	ParentID  sql.NullInt64
+	UpdatedAt pq.NullTime
+}
+
+// Validate checks if values set by the patch meet length, precision, enum, array size and simple check constraints of the table.
+// It returns ValidationError that lists all violations, if any.
+func (p *CategoryPatch) Validate() error {
+	return nil
+}
+
+type CategoryRepositoryBase struct {
+	Table   string
+	Columns []string
+	DB      *sql.DB
+	Log     LogFunc
+	// Validate, if true, makes insert, update and upsert methods validate entities and patches before querying the database.
+	Validate bool
+	// StmtCache, if set, is used to prepare queries of fixed shape (insert, delete and find by key) once and reuse them.
+	StmtCache *StmtCache
+}
+
+func (r *CategoryRepositoryBase) Tx(tx *sql.Tx) (*CategoryRepositoryBaseTx, error) {
+	return &CategoryRepositoryBaseTx{
+		base: r,
+		tx:   tx,
+	}, nil
+}
+
+func (r *CategoryRepositoryBase) BeginTx(ctx context.Context, opts ...TxOption) (*CategoryRepositoryBaseTx, error) {
+	tx, err := r.DB.BeginTx(ctx, newTxOptions(opts).sql())
+	if err != nil {
+		return nil, err
+	}
+	return r.Tx(tx)
+}
+
+func (r CategoryRepositoryBase) RunInTransaction(ctx context.Context, fn func(rtx *CategoryRepositoryBaseTx) error, attempts int, opts ...TxOption) (err error) {
+	return RunInTransaction(r.DB, ctx, func(tx *sql.Tx) error {
+		rtx, err := r.Tx(tx)
+		if err != nil {
+			return err
+		}
+		return fn(rtx)
+	}, attempts, opts...)
+}
+
+var (
+	// ErrCategoryConstraintPrimaryKey is returned if primary key constraint example.category_id_pkey is violated.
+	ErrCategoryConstraintPrimaryKey = &Constraint{
+		Name:    "example.category_id_pkey",
+		Type:    "primary key",
+		Table:   "example.category",
+		Columns: []string{"id"},
+	}
+	// ErrCategoryConstraintParentIDForeignKey is returned if foreign key constraint example.category_parent_id_fkey is violated.
+	ErrCategoryConstraintParentIDForeignKey = &Constraint{
+		Name:    "example.category_parent_id_fkey",
+		Type:    "foreign key",
+		Table:   "example.category",
+		Columns: []string{"parent_id"},
+	}
+)
+
+func (r *CategoryRepositoryBase) InsertQuery(e *CategoryEntity, read bool) (string, []interface{}, error) {
+	if r.Validate {
+		if err := e.Validate(); err != nil {
+			return "", nil, err
+		}
+	}
+	insert := NewComposer(6)
+	columns := bytes.NewBuffer(nil)
+	buf := bytes.NewBufferString("INSERT INTO ")
+	buf.WriteString(r.Table)
+
+	if columns.Len() > 0 {
+		if _, err := columns.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+	}
+	if _, err := columns.WriteString(TableCategoryColumnContent); err != nil {
+		return "", nil, err
+	}
+	if insert.Dirty {
+		if _, err := insert.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+	}
+	if err := insert.WritePlaceholder(); err != nil {
+		return "", nil, err
+	}
+	insert.Add(e.Content)
+	insert.Dirty = true
+
+	if !e.CreatedAt.IsZero() {
+		if columns.Len() > 0 {
+			if _, err := columns.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := columns.WriteString(TableCategoryColumnCreatedAt); err != nil {
+			return "", nil, err
+		}
+		if insert.Dirty {
+			if _, err := insert.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if err := insert.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		insert.Add(e.CreatedAt)
+		insert.Dirty = true
+	}
+
+	if columns.Len() > 0 {
+		if _, err := columns.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+	}
+	if _, err := columns.WriteString(TableCategoryColumnName); err != nil {
+		return "", nil, err
+	}
+	if insert.Dirty {
+		if _, err := insert.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+	}
+	if err := insert.WritePlaceholder(); err != nil {
+		return "", nil, err
+	}
+	insert.Add(e.Name)
+	insert.Dirty = true
+
+	if e.ParentID.Valid {
+		if columns.Len() > 0 {
+			if _, err := columns.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := columns.WriteString(TableCategoryColumnParentID); err != nil {
+			return "", nil, err
+		}
+		if insert.Dirty {
+			if _, err := insert.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if err := insert.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		insert.Add(e.ParentID)
+		insert.Dirty = true
+	}
+
+	if e.UpdatedAt.Valid {
+		if columns.Len() > 0 {
+			if _, err := columns.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := columns.WriteString(TableCategoryColumnUpdatedAt); err != nil {
+			return "", nil, err
+		}
+		if insert.Dirty {
+			if _, err := insert.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if err := insert.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		insert.Add(e.UpdatedAt)
+		insert.Dirty = true
+	}
+
+	if columns.Len() > 0 {
+		buf.WriteString(" (")
+		buf.ReadFrom(columns)
+		buf.WriteString(") VALUES (")
+		buf.ReadFrom(insert)
+		buf.WriteString(") ")
+		if read {
+			buf.WriteString("RETURNING ")
+			if len(r.Columns) > 0 {
+				buf.WriteString(strings.Join(r.Columns, ", "))
+			} else {
+				buf.WriteString("content, created_at, id, name, parent_id, updated_at")
+			}
+		}
+	}
+	return buf.String(), insert.Args(), nil
+}
+
+func (r *CategoryRepositoryBase) insert(ctx context.Context, tx *sql.Tx, e *CategoryEntity) (*CategoryEntity, error) {
+	query, args, err := r.InsertQuery(e, true)
+	if err != nil {
+		return nil, err
+	}
+
+	err = queryRow(ctx, r.DB, tx, r.StmtCache, query, args,
+		&e.Content,
+		&e.CreatedAt,
+		&e.ID,
+		&e.Name,
+		&e.ParentID,
+		&e.UpdatedAt,
+	)
+	if r.Log != nil {
+		if tx == nil {
+			r.Log(err, TableCategory, "insert", query, args...)
+		} else {
+			r.Log(err, TableCategory, "insert tx", query, args...)
+		}
+	}
+	if err != nil {
+		return nil, wrapError(err)
+	}
+	return e, nil
+}
+
+func (r *CategoryRepositoryBase) Insert(ctx context.Context, e *CategoryEntity) (*CategoryEntity, error) {
+	return r.insert(ctx, nil, e)
+}
+
+func (r *CategoryRepositoryBase) FindQuery(fe *CategoryFindExpr) (string, []interface{}, error) {
+	comp := NewComposer(6)
+	buf := bytes.NewBufferString("SELECT ")
+	if len(fe.Columns) == 0 {
+		buf.WriteString("t0.content, t0.created_at, t0.id, t0.name, t0.parent_id, t0.updated_at")
+	} else {
+		for i, c := range fe.Columns {
+			if i > 0 {
+				buf.WriteString(", ")
+			}
+			expr, err := c.selectExpr()
+			if err != nil {
+				return "", nil, err
+			}
+			buf.WriteString(expr)
+		}
+	}
+	buf.WriteString(" FROM ")
+	buf.WriteString(r.Table)
+	buf.WriteString(" AS t0")
+	if comp.Dirty {
+		buf.ReadFrom(comp)
+		comp.Dirty = false
+	}
+	if fe.Where != nil {
+		if err := CategoryCriteriaWhereClause(comp, fe.Where, 0, r.Table); err != nil {
+			return "", nil, err
+		}
+	}
+	if comp.Dirty {
+		if _, err := buf.WriteString(" WHERE "); err != nil {
+			return "", nil, err
+		}
+		buf.ReadFrom(comp)
+	}
+
+	if len(fe.OrderBy) > 0 {
+		i := 0
+		for _, order := range fe.OrderBy {
+			for _, columnName := range TableCategoryColumns {
+				if order.Name == columnName {
+					if i == 0 {
+						comp.WriteString(" ORDER BY ")
+					}
+					if i > 0 {
+						if _, err := comp.WriteString(", "); err != nil {
+							return "", nil, err
+						}
+					}
+					if _, err := comp.WriteString(order.Name); err != nil {
+						return "", nil, err
+					}
+					if order.Descending {
+						if _, err := comp.WriteString(" DESC"); err != nil {
+							return "", nil, err
+						}
+					}
+					i++
+					break
+				}
+			}
+		}
+	}
+	if fe.Offset > 0 {
+		if _, err := comp.WriteString(" OFFSET "); err != nil {
+			return "", nil, err
+		}
+		if err := comp.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		if _, err := comp.WriteString(" "); err != nil {
+			return "", nil, err
+		}
+		comp.Add(fe.Offset)
+	}
+	if fe.Limit > 0 {
+		if _, err := comp.WriteString(" LIMIT "); err != nil {
+			return "", nil, err
+		}
+		if err := comp.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		if _, err := comp.WriteString(" "); err != nil {
+			return "", nil, err
+		}
+		comp.Add(fe.Limit)
+	}
+	if fe.Lock.Actionable() {
+		var of string
+		if err := lockClause(comp, fe.Lock, of); err != nil {
+			return "", nil, err
+		}
+	}
+
+	buf.ReadFrom(comp)
+
+	return buf.String(), comp.Args(), nil
+}
+
+func (r *CategoryRepositoryBase) find(ctx context.Context, tx *sql.Tx, fe *CategoryFindExpr) ([]*CategoryEntity, error) {
+	if tx == nil && fe.Lock.Actionable() {
+		return nil, ErrRowLockOutsideTransaction
+	}
+	query, args, err := r.FindQuery(fe)
+	if err != nil {
+		return nil, err
+	}
+	var rows *sql.Rows
+	if tx == nil {
+		rows, err = r.DB.QueryContext(ctx, query, args...)
+	} else {
+		rows, err = tx.QueryContext(ctx, query, args...)
+	}
+	if r.Log != nil {
+		if tx == nil {
+			r.Log(err, TableCategory, "find", query, args...)
+		} else {
+			r.Log(err, TableCategory, "find tx", query, args...)
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var (
+		entities []*CategoryEntity
+		props    []interface{}
+	)
+	cols := categoryColumnNames(fe.Columns)
+	for rows.Next() {
+		var ent CategoryEntity
+		if props, err = ent.Props(cols...); err != nil {
+			return nil, err
+		}
+		err = rows.Scan(props...)
+		if err != nil {
+			return nil, err
+		}
+
+		entities = append(entities, &ent)
+	}
+	err = rows.Err()
+	if r.Log != nil {
+		r.Log(err, TableCategory, "find", query, args...)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return entities, nil
+}
+
+func (r *CategoryRepositoryBase) Find(ctx context.Context, fe *CategoryFindExpr) ([]*CategoryEntity, error) {
+	return r.find(ctx, nil, fe)
+}
+
+func (r *CategoryRepositoryBase) findIter(ctx context.Context, tx *sql.Tx, fe *CategoryFindExpr) (*CategoryIterator, error) {
+	if tx == nil && fe.Lock.Actionable() {
+		return nil, ErrRowLockOutsideTransaction
+	}
+	query, args, err := r.FindQuery(fe)
+	if err != nil {
+		return nil, err
+	}
+	var rows *sql.Rows
+	if tx == nil {
+		rows, err = r.DB.QueryContext(ctx, query, args...)
+	} else {
+		rows, err = tx.QueryContext(ctx, query, args...)
+	}
+	if r.Log != nil {
+		if tx == nil {
+			r.Log(err, TableCategory, "find iter", query, args...)
+		} else {
+			r.Log(err, TableCategory, "find iter tx", query, args...)
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &CategoryIterator{
+		rows: rows,
+		expr: fe,
+		cols: categoryColumnNames(fe.Columns),
+	}, nil
+}
+
+func (r *CategoryRepositoryBase) FindIter(ctx context.Context, fe *CategoryFindExpr) (*CategoryIterator, error) {
+	return r.findIter(ctx, nil, fe)
+}
+
+func (r *CategoryRepositoryBase) findProjection(ctx context.Context, tx *sql.Tx, fe *CategoryFindExpr, next func() Projection) error {
+	if tx == nil && fe.Lock.Actionable() {
+		return ErrRowLockOutsideTransaction
+	}
+	query, args, err := r.FindQuery(fe)
+	if err != nil {
+		return err
+	}
+	var rows *sql.Rows
+	if tx == nil {
+		rows, err = r.DB.QueryContext(ctx, query, args...)
+	} else {
+		rows, err = tx.QueryContext(ctx, query, args...)
+	}
+	if r.Log != nil {
+		if tx == nil {
+			r.Log(err, TableCategory, "find projection", query, args...)
+		} else {
+			r.Log(err, TableCategory, "find projection tx", query, args...)
+		}
+	}
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	cols, err := rows.Columns()
+	if err != nil {
+		return err
+	}
+	for rows.Next() {
+		props, err := next().Props(cols...)
+		if err != nil {
+			return err
+		}
+		if err := rows.Scan(props...); err != nil {
+			return err
+		}
+	}
+	return rows.Err()
+}
+
+// FindProjection works like Find, but scans each row into the projection returned by next.
+// Selected columns, see CategoryFindExpr.Columns, are passed to its Props method.
+func (r *CategoryRepositoryBase) FindProjection(ctx context.Context, fe *CategoryFindExpr, next func() Projection) error {
+	return r.findProjection(ctx, nil, fe, next)
+}
+
+func (r *CategoryRepositoryBase) findOneByID(ctx context.Context, tx *sql.Tx, pk int64, lock RowLock) (*CategoryEntity, error) {
+	find := NewComposer(6)
+	find.WriteString("SELECT ")
+	if len(r.Columns) == 0 {
+		find.WriteString("content, created_at, id, name, parent_id, updated_at")
+	} else {
+		find.WriteString(strings.Join(r.Columns, ", "))
+	}
+	find.WriteString(" FROM ")
+	find.WriteString(r.Table)
+	find.WriteString(" WHERE ")
+	find.WriteString(TableCategoryColumnID)
+	find.WriteString("=")
+	find.WritePlaceholder()
+	find.Add(pk)
+	if lock.Actionable() {
+		if tx == nil {
+			return nil, ErrRowLockOutsideTransaction
+		}
+		if err := lockClause(find, lock, ""); err != nil {
+			return nil, err
+		}
+	}
+	var (
+		ent CategoryEntity
+	)
+	props, err := ent.Props(r.Columns...)
+	if err != nil {
+		return nil, err
+	}
+	err = queryRow(ctx, r.DB, tx, r.StmtCache, find.String(), find.Args(), props...)
+	if r.Log != nil {
+		if tx == nil {
+			r.Log(err, TableCategory, "find by primary key", find.String(), find.Args()...)
+		} else {
+			r.Log(err, TableCategory, "find by primary key tx", find.String(), find.Args()...)
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &ent, nil
+}
+
+func (r *CategoryRepositoryBase) FindOneByID(ctx context.Context, pk int64) (*CategoryEntity, error) {
+	return r.findOneByID(ctx, nil, pk, RowLock{})
+}
+
+func (r *CategoryRepositoryBase) history(ctx context.Context, tx *sql.Tx, pk int64) ([]*CategoryHistoryEntity, error) {
+	find := NewComposer(6)
+	find.WriteString("SELECT ")
+	if len(r.Columns) == 0 {
+		find.WriteString("content, created_at, id, name, parent_id, updated_at")
+	} else {
+		find.WriteString(strings.Join(r.Columns, ", "))
+	}
+	find.WriteString(", history_operation, history_at, history_actor FROM ")
+	find.WriteString(inSchemaOf(TableCategoryHistory, r.Table))
+	find.WriteString(" WHERE ")
+	find.WriteString(TableCategoryColumnID)
+	find.WriteString("=")
+	find.WritePlaceholder()
+	find.Add(pk)
+	find.WriteString(" ORDER BY ")
+	find.WriteString(TableCategoryHistoryColumnHistoryAt)
+
+	var (
+		rows *sql.Rows
+		err  error
+	)
+	if tx == nil {
+		rows, err = r.DB.QueryContext(ctx, find.String(), find.Args()...)
+	} else {
+		rows, err = tx.QueryContext(ctx, find.String(), find.Args()...)
+	}
+	if r.Log != nil {
+		if tx == nil {
+			r.Log(err, TableCategory, "history", find.String(), find.Args()...)
+		} else {
+			r.Log(err, TableCategory, "history tx", find.String(), find.Args()...)
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var entities []*CategoryHistoryEntity
+	for rows.Next() {
+		var ent CategoryHistoryEntity
+		props, err := ent.Props(r.Columns...)
+		if err != nil {
+			return nil, err
+		}
+		err = rows.Scan(append(props, &ent.HistoryOperation, &ent.HistoryAt, &ent.HistoryActor)...)
+		if err != nil {
+			return nil, err
+		}
+
+		entities = append(entities, &ent)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+	return entities, nil
+}
+
+func (r *CategoryRepositoryBase) History(ctx context.Context, pk int64) ([]*CategoryHistoryEntity, error) {
+	return r.history(ctx, nil, pk)
+}
+
+func (r *CategoryRepositoryBase) findOneByIDAsOf(ctx context.Context, tx *sql.Tx, pk int64, at time.Time) (*CategoryEntity, error) {
+	find := NewComposer(6)
+	find.WriteString("SELECT ")
+	if len(r.Columns) == 0 {
+		find.WriteString("content, created_at, id, name, parent_id, updated_at")
+	} else {
+		find.WriteString(strings.Join(r.Columns, ", "))
+	}
+	find.WriteString(", history_operation, history_at, history_actor FROM ")
+	find.WriteString(inSchemaOf(TableCategoryHistory, r.Table))
+	find.WriteString(" WHERE ")
+	find.WriteString(TableCategoryColumnID)
+	find.WriteString("=")
+	find.WritePlaceholder()
+	find.Add(pk)
+	find.WriteString(" AND ")
+	find.WriteString(TableCategoryHistoryColumnHistoryAt)
+	find.WriteString("<=")
+	find.WritePlaceholder()
+	find.Add(at)
+	find.WriteString(" ORDER BY ")
+	find.WriteString(TableCategoryHistoryColumnHistoryAt)
+	find.WriteString(" DESC LIMIT 1")
+
+	var ent CategoryHistoryEntity
+	props, err := ent.Props(r.Columns...)
+	if err != nil {
+		return nil, err
+	}
+	props = append(props, &ent.HistoryOperation, &ent.HistoryAt, &ent.HistoryActor)
+	if tx == nil {
+		err = r.DB.QueryRowContext(ctx, find.String(), find.Args()...).Scan(props...)
+	} else {
+		err = tx.QueryRowContext(ctx, find.String(), find.Args()...).Scan(props...)
+	}
+	if r.Log != nil {
+		if tx == nil {
+			r.Log(err, TableCategory, "find by primary key as of", find.String(), find.Args()...)
+		} else {
+			r.Log(err, TableCategory, "find by primary key as of tx", find.String(), find.Args()...)
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	if ent.HistoryOperation == "DELETE" {
+		return nil, sql.ErrNoRows
+	}
+	return &ent.CategoryEntity, nil
+}
+
+// FindOneByIDAsOf returns version of the row that was current at given time.
+// If the row did not exist at that time, sql.ErrNoRows is returned.
+func (r *CategoryRepositoryBase) FindOneByIDAsOf(ctx context.Context, pk int64, at time.Time) (*CategoryEntity, error) {
+	return r.findOneByIDAsOf(ctx, nil, pk, at)
+}
+
+func (r *CategoryRepositoryBase) UpdateOneByIDQuery(pk int64, p *CategoryPatch) (string, []interface{}, error) {
+	if r.Validate {
+		if err := p.Validate(); err != nil {
+			return "", nil, err
+		}
+	}
+	buf := bytes.NewBufferString("UPDATE ")
+	buf.WriteString(r.Table)
+	update := NewComposer(6)
+	if p.Content.Valid {
+		if update.Dirty {
+			if _, err := update.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := update.WriteString(TableCategoryColumnContent); err != nil {
+			return "", nil, err
+		}
+		if _, err := update.WriteString("="); err != nil {
+			return "", nil, err
+		}
+		if err := update.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		update.Add(p.Content)
+		update.Dirty = true
+
+	}
+	if p.CreatedAt.Valid {
+		if update.Dirty {
+			if _, err := update.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := update.WriteString(TableCategoryColumnCreatedAt); err != nil {
+			return "", nil, err
+		}
+		if _, err := update.WriteString("="); err != nil {
+			return "", nil, err
+		}
+		if err := update.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		update.Add(p.CreatedAt)
+		update.Dirty = true
+
+	}
+	if p.Name.Valid {
+		if update.Dirty {
+			if _, err := update.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := update.WriteString(TableCategoryColumnName); err != nil {
+			return "", nil, err
+		}
+		if _, err := update.WriteString("="); err != nil {
+			return "", nil, err
+		}
+		if err := update.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		update.Add(p.Name)
+		update.Dirty = true
+
+	}
+	if p.ParentID.Valid {
+		if update.Dirty {
+			if _, err := update.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := update.WriteString(TableCategoryColumnParentID); err != nil {
+			return "", nil, err
+		}
+		if _, err := update.WriteString("="); err != nil {
+			return "", nil, err
+		}
+		if err := update.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		update.Add(p.ParentID)
+		update.Dirty = true
+
+	}
+	if p.UpdatedAt.Valid {
+		if update.Dirty {
+			if _, err := update.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := update.WriteString(TableCategoryColumnUpdatedAt); err != nil {
+			return "", nil, err
+		}
+		if _, err := update.WriteString("="); err != nil {
+			return "", nil, err
+		}
+		if err := update.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		update.Add(p.UpdatedAt)
+		update.Dirty = true
+
+	} else {
+		if update.Dirty {
+			if _, err := update.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := update.WriteString(TableCategoryColumnUpdatedAt); err != nil {
+			return "", nil, err
+		}
+		if _, err := update.WriteString("=NOW()"); err != nil {
+			return "", nil, err
+		}
+		update.Dirty = true
+	}
+	if !update.Dirty {
+		return "", nil, errors.New("Category update failure, nothing to update")
+	}
+	buf.WriteString(" SET ")
+	buf.ReadFrom(update)
+	buf.WriteString(" WHERE ")
+
+	update.WriteString(TableCategoryColumnID)
+	update.WriteString("=")
+	update.WritePlaceholder()
+	update.Add(pk)
+
+	buf.ReadFrom(update)
+	buf.WriteString(" RETURNING ")
+	if len(r.Columns) > 0 {
+		buf.WriteString(strings.Join(r.Columns, ", "))
+	} else {
+		buf.WriteString("content, created_at, id, name, parent_id, updated_at")
+	}
+	return buf.String(), update.Args(), nil
+}
+
+func (r *CategoryRepositoryBase) updateOneByID(ctx context.Context, tx *sql.Tx, pk int64, p *CategoryPatch) (*CategoryEntity, error) {
+	query, args, err := r.UpdateOneByIDQuery(pk, p)
+	if err != nil {
+		return nil, err
+	}
+	var ent CategoryEntity
+	props, err := ent.Props(r.Columns...)
+	if err != nil {
+		return nil, err
+	}
+	if tx == nil {
+		err = r.DB.QueryRowContext(ctx, query, args...).Scan(props...)
+	} else {
+		err = tx.QueryRowContext(ctx, query, args...).Scan(props...)
+	}
+	if r.Log != nil {
+		if tx == nil {
+			r.Log(err, TableCategory, "update by primary key", query, args...)
+		} else {
+			r.Log(err, TableCategory, "update by primary key tx", query, args...)
+		}
+	}
+	if err != nil {
+		return nil, wrapError(err)
+	}
+	return &ent, nil
+}
+
+func (r *CategoryRepositoryBase) UpdateOneByID(ctx context.Context, pk int64, p *CategoryPatch) (*CategoryEntity, error) {
+	return r.updateOneByID(ctx, nil, pk, p)
+}
+
+func (r *CategoryRepositoryBase) FindOneByIDAndUpdate(ctx context.Context, pk int64, p *CategoryPatch) (before, after *CategoryEntity, err error) {
+	find := NewComposer(6)
+	find.WriteString("SELECT ")
+	if len(r.Columns) == 0 {
+		find.WriteString("content, created_at, id, name, parent_id, updated_at")
+	} else {
+		find.WriteString(strings.Join(r.Columns, ", "))
+	}
+	find.WriteString(" FROM ")
+	find.WriteString(r.Table)
+	find.WriteString(" WHERE ")
+	find.WriteString(TableCategoryColumnID)
+	find.WriteString("=")
+	find.WritePlaceholder()
+	find.Add(pk)
+	find.WriteString(" FOR UPDATE")
+	query, args, err := r.UpdateOneByIDQuery(pk, p)
+	if err != nil {
+		return
+	}
+	var (
+		oldEnt, newEnt CategoryEntity
+	)
+	oldProps, err := oldEnt.Props(r.Columns...)
+	if err != nil {
+		return
+	}
+	newProps, err := newEnt.Props(r.Columns...)
+	if err != nil {
+		return
+	}
+	tx, err := r.DB.Begin()
+	if err != nil {
+		return
+	}
+	err = tx.QueryRowContext(ctx, find.String(), find.Args()...).Scan(oldProps...)
+	if r.Log != nil {
+		r.Log(err, TableCategory, "find by primary key", find.String(), find.Args()...)
+	}
+	if err != nil {
+		tx.Rollback()
+		return
+	}
+	err = tx.QueryRowContext(ctx, query, args...).Scan(newProps...)
+	if r.Log != nil {
+		r.Log(err, TableCategory, "update by primary key", query, args...)
+	}
+	if err != nil {
+		tx.Rollback()
+		err = wrapError(err)
+		return
+	}
+	err = tx.Commit()
+	if err != nil {
+		return
+	}
+	return &oldEnt, &newEnt, nil
+}
+
+func (r *CategoryRepositoryBase) UpsertQuery(e *CategoryEntity, p *CategoryPatch, inf ...string) (string, []interface{}, error) {
+	if r.Validate {
+		if err := e.Validate(); err != nil {
+			return "", nil, err
+		}
+		if p != nil {
+			if err := p.Validate(); err != nil {
+				return "", nil, err
+			}
+		}
+	}
+	upsert := NewComposer(12)
+	columns := bytes.NewBuffer(nil)
+	buf := bytes.NewBufferString("INSERT INTO ")
+	buf.WriteString(r.Table)
+
+	if columns.Len() > 0 {
+		if _, err := columns.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+	}
+	if _, err := columns.WriteString(TableCategoryColumnContent); err != nil {
+		return "", nil, err
+	}
+	if upsert.Dirty {
+		if _, err := upsert.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+	}
+	if err := upsert.WritePlaceholder(); err != nil {
+		return "", nil, err
+	}
+	upsert.Add(e.Content)
+	upsert.Dirty = true
+
+	if !e.CreatedAt.IsZero() {
+		if columns.Len() > 0 {
+			if _, err := columns.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := columns.WriteString(TableCategoryColumnCreatedAt); err != nil {
+			return "", nil, err
+		}
+		if upsert.Dirty {
+			if _, err := upsert.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if err := upsert.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		upsert.Add(e.CreatedAt)
+		upsert.Dirty = true
+	}
+
+	if columns.Len() > 0 {
+		if _, err := columns.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+	}
+	if _, err := columns.WriteString(TableCategoryColumnName); err != nil {
+		return "", nil, err
+	}
+	if upsert.Dirty {
+		if _, err := upsert.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+	}
+	if err := upsert.WritePlaceholder(); err != nil {
+		return "", nil, err
+	}
+	upsert.Add(e.Name)
+	upsert.Dirty = true
+
+	if e.ParentID.Valid {
+		if columns.Len() > 0 {
+			if _, err := columns.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := columns.WriteString(TableCategoryColumnParentID); err != nil {
+			return "", nil, err
+		}
+		if upsert.Dirty {
+			if _, err := upsert.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if err := upsert.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		upsert.Add(e.ParentID)
+		upsert.Dirty = true
+	}
+
+	if e.UpdatedAt.Valid {
+		if columns.Len() > 0 {
+			if _, err := columns.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := columns.WriteString(TableCategoryColumnUpdatedAt); err != nil {
+			return "", nil, err
+		}
+		if upsert.Dirty {
+			if _, err := upsert.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if err := upsert.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		upsert.Add(e.UpdatedAt)
+		upsert.Dirty = true
+	}
+
+	if upsert.Dirty {
+		buf.WriteString(" (")
+		buf.ReadFrom(columns)
+		buf.WriteString(") VALUES (")
+		buf.ReadFrom(upsert)
+		buf.WriteString(")")
+	}
+	buf.WriteString(" ON CONFLICT ")
+	if len(inf) > 0 {
+		upsert.Dirty = false
+		if p.Content.Valid {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TableCategoryColumnContent); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("="); err != nil {
+				return "", nil, err
+			}
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(p.Content)
+			upsert.Dirty = true
+
+		}
+		if p.CreatedAt.Valid {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TableCategoryColumnCreatedAt); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("="); err != nil {
+				return "", nil, err
+			}
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(p.CreatedAt)
+			upsert.Dirty = true
+
+		}
+		if p.Name.Valid {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TableCategoryColumnName); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("="); err != nil {
+				return "", nil, err
+			}
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(p.Name)
+			upsert.Dirty = true
+
+		}
+		if p.ParentID.Valid {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TableCategoryColumnParentID); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("="); err != nil {
+				return "", nil, err
+			}
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(p.ParentID)
+			upsert.Dirty = true
+
+		}
+		if p.UpdatedAt.Valid {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TableCategoryColumnUpdatedAt); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("="); err != nil {
+				return "", nil, err
+			}
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(p.UpdatedAt)
+			upsert.Dirty = true
+
+		} else {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TableCategoryColumnUpdatedAt); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("=NOW()"); err != nil {
+				return "", nil, err
+			}
+			upsert.Dirty = true
+		}
+	}
+	if len(inf) > 0 && upsert.Dirty {
+		buf.WriteString("(")
+		for j, i := range inf {
+			if j != 0 {
+				buf.WriteString(", ")
+			}
+			buf.WriteString(i)
+		}
+		buf.WriteString(")")
+		buf.WriteString(" DO UPDATE SET ")
+		buf.ReadFrom(upsert)
+	} else {
+		buf.WriteString(" DO NOTHING ")
+	}
+	if upsert.Dirty {
+		buf.WriteString(" RETURNING ")
+		if len(r.Columns) > 0 {
+			buf.WriteString(strings.Join(r.Columns, ", "))
+		} else {
+			buf.WriteString("content, created_at, id, name, parent_id, updated_at")
+		}
+	}
+	return buf.String(), upsert.Args(), nil
+}
+
+func (r *CategoryRepositoryBase) upsert(ctx context.Context, tx *sql.Tx, e *CategoryEntity, p *CategoryPatch, inf ...string) (*CategoryEntity, error) {
+	query, args, err := r.UpsertQuery(e, p, inf...)
+	if err != nil {
+		return nil, err
+	}
+
+	var row *sql.Row
+	if tx == nil {
+		row = r.DB.QueryRowContext(ctx, query, args...)
+	} else {
+		row = tx.QueryRowContext(ctx, query, args...)
+	}
+	err = row.Scan(
+		&e.Content,
+		&e.CreatedAt,
+		&e.ID,
+		&e.Name,
+		&e.ParentID,
+		&e.UpdatedAt,
+	)
+	if r.Log != nil {
+		if tx == nil {
+			r.Log(err, TableCategory, "upsert", query, args...)
+		} else {
+			r.Log(err, TableCategory, "upsert tx", query, args...)
+		}
+	}
+	if err != nil {
+		return nil, wrapError(err)
+	}
+	return e, nil
+}
+
+func (r *CategoryRepositoryBase) Upsert(ctx context.Context, e *CategoryEntity, p *CategoryPatch, inf ...string) (*CategoryEntity, error) {
+	return r.upsert(ctx, nil, e, p, inf...)
+}
+
+// CategoryConflictTarget is a conflict target of category upsert, only CategoryConflict* variables can be used.
+type CategoryConflictTarget struct {
+	constraint string
+	columns    []string
+	where      string
+}
+
+func (ct CategoryConflictTarget) write(buf *bytes.Buffer) {
+	switch {
+	case ct.constraint != "":
+		buf.WriteString(" ON CONSTRAINT \"")
+		buf.WriteString(ct.constraint)
+		buf.WriteString("\"")
+	case len(ct.columns) > 0:
+		buf.WriteString(" (")
+		buf.WriteString(strings.Join(ct.columns, ", "))
+		buf.WriteString(")")
+		if ct.where != "" {
+			buf.WriteString(" WHERE ")
+			buf.WriteString(ct.where)
+		}
+	}
+}
+
+var (
+	// CategoryConflictPrimaryKey targets primary key constraint.
+	CategoryConflictPrimaryKey = CategoryConflictTarget{constraint: TableCategoryConstraintPrimaryKey}
+)
+
+// CategoryUpsertExpr describes how conflicts are resolved by UpsertOne and UpsertMany.
+type CategoryUpsertExpr struct {
+	// Target is required if Patch is set, DO NOTHING can be used without it.
+	Target CategoryConflictTarget
+	// Patch is applied to the conflicting row (DO UPDATE), if nil or empty the row is left untouched (DO NOTHING).
+	Patch *CategoryPatch
+	// Where limits DO UPDATE to the conflicting rows that match the criteria, other rows are left untouched.
+	Where *CategoryCriteria
+}
+
+func (r *CategoryRepositoryBase) UpsertManyQuery(ue *CategoryUpsertExpr, es ...*CategoryEntity) (string, []interface{}, error) {
+	if len(es) == 0 {
+		return "", nil, errors.New("upsert requires at least one entity")
+	}
+	if ue == nil {
+		ue = &CategoryUpsertExpr{}
+	}
+	if r.Validate {
+		for _, e := range es {
+			if err := e.Validate(); err != nil {
+				return "", nil, err
+			}
+		}
+		if ue.Patch != nil {
+			if err := ue.Patch.Validate(); err != nil {
+				return "", nil, err
+			}
+		}
+	}
+	upsert := NewComposer(5)
+	buf := bytes.NewBufferString("INSERT INTO ")
+	buf.WriteString(r.Table)
+	buf.WriteString(" AS t0 (")
+	buf.WriteString(TableCategoryColumnContent + ", " + TableCategoryColumnCreatedAt + ", " + TableCategoryColumnName + ", " + TableCategoryColumnParentID + ", " + TableCategoryColumnUpdatedAt)
+	buf.WriteString(") VALUES ")
+	for i, e := range es {
+		if i != 0 {
+			if _, err := upsert.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := upsert.WriteString("("); err != nil {
+			return "", nil, err
+		}
+		if err := upsert.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		upsert.Add(e.Content)
+		if _, err := upsert.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+		if !e.CreatedAt.IsZero() {
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(e.CreatedAt)
+		} else if _, err := upsert.WriteString("DEFAULT"); err != nil {
+			return "", nil, err
+		}
+		if _, err := upsert.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+		if err := upsert.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		upsert.Add(e.Name)
+		if _, err := upsert.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+		if e.ParentID.Valid {
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(e.ParentID)
+		} else if _, err := upsert.WriteString("DEFAULT"); err != nil {
+			return "", nil, err
+		}
+		if _, err := upsert.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+		if e.UpdatedAt.Valid {
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(e.UpdatedAt)
+		} else if _, err := upsert.WriteString("DEFAULT"); err != nil {
+			return "", nil, err
+		}
+		if _, err := upsert.WriteString(")"); err != nil {
+			return "", nil, err
+		}
+	}
+	buf.ReadFrom(upsert)
+	buf.WriteString(" ON CONFLICT")
+	ue.Target.write(buf)
+
+	upsert.Dirty = false
+	if p := ue.Patch; p != nil {
+		if p.Content.Valid {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TableCategoryColumnContent); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("="); err != nil {
+				return "", nil, err
+			}
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(p.Content)
+			upsert.Dirty = true
+
+		}
+		if p.CreatedAt.Valid {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TableCategoryColumnCreatedAt); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("="); err != nil {
+				return "", nil, err
+			}
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(p.CreatedAt)
+			upsert.Dirty = true
+
+		}
+		if p.Name.Valid {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TableCategoryColumnName); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("="); err != nil {
+				return "", nil, err
+			}
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(p.Name)
+			upsert.Dirty = true
+
+		}
+		if p.ParentID.Valid {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TableCategoryColumnParentID); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("="); err != nil {
+				return "", nil, err
+			}
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(p.ParentID)
+			upsert.Dirty = true
+
+		}
+		if p.UpdatedAt.Valid {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TableCategoryColumnUpdatedAt); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("="); err != nil {
+				return "", nil, err
+			}
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(p.UpdatedAt)
+			upsert.Dirty = true
+
+		} else {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TableCategoryColumnUpdatedAt); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("=NOW()"); err != nil {
+				return "", nil, err
+			}
+			upsert.Dirty = true
+		}
+	}
+	if !upsert.Dirty {
+		buf.WriteString(" DO NOTHING")
+	} else {
+		if ue.Target.constraint == "" && len(ue.Target.columns) == 0 {
+			return "", nil, errors.New("upsert with patch requires conflict target")
+		}
+		buf.WriteString(" DO UPDATE SET ")
+		buf.ReadFrom(upsert)
+		if ue.Where != nil {
+			upsert.Dirty = false
+			if err := CategoryCriteriaWhereClause(upsert, ue.Where, 0, r.Table); err != nil {
+				return "", nil, err
+			}
+			if upsert.Dirty {
+				buf.WriteString(" WHERE ")
+				buf.ReadFrom(upsert)
+			}
+		}
+	}
+	buf.WriteString(" RETURNING ")
+	if len(r.Columns) > 0 {
+		buf.WriteString(strings.Join(r.Columns, ", "))
+	} else {
+		buf.WriteString("content, created_at, id, name, parent_id, updated_at")
+	}
+	return buf.String(), upsert.Args(), nil
+}
+
+func (r *CategoryRepositoryBase) upsertMany(ctx context.Context, tx *sql.Tx, ue *CategoryUpsertExpr, es ...*CategoryEntity) ([]*CategoryEntity, error) {
+	query, args, err := r.UpsertManyQuery(ue, es...)
+	if err != nil {
+		return nil, err
+	}
+	var rows *sql.Rows
+	if tx == nil {
+		rows, err = r.DB.QueryContext(ctx, query, args...)
+	} else {
+		rows, err = tx.QueryContext(ctx, query, args...)
+	}
+	if r.Log != nil {
+		if tx == nil {
+			r.Log(err, TableCategory, "upsert many", query, args...)
+		} else {
+			r.Log(err, TableCategory, "upsert many tx", query, args...)
+		}
+	}
+	if err != nil {
+		return nil, wrapError(err)
+	}
+	defer rows.Close()
+
+	var entities []*CategoryEntity
+	for rows.Next() {
+		var ent CategoryEntity
+		props, err := ent.Props(r.Columns...)
+		if err != nil {
+			return nil, err
+		}
+		if err = rows.Scan(props...); err != nil {
+			return nil, err
+		}
+		entities = append(entities, &ent)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, wrapError(err)
+	}
+	return entities, nil
+}
+
+// UpsertMany inserts entities in a single statement, conflicts are resolved as described by the expression.
+// Returned entities are those inserted or updated, rows left untouched by DO NOTHING or Where are omitted.
+func (r *CategoryRepositoryBase) UpsertMany(ctx context.Context, ue *CategoryUpsertExpr, es ...*CategoryEntity) ([]*CategoryEntity, error) {
+	return r.upsertMany(ctx, nil, ue, es...)
+}
+
+// UpsertOne inserts the entity, conflict is resolved as described by the expression.
+// Returned flag is false if the row was neither inserted nor updated.
+func (r *CategoryRepositoryBase) UpsertOne(ctx context.Context, e *CategoryEntity, ue *CategoryUpsertExpr) (*CategoryEntity, bool, error) {
+	return r.upsertOne(ctx, nil, e, ue)
+}
+
+func (r *CategoryRepositoryBase) upsertOne(ctx context.Context, tx *sql.Tx, e *CategoryEntity, ue *CategoryUpsertExpr) (*CategoryEntity, bool, error) {
+	entities, err := r.upsertMany(ctx, tx, ue, e)
+	if err != nil {
+		return nil, false, err
+	}
+	if len(entities) == 0 {
+		return e, false, nil
+	}
+	*e = *entities[0]
+	return e, true, nil
+}
+
+func (r *CategoryRepositoryBase) count(ctx context.Context, tx *sql.Tx, exp *CategoryCountExpr) (int64, error) {
+	query, args, err := r.FindQuery(&CategoryFindExpr{
+		Where:   exp.Where,
+		Columns: []CategoryColumn{categoryColumnCount},
+	})
+	if err != nil {
+		return 0, err
+	}
+	var count int64
+	if tx == nil {
+		err = r.DB.QueryRowContext(ctx, query, args...).Scan(&count)
+	} else {
+		err = tx.QueryRowContext(ctx, query, args...).Scan(&count)
+	}
+	if r.Log != nil {
+		if tx == nil {
+			r.Log(err, TableCategory, "count", query, args...)
+		} else {
+			r.Log(err, TableCategory, "count tx", query, args...)
+		}
+	}
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+func (r *CategoryRepositoryBase) Count(ctx context.Context, exp *CategoryCountExpr) (int64, error) {
+	return r.count(ctx, nil, exp)
+}
+
+func (r *CategoryRepositoryBase) deleteOneByID(ctx context.Context, tx *sql.Tx, pk int64) (int64, error) {
+	find := NewComposer(6)
+	find.WriteString("DELETE FROM ")
+	find.WriteString(r.Table)
+	find.WriteString(" WHERE ")
+	find.WriteString(TableCategoryColumnID)
+	find.WriteString("=")
+	find.WritePlaceholder()
+	find.Add(pk)
+	res, err := exec(ctx, r.DB, tx, r.StmtCache, find.String(), find.Args()...)
+	if err != nil {
+		return 0, wrapError(err)
+	}
+
+	return res.RowsAffected()
+}
+
+func (r *CategoryRepositoryBase) DeleteOneByID(ctx context.Context, pk int64) (int64, error) {
+	return r.deleteOneByID(ctx, nil, pk)
+}
+
+type CategoryRepositoryBaseTx struct {
+	base *CategoryRepositoryBase
+	tx   *sql.Tx
+}
+
+func (r CategoryRepositoryBaseTx) Commit() error {
+	return r.tx.Commit()
+}
+
+func (r CategoryRepositoryBaseTx) Rollback() error {
+	return r.tx.Rollback()
+}
+
+// Savepoint establishes new savepoint within the transaction.
+func (r *CategoryRepositoryBaseTx) Savepoint(ctx context.Context, name string) error {
+	return savepoint(ctx, r.tx, name)
+}
+
+// RollbackTo rolls back all changes made after the savepoint was established, the transaction can be continued.
+func (r *CategoryRepositoryBaseTx) RollbackTo(ctx context.Context, name string) error {
+	return rollbackToSavepoint(ctx, r.tx, name)
+}
+
+// Release destroys the savepoint, changes made after it was established are kept.
+func (r *CategoryRepositoryBaseTx) Release(ctx context.Context, name string) error {
+	return releaseSavepoint(ctx, r.tx, name)
+}
+
+// RunInTransaction runs given function within a savepoint of the transaction, see RunInSavepoint.
+func (r *CategoryRepositoryBaseTx) RunInTransaction(ctx context.Context, fn func(rtx *CategoryRepositoryBaseTx) error) error {
+	return RunInSavepoint(ctx, r.tx, func(*sql.Tx) error {
+		return fn(r)
+	})
+}
+
+func (r *CategoryRepositoryBaseTx) Insert(ctx context.Context, e *CategoryEntity) (*CategoryEntity, error) {
+	return r.base.insert(ctx, r.tx, e)
+}
+
+func (r *CategoryRepositoryBaseTx) Find(ctx context.Context, fe *CategoryFindExpr) ([]*CategoryEntity, error) {
+	return r.base.find(ctx, r.tx, fe)
+}
+
+func (r *CategoryRepositoryBaseTx) FindIter(ctx context.Context, fe *CategoryFindExpr) (*CategoryIterator, error) {
+	return r.base.findIter(ctx, r.tx, fe)
+}
+
+func (r *CategoryRepositoryBaseTx) FindProjection(ctx context.Context, fe *CategoryFindExpr, next func() Projection) error {
+	return r.base.findProjection(ctx, r.tx, fe, next)
+}
+
+func (r *CategoryRepositoryBaseTx) FindOneByID(ctx context.Context, pk int64, lock ...RowLock) (*CategoryEntity, error) {
+	if len(lock) > 1 {
+		return nil, ErrMultipleRowLocks
+	}
+	var rl RowLock
+	if len(lock) > 0 {
+		rl = lock[0]
+	}
+	return r.base.findOneByID(ctx, r.tx, pk, rl)
+}
+
+func (r *CategoryRepositoryBaseTx) History(ctx context.Context, pk int64) ([]*CategoryHistoryEntity, error) {
+	return r.base.history(ctx, r.tx, pk)
+}
+
+func (r *CategoryRepositoryBaseTx) FindOneByIDAsOf(ctx context.Context, pk int64, at time.Time) (*CategoryEntity, error) {
+	return r.base.findOneByIDAsOf(ctx, r.tx, pk, at)
+}
+
+func (r *CategoryRepositoryBaseTx) UpdateOneByID(ctx context.Context, pk int64, p *CategoryPatch) (*CategoryEntity, error) {
+	return r.base.updateOneByID(ctx, r.tx, pk, p)
+}
+
+func (r *CategoryRepositoryBaseTx) Upsert(ctx context.Context, e *CategoryEntity, p *CategoryPatch, inf ...string) (*CategoryEntity, error) {
+	return r.base.upsert(ctx, r.tx, e, p, inf...)
+}
+
+func (r *CategoryRepositoryBaseTx) UpsertMany(ctx context.Context, ue *CategoryUpsertExpr, es ...*CategoryEntity) ([]*CategoryEntity, error) {
+	return r.base.upsertMany(ctx, r.tx, ue, es...)
+}
+
+func (r *CategoryRepositoryBaseTx) UpsertOne(ctx context.Context, e *CategoryEntity, ue *CategoryUpsertExpr) (*CategoryEntity, bool, error) {
+	return r.base.upsertOne(ctx, r.tx, e, ue)
+}
+
+func (r *CategoryRepositoryBaseTx) Count(ctx context.Context, exp *CategoryCountExpr) (int64, error) {
+	return r.base.count(ctx, r.tx, exp)
+}
+
+func (r *CategoryRepositoryBaseTx) DeleteOneByID(ctx context.Context, pk int64) (int64, error) {
+	return r.base.deleteOneByID(ctx, r.tx, pk)
+}
+
+// NewCategoryFixture returns CategoryEntity which mandatory columns are filled with random values, overrides are applied afterwards.
+// If overrides leave a required foreign key unset, parent fixture is inserted and referenced.
+func (f *Fixtures) NewCategoryFixture(ctx context.Context, overrides ...func(*CategoryEntity)) (*CategoryEntity, error) {
+	e := &CategoryEntity{
+		Content: f.string(0, false),
+		Name:    f.string(0, false),
+	}
+	for _, o := range overrides {
+		o(e)
+	}
+	return e, nil
+}
+
+// InsertCategoryFixture works like NewCategoryFixture, but it inserts the fixture as well.
+func (f *Fixtures) InsertCategoryFixture(ctx context.Context, overrides ...func(*CategoryEntity)) (*CategoryEntity, error) {
+	e, err := f.NewCategoryFixture(ctx, overrides...)
+	if err != nil {
+		return nil, err
+	}
+	return (&CategoryRepositoryBase{Table: TableCategory, DB: f.DB}).Insert(ctx, e)
+}
@@ -0,0 +1,1927 @@
+// Code generated by pqt. DO NOT EDIT.
+
+package model
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/lib/pq"
+)
+
+const (
+	TableCommentConstraintNewsTitleForeignKey = "example.comment_news_title_fkey"
+	TableCommentConstraintNewsTitleIndex      = "example.comment_news_title_idx"
+	TableCommentConstraintNewsIDForeignKey    = "example.comment_news_id_fkey"
+)
+
+const (
+	TableComment                 = "example.comment"
+	TableCommentColumnContent    = "content"
+	TableCommentColumnCreatedAt  = "created_at"
+	TableCommentColumnID         = "id"
+	TableCommentColumnIDMultiply = "id_multiply"
+	TableCommentColumnNewsID     = "news_id"
+	TableCommentColumnNewsTitle  = "news_title"
+	TableCommentColumnRightNow   = "right_now"
+	TableCommentColumnUpdatedAt  = "updated_at"
+)
+
+var TableCommentColumns = []string{
+	TableCommentColumnContent,
+	TableCommentColumnCreatedAt,
+	TableCommentColumnID,
+	TableCommentColumnIDMultiply,
+	TableCommentColumnNewsID,
+	TableCommentColumnNewsTitle,
+	TableCommentColumnRightNow,
+	TableCommentColumnUpdatedAt,
+}
+
+// CommentEntity ...
+type CommentEntity struct {
+	// Content ...
+	Content string
+	// CreatedAt ...
+	CreatedAt time.Time
+	// ID ...
+	ID sql.NullInt64
+	// IDMultiply ...
+	// IDMultiply is read only
+	IDMultiply int64
+	// NewsID ...
+	NewsID int64
+	// NewsTitle ...
+	NewsTitle string
+	// RightNow ...
+	// RightNow is read only
+	RightNow time.Time
+	// UpdatedAt ...
+	UpdatedAt pq.NullTime
+	// NewsByTitle ...
+	NewsByTitle *NewsEntity
+	// NewsByID ...
+	NewsByID *NewsEntity
+	// fields holds fields loaded by a projection, see Loaded.
+	fields CommentFields
+}
+
+func (e *CommentEntity) Prop(cn string) (interface{}, bool) {
+	switch cn {
+
+	case TableCommentColumnContent:
+		return &e.Content, true
+	case TableCommentColumnCreatedAt:
+		return &e.CreatedAt, true
+	case TableCommentColumnID:
+		return &e.ID, true
+	case TableCommentColumnIDMultiply:
+		return &e.IDMultiply, true
+	case TableCommentColumnNewsID:
+		return &e.NewsID, true
+	case TableCommentColumnNewsTitle:
+		return &e.NewsTitle, true
+	case TableCommentColumnRightNow:
+		return &e.RightNow, true
+	case TableCommentColumnUpdatedAt:
+		return &e.UpdatedAt, true
+	default:
+		return nil, false
+	}
+}
+
+func (e *CommentEntity) Props(cns ...string) ([]interface{}, error) {
+	projection := len(cns) > 0
+	if !projection {
+		cns = TableCommentColumns
+	}
+	e.fields = CommentFields{}
+	res := make([]interface{}, 0, len(cns))
+	for _, cn := range cns {
+		if prop, ok := e.Prop(cn); ok {
+			res = append(res, prop)
+		} else {
+			return nil, fmt.Errorf("unexpected column provided: %s", cn)
+		}
+		if projection {
+			e.fields.add(cn)
+		}
+	}
+	return res, nil
+}
+
+// CommentFields is a set of CommentEntity fields, identified by their columns.
+type CommentFields [1]uint64
+
+// Has reports whether the set contains field of given column.
+func (f CommentFields) Has(cn string) bool {
+	i := commentFieldIndex(cn)
+	return i >= 0 && f[i/64]&(1<<uint(i%64)) != 0
+}
+
+func (f *CommentFields) add(cn string) {
+	if i := commentFieldIndex(cn); i >= 0 {
+		f[i/64] |= 1 << uint(i%64)
+	}
+}
+
+// commentFieldIndex returns position of the column in TableCommentColumns, or -1 if there is no such column.
+func commentFieldIndex(cn string) int {
+	switch cn {
+	case TableCommentColumnContent:
+		return 0
+	case TableCommentColumnCreatedAt:
+		return 1
+	case TableCommentColumnID:
+		return 2
+	case TableCommentColumnIDMultiply:
+		return 3
+	case TableCommentColumnNewsID:
+		return 4
+	case TableCommentColumnNewsTitle:
+		return 5
+	case TableCommentColumnRightNow:
+		return 6
+	case TableCommentColumnUpdatedAt:
+		return 7
+	}
+	return -1
+}
+
+// Loaded reports whether field of given column was loaded by the query that returned the entity.
+// Entities loaded with all columns, as well as entities built by hand, report every field as loaded.
+func (e *CommentEntity) Loaded(cn string) bool {
+	if e.fields == (CommentFields{}) {
+		return commentFieldIndex(cn) >= 0
+	}
+	return e.fields.Has(cn)
+}
+
+// Validate checks if entity meets NOT NULL, length, precision, enum, array size and simple check constraints of the table.
+// It returns ValidationError that lists all violations, if any.
+func (e *CommentEntity) Validate() error {
+	return nil
+}
+
+// ScanCommentRows helps to scan rows straight to the slice of entities.
+func ScanCommentRows(rows Rows) (entities []*CommentEntity, err error) {
+	for rows.Next() {
+		var ent CommentEntity
+		err = rows.Scan(
+			&ent.Content,
+			&ent.CreatedAt,
+			&ent.ID,
+			&ent.IDMultiply,
+			&ent.NewsID,
+			&ent.NewsTitle,
+			&ent.RightNow,
+			&ent.UpdatedAt,
+		)
+		if err != nil {
+			return
+		}
+
+		entities = append(entities, &ent)
+	}
+	if err = rows.Err(); err != nil {
+		return
+	}
+
+	return
+}
+
+// CommentIterator is not thread safe.
+type CommentIterator struct {
+	rows Rows
+	cols []string
+	expr *CommentFindExpr
+}
+
+func (i *CommentIterator) Next() bool {
+	return i.rows.Next()
+}
+
+func (i *CommentIterator) Close() error {
+	return i.rows.Close()
+}
+
+func (i *CommentIterator) Err() error {
+	return i.rows.Err()
+}
+
+// Columns is wrapper around sql.Rows.Columns method, that also cache output inside iterator.
+func (i *CommentIterator) Columns() ([]string, error) {
+	if i.cols == nil {
+		cols, err := i.rows.Columns()
+		if err != nil {
+			return nil, err
+		}
+		i.cols = cols
+	}
+	return i.cols, nil
+}
+
+// Ent is wrapper around Comment method that makes iterator more generic.
+func (i *CommentIterator) Ent() (interface{}, error) {
+	return i.Comment()
+}
+
+func (i *CommentIterator) Comment() (*CommentEntity, error) {
+	var ent CommentEntity
+	cols, err := i.Columns()
+	if err != nil {
+		return nil, err
+	}
+
+	props, err := ent.Props(cols...)
+	if err != nil {
+		return nil, err
+	}
+	var prop []interface{}
+	if i.expr.JoinNewsByTitle != nil && i.expr.JoinNewsByTitle.Kind.Actionable() && i.expr.JoinNewsByTitle.Fetch {
+		ent.NewsByTitle = &NewsEntity{}
+		if prop, err = ent.NewsByTitle.Props(); err != nil {
+			return nil, err
+		}
+		props = append(props, prop...)
+	}
+	if i.expr.JoinNewsByID != nil && i.expr.JoinNewsByID.Kind.Actionable() && i.expr.JoinNewsByID.Fetch {
+		ent.NewsByID = &NewsEntity{}
+		if prop, err = ent.NewsByID.Props(); err != nil {
+			return nil, err
+		}
+		props = append(props, prop...)
+	}
+	if err := i.rows.Scan(props...); err != nil {
+		return nil, err
+	}
+	return &ent, nil
+}
+
+type CommentCriteria struct {
+	Content                sql.NullString
+	CreatedAt              pq.NullTime
+	ID                     sql.NullInt64
+	IDMultiply             sql.NullInt64
+	NewsID                 sql.NullInt64
+	NewsTitle              sql.NullString
+	RightNow               pq.NullTime
+	UpdatedAt              pq.NullTime
+	operator               string
+	child, sibling, parent *CommentCriteria
+}
+
+func CommentOperand(operator string, operands ...*CommentCriteria) *CommentCriteria {
+	if len(operands) == 0 {
+		return &CommentCriteria{operator: operator}
+	}
+
+	parent := &CommentCriteria{
+		operator: operator,
+		child:    operands[0],
+	}
+
+	for i := 0; i < len(operands); i++ {
+		if i < len(operands)-1 {
+			operands[i].sibling = operands[i+1]
+		}
+		operands[i].parent = parent
+	}
+
+	return parent
+}
+
+func CommentOr(operands ...*CommentCriteria) *CommentCriteria {
+	return CommentOperand("OR", operands...)
+}
+
+func CommentAnd(operands ...*CommentCriteria) *CommentCriteria {
+	return CommentOperand("AND", operands...)
+}
+
+// CommentCriteriaWhereClause writes conditions of the criteria tree into the composer.
+// The id is the alias of the table named table, tables of related rows are resolved relative to its schema.
+func CommentCriteriaWhereClause(comp *Composer, c *CommentCriteria, id int, table string) error {
+	if c.child == nil {
+		return _CommentCriteriaWhereClause(comp, c, id, table)
+	}
+	node := c
+	sibling := false
+	for {
+		if !sibling {
+			if node.child != nil {
+				if node.parent != nil {
+					comp.WriteString("(")
+				}
+				node = node.child
+				continue
+			} else {
+				comp.Dirty = false
+				comp.WriteString("(")
+				if err := _CommentCriteriaWhereClause(comp, node, id, table); err != nil {
+					return err
+				}
+				comp.WriteString(")")
+			}
+		}
+		if node.sibling != nil {
+			sibling = false
+			comp.WriteString(" ")
+			comp.WriteString(node.parent.operator)
+			comp.WriteString(" ")
+			node = node.sibling
+			continue
+		}
+		if node.parent != nil {
+			sibling = true
+			if node.parent.parent != nil {
+				comp.WriteString(")")
+			}
+			node = node.parent
+			continue
+		}
+
+		break
+	}
+	return nil
+}
+
+func _CommentCriteriaWhereClause(comp *Composer, c *CommentCriteria, id int, table string) error {
+	if c.Content.Valid {
+		if comp.Dirty {
+			comp.WriteString(" AND ")
+		}
+		if err := comp.WriteAlias(id); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(TableCommentColumnContent); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString("="); err != nil {
+			return err
+		}
+		if err := comp.WritePlaceholder(); err != nil {
+			return err
+		}
+		comp.Add(c.Content)
+		comp.Dirty = true
+	}
+	if c.CreatedAt.Valid {
+		if comp.Dirty {
+			comp.WriteString(" AND ")
+		}
+		if err := comp.WriteAlias(id); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(TableCommentColumnCreatedAt); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString("="); err != nil {
+			return err
+		}
+		if err := comp.WritePlaceholder(); err != nil {
+			return err
+		}
+		comp.Add(c.CreatedAt)
+		comp.Dirty = true
+	}
+	// id is an empty struct, ignore
+
+	if c.IDMultiply.Valid {
+		if comp.Dirty {
+			comp.WriteString(" AND ")
+		}
+		if _, err := comp.WriteString("multiply"); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString("("); err != nil {
+			return err
+		}
+		if err := comp.WriteAlias(id); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(TableCommentColumnID); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(", "); err != nil {
+			return err
+		}
+		if err := comp.WriteAlias(id); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(TableCommentColumnID); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(")"); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString("="); err != nil {
+			return err
+		}
+		if err := comp.WritePlaceholder(); err != nil {
+			return err
+		}
+		comp.Add(c.IDMultiply)
+		comp.Dirty = true
+	}
+	if c.NewsID.Valid {
+		if comp.Dirty {
+			comp.WriteString(" AND ")
+		}
+		if err := comp.WriteAlias(id); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(TableCommentColumnNewsID); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString("="); err != nil {
+			return err
+		}
+		if err := comp.WritePlaceholder(); err != nil {
+			return err
+		}
+		comp.Add(c.NewsID)
+		comp.Dirty = true
+	}
+	if c.NewsTitle.Valid {
+		if comp.Dirty {
+			comp.WriteString(" AND ")
+		}
+		if err := comp.WriteAlias(id); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(TableCommentColumnNewsTitle); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString("="); err != nil {
+			return err
+		}
+		if err := comp.WritePlaceholder(); err != nil {
+			return err
+		}
+		comp.Add(c.NewsTitle)
+		comp.Dirty = true
+	}
+	if c.RightNow.Valid {
+		if comp.Dirty {
+			comp.WriteString(" AND ")
+		}
+		if _, err := comp.WriteString("now"); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString("("); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(")"); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString("="); err != nil {
+			return err
+		}
+		if err := comp.WritePlaceholder(); err != nil {
+			return err
+		}
+		comp.Add(c.RightNow)
+		comp.Dirty = true
+	}
+	if c.UpdatedAt.Valid {
+		if comp.Dirty {
+			comp.WriteString(" AND ")
+		}
+		if err := comp.WriteAlias(id); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(TableCommentColumnUpdatedAt); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString("="); err != nil {
+			return err
+		}
+		if err := comp.WritePlaceholder(); err != nil {
+			return err
+		}
+		comp.Add(c.UpdatedAt)
+		comp.Dirty = true
+	}
+	return nil
+}
+
+type CommentFindExpr struct {
+	Where           *CommentCriteria
+	Offset, Limit   int64
+	Columns         []CommentColumn
+	OrderBy         []RowOrder
+	Lock            RowLock
+	JoinNewsByTitle *NewsJoin
+	JoinNewsByID    *NewsJoin
+}
+
+// CommentColumn is a column of the comment table, one of TableCommentColumns, that find expression selects.
+type CommentColumn string
+
+// commentColumnCount selects number of rows instead of columns, it is used by count queries.
+const commentColumnCount CommentColumn = "COUNT(*)"
+
+// selectExpr returns select list expression of the column, or an error if the table has no such column.
+func (c CommentColumn) selectExpr() (string, error) {
+	switch c {
+	case commentColumnCount:
+		return string(c), nil
+	case TableCommentColumnContent:
+		return "t0.content", nil
+	case TableCommentColumnCreatedAt:
+		return "t0.created_at", nil
+	case TableCommentColumnID:
+		return "t0.id", nil
+	case TableCommentColumnIDMultiply:
+		return "multiply(t0.id, t0.id) AS id_multiply", nil
+	case TableCommentColumnNewsID:
+		return "t0.news_id", nil
+	case TableCommentColumnNewsTitle:
+		return "t0.news_title", nil
+	case TableCommentColumnRightNow:
+		return "now() AS right_now", nil
+	case TableCommentColumnUpdatedAt:
+		return "t0.updated_at", nil
+	}
+	return "", fmt.Errorf("unexpected column provided: %q", string(c))
+}
+
+func commentColumnNames(cs []CommentColumn) []string {
+	if len(cs) == 0 {
+		return nil
+	}
+	names := make([]string, 0, len(cs))
+	for _, c := range cs {
+		names = append(names, string(c))
+	}
+	return names
+}
+
+type CommentJoin struct {
+	On, Where       *CommentCriteria
+	Fetch           bool
+	Kind            JoinType
+	JoinNewsByTitle *NewsJoin
+	JoinNewsByID    *NewsJoin
+}
+
+type CommentCountExpr struct {
+	Where           *CommentCriteria
+	JoinNewsByTitle *NewsJoin
+	JoinNewsByID    *NewsJoin
+}
+
+type CommentPatch struct {
+	Content    sql.NullString
+	CreatedAt  pq.NullTime
+	ID         sql.NullInt64
+	IDMultiply sql.NullInt64
+	NewsID     sql.NullInt64
+	NewsTitle  sql.NullString
+	RightNow   pq.NullTime
+	UpdatedAt  pq.NullTime
+}
+
+// Validate checks if values set by the patch meet length, precision, enum, array size and simple check constraints of the table.
+// It returns ValidationError that lists all violations, if any.
+func (p *CommentPatch) Validate() error {
+	return nil
+}
+
+type CommentRepositoryBase struct {
+	Table   string
+	Columns []string
+	DB      *sql.DB
+	Log     LogFunc
+	// Session, if set, is applied at the beginning of transactions started by BeginTx and RunInTransaction.
+	// Queries outside of a transaction fail with ErrSessionOutsideTransaction.
+	Session SessionFunc
+	// Validate, if true, makes insert, update and upsert methods validate entities and patches before querying the database.
+	Validate bool
+	// StmtCache, if set, is used to prepare queries of fixed shape (insert, delete and find by key) once and reuse them.
+	StmtCache *StmtCache
+}
+
+func (r *CommentRepositoryBase) Tx(tx *sql.Tx) (*CommentRepositoryBaseTx, error) {
+	return &CommentRepositoryBaseTx{
+		base: r,
+		tx:   tx,
+	}, nil
+}
+
+func (r *CommentRepositoryBase) BeginTx(ctx context.Context, opts ...TxOption) (*CommentRepositoryBaseTx, error) {
+	tx, err := r.DB.BeginTx(ctx, newTxOptions(opts).sql())
+	if err != nil {
+		return nil, err
+	}
+	if r.Session != nil {
+		if err := applySession(ctx, tx, r.Session); err != nil {
+			_ = tx.Rollback()
+			return nil, err
+		}
+	}
+	return r.Tx(tx)
+}
+
+func (r CommentRepositoryBase) RunInTransaction(ctx context.Context, fn func(rtx *CommentRepositoryBaseTx) error, attempts int, opts ...TxOption) (err error) {
+	return RunInTransaction(r.DB, ctx, func(tx *sql.Tx) error {
+		if r.Session != nil {
+			if err := applySession(ctx, tx, r.Session); err != nil {
+				return err
+			}
+		}
+		rtx, err := r.Tx(tx)
+		if err != nil {
+			return err
+		}
+		return fn(rtx)
+	}, attempts, opts...)
+}
+
+var (
+	// ErrCommentConstraintNewsTitleForeignKey is returned if foreign key constraint example.comment_news_title_fkey is violated.
+	ErrCommentConstraintNewsTitleForeignKey = &Constraint{
+		Name:    "example.comment_news_title_fkey",
+		Type:    "foreign key",
+		Table:   "example.comment",
+		Columns: []string{"news_title"},
+	}
+	// ErrCommentConstraintNewsIDForeignKey is returned if foreign key constraint example.comment_news_id_fkey is violated.
+	ErrCommentConstraintNewsIDForeignKey = &Constraint{
+		Name:    "example.comment_news_id_fkey",
+		Type:    "foreign key",
+		Table:   "example.comment",
+		Columns: []string{"news_id"},
+	}
+)
+
+func (r *CommentRepositoryBase) InsertQuery(e *CommentEntity, read bool) (string, []interface{}, error) {
+	if r.Validate {
+		if err := e.Validate(); err != nil {
+			return "", nil, err
+		}
+	}
+	insert := NewComposer(8)
+	columns := bytes.NewBuffer(nil)
+	buf := bytes.NewBufferString("INSERT INTO ")
+	buf.WriteString(r.Table)
+
+	if columns.Len() > 0 {
+		if _, err := columns.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+	}
+	if _, err := columns.WriteString(TableCommentColumnContent); err != nil {
+		return "", nil, err
+	}
+	if insert.Dirty {
+		if _, err := insert.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+	}
+	if err := insert.WritePlaceholder(); err != nil {
+		return "", nil, err
+	}
+	insert.Add(e.Content)
+	insert.Dirty = true
+
+	if !e.CreatedAt.IsZero() {
+		if columns.Len() > 0 {
+			if _, err := columns.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := columns.WriteString(TableCommentColumnCreatedAt); err != nil {
+			return "", nil, err
+		}
+		if insert.Dirty {
+			if _, err := insert.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if err := insert.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		insert.Add(e.CreatedAt)
+		insert.Dirty = true
+	}
+
+	if columns.Len() > 0 {
+		if _, err := columns.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+	}
+	if _, err := columns.WriteString(TableCommentColumnNewsID); err != nil {
+		return "", nil, err
+	}
+	if insert.Dirty {
+		if _, err := insert.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+	}
+	if err := insert.WritePlaceholder(); err != nil {
+		return "", nil, err
+	}
+	insert.Add(e.NewsID)
+	insert.Dirty = true
+
+	if columns.Len() > 0 {
+		if _, err := columns.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+	}
+	if _, err := columns.WriteString(TableCommentColumnNewsTitle); err != nil {
+		return "", nil, err
+	}
+	if insert.Dirty {
+		if _, err := insert.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+	}
+	if err := insert.WritePlaceholder(); err != nil {
+		return "", nil, err
+	}
+	insert.Add(e.NewsTitle)
+	insert.Dirty = true
+
+	if e.UpdatedAt.Valid {
+		if columns.Len() > 0 {
+			if _, err := columns.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := columns.WriteString(TableCommentColumnUpdatedAt); err != nil {
+			return "", nil, err
+		}
+		if insert.Dirty {
+			if _, err := insert.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if err := insert.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		insert.Add(e.UpdatedAt)
+		insert.Dirty = true
+	}
+
+	if columns.Len() > 0 {
+		buf.WriteString(" (")
+		buf.ReadFrom(columns)
+		buf.WriteString(") VALUES (")
+		buf.ReadFrom(insert)
+		buf.WriteString(") ")
+		if read {
+			buf.WriteString("RETURNING ")
+			if len(r.Columns) > 0 {
+				buf.WriteString(strings.Join(r.Columns, ", "))
+			} else {
+				buf.WriteString("content, created_at, id, multiply(id, id) AS id_multiply, news_id, news_title, now() AS right_now, updated_at")
+			}
+		}
+	}
+	return buf.String(), insert.Args(), nil
+}
+
+func (r *CommentRepositoryBase) insert(ctx context.Context, tx *sql.Tx, e *CommentEntity) (*CommentEntity, error) {
+	if tx == nil && r.Session != nil {
+		return nil, ErrSessionOutsideTransaction
+	}
+	query, args, err := r.InsertQuery(e, true)
+	if err != nil {
+		return nil, err
+	}
+
+	err = queryRow(ctx, r.DB, tx, r.StmtCache, query, args,
+		&e.Content,
+		&e.CreatedAt,
+		&e.ID,
+		&e.IDMultiply,
+		&e.NewsID,
+		&e.NewsTitle,
+		&e.RightNow,
+		&e.UpdatedAt,
+	)
+	if r.Log != nil {
+		if tx == nil {
+			r.Log(err, TableComment, "insert", query, args...)
+		} else {
+			r.Log(err, TableComment, "insert tx", query, args...)
+		}
+	}
+	if err != nil {
+		return nil, wrapError(err)
+	}
+	return e, nil
+}
+
+func (r *CommentRepositoryBase) Insert(ctx context.Context, e *CommentEntity) (*CommentEntity, error) {
+	return r.insert(ctx, nil, e)
+}
+
+func (r *CommentRepositoryBase) FindQuery(fe *CommentFindExpr) (string, []interface{}, error) {
+	comp := NewComposer(8)
+	buf := bytes.NewBufferString("SELECT ")
+	if len(fe.Columns) == 0 {
+		buf.WriteString("t0.content, t0.created_at, t0.id, multiply(t0.id, t0.id) AS id_multiply, t0.news_id, t0.news_title, now() AS right_now, t0.updated_at")
+	} else {
+		for i, c := range fe.Columns {
+			if i > 0 {
+				buf.WriteString(", ")
+			}
+			expr, err := c.selectExpr()
+			if err != nil {
+				return "", nil, err
+			}
+			buf.WriteString(expr)
+		}
+	}
+	if fe.JoinNewsByTitle != nil && fe.JoinNewsByTitle.Kind.Actionable() && fe.JoinNewsByTitle.Fetch {
+		buf.WriteString(", t1.content, t1.continue, t1.created_at, t1.day, t1.document, t1.id, t1.lead, t1.meta_data, t1.score, t1.title, t1.updated_at, t1.version, t1.views_distribution")
+	}
+	if fe.JoinNewsByID != nil && fe.JoinNewsByID.Kind.Actionable() && fe.JoinNewsByID.Fetch {
+		buf.WriteString(", t2.content, t2.continue, t2.created_at, t2.day, t2.document, t2.id, t2.lead, t2.meta_data, t2.score, t2.title, t2.updated_at, t2.version, t2.views_distribution")
+	}
+	buf.WriteString(" FROM ")
+	buf.WriteString(r.Table)
+	buf.WriteString(" AS t0")
+	if fe.JoinNewsByTitle != nil && fe.JoinNewsByTitle.Kind.Actionable() {
+		joinClause(comp, fe.JoinNewsByTitle.Kind, inSchemaOf(TableNews, r.Table)+" AS t1 ON t0.news_title=t1.title")
+		if fe.JoinNewsByTitle.On != nil {
+			comp.Dirty = true
+			if err := NewsCriteriaWhereClause(comp, fe.JoinNewsByTitle.On, 1, inSchemaOf(TableNews, r.Table)); err != nil {
+				return "", nil, err
+			}
+		}
+	}
+	if fe.JoinNewsByID != nil && fe.JoinNewsByID.Kind.Actionable() {
+		joinClause(comp, fe.JoinNewsByID.Kind, inSchemaOf(TableNews, r.Table)+" AS t2 ON t0.news_id=t2.id")
+		if fe.JoinNewsByID.On != nil {
+			comp.Dirty = true
+			if err := NewsCriteriaWhereClause(comp, fe.JoinNewsByID.On, 2, inSchemaOf(TableNews, r.Table)); err != nil {
+				return "", nil, err
+			}
+		}
+	}
+	if comp.Dirty {
+		buf.ReadFrom(comp)
+		comp.Dirty = false
+	}
+	if fe.Where != nil {
+		if err := CommentCriteriaWhereClause(comp, fe.Where, 0, r.Table); err != nil {
+			return "", nil, err
+		}
+	}
+	if fe.JoinNewsByTitle != nil && fe.JoinNewsByTitle.Kind.Actionable() && fe.JoinNewsByTitle.Where != nil {
+		if err := NewsCriteriaWhereClause(comp, fe.JoinNewsByTitle.Where, 1, inSchemaOf(TableNews, r.Table)); err != nil {
+			return "", nil, err
+		}
+	}
+	if fe.JoinNewsByID != nil && fe.JoinNewsByID.Kind.Actionable() && fe.JoinNewsByID.Where != nil {
+		if err := NewsCriteriaWhereClause(comp, fe.JoinNewsByID.Where, 2, inSchemaOf(TableNews, r.Table)); err != nil {
+			return "", nil, err
+		}
+	}
+	if comp.Dirty {
+		if _, err := buf.WriteString(" WHERE "); err != nil {
+			return "", nil, err
+		}
+		buf.ReadFrom(comp)
+	}
+
+	if len(fe.OrderBy) > 0 {
+		i := 0
+		for _, order := range fe.OrderBy {
+			for _, columnName := range TableCommentColumns {
+				if order.Name == columnName {
+					if i == 0 {
+						comp.WriteString(" ORDER BY ")
+					}
+					if i > 0 {
+						if _, err := comp.WriteString(", "); err != nil {
+							return "", nil, err
+						}
+					}
+					if _, err := comp.WriteString(order.Name); err != nil {
+						return "", nil, err
+					}
+					if order.Descending {
+						if _, err := comp.WriteString(" DESC"); err != nil {
+							return "", nil, err
+						}
+					}
+					i++
+					break
+				}
+			}
+		}
+	}
+	if fe.Offset > 0 {
+		if _, err := comp.WriteString(" OFFSET "); err != nil {
+			return "", nil, err
+		}
+		if err := comp.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		if _, err := comp.WriteString(" "); err != nil {
+			return "", nil, err
+		}
+		comp.Add(fe.Offset)
+	}
+	if fe.Limit > 0 {
+		if _, err := comp.WriteString(" LIMIT "); err != nil {
+			return "", nil, err
+		}
+		if err := comp.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		if _, err := comp.WriteString(" "); err != nil {
+			return "", nil, err
+		}
+		comp.Add(fe.Limit)
+	}
+	if fe.Lock.Actionable() {
+		var of string
+		if fe.JoinNewsByTitle != nil && fe.JoinNewsByTitle.Kind.Actionable() {
+			of = "t0"
+		}
+		if fe.JoinNewsByID != nil && fe.JoinNewsByID.Kind.Actionable() {
+			of = "t0"
+		}
+		if err := lockClause(comp, fe.Lock, of); err != nil {
+			return "", nil, err
+		}
+	}
+
+	buf.ReadFrom(comp)
+
+	return buf.String(), comp.Args(), nil
+}
+
+func (r *CommentRepositoryBase) find(ctx context.Context, tx *sql.Tx, fe *CommentFindExpr) ([]*CommentEntity, error) {
+	if tx == nil && r.Session != nil {
+		return nil, ErrSessionOutsideTransaction
+	}
+	if tx == nil && fe.Lock.Actionable() {
+		return nil, ErrRowLockOutsideTransaction
+	}
+	query, args, err := r.FindQuery(fe)
+	if err != nil {
+		return nil, err
+	}
+	var rows *sql.Rows
+	if tx == nil {
+		rows, err = r.DB.QueryContext(ctx, query, args...)
+	} else {
+		rows, err = tx.QueryContext(ctx, query, args...)
+	}
+	if r.Log != nil {
+		if tx == nil {
+			r.Log(err, TableComment, "find", query, args...)
+		} else {
+			r.Log(err, TableComment, "find tx", query, args...)
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var (
+		entities []*CommentEntity
+		props    []interface{}
+	)
+	cols := commentColumnNames(fe.Columns)
+	for rows.Next() {
+		var ent CommentEntity
+		if props, err = ent.Props(cols...); err != nil {
+			return nil, err
+		}
+		var prop []interface{}
+		if fe.JoinNewsByTitle != nil && fe.JoinNewsByTitle.Kind.Actionable() && fe.JoinNewsByTitle.Fetch {
+			ent.NewsByTitle = &NewsEntity{}
+			if prop, err = ent.NewsByTitle.Props(); err != nil {
+				return nil, err
+			}
+			props = append(props, prop...)
+		}
+		if fe.JoinNewsByID != nil && fe.JoinNewsByID.Kind.Actionable() && fe.JoinNewsByID.Fetch {
+			ent.NewsByID = &NewsEntity{}
+			if prop, err = ent.NewsByID.Props(); err != nil {
+				return nil, err
+			}
+			props = append(props, prop...)
+		}
+		err = rows.Scan(props...)
+		if err != nil {
+			return nil, err
+		}
+
+		entities = append(entities, &ent)
+	}
+	err = rows.Err()
+	if r.Log != nil {
+		r.Log(err, TableComment, "find", query, args...)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return entities, nil
+}
+
+func (r *CommentRepositoryBase) Find(ctx context.Context, fe *CommentFindExpr) ([]*CommentEntity, error) {
+	return r.find(ctx, nil, fe)
+}
+
+func (r *CommentRepositoryBase) findIter(ctx context.Context, tx *sql.Tx, fe *CommentFindExpr) (*CommentIterator, error) {
+	if tx == nil && r.Session != nil {
+		return nil, ErrSessionOutsideTransaction
+	}
+	if tx == nil && fe.Lock.Actionable() {
+		return nil, ErrRowLockOutsideTransaction
+	}
+	query, args, err := r.FindQuery(fe)
+	if err != nil {
+		return nil, err
+	}
+	var rows *sql.Rows
+	if tx == nil {
+		rows, err = r.DB.QueryContext(ctx, query, args...)
+	} else {
+		rows, err = tx.QueryContext(ctx, query, args...)
+	}
+	if r.Log != nil {
+		if tx == nil {
+			r.Log(err, TableComment, "find iter", query, args...)
+		} else {
+			r.Log(err, TableComment, "find iter tx", query, args...)
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &CommentIterator{
+		rows: rows,
+		expr: fe,
+		cols: commentColumnNames(fe.Columns),
+	}, nil
+}
+
+func (r *CommentRepositoryBase) FindIter(ctx context.Context, fe *CommentFindExpr) (*CommentIterator, error) {
+	return r.findIter(ctx, nil, fe)
+}
+
+func (r *CommentRepositoryBase) findProjection(ctx context.Context, tx *sql.Tx, fe *CommentFindExpr, next func() Projection) error {
+	if tx == nil && r.Session != nil {
+		return ErrSessionOutsideTransaction
+	}
+	if tx == nil && fe.Lock.Actionable() {
+		return ErrRowLockOutsideTransaction
+	}
+	query, args, err := r.FindQuery(fe)
+	if err != nil {
+		return err
+	}
+	var rows *sql.Rows
+	if tx == nil {
+		rows, err = r.DB.QueryContext(ctx, query, args...)
+	} else {
+		rows, err = tx.QueryContext(ctx, query, args...)
+	}
+	if r.Log != nil {
+		if tx == nil {
+			r.Log(err, TableComment, "find projection", query, args...)
+		} else {
+			r.Log(err, TableComment, "find projection tx", query, args...)
+		}
+	}
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	cols, err := rows.Columns()
+	if err != nil {
+		return err
+	}
+	for rows.Next() {
+		props, err := next().Props(cols...)
+		if err != nil {
+			return err
+		}
+		if err := rows.Scan(props...); err != nil {
+			return err
+		}
+	}
+	return rows.Err()
+}
+
+// FindProjection works like Find, but scans each row into the projection returned by next.
+// Selected columns, see CommentFindExpr.Columns, are passed to its Props method.
+func (r *CommentRepositoryBase) FindProjection(ctx context.Context, fe *CommentFindExpr, next func() Projection) error {
+	return r.findProjection(ctx, nil, fe, next)
+}
+
+func (r *CommentRepositoryBase) UpsertQuery(e *CommentEntity, p *CommentPatch, inf ...string) (string, []interface{}, error) {
+	if r.Validate {
+		if err := e.Validate(); err != nil {
+			return "", nil, err
+		}
+		if p != nil {
+			if err := p.Validate(); err != nil {
+				return "", nil, err
+			}
+		}
+	}
+	upsert := NewComposer(16)
+	columns := bytes.NewBuffer(nil)
+	buf := bytes.NewBufferString("INSERT INTO ")
+	buf.WriteString(r.Table)
+
+	if columns.Len() > 0 {
+		if _, err := columns.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+	}
+	if _, err := columns.WriteString(TableCommentColumnContent); err != nil {
+		return "", nil, err
+	}
+	if upsert.Dirty {
+		if _, err := upsert.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+	}
+	if err := upsert.WritePlaceholder(); err != nil {
+		return "", nil, err
+	}
+	upsert.Add(e.Content)
+	upsert.Dirty = true
+
+	if !e.CreatedAt.IsZero() {
+		if columns.Len() > 0 {
+			if _, err := columns.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := columns.WriteString(TableCommentColumnCreatedAt); err != nil {
+			return "", nil, err
+		}
+		if upsert.Dirty {
+			if _, err := upsert.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if err := upsert.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		upsert.Add(e.CreatedAt)
+		upsert.Dirty = true
+	}
+
+	if columns.Len() > 0 {
+		if _, err := columns.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+	}
+	if _, err := columns.WriteString(TableCommentColumnNewsID); err != nil {
+		return "", nil, err
+	}
+	if upsert.Dirty {
+		if _, err := upsert.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+	}
+	if err := upsert.WritePlaceholder(); err != nil {
+		return "", nil, err
+	}
+	upsert.Add(e.NewsID)
+	upsert.Dirty = true
+
+	if columns.Len() > 0 {
+		if _, err := columns.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+	}
+	if _, err := columns.WriteString(TableCommentColumnNewsTitle); err != nil {
+		return "", nil, err
+	}
+	if upsert.Dirty {
+		if _, err := upsert.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+	}
+	if err := upsert.WritePlaceholder(); err != nil {
+		return "", nil, err
+	}
+	upsert.Add(e.NewsTitle)
+	upsert.Dirty = true
+
+	if e.UpdatedAt.Valid {
+		if columns.Len() > 0 {
+			if _, err := columns.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := columns.WriteString(TableCommentColumnUpdatedAt); err != nil {
+			return "", nil, err
+		}
+		if upsert.Dirty {
+			if _, err := upsert.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if err := upsert.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		upsert.Add(e.UpdatedAt)
+		upsert.Dirty = true
+	}
+
+	if upsert.Dirty {
+		buf.WriteString(" (")
+		buf.ReadFrom(columns)
+		buf.WriteString(") VALUES (")
+		buf.ReadFrom(upsert)
+		buf.WriteString(")")
+	}
+	buf.WriteString(" ON CONFLICT ")
+	if len(inf) > 0 {
+		upsert.Dirty = false
+		if p.Content.Valid {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TableCommentColumnContent); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("="); err != nil {
+				return "", nil, err
+			}
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(p.Content)
+			upsert.Dirty = true
+
+		}
+		if p.CreatedAt.Valid {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TableCommentColumnCreatedAt); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("="); err != nil {
+				return "", nil, err
+			}
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(p.CreatedAt)
+			upsert.Dirty = true
+
+		}
+		if p.ID.Valid {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TableCommentColumnID); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("="); err != nil {
+				return "", nil, err
+			}
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(p.ID)
+			upsert.Dirty = true
+
+		}
+		if p.NewsID.Valid {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TableCommentColumnNewsID); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("="); err != nil {
+				return "", nil, err
+			}
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(p.NewsID)
+			upsert.Dirty = true
+
+		}
+		if p.NewsTitle.Valid {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TableCommentColumnNewsTitle); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("="); err != nil {
+				return "", nil, err
+			}
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(p.NewsTitle)
+			upsert.Dirty = true
+
+		}
+		if p.UpdatedAt.Valid {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TableCommentColumnUpdatedAt); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("="); err != nil {
+				return "", nil, err
+			}
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(p.UpdatedAt)
+			upsert.Dirty = true
+
+		} else {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TableCommentColumnUpdatedAt); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("=NOW()"); err != nil {
+				return "", nil, err
+			}
+			upsert.Dirty = true
+		}
+	}
+	if len(inf) > 0 && upsert.Dirty {
+		buf.WriteString("(")
+		for j, i := range inf {
+			if j != 0 {
+				buf.WriteString(", ")
+			}
+			buf.WriteString(i)
+		}
+		buf.WriteString(")")
+		buf.WriteString(" DO UPDATE SET ")
+		buf.ReadFrom(upsert)
+	} else {
+		buf.WriteString(" DO NOTHING ")
+	}
+	if upsert.Dirty {
+		buf.WriteString(" RETURNING ")
+		if len(r.Columns) > 0 {
+			buf.WriteString(strings.Join(r.Columns, ", "))
+		} else {
+			buf.WriteString("content, created_at, id, multiply(id, id) AS id_multiply, news_id, news_title, now() AS right_now, updated_at")
+		}
+	}
+	return buf.String(), upsert.Args(), nil
+}
+
+func (r *CommentRepositoryBase) upsert(ctx context.Context, tx *sql.Tx, e *CommentEntity, p *CommentPatch, inf ...string) (*CommentEntity, error) {
+	if tx == nil && r.Session != nil {
+		return nil, ErrSessionOutsideTransaction
+	}
+	query, args, err := r.UpsertQuery(e, p, inf...)
+	if err != nil {
+		return nil, err
+	}
+
+	var row *sql.Row
+	if tx == nil {
+		row = r.DB.QueryRowContext(ctx, query, args...)
+	} else {
+		row = tx.QueryRowContext(ctx, query, args...)
+	}
+	err = row.Scan(
+		&e.Content,
+		&e.CreatedAt,
+		&e.ID,
+		&e.IDMultiply,
+		&e.NewsID,
+		&e.NewsTitle,
+		&e.RightNow,
+		&e.UpdatedAt,
+	)
+	if r.Log != nil {
+		if tx == nil {
+			r.Log(err, TableComment, "upsert", query, args...)
+		} else {
+			r.Log(err, TableComment, "upsert tx", query, args...)
+		}
+	}
+	if err != nil {
+		return nil, wrapError(err)
+	}
+	return e, nil
+}
+
+func (r *CommentRepositoryBase) Upsert(ctx context.Context, e *CommentEntity, p *CommentPatch, inf ...string) (*CommentEntity, error) {
+	return r.upsert(ctx, nil, e, p, inf...)
+}
+
+// CommentConflictTarget is a conflict target of comment upsert, only CommentConflict* variables can be used.
+type CommentConflictTarget struct {
+	constraint string
+	columns    []string
+	where      string
+}
+
+func (ct CommentConflictTarget) write(buf *bytes.Buffer) {
+	switch {
+	case ct.constraint != "":
+		buf.WriteString(" ON CONSTRAINT \"")
+		buf.WriteString(ct.constraint)
+		buf.WriteString("\"")
+	case len(ct.columns) > 0:
+		buf.WriteString(" (")
+		buf.WriteString(strings.Join(ct.columns, ", "))
+		buf.WriteString(")")
+		if ct.where != "" {
+			buf.WriteString(" WHERE ")
+			buf.WriteString(ct.where)
+		}
+	}
+}
+
+// CommentUpsertExpr describes how conflicts are resolved by UpsertOne and UpsertMany.
+type CommentUpsertExpr struct {
+	// Target is required if Patch is set, DO NOTHING can be used without it.
+	Target CommentConflictTarget
+	// Patch is applied to the conflicting row (DO UPDATE), if nil or empty the row is left untouched (DO NOTHING).
+	Patch *CommentPatch
+	// Where limits DO UPDATE to the conflicting rows that match the criteria, other rows are left untouched.
+	Where *CommentCriteria
+}
+
+func (r *CommentRepositoryBase) UpsertManyQuery(ue *CommentUpsertExpr, es ...*CommentEntity) (string, []interface{}, error) {
+	if len(es) == 0 {
+		return "", nil, errors.New("upsert requires at least one entity")
+	}
+	if ue == nil {
+		ue = &CommentUpsertExpr{}
+	}
+	if r.Validate {
+		for _, e := range es {
+			if err := e.Validate(); err != nil {
+				return "", nil, err
+			}
+		}
+		if ue.Patch != nil {
+			if err := ue.Patch.Validate(); err != nil {
+				return "", nil, err
+			}
+		}
+	}
+	upsert := NewComposer(5)
+	buf := bytes.NewBufferString("INSERT INTO ")
+	buf.WriteString(r.Table)
+	buf.WriteString(" AS t0 (")
+	buf.WriteString(TableCommentColumnContent + ", " + TableCommentColumnCreatedAt + ", " + TableCommentColumnNewsID + ", " + TableCommentColumnNewsTitle + ", " + TableCommentColumnUpdatedAt)
+	buf.WriteString(") VALUES ")
+	for i, e := range es {
+		if i != 0 {
+			if _, err := upsert.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := upsert.WriteString("("); err != nil {
+			return "", nil, err
+		}
+		if err := upsert.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		upsert.Add(e.Content)
+		if _, err := upsert.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+		if !e.CreatedAt.IsZero() {
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(e.CreatedAt)
+		} else if _, err := upsert.WriteString("DEFAULT"); err != nil {
+			return "", nil, err
+		}
+		if _, err := upsert.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+		if err := upsert.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		upsert.Add(e.NewsID)
+		if _, err := upsert.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+		if err := upsert.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		upsert.Add(e.NewsTitle)
+		if _, err := upsert.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+		if e.UpdatedAt.Valid {
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(e.UpdatedAt)
+		} else if _, err := upsert.WriteString("DEFAULT"); err != nil {
+			return "", nil, err
+		}
+		if _, err := upsert.WriteString(")"); err != nil {
+			return "", nil, err
+		}
+	}
+	buf.ReadFrom(upsert)
+	buf.WriteString(" ON CONFLICT")
+	ue.Target.write(buf)
+
+	upsert.Dirty = false
+	if p := ue.Patch; p != nil {
+		if p.Content.Valid {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TableCommentColumnContent); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("="); err != nil {
+				return "", nil, err
+			}
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(p.Content)
+			upsert.Dirty = true
+
+		}
+		if p.CreatedAt.Valid {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TableCommentColumnCreatedAt); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("="); err != nil {
+				return "", nil, err
+			}
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(p.CreatedAt)
+			upsert.Dirty = true
+
+		}
+		if p.ID.Valid {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TableCommentColumnID); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("="); err != nil {
+				return "", nil, err
+			}
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(p.ID)
+			upsert.Dirty = true
+
+		}
+		if p.NewsID.Valid {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TableCommentColumnNewsID); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("="); err != nil {
+				return "", nil, err
+			}
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(p.NewsID)
+			upsert.Dirty = true
+
+		}
+		if p.NewsTitle.Valid {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TableCommentColumnNewsTitle); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("="); err != nil {
+				return "", nil, err
+			}
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(p.NewsTitle)
+			upsert.Dirty = true
+
+		}
+		if p.UpdatedAt.Valid {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TableCommentColumnUpdatedAt); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("="); err != nil {
+				return "", nil, err
+			}
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(p.UpdatedAt)
+			upsert.Dirty = true
+
+		} else {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TableCommentColumnUpdatedAt); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("=NOW()"); err != nil {
+				return "", nil, err
+			}
+			upsert.Dirty = true
+		}
+	}
+	if !upsert.Dirty {
+		buf.WriteString(" DO NOTHING")
+	} else {
+		if ue.Target.constraint == "" && len(ue.Target.columns) == 0 {
+			return "", nil, errors.New("upsert with patch requires conflict target")
+		}
+		buf.WriteString(" DO UPDATE SET ")
+		buf.ReadFrom(upsert)
+		if ue.Where != nil {
+			upsert.Dirty = false
+			if err := CommentCriteriaWhereClause(upsert, ue.Where, 0, r.Table); err != nil {
+				return "", nil, err
+			}
+			if upsert.Dirty {
+				buf.WriteString(" WHERE ")
+				buf.ReadFrom(upsert)
+			}
+		}
+	}
+	buf.WriteString(" RETURNING ")
+	if len(r.Columns) > 0 {
+		buf.WriteString(strings.Join(r.Columns, ", "))
+	} else {
+		buf.WriteString("content, created_at, id, multiply(id, id) AS id_multiply, news_id, news_title, now() AS right_now, updated_at")
+	}
+	return buf.String(), upsert.Args(), nil
+}
+
+func (r *CommentRepositoryBase) upsertMany(ctx context.Context, tx *sql.Tx, ue *CommentUpsertExpr, es ...*CommentEntity) ([]*CommentEntity, error) {
+	if tx == nil && r.Session != nil {
+		return nil, ErrSessionOutsideTransaction
+	}
+	query, args, err := r.UpsertManyQuery(ue, es...)
+	if err != nil {
+		return nil, err
+	}
+	var rows *sql.Rows
+	if tx == nil {
+		rows, err = r.DB.QueryContext(ctx, query, args...)
+	} else {
+		rows, err = tx.QueryContext(ctx, query, args...)
+	}
+	if r.Log != nil {
+		if tx == nil {
+			r.Log(err, TableComment, "upsert many", query, args...)
+		} else {
+			r.Log(err, TableComment, "upsert many tx", query, args...)
+		}
+	}
+	if err != nil {
+		return nil, wrapError(err)
+	}
+	defer rows.Close()
+
+	var entities []*CommentEntity
+	for rows.Next() {
+		var ent CommentEntity
+		props, err := ent.Props(r.Columns...)
+		if err != nil {
+			return nil, err
+		}
+		if err = rows.Scan(props...); err != nil {
+			return nil, err
+		}
+		entities = append(entities, &ent)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, wrapError(err)
+	}
+	return entities, nil
+}
+
+// UpsertMany inserts entities in a single statement, conflicts are resolved as described by the expression.
+// Returned entities are those inserted or updated, rows left untouched by DO NOTHING or Where are omitted.
+func (r *CommentRepositoryBase) UpsertMany(ctx context.Context, ue *CommentUpsertExpr, es ...*CommentEntity) ([]*CommentEntity, error) {
+	return r.upsertMany(ctx, nil, ue, es...)
+}
+
+// UpsertOne inserts the entity, conflict is resolved as described by the expression.
+// Returned flag is false if the row was neither inserted nor updated.
+func (r *CommentRepositoryBase) UpsertOne(ctx context.Context, e *CommentEntity, ue *CommentUpsertExpr) (*CommentEntity, bool, error) {
+	return r.upsertOne(ctx, nil, e, ue)
+}
+
+func (r *CommentRepositoryBase) upsertOne(ctx context.Context, tx *sql.Tx, e *CommentEntity, ue *CommentUpsertExpr) (*CommentEntity, bool, error) {
+	entities, err := r.upsertMany(ctx, tx, ue, e)
+	if err != nil {
+		return nil, false, err
+	}
+	if len(entities) == 0 {
+		return e, false, nil
+	}
+	*e = *entities[0]
+	return e, true, nil
+}
+
+func (r *CommentRepositoryBase) count(ctx context.Context, tx *sql.Tx, exp *CommentCountExpr) (int64, error) {
+	if tx == nil && r.Session != nil {
+		return 0, ErrSessionOutsideTransaction
+	}
+	query, args, err := r.FindQuery(&CommentFindExpr{
+		Where:   exp.Where,
+		Columns: []CommentColumn{commentColumnCount},
+
+		JoinNewsByTitle: exp.JoinNewsByTitle,
+		JoinNewsByID:    exp.JoinNewsByID,
+	})
+	if err != nil {
+		return 0, err
+	}
+	var count int64
+	if tx == nil {
+		err = r.DB.QueryRowContext(ctx, query, args...).Scan(&count)
+	} else {
+		err = tx.QueryRowContext(ctx, query, args...).Scan(&count)
+	}
+	if r.Log != nil {
+		if tx == nil {
+			r.Log(err, TableComment, "count", query, args...)
+		} else {
+			r.Log(err, TableComment, "count tx", query, args...)
+		}
+	}
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+func (r *CommentRepositoryBase) Count(ctx context.Context, exp *CommentCountExpr) (int64, error) {
+	return r.count(ctx, nil, exp)
+}
+
+type CommentRepositoryBaseTx struct {
+	base *CommentRepositoryBase
+	tx   *sql.Tx
+}
+
+func (r CommentRepositoryBaseTx) Commit() error {
+	return r.tx.Commit()
+}
+
+func (r CommentRepositoryBaseTx) Rollback() error {
+	return r.tx.Rollback()
+}
+
+// Savepoint establishes new savepoint within the transaction.
+func (r *CommentRepositoryBaseTx) Savepoint(ctx context.Context, name string) error {
+	return savepoint(ctx, r.tx, name)
+}
+
+// RollbackTo rolls back all changes made after the savepoint was established, the transaction can be continued.
+func (r *CommentRepositoryBaseTx) RollbackTo(ctx context.Context, name string) error {
+	return rollbackToSavepoint(ctx, r.tx, name)
+}
+
+// Release destroys the savepoint, changes made after it was established are kept.
+func (r *CommentRepositoryBaseTx) Release(ctx context.Context, name string) error {
+	return releaseSavepoint(ctx, r.tx, name)
+}
+
+// RunInTransaction runs given function within a savepoint of the transaction, see RunInSavepoint.
+func (r *CommentRepositoryBaseTx) RunInTransaction(ctx context.Context, fn func(rtx *CommentRepositoryBaseTx) error) error {
+	return RunInSavepoint(ctx, r.tx, func(*sql.Tx) error {
+		return fn(r)
+	})
+}
+
+func (r *CommentRepositoryBaseTx) Insert(ctx context.Context, e *CommentEntity) (*CommentEntity, error) {
+	return r.base.insert(ctx, r.tx, e)
+}
+
+func (r *CommentRepositoryBaseTx) Find(ctx context.Context, fe *CommentFindExpr) ([]*CommentEntity, error) {
+	return r.base.find(ctx, r.tx, fe)
+}
+
+func (r *CommentRepositoryBaseTx) FindIter(ctx context.Context, fe *CommentFindExpr) (*CommentIterator, error) {
+	return r.base.findIter(ctx, r.tx, fe)
+}
+
+func (r *CommentRepositoryBaseTx) FindProjection(ctx context.Context, fe *CommentFindExpr, next func() Projection) error {
+	return r.base.findProjection(ctx, r.tx, fe, next)
+}
+
+func (r *CommentRepositoryBaseTx) Upsert(ctx context.Context, e *CommentEntity, p *CommentPatch, inf ...string) (*CommentEntity, error) {
+	return r.base.upsert(ctx, r.tx, e, p, inf...)
+}
+
+func (r *CommentRepositoryBaseTx) UpsertMany(ctx context.Context, ue *CommentUpsertExpr, es ...*CommentEntity) ([]*CommentEntity, error) {
+	return r.base.upsertMany(ctx, r.tx, ue, es...)
+}
+
+func (r *CommentRepositoryBaseTx) UpsertOne(ctx context.Context, e *CommentEntity, ue *CommentUpsertExpr) (*CommentEntity, bool, error) {
+	return r.base.upsertOne(ctx, r.tx, e, ue)
+}
+
+func (r *CommentRepositoryBaseTx) Count(ctx context.Context, exp *CommentCountExpr) (int64, error) {
+	return r.base.count(ctx, r.tx, exp)
+}
+
+// NewCommentFixture returns CommentEntity which mandatory columns are filled with random values, overrides are applied afterwards.
+// If overrides leave a required foreign key unset, parent fixture is inserted and referenced.
+func (f *Fixtures) NewCommentFixture(ctx context.Context, overrides ...func(*CommentEntity)) (*CommentEntity, error) {
+	e := &CommentEntity{
+		Content: f.string(0, false),
+	}
+	for _, o := range overrides {
+		o(e)
+	}
+	if e.NewsID == 0 || e.NewsTitle == "" {
+		parent, err := f.InsertNewsFixture(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if e.NewsID == 0 {
+			e.NewsID = parent.ID
+		}
+		if e.NewsTitle == "" {
+			e.NewsTitle = parent.Title
+		}
+	}
+	return e, nil
+}
+
+// InsertCommentFixture works like NewCommentFixture, but it inserts the fixture as well.
+func (f *Fixtures) InsertCommentFixture(ctx context.Context, overrides ...func(*CommentEntity)) (*CommentEntity, error) {
+	e, err := f.NewCommentFixture(ctx, overrides...)
+	if err != nil {
+		return nil, err
+	}
+	return (&CommentRepositoryBase{Table: TableComment, DB: f.DB}).Insert(ctx, e)
+}
@@ -0,0 +1,7704 @@
+// Code generated by pqt. DO NOT EDIT.
+
+package model
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/lib/pq"
+)
+
+const ()
+
+const (
+	TableComplete                                 = "example.complete"
+	TableCompleteColumnColumnBool                 = "column_bool"
+	TableCompleteColumnColumnBoolArray            = "column_bool_array"
+	TableCompleteColumnColumnBytea                = "column_bytea"
+	TableCompleteColumnColumnCharacter0           = "column_character_0"
+	TableCompleteColumnColumnCharacter100         = "column_character_100"
+	TableCompleteColumnColumnDecimal              = "column_decimal"
+	TableCompleteColumnColumnDoubleArray0         = "column_double_array_0"
+	TableCompleteColumnColumnDoubleArray100       = "column_double_array_100"
+	TableCompleteColumnColumnInet                 = "column_inet"
+	TableCompleteColumnColumnInteger              = "column_integer"
+	TableCompleteColumnColumnIntegerArray0        = "column_integer_array_0"
+	TableCompleteColumnColumnIntegerArray100      = "column_integer_array_100"
+	TableCompleteColumnColumnIntegerBig           = "column_integer_big"
+	TableCompleteColumnColumnIntegerBigArray0     = "column_integer_big_array_0"
+	TableCompleteColumnColumnIntegerBigArray100   = "column_integer_big_array_100"
+	TableCompleteColumnColumnIntegerBigRange      = "column_integer_big_range"
+	TableCompleteColumnColumnIntegerSmall         = "column_integer_small"
+	TableCompleteColumnColumnIntegerSmallArray0   = "column_integer_small_array_0"
+	TableCompleteColumnColumnIntegerSmallArray100 = "column_integer_small_array_100"
+	TableCompleteColumnColumnInterval             = "column_interval"
+	TableCompleteColumnColumnJson                 = "column_json"
+	TableCompleteColumnColumnJsonNn               = "column_json_nn"
+	TableCompleteColumnColumnJsonNnD              = "column_json_nn_d"
+	TableCompleteColumnColumnJsonb                = "column_jsonb"
+	TableCompleteColumnColumnJsonbNn              = "column_jsonb_nn"
+	TableCompleteColumnColumnJsonbNnD             = "column_jsonb_nn_d"
+	TableCompleteColumnColumnNumeric              = "column_numeric"
+	TableCompleteColumnColumnNumericRange         = "column_numeric_range"
+	TableCompleteColumnColumnPoint                = "column_point"
+	TableCompleteColumnColumnReal                 = "column_real"
+	TableCompleteColumnColumnSerial               = "column_serial"
+	TableCompleteColumnColumnSerialBig            = "column_serial_big"
+	TableCompleteColumnColumnSerialSmall          = "column_serial_small"
+	TableCompleteColumnColumnText                 = "column_text"
+	TableCompleteColumnColumnTextArray0           = "column_text_array_0"
+	TableCompleteColumnColumnTextArray100         = "column_text_array_100"
+	TableCompleteColumnColumnTime                 = "column_time"
+	TableCompleteColumnColumnTimestamp            = "column_timestamp"
+	TableCompleteColumnColumnTimestamptz          = "column_timestamptz"
+	TableCompleteColumnColumnTimestamptzArray     = "column_timestamptz_array"
+	TableCompleteColumnColumnTimestamptzRange     = "column_timestamptz_range"
+	TableCompleteColumnColumnUUID                 = "column_uuid"
+	TableCompleteColumnColumnUUIDArray            = "column_uuid_array"
+)
+
+var TableCompleteColumns = []string{
+	TableCompleteColumnColumnBool,
+	TableCompleteColumnColumnBoolArray,
+	TableCompleteColumnColumnBytea,
+	TableCompleteColumnColumnCharacter0,
+	TableCompleteColumnColumnCharacter100,
+	TableCompleteColumnColumnDecimal,
+	TableCompleteColumnColumnDoubleArray0,
+	TableCompleteColumnColumnDoubleArray100,
+	TableCompleteColumnColumnInet,
+	TableCompleteColumnColumnInteger,
+	TableCompleteColumnColumnIntegerArray0,
+	TableCompleteColumnColumnIntegerArray100,
+	TableCompleteColumnColumnIntegerBig,
+	TableCompleteColumnColumnIntegerBigArray0,
+	TableCompleteColumnColumnIntegerBigArray100,
+	TableCompleteColumnColumnIntegerBigRange,
+	TableCompleteColumnColumnIntegerSmall,
+	TableCompleteColumnColumnIntegerSmallArray0,
+	TableCompleteColumnColumnIntegerSmallArray100,
+	TableCompleteColumnColumnInterval,
+	TableCompleteColumnColumnJson,
+	TableCompleteColumnColumnJsonNn,
+	TableCompleteColumnColumnJsonNnD,
+	TableCompleteColumnColumnJsonb,
+	TableCompleteColumnColumnJsonbNn,
+	TableCompleteColumnColumnJsonbNnD,
+	TableCompleteColumnColumnNumeric,
+	TableCompleteColumnColumnNumericRange,
+	TableCompleteColumnColumnPoint,
+	TableCompleteColumnColumnReal,
+	TableCompleteColumnColumnSerial,
+	TableCompleteColumnColumnSerialBig,
+	TableCompleteColumnColumnSerialSmall,
+	TableCompleteColumnColumnText,
+	TableCompleteColumnColumnTextArray0,
+	TableCompleteColumnColumnTextArray100,
+	TableCompleteColumnColumnTime,
+	TableCompleteColumnColumnTimestamp,
+	TableCompleteColumnColumnTimestamptz,
+	TableCompleteColumnColumnTimestamptzArray,
+	TableCompleteColumnColumnTimestamptzRange,
+	TableCompleteColumnColumnUUID,
+	TableCompleteColumnColumnUUIDArray,
+}
+
+// CompleteEntity ...
+type CompleteEntity struct {
+	// ColumnBool ...
+	ColumnBool sql.NullBool
+	// ColumnBoolArray ...
+	ColumnBoolArray NullBoolArray
+	// ColumnBytea ...
+	ColumnBytea []byte
+	// ColumnCharacter0 ...
+	ColumnCharacter0 sql.NullString
+	// ColumnCharacter100 ...
+	ColumnCharacter100 sql.NullString
+	// ColumnDecimal ...
+	ColumnDecimal sql.NullFloat64
+	// ColumnDoubleArray0 ...
+	ColumnDoubleArray0 NullFloat64Array
+	// ColumnDoubleArray100 ...
+	ColumnDoubleArray100 NullFloat64Array
+	// ColumnInet ...
+	ColumnInet sql.NullString
+	// ColumnInteger ...
+	ColumnInteger *int32
+	// ColumnIntegerArray0 ...
+	ColumnIntegerArray0 NullInt64Array
+	// ColumnIntegerArray100 ...
+	ColumnIntegerArray100 NullInt64Array
+	// ColumnIntegerBig ...
+	ColumnIntegerBig sql.NullInt64
+	// ColumnIntegerBigArray0 ...
+	ColumnIntegerBigArray0 NullInt64Array
+	// ColumnIntegerBigArray100 ...
+	ColumnIntegerBigArray100 NullInt64Array
+	// ColumnIntegerBigRange ...
+	ColumnIntegerBigRange Int64Range
+	// ColumnIntegerSmall ...
+	ColumnIntegerSmall *int16
+	// ColumnIntegerSmallArray0 ...
+	ColumnIntegerSmallArray0 NullInt64Array
+	// ColumnIntegerSmallArray100 ...
+	ColumnIntegerSmallArray100 NullInt64Array
+	// ColumnInterval ...
+	ColumnInterval Interval
+	// ColumnJson ...
+	ColumnJson []byte
+	// ColumnJsonNn ...
+	ColumnJsonNn []byte
+	// ColumnJsonNnD ...
+	ColumnJsonNnD []byte
+	// ColumnJsonb ...
+	ColumnJsonb []byte
+	// ColumnJsonbNn ...
+	ColumnJsonbNn []byte
+	// ColumnJsonbNnD ...
+	ColumnJsonbNnD []byte
+	// ColumnNumeric ...
+	ColumnNumeric sql.NullFloat64
+	// ColumnNumericRange ...
+	ColumnNumericRange Float64Range
+	// ColumnPoint ...
+	ColumnPoint Point
+	// ColumnReal ...
+	ColumnReal *float32
+	// ColumnSerial ...
+	ColumnSerial *int32
+	// ColumnSerialBig ...
+	ColumnSerialBig sql.NullInt64
+	// ColumnSerialSmall ...
+	ColumnSerialSmall *int16
+	// ColumnText ...
+	ColumnText sql.NullString
+	// ColumnTextArray0 ...
+	ColumnTextArray0 NullStringArray
+	// ColumnTextArray100 ...
+	ColumnTextArray100 NullStringArray
+	// ColumnTime ...
+	ColumnTime pq.NullTime
+	// ColumnTimestamp ...
+	ColumnTimestamp pq.NullTime
+	// ColumnTimestamptz ...
+	ColumnTimestamptz pq.NullTime
+	// ColumnTimestamptzArray ...
+	ColumnTimestamptzArray NullTimeArray
+	// ColumnTimestamptzRange ...
+	ColumnTimestamptzRange TimeRange
+	// ColumnUUID ...
+	ColumnUUID sql.NullString
+	// ColumnUUIDArray ...
+	ColumnUUIDArray NullStringArray
+	// fields holds fields loaded by a projection, see Loaded.
+	fields CompleteFields
+}
+
+func (e *CompleteEntity) Prop(cn string) (interface{}, bool) {
+	switch cn {
+
+	case TableCompleteColumnColumnBool:
+		return &e.ColumnBool, true
+	case TableCompleteColumnColumnBoolArray:
+		return &e.ColumnBoolArray, true
+	case TableCompleteColumnColumnBytea:
+		return &e.ColumnBytea, true
+	case TableCompleteColumnColumnCharacter0:
+		return &e.ColumnCharacter0, true
+	case TableCompleteColumnColumnCharacter100:
+		return &e.ColumnCharacter100, true
+	case TableCompleteColumnColumnDecimal:
+		return &e.ColumnDecimal, true
+	case TableCompleteColumnColumnDoubleArray0:
+		return &e.ColumnDoubleArray0, true
+	case TableCompleteColumnColumnDoubleArray100:
+		return &e.ColumnDoubleArray100, true
+	case TableCompleteColumnColumnInet:
+		return &e.ColumnInet, true
+	case TableCompleteColumnColumnInteger:
+		return e.ColumnInteger, true
+	case TableCompleteColumnColumnIntegerArray0:
+		return &e.ColumnIntegerArray0, true
+	case TableCompleteColumnColumnIntegerArray100:
+		return &e.ColumnIntegerArray100, true
+	case TableCompleteColumnColumnIntegerBig:
+		return &e.ColumnIntegerBig, true
+	case TableCompleteColumnColumnIntegerBigArray0:
+		return &e.ColumnIntegerBigArray0, true
+	case TableCompleteColumnColumnIntegerBigArray100:
+		return &e.ColumnIntegerBigArray100, true
+	case TableCompleteColumnColumnIntegerBigRange:
+		return &e.ColumnIntegerBigRange, true
+	case TableCompleteColumnColumnIntegerSmall:
+		return e.ColumnIntegerSmall, true
+	case TableCompleteColumnColumnIntegerSmallArray0:
+		return &e.ColumnIntegerSmallArray0, true
+	case TableCompleteColumnColumnIntegerSmallArray100:
+		return &e.ColumnIntegerSmallArray100, true
+	case TableCompleteColumnColumnInterval:
+		return &e.ColumnInterval, true
+	case TableCompleteColumnColumnJson:
+		return &e.ColumnJson, true
+	case TableCompleteColumnColumnJsonNn:
+		return &e.ColumnJsonNn, true
+	case TableCompleteColumnColumnJsonNnD:
+		return &e.ColumnJsonNnD, true
+	case TableCompleteColumnColumnJsonb:
+		return &e.ColumnJsonb, true
+	case TableCompleteColumnColumnJsonbNn:
+		return &e.ColumnJsonbNn, true
+	case TableCompleteColumnColumnJsonbNnD:
+		return &e.ColumnJsonbNnD, true
+	case TableCompleteColumnColumnNumeric:
+		return &e.ColumnNumeric, true
+	case TableCompleteColumnColumnNumericRange:
+		return &e.ColumnNumericRange, true
+	case TableCompleteColumnColumnPoint:
+		return &e.ColumnPoint, true
+	case TableCompleteColumnColumnReal:
+		return e.ColumnReal, true
+	case TableCompleteColumnColumnSerial:
+		return e.ColumnSerial, true
+	case TableCompleteColumnColumnSerialBig:
+		return &e.ColumnSerialBig, true
+	case TableCompleteColumnColumnSerialSmall:
+		return e.ColumnSerialSmall, true
+	case TableCompleteColumnColumnText:
+		return &e.ColumnText, true
+	case TableCompleteColumnColumnTextArray0:
+		return &e.ColumnTextArray0, true
+	case TableCompleteColumnColumnTextArray100:
+		return &e.ColumnTextArray100, true
+	case TableCompleteColumnColumnTime:
+		return &e.ColumnTime, true
+	case TableCompleteColumnColumnTimestamp:
+		return &e.ColumnTimestamp, true
+	case TableCompleteColumnColumnTimestamptz:
+		return &e.ColumnTimestamptz, true
+	case TableCompleteColumnColumnTimestamptzArray:
+		return &e.ColumnTimestamptzArray, true
+	case TableCompleteColumnColumnTimestamptzRange:
+		return &e.ColumnTimestamptzRange, true
+	case TableCompleteColumnColumnUUID:
+		return &e.ColumnUUID, true
+	case TableCompleteColumnColumnUUIDArray:
+		return &e.ColumnUUIDArray, true
+	default:
+		return nil, false
+	}
+}
+
+func (e *CompleteEntity) Props(cns ...string) ([]interface{}, error) {
+	projection := len(cns) > 0
+	if !projection {
+		cns = TableCompleteColumns
+	}
+	e.fields = CompleteFields{}
+	res := make([]interface{}, 0, len(cns))
+	for _, cn := range cns {
+		if prop, ok := e.Prop(cn); ok {
+			res = append(res, prop)
+		} else {
+			return nil, fmt.Errorf("unexpected column provided: %s", cn)
+		}
+		if projection {
+			e.fields.add(cn)
+		}
+	}
+	return res, nil
+}
+
+// CompleteFields is a set of CompleteEntity fields, identified by their columns.
+type CompleteFields [1]uint64
+
+// Has reports whether the set contains field of given column.
+func (f CompleteFields) Has(cn string) bool {
+	i := completeFieldIndex(cn)
+	return i >= 0 && f[i/64]&(1<<uint(i%64)) != 0
+}
+
+func (f *CompleteFields) add(cn string) {
+	if i := completeFieldIndex(cn); i >= 0 {
+		f[i/64] |= 1 << uint(i%64)
+	}
+}
+
+// completeFieldIndex returns position of the column in TableCompleteColumns, or -1 if there is no such column.
+func completeFieldIndex(cn string) int {
+	switch cn {
+	case TableCompleteColumnColumnBool:
+		return 0
+	case TableCompleteColumnColumnBoolArray:
+		return 1
+	case TableCompleteColumnColumnBytea:
+		return 2
+	case TableCompleteColumnColumnCharacter0:
+		return 3
+	case TableCompleteColumnColumnCharacter100:
+		return 4
+	case TableCompleteColumnColumnDecimal:
+		return 5
+	case TableCompleteColumnColumnDoubleArray0:
+		return 6
+	case TableCompleteColumnColumnDoubleArray100:
+		return 7
+	case TableCompleteColumnColumnInet:
+		return 8
+	case TableCompleteColumnColumnInteger:
+		return 9
+	case TableCompleteColumnColumnIntegerArray0:
+		return 10
+	case TableCompleteColumnColumnIntegerArray100:
+		return 11
+	case TableCompleteColumnColumnIntegerBig:
+		return 12
+	case TableCompleteColumnColumnIntegerBigArray0:
+		return 13
+	case TableCompleteColumnColumnIntegerBigArray100:
+		return 14
+	case TableCompleteColumnColumnIntegerBigRange:
+		return 15
+	case TableCompleteColumnColumnIntegerSmall:
+		return 16
+	case TableCompleteColumnColumnIntegerSmallArray0:
+		return 17
+	case TableCompleteColumnColumnIntegerSmallArray100:
+		return 18
+	case TableCompleteColumnColumnInterval:
+		return 19
+	case TableCompleteColumnColumnJson:
+		return 20
+	case TableCompleteColumnColumnJsonNn:
+		return 21
+	case TableCompleteColumnColumnJsonNnD:
+		return 22
+	case TableCompleteColumnColumnJsonb:
+		return 23
+	case TableCompleteColumnColumnJsonbNn:
+		return 24
+	case TableCompleteColumnColumnJsonbNnD:
+		return 25
+	case TableCompleteColumnColumnNumeric:
+		return 26
+	case TableCompleteColumnColumnNumericRange:
+		return 27
+	case TableCompleteColumnColumnPoint:
+		return 28
+	case TableCompleteColumnColumnReal:
+		return 29
+	case TableCompleteColumnColumnSerial:
+		return 30
+	case TableCompleteColumnColumnSerialBig:
+		return 31
+	case TableCompleteColumnColumnSerialSmall:
+		return 32
+	case TableCompleteColumnColumnText:
+		return 33
+	case TableCompleteColumnColumnTextArray0:
+		return 34
+	case TableCompleteColumnColumnTextArray100:
+		return 35
+	case TableCompleteColumnColumnTime:
+		return 36
+	case TableCompleteColumnColumnTimestamp:
+		return 37
+	case TableCompleteColumnColumnTimestamptz:
+		return 38
+	case TableCompleteColumnColumnTimestamptzArray:
+		return 39
+	case TableCompleteColumnColumnTimestamptzRange:
+		return 40
+	case TableCompleteColumnColumnUUID:
+		return 41
+	case TableCompleteColumnColumnUUIDArray:
+		return 42
+	}
+	return -1
+}
+
+// Loaded reports whether field of given column was loaded by the query that returned the entity.
+// Entities loaded with all columns, as well as entities built by hand, report every field as loaded.
+func (e *CompleteEntity) Loaded(cn string) bool {
+	if e.fields == (CompleteFields{}) {
+		return completeFieldIndex(cn) >= 0
+	}
+	return e.fields.Has(cn)
+}
+
+// Validate checks if entity meets NOT NULL, length, precision, enum, array size and simple check constraints of the table.
+// It returns ValidationError that lists all violations, if any.
+func (e *CompleteEntity) Validate() error {
+	var errs ValidationError
+	if e.ColumnCharacter100.Valid {
+		if utf8.RuneCountInString(e.ColumnCharacter100.String) > 100 {
+			errs = append(errs, &FieldError{Field: "ColumnCharacter100", Column: TableCompleteColumnColumnCharacter100, Reason: "is longer than 100 characters"})
+		}
+	}
+	if e.ColumnDecimal.Valid {
+		if !numericFits(e.ColumnDecimal.Float64, 20, 8) {
+			errs = append(errs, &FieldError{Field: "ColumnDecimal", Column: TableCompleteColumnColumnDecimal, Reason: "does not fit numeric(20,8)"})
+		}
+	}
+	if e.ColumnDoubleArray100.Valid {
+		if len(e.ColumnDoubleArray100.Float64Array) > 100 {
+			errs = append(errs, &FieldError{Field: "ColumnDoubleArray100", Column: TableCompleteColumnColumnDoubleArray100, Reason: "has more than 100 elements"})
+		}
+	}
+	if e.ColumnIntegerArray100.Valid {
+		if len(e.ColumnIntegerArray100.Int64Array) > 100 {
+			errs = append(errs, &FieldError{Field: "ColumnIntegerArray100", Column: TableCompleteColumnColumnIntegerArray100, Reason: "has more than 100 elements"})
+		}
+	}
+	if e.ColumnIntegerBigArray100.Valid {
+		if len(e.ColumnIntegerBigArray100.Int64Array) > 100 {
+			errs = append(errs, &FieldError{Field: "ColumnIntegerBigArray100", Column: TableCompleteColumnColumnIntegerBigArray100, Reason: "has more than 100 elements"})
+		}
+	}
+	if e.ColumnIntegerSmallArray100.Valid {
+		if len(e.ColumnIntegerSmallArray100.Int64Array) > 100 {
+			errs = append(errs, &FieldError{Field: "ColumnIntegerSmallArray100", Column: TableCompleteColumnColumnIntegerSmallArray100, Reason: "has more than 100 elements"})
+		}
+	}
+	if e.ColumnJsonNn == nil {
+		errs = append(errs, &FieldError{Field: "ColumnJsonNn", Column: TableCompleteColumnColumnJsonNn, Reason: "must not be null"})
+	}
+	if e.ColumnJsonbNn == nil {
+		errs = append(errs, &FieldError{Field: "ColumnJsonbNn", Column: TableCompleteColumnColumnJsonbNn, Reason: "must not be null"})
+	}
+	if e.ColumnNumeric.Valid {
+		if !numericFits(e.ColumnNumeric.Float64, 20, 8) {
+			errs = append(errs, &FieldError{Field: "ColumnNumeric", Column: TableCompleteColumnColumnNumeric, Reason: "does not fit numeric(20,8)"})
+		}
+	}
+	if e.ColumnTextArray100.Valid {
+		if len(e.ColumnTextArray100.StringArray) > 100 {
+			errs = append(errs, &FieldError{Field: "ColumnTextArray100", Column: TableCompleteColumnColumnTextArray100, Reason: "has more than 100 elements"})
+		}
+	}
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+// ScanCompleteRows helps to scan rows straight to the slice of entities.
+func ScanCompleteRows(rows Rows) (entities []*CompleteEntity, err error) {
+	for rows.Next() {
+		var ent CompleteEntity
+		err = rows.Scan(
+			&ent.ColumnBool,
+			&ent.ColumnBoolArray,
+			&ent.ColumnBytea,
+			&ent.ColumnCharacter0,
+			&ent.ColumnCharacter100,
+			&ent.ColumnDecimal,
+			&ent.ColumnDoubleArray0,
+			&ent.ColumnDoubleArray100,
+			&ent.ColumnInet,
+			&ent.ColumnInteger,
+			&ent.ColumnIntegerArray0,
+			&ent.ColumnIntegerArray100,
+			&ent.ColumnIntegerBig,
+			&ent.ColumnIntegerBigArray0,
+			&ent.ColumnIntegerBigArray100,
+			&ent.ColumnIntegerBigRange,
+			&ent.ColumnIntegerSmall,
+			&ent.ColumnIntegerSmallArray0,
+			&ent.ColumnIntegerSmallArray100,
+			&ent.ColumnInterval,
+			&ent.ColumnJson,
+			&ent.ColumnJsonNn,
+			&ent.ColumnJsonNnD,
+			&ent.ColumnJsonb,
+			&ent.ColumnJsonbNn,
+			&ent.ColumnJsonbNnD,
+			&ent.ColumnNumeric,
+			&ent.ColumnNumericRange,
+			&ent.ColumnPoint,
+			&ent.ColumnReal,
+			&ent.ColumnSerial,
+			&ent.ColumnSerialBig,
+			&ent.ColumnSerialSmall,
+			&ent.ColumnText,
+			&ent.ColumnTextArray0,
+			&ent.ColumnTextArray100,
+			&ent.ColumnTime,
+			&ent.ColumnTimestamp,
+			&ent.ColumnTimestamptz,
+			&ent.ColumnTimestamptzArray,
+			&ent.ColumnTimestamptzRange,
+			&ent.ColumnUUID,
+			&ent.ColumnUUIDArray,
+		)
+		if err != nil {
+			return
+		}
+
+		entities = append(entities, &ent)
+	}
+	if err = rows.Err(); err != nil {
+		return
+	}
+
+	return
+}
+
+// CompleteIterator is not thread safe.
+type CompleteIterator struct {
+	rows Rows
+	cols []string
+	expr *CompleteFindExpr
+}
+
+func (i *CompleteIterator) Next() bool {
+	return i.rows.Next()
+}
+
+func (i *CompleteIterator) Close() error {
+	return i.rows.Close()
+}
+
+func (i *CompleteIterator) Err() error {
+	return i.rows.Err()
+}
+
+// Columns is wrapper around sql.Rows.Columns method, that also cache output inside iterator.
+func (i *CompleteIterator) Columns() ([]string, error) {
+	if i.cols == nil {
+		cols, err := i.rows.Columns()
+		if err != nil {
+			return nil, err
+		}
+		i.cols = cols
+	}
+	return i.cols, nil
+}
+
+// Ent is wrapper around Complete method that makes iterator more generic.
+func (i *CompleteIterator) Ent() (interface{}, error) {
+	return i.Complete()
+}
+
+func (i *CompleteIterator) Complete() (*CompleteEntity, error) {
+	var ent CompleteEntity
+	cols, err := i.Columns()
+	if err != nil {
+		return nil, err
+	}
+
+	props, err := ent.Props(cols...)
+	if err != nil {
+		return nil, err
+	}
+	if err := i.rows.Scan(props...); err != nil {
+		return nil, err
+	}
+	return &ent, nil
+}
+
+type CompleteCriteria struct {
+	ColumnBool      sql.NullBool
+	ColumnBoolArray NullBoolArray
+	// ColumnBoolArrayContains matches rows where column_bool_array contains all given elements (@>).
+	ColumnBoolArrayContains NullBoolArray
+	// ColumnBoolArrayOverlap matches rows where column_bool_array has any elements in common with given ones (&&).
+	ColumnBoolArrayOverlap NullBoolArray
+	// ColumnBoolArrayAny matches rows where any element of column_bool_array equals given value (= ANY).
+	ColumnBoolArrayAny sql.NullBool
+	ColumnBytea        []byte
+	ColumnCharacter0   sql.NullString
+	ColumnCharacter100 sql.NullString
+	ColumnDecimal      sql.NullFloat64
+	ColumnDoubleArray0 NullFloat64Array
+	// ColumnDoubleArray0Contains matches rows where column_double_array_0 contains all given elements (@>).
+	ColumnDoubleArray0Contains NullFloat64Array
+	// ColumnDoubleArray0Overlap matches rows where column_double_array_0 has any elements in common with given ones (&&).
+	ColumnDoubleArray0Overlap NullFloat64Array
+	// ColumnDoubleArray0Any matches rows where any element of column_double_array_0 equals given value (= ANY).
+	ColumnDoubleArray0Any sql.NullFloat64
+	ColumnDoubleArray100  NullFloat64Array
+	// ColumnDoubleArray100Contains matches rows where column_double_array_100 contains all given elements (@>).
+	ColumnDoubleArray100Contains NullFloat64Array
+	// ColumnDoubleArray100Overlap matches rows where column_double_array_100 has any elements in common with given ones (&&).
+	ColumnDoubleArray100Overlap NullFloat64Array
+	// ColumnDoubleArray100Any matches rows where any element of column_double_array_100 equals given value (= ANY).
+	ColumnDoubleArray100Any sql.NullFloat64
+	ColumnInet              sql.NullString
+	ColumnInteger           *int32
+	ColumnIntegerArray0     NullInt64Array
+	// ColumnIntegerArray0Contains matches rows where column_integer_array_0 contains all given elements (@>).
+	ColumnIntegerArray0Contains NullInt64Array
+	// ColumnIntegerArray0Overlap matches rows where column_integer_array_0 has any elements in common with given ones (&&).
+	ColumnIntegerArray0Overlap NullInt64Array
+	// ColumnIntegerArray0Any matches rows where any element of column_integer_array_0 equals given value (= ANY).
+	ColumnIntegerArray0Any sql.NullInt64
+	ColumnIntegerArray100  NullInt64Array
+	// ColumnIntegerArray100Contains matches rows where column_integer_array_100 contains all given elements (@>).
+	ColumnIntegerArray100Contains NullInt64Array
+	// ColumnIntegerArray100Overlap matches rows where column_integer_array_100 has any elements in common with given ones (&&).
+	ColumnIntegerArray100Overlap NullInt64Array
+	// ColumnIntegerArray100Any matches rows where any element of column_integer_array_100 equals given value (= ANY).
+	ColumnIntegerArray100Any sql.NullInt64
+	ColumnIntegerBig         sql.NullInt64
+	ColumnIntegerBigArray0   NullInt64Array
+	// ColumnIntegerBigArray0Contains matches rows where column_integer_big_array_0 contains all given elements (@>).
+	ColumnIntegerBigArray0Contains NullInt64Array
+	// ColumnIntegerBigArray0Overlap matches rows where column_integer_big_array_0 has any elements in common with given ones (&&).
+	ColumnIntegerBigArray0Overlap NullInt64Array
+	// ColumnIntegerBigArray0Any matches rows where any element of column_integer_big_array_0 equals given value (= ANY).
+	ColumnIntegerBigArray0Any sql.NullInt64
+	ColumnIntegerBigArray100  NullInt64Array
+	// ColumnIntegerBigArray100Contains matches rows where column_integer_big_array_100 contains all given elements (@>).
+	ColumnIntegerBigArray100Contains NullInt64Array
+	// ColumnIntegerBigArray100Overlap matches rows where column_integer_big_array_100 has any elements in common with given ones (&&).
+	ColumnIntegerBigArray100Overlap NullInt64Array
+	// ColumnIntegerBigArray100Any matches rows where any element of column_integer_big_array_100 equals given value (= ANY).
+	ColumnIntegerBigArray100Any sql.NullInt64
+	ColumnIntegerBigRange       Int64Range
+	ColumnIntegerSmall          *int16
+	ColumnIntegerSmallArray0    NullInt64Array
+	// ColumnIntegerSmallArray0Contains matches rows where column_integer_small_array_0 contains all given elements (@>).
+	ColumnIntegerSmallArray0Contains NullInt64Array
+	// ColumnIntegerSmallArray0Overlap matches rows where column_integer_small_array_0 has any elements in common with given ones (&&).
+	ColumnIntegerSmallArray0Overlap NullInt64Array
+	// ColumnIntegerSmallArray0Any matches rows where any element of column_integer_small_array_0 equals given value (= ANY).
+	ColumnIntegerSmallArray0Any sql.NullInt64
+	ColumnIntegerSmallArray100  NullInt64Array
+	// ColumnIntegerSmallArray100Contains matches rows where column_integer_small_array_100 contains all given elements (@>).
+	ColumnIntegerSmallArray100Contains NullInt64Array
+	// ColumnIntegerSmallArray100Overlap matches rows where column_integer_small_array_100 has any elements in common with given ones (&&).
+	ColumnIntegerSmallArray100Overlap NullInt64Array
+	// ColumnIntegerSmallArray100Any matches rows where any element of column_integer_small_array_100 equals given value (= ANY).
+	ColumnIntegerSmallArray100Any sql.NullInt64
+	ColumnInterval                Interval
+	ColumnJson                    []byte
+	ColumnJsonNn                  []byte
+	ColumnJsonNnD                 []byte
+	ColumnJsonb                   []byte
+	// ColumnJsonbContains matches rows where column_jsonb contains given JSON document (@>).
+	ColumnJsonbContains []byte
+	// ColumnJsonbHasKey matches rows where column_jsonb has given top-level key (?).
+	ColumnJsonbHasKey sql.NullString
+	// ColumnJsonbHasAnyKeys matches rows where column_jsonb has any of given top-level keys (?|).
+	ColumnJsonbHasAnyKeys pq.StringArray
+	// ColumnJsonbHasAllKeys matches rows where column_jsonb has all of given top-level keys (?&).
+	ColumnJsonbHasAllKeys pq.StringArray
+	// ColumnJsonbPath matches rows where all predicates on values extracted from column_jsonb are true (->>, #>>).
+	ColumnJsonbPath []JSONPredicate
+	// ColumnJsonbMatch matches rows where jsonpath predicate check on column_jsonb returns true (@@).
+	ColumnJsonbMatch sql.NullString
+	// ColumnJsonbExists matches rows where jsonpath returns any item for column_jsonb (@?).
+	ColumnJsonbExists sql.NullString
+	ColumnJsonbNn     []byte
+	// ColumnJsonbNnContains matches rows where column_jsonb_nn contains given JSON document (@>).
+	ColumnJsonbNnContains []byte
+	// ColumnJsonbNnHasKey matches rows where column_jsonb_nn has given top-level key (?).
+	ColumnJsonbNnHasKey sql.NullString
+	// ColumnJsonbNnHasAnyKeys matches rows where column_jsonb_nn has any of given top-level keys (?|).
+	ColumnJsonbNnHasAnyKeys pq.StringArray
+	// ColumnJsonbNnHasAllKeys matches rows where column_jsonb_nn has all of given top-level keys (?&).
+	ColumnJsonbNnHasAllKeys pq.StringArray
+	// ColumnJsonbNnPath matches rows where all predicates on values extracted from column_jsonb_nn are true (->>, #>>).
+	ColumnJsonbNnPath []JSONPredicate
+	// ColumnJsonbNnMatch matches rows where jsonpath predicate check on column_jsonb_nn returns true (@@).
+	ColumnJsonbNnMatch sql.NullString
+	// ColumnJsonbNnExists matches rows where jsonpath returns any item for column_jsonb_nn (@?).
+	ColumnJsonbNnExists sql.NullString
+	ColumnJsonbNnD      []byte
+	// ColumnJsonbNnDContains matches rows where column_jsonb_nn_d contains given JSON document (@>).
+	ColumnJsonbNnDContains []byte
+	// ColumnJsonbNnDHasKey matches rows where column_jsonb_nn_d has given top-level key (?).
+	ColumnJsonbNnDHasKey sql.NullString
+	// ColumnJsonbNnDHasAnyKeys matches rows where column_jsonb_nn_d has any of given top-level keys (?|).
+	ColumnJsonbNnDHasAnyKeys pq.StringArray
+	// ColumnJsonbNnDHasAllKeys matches rows where column_jsonb_nn_d has all of given top-level keys (?&).
+	ColumnJsonbNnDHasAllKeys pq.StringArray
+	// ColumnJsonbNnDPath matches rows where all predicates on values extracted from column_jsonb_nn_d are true (->>, #>>).
+	ColumnJsonbNnDPath []JSONPredicate
+	// ColumnJsonbNnDMatch matches rows where jsonpath predicate check on column_jsonb_nn_d returns true (@@).
+	ColumnJsonbNnDMatch sql.NullString
+	// ColumnJsonbNnDExists matches rows where jsonpath returns any item for column_jsonb_nn_d (@?).
+	ColumnJsonbNnDExists sql.NullString
+	ColumnNumeric        sql.NullFloat64
+	ColumnNumericRange   Float64Range
+	ColumnPoint          Point
+	ColumnReal           *float32
+	ColumnSerial         *int32
+	ColumnSerialBig      sql.NullInt64
+	ColumnSerialSmall    *int16
+	ColumnText           sql.NullString
+	ColumnTextArray0     NullStringArray
+	// ColumnTextArray0Contains matches rows where column_text_array_0 contains all given elements (@>).
+	ColumnTextArray0Contains NullStringArray
+	// ColumnTextArray0Overlap matches rows where column_text_array_0 has any elements in common with given ones (&&).
+	ColumnTextArray0Overlap NullStringArray
+	// ColumnTextArray0Any matches rows where any element of column_text_array_0 equals given value (= ANY).
+	ColumnTextArray0Any sql.NullString
+	ColumnTextArray100  NullStringArray
+	// ColumnTextArray100Contains matches rows where column_text_array_100 contains all given elements (@>).
+	ColumnTextArray100Contains NullStringArray
+	// ColumnTextArray100Overlap matches rows where column_text_array_100 has any elements in common with given ones (&&).
+	ColumnTextArray100Overlap NullStringArray
+	// ColumnTextArray100Any matches rows where any element of column_text_array_100 equals given value (= ANY).
+	ColumnTextArray100Any  sql.NullString
+	ColumnTime             pq.NullTime
+	ColumnTimestamp        pq.NullTime
+	ColumnTimestamptz      pq.NullTime
+	ColumnTimestamptzArray NullTimeArray
+	// ColumnTimestamptzArrayContains matches rows where column_timestamptz_array contains all given elements (@>).
+	ColumnTimestamptzArrayContains NullTimeArray
+	// ColumnTimestamptzArrayOverlap matches rows where column_timestamptz_array has any elements in common with given ones (&&).
+	ColumnTimestamptzArrayOverlap NullTimeArray
+	// ColumnTimestamptzArrayAny matches rows where any element of column_timestamptz_array equals given value (= ANY).
+	ColumnTimestamptzArrayAny pq.NullTime
+	ColumnTimestamptzRange    TimeRange
+	ColumnUUID                sql.NullString
+	ColumnUUIDArray           NullStringArray
+	// ColumnUUIDArrayContains matches rows where column_uuid_array contains all given elements (@>).
+	ColumnUUIDArrayContains NullStringArray
+	// ColumnUUIDArrayOverlap matches rows where column_uuid_array has any elements in common with given ones (&&).
+	ColumnUUIDArrayOverlap NullStringArray
+	// ColumnUUIDArrayAny matches rows where any element of column_uuid_array equals given value (= ANY).
+	ColumnUUIDArrayAny     sql.NullString
+	operator               string
+	child, sibling, parent *CompleteCriteria
+}
+
+func CompleteOperand(operator string, operands ...*CompleteCriteria) *CompleteCriteria {
+	if len(operands) == 0 {
+		return &CompleteCriteria{operator: operator}
+	}
+
+	parent := &CompleteCriteria{
+		operator: operator,
+		child:    operands[0],
+	}
+
+	for i := 0; i < len(operands); i++ {
+		if i < len(operands)-1 {
+			operands[i].sibling = operands[i+1]
+		}
+		operands[i].parent = parent
+	}
+
+	return parent
+}
+
+func CompleteOr(operands ...*CompleteCriteria) *CompleteCriteria {
+	return CompleteOperand("OR", operands...)
+}
+
+func CompleteAnd(operands ...*CompleteCriteria) *CompleteCriteria {
+	return CompleteOperand("AND", operands...)
+}
+
+// CompleteCriteriaWhereClause writes conditions of the criteria tree into the composer.
+// The id is the alias of the table named table, tables of related rows are resolved relative to its schema.
+func CompleteCriteriaWhereClause(comp *Composer, c *CompleteCriteria, id int, table string) error {
+	if c.child == nil {
+		return _CompleteCriteriaWhereClause(comp, c, id, table)
+	}
+	node := c
+	sibling := false
+	for {
+		if !sibling {
+			if node.child != nil {
+				if node.parent != nil {
+					comp.WriteString("(")
+				}
+				node = node.child
+				continue
+			} else {
+				comp.Dirty = false
+				comp.WriteString("(")
+				if err := _CompleteCriteriaWhereClause(comp, node, id, table); err != nil {
+					return err
+				}
+				comp.WriteString(")")
+			}
+		}
+		if node.sibling != nil {
+			sibling = false
+			comp.WriteString(" ")
+			comp.WriteString(node.parent.operator)
+			comp.WriteString(" ")
+			node = node.sibling
+			continue
+		}
+		if node.parent != nil {
+			sibling = true
+			if node.parent.parent != nil {
+				comp.WriteString(")")
+			}
+			node = node.parent
+			continue
+		}
+
+		break
+	}
+	return nil
+}
+
+func _CompleteCriteriaWhereClause(comp *Composer, c *CompleteCriteria, id int, table string) error {
+	if c.ColumnBool.Valid {
+		if comp.Dirty {
+			comp.WriteString(" AND ")
+		}
+		if err := comp.WriteAlias(id); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(TableCompleteColumnColumnBool); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString("="); err != nil {
+			return err
+		}
+		if err := comp.WritePlaceholder(); err != nil {
+			return err
+		}
+		comp.Add(c.ColumnBool)
+		comp.Dirty = true
+	}
+	if c.ColumnBoolArray.Valid {
+		if comp.Dirty {
+			comp.WriteString(" AND ")
+		}
+		if err := comp.WriteAlias(id); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(TableCompleteColumnColumnBoolArray); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString("="); err != nil {
+			return err
+		}
+		if err := comp.WritePlaceholder(); err != nil {
+			return err
+		}
+		comp.Add(c.ColumnBoolArray)
+		comp.Dirty = true
+	}
+	if c.ColumnBoolArrayContains.Valid {
+		if comp.Dirty {
+			comp.WriteString(" AND ")
+		}
+		if err := comp.WriteAlias(id); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(TableCompleteColumnColumnBoolArray); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(" @> "); err != nil {
+			return err
+		}
+		if err := comp.WritePlaceholder(); err != nil {
+			return err
+		}
+		comp.Add(c.ColumnBoolArrayContains)
+		comp.Dirty = true
+	}
+	if c.ColumnBoolArrayOverlap.Valid {
+		if comp.Dirty {
+			comp.WriteString(" AND ")
+		}
+		if err := comp.WriteAlias(id); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(TableCompleteColumnColumnBoolArray); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(" && "); err != nil {
+			return err
+		}
+		if err := comp.WritePlaceholder(); err != nil {
+			return err
+		}
+		comp.Add(c.ColumnBoolArrayOverlap)
+		comp.Dirty = true
+	}
+	if c.ColumnBoolArrayAny.Valid {
+		if comp.Dirty {
+			comp.WriteString(" AND ")
+		}
+		if err := comp.WritePlaceholder(); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(" = ANY("); err != nil {
+			return err
+		}
+		if err := comp.WriteAlias(id); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(TableCompleteColumnColumnBoolArray); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(")"); err != nil {
+			return err
+		}
+		comp.Add(c.ColumnBoolArrayAny)
+		comp.Dirty = true
+	}
+	if c.ColumnBytea != nil {
+		if comp.Dirty {
+			comp.WriteString(" AND ")
+		}
+		if err := comp.WriteAlias(id); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(TableCompleteColumnColumnBytea); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString("="); err != nil {
+			return err
+		}
+		if err := comp.WritePlaceholder(); err != nil {
+			return err
+		}
+		comp.Add(c.ColumnBytea)
+		comp.Dirty = true
+	}
+	if c.ColumnCharacter0.Valid {
+		if comp.Dirty {
+			comp.WriteString(" AND ")
+		}
+		if err := comp.WriteAlias(id); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(TableCompleteColumnColumnCharacter0); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString("="); err != nil {
+			return err
+		}
+		if err := comp.WritePlaceholder(); err != nil {
+			return err
+		}
+		comp.Add(c.ColumnCharacter0)
+		comp.Dirty = true
+	}
+	if c.ColumnCharacter100.Valid {
+		if comp.Dirty {
+			comp.WriteString(" AND ")
+		}
+		if err := comp.WriteAlias(id); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(TableCompleteColumnColumnCharacter100); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString("="); err != nil {
+			return err
+		}
+		if err := comp.WritePlaceholder(); err != nil {
+			return err
+		}
+		comp.Add(c.ColumnCharacter100)
+		comp.Dirty = true
+	}
+	if c.ColumnDecimal.Valid {
+		if comp.Dirty {
+			comp.WriteString(" AND ")
+		}
+		if err := comp.WriteAlias(id); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(TableCompleteColumnColumnDecimal); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString("="); err != nil {
+			return err
+		}
+		if err := comp.WritePlaceholder(); err != nil {
+			return err
+		}
+		comp.Add(c.ColumnDecimal)
+		comp.Dirty = true
+	}
+	if c.ColumnDoubleArray0.Valid {
+		if comp.Dirty {
+			comp.WriteString(" AND ")
+		}
+		if err := comp.WriteAlias(id); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(TableCompleteColumnColumnDoubleArray0); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString("="); err != nil {
+			return err
+		}
+		if err := comp.WritePlaceholder(); err != nil {
+			return err
+		}
+		comp.Add(c.ColumnDoubleArray0)
+		comp.Dirty = true
+	}
+	if c.ColumnDoubleArray0Contains.Valid {
+		if comp.Dirty {
+			comp.WriteString(" AND ")
+		}
+		if err := comp.WriteAlias(id); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(TableCompleteColumnColumnDoubleArray0); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(" @> "); err != nil {
+			return err
+		}
+		if err := comp.WritePlaceholder(); err != nil {
+			return err
+		}
+		comp.Add(c.ColumnDoubleArray0Contains)
+		comp.Dirty = true
+	}
+	if c.ColumnDoubleArray0Overlap.Valid {
+		if comp.Dirty {
+			comp.WriteString(" AND ")
+		}
+		if err := comp.WriteAlias(id); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(TableCompleteColumnColumnDoubleArray0); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(" && "); err != nil {
+			return err
+		}
+		if err := comp.WritePlaceholder(); err != nil {
+			return err
+		}
+		comp.Add(c.ColumnDoubleArray0Overlap)
+		comp.Dirty = true
+	}
+	if c.ColumnDoubleArray0Any.Valid {
+		if comp.Dirty {
+			comp.WriteString(" AND ")
+		}
+		if err := comp.WritePlaceholder(); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(" = ANY("); err != nil {
+			return err
+		}
+		if err := comp.WriteAlias(id); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(TableCompleteColumnColumnDoubleArray0); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(")"); err != nil {
+			return err
+		}
+		comp.Add(c.ColumnDoubleArray0Any)
+		comp.Dirty = true
+	}
+	if c.ColumnDoubleArray100.Valid {
+		if comp.Dirty {
+			comp.WriteString(" AND ")
+		}
+		if err := comp.WriteAlias(id); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(TableCompleteColumnColumnDoubleArray100); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString("="); err != nil {
+			return err
+		}
+		if err := comp.WritePlaceholder(); err != nil {
+			return err
+		}
+		comp.Add(c.ColumnDoubleArray100)
+		comp.Dirty = true
+	}
+	if c.ColumnDoubleArray100Contains.Valid {
+		if comp.Dirty {
+			comp.WriteString(" AND ")
+		}
+		if err := comp.WriteAlias(id); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(TableCompleteColumnColumnDoubleArray100); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(" @> "); err != nil {
+			return err
+		}
+		if err := comp.WritePlaceholder(); err != nil {
+			return err
+		}
+		comp.Add(c.ColumnDoubleArray100Contains)
+		comp.Dirty = true
+	}
+	if c.ColumnDoubleArray100Overlap.Valid {
+		if comp.Dirty {
+			comp.WriteString(" AND ")
+		}
+		if err := comp.WriteAlias(id); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(TableCompleteColumnColumnDoubleArray100); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(" && "); err != nil {
+			return err
+		}
+		if err := comp.WritePlaceholder(); err != nil {
+			return err
+		}
+		comp.Add(c.ColumnDoubleArray100Overlap)
+		comp.Dirty = true
+	}
+	if c.ColumnDoubleArray100Any.Valid {
+		if comp.Dirty {
+			comp.WriteString(" AND ")
+		}
+		if err := comp.WritePlaceholder(); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(" = ANY("); err != nil {
+			return err
+		}
+		if err := comp.WriteAlias(id); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(TableCompleteColumnColumnDoubleArray100); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(")"); err != nil {
+			return err
+		}
+		comp.Add(c.ColumnDoubleArray100Any)
+		comp.Dirty = true
+	}
+	if c.ColumnInet.Valid {
+		if comp.Dirty {
+			comp.WriteString(" AND ")
+		}
+		if err := comp.WriteAlias(id); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(TableCompleteColumnColumnInet); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString("="); err != nil {
+			return err
+		}
+		if err := comp.WritePlaceholder(); err != nil {
+			return err
+		}
+		comp.Add(c.ColumnInet)
+		comp.Dirty = true
+	}
+	if c.ColumnInteger != nil {
+		if comp.Dirty {
+			comp.WriteString(" AND ")
+		}
+		if err := comp.WriteAlias(id); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(TableCompleteColumnColumnInteger); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString("="); err != nil {
+			return err
+		}
+		if err := comp.WritePlaceholder(); err != nil {
+			return err
+		}
+		comp.Add(c.ColumnInteger)
+		comp.Dirty = true
+	}
+	if c.ColumnIntegerArray0.Valid {
+		if comp.Dirty {
+			comp.WriteString(" AND ")
+		}
+		if err := comp.WriteAlias(id); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(TableCompleteColumnColumnIntegerArray0); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString("="); err != nil {
+			return err
+		}
+		if err := comp.WritePlaceholder(); err != nil {
+			return err
+		}
+		comp.Add(c.ColumnIntegerArray0)
+		comp.Dirty = true
+	}
+	if c.ColumnIntegerArray0Contains.Valid {
+		if comp.Dirty {
+			comp.WriteString(" AND ")
+		}
+		if err := comp.WriteAlias(id); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(TableCompleteColumnColumnIntegerArray0); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(" @> "); err != nil {
+			return err
+		}
+		if err := comp.WritePlaceholder(); err != nil {
+			return err
+		}
+		comp.Add(c.ColumnIntegerArray0Contains)
+		comp.Dirty = true
+	}
+	if c.ColumnIntegerArray0Overlap.Valid {
+		if comp.Dirty {
+			comp.WriteString(" AND ")
+		}
+		if err := comp.WriteAlias(id); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(TableCompleteColumnColumnIntegerArray0); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(" && "); err != nil {
+			return err
+		}
+		if err := comp.WritePlaceholder(); err != nil {
+			return err
+		}
+		comp.Add(c.ColumnIntegerArray0Overlap)
+		comp.Dirty = true
+	}
+	if c.ColumnIntegerArray0Any.Valid {
+		if comp.Dirty {
+			comp.WriteString(" AND ")
+		}
+		if err := comp.WritePlaceholder(); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(" = ANY("); err != nil {
+			return err
+		}
+		if err := comp.WriteAlias(id); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(TableCompleteColumnColumnIntegerArray0); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(")"); err != nil {
+			return err
+		}
+		comp.Add(c.ColumnIntegerArray0Any)
+		comp.Dirty = true
+	}
+	if c.ColumnIntegerArray100.Valid {
+		if comp.Dirty {
+			comp.WriteString(" AND ")
+		}
+		if err := comp.WriteAlias(id); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(TableCompleteColumnColumnIntegerArray100); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString("="); err != nil {
+			return err
+		}
+		if err := comp.WritePlaceholder(); err != nil {
+			return err
+		}
+		comp.Add(c.ColumnIntegerArray100)
+		comp.Dirty = true
+	}
+	if c.ColumnIntegerArray100Contains.Valid {
+		if comp.Dirty {
+			comp.WriteString(" AND ")
+		}
+		if err := comp.WriteAlias(id); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(TableCompleteColumnColumnIntegerArray100); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(" @> "); err != nil {
+			return err
+		}
+		if err := comp.WritePlaceholder(); err != nil {
+			return err
+		}
+		comp.Add(c.ColumnIntegerArray100Contains)
+		comp.Dirty = true
+	}
+	if c.ColumnIntegerArray100Overlap.Valid {
+		if comp.Dirty {
+			comp.WriteString(" AND ")
+		}
+		if err := comp.WriteAlias(id); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(TableCompleteColumnColumnIntegerArray100); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(" && "); err != nil {
+			return err
+		}
+		if err := comp.WritePlaceholder(); err != nil {
+			return err
+		}
+		comp.Add(c.ColumnIntegerArray100Overlap)
+		comp.Dirty = true
+	}
+	if c.ColumnIntegerArray100Any.Valid {
+		if comp.Dirty {
+			comp.WriteString(" AND ")
+		}
+		if err := comp.WritePlaceholder(); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(" = ANY("); err != nil {
+			return err
+		}
+		if err := comp.WriteAlias(id); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(TableCompleteColumnColumnIntegerArray100); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(")"); err != nil {
+			return err
+		}
+		comp.Add(c.ColumnIntegerArray100Any)
+		comp.Dirty = true
+	}
+	if c.ColumnIntegerBig.Valid {
+		if comp.Dirty {
+			comp.WriteString(" AND ")
+		}
+		if err := comp.WriteAlias(id); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(TableCompleteColumnColumnIntegerBig); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString("="); err != nil {
+			return err
+		}
+		if err := comp.WritePlaceholder(); err != nil {
+			return err
+		}
+		comp.Add(c.ColumnIntegerBig)
+		comp.Dirty = true
+	}
+	if c.ColumnIntegerBigArray0.Valid {
+		if comp.Dirty {
+			comp.WriteString(" AND ")
+		}
+		if err := comp.WriteAlias(id); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(TableCompleteColumnColumnIntegerBigArray0); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString("="); err != nil {
+			return err
+		}
+		if err := comp.WritePlaceholder(); err != nil {
+			return err
+		}
+		comp.Add(c.ColumnIntegerBigArray0)
+		comp.Dirty = true
+	}
+	if c.ColumnIntegerBigArray0Contains.Valid {
+		if comp.Dirty {
+			comp.WriteString(" AND ")
+		}
+		if err := comp.WriteAlias(id); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(TableCompleteColumnColumnIntegerBigArray0); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(" @> "); err != nil {
+			return err
+		}
+		if err := comp.WritePlaceholder(); err != nil {
+			return err
+		}
+		comp.Add(c.ColumnIntegerBigArray0Contains)
+		comp.Dirty = true
+	}
+	if c.ColumnIntegerBigArray0Overlap.Valid {
+		if comp.Dirty {
+			comp.WriteString(" AND ")
+		}
+		if err := comp.WriteAlias(id); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(TableCompleteColumnColumnIntegerBigArray0); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(" && "); err != nil {
+			return err
+		}
+		if err := comp.WritePlaceholder(); err != nil {
+			return err
+		}
+		comp.Add(c.ColumnIntegerBigArray0Overlap)
+		comp.Dirty = true
+	}
+	if c.ColumnIntegerBigArray0Any.Valid {
+		if comp.Dirty {
+			comp.WriteString(" AND ")
+		}
+		if err := comp.WritePlaceholder(); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(" = ANY("); err != nil {
+			return err
+		}
+		if err := comp.WriteAlias(id); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(TableCompleteColumnColumnIntegerBigArray0); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(")"); err != nil {
+			return err
+		}
+		comp.Add(c.ColumnIntegerBigArray0Any)
+		comp.Dirty = true
+	}
+	if c.ColumnIntegerBigArray100.Valid {
+		if comp.Dirty {
+			comp.WriteString(" AND ")
+		}
+		if err := comp.WriteAlias(id); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(TableCompleteColumnColumnIntegerBigArray100); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString("="); err != nil {
+			return err
+		}
+		if err := comp.WritePlaceholder(); err != nil {
+			return err
+		}
+		comp.Add(c.ColumnIntegerBigArray100)
+		comp.Dirty = true
+	}
+	if c.ColumnIntegerBigArray100Contains.Valid {
+		if comp.Dirty {
+			comp.WriteString(" AND ")
+		}
+		if err := comp.WriteAlias(id); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(TableCompleteColumnColumnIntegerBigArray100); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(" @> "); err != nil {
+			return err
+		}
+		if err := comp.WritePlaceholder(); err != nil {
+			return err
+		}
+		comp.Add(c.ColumnIntegerBigArray100Contains)
+		comp.Dirty = true
+	}
+	if c.ColumnIntegerBigArray100Overlap.Valid {
+		if comp.Dirty {
+			comp.WriteString(" AND ")
+		}
+		if err := comp.WriteAlias(id); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(TableCompleteColumnColumnIntegerBigArray100); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(" && "); err != nil {
+			return err
+		}
+		if err := comp.WritePlaceholder(); err != nil {
+			return err
+		}
+		comp.Add(c.ColumnIntegerBigArray100Overlap)
+		comp.Dirty = true
+	}
+	if c.ColumnIntegerBigArray100Any.Valid {
+		if comp.Dirty {
+			comp.WriteString(" AND ")
+		}
+		if err := comp.WritePlaceholder(); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(" = ANY("); err != nil {
+			return err
+		}
+		if err := comp.WriteAlias(id); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(TableCompleteColumnColumnIntegerBigArray100); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(")"); err != nil {
+			return err
+		}
+		comp.Add(c.ColumnIntegerBigArray100Any)
+		comp.Dirty = true
+	}
+	if c.ColumnIntegerBigRange.Valid {
+		if comp.Dirty {
+			comp.WriteString(" AND ")
+		}
+		if err := comp.WriteAlias(id); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(TableCompleteColumnColumnIntegerBigRange); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString("="); err != nil {
+			return err
+		}
+		if err := comp.WritePlaceholder(); err != nil {
+			return err
+		}
+		comp.Add(c.ColumnIntegerBigRange)
+		comp.Dirty = true
+	}
+	if c.ColumnIntegerSmall != nil {
+		if comp.Dirty {
+			comp.WriteString(" AND ")
+		}
+		if err := comp.WriteAlias(id); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(TableCompleteColumnColumnIntegerSmall); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString("="); err != nil {
+			return err
+		}
+		if err := comp.WritePlaceholder(); err != nil {
+			return err
+		}
+		comp.Add(c.ColumnIntegerSmall)
+		comp.Dirty = true
+	}
+	if c.ColumnIntegerSmallArray0.Valid {
+		if comp.Dirty {
+			comp.WriteString(" AND ")
+		}
+		if err := comp.WriteAlias(id); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(TableCompleteColumnColumnIntegerSmallArray0); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString("="); err != nil {
+			return err
+		}
+		if err := comp.WritePlaceholder(); err != nil {
+			return err
+		}
+		comp.Add(c.ColumnIntegerSmallArray0)
+		comp.Dirty = true
+	}
+	if c.ColumnIntegerSmallArray0Contains.Valid {
+		if comp.Dirty {
+			comp.WriteString(" AND ")
+		}
+		if err := comp.WriteAlias(id); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(TableCompleteColumnColumnIntegerSmallArray0); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(" @> "); err != nil {
+			return err
+		}
+		if err := comp.WritePlaceholder(); err != nil {
+			return err
+		}
+		comp.Add(c.ColumnIntegerSmallArray0Contains)
+		comp.Dirty = true
+	}
+	if c.ColumnIntegerSmallArray0Overlap.Valid {
+		if comp.Dirty {
+			comp.WriteString(" AND ")
+		}
+		if err := comp.WriteAlias(id); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(TableCompleteColumnColumnIntegerSmallArray0); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(" && "); err != nil {
+			return err
+		}
+		if err := comp.WritePlaceholder(); err != nil {
+			return err
+		}
+		comp.Add(c.ColumnIntegerSmallArray0Overlap)
+		comp.Dirty = true
+	}
+	if c.ColumnIntegerSmallArray0Any.Valid {
+		if comp.Dirty {
+			comp.WriteString(" AND ")
+		}
+		if err := comp.WritePlaceholder(); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(" = ANY("); err != nil {
+			return err
+		}
+		if err := comp.WriteAlias(id); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(TableCompleteColumnColumnIntegerSmallArray0); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(")"); err != nil {
+			return err
+		}
+		comp.Add(c.ColumnIntegerSmallArray0Any)
+		comp.Dirty = true
+	}
+	if c.ColumnIntegerSmallArray100.Valid {
+		if comp.Dirty {
+			comp.WriteString(" AND ")
+		}
+		if err := comp.WriteAlias(id); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(TableCompleteColumnColumnIntegerSmallArray100); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString("="); err != nil {
+			return err
+		}
+		if err := comp.WritePlaceholder(); err != nil {
+			return err
+		}
+		comp.Add(c.ColumnIntegerSmallArray100)
+		comp.Dirty = true
+	}
+	if c.ColumnIntegerSmallArray100Contains.Valid {
+		if comp.Dirty {
+			comp.WriteString(" AND ")
+		}
+		if err := comp.WriteAlias(id); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(TableCompleteColumnColumnIntegerSmallArray100); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(" @> "); err != nil {
+			return err
+		}
+		if err := comp.WritePlaceholder(); err != nil {
+			return err
+		}
+		comp.Add(c.ColumnIntegerSmallArray100Contains)
+		comp.Dirty = true
+	}
+	if c.ColumnIntegerSmallArray100Overlap.Valid {
+		if comp.Dirty {
+			comp.WriteString(" AND ")
+		}
+		if err := comp.WriteAlias(id); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(TableCompleteColumnColumnIntegerSmallArray100); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(" && "); err != nil {
+			return err
+		}
+		if err := comp.WritePlaceholder(); err != nil {
+			return err
+		}
+		comp.Add(c.ColumnIntegerSmallArray100Overlap)
+		comp.Dirty = true
+	}
+	if c.ColumnIntegerSmallArray100Any.Valid {
+		if comp.Dirty {
+			comp.WriteString(" AND ")
+		}
+		if err := comp.WritePlaceholder(); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(" = ANY("); err != nil {
+			return err
+		}
+		if err := comp.WriteAlias(id); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(TableCompleteColumnColumnIntegerSmallArray100); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(")"); err != nil {
+			return err
+		}
+		comp.Add(c.ColumnIntegerSmallArray100Any)
+		comp.Dirty = true
+	}
+	if c.ColumnInterval.Valid {
+		if comp.Dirty {
+			comp.WriteString(" AND ")
+		}
+		if err := comp.WriteAlias(id); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(TableCompleteColumnColumnInterval); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString("="); err != nil {
+			return err
+		}
+		if err := comp.WritePlaceholder(); err != nil {
+			return err
+		}
+		comp.Add(c.ColumnInterval)
+		comp.Dirty = true
+	}
+	if c.ColumnJson != nil {
+		if comp.Dirty {
+			comp.WriteString(" AND ")
+		}
+		if err := comp.WriteAlias(id); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(TableCompleteColumnColumnJson); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString("="); err != nil {
+			return err
+		}
+		if err := comp.WritePlaceholder(); err != nil {
+			return err
+		}
+		comp.Add(c.ColumnJson)
+		comp.Dirty = true
+	}
+	if c.ColumnJsonNn != nil {
+		if comp.Dirty {
+			comp.WriteString(" AND ")
+		}
+		if err := comp.WriteAlias(id); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(TableCompleteColumnColumnJsonNn); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString("="); err != nil {
+			return err
+		}
+		if err := comp.WritePlaceholder(); err != nil {
+			return err
+		}
+		comp.Add(c.ColumnJsonNn)
+		comp.Dirty = true
+	}
+	if c.ColumnJsonNnD != nil {
+		if comp.Dirty {
+			comp.WriteString(" AND ")
+		}
+		if err := comp.WriteAlias(id); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(TableCompleteColumnColumnJsonNnD); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString("="); err != nil {
+			return err
+		}
+		if err := comp.WritePlaceholder(); err != nil {
+			return err
+		}
+		comp.Add(c.ColumnJsonNnD)
+		comp.Dirty = true
+	}
+	if c.ColumnJsonb != nil {
+		if comp.Dirty {
+			comp.WriteString(" AND ")
+		}
+		if err := comp.WriteAlias(id); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(TableCompleteColumnColumnJsonb); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString("="); err != nil {
+			return err
+		}
+		if err := comp.WritePlaceholder(); err != nil {
+			return err
+		}
+		comp.Add(c.ColumnJsonb)
+		comp.Dirty = true
+	}
+	if c.ColumnJsonbContains != nil {
+		if comp.Dirty {
+			comp.WriteString(" AND ")
+		}
+		if err := comp.WriteAlias(id); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(TableCompleteColumnColumnJsonb); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(" @> "); err != nil {
+			return err
+		}
+		if err := comp.WritePlaceholder(); err != nil {
+			return err
+		}
+		comp.Add(c.ColumnJsonbContains)
+		comp.Dirty = true
+	}
+	if c.ColumnJsonbHasKey.Valid {
+		if comp.Dirty {
+			comp.WriteString(" AND ")
+		}
+		if err := comp.WriteAlias(id); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(TableCompleteColumnColumnJsonb); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(" ? "); err != nil {
+			return err
+		}
+		if err := comp.WritePlaceholder(); err != nil {
+			return err
+		}
+		comp.Add(c.ColumnJsonbHasKey)
+		comp.Dirty = true
+	}
+	if len(c.ColumnJsonbHasAnyKeys) > 0 {
+		if comp.Dirty {
+			comp.WriteString(" AND ")
+		}
+		if err := comp.WriteAlias(id); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(TableCompleteColumnColumnJsonb); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(" ?| "); err != nil {
+			return err
+		}
+		if err := comp.WritePlaceholder(); err != nil {
+			return err
+		}
+		comp.Add(c.ColumnJsonbHasAnyKeys)
+		comp.Dirty = true
+	}
+	if len(c.ColumnJsonbHasAllKeys) > 0 {
+		if comp.Dirty {
+			comp.WriteString(" AND ")
+		}
+		if err := comp.WriteAlias(id); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(TableCompleteColumnColumnJsonb); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(" ?& "); err != nil {
+			return err
+		}
+		if err := comp.WritePlaceholder(); err != nil {
+			return err
+		}
+		comp.Add(c.ColumnJsonbHasAllKeys)
+		comp.Dirty = true
+	}
+	if c.ColumnJsonbMatch.Valid {
+		if comp.Dirty {
+			comp.WriteString(" AND ")
+		}
+		if err := comp.WriteAlias(id); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(TableCompleteColumnColumnJsonb); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(" @@ "); err != nil {
+			return err
+		}
+		if err := comp.WritePlaceholder(); err != nil {
+			return err
+		}
+		comp.Add(c.ColumnJsonbMatch)
+		comp.Dirty = true
+	}
+	if c.ColumnJsonbExists.Valid {
+		if comp.Dirty {
+			comp.WriteString(" AND ")
+		}
+		if err := comp.WriteAlias(id); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(TableCompleteColumnColumnJsonb); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(" @? "); err != nil {
+			return err
+		}
+		if err := comp.WritePlaceholder(); err != nil {
+			return err
+		}
+		comp.Add(c.ColumnJsonbExists)
+		comp.Dirty = true
+	}
+	for _, p := range c.ColumnJsonbPath {
+		if comp.Dirty {
+			comp.WriteString(" AND ")
+		}
+		if err := jsonPredicateClause(comp, TableCompleteColumnColumnJsonb, id, p); err != nil {
+			return err
+		}
+		comp.Dirty = true
+	}
+	if c.ColumnJsonbNn != nil {
+		if comp.Dirty {
+			comp.WriteString(" AND ")
+		}
+		if err := comp.WriteAlias(id); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(TableCompleteColumnColumnJsonbNn); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString("="); err != nil {
+			return err
+		}
+		if err := comp.WritePlaceholder(); err != nil {
+			return err
+		}
+		comp.Add(c.ColumnJsonbNn)
+		comp.Dirty = true
+	}
+	if c.ColumnJsonbNnContains != nil {
+		if comp.Dirty {
+			comp.WriteString(" AND ")
+		}
+		if err := comp.WriteAlias(id); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(TableCompleteColumnColumnJsonbNn); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(" @> "); err != nil {
+			return err
+		}
+		if err := comp.WritePlaceholder(); err != nil {
+			return err
+		}
+		comp.Add(c.ColumnJsonbNnContains)
+		comp.Dirty = true
+	}
+	if c.ColumnJsonbNnHasKey.Valid {
+		if comp.Dirty {
+			comp.WriteString(" AND ")
+		}
+		if err := comp.WriteAlias(id); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(TableCompleteColumnColumnJsonbNn); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(" ? "); err != nil {
+			return err
+		}
+		if err := comp.WritePlaceholder(); err != nil {
+			return err
+		}
+		comp.Add(c.ColumnJsonbNnHasKey)
+		comp.Dirty = true
+	}
+	if len(c.ColumnJsonbNnHasAnyKeys) > 0 {
+		if comp.Dirty {
+			comp.WriteString(" AND ")
+		}
+		if err := comp.WriteAlias(id); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(TableCompleteColumnColumnJsonbNn); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(" ?| "); err != nil {
+			return err
+		}
+		if err := comp.WritePlaceholder(); err != nil {
+			return err
+		}
+		comp.Add(c.ColumnJsonbNnHasAnyKeys)
+		comp.Dirty = true
+	}
+	if len(c.ColumnJsonbNnHasAllKeys) > 0 {
+		if comp.Dirty {
+			comp.WriteString(" AND ")
+		}
+		if err := comp.WriteAlias(id); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(TableCompleteColumnColumnJsonbNn); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(" ?& "); err != nil {
+			return err
+		}
+		if err := comp.WritePlaceholder(); err != nil {
+			return err
+		}
+		comp.Add(c.ColumnJsonbNnHasAllKeys)
+		comp.Dirty = true
+	}
+	if c.ColumnJsonbNnMatch.Valid {
+		if comp.Dirty {
+			comp.WriteString(" AND ")
+		}
+		if err := comp.WriteAlias(id); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(TableCompleteColumnColumnJsonbNn); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(" @@ "); err != nil {
+			return err
+		}
+		if err := comp.WritePlaceholder(); err != nil {
+			return err
+		}
+		comp.Add(c.ColumnJsonbNnMatch)
+		comp.Dirty = true
+	}
+	if c.ColumnJsonbNnExists.Valid {
+		if comp.Dirty {
+			comp.WriteString(" AND ")
+		}
+		if err := comp.WriteAlias(id); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(TableCompleteColumnColumnJsonbNn); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(" @? "); err != nil {
+			return err
+		}
+		if err := comp.WritePlaceholder(); err != nil {
+			return err
+		}
+		comp.Add(c.ColumnJsonbNnExists)
+		comp.Dirty = true
+	}
+	for _, p := range c.ColumnJsonbNnPath {
+		if comp.Dirty {
+			comp.WriteString(" AND ")
+		}
+		if err := jsonPredicateClause(comp, TableCompleteColumnColumnJsonbNn, id, p); err != nil {
+			return err
+		}
+		comp.Dirty = true
+	}
+	if c.ColumnJsonbNnD != nil {
+		if comp.Dirty {
+			comp.WriteString(" AND ")
+		}
+		if err := comp.WriteAlias(id); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(TableCompleteColumnColumnJsonbNnD); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString("="); err != nil {
+			return err
+		}
+		if err := comp.WritePlaceholder(); err != nil {
+			return err
+		}
+		comp.Add(c.ColumnJsonbNnD)
+		comp.Dirty = true
+	}
+	if c.ColumnJsonbNnDContains != nil {
+		if comp.Dirty {
+			comp.WriteString(" AND ")
+		}
+		if err := comp.WriteAlias(id); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(TableCompleteColumnColumnJsonbNnD); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(" @> "); err != nil {
+			return err
+		}
+		if err := comp.WritePlaceholder(); err != nil {
+			return err
+		}
+		comp.Add(c.ColumnJsonbNnDContains)
+		comp.Dirty = true
+	}
+	if c.ColumnJsonbNnDHasKey.Valid {
+		if comp.Dirty {
+			comp.WriteString(" AND ")
+		}
+		if err := comp.WriteAlias(id); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(TableCompleteColumnColumnJsonbNnD); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(" ? "); err != nil {
+			return err
+		}
+		if err := comp.WritePlaceholder(); err != nil {
+			return err
+		}
+		comp.Add(c.ColumnJsonbNnDHasKey)
+		comp.Dirty = true
+	}
+	if len(c.ColumnJsonbNnDHasAnyKeys) > 0 {
+		if comp.Dirty {
+			comp.WriteString(" AND ")
+		}
+		if err := comp.WriteAlias(id); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(TableCompleteColumnColumnJsonbNnD); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(" ?| "); err != nil {
+			return err
+		}
+		if err := comp.WritePlaceholder(); err != nil {
+			return err
+		}
+		comp.Add(c.ColumnJsonbNnDHasAnyKeys)
+		comp.Dirty = true
+	}
+	if len(c.ColumnJsonbNnDHasAllKeys) > 0 {
+		if comp.Dirty {
+			comp.WriteString(" AND ")
+		}
+		if err := comp.WriteAlias(id); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(TableCompleteColumnColumnJsonbNnD); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(" ?& "); err != nil {
+			return err
+		}
+		if err := comp.WritePlaceholder(); err != nil {
+			return err
+		}
+		comp.Add(c.ColumnJsonbNnDHasAllKeys)
+		comp.Dirty = true
+	}
+	if c.ColumnJsonbNnDMatch.Valid {
+		if comp.Dirty {
+			comp.WriteString(" AND ")
+		}
+		if err := comp.WriteAlias(id); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(TableCompleteColumnColumnJsonbNnD); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(" @@ "); err != nil {
+			return err
+		}
+		if err := comp.WritePlaceholder(); err != nil {
+			return err
+		}
+		comp.Add(c.ColumnJsonbNnDMatch)
+		comp.Dirty = true
+	}
+	if c.ColumnJsonbNnDExists.Valid {
+		if comp.Dirty {
+			comp.WriteString(" AND ")
+		}
+		if err := comp.WriteAlias(id); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(TableCompleteColumnColumnJsonbNnD); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(" @? "); err != nil {
+			return err
+		}
+		if err := comp.WritePlaceholder(); err != nil {
+			return err
+		}
+		comp.Add(c.ColumnJsonbNnDExists)
+		comp.Dirty = true
+	}
+	for _, p := range c.ColumnJsonbNnDPath {
+		if comp.Dirty {
+			comp.WriteString(" AND ")
+		}
+		if err := jsonPredicateClause(comp, TableCompleteColumnColumnJsonbNnD, id, p); err != nil {
+			return err
+		}
+		comp.Dirty = true
+	}
+	if c.ColumnNumeric.Valid {
+		if comp.Dirty {
+			comp.WriteString(" AND ")
+		}
+		if err := comp.WriteAlias(id); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(TableCompleteColumnColumnNumeric); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString("="); err != nil {
+			return err
+		}
+		if err := comp.WritePlaceholder(); err != nil {
+			return err
+		}
+		comp.Add(c.ColumnNumeric)
+		comp.Dirty = true
+	}
+	if c.ColumnNumericRange.Valid {
+		if comp.Dirty {
+			comp.WriteString(" AND ")
+		}
+		if err := comp.WriteAlias(id); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(TableCompleteColumnColumnNumericRange); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString("="); err != nil {
+			return err
+		}
+		if err := comp.WritePlaceholder(); err != nil {
+			return err
+		}
+		comp.Add(c.ColumnNumericRange)
+		comp.Dirty = true
+	}
+	if c.ColumnPoint.Valid {
+		if comp.Dirty {
+			comp.WriteString(" AND ")
+		}
+		if err := comp.WriteAlias(id); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(TableCompleteColumnColumnPoint); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString("="); err != nil {
+			return err
+		}
+		if err := comp.WritePlaceholder(); err != nil {
+			return err
+		}
+		comp.Add(c.ColumnPoint)
+		comp.Dirty = true
+	}
+	if c.ColumnReal != nil {
+		if comp.Dirty {
+			comp.WriteString(" AND ")
+		}
+		if err := comp.WriteAlias(id); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(TableCompleteColumnColumnReal); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString("="); err != nil {
+			return err
+		}
+		if err := comp.WritePlaceholder(); err != nil {
+			return err
+		}
+		comp.Add(c.ColumnReal)
+		comp.Dirty = true
+	}
+	if c.ColumnSerial != nil {
+		if comp.Dirty {
+			comp.WriteString(" AND ")
+		}
+		if err := comp.WriteAlias(id); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(TableCompleteColumnColumnSerial); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString("="); err != nil {
+			return err
+		}
+		if err := comp.WritePlaceholder(); err != nil {
+			return err
+		}
+		comp.Add(c.ColumnSerial)
+		comp.Dirty = true
+	}
+	// column_serial_big is an empty struct, ignore
+
+	if c.ColumnSerialSmall != nil {
+		if comp.Dirty {
+			comp.WriteString(" AND ")
+		}
+		if err := comp.WriteAlias(id); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(TableCompleteColumnColumnSerialSmall); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString("="); err != nil {
+			return err
+		}
+		if err := comp.WritePlaceholder(); err != nil {
+			return err
+		}
+		comp.Add(c.ColumnSerialSmall)
+		comp.Dirty = true
+	}
+	if c.ColumnText.Valid {
+		if comp.Dirty {
+			comp.WriteString(" AND ")
+		}
+		if err := comp.WriteAlias(id); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(TableCompleteColumnColumnText); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString("="); err != nil {
+			return err
+		}
+		if err := comp.WritePlaceholder(); err != nil {
+			return err
+		}
+		comp.Add(c.ColumnText)
+		comp.Dirty = true
+	}
+	if c.ColumnTextArray0.Valid {
+		if comp.Dirty {
+			comp.WriteString(" AND ")
+		}
+		if err := comp.WriteAlias(id); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(TableCompleteColumnColumnTextArray0); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString("="); err != nil {
+			return err
+		}
+		if err := comp.WritePlaceholder(); err != nil {
+			return err
+		}
+		comp.Add(c.ColumnTextArray0)
+		comp.Dirty = true
+	}
+	if c.ColumnTextArray0Contains.Valid {
+		if comp.Dirty {
+			comp.WriteString(" AND ")
+		}
+		if err := comp.WriteAlias(id); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(TableCompleteColumnColumnTextArray0); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(" @> "); err != nil {
+			return err
+		}
+		if err := comp.WritePlaceholder(); err != nil {
+			return err
+		}
+		comp.Add(c.ColumnTextArray0Contains)
+		comp.Dirty = true
+	}
+	if c.ColumnTextArray0Overlap.Valid {
+		if comp.Dirty {
+			comp.WriteString(" AND ")
+		}
+		if err := comp.WriteAlias(id); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(TableCompleteColumnColumnTextArray0); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(" && "); err != nil {
+			return err
+		}
+		if err := comp.WritePlaceholder(); err != nil {
+			return err
+		}
+		comp.Add(c.ColumnTextArray0Overlap)
+		comp.Dirty = true
+	}
+	if c.ColumnTextArray0Any.Valid {
+		if comp.Dirty {
+			comp.WriteString(" AND ")
+		}
+		if err := comp.WritePlaceholder(); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(" = ANY("); err != nil {
+			return err
+		}
+		if err := comp.WriteAlias(id); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(TableCompleteColumnColumnTextArray0); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(")"); err != nil {
+			return err
+		}
+		comp.Add(c.ColumnTextArray0Any)
+		comp.Dirty = true
+	}
+	if c.ColumnTextArray100.Valid {
+		if comp.Dirty {
+			comp.WriteString(" AND ")
+		}
+		if err := comp.WriteAlias(id); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(TableCompleteColumnColumnTextArray100); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString("="); err != nil {
+			return err
+		}
+		if err := comp.WritePlaceholder(); err != nil {
+			return err
+		}
+		comp.Add(c.ColumnTextArray100)
+		comp.Dirty = true
+	}
+	if c.ColumnTextArray100Contains.Valid {
+		if comp.Dirty {
+			comp.WriteString(" AND ")
+		}
+		if err := comp.WriteAlias(id); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(TableCompleteColumnColumnTextArray100); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(" @> "); err != nil {
+			return err
+		}
+		if err := comp.WritePlaceholder(); err != nil {
+			return err
+		}
+		comp.Add(c.ColumnTextArray100Contains)
+		comp.Dirty = true
+	}
+	if c.ColumnTextArray100Overlap.Valid {
+		if comp.Dirty {
+			comp.WriteString(" AND ")
+		}
+		if err := comp.WriteAlias(id); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(TableCompleteColumnColumnTextArray100); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(" && "); err != nil {
+			return err
+		}
+		if err := comp.WritePlaceholder(); err != nil {
+			return err
+		}
+		comp.Add(c.ColumnTextArray100Overlap)
+		comp.Dirty = true
+	}
+	if c.ColumnTextArray100Any.Valid {
+		if comp.Dirty {
+			comp.WriteString(" AND ")
+		}
+		if err := comp.WritePlaceholder(); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(" = ANY("); err != nil {
+			return err
+		}
+		if err := comp.WriteAlias(id); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(TableCompleteColumnColumnTextArray100); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(")"); err != nil {
+			return err
+		}
+		comp.Add(c.ColumnTextArray100Any)
+		comp.Dirty = true
+	}
+	if c.ColumnTime.Valid {
+		if comp.Dirty {
+			comp.WriteString(" AND ")
+		}
+		if err := comp.WriteAlias(id); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(TableCompleteColumnColumnTime); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString("="); err != nil {
+			return err
+		}
+		if err := comp.WritePlaceholder(); err != nil {
+			return err
+		}
+		comp.Add(c.ColumnTime)
+		comp.Dirty = true
+	}
+	if c.ColumnTimestamp.Valid {
+		if comp.Dirty {
+			comp.WriteString(" AND ")
+		}
+		if err := comp.WriteAlias(id); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(TableCompleteColumnColumnTimestamp); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString("="); err != nil {
+			return err
+		}
+		if err := comp.WritePlaceholder(); err != nil {
+			return err
+		}
+		comp.Add(c.ColumnTimestamp)
+		comp.Dirty = true
+	}
+	if c.ColumnTimestamptz.Valid {
+		if comp.Dirty {
+			comp.WriteString(" AND ")
+		}
+		if err := comp.WriteAlias(id); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(TableCompleteColumnColumnTimestamptz); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString("="); err != nil {
+			return err
+		}
+		if err := comp.WritePlaceholder(); err != nil {
+			return err
+		}
+		comp.Add(c.ColumnTimestamptz)
+		comp.Dirty = true
+	}
+	if c.ColumnTimestamptzArray.Valid {
+		if comp.Dirty {
+			comp.WriteString(" AND ")
+		}
+		if err := comp.WriteAlias(id); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(TableCompleteColumnColumnTimestamptzArray); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString("="); err != nil {
+			return err
+		}
+		if err := comp.WritePlaceholder(); err != nil {
+			return err
+		}
+		comp.Add(c.ColumnTimestamptzArray)
+		comp.Dirty = true
+	}
+	if c.ColumnTimestamptzArrayContains.Valid {
+		if comp.Dirty {
+			comp.WriteString(" AND ")
+		}
+		if err := comp.WriteAlias(id); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(TableCompleteColumnColumnTimestamptzArray); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(" @> "); err != nil {
+			return err
+		}
+		if err := comp.WritePlaceholder(); err != nil {
+			return err
+		}
+		comp.Add(c.ColumnTimestamptzArrayContains)
+		comp.Dirty = true
+	}
+	if c.ColumnTimestamptzArrayOverlap.Valid {
+		if comp.Dirty {
+			comp.WriteString(" AND ")
+		}
+		if err := comp.WriteAlias(id); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(TableCompleteColumnColumnTimestamptzArray); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(" && "); err != nil {
+			return err
+		}
+		if err := comp.WritePlaceholder(); err != nil {
+			return err
+		}
+		comp.Add(c.ColumnTimestamptzArrayOverlap)
+		comp.Dirty = true
+	}
+	if c.ColumnTimestamptzArrayAny.Valid {
+		if comp.Dirty {
+			comp.WriteString(" AND ")
+		}
+		if err := comp.WritePlaceholder(); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(" = ANY("); err != nil {
+			return err
+		}
+		if err := comp.WriteAlias(id); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(TableCompleteColumnColumnTimestamptzArray); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(")"); err != nil {
+			return err
+		}
+		comp.Add(c.ColumnTimestamptzArrayAny)
+		comp.Dirty = true
+	}
+	if c.ColumnTimestamptzRange.Valid {
+		if comp.Dirty {
+			comp.WriteString(" AND ")
+		}
+		if err := comp.WriteAlias(id); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(TableCompleteColumnColumnTimestamptzRange); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString("="); err != nil {
+			return err
+		}
+		if err := comp.WritePlaceholder(); err != nil {
+			return err
+		}
+		comp.Add(c.ColumnTimestamptzRange)
+		comp.Dirty = true
+	}
+	if c.ColumnUUID.Valid {
+		if comp.Dirty {
+			comp.WriteString(" AND ")
+		}
+		if err := comp.WriteAlias(id); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(TableCompleteColumnColumnUUID); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString("="); err != nil {
+			return err
+		}
+		if err := comp.WritePlaceholder(); err != nil {
+			return err
+		}
+		comp.Add(c.ColumnUUID)
+		comp.Dirty = true
+	}
+	if c.ColumnUUIDArray.Valid {
+		if comp.Dirty {
+			comp.WriteString(" AND ")
+		}
+		if err := comp.WriteAlias(id); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(TableCompleteColumnColumnUUIDArray); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString("="); err != nil {
+			return err
+		}
+		if err := comp.WritePlaceholder(); err != nil {
+			return err
+		}
+		comp.Add(c.ColumnUUIDArray)
+		comp.Dirty = true
+	}
+	if c.ColumnUUIDArrayContains.Valid {
+		if comp.Dirty {
+			comp.WriteString(" AND ")
+		}
+		if err := comp.WriteAlias(id); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(TableCompleteColumnColumnUUIDArray); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(" @> "); err != nil {
+			return err
+		}
+		if err := comp.WritePlaceholder(); err != nil {
+			return err
+		}
+		comp.Add(c.ColumnUUIDArrayContains)
+		comp.Dirty = true
+	}
+	if c.ColumnUUIDArrayOverlap.Valid {
+		if comp.Dirty {
+			comp.WriteString(" AND ")
+		}
+		if err := comp.WriteAlias(id); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(TableCompleteColumnColumnUUIDArray); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(" && "); err != nil {
+			return err
+		}
+		if err := comp.WritePlaceholder(); err != nil {
+			return err
+		}
+		comp.Add(c.ColumnUUIDArrayOverlap)
+		comp.Dirty = true
+	}
+	if c.ColumnUUIDArrayAny.Valid {
+		if comp.Dirty {
+			comp.WriteString(" AND ")
+		}
+		if err := comp.WritePlaceholder(); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(" = ANY("); err != nil {
+			return err
+		}
+		if err := comp.WriteAlias(id); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(TableCompleteColumnColumnUUIDArray); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(")"); err != nil {
+			return err
+		}
+		comp.Add(c.ColumnUUIDArrayAny)
+		comp.Dirty = true
+	}
+	return nil
+}
+
+type CompleteFindExpr struct {
+	Where         *CompleteCriteria
+	Offset, Limit int64
+	Columns       []CompleteColumn
+	OrderBy       []RowOrder
+	Lock          RowLock
+}
+
+// CompleteColumn is a column of the complete table, one of TableCompleteColumns, that find expression selects.
+type CompleteColumn string
+
+// completeColumnCount selects number of rows instead of columns, it is used by count queries.
+const completeColumnCount CompleteColumn = "COUNT(*)"
+
+// selectExpr returns select list expression of the column, or an error if the table has no such column.
+func (c CompleteColumn) selectExpr() (string, error) {
+	switch c {
+	case completeColumnCount:
+		return string(c), nil
+	case TableCompleteColumnColumnBool:
+		return "t0.column_bool", nil
+	case TableCompleteColumnColumnBoolArray:
+		return "t0.column_bool_array", nil
+	case TableCompleteColumnColumnBytea:
+		return "t0.column_bytea", nil
+	case TableCompleteColumnColumnCharacter0:
+		return "t0.column_character_0", nil
+	case TableCompleteColumnColumnCharacter100:
+		return "t0.column_character_100", nil
+	case TableCompleteColumnColumnDecimal:
+		return "t0.column_decimal", nil
+	case TableCompleteColumnColumnDoubleArray0:
+		return "t0.column_double_array_0", nil
+	case TableCompleteColumnColumnDoubleArray100:
+		return "t0.column_double_array_100", nil
+	case TableCompleteColumnColumnInet:
+		return "t0.column_inet", nil
+	case TableCompleteColumnColumnInteger:
+		return "t0.column_integer", nil
+	case TableCompleteColumnColumnIntegerArray0:
+		return "t0.column_integer_array_0", nil
+	case TableCompleteColumnColumnIntegerArray100:
+		return "t0.column_integer_array_100", nil
+	case TableCompleteColumnColumnIntegerBig:
+		return "t0.column_integer_big", nil
+	case TableCompleteColumnColumnIntegerBigArray0:
+		return "t0.column_integer_big_array_0", nil
+	case TableCompleteColumnColumnIntegerBigArray100:
+		return "t0.column_integer_big_array_100", nil
+	case TableCompleteColumnColumnIntegerBigRange:
+		return "t0.column_integer_big_range", nil
+	case TableCompleteColumnColumnIntegerSmall:
+		return "t0.column_integer_small", nil
+	case TableCompleteColumnColumnIntegerSmallArray0:
+		return "t0.column_integer_small_array_0", nil
+	case TableCompleteColumnColumnIntegerSmallArray100:
+		return "t0.column_integer_small_array_100", nil
+	case TableCompleteColumnColumnInterval:
+		return "t0.column_interval", nil
+	case TableCompleteColumnColumnJson:
+		return "t0.column_json", nil
+	case TableCompleteColumnColumnJsonNn:
+		return "t0.column_json_nn", nil
+	case TableCompleteColumnColumnJsonNnD:
+		return "t0.column_json_nn_d", nil
+	case TableCompleteColumnColumnJsonb:
+		return "t0.column_jsonb", nil
+	case TableCompleteColumnColumnJsonbNn:
+		return "t0.column_jsonb_nn", nil
+	case TableCompleteColumnColumnJsonbNnD:
+		return "t0.column_jsonb_nn_d", nil
+	case TableCompleteColumnColumnNumeric:
+		return "t0.column_numeric", nil
+	case TableCompleteColumnColumnNumericRange:
+		return "t0.column_numeric_range", nil
+	case TableCompleteColumnColumnPoint:
+		return "t0.column_point", nil
+	case TableCompleteColumnColumnReal:
+		return "t0.column_real", nil
+	case TableCompleteColumnColumnSerial:
+		return "t0.column_serial", nil
+	case TableCompleteColumnColumnSerialBig:
+		return "t0.column_serial_big", nil
+	case TableCompleteColumnColumnSerialSmall:
+		return "t0.column_serial_small", nil
+	case TableCompleteColumnColumnText:
+		return "t0.column_text", nil
+	case TableCompleteColumnColumnTextArray0:
+		return "t0.column_text_array_0", nil
+	case TableCompleteColumnColumnTextArray100:
+		return "t0.column_text_array_100", nil
+	case TableCompleteColumnColumnTime:
+		return "t0.column_time", nil
+	case TableCompleteColumnColumnTimestamp:
+		return "t0.column_timestamp", nil
+	case TableCompleteColumnColumnTimestamptz:
+		return "t0.column_timestamptz", nil
+	case TableCompleteColumnColumnTimestamptzArray:
+		return "t0.column_timestamptz_array", nil
+	case TableCompleteColumnColumnTimestamptzRange:
+		return "t0.column_timestamptz_range", nil
+	case TableCompleteColumnColumnUUID:
+		return "t0.column_uuid", nil
+	case TableCompleteColumnColumnUUIDArray:
+		return "t0.column_uuid_array", nil
+	}
+	return "", fmt.Errorf("unexpected column provided: %q", string(c))
+}
+
+func completeColumnNames(cs []CompleteColumn) []string {
+	if len(cs) == 0 {
+		return nil
+	}
+	names := make([]string, 0, len(cs))
+	for _, c := range cs {
+		names = append(names, string(c))
+	}
+	return names
+}
+
+type CompleteJoin struct {
+	On, Where *CompleteCriteria
+	Fetch     bool
+	Kind      JoinType
+}
+
+type CompleteCountExpr struct {
+	Where *CompleteCriteria
+}
+
+type CompletePatch struct {
+	ColumnBool                 sql.NullBool
+	ColumnBoolArray            NullBoolArray
+	ColumnBytea                []byte
+	ColumnCharacter0           sql.NullString
+	ColumnCharacter100         sql.NullString
+	ColumnDecimal              sql.NullFloat64
+	ColumnDoubleArray0         NullFloat64Array
+	ColumnDoubleArray100       NullFloat64Array
+	ColumnInet                 sql.NullString
+	ColumnInteger              *int32
+	ColumnIntegerArray0        NullInt64Array
+	ColumnIntegerArray100      NullInt64Array
+	ColumnIntegerBig           sql.NullInt64
+	ColumnIntegerBigArray0     NullInt64Array
+	ColumnIntegerBigArray100   NullInt64Array
+	ColumnIntegerBigRange      Int64Range
+	ColumnIntegerSmall         *int16
+	ColumnIntegerSmallArray0   NullInt64Array
+	ColumnIntegerSmallArray100 NullInt64Array
+	ColumnInterval             Interval
+	ColumnJson                 []byte
+	ColumnJsonNn               []byte
+	ColumnJsonNnD              []byte
+	ColumnJsonb                []byte
+	// ColumnJsonbSet replaces values at given paths of column_jsonb (jsonb_set).
+	ColumnJsonbSet []JSONSet
+	// ColumnJsonbMerge is concatenated with column_jsonb, top-level keys are overwritten (||).
+	ColumnJsonbMerge []byte
+	// ColumnJsonbRemove lists top-level keys removed from column_jsonb (-).
+	ColumnJsonbRemove pq.StringArray
+	ColumnJsonbNn     []byte
+	// ColumnJsonbNnSet replaces values at given paths of column_jsonb_nn (jsonb_set).
+	ColumnJsonbNnSet []JSONSet
+	// ColumnJsonbNnMerge is concatenated with column_jsonb_nn, top-level keys are overwritten (||).
+	ColumnJsonbNnMerge []byte
+	// ColumnJsonbNnRemove lists top-level keys removed from column_jsonb_nn (-).
+	ColumnJsonbNnRemove pq.StringArray
+	ColumnJsonbNnD      []byte
+	// ColumnJsonbNnDSet replaces values at given paths of column_jsonb_nn_d (jsonb_set).
+	ColumnJsonbNnDSet []JSONSet
+	// ColumnJsonbNnDMerge is concatenated with column_jsonb_nn_d, top-level keys are overwritten (||).
+	ColumnJsonbNnDMerge []byte
+	// ColumnJsonbNnDRemove lists top-level keys removed from column_jsonb_nn_d (-).
+	ColumnJsonbNnDRemove   pq.StringArray
+	ColumnNumeric          sql.NullFloat64
+	ColumnNumericRange     Float64Range
+	ColumnPoint            Point
+	ColumnReal             *float32
+	ColumnSerial           *int32
+	ColumnSerialBig        sql.NullInt64
+	ColumnSerialSmall      *int16
+	ColumnText             sql.NullString
+	ColumnTextArray0       NullStringArray
+	ColumnTextArray100     NullStringArray
+	ColumnTime             pq.NullTime
+	ColumnTimestamp        pq.NullTime
+	ColumnTimestamptz      pq.NullTime
+	ColumnTimestamptzArray NullTimeArray
+	ColumnTimestamptzRange TimeRange
+	ColumnUUID             sql.NullString
+	ColumnUUIDArray        NullStringArray
+}
+
+// Validate checks if values set by the patch meet length, precision, enum, array size and simple check constraints of the table.
+// It returns ValidationError that lists all violations, if any.
+func (p *CompletePatch) Validate() error {
+	var errs ValidationError
+	if p.ColumnCharacter100.Valid {
+		if utf8.RuneCountInString(p.ColumnCharacter100.String) > 100 {
+			errs = append(errs, &FieldError{Field: "ColumnCharacter100", Column: TableCompleteColumnColumnCharacter100, Reason: "is longer than 100 characters"})
+		}
+	}
+	if p.ColumnDecimal.Valid {
+		if !numericFits(p.ColumnDecimal.Float64, 20, 8) {
+			errs = append(errs, &FieldError{Field: "ColumnDecimal", Column: TableCompleteColumnColumnDecimal, Reason: "does not fit numeric(20,8)"})
+		}
+	}
+	if p.ColumnDoubleArray100.Valid {
+		if len(p.ColumnDoubleArray100.Float64Array) > 100 {
+			errs = append(errs, &FieldError{Field: "ColumnDoubleArray100", Column: TableCompleteColumnColumnDoubleArray100, Reason: "has more than 100 elements"})
+		}
+	}
+	if p.ColumnIntegerArray100.Valid {
+		if len(p.ColumnIntegerArray100.Int64Array) > 100 {
+			errs = append(errs, &FieldError{Field: "ColumnIntegerArray100", Column: TableCompleteColumnColumnIntegerArray100, Reason: "has more than 100 elements"})
+		}
+	}
+	if p.ColumnIntegerBigArray100.Valid {
+		if len(p.ColumnIntegerBigArray100.Int64Array) > 100 {
+			errs = append(errs, &FieldError{Field: "ColumnIntegerBigArray100", Column: TableCompleteColumnColumnIntegerBigArray100, Reason: "has more than 100 elements"})
+		}
+	}
+	if p.ColumnIntegerSmallArray100.Valid {
+		if len(p.ColumnIntegerSmallArray100.Int64Array) > 100 {
+			errs = append(errs, &FieldError{Field: "ColumnIntegerSmallArray100", Column: TableCompleteColumnColumnIntegerSmallArray100, Reason: "has more than 100 elements"})
+		}
+	}
+	if p.ColumnNumeric.Valid {
+		if !numericFits(p.ColumnNumeric.Float64, 20, 8) {
+			errs = append(errs, &FieldError{Field: "ColumnNumeric", Column: TableCompleteColumnColumnNumeric, Reason: "does not fit numeric(20,8)"})
+		}
+	}
+	if p.ColumnTextArray100.Valid {
+		if len(p.ColumnTextArray100.StringArray) > 100 {
+			errs = append(errs, &FieldError{Field: "ColumnTextArray100", Column: TableCompleteColumnColumnTextArray100, Reason: "has more than 100 elements"})
+		}
+	}
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+type CompleteRepositoryBase struct {
+	Table   string
+	Columns []string
+	DB      *sql.DB
+	Log     LogFunc
+	// Validate, if true, makes insert, update and upsert methods validate entities and patches before querying the database.
+	Validate bool
+	// StmtCache, if set, is used to prepare queries of fixed shape (insert, delete and find by key) once and reuse them.
+	StmtCache *StmtCache
+}
+
+func (r *CompleteRepositoryBase) Tx(tx *sql.Tx) (*CompleteRepositoryBaseTx, error) {
+	return &CompleteRepositoryBaseTx{
+		base: r,
+		tx:   tx,
+	}, nil
+}
+
+func (r *CompleteRepositoryBase) BeginTx(ctx context.Context, opts ...TxOption) (*CompleteRepositoryBaseTx, error) {
+	tx, err := r.DB.BeginTx(ctx, newTxOptions(opts).sql())
+	if err != nil {
+		return nil, err
+	}
+	return r.Tx(tx)
+}
+
+func (r CompleteRepositoryBase) RunInTransaction(ctx context.Context, fn func(rtx *CompleteRepositoryBaseTx) error, attempts int, opts ...TxOption) (err error) {
+	return RunInTransaction(r.DB, ctx, func(tx *sql.Tx) error {
+		rtx, err := r.Tx(tx)
+		if err != nil {
+			return err
+		}
+		return fn(rtx)
+	}, attempts, opts...)
+}
+
+func (r *CompleteRepositoryBase) InsertQuery(e *CompleteEntity, read bool) (string, []interface{}, error) {
+	if r.Validate {
+		if err := e.Validate(); err != nil {
+			return "", nil, err
+		}
+	}
+	insert := NewComposer(43)
+	columns := bytes.NewBuffer(nil)
+	buf := bytes.NewBufferString("INSERT INTO ")
+	buf.WriteString(r.Table)
+
+	if e.ColumnBool.Valid {
+		if columns.Len() > 0 {
+			if _, err := columns.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := columns.WriteString(TableCompleteColumnColumnBool); err != nil {
+			return "", nil, err
+		}
+		if insert.Dirty {
+			if _, err := insert.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if err := insert.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		insert.Add(e.ColumnBool)
+		insert.Dirty = true
+	}
+
+	if e.ColumnBoolArray.Valid {
+		if columns.Len() > 0 {
+			if _, err := columns.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := columns.WriteString(TableCompleteColumnColumnBoolArray); err != nil {
+			return "", nil, err
+		}
+		if insert.Dirty {
+			if _, err := insert.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if err := insert.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		insert.Add(e.ColumnBoolArray)
+		insert.Dirty = true
+	}
+
+	if e.ColumnBytea != nil {
+		if columns.Len() > 0 {
+			if _, err := columns.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := columns.WriteString(TableCompleteColumnColumnBytea); err != nil {
+			return "", nil, err
+		}
+		if insert.Dirty {
+			if _, err := insert.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if err := insert.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		insert.Add(e.ColumnBytea)
+		insert.Dirty = true
+	}
+
+	if e.ColumnCharacter0.Valid {
+		if columns.Len() > 0 {
+			if _, err := columns.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := columns.WriteString(TableCompleteColumnColumnCharacter0); err != nil {
+			return "", nil, err
+		}
+		if insert.Dirty {
+			if _, err := insert.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if err := insert.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		insert.Add(e.ColumnCharacter0)
+		insert.Dirty = true
+	}
+
+	if e.ColumnCharacter100.Valid {
+		if columns.Len() > 0 {
+			if _, err := columns.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := columns.WriteString(TableCompleteColumnColumnCharacter100); err != nil {
+			return "", nil, err
+		}
+		if insert.Dirty {
+			if _, err := insert.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if err := insert.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		insert.Add(e.ColumnCharacter100)
+		insert.Dirty = true
+	}
+
+	if e.ColumnDecimal.Valid {
+		if columns.Len() > 0 {
+			if _, err := columns.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := columns.WriteString(TableCompleteColumnColumnDecimal); err != nil {
+			return "", nil, err
+		}
+		if insert.Dirty {
+			if _, err := insert.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if err := insert.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		insert.Add(e.ColumnDecimal)
+		insert.Dirty = true
+	}
+
+	if e.ColumnDoubleArray0.Valid {
+		if columns.Len() > 0 {
+			if _, err := columns.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := columns.WriteString(TableCompleteColumnColumnDoubleArray0); err != nil {
+			return "", nil, err
+		}
+		if insert.Dirty {
+			if _, err := insert.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if err := insert.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		insert.Add(e.ColumnDoubleArray0)
+		insert.Dirty = true
+	}
+
+	if e.ColumnDoubleArray100.Valid {
+		if columns.Len() > 0 {
+			if _, err := columns.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := columns.WriteString(TableCompleteColumnColumnDoubleArray100); err != nil {
+			return "", nil, err
+		}
+		if insert.Dirty {
+			if _, err := insert.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if err := insert.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		insert.Add(e.ColumnDoubleArray100)
+		insert.Dirty = true
+	}
+
+	if e.ColumnInet.Valid {
+		if columns.Len() > 0 {
+			if _, err := columns.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := columns.WriteString(TableCompleteColumnColumnInet); err != nil {
+			return "", nil, err
+		}
+		if insert.Dirty {
+			if _, err := insert.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if err := insert.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		insert.Add(e.ColumnInet)
+		insert.Dirty = true
+	}
+
+	if e.ColumnInteger != nil {
+		if columns.Len() > 0 {
+			if _, err := columns.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := columns.WriteString(TableCompleteColumnColumnInteger); err != nil {
+			return "", nil, err
+		}
+		if insert.Dirty {
+			if _, err := insert.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if err := insert.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		insert.Add(e.ColumnInteger)
+		insert.Dirty = true
+	}
+
+	if e.ColumnIntegerArray0.Valid {
+		if columns.Len() > 0 {
+			if _, err := columns.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := columns.WriteString(TableCompleteColumnColumnIntegerArray0); err != nil {
+			return "", nil, err
+		}
+		if insert.Dirty {
+			if _, err := insert.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if err := insert.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		insert.Add(e.ColumnIntegerArray0)
+		insert.Dirty = true
+	}
+
+	if e.ColumnIntegerArray100.Valid {
+		if columns.Len() > 0 {
+			if _, err := columns.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := columns.WriteString(TableCompleteColumnColumnIntegerArray100); err != nil {
+			return "", nil, err
+		}
+		if insert.Dirty {
+			if _, err := insert.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if err := insert.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		insert.Add(e.ColumnIntegerArray100)
+		insert.Dirty = true
+	}
+
+	if e.ColumnIntegerBig.Valid {
+		if columns.Len() > 0 {
+			if _, err := columns.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := columns.WriteString(TableCompleteColumnColumnIntegerBig); err != nil {
+			return "", nil, err
+		}
+		if insert.Dirty {
+			if _, err := insert.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if err := insert.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		insert.Add(e.ColumnIntegerBig)
+		insert.Dirty = true
+	}
+
+	if e.ColumnIntegerBigArray0.Valid {
+		if columns.Len() > 0 {
+			if _, err := columns.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := columns.WriteString(TableCompleteColumnColumnIntegerBigArray0); err != nil {
+			return "", nil, err
+		}
+		if insert.Dirty {
+			if _, err := insert.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if err := insert.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		insert.Add(e.ColumnIntegerBigArray0)
+		insert.Dirty = true
+	}
+
+	if e.ColumnIntegerBigArray100.Valid {
+		if columns.Len() > 0 {
+			if _, err := columns.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := columns.WriteString(TableCompleteColumnColumnIntegerBigArray100); err != nil {
+			return "", nil, err
+		}
+		if insert.Dirty {
+			if _, err := insert.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if err := insert.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		insert.Add(e.ColumnIntegerBigArray100)
+		insert.Dirty = true
+	}
+
+	if e.ColumnIntegerBigRange.Valid {
+		if columns.Len() > 0 {
+			if _, err := columns.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := columns.WriteString(TableCompleteColumnColumnIntegerBigRange); err != nil {
+			return "", nil, err
+		}
+		if insert.Dirty {
+			if _, err := insert.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if err := insert.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		insert.Add(e.ColumnIntegerBigRange)
+		insert.Dirty = true
+	}
+
+	if e.ColumnIntegerSmall != nil {
+		if columns.Len() > 0 {
+			if _, err := columns.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := columns.WriteString(TableCompleteColumnColumnIntegerSmall); err != nil {
+			return "", nil, err
+		}
+		if insert.Dirty {
+			if _, err := insert.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if err := insert.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		insert.Add(e.ColumnIntegerSmall)
+		insert.Dirty = true
+	}
+
+	if e.ColumnIntegerSmallArray0.Valid {
+		if columns.Len() > 0 {
+			if _, err := columns.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := columns.WriteString(TableCompleteColumnColumnIntegerSmallArray0); err != nil {
+			return "", nil, err
+		}
+		if insert.Dirty {
+			if _, err := insert.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if err := insert.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		insert.Add(e.ColumnIntegerSmallArray0)
+		insert.Dirty = true
+	}
+
+	if e.ColumnIntegerSmallArray100.Valid {
+		if columns.Len() > 0 {
+			if _, err := columns.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := columns.WriteString(TableCompleteColumnColumnIntegerSmallArray100); err != nil {
+			return "", nil, err
+		}
+		if insert.Dirty {
+			if _, err := insert.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if err := insert.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		insert.Add(e.ColumnIntegerSmallArray100)
+		insert.Dirty = true
+	}
+
+	if e.ColumnInterval.Valid {
+		if columns.Len() > 0 {
+			if _, err := columns.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := columns.WriteString(TableCompleteColumnColumnInterval); err != nil {
+			return "", nil, err
+		}
+		if insert.Dirty {
+			if _, err := insert.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if err := insert.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		insert.Add(e.ColumnInterval)
+		insert.Dirty = true
+	}
+
+	if e.ColumnJson != nil {
+		if columns.Len() > 0 {
+			if _, err := columns.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := columns.WriteString(TableCompleteColumnColumnJson); err != nil {
+			return "", nil, err
+		}
+		if insert.Dirty {
+			if _, err := insert.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if err := insert.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		insert.Add(e.ColumnJson)
+		insert.Dirty = true
+	}
+
+	if e.ColumnJsonNn != nil {
+		if columns.Len() > 0 {
+			if _, err := columns.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := columns.WriteString(TableCompleteColumnColumnJsonNn); err != nil {
+			return "", nil, err
+		}
+		if insert.Dirty {
+			if _, err := insert.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if err := insert.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		insert.Add(e.ColumnJsonNn)
+		insert.Dirty = true
+	}
+
+	if e.ColumnJsonNnD != nil {
+		if columns.Len() > 0 {
+			if _, err := columns.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := columns.WriteString(TableCompleteColumnColumnJsonNnD); err != nil {
+			return "", nil, err
+		}
+		if insert.Dirty {
+			if _, err := insert.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if err := insert.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		insert.Add(e.ColumnJsonNnD)
+		insert.Dirty = true
+	}
+
+	if e.ColumnJsonb != nil {
+		if columns.Len() > 0 {
+			if _, err := columns.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := columns.WriteString(TableCompleteColumnColumnJsonb); err != nil {
+			return "", nil, err
+		}
+		if insert.Dirty {
+			if _, err := insert.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if err := insert.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		insert.Add(e.ColumnJsonb)
+		insert.Dirty = true
+	}
+
+	if e.ColumnJsonbNn != nil {
+		if columns.Len() > 0 {
+			if _, err := columns.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := columns.WriteString(TableCompleteColumnColumnJsonbNn); err != nil {
+			return "", nil, err
+		}
+		if insert.Dirty {
+			if _, err := insert.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if err := insert.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		insert.Add(e.ColumnJsonbNn)
+		insert.Dirty = true
+	}
+
+	if e.ColumnJsonbNnD != nil {
+		if columns.Len() > 0 {
+			if _, err := columns.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := columns.WriteString(TableCompleteColumnColumnJsonbNnD); err != nil {
+			return "", nil, err
+		}
+		if insert.Dirty {
+			if _, err := insert.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if err := insert.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		insert.Add(e.ColumnJsonbNnD)
+		insert.Dirty = true
+	}
+
+	if e.ColumnNumeric.Valid {
+		if columns.Len() > 0 {
+			if _, err := columns.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := columns.WriteString(TableCompleteColumnColumnNumeric); err != nil {
+			return "", nil, err
+		}
+		if insert.Dirty {
+			if _, err := insert.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if err := insert.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		insert.Add(e.ColumnNumeric)
+		insert.Dirty = true
+	}
+
+	if e.ColumnNumericRange.Valid {
+		if columns.Len() > 0 {
+			if _, err := columns.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := columns.WriteString(TableCompleteColumnColumnNumericRange); err != nil {
+			return "", nil, err
+		}
+		if insert.Dirty {
+			if _, err := insert.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if err := insert.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		insert.Add(e.ColumnNumericRange)
+		insert.Dirty = true
+	}
+
+	if e.ColumnPoint.Valid {
+		if columns.Len() > 0 {
+			if _, err := columns.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := columns.WriteString(TableCompleteColumnColumnPoint); err != nil {
+			return "", nil, err
+		}
+		if insert.Dirty {
+			if _, err := insert.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if err := insert.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		insert.Add(e.ColumnPoint)
+		insert.Dirty = true
+	}
+
+	if e.ColumnReal != nil {
+		if columns.Len() > 0 {
+			if _, err := columns.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := columns.WriteString(TableCompleteColumnColumnReal); err != nil {
+			return "", nil, err
+		}
+		if insert.Dirty {
+			if _, err := insert.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if err := insert.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		insert.Add(e.ColumnReal)
+		insert.Dirty = true
+	}
+
+	if e.ColumnText.Valid {
+		if columns.Len() > 0 {
+			if _, err := columns.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := columns.WriteString(TableCompleteColumnColumnText); err != nil {
+			return "", nil, err
+		}
+		if insert.Dirty {
+			if _, err := insert.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if err := insert.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		insert.Add(e.ColumnText)
+		insert.Dirty = true
+	}
+
+	if e.ColumnTextArray0.Valid {
+		if columns.Len() > 0 {
+			if _, err := columns.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := columns.WriteString(TableCompleteColumnColumnTextArray0); err != nil {
+			return "", nil, err
+		}
+		if insert.Dirty {
+			if _, err := insert.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if err := insert.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		insert.Add(e.ColumnTextArray0)
+		insert.Dirty = true
+	}
+
+	if e.ColumnTextArray100.Valid {
+		if columns.Len() > 0 {
+			if _, err := columns.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := columns.WriteString(TableCompleteColumnColumnTextArray100); err != nil {
+			return "", nil, err
+		}
+		if insert.Dirty {
+			if _, err := insert.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if err := insert.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		insert.Add(e.ColumnTextArray100)
+		insert.Dirty = true
+	}
+
+	if e.ColumnTime.Valid {
+		if columns.Len() > 0 {
+			if _, err := columns.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := columns.WriteString(TableCompleteColumnColumnTime); err != nil {
+			return "", nil, err
+		}
+		if insert.Dirty {
+			if _, err := insert.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if err := insert.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		insert.Add(e.ColumnTime)
+		insert.Dirty = true
+	}
+
+	if e.ColumnTimestamp.Valid {
+		if columns.Len() > 0 {
+			if _, err := columns.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := columns.WriteString(TableCompleteColumnColumnTimestamp); err != nil {
+			return "", nil, err
+		}
+		if insert.Dirty {
+			if _, err := insert.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if err := insert.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		insert.Add(e.ColumnTimestamp)
+		insert.Dirty = true
+	}
+
+	if e.ColumnTimestamptz.Valid {
+		if columns.Len() > 0 {
+			if _, err := columns.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := columns.WriteString(TableCompleteColumnColumnTimestamptz); err != nil {
+			return "", nil, err
+		}
+		if insert.Dirty {
+			if _, err := insert.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if err := insert.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		insert.Add(e.ColumnTimestamptz)
+		insert.Dirty = true
+	}
+
+	if e.ColumnTimestamptzArray.Valid {
+		if columns.Len() > 0 {
+			if _, err := columns.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := columns.WriteString(TableCompleteColumnColumnTimestamptzArray); err != nil {
+			return "", nil, err
+		}
+		if insert.Dirty {
+			if _, err := insert.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if err := insert.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		insert.Add(e.ColumnTimestamptzArray)
+		insert.Dirty = true
+	}
+
+	if e.ColumnTimestamptzRange.Valid {
+		if columns.Len() > 0 {
+			if _, err := columns.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := columns.WriteString(TableCompleteColumnColumnTimestamptzRange); err != nil {
+			return "", nil, err
+		}
+		if insert.Dirty {
+			if _, err := insert.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if err := insert.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		insert.Add(e.ColumnTimestamptzRange)
+		insert.Dirty = true
+	}
+
+	if e.ColumnUUID.Valid {
+		if columns.Len() > 0 {
+			if _, err := columns.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := columns.WriteString(TableCompleteColumnColumnUUID); err != nil {
+			return "", nil, err
+		}
+		if insert.Dirty {
+			if _, err := insert.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if err := insert.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		insert.Add(e.ColumnUUID)
+		insert.Dirty = true
+	}
+
+	if e.ColumnUUIDArray.Valid {
+		if columns.Len() > 0 {
+			if _, err := columns.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := columns.WriteString(TableCompleteColumnColumnUUIDArray); err != nil {
+			return "", nil, err
+		}
+		if insert.Dirty {
+			if _, err := insert.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if err := insert.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		insert.Add(e.ColumnUUIDArray)
+		insert.Dirty = true
+	}
+
+	if columns.Len() > 0 {
+		buf.WriteString(" (")
+		buf.ReadFrom(columns)
+		buf.WriteString(") VALUES (")
+		buf.ReadFrom(insert)
+		buf.WriteString(") ")
+		if read {
+			buf.WriteString("RETURNING ")
+			if len(r.Columns) > 0 {
+				buf.WriteString(strings.Join(r.Columns, ", "))
+			} else {
+				buf.WriteString("column_bool, column_bool_array, column_bytea, column_character_0, column_character_100, column_decimal, column_double_array_0, column_double_array_100, column_inet, column_integer, column_integer_array_0, column_integer_array_100, column_integer_big, column_integer_big_array_0, column_integer_big_array_100, column_integer_big_range, column_integer_small, column_integer_small_array_0, column_integer_small_array_100, column_interval, column_json, column_json_nn, column_json_nn_d, column_jsonb, column_jsonb_nn, column_jsonb_nn_d, column_numeric, column_numeric_range, column_point, column_real, column_serial, column_serial_big, column_serial_small, column_text, column_text_array_0, column_text_array_100, column_time, column_timestamp, column_timestamptz, column_timestamptz_array, column_timestamptz_range, column_uuid, column_uuid_array")
+			}
+		}
+	}
+	return buf.String(), insert.Args(), nil
+}
+
+func (r *CompleteRepositoryBase) insert(ctx context.Context, tx *sql.Tx, e *CompleteEntity) (*CompleteEntity, error) {
+	query, args, err := r.InsertQuery(e, true)
+	if err != nil {
+		return nil, err
+	}
+
+	err = queryRow(ctx, r.DB, tx, r.StmtCache, query, args,
+		&e.ColumnBool,
+		&e.ColumnBoolArray,
+		&e.ColumnBytea,
+		&e.ColumnCharacter0,
+		&e.ColumnCharacter100,
+		&e.ColumnDecimal,
+		&e.ColumnDoubleArray0,
+		&e.ColumnDoubleArray100,
+		&e.ColumnInet,
+		&e.ColumnInteger,
+		&e.ColumnIntegerArray0,
+		&e.ColumnIntegerArray100,
+		&e.ColumnIntegerBig,
+		&e.ColumnIntegerBigArray0,
+		&e.ColumnIntegerBigArray100,
+		&e.ColumnIntegerBigRange,
+		&e.ColumnIntegerSmall,
+		&e.ColumnIntegerSmallArray0,
+		&e.ColumnIntegerSmallArray100,
+		&e.ColumnInterval,
+		&e.ColumnJson,
+		&e.ColumnJsonNn,
+		&e.ColumnJsonNnD,
+		&e.ColumnJsonb,
+		&e.ColumnJsonbNn,
+		&e.ColumnJsonbNnD,
+		&e.ColumnNumeric,
+		&e.ColumnNumericRange,
+		&e.ColumnPoint,
+		&e.ColumnReal,
+		&e.ColumnSerial,
+		&e.ColumnSerialBig,
+		&e.ColumnSerialSmall,
+		&e.ColumnText,
+		&e.ColumnTextArray0,
+		&e.ColumnTextArray100,
+		&e.ColumnTime,
+		&e.ColumnTimestamp,
+		&e.ColumnTimestamptz,
+		&e.ColumnTimestamptzArray,
+		&e.ColumnTimestamptzRange,
+		&e.ColumnUUID,
+		&e.ColumnUUIDArray,
+	)
+	if r.Log != nil {
+		if tx == nil {
+			r.Log(err, TableComplete, "insert", query, args...)
+		} else {
+			r.Log(err, TableComplete, "insert tx", query, args...)
+		}
+	}
+	if err != nil {
+		return nil, wrapError(err)
+	}
+	return e, nil
+}
+
+func (r *CompleteRepositoryBase) Insert(ctx context.Context, e *CompleteEntity) (*CompleteEntity, error) {
+	return r.insert(ctx, nil, e)
+}
+
+func (r *CompleteRepositoryBase) FindQuery(fe *CompleteFindExpr) (string, []interface{}, error) {
+	comp := NewComposer(43)
+	buf := bytes.NewBufferString("SELECT ")
+	if len(fe.Columns) == 0 {
+		buf.WriteString("t0.column_bool, t0.column_bool_array, t0.column_bytea, t0.column_character_0, t0.column_character_100, t0.column_decimal, t0.column_double_array_0, t0.column_double_array_100, t0.column_inet, t0.column_integer, t0.column_integer_array_0, t0.column_integer_array_100, t0.column_integer_big, t0.column_integer_big_array_0, t0.column_integer_big_array_100, t0.column_integer_big_range, t0.column_integer_small, t0.column_integer_small_array_0, t0.column_integer_small_array_100, t0.column_interval, t0.column_json, t0.column_json_nn, t0.column_json_nn_d, t0.column_jsonb, t0.column_jsonb_nn, t0.column_jsonb_nn_d, t0.column_numeric, t0.column_numeric_range, t0.column_point, t0.column_real, t0.column_serial, t0.column_serial_big, t0.column_serial_small, t0.column_text, t0.column_text_array_0, t0.column_text_array_100, t0.column_time, t0.column_timestamp, t0.column_timestamptz, t0.column_timestamptz_array, t0.column_timestamptz_range, t0.column_uuid, t0.column_uuid_array")
+	} else {
+		for i, c := range fe.Columns {
+			if i > 0 {
+				buf.WriteString(", ")
+			}
+			expr, err := c.selectExpr()
+			if err != nil {
+				return "", nil, err
+			}
+			buf.WriteString(expr)
+		}
+	}
+	buf.WriteString(" FROM ")
+	buf.WriteString(r.Table)
+	buf.WriteString(" AS t0")
+	if comp.Dirty {
+		buf.ReadFrom(comp)
+		comp.Dirty = false
+	}
+	if fe.Where != nil {
+		if err := CompleteCriteriaWhereClause(comp, fe.Where, 0, r.Table); err != nil {
+			return "", nil, err
+		}
+	}
+	if comp.Dirty {
+		if _, err := buf.WriteString(" WHERE "); err != nil {
+			return "", nil, err
+		}
+		buf.ReadFrom(comp)
+	}
+
+	if len(fe.OrderBy) > 0 {
+		i := 0
+		for _, order := range fe.OrderBy {
+			for _, columnName := range TableCompleteColumns {
+				if order.Name == columnName {
+					if i == 0 {
+						comp.WriteString(" ORDER BY ")
+					}
+					if i > 0 {
+						if _, err := comp.WriteString(", "); err != nil {
+							return "", nil, err
+						}
+					}
+					if _, err := comp.WriteString(order.Name); err != nil {
+						return "", nil, err
+					}
+					if order.Descending {
+						if _, err := comp.WriteString(" DESC"); err != nil {
+							return "", nil, err
+						}
+					}
+					i++
+					break
+				}
+			}
+		}
+	}
+	if fe.Offset > 0 {
+		if _, err := comp.WriteString(" OFFSET "); err != nil {
+			return "", nil, err
+		}
+		if err := comp.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		if _, err := comp.WriteString(" "); err != nil {
+			return "", nil, err
+		}
+		comp.Add(fe.Offset)
+	}
+	if fe.Limit > 0 {
+		if _, err := comp.WriteString(" LIMIT "); err != nil {
+			return "", nil, err
+		}
+		if err := comp.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		if _, err := comp.WriteString(" "); err != nil {
+			return "", nil, err
+		}
+		comp.Add(fe.Limit)
+	}
+	if fe.Lock.Actionable() {
+		var of string
+		if err := lockClause(comp, fe.Lock, of); err != nil {
+			return "", nil, err
+		}
+	}
+
+	buf.ReadFrom(comp)
+
+	return buf.String(), comp.Args(), nil
+}
+
+func (r *CompleteRepositoryBase) find(ctx context.Context, tx *sql.Tx, fe *CompleteFindExpr) ([]*CompleteEntity, error) {
+	if tx == nil && fe.Lock.Actionable() {
+		return nil, ErrRowLockOutsideTransaction
+	}
+	query, args, err := r.FindQuery(fe)
+	if err != nil {
+		return nil, err
+	}
+	var rows *sql.Rows
+	if tx == nil {
+		rows, err = r.DB.QueryContext(ctx, query, args...)
+	} else {
+		rows, err = tx.QueryContext(ctx, query, args...)
+	}
+	if r.Log != nil {
+		if tx == nil {
+			r.Log(err, TableComplete, "find", query, args...)
+		} else {
+			r.Log(err, TableComplete, "find tx", query, args...)
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var (
+		entities []*CompleteEntity
+		props    []interface{}
+	)
+	cols := completeColumnNames(fe.Columns)
+	for rows.Next() {
+		var ent CompleteEntity
+		if props, err = ent.Props(cols...); err != nil {
+			return nil, err
+		}
+		err = rows.Scan(props...)
+		if err != nil {
+			return nil, err
+		}
+
+		entities = append(entities, &ent)
+	}
+	err = rows.Err()
+	if r.Log != nil {
+		r.Log(err, TableComplete, "find", query, args...)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return entities, nil
+}
+
+func (r *CompleteRepositoryBase) Find(ctx context.Context, fe *CompleteFindExpr) ([]*CompleteEntity, error) {
+	return r.find(ctx, nil, fe)
+}
+
+func (r *CompleteRepositoryBase) findIter(ctx context.Context, tx *sql.Tx, fe *CompleteFindExpr) (*CompleteIterator, error) {
+	if tx == nil && fe.Lock.Actionable() {
+		return nil, ErrRowLockOutsideTransaction
+	}
+	query, args, err := r.FindQuery(fe)
+	if err != nil {
+		return nil, err
+	}
+	var rows *sql.Rows
+	if tx == nil {
+		rows, err = r.DB.QueryContext(ctx, query, args...)
+	} else {
+		rows, err = tx.QueryContext(ctx, query, args...)
+	}
+	if r.Log != nil {
+		if tx == nil {
+			r.Log(err, TableComplete, "find iter", query, args...)
+		} else {
+			r.Log(err, TableComplete, "find iter tx", query, args...)
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &CompleteIterator{
+		rows: rows,
+		expr: fe,
+		cols: completeColumnNames(fe.Columns),
+	}, nil
+}
+
+func (r *CompleteRepositoryBase) FindIter(ctx context.Context, fe *CompleteFindExpr) (*CompleteIterator, error) {
+	return r.findIter(ctx, nil, fe)
+}
+
+func (r *CompleteRepositoryBase) findProjection(ctx context.Context, tx *sql.Tx, fe *CompleteFindExpr, next func() Projection) error {
+	if tx == nil && fe.Lock.Actionable() {
+		return ErrRowLockOutsideTransaction
+	}
+	query, args, err := r.FindQuery(fe)
+	if err != nil {
+		return err
+	}
+	var rows *sql.Rows
+	if tx == nil {
+		rows, err = r.DB.QueryContext(ctx, query, args...)
+	} else {
+		rows, err = tx.QueryContext(ctx, query, args...)
+	}
+	if r.Log != nil {
+		if tx == nil {
+			r.Log(err, TableComplete, "find projection", query, args...)
+		} else {
+			r.Log(err, TableComplete, "find projection tx", query, args...)
+		}
+	}
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	cols, err := rows.Columns()
+	if err != nil {
+		return err
+	}
+	for rows.Next() {
+		props, err := next().Props(cols...)
+		if err != nil {
+			return err
+		}
+		if err := rows.Scan(props...); err != nil {
+			return err
+		}
+	}
+	return rows.Err()
+}
+
+// FindProjection works like Find, but scans each row into the projection returned by next.
+// Selected columns, see CompleteFindExpr.Columns, are passed to its Props method.
+func (r *CompleteRepositoryBase) FindProjection(ctx context.Context, fe *CompleteFindExpr, next func() Projection) error {
+	return r.findProjection(ctx, nil, fe, next)
+}
+
+func (r *CompleteRepositoryBase) UpsertQuery(e *CompleteEntity, p *CompletePatch, inf ...string) (string, []interface{}, error) {
+	if r.Validate {
+		if err := e.Validate(); err != nil {
+			return "", nil, err
+		}
+		if p != nil {
+			if err := p.Validate(); err != nil {
+				return "", nil, err
+			}
+		}
+	}
+	upsert := NewComposer(86)
+	columns := bytes.NewBuffer(nil)
+	buf := bytes.NewBufferString("INSERT INTO ")
+	buf.WriteString(r.Table)
+
+	if e.ColumnBool.Valid {
+		if columns.Len() > 0 {
+			if _, err := columns.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := columns.WriteString(TableCompleteColumnColumnBool); err != nil {
+			return "", nil, err
+		}
+		if upsert.Dirty {
+			if _, err := upsert.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if err := upsert.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		upsert.Add(e.ColumnBool)
+		upsert.Dirty = true
+	}
+
+	if e.ColumnBoolArray.Valid {
+		if columns.Len() > 0 {
+			if _, err := columns.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := columns.WriteString(TableCompleteColumnColumnBoolArray); err != nil {
+			return "", nil, err
+		}
+		if upsert.Dirty {
+			if _, err := upsert.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if err := upsert.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		upsert.Add(e.ColumnBoolArray)
+		upsert.Dirty = true
+	}
+
+	if e.ColumnBytea != nil {
+		if columns.Len() > 0 {
+			if _, err := columns.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := columns.WriteString(TableCompleteColumnColumnBytea); err != nil {
+			return "", nil, err
+		}
+		if upsert.Dirty {
+			if _, err := upsert.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if err := upsert.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		upsert.Add(e.ColumnBytea)
+		upsert.Dirty = true
+	}
+
+	if e.ColumnCharacter0.Valid {
+		if columns.Len() > 0 {
+			if _, err := columns.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := columns.WriteString(TableCompleteColumnColumnCharacter0); err != nil {
+			return "", nil, err
+		}
+		if upsert.Dirty {
+			if _, err := upsert.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if err := upsert.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		upsert.Add(e.ColumnCharacter0)
+		upsert.Dirty = true
+	}
+
+	if e.ColumnCharacter100.Valid {
+		if columns.Len() > 0 {
+			if _, err := columns.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := columns.WriteString(TableCompleteColumnColumnCharacter100); err != nil {
+			return "", nil, err
+		}
+		if upsert.Dirty {
+			if _, err := upsert.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if err := upsert.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		upsert.Add(e.ColumnCharacter100)
+		upsert.Dirty = true
+	}
+
+	if e.ColumnDecimal.Valid {
+		if columns.Len() > 0 {
+			if _, err := columns.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := columns.WriteString(TableCompleteColumnColumnDecimal); err != nil {
+			return "", nil, err
+		}
+		if upsert.Dirty {
+			if _, err := upsert.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if err := upsert.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		upsert.Add(e.ColumnDecimal)
+		upsert.Dirty = true
+	}
+
+	if e.ColumnDoubleArray0.Valid {
+		if columns.Len() > 0 {
+			if _, err := columns.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := columns.WriteString(TableCompleteColumnColumnDoubleArray0); err != nil {
+			return "", nil, err
+		}
+		if upsert.Dirty {
+			if _, err := upsert.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if err := upsert.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		upsert.Add(e.ColumnDoubleArray0)
+		upsert.Dirty = true
+	}
+
+	if e.ColumnDoubleArray100.Valid {
+		if columns.Len() > 0 {
+			if _, err := columns.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := columns.WriteString(TableCompleteColumnColumnDoubleArray100); err != nil {
+			return "", nil, err
+		}
+		if upsert.Dirty {
+			if _, err := upsert.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if err := upsert.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		upsert.Add(e.ColumnDoubleArray100)
+		upsert.Dirty = true
+	}
+
+	if e.ColumnInet.Valid {
+		if columns.Len() > 0 {
+			if _, err := columns.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := columns.WriteString(TableCompleteColumnColumnInet); err != nil {
+			return "", nil, err
+		}
+		if upsert.Dirty {
+			if _, err := upsert.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if err := upsert.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		upsert.Add(e.ColumnInet)
+		upsert.Dirty = true
+	}
+
+	if e.ColumnInteger != nil {
+		if columns.Len() > 0 {
+			if _, err := columns.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := columns.WriteString(TableCompleteColumnColumnInteger); err != nil {
+			return "", nil, err
+		}
+		if upsert.Dirty {
+			if _, err := upsert.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if err := upsert.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		upsert.Add(e.ColumnInteger)
+		upsert.Dirty = true
+	}
+
+	if e.ColumnIntegerArray0.Valid {
+		if columns.Len() > 0 {
+			if _, err := columns.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := columns.WriteString(TableCompleteColumnColumnIntegerArray0); err != nil {
+			return "", nil, err
+		}
+		if upsert.Dirty {
+			if _, err := upsert.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if err := upsert.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		upsert.Add(e.ColumnIntegerArray0)
+		upsert.Dirty = true
+	}
+
+	if e.ColumnIntegerArray100.Valid {
+		if columns.Len() > 0 {
+			if _, err := columns.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := columns.WriteString(TableCompleteColumnColumnIntegerArray100); err != nil {
+			return "", nil, err
+		}
+		if upsert.Dirty {
+			if _, err := upsert.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if err := upsert.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		upsert.Add(e.ColumnIntegerArray100)
+		upsert.Dirty = true
+	}
+
+	if e.ColumnIntegerBig.Valid {
+		if columns.Len() > 0 {
+			if _, err := columns.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := columns.WriteString(TableCompleteColumnColumnIntegerBig); err != nil {
+			return "", nil, err
+		}
+		if upsert.Dirty {
+			if _, err := upsert.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if err := upsert.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		upsert.Add(e.ColumnIntegerBig)
+		upsert.Dirty = true
+	}
+
+	if e.ColumnIntegerBigArray0.Valid {
+		if columns.Len() > 0 {
+			if _, err := columns.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := columns.WriteString(TableCompleteColumnColumnIntegerBigArray0); err != nil {
+			return "", nil, err
+		}
+		if upsert.Dirty {
+			if _, err := upsert.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if err := upsert.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		upsert.Add(e.ColumnIntegerBigArray0)
+		upsert.Dirty = true
+	}
+
+	if e.ColumnIntegerBigArray100.Valid {
+		if columns.Len() > 0 {
+			if _, err := columns.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := columns.WriteString(TableCompleteColumnColumnIntegerBigArray100); err != nil {
+			return "", nil, err
+		}
+		if upsert.Dirty {
+			if _, err := upsert.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if err := upsert.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		upsert.Add(e.ColumnIntegerBigArray100)
+		upsert.Dirty = true
+	}
+
+	if e.ColumnIntegerBigRange.Valid {
+		if columns.Len() > 0 {
+			if _, err := columns.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := columns.WriteString(TableCompleteColumnColumnIntegerBigRange); err != nil {
+			return "", nil, err
+		}
+		if upsert.Dirty {
+			if _, err := upsert.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if err := upsert.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		upsert.Add(e.ColumnIntegerBigRange)
+		upsert.Dirty = true
+	}
+
+	if e.ColumnIntegerSmall != nil {
+		if columns.Len() > 0 {
+			if _, err := columns.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := columns.WriteString(TableCompleteColumnColumnIntegerSmall); err != nil {
+			return "", nil, err
+		}
+		if upsert.Dirty {
+			if _, err := upsert.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if err := upsert.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		upsert.Add(e.ColumnIntegerSmall)
+		upsert.Dirty = true
+	}
+
+	if e.ColumnIntegerSmallArray0.Valid {
+		if columns.Len() > 0 {
+			if _, err := columns.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := columns.WriteString(TableCompleteColumnColumnIntegerSmallArray0); err != nil {
+			return "", nil, err
+		}
+		if upsert.Dirty {
+			if _, err := upsert.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if err := upsert.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		upsert.Add(e.ColumnIntegerSmallArray0)
+		upsert.Dirty = true
+	}
+
+	if e.ColumnIntegerSmallArray100.Valid {
+		if columns.Len() > 0 {
+			if _, err := columns.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := columns.WriteString(TableCompleteColumnColumnIntegerSmallArray100); err != nil {
+			return "", nil, err
+		}
+		if upsert.Dirty {
+			if _, err := upsert.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if err := upsert.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		upsert.Add(e.ColumnIntegerSmallArray100)
+		upsert.Dirty = true
+	}
+
+	if e.ColumnInterval.Valid {
+		if columns.Len() > 0 {
+			if _, err := columns.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := columns.WriteString(TableCompleteColumnColumnInterval); err != nil {
+			return "", nil, err
+		}
+		if upsert.Dirty {
+			if _, err := upsert.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if err := upsert.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		upsert.Add(e.ColumnInterval)
+		upsert.Dirty = true
+	}
+
+	if e.ColumnJson != nil {
+		if columns.Len() > 0 {
+			if _, err := columns.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := columns.WriteString(TableCompleteColumnColumnJson); err != nil {
+			return "", nil, err
+		}
+		if upsert.Dirty {
+			if _, err := upsert.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if err := upsert.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		upsert.Add(e.ColumnJson)
+		upsert.Dirty = true
+	}
+
+	if e.ColumnJsonNn != nil {
+		if columns.Len() > 0 {
+			if _, err := columns.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := columns.WriteString(TableCompleteColumnColumnJsonNn); err != nil {
+			return "", nil, err
+		}
+		if upsert.Dirty {
+			if _, err := upsert.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if err := upsert.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		upsert.Add(e.ColumnJsonNn)
+		upsert.Dirty = true
+	}
+
+	if e.ColumnJsonNnD != nil {
+		if columns.Len() > 0 {
+			if _, err := columns.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := columns.WriteString(TableCompleteColumnColumnJsonNnD); err != nil {
+			return "", nil, err
+		}
+		if upsert.Dirty {
+			if _, err := upsert.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if err := upsert.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		upsert.Add(e.ColumnJsonNnD)
+		upsert.Dirty = true
+	}
+
+	if e.ColumnJsonb != nil {
+		if columns.Len() > 0 {
+			if _, err := columns.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := columns.WriteString(TableCompleteColumnColumnJsonb); err != nil {
+			return "", nil, err
+		}
+		if upsert.Dirty {
+			if _, err := upsert.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if err := upsert.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		upsert.Add(e.ColumnJsonb)
+		upsert.Dirty = true
+	}
+
+	if e.ColumnJsonbNn != nil {
+		if columns.Len() > 0 {
+			if _, err := columns.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := columns.WriteString(TableCompleteColumnColumnJsonbNn); err != nil {
+			return "", nil, err
+		}
+		if upsert.Dirty {
+			if _, err := upsert.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if err := upsert.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		upsert.Add(e.ColumnJsonbNn)
+		upsert.Dirty = true
+	}
+
+	if e.ColumnJsonbNnD != nil {
+		if columns.Len() > 0 {
+			if _, err := columns.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := columns.WriteString(TableCompleteColumnColumnJsonbNnD); err != nil {
+			return "", nil, err
+		}
+		if upsert.Dirty {
+			if _, err := upsert.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if err := upsert.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		upsert.Add(e.ColumnJsonbNnD)
+		upsert.Dirty = true
+	}
+
+	if e.ColumnNumeric.Valid {
+		if columns.Len() > 0 {
+			if _, err := columns.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := columns.WriteString(TableCompleteColumnColumnNumeric); err != nil {
+			return "", nil, err
+		}
+		if upsert.Dirty {
+			if _, err := upsert.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if err := upsert.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		upsert.Add(e.ColumnNumeric)
+		upsert.Dirty = true
+	}
+
+	if e.ColumnNumericRange.Valid {
+		if columns.Len() > 0 {
+			if _, err := columns.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := columns.WriteString(TableCompleteColumnColumnNumericRange); err != nil {
+			return "", nil, err
+		}
+		if upsert.Dirty {
+			if _, err := upsert.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if err := upsert.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		upsert.Add(e.ColumnNumericRange)
+		upsert.Dirty = true
+	}
+
+	if e.ColumnPoint.Valid {
+		if columns.Len() > 0 {
+			if _, err := columns.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := columns.WriteString(TableCompleteColumnColumnPoint); err != nil {
+			return "", nil, err
+		}
+		if upsert.Dirty {
+			if _, err := upsert.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if err := upsert.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		upsert.Add(e.ColumnPoint)
+		upsert.Dirty = true
+	}
+
+	if e.ColumnReal != nil {
+		if columns.Len() > 0 {
+			if _, err := columns.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := columns.WriteString(TableCompleteColumnColumnReal); err != nil {
+			return "", nil, err
+		}
+		if upsert.Dirty {
+			if _, err := upsert.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if err := upsert.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		upsert.Add(e.ColumnReal)
+		upsert.Dirty = true
+	}
+
+	if e.ColumnText.Valid {
+		if columns.Len() > 0 {
+			if _, err := columns.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := columns.WriteString(TableCompleteColumnColumnText); err != nil {
+			return "", nil, err
+		}
+		if upsert.Dirty {
+			if _, err := upsert.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if err := upsert.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		upsert.Add(e.ColumnText)
+		upsert.Dirty = true
+	}
+
+	if e.ColumnTextArray0.Valid {
+		if columns.Len() > 0 {
+			if _, err := columns.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := columns.WriteString(TableCompleteColumnColumnTextArray0); err != nil {
+			return "", nil, err
+		}
+		if upsert.Dirty {
+			if _, err := upsert.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if err := upsert.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		upsert.Add(e.ColumnTextArray0)
+		upsert.Dirty = true
+	}
+
+	if e.ColumnTextArray100.Valid {
+		if columns.Len() > 0 {
+			if _, err := columns.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := columns.WriteString(TableCompleteColumnColumnTextArray100); err != nil {
+			return "", nil, err
+		}
+		if upsert.Dirty {
+			if _, err := upsert.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if err := upsert.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		upsert.Add(e.ColumnTextArray100)
+		upsert.Dirty = true
+	}
+
+	if e.ColumnTime.Valid {
+		if columns.Len() > 0 {
+			if _, err := columns.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := columns.WriteString(TableCompleteColumnColumnTime); err != nil {
+			return "", nil, err
+		}
+		if upsert.Dirty {
+			if _, err := upsert.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if err := upsert.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		upsert.Add(e.ColumnTime)
+		upsert.Dirty = true
+	}
+
+	if e.ColumnTimestamp.Valid {
+		if columns.Len() > 0 {
+			if _, err := columns.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := columns.WriteString(TableCompleteColumnColumnTimestamp); err != nil {
+			return "", nil, err
+		}
+		if upsert.Dirty {
+			if _, err := upsert.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if err := upsert.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		upsert.Add(e.ColumnTimestamp)
+		upsert.Dirty = true
+	}
+
+	if e.ColumnTimestamptz.Valid {
+		if columns.Len() > 0 {
+			if _, err := columns.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := columns.WriteString(TableCompleteColumnColumnTimestamptz); err != nil {
+			return "", nil, err
+		}
+		if upsert.Dirty {
+			if _, err := upsert.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if err := upsert.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		upsert.Add(e.ColumnTimestamptz)
+		upsert.Dirty = true
+	}
+
+	if e.ColumnTimestamptzArray.Valid {
+		if columns.Len() > 0 {
+			if _, err := columns.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := columns.WriteString(TableCompleteColumnColumnTimestamptzArray); err != nil {
+			return "", nil, err
+		}
+		if upsert.Dirty {
+			if _, err := upsert.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if err := upsert.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		upsert.Add(e.ColumnTimestamptzArray)
+		upsert.Dirty = true
+	}
+
+	if e.ColumnTimestamptzRange.Valid {
+		if columns.Len() > 0 {
+			if _, err := columns.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := columns.WriteString(TableCompleteColumnColumnTimestamptzRange); err != nil {
+			return "", nil, err
+		}
+		if upsert.Dirty {
+			if _, err := upsert.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if err := upsert.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		upsert.Add(e.ColumnTimestamptzRange)
+		upsert.Dirty = true
+	}
+
+	if e.ColumnUUID.Valid {
+		if columns.Len() > 0 {
+			if _, err := columns.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := columns.WriteString(TableCompleteColumnColumnUUID); err != nil {
+			return "", nil, err
+		}
+		if upsert.Dirty {
+			if _, err := upsert.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if err := upsert.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		upsert.Add(e.ColumnUUID)
+		upsert.Dirty = true
+	}
+
+	if e.ColumnUUIDArray.Valid {
+		if columns.Len() > 0 {
+			if _, err := columns.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := columns.WriteString(TableCompleteColumnColumnUUIDArray); err != nil {
+			return "", nil, err
+		}
+		if upsert.Dirty {
+			if _, err := upsert.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if err := upsert.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		upsert.Add(e.ColumnUUIDArray)
+		upsert.Dirty = true
+	}
+
+	if upsert.Dirty {
+		buf.WriteString(" (")
+		buf.ReadFrom(columns)
+		buf.WriteString(") VALUES (")
+		buf.ReadFrom(upsert)
+		buf.WriteString(")")
+	}
+	buf.WriteString(" ON CONFLICT ")
+	if len(inf) > 0 {
+		upsert.Dirty = false
+		if p.ColumnBool.Valid {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TableCompleteColumnColumnBool); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("="); err != nil {
+				return "", nil, err
+			}
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(p.ColumnBool)
+			upsert.Dirty = true
+
+		}
+		if p.ColumnBoolArray.Valid {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TableCompleteColumnColumnBoolArray); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("="); err != nil {
+				return "", nil, err
+			}
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(p.ColumnBoolArray)
+			upsert.Dirty = true
+
+		}
+		if p.ColumnBytea != nil {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TableCompleteColumnColumnBytea); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("="); err != nil {
+				return "", nil, err
+			}
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(p.ColumnBytea)
+			upsert.Dirty = true
+
+		}
+		if p.ColumnCharacter0.Valid {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TableCompleteColumnColumnCharacter0); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("="); err != nil {
+				return "", nil, err
+			}
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(p.ColumnCharacter0)
+			upsert.Dirty = true
+
+		}
+		if p.ColumnCharacter100.Valid {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TableCompleteColumnColumnCharacter100); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("="); err != nil {
+				return "", nil, err
+			}
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(p.ColumnCharacter100)
+			upsert.Dirty = true
+
+		}
+		if p.ColumnDecimal.Valid {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TableCompleteColumnColumnDecimal); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("="); err != nil {
+				return "", nil, err
+			}
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(p.ColumnDecimal)
+			upsert.Dirty = true
+
+		}
+		if p.ColumnDoubleArray0.Valid {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TableCompleteColumnColumnDoubleArray0); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("="); err != nil {
+				return "", nil, err
+			}
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(p.ColumnDoubleArray0)
+			upsert.Dirty = true
+
+		}
+		if p.ColumnDoubleArray100.Valid {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TableCompleteColumnColumnDoubleArray100); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("="); err != nil {
+				return "", nil, err
+			}
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(p.ColumnDoubleArray100)
+			upsert.Dirty = true
+
+		}
+		if p.ColumnInet.Valid {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TableCompleteColumnColumnInet); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("="); err != nil {
+				return "", nil, err
+			}
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(p.ColumnInet)
+			upsert.Dirty = true
+
+		}
+		if p.ColumnInteger != nil {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TableCompleteColumnColumnInteger); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("="); err != nil {
+				return "", nil, err
+			}
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(p.ColumnInteger)
+			upsert.Dirty = true
+
+		}
+		if p.ColumnIntegerArray0.Valid {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TableCompleteColumnColumnIntegerArray0); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("="); err != nil {
+				return "", nil, err
+			}
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(p.ColumnIntegerArray0)
+			upsert.Dirty = true
+
+		}
+		if p.ColumnIntegerArray100.Valid {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TableCompleteColumnColumnIntegerArray100); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("="); err != nil {
+				return "", nil, err
+			}
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(p.ColumnIntegerArray100)
+			upsert.Dirty = true
+
+		}
+		if p.ColumnIntegerBig.Valid {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TableCompleteColumnColumnIntegerBig); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("="); err != nil {
+				return "", nil, err
+			}
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(p.ColumnIntegerBig)
+			upsert.Dirty = true
+
+		}
+		if p.ColumnIntegerBigArray0.Valid {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TableCompleteColumnColumnIntegerBigArray0); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("="); err != nil {
+				return "", nil, err
+			}
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(p.ColumnIntegerBigArray0)
+			upsert.Dirty = true
+
+		}
+		if p.ColumnIntegerBigArray100.Valid {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TableCompleteColumnColumnIntegerBigArray100); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("="); err != nil {
+				return "", nil, err
+			}
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(p.ColumnIntegerBigArray100)
+			upsert.Dirty = true
+
+		}
+		if p.ColumnIntegerBigRange.Valid {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TableCompleteColumnColumnIntegerBigRange); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("="); err != nil {
+				return "", nil, err
+			}
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(p.ColumnIntegerBigRange)
+			upsert.Dirty = true
+
+		}
+		if p.ColumnIntegerSmall != nil {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TableCompleteColumnColumnIntegerSmall); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("="); err != nil {
+				return "", nil, err
+			}
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(p.ColumnIntegerSmall)
+			upsert.Dirty = true
+
+		}
+		if p.ColumnIntegerSmallArray0.Valid {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TableCompleteColumnColumnIntegerSmallArray0); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("="); err != nil {
+				return "", nil, err
+			}
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(p.ColumnIntegerSmallArray0)
+			upsert.Dirty = true
+
+		}
+		if p.ColumnIntegerSmallArray100.Valid {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TableCompleteColumnColumnIntegerSmallArray100); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("="); err != nil {
+				return "", nil, err
+			}
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(p.ColumnIntegerSmallArray100)
+			upsert.Dirty = true
+
+		}
+		if p.ColumnInterval.Valid {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TableCompleteColumnColumnInterval); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("="); err != nil {
+				return "", nil, err
+			}
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(p.ColumnInterval)
+			upsert.Dirty = true
+
+		}
+		if p.ColumnJson != nil {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TableCompleteColumnColumnJson); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("="); err != nil {
+				return "", nil, err
+			}
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(p.ColumnJson)
+			upsert.Dirty = true
+
+		}
+		if p.ColumnJsonNn != nil {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TableCompleteColumnColumnJsonNn); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("="); err != nil {
+				return "", nil, err
+			}
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(p.ColumnJsonNn)
+			upsert.Dirty = true
+
+		}
+		if p.ColumnJsonNnD != nil {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TableCompleteColumnColumnJsonNnD); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("="); err != nil {
+				return "", nil, err
+			}
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(p.ColumnJsonNnD)
+			upsert.Dirty = true
+
+		}
+		if p.ColumnJsonb != nil {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TableCompleteColumnColumnJsonb); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("="); err != nil {
+				return "", nil, err
+			}
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(p.ColumnJsonb)
+			upsert.Dirty = true
+
+		}
+		if len(p.ColumnJsonbSet) > 0 || p.ColumnJsonbMerge != nil || len(p.ColumnJsonbRemove) > 0 {
+			if p.ColumnJsonb != nil {
+				return "", nil, errors.New("column_jsonb: value and JSON modifications cannot be used together")
+			}
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TableCompleteColumnColumnJsonb); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("="); err != nil {
+				return "", nil, err
+			}
+			if err := jsonPatchClause(upsert, TableCompleteColumnColumnJsonb, p.ColumnJsonbSet, p.ColumnJsonbMerge, p.ColumnJsonbRemove); err != nil {
+				return "", nil, err
+			}
+			upsert.Dirty = true
+		}
+		if p.ColumnJsonbNn != nil {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TableCompleteColumnColumnJsonbNn); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("="); err != nil {
+				return "", nil, err
+			}
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(p.ColumnJsonbNn)
+			upsert.Dirty = true
+
+		}
+		if len(p.ColumnJsonbNnSet) > 0 || p.ColumnJsonbNnMerge != nil || len(p.ColumnJsonbNnRemove) > 0 {
+			if p.ColumnJsonbNn != nil {
+				return "", nil, errors.New("column_jsonb_nn: value and JSON modifications cannot be used together")
+			}
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TableCompleteColumnColumnJsonbNn); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("="); err != nil {
+				return "", nil, err
+			}
+			if err := jsonPatchClause(upsert, TableCompleteColumnColumnJsonbNn, p.ColumnJsonbNnSet, p.ColumnJsonbNnMerge, p.ColumnJsonbNnRemove); err != nil {
+				return "", nil, err
+			}
+			upsert.Dirty = true
+		}
+		if p.ColumnJsonbNnD != nil {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TableCompleteColumnColumnJsonbNnD); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("="); err != nil {
+				return "", nil, err
+			}
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(p.ColumnJsonbNnD)
+			upsert.Dirty = true
+
+		}
+		if len(p.ColumnJsonbNnDSet) > 0 || p.ColumnJsonbNnDMerge != nil || len(p.ColumnJsonbNnDRemove) > 0 {
+			if p.ColumnJsonbNnD != nil {
+				return "", nil, errors.New("column_jsonb_nn_d: value and JSON modifications cannot be used together")
+			}
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TableCompleteColumnColumnJsonbNnD); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("="); err != nil {
+				return "", nil, err
+			}
+			if err := jsonPatchClause(upsert, TableCompleteColumnColumnJsonbNnD, p.ColumnJsonbNnDSet, p.ColumnJsonbNnDMerge, p.ColumnJsonbNnDRemove); err != nil {
+				return "", nil, err
+			}
+			upsert.Dirty = true
+		}
+		if p.ColumnNumeric.Valid {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TableCompleteColumnColumnNumeric); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("="); err != nil {
+				return "", nil, err
+			}
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(p.ColumnNumeric)
+			upsert.Dirty = true
+
+		}
+		if p.ColumnNumericRange.Valid {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TableCompleteColumnColumnNumericRange); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("="); err != nil {
+				return "", nil, err
+			}
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(p.ColumnNumericRange)
+			upsert.Dirty = true
+
+		}
+		if p.ColumnPoint.Valid {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TableCompleteColumnColumnPoint); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("="); err != nil {
+				return "", nil, err
+			}
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(p.ColumnPoint)
+			upsert.Dirty = true
+
+		}
+		if p.ColumnReal != nil {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TableCompleteColumnColumnReal); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("="); err != nil {
+				return "", nil, err
+			}
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(p.ColumnReal)
+			upsert.Dirty = true
+
+		}
+		if p.ColumnSerial != nil {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TableCompleteColumnColumnSerial); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("="); err != nil {
+				return "", nil, err
+			}
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(p.ColumnSerial)
+			upsert.Dirty = true
+
+		}
+		if p.ColumnSerialBig.Valid {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TableCompleteColumnColumnSerialBig); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("="); err != nil {
+				return "", nil, err
+			}
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(p.ColumnSerialBig)
+			upsert.Dirty = true
+
+		}
+		if p.ColumnSerialSmall != nil {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TableCompleteColumnColumnSerialSmall); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("="); err != nil {
+				return "", nil, err
+			}
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(p.ColumnSerialSmall)
+			upsert.Dirty = true
+
+		}
+		if p.ColumnText.Valid {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TableCompleteColumnColumnText); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("="); err != nil {
+				return "", nil, err
+			}
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(p.ColumnText)
+			upsert.Dirty = true
+
+		}
+		if p.ColumnTextArray0.Valid {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TableCompleteColumnColumnTextArray0); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("="); err != nil {
+				return "", nil, err
+			}
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(p.ColumnTextArray0)
+			upsert.Dirty = true
+
+		}
+		if p.ColumnTextArray100.Valid {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TableCompleteColumnColumnTextArray100); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("="); err != nil {
+				return "", nil, err
+			}
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(p.ColumnTextArray100)
+			upsert.Dirty = true
+
+		}
+		if p.ColumnTime.Valid {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TableCompleteColumnColumnTime); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("="); err != nil {
+				return "", nil, err
+			}
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(p.ColumnTime)
+			upsert.Dirty = true
+
+		}
+		if p.ColumnTimestamp.Valid {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TableCompleteColumnColumnTimestamp); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("="); err != nil {
+				return "", nil, err
+			}
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(p.ColumnTimestamp)
+			upsert.Dirty = true
+
+		}
+		if p.ColumnTimestamptz.Valid {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TableCompleteColumnColumnTimestamptz); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("="); err != nil {
+				return "", nil, err
+			}
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(p.ColumnTimestamptz)
+			upsert.Dirty = true
+
+		}
+		if p.ColumnTimestamptzArray.Valid {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TableCompleteColumnColumnTimestamptzArray); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("="); err != nil {
+				return "", nil, err
+			}
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(p.ColumnTimestamptzArray)
+			upsert.Dirty = true
+
+		}
+		if p.ColumnTimestamptzRange.Valid {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TableCompleteColumnColumnTimestamptzRange); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("="); err != nil {
+				return "", nil, err
+			}
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(p.ColumnTimestamptzRange)
+			upsert.Dirty = true
+
+		}
+		if p.ColumnUUID.Valid {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TableCompleteColumnColumnUUID); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("="); err != nil {
+				return "", nil, err
+			}
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(p.ColumnUUID)
+			upsert.Dirty = true
+
+		}
+		if p.ColumnUUIDArray.Valid {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TableCompleteColumnColumnUUIDArray); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("="); err != nil {
+				return "", nil, err
+			}
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(p.ColumnUUIDArray)
+			upsert.Dirty = true
+
+		}
+	}
+	if len(inf) > 0 && upsert.Dirty {
+		buf.WriteString("(")
+		for j, i := range inf {
+			if j != 0 {
+				buf.WriteString(", ")
+			}
+			buf.WriteString(i)
+		}
+		buf.WriteString(")")
+		buf.WriteString(" DO UPDATE SET ")
+		buf.ReadFrom(upsert)
+	} else {
+		buf.WriteString(" DO NOTHING ")
+	}
+	if upsert.Dirty {
+		buf.WriteString(" RETURNING ")
+		if len(r.Columns) > 0 {
+			buf.WriteString(strings.Join(r.Columns, ", "))
+		} else {
+			buf.WriteString("column_bool, column_bool_array, column_bytea, column_character_0, column_character_100, column_decimal, column_double_array_0, column_double_array_100, column_inet, column_integer, column_integer_array_0, column_integer_array_100, column_integer_big, column_integer_big_array_0, column_integer_big_array_100, column_integer_big_range, column_integer_small, column_integer_small_array_0, column_integer_small_array_100, column_interval, column_json, column_json_nn, column_json_nn_d, column_jsonb, column_jsonb_nn, column_jsonb_nn_d, column_numeric, column_numeric_range, column_point, column_real, column_serial, column_serial_big, column_serial_small, column_text, column_text_array_0, column_text_array_100, column_time, column_timestamp, column_timestamptz, column_timestamptz_array, column_timestamptz_range, column_uuid, column_uuid_array")
+		}
+	}
+	return buf.String(), upsert.Args(), nil
+}
+
+func (r *CompleteRepositoryBase) upsert(ctx context.Context, tx *sql.Tx, e *CompleteEntity, p *CompletePatch, inf ...string) (*CompleteEntity, error) {
+	query, args, err := r.UpsertQuery(e, p, inf...)
+	if err != nil {
+		return nil, err
+	}
+
+	var row *sql.Row
+	if tx == nil {
+		row = r.DB.QueryRowContext(ctx, query, args...)
+	} else {
+		row = tx.QueryRowContext(ctx, query, args...)
+	}
+	err = row.Scan(
+		&e.ColumnBool,
+		&e.ColumnBoolArray,
+		&e.ColumnBytea,
+		&e.ColumnCharacter0,
+		&e.ColumnCharacter100,
+		&e.ColumnDecimal,
+		&e.ColumnDoubleArray0,
+		&e.ColumnDoubleArray100,
+		&e.ColumnInet,
+		&e.ColumnInteger,
+		&e.ColumnIntegerArray0,
+		&e.ColumnIntegerArray100,
+		&e.ColumnIntegerBig,
+		&e.ColumnIntegerBigArray0,
+		&e.ColumnIntegerBigArray100,
+		&e.ColumnIntegerBigRange,
+		&e.ColumnIntegerSmall,
+		&e.ColumnIntegerSmallArray0,
+		&e.ColumnIntegerSmallArray100,
+		&e.ColumnInterval,
+		&e.ColumnJson,
+		&e.ColumnJsonNn,
+		&e.ColumnJsonNnD,
+		&e.ColumnJsonb,
+		&e.ColumnJsonbNn,
+		&e.ColumnJsonbNnD,
+		&e.ColumnNumeric,
+		&e.ColumnNumericRange,
+		&e.ColumnPoint,
+		&e.ColumnReal,
+		&e.ColumnSerial,
+		&e.ColumnSerialBig,
+		&e.ColumnSerialSmall,
+		&e.ColumnText,
+		&e.ColumnTextArray0,
+		&e.ColumnTextArray100,
+		&e.ColumnTime,
+		&e.ColumnTimestamp,
+		&e.ColumnTimestamptz,
+		&e.ColumnTimestamptzArray,
+		&e.ColumnTimestamptzRange,
+		&e.ColumnUUID,
+		&e.ColumnUUIDArray,
+	)
+	if r.Log != nil {
+		if tx == nil {
+			r.Log(err, TableComplete, "upsert", query, args...)
+		} else {
+			r.Log(err, TableComplete, "upsert tx", query, args...)
+		}
+	}
+	if err != nil {
+		return nil, wrapError(err)
+	}
+	return e, nil
+}
+
+func (r *CompleteRepositoryBase) Upsert(ctx context.Context, e *CompleteEntity, p *CompletePatch, inf ...string) (*CompleteEntity, error) {
+	return r.upsert(ctx, nil, e, p, inf...)
+}
+
+// CompleteConflictTarget is a conflict target of complete upsert, only CompleteConflict* variables can be used.
+type CompleteConflictTarget struct {
+	constraint string
+	columns    []string
+	where      string
+}
+
+func (ct CompleteConflictTarget) write(buf *bytes.Buffer) {
+	switch {
+	case ct.constraint != "":
+		buf.WriteString(" ON CONSTRAINT \"")
+		buf.WriteString(ct.constraint)
+		buf.WriteString("\"")
+	case len(ct.columns) > 0:
+		buf.WriteString(" (")
+		buf.WriteString(strings.Join(ct.columns, ", "))
+		buf.WriteString(")")
+		if ct.where != "" {
+			buf.WriteString(" WHERE ")
+			buf.WriteString(ct.where)
+		}
+	}
+}
+
+// CompleteUpsertExpr describes how conflicts are resolved by UpsertOne and UpsertMany.
+type CompleteUpsertExpr struct {
+	// Target is required if Patch is set, DO NOTHING can be used without it.
+	Target CompleteConflictTarget
+	// Patch is applied to the conflicting row (DO UPDATE), if nil or empty the row is left untouched (DO NOTHING).
+	Patch *CompletePatch
+	// Where limits DO UPDATE to the conflicting rows that match the criteria, other rows are left untouched.
+	Where *CompleteCriteria
+}
+
+func (r *CompleteRepositoryBase) UpsertManyQuery(ue *CompleteUpsertExpr, es ...*CompleteEntity) (string, []interface{}, error) {
+	if len(es) == 0 {
+		return "", nil, errors.New("upsert requires at least one entity")
+	}
+	if ue == nil {
+		ue = &CompleteUpsertExpr{}
+	}
+	if r.Validate {
+		for _, e := range es {
+			if err := e.Validate(); err != nil {
+				return "", nil, err
+			}
+		}
+		if ue.Patch != nil {
+			if err := ue.Patch.Validate(); err != nil {
+				return "", nil, err
+			}
+		}
+	}
+	upsert := NewComposer(40)
+	buf := bytes.NewBufferString("INSERT INTO ")
+	buf.WriteString(r.Table)
+	buf.WriteString(" AS t0 (")
+	buf.WriteString(TableCompleteColumnColumnBool + ", " + TableCompleteColumnColumnBoolArray + ", " + TableCompleteColumnColumnBytea + ", " + TableCompleteColumnColumnCharacter0 + ", " + TableCompleteColumnColumnCharacter100 + ", " + TableCompleteColumnColumnDecimal + ", " + TableCompleteColumnColumnDoubleArray0 + ", " + TableCompleteColumnColumnDoubleArray100 + ", " + TableCompleteColumnColumnInet + ", " + TableCompleteColumnColumnInteger + ", " + TableCompleteColumnColumnIntegerArray0 + ", " + TableCompleteColumnColumnIntegerArray100 + ", " + TableCompleteColumnColumnIntegerBig + ", " + TableCompleteColumnColumnIntegerBigArray0 + ", " + TableCompleteColumnColumnIntegerBigArray100 + ", " + TableCompleteColumnColumnIntegerBigRange + ", " + TableCompleteColumnColumnIntegerSmall + ", " + TableCompleteColumnColumnIntegerSmallArray0 + ", " + TableCompleteColumnColumnIntegerSmallArray100 + ", " + TableCompleteColumnColumnInterval + ", " + TableCompleteColumnColumnJson + ", " + TableCompleteColumnColumnJsonNn + ", " + TableCompleteColumnColumnJsonNnD + ", " + TableCompleteColumnColumnJsonb + ", " + TableCompleteColumnColumnJsonbNn + ", " + TableCompleteColumnColumnJsonbNnD + ", " + TableCompleteColumnColumnNumeric + ", " + TableCompleteColumnColumnNumericRange + ", " + TableCompleteColumnColumnPoint + ", " + TableCompleteColumnColumnReal + ", " + TableCompleteColumnColumnText + ", " + TableCompleteColumnColumnTextArray0 + ", " + TableCompleteColumnColumnTextArray100 + ", " + TableCompleteColumnColumnTime + ", " + TableCompleteColumnColumnTimestamp + ", " + TableCompleteColumnColumnTimestamptz + ", " + TableCompleteColumnColumnTimestamptzArray + ", " + TableCompleteColumnColumnTimestamptzRange + ", " + TableCompleteColumnColumnUUID + ", " + TableCompleteColumnColumnUUIDArray)
+	buf.WriteString(") VALUES ")
+	for i, e := range es {
+		if i != 0 {
+			if _, err := upsert.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := upsert.WriteString("("); err != nil {
+			return "", nil, err
+		}
+		if e.ColumnBool.Valid {
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(e.ColumnBool)
+		} else if _, err := upsert.WriteString("DEFAULT"); err != nil {
+			return "", nil, err
+		}
+		if _, err := upsert.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+		if e.ColumnBoolArray.Valid {
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(e.ColumnBoolArray)
+		} else if _, err := upsert.WriteString("DEFAULT"); err != nil {
+			return "", nil, err
+		}
+		if _, err := upsert.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+		if e.ColumnBytea != nil {
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(e.ColumnBytea)
+		} else if _, err := upsert.WriteString("DEFAULT"); err != nil {
+			return "", nil, err
+		}
+		if _, err := upsert.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+		if e.ColumnCharacter0.Valid {
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(e.ColumnCharacter0)
+		} else if _, err := upsert.WriteString("DEFAULT"); err != nil {
+			return "", nil, err
+		}
+		if _, err := upsert.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+		if e.ColumnCharacter100.Valid {
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(e.ColumnCharacter100)
+		} else if _, err := upsert.WriteString("DEFAULT"); err != nil {
+			return "", nil, err
+		}
+		if _, err := upsert.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+		if e.ColumnDecimal.Valid {
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(e.ColumnDecimal)
+		} else if _, err := upsert.WriteString("DEFAULT"); err != nil {
+			return "", nil, err
+		}
+		if _, err := upsert.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+		if e.ColumnDoubleArray0.Valid {
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(e.ColumnDoubleArray0)
+		} else if _, err := upsert.WriteString("DEFAULT"); err != nil {
+			return "", nil, err
+		}
+		if _, err := upsert.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+		if e.ColumnDoubleArray100.Valid {
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(e.ColumnDoubleArray100)
+		} else if _, err := upsert.WriteString("DEFAULT"); err != nil {
+			return "", nil, err
+		}
+		if _, err := upsert.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+		if e.ColumnInet.Valid {
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(e.ColumnInet)
+		} else if _, err := upsert.WriteString("DEFAULT"); err != nil {
+			return "", nil, err
+		}
+		if _, err := upsert.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+		if e.ColumnInteger != nil {
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(e.ColumnInteger)
+		} else if _, err := upsert.WriteString("DEFAULT"); err != nil {
+			return "", nil, err
+		}
+		if _, err := upsert.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+		if e.ColumnIntegerArray0.Valid {
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(e.ColumnIntegerArray0)
+		} else if _, err := upsert.WriteString("DEFAULT"); err != nil {
+			return "", nil, err
+		}
+		if _, err := upsert.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+		if e.ColumnIntegerArray100.Valid {
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(e.ColumnIntegerArray100)
+		} else if _, err := upsert.WriteString("DEFAULT"); err != nil {
+			return "", nil, err
+		}
+		if _, err := upsert.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+		if e.ColumnIntegerBig.Valid {
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(e.ColumnIntegerBig)
+		} else if _, err := upsert.WriteString("DEFAULT"); err != nil {
+			return "", nil, err
+		}
+		if _, err := upsert.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+		if e.ColumnIntegerBigArray0.Valid {
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(e.ColumnIntegerBigArray0)
+		} else if _, err := upsert.WriteString("DEFAULT"); err != nil {
+			return "", nil, err
+		}
+		if _, err := upsert.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+		if e.ColumnIntegerBigArray100.Valid {
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(e.ColumnIntegerBigArray100)
+		} else if _, err := upsert.WriteString("DEFAULT"); err != nil {
+			return "", nil, err
+		}
+		if _, err := upsert.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+		if e.ColumnIntegerBigRange.Valid {
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(e.ColumnIntegerBigRange)
+		} else if _, err := upsert.WriteString("DEFAULT"); err != nil {
+			return "", nil, err
+		}
+		if _, err := upsert.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+		if e.ColumnIntegerSmall != nil {
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(e.ColumnIntegerSmall)
+		} else if _, err := upsert.WriteString("DEFAULT"); err != nil {
+			return "", nil, err
+		}
+		if _, err := upsert.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+		if e.ColumnIntegerSmallArray0.Valid {
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(e.ColumnIntegerSmallArray0)
+		} else if _, err := upsert.WriteString("DEFAULT"); err != nil {
+			return "", nil, err
+		}
+		if _, err := upsert.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+		if e.ColumnIntegerSmallArray100.Valid {
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(e.ColumnIntegerSmallArray100)
+		} else if _, err := upsert.WriteString("DEFAULT"); err != nil {
+			return "", nil, err
+		}
+		if _, err := upsert.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+		if e.ColumnInterval.Valid {
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(e.ColumnInterval)
+		} else if _, err := upsert.WriteString("DEFAULT"); err != nil {
+			return "", nil, err
+		}
+		if _, err := upsert.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+		if e.ColumnJson != nil {
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(e.ColumnJson)
+		} else if _, err := upsert.WriteString("DEFAULT"); err != nil {
+			return "", nil, err
+		}
+		if _, err := upsert.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+		if e.ColumnJsonNn != nil {
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(e.ColumnJsonNn)
+		} else if _, err := upsert.WriteString("DEFAULT"); err != nil {
+			return "", nil, err
+		}
+		if _, err := upsert.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+		if e.ColumnJsonNnD != nil {
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(e.ColumnJsonNnD)
+		} else if _, err := upsert.WriteString("DEFAULT"); err != nil {
+			return "", nil, err
+		}
+		if _, err := upsert.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+		if e.ColumnJsonb != nil {
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(e.ColumnJsonb)
+		} else if _, err := upsert.WriteString("DEFAULT"); err != nil {
+			return "", nil, err
+		}
+		if _, err := upsert.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+		if e.ColumnJsonbNn != nil {
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(e.ColumnJsonbNn)
+		} else if _, err := upsert.WriteString("DEFAULT"); err != nil {
+			return "", nil, err
+		}
+		if _, err := upsert.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+		if e.ColumnJsonbNnD != nil {
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(e.ColumnJsonbNnD)
+		} else if _, err := upsert.WriteString("DEFAULT"); err != nil {
+			return "", nil, err
+		}
+		if _, err := upsert.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+		if e.ColumnNumeric.Valid {
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(e.ColumnNumeric)
+		} else if _, err := upsert.WriteString("DEFAULT"); err != nil {
+			return "", nil, err
+		}
+		if _, err := upsert.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+		if e.ColumnNumericRange.Valid {
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(e.ColumnNumericRange)
+		} else if _, err := upsert.WriteString("DEFAULT"); err != nil {
+			return "", nil, err
+		}
+		if _, err := upsert.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+		if e.ColumnPoint.Valid {
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(e.ColumnPoint)
+		} else if _, err := upsert.WriteString("DEFAULT"); err != nil {
+			return "", nil, err
+		}
+		if _, err := upsert.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+		if e.ColumnReal != nil {
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(e.ColumnReal)
+		} else if _, err := upsert.WriteString("DEFAULT"); err != nil {
+			return "", nil, err
+		}
+		if _, err := upsert.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+		if e.ColumnText.Valid {
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(e.ColumnText)
+		} else if _, err := upsert.WriteString("DEFAULT"); err != nil {
+			return "", nil, err
+		}
+		if _, err := upsert.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+		if e.ColumnTextArray0.Valid {
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(e.ColumnTextArray0)
+		} else if _, err := upsert.WriteString("DEFAULT"); err != nil {
+			return "", nil, err
+		}
+		if _, err := upsert.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+		if e.ColumnTextArray100.Valid {
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(e.ColumnTextArray100)
+		} else if _, err := upsert.WriteString("DEFAULT"); err != nil {
+			return "", nil, err
+		}
+		if _, err := upsert.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+		if e.ColumnTime.Valid {
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(e.ColumnTime)
+		} else if _, err := upsert.WriteString("DEFAULT"); err != nil {
+			return "", nil, err
+		}
+		if _, err := upsert.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+		if e.ColumnTimestamp.Valid {
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(e.ColumnTimestamp)
+		} else if _, err := upsert.WriteString("DEFAULT"); err != nil {
+			return "", nil, err
+		}
+		if _, err := upsert.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+		if e.ColumnTimestamptz.Valid {
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(e.ColumnTimestamptz)
+		} else if _, err := upsert.WriteString("DEFAULT"); err != nil {
+			return "", nil, err
+		}
+		if _, err := upsert.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+		if e.ColumnTimestamptzArray.Valid {
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(e.ColumnTimestamptzArray)
+		} else if _, err := upsert.WriteString("DEFAULT"); err != nil {
+			return "", nil, err
+		}
+		if _, err := upsert.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+		if e.ColumnTimestamptzRange.Valid {
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(e.ColumnTimestamptzRange)
+		} else if _, err := upsert.WriteString("DEFAULT"); err != nil {
+			return "", nil, err
+		}
+		if _, err := upsert.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+		if e.ColumnUUID.Valid {
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(e.ColumnUUID)
+		} else if _, err := upsert.WriteString("DEFAULT"); err != nil {
+			return "", nil, err
+		}
+		if _, err := upsert.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+		if e.ColumnUUIDArray.Valid {
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(e.ColumnUUIDArray)
+		} else if _, err := upsert.WriteString("DEFAULT"); err != nil {
+			return "", nil, err
+		}
+		if _, err := upsert.WriteString(")"); err != nil {
+			return "", nil, err
+		}
+	}
+	buf.ReadFrom(upsert)
+	buf.WriteString(" ON CONFLICT")
+	ue.Target.write(buf)
+
+	upsert.Dirty = false
+	if p := ue.Patch; p != nil {
+		if p.ColumnBool.Valid {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TableCompleteColumnColumnBool); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("="); err != nil {
+				return "", nil, err
+			}
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(p.ColumnBool)
+			upsert.Dirty = true
+
+		}
+		if p.ColumnBoolArray.Valid {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TableCompleteColumnColumnBoolArray); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("="); err != nil {
+				return "", nil, err
+			}
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(p.ColumnBoolArray)
+			upsert.Dirty = true
+
+		}
+		if p.ColumnBytea != nil {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TableCompleteColumnColumnBytea); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("="); err != nil {
+				return "", nil, err
+			}
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(p.ColumnBytea)
+			upsert.Dirty = true
+
+		}
+		if p.ColumnCharacter0.Valid {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TableCompleteColumnColumnCharacter0); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("="); err != nil {
+				return "", nil, err
+			}
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(p.ColumnCharacter0)
+			upsert.Dirty = true
+
+		}
+		if p.ColumnCharacter100.Valid {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TableCompleteColumnColumnCharacter100); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("="); err != nil {
+				return "", nil, err
+			}
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(p.ColumnCharacter100)
+			upsert.Dirty = true
+
+		}
+		if p.ColumnDecimal.Valid {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TableCompleteColumnColumnDecimal); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("="); err != nil {
+				return "", nil, err
+			}
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(p.ColumnDecimal)
+			upsert.Dirty = true
+
+		}
+		if p.ColumnDoubleArray0.Valid {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TableCompleteColumnColumnDoubleArray0); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("="); err != nil {
+				return "", nil, err
+			}
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(p.ColumnDoubleArray0)
+			upsert.Dirty = true
+
+		}
+		if p.ColumnDoubleArray100.Valid {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TableCompleteColumnColumnDoubleArray100); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("="); err != nil {
+				return "", nil, err
+			}
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(p.ColumnDoubleArray100)
+			upsert.Dirty = true
+
+		}
+		if p.ColumnInet.Valid {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TableCompleteColumnColumnInet); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("="); err != nil {
+				return "", nil, err
+			}
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(p.ColumnInet)
+			upsert.Dirty = true
+
+		}
+		if p.ColumnInteger != nil {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TableCompleteColumnColumnInteger); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("="); err != nil {
+				return "", nil, err
+			}
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(p.ColumnInteger)
+			upsert.Dirty = true
+
+		}
+		if p.ColumnIntegerArray0.Valid {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TableCompleteColumnColumnIntegerArray0); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("="); err != nil {
+				return "", nil, err
+			}
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(p.ColumnIntegerArray0)
+			upsert.Dirty = true
+
+		}
+		if p.ColumnIntegerArray100.Valid {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TableCompleteColumnColumnIntegerArray100); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("="); err != nil {
+				return "", nil, err
+			}
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(p.ColumnIntegerArray100)
+			upsert.Dirty = true
+
+		}
+		if p.ColumnIntegerBig.Valid {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TableCompleteColumnColumnIntegerBig); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("="); err != nil {
+				return "", nil, err
+			}
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(p.ColumnIntegerBig)
+			upsert.Dirty = true
+
+		}
+		if p.ColumnIntegerBigArray0.Valid {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TableCompleteColumnColumnIntegerBigArray0); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("="); err != nil {
+				return "", nil, err
+			}
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(p.ColumnIntegerBigArray0)
+			upsert.Dirty = true
+
+		}
+		if p.ColumnIntegerBigArray100.Valid {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TableCompleteColumnColumnIntegerBigArray100); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("="); err != nil {
+				return "", nil, err
+			}
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(p.ColumnIntegerBigArray100)
+			upsert.Dirty = true
+
+		}
+		if p.ColumnIntegerBigRange.Valid {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TableCompleteColumnColumnIntegerBigRange); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("="); err != nil {
+				return "", nil, err
+			}
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(p.ColumnIntegerBigRange)
+			upsert.Dirty = true
+
+		}
+		if p.ColumnIntegerSmall != nil {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TableCompleteColumnColumnIntegerSmall); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("="); err != nil {
+				return "", nil, err
+			}
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(p.ColumnIntegerSmall)
+			upsert.Dirty = true
+
+		}
+		if p.ColumnIntegerSmallArray0.Valid {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TableCompleteColumnColumnIntegerSmallArray0); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("="); err != nil {
+				return "", nil, err
+			}
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(p.ColumnIntegerSmallArray0)
+			upsert.Dirty = true
+
+		}
+		if p.ColumnIntegerSmallArray100.Valid {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TableCompleteColumnColumnIntegerSmallArray100); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("="); err != nil {
+				return "", nil, err
+			}
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(p.ColumnIntegerSmallArray100)
+			upsert.Dirty = true
+
+		}
+		if p.ColumnInterval.Valid {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TableCompleteColumnColumnInterval); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("="); err != nil {
+				return "", nil, err
+			}
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(p.ColumnInterval)
+			upsert.Dirty = true
+
+		}
+		if p.ColumnJson != nil {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TableCompleteColumnColumnJson); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("="); err != nil {
+				return "", nil, err
+			}
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(p.ColumnJson)
+			upsert.Dirty = true
+
+		}
+		if p.ColumnJsonNn != nil {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TableCompleteColumnColumnJsonNn); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("="); err != nil {
+				return "", nil, err
+			}
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(p.ColumnJsonNn)
+			upsert.Dirty = true
+
+		}
+		if p.ColumnJsonNnD != nil {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TableCompleteColumnColumnJsonNnD); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("="); err != nil {
+				return "", nil, err
+			}
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(p.ColumnJsonNnD)
+			upsert.Dirty = true
+
+		}
+		if p.ColumnJsonb != nil {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TableCompleteColumnColumnJsonb); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("="); err != nil {
+				return "", nil, err
+			}
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(p.ColumnJsonb)
+			upsert.Dirty = true
+
+		}
+		if len(p.ColumnJsonbSet) > 0 || p.ColumnJsonbMerge != nil || len(p.ColumnJsonbRemove) > 0 {
+			if p.ColumnJsonb != nil {
+				return "", nil, errors.New("column_jsonb: value and JSON modifications cannot be used together")
+			}
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TableCompleteColumnColumnJsonb); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("="); err != nil {
+				return "", nil, err
+			}
+			if err := jsonPatchClause(upsert, TableCompleteColumnColumnJsonb, p.ColumnJsonbSet, p.ColumnJsonbMerge, p.ColumnJsonbRemove); err != nil {
+				return "", nil, err
+			}
+			upsert.Dirty = true
+		}
+		if p.ColumnJsonbNn != nil {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TableCompleteColumnColumnJsonbNn); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("="); err != nil {
+				return "", nil, err
+			}
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(p.ColumnJsonbNn)
+			upsert.Dirty = true
+
+		}
+		if len(p.ColumnJsonbNnSet) > 0 || p.ColumnJsonbNnMerge != nil || len(p.ColumnJsonbNnRemove) > 0 {
+			if p.ColumnJsonbNn != nil {
+				return "", nil, errors.New("column_jsonb_nn: value and JSON modifications cannot be used together")
+			}
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TableCompleteColumnColumnJsonbNn); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("="); err != nil {
+				return "", nil, err
+			}
+			if err := jsonPatchClause(upsert, TableCompleteColumnColumnJsonbNn, p.ColumnJsonbNnSet, p.ColumnJsonbNnMerge, p.ColumnJsonbNnRemove); err != nil {
+				return "", nil, err
+			}
+			upsert.Dirty = true
+		}
+		if p.ColumnJsonbNnD != nil {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TableCompleteColumnColumnJsonbNnD); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("="); err != nil {
+				return "", nil, err
+			}
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(p.ColumnJsonbNnD)
+			upsert.Dirty = true
+
+		}
+		if len(p.ColumnJsonbNnDSet) > 0 || p.ColumnJsonbNnDMerge != nil || len(p.ColumnJsonbNnDRemove) > 0 {
+			if p.ColumnJsonbNnD != nil {
+				return "", nil, errors.New("column_jsonb_nn_d: value and JSON modifications cannot be used together")
+			}
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TableCompleteColumnColumnJsonbNnD); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("="); err != nil {
+				return "", nil, err
+			}
+			if err := jsonPatchClause(upsert, TableCompleteColumnColumnJsonbNnD, p.ColumnJsonbNnDSet, p.ColumnJsonbNnDMerge, p.ColumnJsonbNnDRemove); err != nil {
+				return "", nil, err
+			}
+			upsert.Dirty = true
+		}
+		if p.ColumnNumeric.Valid {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TableCompleteColumnColumnNumeric); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("="); err != nil {
+				return "", nil, err
+			}
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(p.ColumnNumeric)
+			upsert.Dirty = true
+
+		}
+		if p.ColumnNumericRange.Valid {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TableCompleteColumnColumnNumericRange); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("="); err != nil {
+				return "", nil, err
+			}
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(p.ColumnNumericRange)
+			upsert.Dirty = true
+
+		}
+		if p.ColumnPoint.Valid {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TableCompleteColumnColumnPoint); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("="); err != nil {
+				return "", nil, err
+			}
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(p.ColumnPoint)
+			upsert.Dirty = true
+
+		}
+		if p.ColumnReal != nil {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TableCompleteColumnColumnReal); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("="); err != nil {
+				return "", nil, err
+			}
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(p.ColumnReal)
+			upsert.Dirty = true
+
+		}
+		if p.ColumnSerial != nil {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TableCompleteColumnColumnSerial); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("="); err != nil {
+				return "", nil, err
+			}
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(p.ColumnSerial)
+			upsert.Dirty = true
+
+		}
+		if p.ColumnSerialBig.Valid {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TableCompleteColumnColumnSerialBig); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("="); err != nil {
+				return "", nil, err
+			}
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(p.ColumnSerialBig)
+			upsert.Dirty = true
+
+		}
+		if p.ColumnSerialSmall != nil {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TableCompleteColumnColumnSerialSmall); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("="); err != nil {
+				return "", nil, err
+			}
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(p.ColumnSerialSmall)
+			upsert.Dirty = true
+
+		}
+		if p.ColumnText.Valid {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TableCompleteColumnColumnText); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("="); err != nil {
+				return "", nil, err
+			}
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(p.ColumnText)
+			upsert.Dirty = true
+
+		}
+		if p.ColumnTextArray0.Valid {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TableCompleteColumnColumnTextArray0); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("="); err != nil {
+				return "", nil, err
+			}
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(p.ColumnTextArray0)
+			upsert.Dirty = true
+
+		}
+		if p.ColumnTextArray100.Valid {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TableCompleteColumnColumnTextArray100); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("="); err != nil {
+				return "", nil, err
+			}
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(p.ColumnTextArray100)
+			upsert.Dirty = true
+
+		}
+		if p.ColumnTime.Valid {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TableCompleteColumnColumnTime); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("="); err != nil {
+				return "", nil, err
+			}
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(p.ColumnTime)
+			upsert.Dirty = true
+
+		}
+		if p.ColumnTimestamp.Valid {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TableCompleteColumnColumnTimestamp); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("="); err != nil {
+				return "", nil, err
+			}
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(p.ColumnTimestamp)
+			upsert.Dirty = true
+
+		}
+		if p.ColumnTimestamptz.Valid {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TableCompleteColumnColumnTimestamptz); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("="); err != nil {
+				return "", nil, err
+			}
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(p.ColumnTimestamptz)
+			upsert.Dirty = true
+
+		}
+		if p.ColumnTimestamptzArray.Valid {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TableCompleteColumnColumnTimestamptzArray); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("="); err != nil {
+				return "", nil, err
+			}
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(p.ColumnTimestamptzArray)
+			upsert.Dirty = true
+
+		}
+		if p.ColumnTimestamptzRange.Valid {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TableCompleteColumnColumnTimestamptzRange); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("="); err != nil {
+				return "", nil, err
+			}
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(p.ColumnTimestamptzRange)
+			upsert.Dirty = true
+
+		}
+		if p.ColumnUUID.Valid {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TableCompleteColumnColumnUUID); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("="); err != nil {
+				return "", nil, err
+			}
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(p.ColumnUUID)
+			upsert.Dirty = true
+
+		}
+		if p.ColumnUUIDArray.Valid {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TableCompleteColumnColumnUUIDArray); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("="); err != nil {
+				return "", nil, err
+			}
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(p.ColumnUUIDArray)
+			upsert.Dirty = true
+
+		}
+	}
+	if !upsert.Dirty {
+		buf.WriteString(" DO NOTHING")
+	} else {
+		if ue.Target.constraint == "" && len(ue.Target.columns) == 0 {
+			return "", nil, errors.New("upsert with patch requires conflict target")
+		}
+		buf.WriteString(" DO UPDATE SET ")
+		buf.ReadFrom(upsert)
+		if ue.Where != nil {
+			upsert.Dirty = false
+			if err := CompleteCriteriaWhereClause(upsert, ue.Where, 0, r.Table); err != nil {
+				return "", nil, err
+			}
+			if upsert.Dirty {
+				buf.WriteString(" WHERE ")
+				buf.ReadFrom(upsert)
+			}
+		}
+	}
+	buf.WriteString(" RETURNING ")
+	if len(r.Columns) > 0 {
+		buf.WriteString(strings.Join(r.Columns, ", "))
+	} else {
+		buf.WriteString("column_bool, column_bool_array, column_bytea, column_character_0, column_character_100, column_decimal, column_double_array_0, column_double_array_100, column_inet, column_integer, column_integer_array_0, column_integer_array_100, column_integer_big, column_integer_big_array_0, column_integer_big_array_100, column_integer_big_range, column_integer_small, column_integer_small_array_0, column_integer_small_array_100, column_interval, column_json, column_json_nn, column_json_nn_d, column_jsonb, column_jsonb_nn, column_jsonb_nn_d, column_numeric, column_numeric_range, column_point, column_real, column_serial, column_serial_big, column_serial_small, column_text, column_text_array_0, column_text_array_100, column_time, column_timestamp, column_timestamptz, column_timestamptz_array, column_timestamptz_range, column_uuid, column_uuid_array")
+	}
+	return buf.String(), upsert.Args(), nil
+}
+
+func (r *CompleteRepositoryBase) upsertMany(ctx context.Context, tx *sql.Tx, ue *CompleteUpsertExpr, es ...*CompleteEntity) ([]*CompleteEntity, error) {
+	query, args, err := r.UpsertManyQuery(ue, es...)
+	if err != nil {
+		return nil, err
+	}
+	var rows *sql.Rows
+	if tx == nil {
+		rows, err = r.DB.QueryContext(ctx, query, args...)
+	} else {
+		rows, err = tx.QueryContext(ctx, query, args...)
+	}
+	if r.Log != nil {
+		if tx == nil {
+			r.Log(err, TableComplete, "upsert many", query, args...)
+		} else {
+			r.Log(err, TableComplete, "upsert many tx", query, args...)
+		}
+	}
+	if err != nil {
+		return nil, wrapError(err)
+	}
+	defer rows.Close()
+
+	var entities []*CompleteEntity
+	for rows.Next() {
+		var ent CompleteEntity
+		props, err := ent.Props(r.Columns...)
+		if err != nil {
+			return nil, err
+		}
+		if err = rows.Scan(props...); err != nil {
+			return nil, err
+		}
+		entities = append(entities, &ent)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, wrapError(err)
+	}
+	return entities, nil
+}
+
+// UpsertMany inserts entities in a single statement, conflicts are resolved as described by the expression.
+// Returned entities are those inserted or updated, rows left untouched by DO NOTHING or Where are omitted.
+func (r *CompleteRepositoryBase) UpsertMany(ctx context.Context, ue *CompleteUpsertExpr, es ...*CompleteEntity) ([]*CompleteEntity, error) {
+	return r.upsertMany(ctx, nil, ue, es...)
+}
+
+// UpsertOne inserts the entity, conflict is resolved as described by the expression.
+// Returned flag is false if the row was neither inserted nor updated.
+func (r *CompleteRepositoryBase) UpsertOne(ctx context.Context, e *CompleteEntity, ue *CompleteUpsertExpr) (*CompleteEntity, bool, error) {
+	return r.upsertOne(ctx, nil, e, ue)
+}
+
+func (r *CompleteRepositoryBase) upsertOne(ctx context.Context, tx *sql.Tx, e *CompleteEntity, ue *CompleteUpsertExpr) (*CompleteEntity, bool, error) {
+	entities, err := r.upsertMany(ctx, tx, ue, e)
+	if err != nil {
+		return nil, false, err
+	}
+	if len(entities) == 0 {
+		return e, false, nil
+	}
+	*e = *entities[0]
+	return e, true, nil
+}
+
+func (r *CompleteRepositoryBase) count(ctx context.Context, tx *sql.Tx, exp *CompleteCountExpr) (int64, error) {
+	query, args, err := r.FindQuery(&CompleteFindExpr{
+		Where:   exp.Where,
+		Columns: []CompleteColumn{completeColumnCount},
+	})
+	if err != nil {
+		return 0, err
+	}
+	var count int64
+	if tx == nil {
+		err = r.DB.QueryRowContext(ctx, query, args...).Scan(&count)
+	} else {
+		err = tx.QueryRowContext(ctx, query, args...).Scan(&count)
+	}
+	if r.Log != nil {
+		if tx == nil {
+			r.Log(err, TableComplete, "count", query, args...)
+		} else {
+			r.Log(err, TableComplete, "count tx", query, args...)
+		}
+	}
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+func (r *CompleteRepositoryBase) Count(ctx context.Context, exp *CompleteCountExpr) (int64, error) {
+	return r.count(ctx, nil, exp)
+}
+
+type CompleteRepositoryBaseTx struct {
+	base *CompleteRepositoryBase
+	tx   *sql.Tx
+}
+
+func (r CompleteRepositoryBaseTx) Commit() error {
+	return r.tx.Commit()
+}
+
+func (r CompleteRepositoryBaseTx) Rollback() error {
+	return r.tx.Rollback()
+}
+
+// Savepoint establishes new savepoint within the transaction.
+func (r *CompleteRepositoryBaseTx) Savepoint(ctx context.Context, name string) error {
+	return savepoint(ctx, r.tx, name)
+}
+
+// RollbackTo rolls back all changes made after the savepoint was established, the transaction can be continued.
+func (r *CompleteRepositoryBaseTx) RollbackTo(ctx context.Context, name string) error {
+	return rollbackToSavepoint(ctx, r.tx, name)
+}
+
+// Release destroys the savepoint, changes made after it was established are kept.
+func (r *CompleteRepositoryBaseTx) Release(ctx context.Context, name string) error {
+	return releaseSavepoint(ctx, r.tx, name)
+}
+
+// RunInTransaction runs given function within a savepoint of the transaction, see RunInSavepoint.
+func (r *CompleteRepositoryBaseTx) RunInTransaction(ctx context.Context, fn func(rtx *CompleteRepositoryBaseTx) error) error {
+	return RunInSavepoint(ctx, r.tx, func(*sql.Tx) error {
+		return fn(r)
+	})
+}
+
+func (r *CompleteRepositoryBaseTx) Insert(ctx context.Context, e *CompleteEntity) (*CompleteEntity, error) {
+	return r.base.insert(ctx, r.tx, e)
+}
+
+func (r *CompleteRepositoryBaseTx) Find(ctx context.Context, fe *CompleteFindExpr) ([]*CompleteEntity, error) {
+	return r.base.find(ctx, r.tx, fe)
+}
+
+func (r *CompleteRepositoryBaseTx) FindIter(ctx context.Context, fe *CompleteFindExpr) (*CompleteIterator, error) {
+	return r.base.findIter(ctx, r.tx, fe)
+}
+
+func (r *CompleteRepositoryBaseTx) FindProjection(ctx context.Context, fe *CompleteFindExpr, next func() Projection) error {
+	return r.base.findProjection(ctx, r.tx, fe, next)
+}
+
+func (r *CompleteRepositoryBaseTx) Upsert(ctx context.Context, e *CompleteEntity, p *CompletePatch, inf ...string) (*CompleteEntity, error) {
+	return r.base.upsert(ctx, r.tx, e, p, inf...)
+}
+
+func (r *CompleteRepositoryBaseTx) UpsertMany(ctx context.Context, ue *CompleteUpsertExpr, es ...*CompleteEntity) ([]*CompleteEntity, error) {
+	return r.base.upsertMany(ctx, r.tx, ue, es...)
+}
+
+func (r *CompleteRepositoryBaseTx) UpsertOne(ctx context.Context, e *CompleteEntity, ue *CompleteUpsertExpr) (*CompleteEntity, bool, error) {
+	return r.base.upsertOne(ctx, r.tx, e, ue)
+}
+
+func (r *CompleteRepositoryBaseTx) Count(ctx context.Context, exp *CompleteCountExpr) (int64, error) {
+	return r.base.count(ctx, r.tx, exp)
+}
+
+// NewCompleteFixture returns CompleteEntity which mandatory columns are filled with random values, overrides are applied afterwards.
+// If overrides leave a required foreign key unset, parent fixture is inserted and referenced.
+func (f *Fixtures) NewCompleteFixture(ctx context.Context, overrides ...func(*CompleteEntity)) (*CompleteEntity, error) {
+	e := &CompleteEntity{
+		ColumnJsonNn:  []byte("{}"),
+		ColumnJsonbNn: []byte("{}"),
+	}
+	for _, o := range overrides {
+		o(e)
+	}
+	return e, nil
+}
+
+// InsertCompleteFixture works like NewCompleteFixture, but it inserts the fixture as well.
+func (f *Fixtures) InsertCompleteFixture(ctx context.Context, overrides ...func(*CompleteEntity)) (*CompleteEntity, error) {
+	e, err := f.NewCompleteFixture(ctx, overrides...)
+	if err != nil {
+		return nil, err
+	}
+	return (&CompleteRepositoryBase{Table: TableComplete, DB: f.DB}).Insert(ctx, e)
+}
@@ -1,4 +1,3 @@
 package model
 
 //go:generate generator
-//go:generate goimports -w schema.pqt.go
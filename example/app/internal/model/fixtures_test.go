@@ -0,0 +1,62 @@
+package model_test
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/piotrkowalczuk/pqt/example/app/internal/model"
+)
+
+func TestFixtures_NewNewsFixture(t *testing.T) {
+	ctx := context.Background()
+
+	got1, err := model.NewFixtures(nil, 1).NewNewsFixture(ctx)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	got2, err := model.NewFixtures(nil, 1).NewNewsFixture(ctx)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	if !reflect.DeepEqual(got1, got2) {
+		t.Errorf("fixtures built using the same seed expected to be equal, got %v and %v", got1, got2)
+	}
+	if got1.Title == "" || got1.Content == "" {
+		t.Errorf("mandatory columns expected to be filled, got %v", got1)
+	}
+
+	got3, err := model.NewFixtures(nil, 1).NewNewsFixture(ctx, func(e *model.NewsEntity) {
+		e.Title = "title"
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	if got3.Title != "title" {
+		t.Errorf("wrong title, expected %s but got %s", "title", got3.Title)
+	}
+}
+
+func TestFixtures_InsertCommentFixture(t *testing.T) {
+	s := setup(t)
+	defer s.teardown(t)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	f := model.NewFixtures(s.db, 1)
+	for i := 0; i < 3; i++ {
+		got, err := f.InsertCommentFixture(ctx)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err.Error())
+		}
+		news, err := s.news.FindOneByID(ctx, got.NewsID)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err.Error())
+		}
+		if news.Title != got.NewsTitle {
+			t.Errorf("comment expected to reference single news, but got %s and %s", news.Title, got.NewsTitle)
+		}
+	}
+}
@@ -56,12 +56,13 @@ func join(arr []string, id int) string {
 }
 
 type suite struct {
-	db       *sql.DB
-	news     *model.NewsRepositoryBase
-	category *model.CategoryRepositoryBase
-	comment  *model.CommentRepositoryBase
-	pkg      *model.PackageRepositoryBase
-	complete *model.CompleteRepositoryBase
+	db           *sql.DB
+	news         *model.NewsRepositoryBase
+	category     *model.CategoryRepositoryBase
+	categoryNews *model.CategoryNewsRepositoryBase
+	comment      *model.CommentRepositoryBase
+	pkg          *model.PackageRepositoryBase
+	complete     *model.CompleteRepositoryBase
 }
 
 func setup(t testing.TB) *suite {
@@ -97,6 +98,11 @@ func setup(t testing.TB) *suite {
 			DB:    db,
 			Log:   log,
 		},
+		categoryNews: &model.CategoryNewsRepositoryBase{
+			Table: model.TableCategoryNews,
+			DB:    db,
+			Log:   log,
+		},
 		complete: &model.CompleteRepositoryBase{
 			Table: model.TableComplete,
 			DB:    db,
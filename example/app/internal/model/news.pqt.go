@@ -0,0 +1,4459 @@
+// Code generated by pqt. DO NOT EDIT.
+
+package model
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/lib/pq"
+)
+
+const (
+	TableNewsConstraintPrimaryKey      = "example.news_id_pkey"
+	TableNewsConstraintTitleUnique     = "example.news_title_key"
+	TableNewsConstraintTitleLeadUnique = "example.news_title_lead_key"
+	TableNewsConstraintDocumentIndex   = "example.news_document_idx"
+)
+
+const (
+	TableNews                        = "example.news"
+	TableNewsColumnContent           = "content"
+	TableNewsColumnContinue          = "continue"
+	TableNewsColumnCreatedAt         = "created_at"
+	TableNewsColumnDay               = "day"
+	TableNewsColumnDocument          = "document"
+	TableNewsColumnID                = "id"
+	TableNewsColumnLead              = "lead"
+	TableNewsColumnMetaData          = "meta_data"
+	TableNewsColumnScore             = "score"
+	TableNewsColumnTitle             = "title"
+	TableNewsColumnUpdatedAt         = "updated_at"
+	TableNewsColumnVersion           = "version"
+	TableNewsColumnViewsDistribution = "views_distribution"
+)
+
+var TableNewsColumns = []string{
+	TableNewsColumnContent,
+	TableNewsColumnContinue,
+	TableNewsColumnCreatedAt,
+	TableNewsColumnDay,
+	TableNewsColumnDocument,
+	TableNewsColumnID,
+	TableNewsColumnLead,
+	TableNewsColumnMetaData,
+	TableNewsColumnScore,
+	TableNewsColumnTitle,
+	TableNewsColumnUpdatedAt,
+	TableNewsColumnVersion,
+	TableNewsColumnViewsDistribution,
+}
+
+// NewsEntity ...
+type NewsEntity struct {
+	// Content ...
+	Content string
+	// Continue ...
+	Continue bool
+	// CreatedAt ...
+	CreatedAt time.Time
+	// Day ...
+	Day pq.NullTime
+	// Document ...
+	// Document is read only
+	Document sql.NullString
+	// ID ...
+	ID int64
+	// Lead ...
+	Lead sql.NullString
+	// MetaData ...
+	MetaData []byte
+	// Score ...
+	Score float64
+	// Title ...
+	Title string
+	// UpdatedAt ...
+	UpdatedAt pq.NullTime
+	// Version ...
+	Version int64
+	// ViewsDistribution ...
+	ViewsDistribution NullFloat64Array
+	// CommentsByNewsTitle ...
+	CommentsByNewsTitle []*CommentEntity
+	// Comments ...
+	Comments []*CommentEntity
+	// Categorys ...
+	Categorys []*CategoryEntity
+	// DocumentHeadline is a ts_headline snippet of document, it is populated only if requested by NewsFindExpr.DocumentHeadline.
+	DocumentHeadline sql.NullString
+	// fields holds fields loaded by a projection, see Loaded.
+	fields NewsFields
+}
+
+func (e *NewsEntity) Prop(cn string) (interface{}, bool) {
+	switch cn {
+
+	case TableNewsColumnContent:
+		return &e.Content, true
+	case TableNewsColumnContinue:
+		return &e.Continue, true
+	case TableNewsColumnCreatedAt:
+		return &e.CreatedAt, true
+	case TableNewsColumnDay:
+		return &e.Day, true
+	case TableNewsColumnDocument:
+		return &e.Document, true
+	case TableNewsColumnID:
+		return &e.ID, true
+	case TableNewsColumnLead:
+		return &e.Lead, true
+	case TableNewsColumnMetaData:
+		return &e.MetaData, true
+	case TableNewsColumnScore:
+		return &e.Score, true
+	case TableNewsColumnTitle:
+		return &e.Title, true
+	case TableNewsColumnUpdatedAt:
+		return &e.UpdatedAt, true
+	case TableNewsColumnVersion:
+		return &e.Version, true
+	case TableNewsColumnViewsDistribution:
+		return &e.ViewsDistribution, true
+	default:
+		return nil, false
+	}
+}
+
+func (e *NewsEntity) Props(cns ...string) ([]interface{}, error) {
+	projection := len(cns) > 0
+	if !projection {
+		cns = TableNewsColumns
+	}
+	e.fields = NewsFields{}
+	res := make([]interface{}, 0, len(cns))
+	for _, cn := range cns {
+		if prop, ok := e.Prop(cn); ok {
+			res = append(res, prop)
+		} else {
+			return nil, fmt.Errorf("unexpected column provided: %s", cn)
+		}
+		if projection {
+			e.fields.add(cn)
+		}
+	}
+	return res, nil
+}
+
+// NewsFields is a set of NewsEntity fields, identified by their columns.
+type NewsFields [1]uint64
+
+// Has reports whether the set contains field of given column.
+func (f NewsFields) Has(cn string) bool {
+	i := newsFieldIndex(cn)
+	return i >= 0 && f[i/64]&(1<<uint(i%64)) != 0
+}
+
+func (f *NewsFields) add(cn string) {
+	if i := newsFieldIndex(cn); i >= 0 {
+		f[i/64] |= 1 << uint(i%64)
+	}
+}
+
+// newsFieldIndex returns position of the column in TableNewsColumns, or -1 if there is no such column.
+func newsFieldIndex(cn string) int {
+	switch cn {
+	case TableNewsColumnContent:
+		return 0
+	case TableNewsColumnContinue:
+		return 1
+	case TableNewsColumnCreatedAt:
+		return 2
+	case TableNewsColumnDay:
+		return 3
+	case TableNewsColumnDocument:
+		return 4
+	case TableNewsColumnID:
+		return 5
+	case TableNewsColumnLead:
+		return 6
+	case TableNewsColumnMetaData:
+		return 7
+	case TableNewsColumnScore:
+		return 8
+	case TableNewsColumnTitle:
+		return 9
+	case TableNewsColumnUpdatedAt:
+		return 10
+	case TableNewsColumnVersion:
+		return 11
+	case TableNewsColumnViewsDistribution:
+		return 12
+	}
+	return -1
+}
+
+// Loaded reports whether field of given column was loaded by the query that returned the entity.
+// Entities loaded with all columns, as well as entities built by hand, report every field as loaded.
+func (e *NewsEntity) Loaded(cn string) bool {
+	if e.fields == (NewsFields{}) {
+		return newsFieldIndex(cn) >= 0
+	}
+	return e.fields.Has(cn)
+}
+
+// Validate checks if entity meets NOT NULL, length, precision, enum, array size and simple check constraints of the table.
+// It returns ValidationError that lists all violations, if any.
+func (e *NewsEntity) Validate() error {
+	var errs ValidationError
+	if !numericFits(e.Score, 20, 8) {
+		errs = append(errs, &FieldError{Field: "Score", Column: TableNewsColumnScore, Reason: "does not fit numeric(20,8)"})
+	}
+	if e.ViewsDistribution.Valid {
+		if len(e.ViewsDistribution.Float64Array) > 168 {
+			errs = append(errs, &FieldError{Field: "ViewsDistribution", Column: TableNewsColumnViewsDistribution, Reason: "has more than 168 elements"})
+		}
+	}
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+const (
+	TableNewsHistory                       = "example.news_history"
+	TableNewsHistoryColumnHistoryOperation = "history_operation"
+	TableNewsHistoryColumnHistoryAt        = "history_at"
+	TableNewsHistoryColumnHistoryActor     = "history_actor"
+)
+
+// NewsHistoryEntity is a single version of a news row stored in the history table.
+type NewsHistoryEntity struct {
+	NewsEntity
+	// HistoryOperation is a type of the change (INSERT, UPDATE or DELETE) that produced this version.
+	HistoryOperation string
+	// HistoryAt is a time of the change.
+	HistoryAt time.Time
+	// HistoryActor is an author of the change, if it was provided.
+	HistoryActor sql.NullString
+}
+
+const TableNewsChannel = "example_news"
+
+// NewsChange represents single change of a news row.
+type NewsChange struct {
+	Operation ChangeOperation
+	ID        int64
+	Columns   []string
+}
+
+// Changed returns true if given column was modified by the change.
+func (c *NewsChange) Changed(column string) bool {
+	for _, col := range c.Columns {
+		if col == column {
+			return true
+		}
+	}
+	return false
+}
+
+// DecodeNewsChange decodes notification payload published by the news table trigger.
+func DecodeNewsChange(payload string) (*NewsChange, error) {
+	var msg struct {
+		Operation ChangeOperation `json:"operation"`
+		Key       struct {
+			ID int64 `json:"id"`
+		} `json:"key"`
+		Columns []string `json:"columns"`
+	}
+	if err := json.Unmarshal([]byte(payload), &msg); err != nil {
+		return nil, err
+	}
+	return &NewsChange{
+		Operation: msg.Operation,
+		ID:        msg.Key.ID,
+		Columns:   msg.Columns,
+	}, nil
+}
+
+// NewsChangeListener receives changes published on TableNewsChannel.
+// Listener should not be shared with other change listeners, otherwise notifications will be lost.
+type NewsChangeListener struct {
+	Listener Listener
+	Log      LogFunc
+}
+
+// Listen subscribes to TableNewsChannel and calls fn for every change,
+// until context is done, notification channel is closed or fn returns an error.
+func (l *NewsChangeListener) Listen(ctx context.Context, fn func(*NewsChange) error) error {
+	if err := l.Listener.Listen(TableNewsChannel); err != nil {
+		return err
+	}
+	defer l.Listener.Unlisten(TableNewsChannel)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case n, ok := <-l.Listener.NotificationChannel():
+			if !ok {
+				return nil
+			}
+			// nil notification is sent after the connection has been re-established.
+			if n == nil || n.Channel != TableNewsChannel {
+				continue
+			}
+			change, err := DecodeNewsChange(n.Extra)
+			if l.Log != nil {
+				l.Log(err, TableNews, "listen", n.Extra)
+			}
+			if err != nil {
+				return err
+			}
+			if err := fn(change); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// ScanNewsRows helps to scan rows straight to the slice of entities.
+func ScanNewsRows(rows Rows) (entities []*NewsEntity, err error) {
+	for rows.Next() {
+		var ent NewsEntity
+		err = rows.Scan(
+			&ent.Content,
+			&ent.Continue,
+			&ent.CreatedAt,
+			&ent.Day,
+			&ent.Document,
+			&ent.ID,
+			&ent.Lead,
+			&ent.MetaData,
+			&ent.Score,
+			&ent.Title,
+			&ent.UpdatedAt,
+			&ent.Version,
+			&ent.ViewsDistribution,
+		)
+		if err != nil {
+			return
+		}
+
+		entities = append(entities, &ent)
+	}
+	if err = rows.Err(); err != nil {
+		return
+	}
+
+	return
+}
+
+// NewsIterator is not thread safe.
+type NewsIterator struct {
+	rows Rows
+	cols []string
+	expr *NewsFindExpr
+}
+
+func (i *NewsIterator) Next() bool {
+	return i.rows.Next()
+}
+
+func (i *NewsIterator) Close() error {
+	return i.rows.Close()
+}
+
+func (i *NewsIterator) Err() error {
+	return i.rows.Err()
+}
+
+// Columns is wrapper around sql.Rows.Columns method, that also cache output inside iterator.
+func (i *NewsIterator) Columns() ([]string, error) {
+	if i.cols == nil {
+		cols, err := i.rows.Columns()
+		if err != nil {
+			return nil, err
+		}
+		i.cols = cols
+	}
+	return i.cols, nil
+}
+
+// Ent is wrapper around News method that makes iterator more generic.
+func (i *NewsIterator) Ent() (interface{}, error) {
+	return i.News()
+}
+
+func (i *NewsIterator) News() (*NewsEntity, error) {
+	var ent NewsEntity
+	cols, err := i.Columns()
+	if err != nil {
+		return nil, err
+	}
+
+	props, err := ent.Props(cols...)
+	if err != nil {
+		return nil, err
+	}
+	if i.expr.DocumentHeadline != nil {
+		props = append(props, &ent.DocumentHeadline)
+	}
+	if err := i.rows.Scan(props...); err != nil {
+		return nil, err
+	}
+	return &ent, nil
+}
+
+type NewsCriteria struct {
+	Content   sql.NullString
+	Continue  sql.NullBool
+	CreatedAt pq.NullTime
+	Day       pq.NullTime
+	Document  sql.NullString
+	// DocumentSearch matches rows which document matches given query (@@).
+	DocumentSearch *TextSearch
+	ID             sql.NullInt64
+	Lead           sql.NullString
+	MetaData       []byte
+	// MetaDataContains matches rows where meta_data contains given JSON document (@>).
+	MetaDataContains []byte
+	// MetaDataHasKey matches rows where meta_data has given top-level key (?).
+	MetaDataHasKey sql.NullString
+	// MetaDataHasAnyKeys matches rows where meta_data has any of given top-level keys (?|).
+	MetaDataHasAnyKeys pq.StringArray
+	// MetaDataHasAllKeys matches rows where meta_data has all of given top-level keys (?&).
+	MetaDataHasAllKeys pq.StringArray
+	// MetaDataPath matches rows where all predicates on values extracted from meta_data are true (->>, #>>).
+	MetaDataPath []JSONPredicate
+	// MetaDataMatch matches rows where jsonpath predicate check on meta_data returns true (@@).
+	MetaDataMatch sql.NullString
+	// MetaDataExists matches rows where jsonpath returns any item for meta_data (@?).
+	MetaDataExists    sql.NullString
+	Score             sql.NullFloat64
+	Title             sql.NullString
+	UpdatedAt         pq.NullTime
+	Version           sql.NullInt64
+	ViewsDistribution NullFloat64Array
+	// ViewsDistributionContains matches rows where views_distribution contains all given elements (@>).
+	ViewsDistributionContains NullFloat64Array
+	// ViewsDistributionOverlap matches rows where views_distribution has any elements in common with given ones (&&).
+	ViewsDistributionOverlap NullFloat64Array
+	// ViewsDistributionAny matches rows where any element of views_distribution equals given value (= ANY).
+	ViewsDistributionAny                             sql.NullFloat64
+	hasCommentsByNewsTitle, hasNoCommentsByNewsTitle *CommentCriteria
+	hasComments, hasNoComments                       *CommentCriteria
+	hasCategorys, hasNoCategorys                     *CategoryCriteria
+	operator                                         string
+	child, sibling, parent                           *NewsCriteria
+}
+
+// HasCommentsByNewsTitle matches news rows that have at least one related comment that meets given criteria, nil criteria matches any.
+func (c *NewsCriteria) HasCommentsByNewsTitle(where *CommentCriteria) *NewsCriteria {
+	if where == nil {
+		where = &CommentCriteria{}
+	}
+	c.hasCommentsByNewsTitle = where
+	return c
+}
+
+// HasNoCommentsByNewsTitle matches news rows that have no related comment that meets given criteria, nil criteria matches any.
+func (c *NewsCriteria) HasNoCommentsByNewsTitle(where *CommentCriteria) *NewsCriteria {
+	if where == nil {
+		where = &CommentCriteria{}
+	}
+	c.hasNoCommentsByNewsTitle = where
+	return c
+}
+
+// HasComments matches news rows that have at least one related comment that meets given criteria, nil criteria matches any.
+func (c *NewsCriteria) HasComments(where *CommentCriteria) *NewsCriteria {
+	if where == nil {
+		where = &CommentCriteria{}
+	}
+	c.hasComments = where
+	return c
+}
+
+// HasNoComments matches news rows that have no related comment that meets given criteria, nil criteria matches any.
+func (c *NewsCriteria) HasNoComments(where *CommentCriteria) *NewsCriteria {
+	if where == nil {
+		where = &CommentCriteria{}
+	}
+	c.hasNoComments = where
+	return c
+}
+
+// HasCategorys matches news rows that have at least one related category that meets given criteria, nil criteria matches any.
+func (c *NewsCriteria) HasCategorys(where *CategoryCriteria) *NewsCriteria {
+	if where == nil {
+		where = &CategoryCriteria{}
+	}
+	c.hasCategorys = where
+	return c
+}
+
+// HasNoCategorys matches news rows that have no related category that meets given criteria, nil criteria matches any.
+func (c *NewsCriteria) HasNoCategorys(where *CategoryCriteria) *NewsCriteria {
+	if where == nil {
+		where = &CategoryCriteria{}
+	}
+	c.hasNoCategorys = where
+	return c
+}
+
+func NewsOperand(operator string, operands ...*NewsCriteria) *NewsCriteria {
+	if len(operands) == 0 {
+		return &NewsCriteria{operator: operator}
+	}
+
+	parent := &NewsCriteria{
+		operator: operator,
+		child:    operands[0],
+	}
+
+	for i := 0; i < len(operands); i++ {
+		if i < len(operands)-1 {
+			operands[i].sibling = operands[i+1]
+		}
+		operands[i].parent = parent
+	}
+
+	return parent
+}
+
+func NewsOr(operands ...*NewsCriteria) *NewsCriteria {
+	return NewsOperand("OR", operands...)
+}
+
+func NewsAnd(operands ...*NewsCriteria) *NewsCriteria {
+	return NewsOperand("AND", operands...)
+}
+
+// NewsCriteriaWhereClause writes conditions of the criteria tree into the composer.
+// The id is the alias of the table named table, tables of related rows are resolved relative to its schema.
+func NewsCriteriaWhereClause(comp *Composer, c *NewsCriteria, id int, table string) error {
+	if c.child == nil {
+		return _NewsCriteriaWhereClause(comp, c, id, table)
+	}
+	node := c
+	sibling := false
+	for {
+		if !sibling {
+			if node.child != nil {
+				if node.parent != nil {
+					comp.WriteString("(")
+				}
+				node = node.child
+				continue
+			} else {
+				comp.Dirty = false
+				comp.WriteString("(")
+				if err := _NewsCriteriaWhereClause(comp, node, id, table); err != nil {
+					return err
+				}
+				comp.WriteString(")")
+			}
+		}
+		if node.sibling != nil {
+			sibling = false
+			comp.WriteString(" ")
+			comp.WriteString(node.parent.operator)
+			comp.WriteString(" ")
+			node = node.sibling
+			continue
+		}
+		if node.parent != nil {
+			sibling = true
+			if node.parent.parent != nil {
+				comp.WriteString(")")
+			}
+			node = node.parent
+			continue
+		}
+
+		break
+	}
+	return nil
+}
+
+func _NewsCriteriaWhereClause(comp *Composer, c *NewsCriteria, id int, table string) error {
+	if c.Content.Valid {
+		if comp.Dirty {
+			comp.WriteString(" AND ")
+		}
+		if err := comp.WriteAlias(id); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(TableNewsColumnContent); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString("="); err != nil {
+			return err
+		}
+		if err := comp.WritePlaceholder(); err != nil {
+			return err
+		}
+		comp.Add(c.Content)
+		comp.Dirty = true
+	}
+	if c.Continue.Valid {
+		if comp.Dirty {
+			comp.WriteString(" AND ")
+		}
+		if err := comp.WriteAlias(id); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(TableNewsColumnContinue); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString("="); err != nil {
+			return err
+		}
+		if err := comp.WritePlaceholder(); err != nil {
+			return err
+		}
+		comp.Add(c.Continue)
+		comp.Dirty = true
+	}
+	if c.CreatedAt.Valid {
+		if comp.Dirty {
+			comp.WriteString(" AND ")
+		}
+		if err := comp.WriteAlias(id); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(TableNewsColumnCreatedAt); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString("="); err != nil {
+			return err
+		}
+		if err := comp.WritePlaceholder(); err != nil {
+			return err
+		}
+		comp.Add(c.CreatedAt)
+		comp.Dirty = true
+	}
+	if c.Day.Valid {
+		if comp.Dirty {
+			comp.WriteString(" AND ")
+		}
+		if err := comp.WriteAlias(id); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(TableNewsColumnDay); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString("="); err != nil {
+			return err
+		}
+		if err := comp.WritePlaceholder(); err != nil {
+			return err
+		}
+		comp.Add(c.Day)
+		comp.Dirty = true
+	}
+	if c.Document.Valid {
+		if comp.Dirty {
+			comp.WriteString(" AND ")
+		}
+		if err := comp.WriteAlias(id); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(TableNewsColumnDocument); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString("="); err != nil {
+			return err
+		}
+		if err := comp.WritePlaceholder(); err != nil {
+			return err
+		}
+		comp.Add(c.Document)
+		comp.Dirty = true
+	}
+	if c.DocumentSearch != nil {
+		if comp.Dirty {
+			comp.WriteString(" AND ")
+		}
+		if err := comp.WriteAlias(id); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(TableNewsColumnDocument); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(" @@ "); err != nil {
+			return err
+		}
+		if err := textSearchQuery(comp, "english", c.DocumentSearch); err != nil {
+			return err
+		}
+		comp.Dirty = true
+	}
+	// id is an empty struct, ignore
+
+	if c.Lead.Valid {
+		if comp.Dirty {
+			comp.WriteString(" AND ")
+		}
+		if err := comp.WriteAlias(id); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(TableNewsColumnLead); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString("="); err != nil {
+			return err
+		}
+		if err := comp.WritePlaceholder(); err != nil {
+			return err
+		}
+		comp.Add(c.Lead)
+		comp.Dirty = true
+	}
+	if c.MetaData != nil {
+		if comp.Dirty {
+			comp.WriteString(" AND ")
+		}
+		if err := comp.WriteAlias(id); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(TableNewsColumnMetaData); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString("="); err != nil {
+			return err
+		}
+		if err := comp.WritePlaceholder(); err != nil {
+			return err
+		}
+		comp.Add(c.MetaData)
+		comp.Dirty = true
+	}
+	if c.MetaDataContains != nil {
+		if comp.Dirty {
+			comp.WriteString(" AND ")
+		}
+		if err := comp.WriteAlias(id); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(TableNewsColumnMetaData); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(" @> "); err != nil {
+			return err
+		}
+		if err := comp.WritePlaceholder(); err != nil {
+			return err
+		}
+		comp.Add(c.MetaDataContains)
+		comp.Dirty = true
+	}
+	if c.MetaDataHasKey.Valid {
+		if comp.Dirty {
+			comp.WriteString(" AND ")
+		}
+		if err := comp.WriteAlias(id); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(TableNewsColumnMetaData); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(" ? "); err != nil {
+			return err
+		}
+		if err := comp.WritePlaceholder(); err != nil {
+			return err
+		}
+		comp.Add(c.MetaDataHasKey)
+		comp.Dirty = true
+	}
+	if len(c.MetaDataHasAnyKeys) > 0 {
+		if comp.Dirty {
+			comp.WriteString(" AND ")
+		}
+		if err := comp.WriteAlias(id); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(TableNewsColumnMetaData); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(" ?| "); err != nil {
+			return err
+		}
+		if err := comp.WritePlaceholder(); err != nil {
+			return err
+		}
+		comp.Add(c.MetaDataHasAnyKeys)
+		comp.Dirty = true
+	}
+	if len(c.MetaDataHasAllKeys) > 0 {
+		if comp.Dirty {
+			comp.WriteString(" AND ")
+		}
+		if err := comp.WriteAlias(id); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(TableNewsColumnMetaData); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(" ?& "); err != nil {
+			return err
+		}
+		if err := comp.WritePlaceholder(); err != nil {
+			return err
+		}
+		comp.Add(c.MetaDataHasAllKeys)
+		comp.Dirty = true
+	}
+	if c.MetaDataMatch.Valid {
+		if comp.Dirty {
+			comp.WriteString(" AND ")
+		}
+		if err := comp.WriteAlias(id); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(TableNewsColumnMetaData); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(" @@ "); err != nil {
+			return err
+		}
+		if err := comp.WritePlaceholder(); err != nil {
+			return err
+		}
+		comp.Add(c.MetaDataMatch)
+		comp.Dirty = true
+	}
+	if c.MetaDataExists.Valid {
+		if comp.Dirty {
+			comp.WriteString(" AND ")
+		}
+		if err := comp.WriteAlias(id); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(TableNewsColumnMetaData); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(" @? "); err != nil {
+			return err
+		}
+		if err := comp.WritePlaceholder(); err != nil {
+			return err
+		}
+		comp.Add(c.MetaDataExists)
+		comp.Dirty = true
+	}
+	for _, p := range c.MetaDataPath {
+		if comp.Dirty {
+			comp.WriteString(" AND ")
+		}
+		if err := jsonPredicateClause(comp, TableNewsColumnMetaData, id, p); err != nil {
+			return err
+		}
+		comp.Dirty = true
+	}
+	if c.Score.Valid {
+		if comp.Dirty {
+			comp.WriteString(" AND ")
+		}
+		if err := comp.WriteAlias(id); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(TableNewsColumnScore); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString("="); err != nil {
+			return err
+		}
+		if err := comp.WritePlaceholder(); err != nil {
+			return err
+		}
+		comp.Add(c.Score)
+		comp.Dirty = true
+	}
+	if c.Title.Valid {
+		if comp.Dirty {
+			comp.WriteString(" AND ")
+		}
+		if err := comp.WriteAlias(id); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(TableNewsColumnTitle); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString("="); err != nil {
+			return err
+		}
+		if err := comp.WritePlaceholder(); err != nil {
+			return err
+		}
+		comp.Add(c.Title)
+		comp.Dirty = true
+	}
+	if c.UpdatedAt.Valid {
+		if comp.Dirty {
+			comp.WriteString(" AND ")
+		}
+		if err := comp.WriteAlias(id); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(TableNewsColumnUpdatedAt); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString("="); err != nil {
+			return err
+		}
+		if err := comp.WritePlaceholder(); err != nil {
+			return err
+		}
+		comp.Add(c.UpdatedAt)
+		comp.Dirty = true
+	}
+	if c.Version.Valid {
+		if comp.Dirty {
+			comp.WriteString(" AND ")
+		}
+		if err := comp.WriteAlias(id); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(TableNewsColumnVersion); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString("="); err != nil {
+			return err
+		}
+		if err := comp.WritePlaceholder(); err != nil {
+			return err
+		}
+		comp.Add(c.Version)
+		comp.Dirty = true
+	}
+	if c.ViewsDistribution.Valid {
+		if comp.Dirty {
+			comp.WriteString(" AND ")
+		}
+		if err := comp.WriteAlias(id); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(TableNewsColumnViewsDistribution); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString("="); err != nil {
+			return err
+		}
+		if err := comp.WritePlaceholder(); err != nil {
+			return err
+		}
+		comp.Add(c.ViewsDistribution)
+		comp.Dirty = true
+	}
+	if c.ViewsDistributionContains.Valid {
+		if comp.Dirty {
+			comp.WriteString(" AND ")
+		}
+		if err := comp.WriteAlias(id); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(TableNewsColumnViewsDistribution); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(" @> "); err != nil {
+			return err
+		}
+		if err := comp.WritePlaceholder(); err != nil {
+			return err
+		}
+		comp.Add(c.ViewsDistributionContains)
+		comp.Dirty = true
+	}
+	if c.ViewsDistributionOverlap.Valid {
+		if comp.Dirty {
+			comp.WriteString(" AND ")
+		}
+		if err := comp.WriteAlias(id); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(TableNewsColumnViewsDistribution); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(" && "); err != nil {
+			return err
+		}
+		if err := comp.WritePlaceholder(); err != nil {
+			return err
+		}
+		comp.Add(c.ViewsDistributionOverlap)
+		comp.Dirty = true
+	}
+	if c.ViewsDistributionAny.Valid {
+		if comp.Dirty {
+			comp.WriteString(" AND ")
+		}
+		if err := comp.WritePlaceholder(); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(" = ANY("); err != nil {
+			return err
+		}
+		if err := comp.WriteAlias(id); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(TableNewsColumnViewsDistribution); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(")"); err != nil {
+			return err
+		}
+		comp.Add(c.ViewsDistributionAny)
+		comp.Dirty = true
+	}
+	for _, rel := range []struct {
+		where *CommentCriteria
+		not   bool
+	}{{where: c.hasCommentsByNewsTitle}, {where: c.hasNoCommentsByNewsTitle, not: true}} {
+		if rel.where == nil {
+			continue
+		}
+		sub := id + subqueryAliasOffset
+		if err := existsClause(comp, rel.not, inSchemaOf(TableComment, table), sub); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(" WHERE "); err != nil {
+			return err
+		}
+		if err := correlate(comp, sub, TableCommentColumnNewsTitle, id, TableNewsColumnTitle); err != nil {
+			return err
+		}
+		comp.Dirty = true
+		if rel.where.child != nil {
+			if _, err := comp.WriteString(" AND ("); err != nil {
+				return err
+			}
+		}
+		if err := CommentCriteriaWhereClause(comp, rel.where, sub, inSchemaOf(TableComment, table)); err != nil {
+			return err
+		}
+		if rel.where.child != nil {
+			if _, err := comp.WriteString(")"); err != nil {
+				return err
+			}
+		}
+		if _, err := comp.WriteString(")"); err != nil {
+			return err
+		}
+		comp.Dirty = true
+	}
+	for _, rel := range []struct {
+		where *CommentCriteria
+		not   bool
+	}{{where: c.hasComments}, {where: c.hasNoComments, not: true}} {
+		if rel.where == nil {
+			continue
+		}
+		sub := id + subqueryAliasOffset
+		if err := existsClause(comp, rel.not, inSchemaOf(TableComment, table), sub); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(" WHERE "); err != nil {
+			return err
+		}
+		if err := correlate(comp, sub, TableCommentColumnNewsID, id, TableNewsColumnID); err != nil {
+			return err
+		}
+		comp.Dirty = true
+		if rel.where.child != nil {
+			if _, err := comp.WriteString(" AND ("); err != nil {
+				return err
+			}
+		}
+		if err := CommentCriteriaWhereClause(comp, rel.where, sub, inSchemaOf(TableComment, table)); err != nil {
+			return err
+		}
+		if rel.where.child != nil {
+			if _, err := comp.WriteString(")"); err != nil {
+				return err
+			}
+		}
+		if _, err := comp.WriteString(")"); err != nil {
+			return err
+		}
+		comp.Dirty = true
+	}
+	for _, rel := range []struct {
+		where *CategoryCriteria
+		not   bool
+	}{{where: c.hasCategorys}, {where: c.hasNoCategorys, not: true}} {
+		if rel.where == nil {
+			continue
+		}
+		sub := id + subqueryAliasOffset
+		if err := existsClause(comp, rel.not, inSchemaOf(TableCategoryNews, table), sub); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(" INNER JOIN " + inSchemaOf(TableCategory, table) + " AS t" + strconv.Itoa(sub+1) + " ON "); err != nil {
+			return err
+		}
+		if err := correlate(comp, sub, TableCategoryNewsColumnCategoryID, sub+1, TableCategoryColumnID); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(" WHERE "); err != nil {
+			return err
+		}
+		if err := correlate(comp, sub, TableCategoryNewsColumnNewsID, id, TableNewsColumnID); err != nil {
+			return err
+		}
+		comp.Dirty = true
+		if rel.where.child != nil {
+			if _, err := comp.WriteString(" AND ("); err != nil {
+				return err
+			}
+		}
+		if err := CategoryCriteriaWhereClause(comp, rel.where, sub+1, inSchemaOf(TableCategory, table)); err != nil {
+			return err
+		}
+		if rel.where.child != nil {
+			if _, err := comp.WriteString(")"); err != nil {
+				return err
+			}
+		}
+		if _, err := comp.WriteString(")"); err != nil {
+			return err
+		}
+		comp.Dirty = true
+	}
+	return nil
+}
+
+type NewsFindExpr struct {
+	Where         *NewsCriteria
+	Offset, Limit int64
+	Columns       []NewsColumn
+	OrderBy       []RowOrder
+	Lock          RowLock
+	// DocumentRank orders rows by ts_rank of document against given query, before any other order.
+	DocumentRank *TextSearch
+	// DocumentHeadline, if set, populates NewsEntity.DocumentHeadline.
+	DocumentHeadline *TextSearchHeadline
+}
+
+// NewsColumn is a column of the news table, one of TableNewsColumns, that find expression selects.
+type NewsColumn string
+
+// newsColumnCount selects number of rows instead of columns, it is used by count queries.
+const newsColumnCount NewsColumn = "COUNT(*)"
+
+// selectExpr returns select list expression of the column, or an error if the table has no such column.
+func (c NewsColumn) selectExpr() (string, error) {
+	switch c {
+	case newsColumnCount:
+		return string(c), nil
+	case TableNewsColumnContent:
+		return "t0.content", nil
+	case TableNewsColumnContinue:
+		return "t0.continue", nil
+	case TableNewsColumnCreatedAt:
+		return "t0.created_at", nil
+	case TableNewsColumnDay:
+		return "t0.day", nil
+	case TableNewsColumnDocument:
+		return "t0.document", nil
+	case TableNewsColumnID:
+		return "t0.id", nil
+	case TableNewsColumnLead:
+		return "t0.lead", nil
+	case TableNewsColumnMetaData:
+		return "t0.meta_data", nil
+	case TableNewsColumnScore:
+		return "t0.score", nil
+	case TableNewsColumnTitle:
+		return "t0.title", nil
+	case TableNewsColumnUpdatedAt:
+		return "t0.updated_at", nil
+	case TableNewsColumnVersion:
+		return "t0.version", nil
+	case TableNewsColumnViewsDistribution:
+		return "t0.views_distribution", nil
+	}
+	return "", fmt.Errorf("unexpected column provided: %q", string(c))
+}
+
+func newsColumnNames(cs []NewsColumn) []string {
+	if len(cs) == 0 {
+		return nil
+	}
+	names := make([]string, 0, len(cs))
+	for _, c := range cs {
+		names = append(names, string(c))
+	}
+	return names
+}
+
+type NewsJoin struct {
+	On, Where *NewsCriteria
+	Fetch     bool
+	Kind      JoinType
+}
+
+type NewsCountExpr struct {
+	Where *NewsCriteria
+}
+
+type NewsPatch struct {
+	Content   sql.NullString
+	Continue  sql.NullBool
+	CreatedAt pq.NullTime
+	Day       pq.NullTime
+	Lead      sql.NullString
+	MetaData  []byte
+	// MetaDataSet replaces values at given paths of meta_data (jsonb_set).
+	MetaDataSet []JSONSet
+	// MetaDataMerge is concatenated with meta_data, top-level keys are overwritten (||).
+	MetaDataMerge []byte
+	// MetaDataRemove lists top-level keys removed from meta_data (-).
+	MetaDataRemove    pq.StringArray
+	Score             sql.NullFloat64
+	Title             sql.NullString
+	UpdatedAt         pq.NullTime
+	Version           sql.NullInt64
+	ViewsDistribution NullFloat64Array
+}
+
+// Validate checks if values set by the patch meet length, precision, enum, array size and simple check constraints of the table.
+// It returns ValidationError that lists all violations, if any.
+func (p *NewsPatch) Validate() error {
+	var errs ValidationError
+	if p.Score.Valid {
+		if !numericFits(p.Score.Float64, 20, 8) {
+			errs = append(errs, &FieldError{Field: "Score", Column: TableNewsColumnScore, Reason: "does not fit numeric(20,8)"})
+		}
+	}
+	if p.ViewsDistribution.Valid {
+		if len(p.ViewsDistribution.Float64Array) > 168 {
+			errs = append(errs, &FieldError{Field: "ViewsDistribution", Column: TableNewsColumnViewsDistribution, Reason: "has more than 168 elements"})
+		}
+	}
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+type NewsRepositoryBase struct {
+	Table   string
+	Columns []string
+	DB      *sql.DB
+	Log     LogFunc
+	// Validate, if true, makes insert, update and upsert methods validate entities and patches before querying the database.
+	Validate bool
+	// StmtCache, if set, is used to prepare queries of fixed shape (insert, delete and find by key) once and reuse them.
+	StmtCache *StmtCache
+}
+
+func (r *NewsRepositoryBase) Tx(tx *sql.Tx) (*NewsRepositoryBaseTx, error) {
+	return &NewsRepositoryBaseTx{
+		base: r,
+		tx:   tx,
+	}, nil
+}
+
+func (r *NewsRepositoryBase) BeginTx(ctx context.Context, opts ...TxOption) (*NewsRepositoryBaseTx, error) {
+	tx, err := r.DB.BeginTx(ctx, newTxOptions(opts).sql())
+	if err != nil {
+		return nil, err
+	}
+	return r.Tx(tx)
+}
+
+func (r NewsRepositoryBase) RunInTransaction(ctx context.Context, fn func(rtx *NewsRepositoryBaseTx) error, attempts int, opts ...TxOption) (err error) {
+	return RunInTransaction(r.DB, ctx, func(tx *sql.Tx) error {
+		rtx, err := r.Tx(tx)
+		if err != nil {
+			return err
+		}
+		return fn(rtx)
+	}, attempts, opts...)
+}
+
+var (
+	// ErrNewsConstraintPrimaryKey is returned if primary key constraint example.news_id_pkey is violated.
+	ErrNewsConstraintPrimaryKey = &Constraint{
+		Name:    "example.news_id_pkey",
+		Type:    "primary key",
+		Table:   "example.news",
+		Columns: []string{"id"},
+	}
+	// ErrNewsConstraintTitleUnique is returned if unique constraint example.news_title_key is violated.
+	ErrNewsConstraintTitleUnique = &Constraint{
+		Name:    "example.news_title_key",
+		Type:    "unique",
+		Table:   "example.news",
+		Columns: []string{"title"},
+	}
+	// ErrNewsConstraintTitleLeadUnique is returned if unique constraint example.news_title_lead_key is violated.
+	ErrNewsConstraintTitleLeadUnique = &Constraint{
+		Name:    "example.news_title_lead_key",
+		Type:    "unique",
+		Table:   "example.news",
+		Columns: []string{"title", "lead"},
+	}
+)
+
+func (r *NewsRepositoryBase) InsertQuery(e *NewsEntity, read bool) (string, []interface{}, error) {
+	if r.Validate {
+		if err := e.Validate(); err != nil {
+			return "", nil, err
+		}
+	}
+	insert := NewComposer(13)
+	columns := bytes.NewBuffer(nil)
+	buf := bytes.NewBufferString("INSERT INTO ")
+	buf.WriteString(r.Table)
+
+	if columns.Len() > 0 {
+		if _, err := columns.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+	}
+	if _, err := columns.WriteString(TableNewsColumnContent); err != nil {
+		return "", nil, err
+	}
+	if insert.Dirty {
+		if _, err := insert.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+	}
+	if err := insert.WritePlaceholder(); err != nil {
+		return "", nil, err
+	}
+	insert.Add(e.Content)
+	insert.Dirty = true
+
+	if columns.Len() > 0 {
+		if _, err := columns.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+	}
+	if _, err := columns.WriteString(TableNewsColumnContinue); err != nil {
+		return "", nil, err
+	}
+	if insert.Dirty {
+		if _, err := insert.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+	}
+	if err := insert.WritePlaceholder(); err != nil {
+		return "", nil, err
+	}
+	insert.Add(e.Continue)
+	insert.Dirty = true
+
+	if !e.CreatedAt.IsZero() {
+		if columns.Len() > 0 {
+			if _, err := columns.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := columns.WriteString(TableNewsColumnCreatedAt); err != nil {
+			return "", nil, err
+		}
+		if insert.Dirty {
+			if _, err := insert.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if err := insert.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		insert.Add(e.CreatedAt)
+		insert.Dirty = true
+	}
+
+	if e.Day.Valid {
+		if columns.Len() > 0 {
+			if _, err := columns.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := columns.WriteString(TableNewsColumnDay); err != nil {
+			return "", nil, err
+		}
+		if insert.Dirty {
+			if _, err := insert.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if err := insert.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		insert.Add(e.Day)
+		insert.Dirty = true
+	}
+
+	if e.Lead.Valid {
+		if columns.Len() > 0 {
+			if _, err := columns.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := columns.WriteString(TableNewsColumnLead); err != nil {
+			return "", nil, err
+		}
+		if insert.Dirty {
+			if _, err := insert.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if err := insert.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		insert.Add(e.Lead)
+		insert.Dirty = true
+	}
+
+	if e.MetaData != nil {
+		if columns.Len() > 0 {
+			if _, err := columns.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := columns.WriteString(TableNewsColumnMetaData); err != nil {
+			return "", nil, err
+		}
+		if insert.Dirty {
+			if _, err := insert.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if err := insert.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		insert.Add(e.MetaData)
+		insert.Dirty = true
+	}
+
+	if columns.Len() > 0 {
+		if _, err := columns.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+	}
+	if _, err := columns.WriteString(TableNewsColumnScore); err != nil {
+		return "", nil, err
+	}
+	if insert.Dirty {
+		if _, err := insert.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+	}
+	if err := insert.WritePlaceholder(); err != nil {
+		return "", nil, err
+	}
+	insert.Add(e.Score)
+	insert.Dirty = true
+
+	if columns.Len() > 0 {
+		if _, err := columns.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+	}
+	if _, err := columns.WriteString(TableNewsColumnTitle); err != nil {
+		return "", nil, err
+	}
+	if insert.Dirty {
+		if _, err := insert.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+	}
+	if err := insert.WritePlaceholder(); err != nil {
+		return "", nil, err
+	}
+	insert.Add(e.Title)
+	insert.Dirty = true
+
+	if e.UpdatedAt.Valid {
+		if columns.Len() > 0 {
+			if _, err := columns.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := columns.WriteString(TableNewsColumnUpdatedAt); err != nil {
+			return "", nil, err
+		}
+		if insert.Dirty {
+			if _, err := insert.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if err := insert.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		insert.Add(e.UpdatedAt)
+		insert.Dirty = true
+	}
+
+	if columns.Len() > 0 {
+		if _, err := columns.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+	}
+	if _, err := columns.WriteString(TableNewsColumnVersion); err != nil {
+		return "", nil, err
+	}
+	if insert.Dirty {
+		if _, err := insert.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+	}
+	if err := insert.WritePlaceholder(); err != nil {
+		return "", nil, err
+	}
+	insert.Add(e.Version)
+	insert.Dirty = true
+
+	if e.ViewsDistribution.Valid {
+		if columns.Len() > 0 {
+			if _, err := columns.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := columns.WriteString(TableNewsColumnViewsDistribution); err != nil {
+			return "", nil, err
+		}
+		if insert.Dirty {
+			if _, err := insert.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if err := insert.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		insert.Add(e.ViewsDistribution)
+		insert.Dirty = true
+	}
+
+	if columns.Len() > 0 {
+		buf.WriteString(" (")
+		buf.ReadFrom(columns)
+		buf.WriteString(") VALUES (")
+		buf.ReadFrom(insert)
+		buf.WriteString(") ")
+		if read {
+			buf.WriteString("RETURNING ")
+			if len(r.Columns) > 0 {
+				buf.WriteString(strings.Join(r.Columns, ", "))
+			} else {
+				buf.WriteString("content, continue, created_at, day, document, id, lead, meta_data, score, title, updated_at, version, views_distribution")
+			}
+		}
+	}
+	return buf.String(), insert.Args(), nil
+}
+
+func (r *NewsRepositoryBase) insert(ctx context.Context, tx *sql.Tx, e *NewsEntity) (*NewsEntity, error) {
+	query, args, err := r.InsertQuery(e, true)
+	if err != nil {
+		return nil, err
+	}
+
+	err = queryRow(ctx, r.DB, tx, r.StmtCache, query, args,
+		&e.Content,
+		&e.Continue,
+		&e.CreatedAt,
+		&e.Day,
+		&e.Document,
+		&e.ID,
+		&e.Lead,
+		&e.MetaData,
+		&e.Score,
+		&e.Title,
+		&e.UpdatedAt,
+		&e.Version,
+		&e.ViewsDistribution,
+	)
+	if r.Log != nil {
+		if tx == nil {
+			r.Log(err, TableNews, "insert", query, args...)
+		} else {
+			r.Log(err, TableNews, "insert tx", query, args...)
+		}
+	}
+	if err != nil {
+		return nil, wrapError(err)
+	}
+	return e, nil
+}
+
+func (r *NewsRepositoryBase) Insert(ctx context.Context, e *NewsEntity) (*NewsEntity, error) {
+	return r.insert(ctx, nil, e)
+}
+
+func (r *NewsRepositoryBase) FindQuery(fe *NewsFindExpr) (string, []interface{}, error) {
+	comp := NewComposer(13)
+	buf := bytes.NewBufferString("SELECT ")
+	if len(fe.Columns) == 0 {
+		buf.WriteString("t0.content, t0.continue, t0.created_at, t0.day, t0.document, t0.id, t0.lead, t0.meta_data, t0.score, t0.title, t0.updated_at, t0.version, t0.views_distribution")
+	} else {
+		for i, c := range fe.Columns {
+			if i > 0 {
+				buf.WriteString(", ")
+			}
+			expr, err := c.selectExpr()
+			if err != nil {
+				return "", nil, err
+			}
+			buf.WriteString(expr)
+		}
+	}
+	if fe.DocumentHeadline != nil {
+		if _, err := comp.WriteString(", ts_headline('english', coalesce(t0.title, '') || ' ' || coalesce(t0.lead, '') || ' ' || coalesce(t0.content, ''), "); err != nil {
+			return "", nil, err
+		}
+		if err := textSearchQuery(comp, "english", &fe.DocumentHeadline.TextSearch); err != nil {
+			return "", nil, err
+		}
+		if fe.DocumentHeadline.Options != "" {
+			if _, err := comp.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+			if err := comp.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			comp.Add(fe.DocumentHeadline.Options)
+		}
+		if _, err := comp.WriteString(") AS document_headline"); err != nil {
+			return "", nil, err
+		}
+		buf.ReadFrom(comp)
+	}
+	buf.WriteString(" FROM ")
+	buf.WriteString(r.Table)
+	buf.WriteString(" AS t0")
+	if comp.Dirty {
+		buf.ReadFrom(comp)
+		comp.Dirty = false
+	}
+	if fe.Where != nil {
+		if err := NewsCriteriaWhereClause(comp, fe.Where, 0, r.Table); err != nil {
+			return "", nil, err
+		}
+	}
+	if comp.Dirty {
+		if _, err := buf.WriteString(" WHERE "); err != nil {
+			return "", nil, err
+		}
+		buf.ReadFrom(comp)
+	}
+
+	ranked := 0
+	if fe.DocumentRank != nil {
+		if ranked == 0 {
+			comp.WriteString(" ORDER BY ")
+		} else {
+			comp.WriteString(", ")
+		}
+		if _, err := comp.WriteString("ts_rank(t0.document, "); err != nil {
+			return "", nil, err
+		}
+		if err := textSearchQuery(comp, "english", fe.DocumentRank); err != nil {
+			return "", nil, err
+		}
+		if _, err := comp.WriteString(") DESC"); err != nil {
+			return "", nil, err
+		}
+		ranked++
+	}
+	if len(fe.OrderBy) > 0 {
+		i := ranked
+		for _, order := range fe.OrderBy {
+			for _, columnName := range TableNewsColumns {
+				if order.Name == columnName {
+					if i == 0 {
+						comp.WriteString(" ORDER BY ")
+					}
+					if i > 0 {
+						if _, err := comp.WriteString(", "); err != nil {
+							return "", nil, err
+						}
+					}
+					if _, err := comp.WriteString(order.Name); err != nil {
+						return "", nil, err
+					}
+					if order.Descending {
+						if _, err := comp.WriteString(" DESC"); err != nil {
+							return "", nil, err
+						}
+					}
+					i++
+					break
+				}
+			}
+		}
+	}
+	if fe.Offset > 0 {
+		if _, err := comp.WriteString(" OFFSET "); err != nil {
+			return "", nil, err
+		}
+		if err := comp.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		if _, err := comp.WriteString(" "); err != nil {
+			return "", nil, err
+		}
+		comp.Add(fe.Offset)
+	}
+	if fe.Limit > 0 {
+		if _, err := comp.WriteString(" LIMIT "); err != nil {
+			return "", nil, err
+		}
+		if err := comp.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		if _, err := comp.WriteString(" "); err != nil {
+			return "", nil, err
+		}
+		comp.Add(fe.Limit)
+	}
+	if fe.Lock.Actionable() {
+		var of string
+		if err := lockClause(comp, fe.Lock, of); err != nil {
+			return "", nil, err
+		}
+	}
+
+	buf.ReadFrom(comp)
+
+	return buf.String(), comp.Args(), nil
+}
+
+func (r *NewsRepositoryBase) find(ctx context.Context, tx *sql.Tx, fe *NewsFindExpr) ([]*NewsEntity, error) {
+	if tx == nil && fe.Lock.Actionable() {
+		return nil, ErrRowLockOutsideTransaction
+	}
+	query, args, err := r.FindQuery(fe)
+	if err != nil {
+		return nil, err
+	}
+	var rows *sql.Rows
+	if tx == nil {
+		rows, err = r.DB.QueryContext(ctx, query, args...)
+	} else {
+		rows, err = tx.QueryContext(ctx, query, args...)
+	}
+	if r.Log != nil {
+		if tx == nil {
+			r.Log(err, TableNews, "find", query, args...)
+		} else {
+			r.Log(err, TableNews, "find tx", query, args...)
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var (
+		entities []*NewsEntity
+		props    []interface{}
+	)
+	cols := newsColumnNames(fe.Columns)
+	for rows.Next() {
+		var ent NewsEntity
+		if props, err = ent.Props(cols...); err != nil {
+			return nil, err
+		}
+		if fe.DocumentHeadline != nil {
+			props = append(props, &ent.DocumentHeadline)
+		}
+		err = rows.Scan(props...)
+		if err != nil {
+			return nil, err
+		}
+
+		entities = append(entities, &ent)
+	}
+	err = rows.Err()
+	if r.Log != nil {
+		r.Log(err, TableNews, "find", query, args...)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return entities, nil
+}
+
+func (r *NewsRepositoryBase) Find(ctx context.Context, fe *NewsFindExpr) ([]*NewsEntity, error) {
+	return r.find(ctx, nil, fe)
+}
+
+func (r *NewsRepositoryBase) findIter(ctx context.Context, tx *sql.Tx, fe *NewsFindExpr) (*NewsIterator, error) {
+	if tx == nil && fe.Lock.Actionable() {
+		return nil, ErrRowLockOutsideTransaction
+	}
+	query, args, err := r.FindQuery(fe)
+	if err != nil {
+		return nil, err
+	}
+	var rows *sql.Rows
+	if tx == nil {
+		rows, err = r.DB.QueryContext(ctx, query, args...)
+	} else {
+		rows, err = tx.QueryContext(ctx, query, args...)
+	}
+	if r.Log != nil {
+		if tx == nil {
+			r.Log(err, TableNews, "find iter", query, args...)
+		} else {
+			r.Log(err, TableNews, "find iter tx", query, args...)
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &NewsIterator{
+		rows: rows,
+		expr: fe,
+		cols: newsColumnNames(fe.Columns),
+	}, nil
+}
+
+func (r *NewsRepositoryBase) FindIter(ctx context.Context, fe *NewsFindExpr) (*NewsIterator, error) {
+	return r.findIter(ctx, nil, fe)
+}
+
+func (r *NewsRepositoryBase) findProjection(ctx context.Context, tx *sql.Tx, fe *NewsFindExpr, next func() Projection) error {
+	if tx == nil && fe.Lock.Actionable() {
+		return ErrRowLockOutsideTransaction
+	}
+	query, args, err := r.FindQuery(fe)
+	if err != nil {
+		return err
+	}
+	var rows *sql.Rows
+	if tx == nil {
+		rows, err = r.DB.QueryContext(ctx, query, args...)
+	} else {
+		rows, err = tx.QueryContext(ctx, query, args...)
+	}
+	if r.Log != nil {
+		if tx == nil {
+			r.Log(err, TableNews, "find projection", query, args...)
+		} else {
+			r.Log(err, TableNews, "find projection tx", query, args...)
+		}
+	}
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	cols, err := rows.Columns()
+	if err != nil {
+		return err
+	}
+	for rows.Next() {
+		props, err := next().Props(cols...)
+		if err != nil {
+			return err
+		}
+		if err := rows.Scan(props...); err != nil {
+			return err
+		}
+	}
+	return rows.Err()
+}
+
+// FindProjection works like Find, but scans each row into the projection returned by next.
+// Selected columns, see NewsFindExpr.Columns, are passed to its Props method.
+func (r *NewsRepositoryBase) FindProjection(ctx context.Context, fe *NewsFindExpr, next func() Projection) error {
+	return r.findProjection(ctx, nil, fe, next)
+}
+
+func (r *NewsRepositoryBase) findOneByID(ctx context.Context, tx *sql.Tx, pk int64, lock RowLock) (*NewsEntity, error) {
+	find := NewComposer(13)
+	find.WriteString("SELECT ")
+	if len(r.Columns) == 0 {
+		find.WriteString("content, continue, created_at, day, document, id, lead, meta_data, score, title, updated_at, version, views_distribution")
+	} else {
+		find.WriteString(strings.Join(r.Columns, ", "))
+	}
+	find.WriteString(" FROM ")
+	find.WriteString(r.Table)
+	find.WriteString(" WHERE ")
+	find.WriteString(TableNewsColumnID)
+	find.WriteString("=")
+	find.WritePlaceholder()
+	find.Add(pk)
+	if lock.Actionable() {
+		if tx == nil {
+			return nil, ErrRowLockOutsideTransaction
+		}
+		if err := lockClause(find, lock, ""); err != nil {
+			return nil, err
+		}
+	}
+	var (
+		ent NewsEntity
+	)
+	props, err := ent.Props(r.Columns...)
+	if err != nil {
+		return nil, err
+	}
+	err = queryRow(ctx, r.DB, tx, r.StmtCache, find.String(), find.Args(), props...)
+	if r.Log != nil {
+		if tx == nil {
+			r.Log(err, TableNews, "find by primary key", find.String(), find.Args()...)
+		} else {
+			r.Log(err, TableNews, "find by primary key tx", find.String(), find.Args()...)
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &ent, nil
+}
+
+func (r *NewsRepositoryBase) FindOneByID(ctx context.Context, pk int64) (*NewsEntity, error) {
+	return r.findOneByID(ctx, nil, pk, RowLock{})
+}
+
+func (r *NewsRepositoryBase) findOneByTitle(ctx context.Context, tx *sql.Tx, newsTitle string, lock RowLock) (*NewsEntity, error) {
+	find := NewComposer(13)
+	find.WriteString("SELECT ")
+	if len(r.Columns) == 0 {
+		find.WriteString("content, continue, created_at, day, document, id, lead, meta_data, score, title, updated_at, version, views_distribution")
+	} else {
+		find.WriteString(strings.Join(r.Columns, ", "))
+	}
+	find.WriteString(" FROM ")
+	find.WriteString(r.Table)
+	find.WriteString(" WHERE ")
+	find.WriteString(TableNewsColumnTitle)
+	find.WriteString("=")
+	find.WritePlaceholder()
+	find.Add(newsTitle)
+
+	if lock.Actionable() {
+		if tx == nil {
+			return nil, ErrRowLockOutsideTransaction
+		}
+		if err := lockClause(find, lock, ""); err != nil {
+			return nil, err
+		}
+	}
+
+	var (
+		ent NewsEntity
+	)
+	props, err := ent.Props(r.Columns...)
+	if err != nil {
+		return nil, err
+	}
+	err = queryRow(ctx, r.DB, tx, r.StmtCache, find.String(), find.Args(), props...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ent, nil
+}
+
+func (r *NewsRepositoryBase) findOneByTitleAndLead(ctx context.Context, tx *sql.Tx, newsTitle string, newsLead string, lock RowLock) (*NewsEntity, error) {
+	find := NewComposer(13)
+	find.WriteString("SELECT ")
+	if len(r.Columns) == 0 {
+		find.WriteString("content, continue, created_at, day, document, id, lead, meta_data, score, title, updated_at, version, views_distribution")
+	} else {
+		find.WriteString(strings.Join(r.Columns, ", "))
+	}
+	find.WriteString(" FROM ")
+	find.WriteString(r.Table)
+	find.WriteString(" WHERE ")
+	find.WriteString(TableNewsColumnTitle)
+	find.WriteString("=")
+	find.WritePlaceholder()
+	find.Add(newsTitle)
+	find.WriteString(" AND ")
+	find.WriteString(TableNewsColumnLead)
+	find.WriteString("=")
+	find.WritePlaceholder()
+	find.Add(newsLead)
+
+	if lock.Actionable() {
+		if tx == nil {
+			return nil, ErrRowLockOutsideTransaction
+		}
+		if err := lockClause(find, lock, ""); err != nil {
+			return nil, err
+		}
+	}
+
+	var (
+		ent NewsEntity
+	)
+	props, err := ent.Props(r.Columns...)
+	if err != nil {
+		return nil, err
+	}
+	err = queryRow(ctx, r.DB, tx, r.StmtCache, find.String(), find.Args(), props...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ent, nil
+}
+
+func (r *NewsRepositoryBase) FindOneByTitle(ctx context.Context, newsTitle string) (*NewsEntity, error) {
+	return r.findOneByTitle(ctx, nil, newsTitle, RowLock{})
+}
+func (r *NewsRepositoryBase) FindOneByTitleAndLead(ctx context.Context, newsTitle string, newsLead string) (*NewsEntity, error) {
+	return r.findOneByTitleAndLead(ctx, nil, newsTitle, newsLead, RowLock{})
+}
+
+func (r *NewsRepositoryBase) history(ctx context.Context, tx *sql.Tx, pk int64) ([]*NewsHistoryEntity, error) {
+	find := NewComposer(13)
+	find.WriteString("SELECT ")
+	if len(r.Columns) == 0 {
+		find.WriteString("content, continue, created_at, day, document, id, lead, meta_data, score, title, updated_at, version, views_distribution")
+	} else {
+		find.WriteString(strings.Join(r.Columns, ", "))
+	}
+	find.WriteString(", history_operation, history_at, history_actor FROM ")
+	find.WriteString(inSchemaOf(TableNewsHistory, r.Table))
+	find.WriteString(" WHERE ")
+	find.WriteString(TableNewsColumnID)
+	find.WriteString("=")
+	find.WritePlaceholder()
+	find.Add(pk)
+	find.WriteString(" ORDER BY ")
+	find.WriteString(TableNewsHistoryColumnHistoryAt)
+
+	var (
+		rows *sql.Rows
+		err  error
+	)
+	if tx == nil {
+		rows, err = r.DB.QueryContext(ctx, find.String(), find.Args()...)
+	} else {
+		rows, err = tx.QueryContext(ctx, find.String(), find.Args()...)
+	}
+	if r.Log != nil {
+		if tx == nil {
+			r.Log(err, TableNews, "history", find.String(), find.Args()...)
+		} else {
+			r.Log(err, TableNews, "history tx", find.String(), find.Args()...)
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var entities []*NewsHistoryEntity
+	for rows.Next() {
+		var ent NewsHistoryEntity
+		props, err := ent.Props(r.Columns...)
+		if err != nil {
+			return nil, err
+		}
+		err = rows.Scan(append(props, &ent.HistoryOperation, &ent.HistoryAt, &ent.HistoryActor)...)
+		if err != nil {
+			return nil, err
+		}
+
+		entities = append(entities, &ent)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+	return entities, nil
+}
+
+func (r *NewsRepositoryBase) History(ctx context.Context, pk int64) ([]*NewsHistoryEntity, error) {
+	return r.history(ctx, nil, pk)
+}
+
+func (r *NewsRepositoryBase) findOneByIDAsOf(ctx context.Context, tx *sql.Tx, pk int64, at time.Time) (*NewsEntity, error) {
+	find := NewComposer(13)
+	find.WriteString("SELECT ")
+	if len(r.Columns) == 0 {
+		find.WriteString("content, continue, created_at, day, document, id, lead, meta_data, score, title, updated_at, version, views_distribution")
+	} else {
+		find.WriteString(strings.Join(r.Columns, ", "))
+	}
+	find.WriteString(", history_operation, history_at, history_actor FROM ")
+	find.WriteString(inSchemaOf(TableNewsHistory, r.Table))
+	find.WriteString(" WHERE ")
+	find.WriteString(TableNewsColumnID)
+	find.WriteString("=")
+	find.WritePlaceholder()
+	find.Add(pk)
+	find.WriteString(" AND ")
+	find.WriteString(TableNewsHistoryColumnHistoryAt)
+	find.WriteString("<=")
+	find.WritePlaceholder()
+	find.Add(at)
+	find.WriteString(" ORDER BY ")
+	find.WriteString(TableNewsHistoryColumnHistoryAt)
+	find.WriteString(" DESC LIMIT 1")
+
+	var ent NewsHistoryEntity
+	props, err := ent.Props(r.Columns...)
+	if err != nil {
+		return nil, err
+	}
+	props = append(props, &ent.HistoryOperation, &ent.HistoryAt, &ent.HistoryActor)
+	if tx == nil {
+		err = r.DB.QueryRowContext(ctx, find.String(), find.Args()...).Scan(props...)
+	} else {
+		err = tx.QueryRowContext(ctx, find.String(), find.Args()...).Scan(props...)
+	}
+	if r.Log != nil {
+		if tx == nil {
+			r.Log(err, TableNews, "find by primary key as of", find.String(), find.Args()...)
+		} else {
+			r.Log(err, TableNews, "find by primary key as of tx", find.String(), find.Args()...)
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	if ent.HistoryOperation == "DELETE" {
+		return nil, sql.ErrNoRows
+	}
+	return &ent.NewsEntity, nil
+}
+
+// FindOneByIDAsOf returns version of the row that was current at given time.
+// If the row did not exist at that time, sql.ErrNoRows is returned.
+func (r *NewsRepositoryBase) FindOneByIDAsOf(ctx context.Context, pk int64, at time.Time) (*NewsEntity, error) {
+	return r.findOneByIDAsOf(ctx, nil, pk, at)
+}
+
+func (r *NewsRepositoryBase) UpdateOneByIDQuery(pk int64, p *NewsPatch) (string, []interface{}, error) {
+	if r.Validate {
+		if err := p.Validate(); err != nil {
+			return "", nil, err
+		}
+	}
+	buf := bytes.NewBufferString("UPDATE ")
+	buf.WriteString(r.Table)
+	update := NewComposer(13)
+	if p.Content.Valid {
+		if update.Dirty {
+			if _, err := update.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := update.WriteString(TableNewsColumnContent); err != nil {
+			return "", nil, err
+		}
+		if _, err := update.WriteString("="); err != nil {
+			return "", nil, err
+		}
+		if err := update.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		update.Add(p.Content)
+		update.Dirty = true
+
+	}
+	if p.Continue.Valid {
+		if update.Dirty {
+			if _, err := update.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := update.WriteString(TableNewsColumnContinue); err != nil {
+			return "", nil, err
+		}
+		if _, err := update.WriteString("="); err != nil {
+			return "", nil, err
+		}
+		if err := update.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		update.Add(p.Continue)
+		update.Dirty = true
+
+	}
+	if p.CreatedAt.Valid {
+		if update.Dirty {
+			if _, err := update.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := update.WriteString(TableNewsColumnCreatedAt); err != nil {
+			return "", nil, err
+		}
+		if _, err := update.WriteString("="); err != nil {
+			return "", nil, err
+		}
+		if err := update.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		update.Add(p.CreatedAt)
+		update.Dirty = true
+
+	}
+	if p.Day.Valid {
+		if update.Dirty {
+			if _, err := update.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := update.WriteString(TableNewsColumnDay); err != nil {
+			return "", nil, err
+		}
+		if _, err := update.WriteString("="); err != nil {
+			return "", nil, err
+		}
+		if err := update.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		update.Add(p.Day)
+		update.Dirty = true
+
+	}
+	if p.Lead.Valid {
+		if update.Dirty {
+			if _, err := update.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := update.WriteString(TableNewsColumnLead); err != nil {
+			return "", nil, err
+		}
+		if _, err := update.WriteString("="); err != nil {
+			return "", nil, err
+		}
+		if err := update.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		update.Add(p.Lead)
+		update.Dirty = true
+
+	}
+	if p.MetaData != nil {
+		if update.Dirty {
+			if _, err := update.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := update.WriteString(TableNewsColumnMetaData); err != nil {
+			return "", nil, err
+		}
+		if _, err := update.WriteString("="); err != nil {
+			return "", nil, err
+		}
+		if err := update.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		update.Add(p.MetaData)
+		update.Dirty = true
+
+	}
+	if len(p.MetaDataSet) > 0 || p.MetaDataMerge != nil || len(p.MetaDataRemove) > 0 {
+		if p.MetaData != nil {
+			return "", nil, errors.New("meta_data: value and JSON modifications cannot be used together")
+		}
+		if update.Dirty {
+			if _, err := update.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := update.WriteString(TableNewsColumnMetaData); err != nil {
+			return "", nil, err
+		}
+		if _, err := update.WriteString("="); err != nil {
+			return "", nil, err
+		}
+		if err := jsonPatchClause(update, TableNewsColumnMetaData, p.MetaDataSet, p.MetaDataMerge, p.MetaDataRemove); err != nil {
+			return "", nil, err
+		}
+		update.Dirty = true
+	}
+	if p.Score.Valid {
+		if update.Dirty {
+			if _, err := update.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := update.WriteString(TableNewsColumnScore); err != nil {
+			return "", nil, err
+		}
+		if _, err := update.WriteString("="); err != nil {
+			return "", nil, err
+		}
+		if err := update.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		update.Add(p.Score)
+		update.Dirty = true
+
+	}
+	if p.Title.Valid {
+		if update.Dirty {
+			if _, err := update.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := update.WriteString(TableNewsColumnTitle); err != nil {
+			return "", nil, err
+		}
+		if _, err := update.WriteString("="); err != nil {
+			return "", nil, err
+		}
+		if err := update.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		update.Add(p.Title)
+		update.Dirty = true
+
+	}
+	if p.UpdatedAt.Valid {
+		if update.Dirty {
+			if _, err := update.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := update.WriteString(TableNewsColumnUpdatedAt); err != nil {
+			return "", nil, err
+		}
+		if _, err := update.WriteString("="); err != nil {
+			return "", nil, err
+		}
+		if err := update.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		update.Add(p.UpdatedAt)
+		update.Dirty = true
+
+	} else {
+		if update.Dirty {
+			if _, err := update.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := update.WriteString(TableNewsColumnUpdatedAt); err != nil {
+			return "", nil, err
+		}
+		if _, err := update.WriteString("=NOW()"); err != nil {
+			return "", nil, err
+		}
+		update.Dirty = true
+	}
+	if p.Version.Valid {
+		if update.Dirty {
+			if _, err := update.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := update.WriteString(TableNewsColumnVersion); err != nil {
+			return "", nil, err
+		}
+		if _, err := update.WriteString("="); err != nil {
+			return "", nil, err
+		}
+		if err := update.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		update.Add(p.Version)
+		update.Dirty = true
+
+	} else {
+		if update.Dirty {
+			if _, err := update.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := update.WriteString(TableNewsColumnVersion); err != nil {
+			return "", nil, err
+		}
+		if _, err := update.WriteString("=version+1"); err != nil {
+			return "", nil, err
+		}
+		update.Dirty = true
+	}
+	if p.ViewsDistribution.Valid {
+		if update.Dirty {
+			if _, err := update.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := update.WriteString(TableNewsColumnViewsDistribution); err != nil {
+			return "", nil, err
+		}
+		if _, err := update.WriteString("="); err != nil {
+			return "", nil, err
+		}
+		if err := update.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		update.Add(p.ViewsDistribution)
+		update.Dirty = true
+
+	}
+	if !update.Dirty {
+		return "", nil, errors.New("News update failure, nothing to update")
+	}
+	buf.WriteString(" SET ")
+	buf.ReadFrom(update)
+	buf.WriteString(" WHERE ")
+
+	update.WriteString(TableNewsColumnID)
+	update.WriteString("=")
+	update.WritePlaceholder()
+	update.Add(pk)
+
+	buf.ReadFrom(update)
+	buf.WriteString(" RETURNING ")
+	if len(r.Columns) > 0 {
+		buf.WriteString(strings.Join(r.Columns, ", "))
+	} else {
+		buf.WriteString("content, continue, created_at, day, document, id, lead, meta_data, score, title, updated_at, version, views_distribution")
+	}
+	return buf.String(), update.Args(), nil
+}
+
+func (r *NewsRepositoryBase) updateOneByID(ctx context.Context, tx *sql.Tx, pk int64, p *NewsPatch) (*NewsEntity, error) {
+	query, args, err := r.UpdateOneByIDQuery(pk, p)
+	if err != nil {
+		return nil, err
+	}
+	var ent NewsEntity
+	props, err := ent.Props(r.Columns...)
+	if err != nil {
+		return nil, err
+	}
+	if tx == nil {
+		err = r.DB.QueryRowContext(ctx, query, args...).Scan(props...)
+	} else {
+		err = tx.QueryRowContext(ctx, query, args...).Scan(props...)
+	}
+	if r.Log != nil {
+		if tx == nil {
+			r.Log(err, TableNews, "update by primary key", query, args...)
+		} else {
+			r.Log(err, TableNews, "update by primary key tx", query, args...)
+		}
+	}
+	if err != nil {
+		return nil, wrapError(err)
+	}
+	return &ent, nil
+}
+
+func (r *NewsRepositoryBase) UpdateOneByID(ctx context.Context, pk int64, p *NewsPatch) (*NewsEntity, error) {
+	return r.updateOneByID(ctx, nil, pk, p)
+}
+
+func (r *NewsRepositoryBase) FindOneByIDAndUpdate(ctx context.Context, pk int64, p *NewsPatch) (before, after *NewsEntity, err error) {
+	find := NewComposer(13)
+	find.WriteString("SELECT ")
+	if len(r.Columns) == 0 {
+		find.WriteString("content, continue, created_at, day, document, id, lead, meta_data, score, title, updated_at, version, views_distribution")
+	} else {
+		find.WriteString(strings.Join(r.Columns, ", "))
+	}
+	find.WriteString(" FROM ")
+	find.WriteString(r.Table)
+	find.WriteString(" WHERE ")
+	find.WriteString(TableNewsColumnID)
+	find.WriteString("=")
+	find.WritePlaceholder()
+	find.Add(pk)
+	find.WriteString(" FOR UPDATE")
+	query, args, err := r.UpdateOneByIDQuery(pk, p)
+	if err != nil {
+		return
+	}
+	var (
+		oldEnt, newEnt NewsEntity
+	)
+	oldProps, err := oldEnt.Props(r.Columns...)
+	if err != nil {
+		return
+	}
+	newProps, err := newEnt.Props(r.Columns...)
+	if err != nil {
+		return
+	}
+	tx, err := r.DB.Begin()
+	if err != nil {
+		return
+	}
+	err = tx.QueryRowContext(ctx, find.String(), find.Args()...).Scan(oldProps...)
+	if r.Log != nil {
+		r.Log(err, TableNews, "find by primary key", find.String(), find.Args()...)
+	}
+	if err != nil {
+		tx.Rollback()
+		return
+	}
+	err = tx.QueryRowContext(ctx, query, args...).Scan(newProps...)
+	if r.Log != nil {
+		r.Log(err, TableNews, "update by primary key", query, args...)
+	}
+	if err != nil {
+		tx.Rollback()
+		err = wrapError(err)
+		return
+	}
+	err = tx.Commit()
+	if err != nil {
+		return
+	}
+	return &oldEnt, &newEnt, nil
+}
+
+func (r *NewsRepositoryBase) UpdateOneByTitleQuery(newsTitle string, p *NewsPatch) (string, []interface{}, error) {
+	if r.Validate {
+		if err := p.Validate(); err != nil {
+			return "", nil, err
+		}
+	}
+	buf := bytes.NewBufferString("UPDATE ")
+	buf.WriteString(r.Table)
+	update := NewComposer(1)
+	if p.Content.Valid {
+		if update.Dirty {
+			if _, err := update.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := update.WriteString(TableNewsColumnContent); err != nil {
+			return "", nil, err
+		}
+		if _, err := update.WriteString("="); err != nil {
+			return "", nil, err
+		}
+		if err := update.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		update.Add(p.Content)
+		update.Dirty = true
+
+	}
+	if p.Continue.Valid {
+		if update.Dirty {
+			if _, err := update.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := update.WriteString(TableNewsColumnContinue); err != nil {
+			return "", nil, err
+		}
+		if _, err := update.WriteString("="); err != nil {
+			return "", nil, err
+		}
+		if err := update.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		update.Add(p.Continue)
+		update.Dirty = true
+
+	}
+	if p.CreatedAt.Valid {
+		if update.Dirty {
+			if _, err := update.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := update.WriteString(TableNewsColumnCreatedAt); err != nil {
+			return "", nil, err
+		}
+		if _, err := update.WriteString("="); err != nil {
+			return "", nil, err
+		}
+		if err := update.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		update.Add(p.CreatedAt)
+		update.Dirty = true
+
+	}
+	if p.Day.Valid {
+		if update.Dirty {
+			if _, err := update.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := update.WriteString(TableNewsColumnDay); err != nil {
+			return "", nil, err
+		}
+		if _, err := update.WriteString("="); err != nil {
+			return "", nil, err
+		}
+		if err := update.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		update.Add(p.Day)
+		update.Dirty = true
+
+	}
+	if p.Lead.Valid {
+		if update.Dirty {
+			if _, err := update.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := update.WriteString(TableNewsColumnLead); err != nil {
+			return "", nil, err
+		}
+		if _, err := update.WriteString("="); err != nil {
+			return "", nil, err
+		}
+		if err := update.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		update.Add(p.Lead)
+		update.Dirty = true
+
+	}
+	if p.MetaData != nil {
+		if update.Dirty {
+			if _, err := update.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := update.WriteString(TableNewsColumnMetaData); err != nil {
+			return "", nil, err
+		}
+		if _, err := update.WriteString("="); err != nil {
+			return "", nil, err
+		}
+		if err := update.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		update.Add(p.MetaData)
+		update.Dirty = true
+
+	}
+	if len(p.MetaDataSet) > 0 || p.MetaDataMerge != nil || len(p.MetaDataRemove) > 0 {
+		if p.MetaData != nil {
+			return "", nil, errors.New("meta_data: value and JSON modifications cannot be used together")
+		}
+		if update.Dirty {
+			if _, err := update.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := update.WriteString(TableNewsColumnMetaData); err != nil {
+			return "", nil, err
+		}
+		if _, err := update.WriteString("="); err != nil {
+			return "", nil, err
+		}
+		if err := jsonPatchClause(update, TableNewsColumnMetaData, p.MetaDataSet, p.MetaDataMerge, p.MetaDataRemove); err != nil {
+			return "", nil, err
+		}
+		update.Dirty = true
+	}
+	if p.Score.Valid {
+		if update.Dirty {
+			if _, err := update.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := update.WriteString(TableNewsColumnScore); err != nil {
+			return "", nil, err
+		}
+		if _, err := update.WriteString("="); err != nil {
+			return "", nil, err
+		}
+		if err := update.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		update.Add(p.Score)
+		update.Dirty = true
+
+	}
+	if p.Title.Valid {
+		if update.Dirty {
+			if _, err := update.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := update.WriteString(TableNewsColumnTitle); err != nil {
+			return "", nil, err
+		}
+		if _, err := update.WriteString("="); err != nil {
+			return "", nil, err
+		}
+		if err := update.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		update.Add(p.Title)
+		update.Dirty = true
+
+	}
+	if p.UpdatedAt.Valid {
+		if update.Dirty {
+			if _, err := update.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := update.WriteString(TableNewsColumnUpdatedAt); err != nil {
+			return "", nil, err
+		}
+		if _, err := update.WriteString("="); err != nil {
+			return "", nil, err
+		}
+		if err := update.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		update.Add(p.UpdatedAt)
+		update.Dirty = true
+
+	} else {
+		if update.Dirty {
+			if _, err := update.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := update.WriteString(TableNewsColumnUpdatedAt); err != nil {
+			return "", nil, err
+		}
+		if _, err := update.WriteString("=NOW()"); err != nil {
+			return "", nil, err
+		}
+		update.Dirty = true
+	}
+	if p.Version.Valid {
+		if update.Dirty {
+			if _, err := update.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := update.WriteString(TableNewsColumnVersion); err != nil {
+			return "", nil, err
+		}
+		if _, err := update.WriteString("="); err != nil {
+			return "", nil, err
+		}
+		if err := update.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		update.Add(p.Version)
+		update.Dirty = true
+
+	} else {
+		if update.Dirty {
+			if _, err := update.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := update.WriteString(TableNewsColumnVersion); err != nil {
+			return "", nil, err
+		}
+		if _, err := update.WriteString("=version+1"); err != nil {
+			return "", nil, err
+		}
+		update.Dirty = true
+	}
+	if p.ViewsDistribution.Valid {
+		if update.Dirty {
+			if _, err := update.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := update.WriteString(TableNewsColumnViewsDistribution); err != nil {
+			return "", nil, err
+		}
+		if _, err := update.WriteString("="); err != nil {
+			return "", nil, err
+		}
+		if err := update.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		update.Add(p.ViewsDistribution)
+		update.Dirty = true
+
+	}
+	if !update.Dirty {
+		return "", nil, errors.New("news update failure, nothing to update")
+	}
+	buf.WriteString(" SET ")
+	buf.ReadFrom(update)
+	buf.WriteString(" WHERE ")
+	update.WriteString(TableNewsColumnTitle)
+	update.WriteString("=")
+	update.WritePlaceholder()
+	update.Add(newsTitle)
+	buf.ReadFrom(update)
+	buf.WriteString(" RETURNING ")
+	if len(r.Columns) > 0 {
+		buf.WriteString(strings.Join(r.Columns, ", "))
+	} else {
+		buf.WriteString("content, continue, created_at, day, document, id, lead, meta_data, score, title, updated_at, version, views_distribution")
+	}
+	return buf.String(), update.Args(), nil
+}
+
+func (r *NewsRepositoryBase) UpdateOneByTitleAndLeadQuery(newsTitle string, newsLead string, p *NewsPatch) (string, []interface{}, error) {
+	if r.Validate {
+		if err := p.Validate(); err != nil {
+			return "", nil, err
+		}
+	}
+	buf := bytes.NewBufferString("UPDATE ")
+	buf.WriteString(r.Table)
+	update := NewComposer(2)
+	if p.Content.Valid {
+		if update.Dirty {
+			if _, err := update.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := update.WriteString(TableNewsColumnContent); err != nil {
+			return "", nil, err
+		}
+		if _, err := update.WriteString("="); err != nil {
+			return "", nil, err
+		}
+		if err := update.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		update.Add(p.Content)
+		update.Dirty = true
+
+	}
+	if p.Continue.Valid {
+		if update.Dirty {
+			if _, err := update.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := update.WriteString(TableNewsColumnContinue); err != nil {
+			return "", nil, err
+		}
+		if _, err := update.WriteString("="); err != nil {
+			return "", nil, err
+		}
+		if err := update.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		update.Add(p.Continue)
+		update.Dirty = true
+
+	}
+	if p.CreatedAt.Valid {
+		if update.Dirty {
+			if _, err := update.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := update.WriteString(TableNewsColumnCreatedAt); err != nil {
+			return "", nil, err
+		}
+		if _, err := update.WriteString("="); err != nil {
+			return "", nil, err
+		}
+		if err := update.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		update.Add(p.CreatedAt)
+		update.Dirty = true
+
+	}
+	if p.Day.Valid {
+		if update.Dirty {
+			if _, err := update.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := update.WriteString(TableNewsColumnDay); err != nil {
+			return "", nil, err
+		}
+		if _, err := update.WriteString("="); err != nil {
+			return "", nil, err
+		}
+		if err := update.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		update.Add(p.Day)
+		update.Dirty = true
+
+	}
+	if p.Lead.Valid {
+		if update.Dirty {
+			if _, err := update.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := update.WriteString(TableNewsColumnLead); err != nil {
+			return "", nil, err
+		}
+		if _, err := update.WriteString("="); err != nil {
+			return "", nil, err
+		}
+		if err := update.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		update.Add(p.Lead)
+		update.Dirty = true
+
+	}
+	if p.MetaData != nil {
+		if update.Dirty {
+			if _, err := update.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := update.WriteString(TableNewsColumnMetaData); err != nil {
+			return "", nil, err
+		}
+		if _, err := update.WriteString("="); err != nil {
+			return "", nil, err
+		}
+		if err := update.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		update.Add(p.MetaData)
+		update.Dirty = true
+
+	}
+	if len(p.MetaDataSet) > 0 || p.MetaDataMerge != nil || len(p.MetaDataRemove) > 0 {
+		if p.MetaData != nil {
+			return "", nil, errors.New("meta_data: value and JSON modifications cannot be used together")
+		}
+		if update.Dirty {
+			if _, err := update.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := update.WriteString(TableNewsColumnMetaData); err != nil {
+			return "", nil, err
+		}
+		if _, err := update.WriteString("="); err != nil {
+			return "", nil, err
+		}
+		if err := jsonPatchClause(update, TableNewsColumnMetaData, p.MetaDataSet, p.MetaDataMerge, p.MetaDataRemove); err != nil {
+			return "", nil, err
+		}
+		update.Dirty = true
+	}
+	if p.Score.Valid {
+		if update.Dirty {
+			if _, err := update.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := update.WriteString(TableNewsColumnScore); err != nil {
+			return "", nil, err
+		}
+		if _, err := update.WriteString("="); err != nil {
+			return "", nil, err
+		}
+		if err := update.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		update.Add(p.Score)
+		update.Dirty = true
+
+	}
+	if p.Title.Valid {
+		if update.Dirty {
+			if _, err := update.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := update.WriteString(TableNewsColumnTitle); err != nil {
+			return "", nil, err
+		}
+		if _, err := update.WriteString("="); err != nil {
+			return "", nil, err
+		}
+		if err := update.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		update.Add(p.Title)
+		update.Dirty = true
+
+	}
+	if p.UpdatedAt.Valid {
+		if update.Dirty {
+			if _, err := update.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := update.WriteString(TableNewsColumnUpdatedAt); err != nil {
+			return "", nil, err
+		}
+		if _, err := update.WriteString("="); err != nil {
+			return "", nil, err
+		}
+		if err := update.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		update.Add(p.UpdatedAt)
+		update.Dirty = true
+
+	} else {
+		if update.Dirty {
+			if _, err := update.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := update.WriteString(TableNewsColumnUpdatedAt); err != nil {
+			return "", nil, err
+		}
+		if _, err := update.WriteString("=NOW()"); err != nil {
+			return "", nil, err
+		}
+		update.Dirty = true
+	}
+	if p.Version.Valid {
+		if update.Dirty {
+			if _, err := update.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := update.WriteString(TableNewsColumnVersion); err != nil {
+			return "", nil, err
+		}
+		if _, err := update.WriteString("="); err != nil {
+			return "", nil, err
+		}
+		if err := update.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		update.Add(p.Version)
+		update.Dirty = true
+
+	} else {
+		if update.Dirty {
+			if _, err := update.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := update.WriteString(TableNewsColumnVersion); err != nil {
+			return "", nil, err
+		}
+		if _, err := update.WriteString("=version+1"); err != nil {
+			return "", nil, err
+		}
+		update.Dirty = true
+	}
+	if p.ViewsDistribution.Valid {
+		if update.Dirty {
+			if _, err := update.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := update.WriteString(TableNewsColumnViewsDistribution); err != nil {
+			return "", nil, err
+		}
+		if _, err := update.WriteString("="); err != nil {
+			return "", nil, err
+		}
+		if err := update.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		update.Add(p.ViewsDistribution)
+		update.Dirty = true
+
+	}
+	if !update.Dirty {
+		return "", nil, errors.New("news update failure, nothing to update")
+	}
+	buf.WriteString(" SET ")
+	buf.ReadFrom(update)
+	buf.WriteString(" WHERE ")
+	update.WriteString(TableNewsColumnTitle)
+	update.WriteString("=")
+	update.WritePlaceholder()
+	update.Add(newsTitle)
+	update.WriteString(" AND ")
+	update.WriteString(TableNewsColumnLead)
+	update.WriteString("=")
+	update.WritePlaceholder()
+	update.Add(newsLead)
+	buf.ReadFrom(update)
+	buf.WriteString(" RETURNING ")
+	if len(r.Columns) > 0 {
+		buf.WriteString(strings.Join(r.Columns, ", "))
+	} else {
+		buf.WriteString("content, continue, created_at, day, document, id, lead, meta_data, score, title, updated_at, version, views_distribution")
+	}
+	return buf.String(), update.Args(), nil
+}
+
+func (r *NewsRepositoryBase) updateOneByTitle(ctx context.Context, tx *sql.Tx, newsTitle string, p *NewsPatch) (*NewsEntity, error) {
+	query, args, err := r.UpdateOneByTitleQuery(newsTitle, p)
+	if err != nil {
+		return nil, err
+	}
+	var ent NewsEntity
+	props, err := ent.Props(r.Columns...)
+	if err != nil {
+		return nil, err
+	}
+
+	var row *sql.Row
+	if tx == nil {
+		row = r.DB.QueryRowContext(ctx, query, args...)
+	} else {
+		row = tx.QueryRowContext(ctx, query, args...)
+	}
+	err = row.Scan(props...)
+	if r.Log != nil {
+		if tx == nil {
+			r.Log(err, TableNews, "update one by unique", query, args...)
+		} else {
+			r.Log(err, TableNews, "update one by unique tx", query, args...)
+		}
+	}
+	if err != nil {
+		return nil, wrapError(err)
+	}
+	return &ent, nil
+}
+
+func (r *NewsRepositoryBase) updateOneByTitleAndLead(ctx context.Context, tx *sql.Tx, newsTitle string, newsLead string, p *NewsPatch) (*NewsEntity, error) {
+	query, args, err := r.UpdateOneByTitleAndLeadQuery(newsTitle, newsLead, p)
+	if err != nil {
+		return nil, err
+	}
+	var ent NewsEntity
+	props, err := ent.Props(r.Columns...)
+	if err != nil {
+		return nil, err
+	}
+
+	var row *sql.Row
+	if tx == nil {
+		row = r.DB.QueryRowContext(ctx, query, args...)
+	} else {
+		row = tx.QueryRowContext(ctx, query, args...)
+	}
+	err = row.Scan(props...)
+	if r.Log != nil {
+		if tx == nil {
+			r.Log(err, TableNews, "update one by unique", query, args...)
+		} else {
+			r.Log(err, TableNews, "update one by unique tx", query, args...)
+		}
+	}
+	if err != nil {
+		return nil, wrapError(err)
+	}
+	return &ent, nil
+}
+
+func (r *NewsRepositoryBase) UpdateOneByTitle(ctx context.Context, newsTitle string, p *NewsPatch) (*NewsEntity, error) {
+	return r.updateOneByTitle(ctx, nil, newsTitle, p)
+}
+
+func (r *NewsRepositoryBase) UpdateOneByTitleAndLead(ctx context.Context, newsTitle string, newsLead string, p *NewsPatch) (*NewsEntity, error) {
+	return r.updateOneByTitleAndLead(ctx, nil, newsTitle, newsLead, p)
+}
+
+func (r *NewsRepositoryBase) UpsertQuery(e *NewsEntity, p *NewsPatch, inf ...string) (string, []interface{}, error) {
+	if r.Validate {
+		if err := e.Validate(); err != nil {
+			return "", nil, err
+		}
+		if p != nil {
+			if err := p.Validate(); err != nil {
+				return "", nil, err
+			}
+		}
+	}
+	upsert := NewComposer(26)
+	columns := bytes.NewBuffer(nil)
+	buf := bytes.NewBufferString("INSERT INTO ")
+	buf.WriteString(r.Table)
+
+	if columns.Len() > 0 {
+		if _, err := columns.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+	}
+	if _, err := columns.WriteString(TableNewsColumnContent); err != nil {
+		return "", nil, err
+	}
+	if upsert.Dirty {
+		if _, err := upsert.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+	}
+	if err := upsert.WritePlaceholder(); err != nil {
+		return "", nil, err
+	}
+	upsert.Add(e.Content)
+	upsert.Dirty = true
+
+	if columns.Len() > 0 {
+		if _, err := columns.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+	}
+	if _, err := columns.WriteString(TableNewsColumnContinue); err != nil {
+		return "", nil, err
+	}
+	if upsert.Dirty {
+		if _, err := upsert.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+	}
+	if err := upsert.WritePlaceholder(); err != nil {
+		return "", nil, err
+	}
+	upsert.Add(e.Continue)
+	upsert.Dirty = true
+
+	if !e.CreatedAt.IsZero() {
+		if columns.Len() > 0 {
+			if _, err := columns.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := columns.WriteString(TableNewsColumnCreatedAt); err != nil {
+			return "", nil, err
+		}
+		if upsert.Dirty {
+			if _, err := upsert.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if err := upsert.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		upsert.Add(e.CreatedAt)
+		upsert.Dirty = true
+	}
+
+	if e.Day.Valid {
+		if columns.Len() > 0 {
+			if _, err := columns.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := columns.WriteString(TableNewsColumnDay); err != nil {
+			return "", nil, err
+		}
+		if upsert.Dirty {
+			if _, err := upsert.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if err := upsert.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		upsert.Add(e.Day)
+		upsert.Dirty = true
+	}
+
+	if e.Lead.Valid {
+		if columns.Len() > 0 {
+			if _, err := columns.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := columns.WriteString(TableNewsColumnLead); err != nil {
+			return "", nil, err
+		}
+		if upsert.Dirty {
+			if _, err := upsert.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if err := upsert.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		upsert.Add(e.Lead)
+		upsert.Dirty = true
+	}
+
+	if e.MetaData != nil {
+		if columns.Len() > 0 {
+			if _, err := columns.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := columns.WriteString(TableNewsColumnMetaData); err != nil {
+			return "", nil, err
+		}
+		if upsert.Dirty {
+			if _, err := upsert.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if err := upsert.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		upsert.Add(e.MetaData)
+		upsert.Dirty = true
+	}
+
+	if columns.Len() > 0 {
+		if _, err := columns.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+	}
+	if _, err := columns.WriteString(TableNewsColumnScore); err != nil {
+		return "", nil, err
+	}
+	if upsert.Dirty {
+		if _, err := upsert.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+	}
+	if err := upsert.WritePlaceholder(); err != nil {
+		return "", nil, err
+	}
+	upsert.Add(e.Score)
+	upsert.Dirty = true
+
+	if columns.Len() > 0 {
+		if _, err := columns.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+	}
+	if _, err := columns.WriteString(TableNewsColumnTitle); err != nil {
+		return "", nil, err
+	}
+	if upsert.Dirty {
+		if _, err := upsert.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+	}
+	if err := upsert.WritePlaceholder(); err != nil {
+		return "", nil, err
+	}
+	upsert.Add(e.Title)
+	upsert.Dirty = true
+
+	if e.UpdatedAt.Valid {
+		if columns.Len() > 0 {
+			if _, err := columns.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := columns.WriteString(TableNewsColumnUpdatedAt); err != nil {
+			return "", nil, err
+		}
+		if upsert.Dirty {
+			if _, err := upsert.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if err := upsert.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		upsert.Add(e.UpdatedAt)
+		upsert.Dirty = true
+	}
+
+	if columns.Len() > 0 {
+		if _, err := columns.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+	}
+	if _, err := columns.WriteString(TableNewsColumnVersion); err != nil {
+		return "", nil, err
+	}
+	if upsert.Dirty {
+		if _, err := upsert.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+	}
+	if err := upsert.WritePlaceholder(); err != nil {
+		return "", nil, err
+	}
+	upsert.Add(e.Version)
+	upsert.Dirty = true
+
+	if e.ViewsDistribution.Valid {
+		if columns.Len() > 0 {
+			if _, err := columns.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := columns.WriteString(TableNewsColumnViewsDistribution); err != nil {
+			return "", nil, err
+		}
+		if upsert.Dirty {
+			if _, err := upsert.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if err := upsert.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		upsert.Add(e.ViewsDistribution)
+		upsert.Dirty = true
+	}
+
+	if upsert.Dirty {
+		buf.WriteString(" (")
+		buf.ReadFrom(columns)
+		buf.WriteString(") VALUES (")
+		buf.ReadFrom(upsert)
+		buf.WriteString(")")
+	}
+	buf.WriteString(" ON CONFLICT ")
+	if len(inf) > 0 {
+		upsert.Dirty = false
+		if p.Content.Valid {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TableNewsColumnContent); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("="); err != nil {
+				return "", nil, err
+			}
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(p.Content)
+			upsert.Dirty = true
+
+		}
+		if p.Continue.Valid {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TableNewsColumnContinue); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("="); err != nil {
+				return "", nil, err
+			}
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(p.Continue)
+			upsert.Dirty = true
+
+		}
+		if p.CreatedAt.Valid {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TableNewsColumnCreatedAt); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("="); err != nil {
+				return "", nil, err
+			}
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(p.CreatedAt)
+			upsert.Dirty = true
+
+		}
+		if p.Day.Valid {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TableNewsColumnDay); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("="); err != nil {
+				return "", nil, err
+			}
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(p.Day)
+			upsert.Dirty = true
+
+		}
+		if p.Lead.Valid {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TableNewsColumnLead); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("="); err != nil {
+				return "", nil, err
+			}
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(p.Lead)
+			upsert.Dirty = true
+
+		}
+		if p.MetaData != nil {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TableNewsColumnMetaData); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("="); err != nil {
+				return "", nil, err
+			}
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(p.MetaData)
+			upsert.Dirty = true
+
+		}
+		if len(p.MetaDataSet) > 0 || p.MetaDataMerge != nil || len(p.MetaDataRemove) > 0 {
+			if p.MetaData != nil {
+				return "", nil, errors.New("meta_data: value and JSON modifications cannot be used together")
+			}
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TableNewsColumnMetaData); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("="); err != nil {
+				return "", nil, err
+			}
+			if err := jsonPatchClause(upsert, TableNewsColumnMetaData, p.MetaDataSet, p.MetaDataMerge, p.MetaDataRemove); err != nil {
+				return "", nil, err
+			}
+			upsert.Dirty = true
+		}
+		if p.Score.Valid {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TableNewsColumnScore); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("="); err != nil {
+				return "", nil, err
+			}
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(p.Score)
+			upsert.Dirty = true
+
+		}
+		if p.Title.Valid {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TableNewsColumnTitle); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("="); err != nil {
+				return "", nil, err
+			}
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(p.Title)
+			upsert.Dirty = true
+
+		}
+		if p.UpdatedAt.Valid {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TableNewsColumnUpdatedAt); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("="); err != nil {
+				return "", nil, err
+			}
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(p.UpdatedAt)
+			upsert.Dirty = true
+
+		} else {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TableNewsColumnUpdatedAt); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("=NOW()"); err != nil {
+				return "", nil, err
+			}
+			upsert.Dirty = true
+		}
+		if p.Version.Valid {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TableNewsColumnVersion); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("="); err != nil {
+				return "", nil, err
+			}
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(p.Version)
+			upsert.Dirty = true
+
+		} else {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TableNewsColumnVersion); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("=version+1"); err != nil {
+				return "", nil, err
+			}
+			upsert.Dirty = true
+		}
+		if p.ViewsDistribution.Valid {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TableNewsColumnViewsDistribution); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("="); err != nil {
+				return "", nil, err
+			}
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(p.ViewsDistribution)
+			upsert.Dirty = true
+
+		}
+	}
+	if len(inf) > 0 && upsert.Dirty {
+		buf.WriteString("(")
+		for j, i := range inf {
+			if j != 0 {
+				buf.WriteString(", ")
+			}
+			buf.WriteString(i)
+		}
+		buf.WriteString(")")
+		buf.WriteString(" DO UPDATE SET ")
+		buf.ReadFrom(upsert)
+	} else {
+		buf.WriteString(" DO NOTHING ")
+	}
+	if upsert.Dirty {
+		buf.WriteString(" RETURNING ")
+		if len(r.Columns) > 0 {
+			buf.WriteString(strings.Join(r.Columns, ", "))
+		} else {
+			buf.WriteString("content, continue, created_at, day, document, id, lead, meta_data, score, title, updated_at, version, views_distribution")
+		}
+	}
+	return buf.String(), upsert.Args(), nil
+}
+
+func (r *NewsRepositoryBase) upsert(ctx context.Context, tx *sql.Tx, e *NewsEntity, p *NewsPatch, inf ...string) (*NewsEntity, error) {
+	query, args, err := r.UpsertQuery(e, p, inf...)
+	if err != nil {
+		return nil, err
+	}
+
+	var row *sql.Row
+	if tx == nil {
+		row = r.DB.QueryRowContext(ctx, query, args...)
+	} else {
+		row = tx.QueryRowContext(ctx, query, args...)
+	}
+	err = row.Scan(
+		&e.Content,
+		&e.Continue,
+		&e.CreatedAt,
+		&e.Day,
+		&e.Document,
+		&e.ID,
+		&e.Lead,
+		&e.MetaData,
+		&e.Score,
+		&e.Title,
+		&e.UpdatedAt,
+		&e.Version,
+		&e.ViewsDistribution,
+	)
+	if r.Log != nil {
+		if tx == nil {
+			r.Log(err, TableNews, "upsert", query, args...)
+		} else {
+			r.Log(err, TableNews, "upsert tx", query, args...)
+		}
+	}
+	if err != nil {
+		return nil, wrapError(err)
+	}
+	return e, nil
+}
+
+func (r *NewsRepositoryBase) Upsert(ctx context.Context, e *NewsEntity, p *NewsPatch, inf ...string) (*NewsEntity, error) {
+	return r.upsert(ctx, nil, e, p, inf...)
+}
+
+// NewsConflictTarget is a conflict target of news upsert, only NewsConflict* variables can be used.
+type NewsConflictTarget struct {
+	constraint string
+	columns    []string
+	where      string
+}
+
+func (ct NewsConflictTarget) write(buf *bytes.Buffer) {
+	switch {
+	case ct.constraint != "":
+		buf.WriteString(" ON CONSTRAINT \"")
+		buf.WriteString(ct.constraint)
+		buf.WriteString("\"")
+	case len(ct.columns) > 0:
+		buf.WriteString(" (")
+		buf.WriteString(strings.Join(ct.columns, ", "))
+		buf.WriteString(")")
+		if ct.where != "" {
+			buf.WriteString(" WHERE ")
+			buf.WriteString(ct.where)
+		}
+	}
+}
+
+var (
+	// NewsConflictPrimaryKey targets primary key constraint.
+	NewsConflictPrimaryKey = NewsConflictTarget{constraint: TableNewsConstraintPrimaryKey}
+	// NewsConflictTitleUnique targets unique constraint on title.
+	NewsConflictTitleUnique = NewsConflictTarget{constraint: TableNewsConstraintTitleUnique}
+	// NewsConflictTitleLeadUnique targets unique constraint on title, lead.
+	NewsConflictTitleLeadUnique = NewsConflictTarget{constraint: TableNewsConstraintTitleLeadUnique}
+)
+
+// NewsUpsertExpr describes how conflicts are resolved by UpsertOne and UpsertMany.
+type NewsUpsertExpr struct {
+	// Target is required if Patch is set, DO NOTHING can be used without it.
+	Target NewsConflictTarget
+	// Patch is applied to the conflicting row (DO UPDATE), if nil or empty the row is left untouched (DO NOTHING).
+	Patch *NewsPatch
+	// Where limits DO UPDATE to the conflicting rows that match the criteria, other rows are left untouched.
+	Where *NewsCriteria
+}
+
+func (r *NewsRepositoryBase) UpsertManyQuery(ue *NewsUpsertExpr, es ...*NewsEntity) (string, []interface{}, error) {
+	if len(es) == 0 {
+		return "", nil, errors.New("upsert requires at least one entity")
+	}
+	if ue == nil {
+		ue = &NewsUpsertExpr{}
+	}
+	if r.Validate {
+		for _, e := range es {
+			if err := e.Validate(); err != nil {
+				return "", nil, err
+			}
+		}
+		if ue.Patch != nil {
+			if err := ue.Patch.Validate(); err != nil {
+				return "", nil, err
+			}
+		}
+	}
+	upsert := NewComposer(11)
+	buf := bytes.NewBufferString("INSERT INTO ")
+	buf.WriteString(r.Table)
+	buf.WriteString(" AS t0 (")
+	buf.WriteString(TableNewsColumnContent + ", " + TableNewsColumnContinue + ", " + TableNewsColumnCreatedAt + ", " + TableNewsColumnDay + ", " + TableNewsColumnLead + ", " + TableNewsColumnMetaData + ", " + TableNewsColumnScore + ", " + TableNewsColumnTitle + ", " + TableNewsColumnUpdatedAt + ", " + TableNewsColumnVersion + ", " + TableNewsColumnViewsDistribution)
+	buf.WriteString(") VALUES ")
+	for i, e := range es {
+		if i != 0 {
+			if _, err := upsert.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := upsert.WriteString("("); err != nil {
+			return "", nil, err
+		}
+		if err := upsert.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		upsert.Add(e.Content)
+		if _, err := upsert.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+		if err := upsert.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		upsert.Add(e.Continue)
+		if _, err := upsert.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+		if !e.CreatedAt.IsZero() {
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(e.CreatedAt)
+		} else if _, err := upsert.WriteString("DEFAULT"); err != nil {
+			return "", nil, err
+		}
+		if _, err := upsert.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+		if e.Day.Valid {
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(e.Day)
+		} else if _, err := upsert.WriteString("DEFAULT"); err != nil {
+			return "", nil, err
+		}
+		if _, err := upsert.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+		if e.Lead.Valid {
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(e.Lead)
+		} else if _, err := upsert.WriteString("DEFAULT"); err != nil {
+			return "", nil, err
+		}
+		if _, err := upsert.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+		if e.MetaData != nil {
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(e.MetaData)
+		} else if _, err := upsert.WriteString("DEFAULT"); err != nil {
+			return "", nil, err
+		}
+		if _, err := upsert.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+		if err := upsert.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		upsert.Add(e.Score)
+		if _, err := upsert.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+		if err := upsert.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		upsert.Add(e.Title)
+		if _, err := upsert.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+		if e.UpdatedAt.Valid {
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(e.UpdatedAt)
+		} else if _, err := upsert.WriteString("DEFAULT"); err != nil {
+			return "", nil, err
+		}
+		if _, err := upsert.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+		if err := upsert.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		upsert.Add(e.Version)
+		if _, err := upsert.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+		if e.ViewsDistribution.Valid {
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(e.ViewsDistribution)
+		} else if _, err := upsert.WriteString("DEFAULT"); err != nil {
+			return "", nil, err
+		}
+		if _, err := upsert.WriteString(")"); err != nil {
+			return "", nil, err
+		}
+	}
+	buf.ReadFrom(upsert)
+	buf.WriteString(" ON CONFLICT")
+	ue.Target.write(buf)
+
+	upsert.Dirty = false
+	if p := ue.Patch; p != nil {
+		if p.Content.Valid {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TableNewsColumnContent); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("="); err != nil {
+				return "", nil, err
+			}
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(p.Content)
+			upsert.Dirty = true
+
+		}
+		if p.Continue.Valid {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TableNewsColumnContinue); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("="); err != nil {
+				return "", nil, err
+			}
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(p.Continue)
+			upsert.Dirty = true
+
+		}
+		if p.CreatedAt.Valid {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TableNewsColumnCreatedAt); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("="); err != nil {
+				return "", nil, err
+			}
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(p.CreatedAt)
+			upsert.Dirty = true
+
+		}
+		if p.Day.Valid {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TableNewsColumnDay); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("="); err != nil {
+				return "", nil, err
+			}
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(p.Day)
+			upsert.Dirty = true
+
+		}
+		if p.Lead.Valid {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TableNewsColumnLead); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("="); err != nil {
+				return "", nil, err
+			}
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(p.Lead)
+			upsert.Dirty = true
+
+		}
+		if p.MetaData != nil {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TableNewsColumnMetaData); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("="); err != nil {
+				return "", nil, err
+			}
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(p.MetaData)
+			upsert.Dirty = true
+
+		}
+		if len(p.MetaDataSet) > 0 || p.MetaDataMerge != nil || len(p.MetaDataRemove) > 0 {
+			if p.MetaData != nil {
+				return "", nil, errors.New("meta_data: value and JSON modifications cannot be used together")
+			}
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TableNewsColumnMetaData); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("="); err != nil {
+				return "", nil, err
+			}
+			if err := jsonPatchClause(upsert, TableNewsColumnMetaData, p.MetaDataSet, p.MetaDataMerge, p.MetaDataRemove); err != nil {
+				return "", nil, err
+			}
+			upsert.Dirty = true
+		}
+		if p.Score.Valid {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TableNewsColumnScore); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("="); err != nil {
+				return "", nil, err
+			}
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(p.Score)
+			upsert.Dirty = true
+
+		}
+		if p.Title.Valid {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TableNewsColumnTitle); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("="); err != nil {
+				return "", nil, err
+			}
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(p.Title)
+			upsert.Dirty = true
+
+		}
+		if p.UpdatedAt.Valid {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TableNewsColumnUpdatedAt); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("="); err != nil {
+				return "", nil, err
+			}
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(p.UpdatedAt)
+			upsert.Dirty = true
+
+		} else {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TableNewsColumnUpdatedAt); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("=NOW()"); err != nil {
+				return "", nil, err
+			}
+			upsert.Dirty = true
+		}
+		if p.Version.Valid {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TableNewsColumnVersion); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("="); err != nil {
+				return "", nil, err
+			}
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(p.Version)
+			upsert.Dirty = true
+
+		} else {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TableNewsColumnVersion); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("=version+1"); err != nil {
+				return "", nil, err
+			}
+			upsert.Dirty = true
+		}
+		if p.ViewsDistribution.Valid {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TableNewsColumnViewsDistribution); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("="); err != nil {
+				return "", nil, err
+			}
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(p.ViewsDistribution)
+			upsert.Dirty = true
+
+		}
+	}
+	if !upsert.Dirty {
+		buf.WriteString(" DO NOTHING")
+	} else {
+		if ue.Target.constraint == "" && len(ue.Target.columns) == 0 {
+			return "", nil, errors.New("upsert with patch requires conflict target")
+		}
+		buf.WriteString(" DO UPDATE SET ")
+		buf.ReadFrom(upsert)
+		if ue.Where != nil {
+			upsert.Dirty = false
+			if err := NewsCriteriaWhereClause(upsert, ue.Where, 0, r.Table); err != nil {
+				return "", nil, err
+			}
+			if upsert.Dirty {
+				buf.WriteString(" WHERE ")
+				buf.ReadFrom(upsert)
+			}
+		}
+	}
+	buf.WriteString(" RETURNING ")
+	if len(r.Columns) > 0 {
+		buf.WriteString(strings.Join(r.Columns, ", "))
+	} else {
+		buf.WriteString("content, continue, created_at, day, document, id, lead, meta_data, score, title, updated_at, version, views_distribution")
+	}
+	return buf.String(), upsert.Args(), nil
+}
+
+func (r *NewsRepositoryBase) upsertMany(ctx context.Context, tx *sql.Tx, ue *NewsUpsertExpr, es ...*NewsEntity) ([]*NewsEntity, error) {
+	query, args, err := r.UpsertManyQuery(ue, es...)
+	if err != nil {
+		return nil, err
+	}
+	var rows *sql.Rows
+	if tx == nil {
+		rows, err = r.DB.QueryContext(ctx, query, args...)
+	} else {
+		rows, err = tx.QueryContext(ctx, query, args...)
+	}
+	if r.Log != nil {
+		if tx == nil {
+			r.Log(err, TableNews, "upsert many", query, args...)
+		} else {
+			r.Log(err, TableNews, "upsert many tx", query, args...)
+		}
+	}
+	if err != nil {
+		return nil, wrapError(err)
+	}
+	defer rows.Close()
+
+	var entities []*NewsEntity
+	for rows.Next() {
+		var ent NewsEntity
+		props, err := ent.Props(r.Columns...)
+		if err != nil {
+			return nil, err
+		}
+		if err = rows.Scan(props...); err != nil {
+			return nil, err
+		}
+		entities = append(entities, &ent)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, wrapError(err)
+	}
+	return entities, nil
+}
+
+// UpsertMany inserts entities in a single statement, conflicts are resolved as described by the expression.
+// Returned entities are those inserted or updated, rows left untouched by DO NOTHING or Where are omitted.
+func (r *NewsRepositoryBase) UpsertMany(ctx context.Context, ue *NewsUpsertExpr, es ...*NewsEntity) ([]*NewsEntity, error) {
+	return r.upsertMany(ctx, nil, ue, es...)
+}
+
+// UpsertOne inserts the entity, conflict is resolved as described by the expression.
+// Returned flag is false if the row was neither inserted nor updated.
+func (r *NewsRepositoryBase) UpsertOne(ctx context.Context, e *NewsEntity, ue *NewsUpsertExpr) (*NewsEntity, bool, error) {
+	return r.upsertOne(ctx, nil, e, ue)
+}
+
+func (r *NewsRepositoryBase) upsertOne(ctx context.Context, tx *sql.Tx, e *NewsEntity, ue *NewsUpsertExpr) (*NewsEntity, bool, error) {
+	entities, err := r.upsertMany(ctx, tx, ue, e)
+	if err != nil {
+		return nil, false, err
+	}
+	if len(entities) == 0 {
+		return e, false, nil
+	}
+	*e = *entities[0]
+	return e, true, nil
+}
+
+func (r *NewsRepositoryBase) count(ctx context.Context, tx *sql.Tx, exp *NewsCountExpr) (int64, error) {
+	query, args, err := r.FindQuery(&NewsFindExpr{
+		Where:   exp.Where,
+		Columns: []NewsColumn{newsColumnCount},
+	})
+	if err != nil {
+		return 0, err
+	}
+	var count int64
+	if tx == nil {
+		err = r.DB.QueryRowContext(ctx, query, args...).Scan(&count)
+	} else {
+		err = tx.QueryRowContext(ctx, query, args...).Scan(&count)
+	}
+	if r.Log != nil {
+		if tx == nil {
+			r.Log(err, TableNews, "count", query, args...)
+		} else {
+			r.Log(err, TableNews, "count tx", query, args...)
+		}
+	}
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+func (r *NewsRepositoryBase) Count(ctx context.Context, exp *NewsCountExpr) (int64, error) {
+	return r.count(ctx, nil, exp)
+}
+
+func (r *NewsRepositoryBase) deleteOneByID(ctx context.Context, tx *sql.Tx, pk int64) (int64, error) {
+	find := NewComposer(13)
+	find.WriteString("DELETE FROM ")
+	find.WriteString(r.Table)
+	find.WriteString(" WHERE ")
+	find.WriteString(TableNewsColumnID)
+	find.WriteString("=")
+	find.WritePlaceholder()
+	find.Add(pk)
+	res, err := exec(ctx, r.DB, tx, r.StmtCache, find.String(), find.Args()...)
+	if err != nil {
+		return 0, wrapError(err)
+	}
+
+	return res.RowsAffected()
+}
+
+func (r *NewsRepositoryBase) DeleteOneByID(ctx context.Context, pk int64) (int64, error) {
+	return r.deleteOneByID(ctx, nil, pk)
+}
+
+type NewsRepositoryBaseTx struct {
+	base *NewsRepositoryBase
+	tx   *sql.Tx
+}
+
+func (r NewsRepositoryBaseTx) Commit() error {
+	return r.tx.Commit()
+}
+
+func (r NewsRepositoryBaseTx) Rollback() error {
+	return r.tx.Rollback()
+}
+
+// Savepoint establishes new savepoint within the transaction.
+func (r *NewsRepositoryBaseTx) Savepoint(ctx context.Context, name string) error {
+	return savepoint(ctx, r.tx, name)
+}
+
+// RollbackTo rolls back all changes made after the savepoint was established, the transaction can be continued.
+func (r *NewsRepositoryBaseTx) RollbackTo(ctx context.Context, name string) error {
+	return rollbackToSavepoint(ctx, r.tx, name)
+}
+
+// Release destroys the savepoint, changes made after it was established are kept.
+func (r *NewsRepositoryBaseTx) Release(ctx context.Context, name string) error {
+	return releaseSavepoint(ctx, r.tx, name)
+}
+
+// RunInTransaction runs given function within a savepoint of the transaction, see RunInSavepoint.
+func (r *NewsRepositoryBaseTx) RunInTransaction(ctx context.Context, fn func(rtx *NewsRepositoryBaseTx) error) error {
+	return RunInSavepoint(ctx, r.tx, func(*sql.Tx) error {
+		return fn(r)
+	})
+}
+
+func (r *NewsRepositoryBaseTx) Insert(ctx context.Context, e *NewsEntity) (*NewsEntity, error) {
+	return r.base.insert(ctx, r.tx, e)
+}
+
+func (r *NewsRepositoryBaseTx) Find(ctx context.Context, fe *NewsFindExpr) ([]*NewsEntity, error) {
+	return r.base.find(ctx, r.tx, fe)
+}
+
+func (r *NewsRepositoryBaseTx) FindIter(ctx context.Context, fe *NewsFindExpr) (*NewsIterator, error) {
+	return r.base.findIter(ctx, r.tx, fe)
+}
+
+func (r *NewsRepositoryBaseTx) FindProjection(ctx context.Context, fe *NewsFindExpr, next func() Projection) error {
+	return r.base.findProjection(ctx, r.tx, fe, next)
+}
+
+func (r *NewsRepositoryBaseTx) FindOneByID(ctx context.Context, pk int64, lock ...RowLock) (*NewsEntity, error) {
+	if len(lock) > 1 {
+		return nil, ErrMultipleRowLocks
+	}
+	var rl RowLock
+	if len(lock) > 0 {
+		rl = lock[0]
+	}
+	return r.base.findOneByID(ctx, r.tx, pk, rl)
+}
+
+func (r *NewsRepositoryBaseTx) FindOneByTitle(ctx context.Context, newsTitle string, lock ...RowLock) (*NewsEntity, error) {
+	if len(lock) > 1 {
+		return nil, ErrMultipleRowLocks
+	}
+	var rl RowLock
+	if len(lock) > 0 {
+		rl = lock[0]
+	}
+	return r.base.findOneByTitle(ctx, r.tx, newsTitle, rl)
+}
+func (r *NewsRepositoryBaseTx) FindOneByTitleAndLead(ctx context.Context, newsTitle string, newsLead string, lock ...RowLock) (*NewsEntity, error) {
+	if len(lock) > 1 {
+		return nil, ErrMultipleRowLocks
+	}
+	var rl RowLock
+	if len(lock) > 0 {
+		rl = lock[0]
+	}
+	return r.base.findOneByTitleAndLead(ctx, r.tx, newsTitle, newsLead, rl)
+}
+
+func (r *NewsRepositoryBaseTx) History(ctx context.Context, pk int64) ([]*NewsHistoryEntity, error) {
+	return r.base.history(ctx, r.tx, pk)
+}
+
+func (r *NewsRepositoryBaseTx) FindOneByIDAsOf(ctx context.Context, pk int64, at time.Time) (*NewsEntity, error) {
+	return r.base.findOneByIDAsOf(ctx, r.tx, pk, at)
+}
+
+func (r *NewsRepositoryBaseTx) UpdateOneByID(ctx context.Context, pk int64, p *NewsPatch) (*NewsEntity, error) {
+	return r.base.updateOneByID(ctx, r.tx, pk, p)
+}
+
+func (r *NewsRepositoryBaseTx) UpdateOneByTitle(ctx context.Context, newsTitle string, p *NewsPatch) (*NewsEntity, error) {
+	return r.base.updateOneByTitle(ctx, r.tx, newsTitle, p)
+}
+
+func (r *NewsRepositoryBaseTx) UpdateOneByTitleAndLead(ctx context.Context, newsTitle string, newsLead string, p *NewsPatch) (*NewsEntity, error) {
+	return r.base.updateOneByTitleAndLead(ctx, r.tx, newsTitle, newsLead, p)
+}
+
+func (r *NewsRepositoryBaseTx) Upsert(ctx context.Context, e *NewsEntity, p *NewsPatch, inf ...string) (*NewsEntity, error) {
+	return r.base.upsert(ctx, r.tx, e, p, inf...)
+}
+
+func (r *NewsRepositoryBaseTx) UpsertMany(ctx context.Context, ue *NewsUpsertExpr, es ...*NewsEntity) ([]*NewsEntity, error) {
+	return r.base.upsertMany(ctx, r.tx, ue, es...)
+}
+
+func (r *NewsRepositoryBaseTx) UpsertOne(ctx context.Context, e *NewsEntity, ue *NewsUpsertExpr) (*NewsEntity, bool, error) {
+	return r.base.upsertOne(ctx, r.tx, e, ue)
+}
+
+func (r *NewsRepositoryBaseTx) Count(ctx context.Context, exp *NewsCountExpr) (int64, error) {
+	return r.base.count(ctx, r.tx, exp)
+}
+
+func (r *NewsRepositoryBaseTx) DeleteOneByID(ctx context.Context, pk int64) (int64, error) {
+	return r.base.deleteOneByID(ctx, r.tx, pk)
+}
+
+// NewNewsFixture returns NewsEntity which mandatory columns are filled with random values, overrides are applied afterwards.
+// If overrides leave a required foreign key unset, parent fixture is inserted and referenced.
+func (f *Fixtures) NewNewsFixture(ctx context.Context, overrides ...func(*NewsEntity)) (*NewsEntity, error) {
+	e := &NewsEntity{
+		Content: f.string(0, false),
+		Title:   f.string(0, true),
+		Version: f.int64(false),
+	}
+	for _, o := range overrides {
+		o(e)
+	}
+	return e, nil
+}
+
+// InsertNewsFixture works like NewNewsFixture, but it inserts the fixture as well.
+func (f *Fixtures) InsertNewsFixture(ctx context.Context, overrides ...func(*NewsEntity)) (*NewsEntity, error) {
+	e, err := f.NewNewsFixture(ctx, overrides...)
+	if err != nil {
+		return nil, err
+	}
+	return (&NewsRepositoryBase{Table: TableNews, DB: f.DB}).Insert(ctx, e)
+}
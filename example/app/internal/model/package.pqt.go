@@ -0,0 +1,1910 @@
+// Code generated by pqt. DO NOT EDIT.
+
+package model
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/lib/pq"
+)
+
+const (
+	TablePackageConstraintPrimaryKey           = "example.package_id_pkey"
+	TablePackageConstraintCategoryIDForeignKey = "example.package_category_id_fkey"
+)
+
+const (
+	TablePackage                 = "example.package"
+	TablePackageColumnBreak      = "break"
+	TablePackageColumnCategoryID = "category_id"
+	TablePackageColumnCreatedAt  = "created_at"
+	TablePackageColumnID         = "id"
+	TablePackageColumnUpdatedAt  = "updated_at"
+)
+
+var TablePackageColumns = []string{
+	TablePackageColumnBreak,
+	TablePackageColumnCategoryID,
+	TablePackageColumnCreatedAt,
+	TablePackageColumnID,
+	TablePackageColumnUpdatedAt,
+}
+
+// PackageEntity ...
+type PackageEntity struct {
+	// Break ...
+	Break sql.NullString
+	// CategoryID ...
+	CategoryID sql.NullInt64
+	// CreatedAt ...
+	CreatedAt time.Time
+	// ID ...
+	ID int64
+	// UpdatedAt ...
+	UpdatedAt pq.NullTime
+	// Category ...
+	Category *CategoryEntity
+	// fields holds fields loaded by a projection, see Loaded.
+	fields PackageFields
+}
+
+func (e *PackageEntity) Prop(cn string) (interface{}, bool) {
+	switch cn {
+
+	case TablePackageColumnBreak:
+		return &e.Break, true
+	case TablePackageColumnCategoryID:
+		return &e.CategoryID, true
+	case TablePackageColumnCreatedAt:
+		return &e.CreatedAt, true
+	case TablePackageColumnID:
+		return &e.ID, true
+	case TablePackageColumnUpdatedAt:
+		return &e.UpdatedAt, true
+	default:
+		return nil, false
+	}
+}
+
+func (e *PackageEntity) Props(cns ...string) ([]interface{}, error) {
+	projection := len(cns) > 0
+	if !projection {
+		cns = TablePackageColumns
+	}
+	e.fields = PackageFields{}
+	res := make([]interface{}, 0, len(cns))
+	for _, cn := range cns {
+		if prop, ok := e.Prop(cn); ok {
+			res = append(res, prop)
+		} else {
+			return nil, fmt.Errorf("unexpected column provided: %s", cn)
+		}
+		if projection {
+			e.fields.add(cn)
+		}
+	}
+	return res, nil
+}
+
+// PackageFields is a set of PackageEntity fields, identified by their columns.
+type PackageFields [1]uint64
+
+// Has reports whether the set contains field of given column.
+func (f PackageFields) Has(cn string) bool {
+	i := pkgFieldIndex(cn)
+	return i >= 0 && f[i/64]&(1<<uint(i%64)) != 0
+}
+
+func (f *PackageFields) add(cn string) {
+	if i := pkgFieldIndex(cn); i >= 0 {
+		f[i/64] |= 1 << uint(i%64)
+	}
+}
+
+// pkgFieldIndex returns position of the column in TablePackageColumns, or -1 if there is no such column.
+func pkgFieldIndex(cn string) int {
+	switch cn {
+	case TablePackageColumnBreak:
+		return 0
+	case TablePackageColumnCategoryID:
+		return 1
+	case TablePackageColumnCreatedAt:
+		return 2
+	case TablePackageColumnID:
+		return 3
+	case TablePackageColumnUpdatedAt:
+		return 4
+	}
+	return -1
+}
+
+// Loaded reports whether field of given column was loaded by the query that returned the entity.
+// Entities loaded with all columns, as well as entities built by hand, report every field as loaded.
+func (e *PackageEntity) Loaded(cn string) bool {
+	if e.fields == (PackageFields{}) {
+		return pkgFieldIndex(cn) >= 0
+	}
+	return e.fields.Has(cn)
+}
+
+// Validate checks if entity meets NOT NULL, length, precision, enum, array size and simple check constraints of the table.
+// It returns ValidationError that lists all violations, if any.
+func (e *PackageEntity) Validate() error {
+	return nil
+}
+
+// ScanPackageRows helps to scan rows straight to the slice of entities.
+func ScanPackageRows(rows Rows) (entities []*PackageEntity, err error) {
+	for rows.Next() {
+		var ent PackageEntity
+		err = rows.Scan(
+			&ent.Break,
+			&ent.CategoryID,
+			&ent.CreatedAt,
+			&ent.ID,
+			&ent.UpdatedAt,
+		)
+		if err != nil {
+			return
+		}
+
+		entities = append(entities, &ent)
+	}
+	if err = rows.Err(); err != nil {
+		return
+	}
+
+	return
+}
+
+// PackageIterator is not thread safe.
+type PackageIterator struct {
+	rows Rows
+	cols []string
+	expr *PackageFindExpr
+}
+
+func (i *PackageIterator) Next() bool {
+	return i.rows.Next()
+}
+
+func (i *PackageIterator) Close() error {
+	return i.rows.Close()
+}
+
+func (i *PackageIterator) Err() error {
+	return i.rows.Err()
+}
+
+// Columns is wrapper around sql.Rows.Columns method, that also cache output inside iterator.
+func (i *PackageIterator) Columns() ([]string, error) {
+	if i.cols == nil {
+		cols, err := i.rows.Columns()
+		if err != nil {
+			return nil, err
+		}
+		i.cols = cols
+	}
+	return i.cols, nil
+}
+
+// Ent is wrapper around Package method that makes iterator more generic.
+func (i *PackageIterator) Ent() (interface{}, error) {
+	return i.Package()
+}
+
+func (i *PackageIterator) Package() (*PackageEntity, error) {
+	var ent PackageEntity
+	cols, err := i.Columns()
+	if err != nil {
+		return nil, err
+	}
+
+	props, err := ent.Props(cols...)
+	if err != nil {
+		return nil, err
+	}
+	var prop []interface{}
+	if i.expr.JoinCategory != nil && i.expr.JoinCategory.Kind.Actionable() && i.expr.JoinCategory.Fetch {
+		ent.Category = &CategoryEntity{}
+		if prop, err = ent.Category.Props(); err != nil {
+			return nil, err
+		}
+		props = append(props, prop...)
+	}
+	if err := i.rows.Scan(props...); err != nil {
+		return nil, err
+	}
+	return &ent, nil
+}
+
+type PackageCriteria struct {
+	Break                  sql.NullString
+	CategoryID             sql.NullInt64
+	CreatedAt              pq.NullTime
+	ID                     sql.NullInt64
+	UpdatedAt              pq.NullTime
+	operator               string
+	child, sibling, parent *PackageCriteria
+}
+
+func PackageOperand(operator string, operands ...*PackageCriteria) *PackageCriteria {
+	if len(operands) == 0 {
+		return &PackageCriteria{operator: operator}
+	}
+
+	parent := &PackageCriteria{
+		operator: operator,
+		child:    operands[0],
+	}
+
+	for i := 0; i < len(operands); i++ {
+		if i < len(operands)-1 {
+			operands[i].sibling = operands[i+1]
+		}
+		operands[i].parent = parent
+	}
+
+	return parent
+}
+
+func PackageOr(operands ...*PackageCriteria) *PackageCriteria {
+	return PackageOperand("OR", operands...)
+}
+
+func PackageAnd(operands ...*PackageCriteria) *PackageCriteria {
+	return PackageOperand("AND", operands...)
+}
+
+// PackageCriteriaWhereClause writes conditions of the criteria tree into the composer.
+// The id is the alias of the table named table, tables of related rows are resolved relative to its schema.
+func PackageCriteriaWhereClause(comp *Composer, c *PackageCriteria, id int, table string) error {
+	if c.child == nil {
+		return _PackageCriteriaWhereClause(comp, c, id, table)
+	}
+	node := c
+	sibling := false
+	for {
+		if !sibling {
+			if node.child != nil {
+				if node.parent != nil {
+					comp.WriteString("(")
+				}
+				node = node.child
+				continue
+			} else {
+				comp.Dirty = false
+				comp.WriteString("(")
+				if err := _PackageCriteriaWhereClause(comp, node, id, table); err != nil {
+					return err
+				}
+				comp.WriteString(")")
+			}
+		}
+		if node.sibling != nil {
+			sibling = false
+			comp.WriteString(" ")
+			comp.WriteString(node.parent.operator)
+			comp.WriteString(" ")
+			node = node.sibling
+			continue
+		}
+		if node.parent != nil {
+			sibling = true
+			if node.parent.parent != nil {
+				comp.WriteString(")")
+			}
+			node = node.parent
+			continue
+		}
+
+		break
+	}
+	return nil
+}
+
+func _PackageCriteriaWhereClause(comp *Composer, c *PackageCriteria, id int, table string) error {
+	if c.Break.Valid {
+		if comp.Dirty {
+			comp.WriteString(" AND ")
+		}
+		if err := comp.WriteAlias(id); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(TablePackageColumnBreak); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString("="); err != nil {
+			return err
+		}
+		if err := comp.WritePlaceholder(); err != nil {
+			return err
+		}
+		comp.Add(c.Break)
+		comp.Dirty = true
+	}
+	if c.CategoryID.Valid {
+		if comp.Dirty {
+			comp.WriteString(" AND ")
+		}
+		if err := comp.WriteAlias(id); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(TablePackageColumnCategoryID); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString("="); err != nil {
+			return err
+		}
+		if err := comp.WritePlaceholder(); err != nil {
+			return err
+		}
+		comp.Add(c.CategoryID)
+		comp.Dirty = true
+	}
+	if c.CreatedAt.Valid {
+		if comp.Dirty {
+			comp.WriteString(" AND ")
+		}
+		if err := comp.WriteAlias(id); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(TablePackageColumnCreatedAt); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString("="); err != nil {
+			return err
+		}
+		if err := comp.WritePlaceholder(); err != nil {
+			return err
+		}
+		comp.Add(c.CreatedAt)
+		comp.Dirty = true
+	}
+	// id is an empty struct, ignore
+
+	if c.UpdatedAt.Valid {
+		if comp.Dirty {
+			comp.WriteString(" AND ")
+		}
+		if err := comp.WriteAlias(id); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(TablePackageColumnUpdatedAt); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString("="); err != nil {
+			return err
+		}
+		if err := comp.WritePlaceholder(); err != nil {
+			return err
+		}
+		comp.Add(c.UpdatedAt)
+		comp.Dirty = true
+	}
+	return nil
+}
+
+type PackageFindExpr struct {
+	Where         *PackageCriteria
+	Offset, Limit int64
+	Columns       []PackageColumn
+	OrderBy       []RowOrder
+	Lock          RowLock
+	JoinCategory  *CategoryJoin
+}
+
+// PackageColumn is a column of the package table, one of TablePackageColumns, that find expression selects.
+type PackageColumn string
+
+// pkgColumnCount selects number of rows instead of columns, it is used by count queries.
+const pkgColumnCount PackageColumn = "COUNT(*)"
+
+// selectExpr returns select list expression of the column, or an error if the table has no such column.
+func (c PackageColumn) selectExpr() (string, error) {
+	switch c {
+	case pkgColumnCount:
+		return string(c), nil
+	case TablePackageColumnBreak:
+		return "t0.break", nil
+	case TablePackageColumnCategoryID:
+		return "t0.category_id", nil
+	case TablePackageColumnCreatedAt:
+		return "t0.created_at", nil
+	case TablePackageColumnID:
+		return "t0.id", nil
+	case TablePackageColumnUpdatedAt:
+		return "t0.updated_at", nil
+	}
+	return "", fmt.Errorf("unexpected column provided: %q", string(c))
+}
+
+func pkgColumnNames(cs []PackageColumn) []string {
+	if len(cs) == 0 {
+		return nil
+	}
+	names := make([]string, 0, len(cs))
+	for _, c := range cs {
+		names = append(names, string(c))
+	}
+	return names
+}
+
+type PackageJoin struct {
+	On, Where    *PackageCriteria
+	Fetch        bool
+	Kind         JoinType
+	JoinCategory *CategoryJoin
+}
+
+type PackageCountExpr struct {
+	Where        *PackageCriteria
+	JoinCategory *CategoryJoin
+}
+
+type PackagePatch struct {
+	Break      sql.NullString
+	CategoryID sql.NullInt64
+	CreatedAt  pq.NullTime
+	UpdatedAt  pq.NullTime
+}
+
+// Validate checks if values set by the patch meet length, precision, enum, array size and simple check constraints of the table.
+// It returns ValidationError that lists all violations, if any.
+func (p *PackagePatch) Validate() error {
+	return nil
+}
+
+type PackageRepositoryBase struct {
+	Table   string
+	Columns []string
+	DB      *sql.DB
+	Log     LogFunc
+	// Validate, if true, makes insert, update and upsert methods validate entities and patches before querying the database.
+	Validate bool
+	// StmtCache, if set, is used to prepare queries of fixed shape (insert, delete and find by key) once and reuse them.
+	StmtCache *StmtCache
+}
+
+func (r *PackageRepositoryBase) Tx(tx *sql.Tx) (*PackageRepositoryBaseTx, error) {
+	return &PackageRepositoryBaseTx{
+		base: r,
+		tx:   tx,
+	}, nil
+}
+
+func (r *PackageRepositoryBase) BeginTx(ctx context.Context, opts ...TxOption) (*PackageRepositoryBaseTx, error) {
+	tx, err := r.DB.BeginTx(ctx, newTxOptions(opts).sql())
+	if err != nil {
+		return nil, err
+	}
+	return r.Tx(tx)
+}
+
+func (r PackageRepositoryBase) RunInTransaction(ctx context.Context, fn func(rtx *PackageRepositoryBaseTx) error, attempts int, opts ...TxOption) (err error) {
+	return RunInTransaction(r.DB, ctx, func(tx *sql.Tx) error {
+		rtx, err := r.Tx(tx)
+		if err != nil {
+			return err
+		}
+		return fn(rtx)
+	}, attempts, opts...)
+}
+
+var (
+	// ErrPackageConstraintPrimaryKey is returned if primary key constraint example.package_id_pkey is violated.
+	ErrPackageConstraintPrimaryKey = &Constraint{
+		Name:    "example.package_id_pkey",
+		Type:    "primary key",
+		Table:   "example.package",
+		Columns: []string{"id"},
+	}
+	// ErrPackageConstraintCategoryIDForeignKey is returned if foreign key constraint example.package_category_id_fkey is violated.
+	ErrPackageConstraintCategoryIDForeignKey = &Constraint{
+		Name:    "example.package_category_id_fkey",
+		Type:    "foreign key",
+		Table:   "example.package",
+		Columns: []string{"category_id"},
+	}
+)
+
+func (r *PackageRepositoryBase) InsertQuery(e *PackageEntity, read bool) (string, []interface{}, error) {
+	if r.Validate {
+		if err := e.Validate(); err != nil {
+			return "", nil, err
+		}
+	}
+	insert := NewComposer(5)
+	columns := bytes.NewBuffer(nil)
+	buf := bytes.NewBufferString("INSERT INTO ")
+	buf.WriteString(r.Table)
+
+	if e.Break.Valid {
+		if columns.Len() > 0 {
+			if _, err := columns.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := columns.WriteString(TablePackageColumnBreak); err != nil {
+			return "", nil, err
+		}
+		if insert.Dirty {
+			if _, err := insert.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if err := insert.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		insert.Add(e.Break)
+		insert.Dirty = true
+	}
+
+	if e.CategoryID.Valid {
+		if columns.Len() > 0 {
+			if _, err := columns.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := columns.WriteString(TablePackageColumnCategoryID); err != nil {
+			return "", nil, err
+		}
+		if insert.Dirty {
+			if _, err := insert.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if err := insert.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		insert.Add(e.CategoryID)
+		insert.Dirty = true
+	}
+
+	if !e.CreatedAt.IsZero() {
+		if columns.Len() > 0 {
+			if _, err := columns.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := columns.WriteString(TablePackageColumnCreatedAt); err != nil {
+			return "", nil, err
+		}
+		if insert.Dirty {
+			if _, err := insert.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if err := insert.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		insert.Add(e.CreatedAt)
+		insert.Dirty = true
+	}
+
+	if e.UpdatedAt.Valid {
+		if columns.Len() > 0 {
+			if _, err := columns.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := columns.WriteString(TablePackageColumnUpdatedAt); err != nil {
+			return "", nil, err
+		}
+		if insert.Dirty {
+			if _, err := insert.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if err := insert.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		insert.Add(e.UpdatedAt)
+		insert.Dirty = true
+	}
+
+	if columns.Len() > 0 {
+		buf.WriteString(" (")
+		buf.ReadFrom(columns)
+		buf.WriteString(") VALUES (")
+		buf.ReadFrom(insert)
+		buf.WriteString(") ")
+		if read {
+			buf.WriteString("RETURNING ")
+			if len(r.Columns) > 0 {
+				buf.WriteString(strings.Join(r.Columns, ", "))
+			} else {
+				buf.WriteString("break, category_id, created_at, id, updated_at")
+			}
+		}
+	}
+	return buf.String(), insert.Args(), nil
+}
+
+func (r *PackageRepositoryBase) insert(ctx context.Context, tx *sql.Tx, e *PackageEntity) (*PackageEntity, error) {
+	query, args, err := r.InsertQuery(e, true)
+	if err != nil {
+		return nil, err
+	}
+
+	err = queryRow(ctx, r.DB, tx, r.StmtCache, query, args,
+		&e.Break,
+		&e.CategoryID,
+		&e.CreatedAt,
+		&e.ID,
+		&e.UpdatedAt,
+	)
+	if r.Log != nil {
+		if tx == nil {
+			r.Log(err, TablePackage, "insert", query, args...)
+		} else {
+			r.Log(err, TablePackage, "insert tx", query, args...)
+		}
+	}
+	if err != nil {
+		return nil, wrapError(err)
+	}
+	return e, nil
+}
+
+func (r *PackageRepositoryBase) Insert(ctx context.Context, e *PackageEntity) (*PackageEntity, error) {
+	return r.insert(ctx, nil, e)
+}
+
+func (r *PackageRepositoryBase) FindQuery(fe *PackageFindExpr) (string, []interface{}, error) {
+	comp := NewComposer(5)
+	buf := bytes.NewBufferString("SELECT ")
+	if len(fe.Columns) == 0 {
+		buf.WriteString("t0.break, t0.category_id, t0.created_at, t0.id, t0.updated_at")
+	} else {
+		for i, c := range fe.Columns {
+			if i > 0 {
+				buf.WriteString(", ")
+			}
+			expr, err := c.selectExpr()
+			if err != nil {
+				return "", nil, err
+			}
+			buf.WriteString(expr)
+		}
+	}
+	if fe.JoinCategory != nil && fe.JoinCategory.Kind.Actionable() && fe.JoinCategory.Fetch {
+		buf.WriteString(", t1.content, t1.created_at, t1.id, t1.name, t1.parent_id, t1.updated_at")
+	}
+	buf.WriteString(" FROM ")
+	buf.WriteString(r.Table)
+	buf.WriteString(" AS t0")
+	if fe.JoinCategory != nil && fe.JoinCategory.Kind.Actionable() {
+		joinClause(comp, fe.JoinCategory.Kind, inSchemaOf(TableCategory, r.Table)+" AS t1 ON t0.category_id=t1.id")
+		if fe.JoinCategory.On != nil {
+			comp.Dirty = true
+			if err := CategoryCriteriaWhereClause(comp, fe.JoinCategory.On, 1, inSchemaOf(TableCategory, r.Table)); err != nil {
+				return "", nil, err
+			}
+		}
+	}
+	if comp.Dirty {
+		buf.ReadFrom(comp)
+		comp.Dirty = false
+	}
+	if fe.Where != nil {
+		if err := PackageCriteriaWhereClause(comp, fe.Where, 0, r.Table); err != nil {
+			return "", nil, err
+		}
+	}
+	if fe.JoinCategory != nil && fe.JoinCategory.Kind.Actionable() && fe.JoinCategory.Where != nil {
+		if err := CategoryCriteriaWhereClause(comp, fe.JoinCategory.Where, 1, inSchemaOf(TableCategory, r.Table)); err != nil {
+			return "", nil, err
+		}
+	}
+	if comp.Dirty {
+		if _, err := buf.WriteString(" WHERE "); err != nil {
+			return "", nil, err
+		}
+		buf.ReadFrom(comp)
+	}
+
+	if len(fe.OrderBy) > 0 {
+		i := 0
+		for _, order := range fe.OrderBy {
+			for _, columnName := range TablePackageColumns {
+				if order.Name == columnName {
+					if i == 0 {
+						comp.WriteString(" ORDER BY ")
+					}
+					if i > 0 {
+						if _, err := comp.WriteString(", "); err != nil {
+							return "", nil, err
+						}
+					}
+					if _, err := comp.WriteString(order.Name); err != nil {
+						return "", nil, err
+					}
+					if order.Descending {
+						if _, err := comp.WriteString(" DESC"); err != nil {
+							return "", nil, err
+						}
+					}
+					i++
+					break
+				}
+			}
+		}
+	}
+	if fe.Offset > 0 {
+		if _, err := comp.WriteString(" OFFSET "); err != nil {
+			return "", nil, err
+		}
+		if err := comp.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		if _, err := comp.WriteString(" "); err != nil {
+			return "", nil, err
+		}
+		comp.Add(fe.Offset)
+	}
+	if fe.Limit > 0 {
+		if _, err := comp.WriteString(" LIMIT "); err != nil {
+			return "", nil, err
+		}
+		if err := comp.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		if _, err := comp.WriteString(" "); err != nil {
+			return "", nil, err
+		}
+		comp.Add(fe.Limit)
+	}
+	if fe.Lock.Actionable() {
+		var of string
+		if fe.JoinCategory != nil && fe.JoinCategory.Kind.Actionable() {
+			of = "t0"
+		}
+		if err := lockClause(comp, fe.Lock, of); err != nil {
+			return "", nil, err
+		}
+	}
+
+	buf.ReadFrom(comp)
+
+	return buf.String(), comp.Args(), nil
+}
+
+func (r *PackageRepositoryBase) find(ctx context.Context, tx *sql.Tx, fe *PackageFindExpr) ([]*PackageEntity, error) {
+	if tx == nil && fe.Lock.Actionable() {
+		return nil, ErrRowLockOutsideTransaction
+	}
+	query, args, err := r.FindQuery(fe)
+	if err != nil {
+		return nil, err
+	}
+	var rows *sql.Rows
+	if tx == nil {
+		rows, err = r.DB.QueryContext(ctx, query, args...)
+	} else {
+		rows, err = tx.QueryContext(ctx, query, args...)
+	}
+	if r.Log != nil {
+		if tx == nil {
+			r.Log(err, TablePackage, "find", query, args...)
+		} else {
+			r.Log(err, TablePackage, "find tx", query, args...)
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var (
+		entities []*PackageEntity
+		props    []interface{}
+	)
+	cols := pkgColumnNames(fe.Columns)
+	for rows.Next() {
+		var ent PackageEntity
+		if props, err = ent.Props(cols...); err != nil {
+			return nil, err
+		}
+		var prop []interface{}
+		if fe.JoinCategory != nil && fe.JoinCategory.Kind.Actionable() && fe.JoinCategory.Fetch {
+			ent.Category = &CategoryEntity{}
+			if prop, err = ent.Category.Props(); err != nil {
+				return nil, err
+			}
+			props = append(props, prop...)
+		}
+		err = rows.Scan(props...)
+		if err != nil {
+			return nil, err
+		}
+
+		entities = append(entities, &ent)
+	}
+	err = rows.Err()
+	if r.Log != nil {
+		r.Log(err, TablePackage, "find", query, args...)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return entities, nil
+}
+
+func (r *PackageRepositoryBase) Find(ctx context.Context, fe *PackageFindExpr) ([]*PackageEntity, error) {
+	return r.find(ctx, nil, fe)
+}
+
+func (r *PackageRepositoryBase) findIter(ctx context.Context, tx *sql.Tx, fe *PackageFindExpr) (*PackageIterator, error) {
+	if tx == nil && fe.Lock.Actionable() {
+		return nil, ErrRowLockOutsideTransaction
+	}
+	query, args, err := r.FindQuery(fe)
+	if err != nil {
+		return nil, err
+	}
+	var rows *sql.Rows
+	if tx == nil {
+		rows, err = r.DB.QueryContext(ctx, query, args...)
+	} else {
+		rows, err = tx.QueryContext(ctx, query, args...)
+	}
+	if r.Log != nil {
+		if tx == nil {
+			r.Log(err, TablePackage, "find iter", query, args...)
+		} else {
+			r.Log(err, TablePackage, "find iter tx", query, args...)
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &PackageIterator{
+		rows: rows,
+		expr: fe,
+		cols: pkgColumnNames(fe.Columns),
+	}, nil
+}
+
+func (r *PackageRepositoryBase) FindIter(ctx context.Context, fe *PackageFindExpr) (*PackageIterator, error) {
+	return r.findIter(ctx, nil, fe)
+}
+
+func (r *PackageRepositoryBase) findProjection(ctx context.Context, tx *sql.Tx, fe *PackageFindExpr, next func() Projection) error {
+	if tx == nil && fe.Lock.Actionable() {
+		return ErrRowLockOutsideTransaction
+	}
+	query, args, err := r.FindQuery(fe)
+	if err != nil {
+		return err
+	}
+	var rows *sql.Rows
+	if tx == nil {
+		rows, err = r.DB.QueryContext(ctx, query, args...)
+	} else {
+		rows, err = tx.QueryContext(ctx, query, args...)
+	}
+	if r.Log != nil {
+		if tx == nil {
+			r.Log(err, TablePackage, "find projection", query, args...)
+		} else {
+			r.Log(err, TablePackage, "find projection tx", query, args...)
+		}
+	}
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	cols, err := rows.Columns()
+	if err != nil {
+		return err
+	}
+	for rows.Next() {
+		props, err := next().Props(cols...)
+		if err != nil {
+			return err
+		}
+		if err := rows.Scan(props...); err != nil {
+			return err
+		}
+	}
+	return rows.Err()
+}
+
+// FindProjection works like Find, but scans each row into the projection returned by next.
+// Selected columns, see PackageFindExpr.Columns, are passed to its Props method.
+func (r *PackageRepositoryBase) FindProjection(ctx context.Context, fe *PackageFindExpr, next func() Projection) error {
+	return r.findProjection(ctx, nil, fe, next)
+}
+
+func (r *PackageRepositoryBase) findOneByID(ctx context.Context, tx *sql.Tx, pk int64, lock RowLock) (*PackageEntity, error) {
+	find := NewComposer(5)
+	find.WriteString("SELECT ")
+	if len(r.Columns) == 0 {
+		find.WriteString("break, category_id, created_at, id, updated_at")
+	} else {
+		find.WriteString(strings.Join(r.Columns, ", "))
+	}
+	find.WriteString(" FROM ")
+	find.WriteString(r.Table)
+	find.WriteString(" WHERE ")
+	find.WriteString(TablePackageColumnID)
+	find.WriteString("=")
+	find.WritePlaceholder()
+	find.Add(pk)
+	if lock.Actionable() {
+		if tx == nil {
+			return nil, ErrRowLockOutsideTransaction
+		}
+		if err := lockClause(find, lock, ""); err != nil {
+			return nil, err
+		}
+	}
+	var (
+		ent PackageEntity
+	)
+	props, err := ent.Props(r.Columns...)
+	if err != nil {
+		return nil, err
+	}
+	err = queryRow(ctx, r.DB, tx, r.StmtCache, find.String(), find.Args(), props...)
+	if r.Log != nil {
+		if tx == nil {
+			r.Log(err, TablePackage, "find by primary key", find.String(), find.Args()...)
+		} else {
+			r.Log(err, TablePackage, "find by primary key tx", find.String(), find.Args()...)
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &ent, nil
+}
+
+func (r *PackageRepositoryBase) FindOneByID(ctx context.Context, pk int64) (*PackageEntity, error) {
+	return r.findOneByID(ctx, nil, pk, RowLock{})
+}
+
+func (r *PackageRepositoryBase) UpdateOneByIDQuery(pk int64, p *PackagePatch) (string, []interface{}, error) {
+	if r.Validate {
+		if err := p.Validate(); err != nil {
+			return "", nil, err
+		}
+	}
+	buf := bytes.NewBufferString("UPDATE ")
+	buf.WriteString(r.Table)
+	update := NewComposer(5)
+	if p.Break.Valid {
+		if update.Dirty {
+			if _, err := update.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := update.WriteString(TablePackageColumnBreak); err != nil {
+			return "", nil, err
+		}
+		if _, err := update.WriteString("="); err != nil {
+			return "", nil, err
+		}
+		if err := update.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		update.Add(p.Break)
+		update.Dirty = true
+
+	}
+	if p.CategoryID.Valid {
+		if update.Dirty {
+			if _, err := update.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := update.WriteString(TablePackageColumnCategoryID); err != nil {
+			return "", nil, err
+		}
+		if _, err := update.WriteString("="); err != nil {
+			return "", nil, err
+		}
+		if err := update.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		update.Add(p.CategoryID)
+		update.Dirty = true
+
+	}
+	if p.CreatedAt.Valid {
+		if update.Dirty {
+			if _, err := update.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := update.WriteString(TablePackageColumnCreatedAt); err != nil {
+			return "", nil, err
+		}
+		if _, err := update.WriteString("="); err != nil {
+			return "", nil, err
+		}
+		if err := update.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		update.Add(p.CreatedAt)
+		update.Dirty = true
+
+	}
+	if p.UpdatedAt.Valid {
+		if update.Dirty {
+			if _, err := update.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := update.WriteString(TablePackageColumnUpdatedAt); err != nil {
+			return "", nil, err
+		}
+		if _, err := update.WriteString("="); err != nil {
+			return "", nil, err
+		}
+		if err := update.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		update.Add(p.UpdatedAt)
+		update.Dirty = true
+
+	} else {
+		if update.Dirty {
+			if _, err := update.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := update.WriteString(TablePackageColumnUpdatedAt); err != nil {
+			return "", nil, err
+		}
+		if _, err := update.WriteString("=NOW()"); err != nil {
+			return "", nil, err
+		}
+		update.Dirty = true
+	}
+	if !update.Dirty {
+		return "", nil, errors.New("Package update failure, nothing to update")
+	}
+	buf.WriteString(" SET ")
+	buf.ReadFrom(update)
+	buf.WriteString(" WHERE ")
+
+	update.WriteString(TablePackageColumnID)
+	update.WriteString("=")
+	update.WritePlaceholder()
+	update.Add(pk)
+
+	buf.ReadFrom(update)
+	buf.WriteString(" RETURNING ")
+	if len(r.Columns) > 0 {
+		buf.WriteString(strings.Join(r.Columns, ", "))
+	} else {
+		buf.WriteString("break, category_id, created_at, id, updated_at")
+	}
+	return buf.String(), update.Args(), nil
+}
+
+func (r *PackageRepositoryBase) updateOneByID(ctx context.Context, tx *sql.Tx, pk int64, p *PackagePatch) (*PackageEntity, error) {
+	query, args, err := r.UpdateOneByIDQuery(pk, p)
+	if err != nil {
+		return nil, err
+	}
+	var ent PackageEntity
+	props, err := ent.Props(r.Columns...)
+	if err != nil {
+		return nil, err
+	}
+	if tx == nil {
+		err = r.DB.QueryRowContext(ctx, query, args...).Scan(props...)
+	} else {
+		err = tx.QueryRowContext(ctx, query, args...).Scan(props...)
+	}
+	if r.Log != nil {
+		if tx == nil {
+			r.Log(err, TablePackage, "update by primary key", query, args...)
+		} else {
+			r.Log(err, TablePackage, "update by primary key tx", query, args...)
+		}
+	}
+	if err != nil {
+		return nil, wrapError(err)
+	}
+	return &ent, nil
+}
+
+func (r *PackageRepositoryBase) UpdateOneByID(ctx context.Context, pk int64, p *PackagePatch) (*PackageEntity, error) {
+	return r.updateOneByID(ctx, nil, pk, p)
+}
+
+func (r *PackageRepositoryBase) FindOneByIDAndUpdate(ctx context.Context, pk int64, p *PackagePatch) (before, after *PackageEntity, err error) {
+	find := NewComposer(5)
+	find.WriteString("SELECT ")
+	if len(r.Columns) == 0 {
+		find.WriteString("break, category_id, created_at, id, updated_at")
+	} else {
+		find.WriteString(strings.Join(r.Columns, ", "))
+	}
+	find.WriteString(" FROM ")
+	find.WriteString(r.Table)
+	find.WriteString(" WHERE ")
+	find.WriteString(TablePackageColumnID)
+	find.WriteString("=")
+	find.WritePlaceholder()
+	find.Add(pk)
+	find.WriteString(" FOR UPDATE")
+	query, args, err := r.UpdateOneByIDQuery(pk, p)
+	if err != nil {
+		return
+	}
+	var (
+		oldEnt, newEnt PackageEntity
+	)
+	oldProps, err := oldEnt.Props(r.Columns...)
+	if err != nil {
+		return
+	}
+	newProps, err := newEnt.Props(r.Columns...)
+	if err != nil {
+		return
+	}
+	tx, err := r.DB.Begin()
+	if err != nil {
+		return
+	}
+	err = tx.QueryRowContext(ctx, find.String(), find.Args()...).Scan(oldProps...)
+	if r.Log != nil {
+		r.Log(err, TablePackage, "find by primary key", find.String(), find.Args()...)
+	}
+	if err != nil {
+		tx.Rollback()
+		return
+	}
+	err = tx.QueryRowContext(ctx, query, args...).Scan(newProps...)
+	if r.Log != nil {
+		r.Log(err, TablePackage, "update by primary key", query, args...)
+	}
+	if err != nil {
+		tx.Rollback()
+		err = wrapError(err)
+		return
+	}
+	err = tx.Commit()
+	if err != nil {
+		return
+	}
+	return &oldEnt, &newEnt, nil
+}
+
+func (r *PackageRepositoryBase) UpsertQuery(e *PackageEntity, p *PackagePatch, inf ...string) (string, []interface{}, error) {
+	if r.Validate {
+		if err := e.Validate(); err != nil {
+			return "", nil, err
+		}
+		if p != nil {
+			if err := p.Validate(); err != nil {
+				return "", nil, err
+			}
+		}
+	}
+	upsert := NewComposer(10)
+	columns := bytes.NewBuffer(nil)
+	buf := bytes.NewBufferString("INSERT INTO ")
+	buf.WriteString(r.Table)
+
+	if e.Break.Valid {
+		if columns.Len() > 0 {
+			if _, err := columns.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := columns.WriteString(TablePackageColumnBreak); err != nil {
+			return "", nil, err
+		}
+		if upsert.Dirty {
+			if _, err := upsert.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if err := upsert.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		upsert.Add(e.Break)
+		upsert.Dirty = true
+	}
+
+	if e.CategoryID.Valid {
+		if columns.Len() > 0 {
+			if _, err := columns.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := columns.WriteString(TablePackageColumnCategoryID); err != nil {
+			return "", nil, err
+		}
+		if upsert.Dirty {
+			if _, err := upsert.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if err := upsert.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		upsert.Add(e.CategoryID)
+		upsert.Dirty = true
+	}
+
+	if !e.CreatedAt.IsZero() {
+		if columns.Len() > 0 {
+			if _, err := columns.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := columns.WriteString(TablePackageColumnCreatedAt); err != nil {
+			return "", nil, err
+		}
+		if upsert.Dirty {
+			if _, err := upsert.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if err := upsert.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		upsert.Add(e.CreatedAt)
+		upsert.Dirty = true
+	}
+
+	if e.UpdatedAt.Valid {
+		if columns.Len() > 0 {
+			if _, err := columns.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := columns.WriteString(TablePackageColumnUpdatedAt); err != nil {
+			return "", nil, err
+		}
+		if upsert.Dirty {
+			if _, err := upsert.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if err := upsert.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		upsert.Add(e.UpdatedAt)
+		upsert.Dirty = true
+	}
+
+	if upsert.Dirty {
+		buf.WriteString(" (")
+		buf.ReadFrom(columns)
+		buf.WriteString(") VALUES (")
+		buf.ReadFrom(upsert)
+		buf.WriteString(")")
+	}
+	buf.WriteString(" ON CONFLICT ")
+	if len(inf) > 0 {
+		upsert.Dirty = false
+		if p.Break.Valid {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TablePackageColumnBreak); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("="); err != nil {
+				return "", nil, err
+			}
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(p.Break)
+			upsert.Dirty = true
+
+		}
+		if p.CategoryID.Valid {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TablePackageColumnCategoryID); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("="); err != nil {
+				return "", nil, err
+			}
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(p.CategoryID)
+			upsert.Dirty = true
+
+		}
+		if p.CreatedAt.Valid {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TablePackageColumnCreatedAt); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("="); err != nil {
+				return "", nil, err
+			}
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(p.CreatedAt)
+			upsert.Dirty = true
+
+		}
+		if p.UpdatedAt.Valid {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TablePackageColumnUpdatedAt); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("="); err != nil {
+				return "", nil, err
+			}
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(p.UpdatedAt)
+			upsert.Dirty = true
+
+		} else {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TablePackageColumnUpdatedAt); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("=NOW()"); err != nil {
+				return "", nil, err
+			}
+			upsert.Dirty = true
+		}
+	}
+	if len(inf) > 0 && upsert.Dirty {
+		buf.WriteString("(")
+		for j, i := range inf {
+			if j != 0 {
+				buf.WriteString(", ")
+			}
+			buf.WriteString(i)
+		}
+		buf.WriteString(")")
+		buf.WriteString(" DO UPDATE SET ")
+		buf.ReadFrom(upsert)
+	} else {
+		buf.WriteString(" DO NOTHING ")
+	}
+	if upsert.Dirty {
+		buf.WriteString(" RETURNING ")
+		if len(r.Columns) > 0 {
+			buf.WriteString(strings.Join(r.Columns, ", "))
+		} else {
+			buf.WriteString("break, category_id, created_at, id, updated_at")
+		}
+	}
+	return buf.String(), upsert.Args(), nil
+}
+
+func (r *PackageRepositoryBase) upsert(ctx context.Context, tx *sql.Tx, e *PackageEntity, p *PackagePatch, inf ...string) (*PackageEntity, error) {
+	query, args, err := r.UpsertQuery(e, p, inf...)
+	if err != nil {
+		return nil, err
+	}
+
+	var row *sql.Row
+	if tx == nil {
+		row = r.DB.QueryRowContext(ctx, query, args...)
+	} else {
+		row = tx.QueryRowContext(ctx, query, args...)
+	}
+	err = row.Scan(
+		&e.Break,
+		&e.CategoryID,
+		&e.CreatedAt,
+		&e.ID,
+		&e.UpdatedAt,
+	)
+	if r.Log != nil {
+		if tx == nil {
+			r.Log(err, TablePackage, "upsert", query, args...)
+		} else {
+			r.Log(err, TablePackage, "upsert tx", query, args...)
+		}
+	}
+	if err != nil {
+		return nil, wrapError(err)
+	}
+	return e, nil
+}
+
+func (r *PackageRepositoryBase) Upsert(ctx context.Context, e *PackageEntity, p *PackagePatch, inf ...string) (*PackageEntity, error) {
+	return r.upsert(ctx, nil, e, p, inf...)
+}
+
+// PackageConflictTarget is a conflict target of package upsert, only PackageConflict* variables can be used.
+type PackageConflictTarget struct {
+	constraint string
+	columns    []string
+	where      string
+}
+
+func (ct PackageConflictTarget) write(buf *bytes.Buffer) {
+	switch {
+	case ct.constraint != "":
+		buf.WriteString(" ON CONSTRAINT \"")
+		buf.WriteString(ct.constraint)
+		buf.WriteString("\"")
+	case len(ct.columns) > 0:
+		buf.WriteString(" (")
+		buf.WriteString(strings.Join(ct.columns, ", "))
+		buf.WriteString(")")
+		if ct.where != "" {
+			buf.WriteString(" WHERE ")
+			buf.WriteString(ct.where)
+		}
+	}
+}
+
+var (
+	// PackageConflictPrimaryKey targets primary key constraint.
+	PackageConflictPrimaryKey = PackageConflictTarget{constraint: TablePackageConstraintPrimaryKey}
+)
+
+// PackageUpsertExpr describes how conflicts are resolved by UpsertOne and UpsertMany.
+type PackageUpsertExpr struct {
+	// Target is required if Patch is set, DO NOTHING can be used without it.
+	Target PackageConflictTarget
+	// Patch is applied to the conflicting row (DO UPDATE), if nil or empty the row is left untouched (DO NOTHING).
+	Patch *PackagePatch
+	// Where limits DO UPDATE to the conflicting rows that match the criteria, other rows are left untouched.
+	Where *PackageCriteria
+}
+
+func (r *PackageRepositoryBase) UpsertManyQuery(ue *PackageUpsertExpr, es ...*PackageEntity) (string, []interface{}, error) {
+	if len(es) == 0 {
+		return "", nil, errors.New("upsert requires at least one entity")
+	}
+	if ue == nil {
+		ue = &PackageUpsertExpr{}
+	}
+	if r.Validate {
+		for _, e := range es {
+			if err := e.Validate(); err != nil {
+				return "", nil, err
+			}
+		}
+		if ue.Patch != nil {
+			if err := ue.Patch.Validate(); err != nil {
+				return "", nil, err
+			}
+		}
+	}
+	upsert := NewComposer(4)
+	buf := bytes.NewBufferString("INSERT INTO ")
+	buf.WriteString(r.Table)
+	buf.WriteString(" AS t0 (")
+	buf.WriteString(TablePackageColumnBreak + ", " + TablePackageColumnCategoryID + ", " + TablePackageColumnCreatedAt + ", " + TablePackageColumnUpdatedAt)
+	buf.WriteString(") VALUES ")
+	for i, e := range es {
+		if i != 0 {
+			if _, err := upsert.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := upsert.WriteString("("); err != nil {
+			return "", nil, err
+		}
+		if e.Break.Valid {
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(e.Break)
+		} else if _, err := upsert.WriteString("DEFAULT"); err != nil {
+			return "", nil, err
+		}
+		if _, err := upsert.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+		if e.CategoryID.Valid {
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(e.CategoryID)
+		} else if _, err := upsert.WriteString("DEFAULT"); err != nil {
+			return "", nil, err
+		}
+		if _, err := upsert.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+		if !e.CreatedAt.IsZero() {
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(e.CreatedAt)
+		} else if _, err := upsert.WriteString("DEFAULT"); err != nil {
+			return "", nil, err
+		}
+		if _, err := upsert.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+		if e.UpdatedAt.Valid {
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(e.UpdatedAt)
+		} else if _, err := upsert.WriteString("DEFAULT"); err != nil {
+			return "", nil, err
+		}
+		if _, err := upsert.WriteString(")"); err != nil {
+			return "", nil, err
+		}
+	}
+	buf.ReadFrom(upsert)
+	buf.WriteString(" ON CONFLICT")
+	ue.Target.write(buf)
+
+	upsert.Dirty = false
+	if p := ue.Patch; p != nil {
+		if p.Break.Valid {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TablePackageColumnBreak); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("="); err != nil {
+				return "", nil, err
+			}
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(p.Break)
+			upsert.Dirty = true
+
+		}
+		if p.CategoryID.Valid {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TablePackageColumnCategoryID); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("="); err != nil {
+				return "", nil, err
+			}
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(p.CategoryID)
+			upsert.Dirty = true
+
+		}
+		if p.CreatedAt.Valid {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TablePackageColumnCreatedAt); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("="); err != nil {
+				return "", nil, err
+			}
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(p.CreatedAt)
+			upsert.Dirty = true
+
+		}
+		if p.UpdatedAt.Valid {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TablePackageColumnUpdatedAt); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("="); err != nil {
+				return "", nil, err
+			}
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(p.UpdatedAt)
+			upsert.Dirty = true
+
+		} else {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TablePackageColumnUpdatedAt); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("=NOW()"); err != nil {
+				return "", nil, err
+			}
+			upsert.Dirty = true
+		}
+	}
+	if !upsert.Dirty {
+		buf.WriteString(" DO NOTHING")
+	} else {
+		if ue.Target.constraint == "" && len(ue.Target.columns) == 0 {
+			return "", nil, errors.New("upsert with patch requires conflict target")
+		}
+		buf.WriteString(" DO UPDATE SET ")
+		buf.ReadFrom(upsert)
+		if ue.Where != nil {
+			upsert.Dirty = false
+			if err := PackageCriteriaWhereClause(upsert, ue.Where, 0, r.Table); err != nil {
+				return "", nil, err
+			}
+			if upsert.Dirty {
+				buf.WriteString(" WHERE ")
+				buf.ReadFrom(upsert)
+			}
+		}
+	}
+	buf.WriteString(" RETURNING ")
+	if len(r.Columns) > 0 {
+		buf.WriteString(strings.Join(r.Columns, ", "))
+	} else {
+		buf.WriteString("break, category_id, created_at, id, updated_at")
+	}
+	return buf.String(), upsert.Args(), nil
+}
+
+func (r *PackageRepositoryBase) upsertMany(ctx context.Context, tx *sql.Tx, ue *PackageUpsertExpr, es ...*PackageEntity) ([]*PackageEntity, error) {
+	query, args, err := r.UpsertManyQuery(ue, es...)
+	if err != nil {
+		return nil, err
+	}
+	var rows *sql.Rows
+	if tx == nil {
+		rows, err = r.DB.QueryContext(ctx, query, args...)
+	} else {
+		rows, err = tx.QueryContext(ctx, query, args...)
+	}
+	if r.Log != nil {
+		if tx == nil {
+			r.Log(err, TablePackage, "upsert many", query, args...)
+		} else {
+			r.Log(err, TablePackage, "upsert many tx", query, args...)
+		}
+	}
+	if err != nil {
+		return nil, wrapError(err)
+	}
+	defer rows.Close()
+
+	var entities []*PackageEntity
+	for rows.Next() {
+		var ent PackageEntity
+		props, err := ent.Props(r.Columns...)
+		if err != nil {
+			return nil, err
+		}
+		if err = rows.Scan(props...); err != nil {
+			return nil, err
+		}
+		entities = append(entities, &ent)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, wrapError(err)
+	}
+	return entities, nil
+}
+
+// UpsertMany inserts entities in a single statement, conflicts are resolved as described by the expression.
+// Returned entities are those inserted or updated, rows left untouched by DO NOTHING or Where are omitted.
+func (r *PackageRepositoryBase) UpsertMany(ctx context.Context, ue *PackageUpsertExpr, es ...*PackageEntity) ([]*PackageEntity, error) {
+	return r.upsertMany(ctx, nil, ue, es...)
+}
+
+// UpsertOne inserts the entity, conflict is resolved as described by the expression.
+// Returned flag is false if the row was neither inserted nor updated.
+func (r *PackageRepositoryBase) UpsertOne(ctx context.Context, e *PackageEntity, ue *PackageUpsertExpr) (*PackageEntity, bool, error) {
+	return r.upsertOne(ctx, nil, e, ue)
+}
+
+func (r *PackageRepositoryBase) upsertOne(ctx context.Context, tx *sql.Tx, e *PackageEntity, ue *PackageUpsertExpr) (*PackageEntity, bool, error) {
+	entities, err := r.upsertMany(ctx, tx, ue, e)
+	if err != nil {
+		return nil, false, err
+	}
+	if len(entities) == 0 {
+		return e, false, nil
+	}
+	*e = *entities[0]
+	return e, true, nil
+}
+
+func (r *PackageRepositoryBase) count(ctx context.Context, tx *sql.Tx, exp *PackageCountExpr) (int64, error) {
+	query, args, err := r.FindQuery(&PackageFindExpr{
+		Where:   exp.Where,
+		Columns: []PackageColumn{pkgColumnCount},
+
+		JoinCategory: exp.JoinCategory,
+	})
+	if err != nil {
+		return 0, err
+	}
+	var count int64
+	if tx == nil {
+		err = r.DB.QueryRowContext(ctx, query, args...).Scan(&count)
+	} else {
+		err = tx.QueryRowContext(ctx, query, args...).Scan(&count)
+	}
+	if r.Log != nil {
+		if tx == nil {
+			r.Log(err, TablePackage, "count", query, args...)
+		} else {
+			r.Log(err, TablePackage, "count tx", query, args...)
+		}
+	}
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+func (r *PackageRepositoryBase) Count(ctx context.Context, exp *PackageCountExpr) (int64, error) {
+	return r.count(ctx, nil, exp)
+}
+
+func (r *PackageRepositoryBase) deleteOneByID(ctx context.Context, tx *sql.Tx, pk int64) (int64, error) {
+	find := NewComposer(5)
+	find.WriteString("DELETE FROM ")
+	find.WriteString(r.Table)
+	find.WriteString(" WHERE ")
+	find.WriteString(TablePackageColumnID)
+	find.WriteString("=")
+	find.WritePlaceholder()
+	find.Add(pk)
+	res, err := exec(ctx, r.DB, tx, r.StmtCache, find.String(), find.Args()...)
+	if err != nil {
+		return 0, wrapError(err)
+	}
+
+	return res.RowsAffected()
+}
+
+func (r *PackageRepositoryBase) DeleteOneByID(ctx context.Context, pk int64) (int64, error) {
+	return r.deleteOneByID(ctx, nil, pk)
+}
+
+type PackageRepositoryBaseTx struct {
+	base *PackageRepositoryBase
+	tx   *sql.Tx
+}
+
+func (r PackageRepositoryBaseTx) Commit() error {
+	return r.tx.Commit()
+}
+
+func (r PackageRepositoryBaseTx) Rollback() error {
+	return r.tx.Rollback()
+}
+
+// Savepoint establishes new savepoint within the transaction.
+func (r *PackageRepositoryBaseTx) Savepoint(ctx context.Context, name string) error {
+	return savepoint(ctx, r.tx, name)
+}
+
+// RollbackTo rolls back all changes made after the savepoint was established, the transaction can be continued.
+func (r *PackageRepositoryBaseTx) RollbackTo(ctx context.Context, name string) error {
+	return rollbackToSavepoint(ctx, r.tx, name)
+}
+
+// Release destroys the savepoint, changes made after it was established are kept.
+func (r *PackageRepositoryBaseTx) Release(ctx context.Context, name string) error {
+	return releaseSavepoint(ctx, r.tx, name)
+}
+
+// RunInTransaction runs given function within a savepoint of the transaction, see RunInSavepoint.
+func (r *PackageRepositoryBaseTx) RunInTransaction(ctx context.Context, fn func(rtx *PackageRepositoryBaseTx) error) error {
+	return RunInSavepoint(ctx, r.tx, func(*sql.Tx) error {
+		return fn(r)
+	})
+}
+
+func (r *PackageRepositoryBaseTx) Insert(ctx context.Context, e *PackageEntity) (*PackageEntity, error) {
+	return r.base.insert(ctx, r.tx, e)
+}
+
+func (r *PackageRepositoryBaseTx) Find(ctx context.Context, fe *PackageFindExpr) ([]*PackageEntity, error) {
+	return r.base.find(ctx, r.tx, fe)
+}
+
+func (r *PackageRepositoryBaseTx) FindIter(ctx context.Context, fe *PackageFindExpr) (*PackageIterator, error) {
+	return r.base.findIter(ctx, r.tx, fe)
+}
+
+func (r *PackageRepositoryBaseTx) FindProjection(ctx context.Context, fe *PackageFindExpr, next func() Projection) error {
+	return r.base.findProjection(ctx, r.tx, fe, next)
+}
+
+func (r *PackageRepositoryBaseTx) FindOneByID(ctx context.Context, pk int64, lock ...RowLock) (*PackageEntity, error) {
+	if len(lock) > 1 {
+		return nil, ErrMultipleRowLocks
+	}
+	var rl RowLock
+	if len(lock) > 0 {
+		rl = lock[0]
+	}
+	return r.base.findOneByID(ctx, r.tx, pk, rl)
+}
+
+func (r *PackageRepositoryBaseTx) UpdateOneByID(ctx context.Context, pk int64, p *PackagePatch) (*PackageEntity, error) {
+	return r.base.updateOneByID(ctx, r.tx, pk, p)
+}
+
+func (r *PackageRepositoryBaseTx) Upsert(ctx context.Context, e *PackageEntity, p *PackagePatch, inf ...string) (*PackageEntity, error) {
+	return r.base.upsert(ctx, r.tx, e, p, inf...)
+}
+
+func (r *PackageRepositoryBaseTx) UpsertMany(ctx context.Context, ue *PackageUpsertExpr, es ...*PackageEntity) ([]*PackageEntity, error) {
+	return r.base.upsertMany(ctx, r.tx, ue, es...)
+}
+
+func (r *PackageRepositoryBaseTx) UpsertOne(ctx context.Context, e *PackageEntity, ue *PackageUpsertExpr) (*PackageEntity, bool, error) {
+	return r.base.upsertOne(ctx, r.tx, e, ue)
+}
+
+func (r *PackageRepositoryBaseTx) Count(ctx context.Context, exp *PackageCountExpr) (int64, error) {
+	return r.base.count(ctx, r.tx, exp)
+}
+
+func (r *PackageRepositoryBaseTx) DeleteOneByID(ctx context.Context, pk int64) (int64, error) {
+	return r.base.deleteOneByID(ctx, r.tx, pk)
+}
+
+// NewPackageFixture returns PackageEntity which mandatory columns are filled with random values, overrides are applied afterwards.
+// If overrides leave a required foreign key unset, parent fixture is inserted and referenced.
+func (f *Fixtures) NewPackageFixture(ctx context.Context, overrides ...func(*PackageEntity)) (*PackageEntity, error) {
+	e := &PackageEntity{}
+	for _, o := range overrides {
+		o(e)
+	}
+	return e, nil
+}
+
+// InsertPackageFixture works like NewPackageFixture, but it inserts the fixture as well.
+func (f *Fixtures) InsertPackageFixture(ctx context.Context, overrides ...func(*PackageEntity)) (*PackageEntity, error) {
+	e, err := f.NewPackageFixture(ctx, overrides...)
+	if err != nil {
+		return nil, err
+	}
+	return (&PackageRepositoryBase{Table: TablePackage, DB: f.DB}).Insert(ctx, e)
+}
@@ -0,0 +1,106 @@
+package model_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/piotrkowalczuk/pqt/example/app/internal/model"
+)
+
+type newsTitle struct {
+	ID    int64
+	Title string
+}
+
+func (nt *newsTitle) Props(cns ...string) ([]interface{}, error) {
+	props := make([]interface{}, 0, len(cns))
+	for _, cn := range cns {
+		switch cn {
+		case model.TableNewsColumnID:
+			props = append(props, &nt.ID)
+		case model.TableNewsColumnTitle:
+			props = append(props, &nt.Title)
+		default:
+			return nil, fmt.Errorf("unexpected column: %s", cn)
+		}
+	}
+	return props, nil
+}
+
+func TestNewsRepositoryBase_FindQuery_columns(t *testing.T) {
+	repo := &model.NewsRepositoryBase{Table: model.TableNews}
+
+	query, _, err := repo.FindQuery(&model.NewsFindExpr{
+		Columns: []model.NewsColumn{model.TableNewsColumnID, model.TableNewsColumnTitle},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	if exp := "SELECT t0.id, t0.title FROM example.news AS t0"; query != exp {
+		t.Errorf("wrong output, expected:\n	%s\nbut got:\n	%s", exp, query)
+	}
+
+	if _, _, err = repo.FindQuery(&model.NewsFindExpr{
+		Columns: []model.NewsColumn{"id; DROP TABLE example.news"},
+	}); err == nil {
+		t.Error("expected error for unknown column")
+	}
+}
+
+func TestNewsEntity_Loaded(t *testing.T) {
+	var ent model.NewsEntity
+	if !ent.Loaded(model.TableNewsColumnTitle) {
+		t.Error("entity built by hand should report all fields as loaded")
+	}
+	if ent.Loaded("unknown") {
+		t.Error("unknown column should not be reported as loaded")
+	}
+	if _, err := ent.Props(model.TableNewsColumnID, model.TableNewsColumnContent); err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	if !ent.Loaded(model.TableNewsColumnID) || !ent.Loaded(model.TableNewsColumnContent) {
+		t.Error("selected fields should be reported as loaded")
+	}
+	if ent.Loaded(model.TableNewsColumnTitle) {
+		t.Error("title should not be reported as loaded")
+	}
+	if _, err := ent.Props(); err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	if !ent.Loaded(model.TableNewsColumnTitle) {
+		t.Error("entity loaded in full should report all fields as loaded")
+	}
+}
+
+func TestNewsRepositoryBase_FindProjection(t *testing.T) {
+	s := setup(t)
+	defer s.teardown(t)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	expected := 10
+	populateNews(t, s.news, expected)
+
+	var got []*newsTitle
+	err := s.news.FindProjection(ctx, &model.NewsFindExpr{
+		Columns: []model.NewsColumn{model.TableNewsColumnID, model.TableNewsColumnTitle},
+	}, func() model.Projection {
+		nt := &newsTitle{}
+		got = append(got, nt)
+		return nt
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	if len(got) != expected {
+		t.Fatalf("wrong output, expected %d but got %d", expected, len(got))
+	}
+	for _, nt := range got {
+		if nt.ID == 0 || nt.Title == "" {
+			t.Errorf("projection not populated: %+v", nt)
+		}
+	}
+}
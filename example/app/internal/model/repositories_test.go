@@ -0,0 +1,111 @@
+package model_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/piotrkowalczuk/pqt/example/app/internal/model"
+)
+
+func TestRepositories_RunInTransaction(t *testing.T) {
+	s := setup(t)
+	defer s.teardown(t)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	repos := model.NewRepositories(s.db, nil)
+	insert := func(rtx *model.RepositoriesTx) error {
+		news, err := rtx.News.Insert(ctx, &model.NewsEntity{
+			Title:   "title",
+			Content: "content",
+		})
+		if err != nil {
+			return err
+		}
+		_, err = rtx.Comment.Insert(ctx, &model.CommentEntity{
+			NewsID:    news.ID,
+			NewsTitle: news.Title,
+			Content:   "content",
+		})
+		return err
+	}
+
+	errAbort := errors.New("abort")
+	err := repos.RunInTransaction(ctx, func(rtx *model.RepositoriesTx) error {
+		if err := insert(rtx); err != nil {
+			return err
+		}
+		return errAbort
+	}, 1)
+	if err != errAbort {
+		t.Fatalf("expected %s, got %v", errAbort, err)
+	}
+	assertCount(t, ctx, repos, 0)
+
+	if err := repos.RunInTransaction(ctx, insert, 1); err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	assertCount(t, ctx, repos, 1)
+}
+
+func TestRepositoriesTx_RunInTransaction(t *testing.T) {
+	s := setup(t)
+	defer s.teardown(t)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	repos := model.NewRepositories(s.db, nil)
+	err := repos.RunInTransaction(ctx, func(rtx *model.RepositoriesTx) error {
+		news, err := rtx.News.Insert(ctx, &model.NewsEntity{
+			Title:   "title",
+			Content: "content",
+		})
+		if err != nil {
+			return err
+		}
+		// Comment that references missing news violates foreign key, but it aborts only the savepoint.
+		err = rtx.RunInTransaction(ctx, func(rtx *model.RepositoriesTx) error {
+			_, err := rtx.Comment.Insert(ctx, &model.CommentEntity{
+				NewsID:    news.ID + 1,
+				NewsTitle: news.Title,
+				Content:   "content",
+			})
+			return err
+		})
+		if err == nil {
+			t.Error("expected foreign key violation")
+		}
+		return rtx.RunInTransaction(ctx, func(rtx *model.RepositoriesTx) error {
+			_, err := rtx.Comment.Insert(ctx, &model.CommentEntity{
+				NewsID:    news.ID,
+				NewsTitle: news.Title,
+				Content:   "content",
+			})
+			return err
+		})
+	}, 1)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	assertCount(t, ctx, repos, 1)
+}
+
+func assertCount(t *testing.T, ctx context.Context, repos *model.Repositories, expected int64) {
+	t.Helper()
+
+	news, err := repos.News.Count(ctx, &model.NewsCountExpr{})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	comments, err := repos.Comment.Count(ctx, &model.CommentCountExpr{})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	if news != expected || comments != expected {
+		t.Errorf("wrong number of rows, expected %d news and comments but got %d and %d", expected, news, comments)
+	}
+}
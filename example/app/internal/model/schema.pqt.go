@@ -1,10 +1,10 @@
-// Code generated by pqt.
-// source: cmd/app/main.go
-// DO NOT EDIT!
+// Code generated by pqt. DO NOT EDIT.
+
 package model
 
 import (
 	"bytes"
+	"container/list"
 	"context"
 	"database/sql"
 	"database/sql/driver"
@@ -12,8 +12,13 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"math"
+	"math/rand"
+	"regexp"
 	"strconv"
 	"strings"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/lib/pq"
@@ -22,13 +27,209 @@ import (
 // LogFunc represents function that can be passed into repository to log query result.
 type LogFunc func(err error, ent, fnc, sql string, args ...interface{})
 
+// WithSchema returns table name qualified with the given schema instead of the one it was generated with,
+// e.g. Table: WithSchema(TableNews, "tenant") makes a repository query tenant.news.
+// Joined tables of the same schema follow the repository table.
+func WithSchema(table, schema string) string {
+	if i := strings.LastIndex(table, "."); i >= 0 {
+		table = table[i+1:]
+	}
+	if schema == "" {
+		return table
+	}
+	return schema + "." + table
+}
+
+// inSchemaOf returns table name qualified with the schema of the other table.
+func inSchemaOf(table, other string) string {
+	if i := strings.LastIndex(other, "."); i >= 0 {
+		return WithSchema(table, other[:i])
+	}
+	return WithSchema(table, "")
+}
+
 // RetryTransaction can be returned by user defined function when a transaction is rolled back and logic repeated.
 var RetryTransaction = errors.New("retry transaction")
 
-func RunInTransaction(db *sql.DB, ctx context.Context, f func(tx *sql.Tx) error, attempts int) (err error) {
+// ErrRowLockOutsideTransaction is returned when row locking clause is requested outside of a transaction.
+var ErrRowLockOutsideTransaction = errors.New("row locking clause requires a transaction")
+
+// ErrMultipleRowLocks is returned when more than one row locking clause is passed to a finder.
+var ErrMultipleRowLocks = errors.New("only one row locking clause can be requested")
+
+// Constraint describes constraint of a table.
+// Generated constraints are sentinel errors, so their violations can be matched using errors.Is.
+type Constraint struct {
+	Name string
+	// Type is one of primary key, unique, unique index, foreign key, check or exclusion.
+	Type    string
+	Table   string
+	Columns []string
+}
+
+// Error implements error interface.
+func (c *Constraint) Error() string {
+	return "violation of " + c.Type + " constraint " + c.Name
+}
+
+// ConstraintError is returned by repositories if a statement violates a constraint.
+type ConstraintError struct {
+	Constraint *Constraint
+	// Values maps columns of the constraint to the values that violated it, if the database reported them.
+	Values map[string]string
+	Err    *pq.Error
+}
+
+// Error implements error interface.
+func (e *ConstraintError) Error() string {
+	return e.Err.Error()
+}
+
+// Unwrap returns the driver error.
+func (e *ConstraintError) Unwrap() error {
+	return e.Err
+}
+
+// Is returns true if target is the violated constraint.
+func (e *ConstraintError) Is(target error) bool {
+	c, ok := target.(*Constraint)
+	return ok && c.Name == e.Constraint.Name
+}
+
+var constraintDetail = regexp.MustCompile("^Key \\((.+?)\\)=\\((.*)\\) (already exists|is not present|is still referenced|conflicts with)")
+
+var constraints = map[string]*Constraint{
+	"example.category_id_pkey":                       ErrCategoryConstraintPrimaryKey,
+	"example.category_parent_id_fkey":                ErrCategoryConstraintParentIDForeignKey,
+	"example.package_id_pkey":                        ErrPackageConstraintPrimaryKey,
+	"example.package_category_id_fkey":               ErrPackageConstraintCategoryIDForeignKey,
+	"example.news_id_pkey":                           ErrNewsConstraintPrimaryKey,
+	"example.news_title_key":                         ErrNewsConstraintTitleUnique,
+	"example.news_title_lead_key":                    ErrNewsConstraintTitleLeadUnique,
+	"example.comment_news_title_fkey":                ErrCommentConstraintNewsTitleForeignKey,
+	"example.comment_news_id_fkey":                   ErrCommentConstraintNewsIDForeignKey,
+	"example.category_news_category_id_fkey":         ErrCategoryNewsConstraintCategoryIDForeignKey,
+	"example.category_news_news_id_fkey":             ErrCategoryNewsConstraintNewsIDForeignKey,
+	"example.category_news_category_id_news_id_pkey": ErrCategoryNewsConstraintPrimaryKey,
+}
+
+// wrapError turns constraint violation reported by the pq library into ConstraintError, other errors are returned unchanged.
+func wrapError(err error) error {
+	pqErr, ok := err.(*pq.Error)
+	if !ok || pqErr.Constraint == "" {
+		return err
+	}
+	c, ok := constraints[pqErr.Constraint]
+	if !ok {
+		c = &Constraint{Name: pqErr.Constraint, Table: pqErr.Table}
+	}
+	ce := &ConstraintError{Constraint: c, Err: pqErr}
+	if m := constraintDetail.FindStringSubmatch(pqErr.Detail); m != nil {
+		columns, values := strings.Split(m[1], ", "), strings.Split(m[2], ", ")
+		if len(columns) == len(values) {
+			ce.Values = make(map[string]string, len(columns))
+			for i, column := range columns {
+				ce.Values[column] = values[i]
+			}
+		}
+	}
+	return ce
+}
+
+// TxOptions configures transactions started by BeginTx and RunInTransaction.
+type TxOptions struct {
+	// Isolation is isolation level of the transaction, if zero, the database default is used.
+	Isolation sql.IsolationLevel
+	// ReadOnly makes the transaction read-only.
+	ReadOnly bool
+	// Backoff returns delay before n-th retry of RunInTransaction, if nil, the transaction is retried immediately.
+	Backoff func(n int) time.Duration
+}
+
+// TxOption configures transactions started by BeginTx and RunInTransaction.
+type TxOption func(*TxOptions)
+
+// WithIsolation sets isolation level of the transaction.
+func WithIsolation(level sql.IsolationLevel) TxOption {
+	return func(o *TxOptions) {
+		o.Isolation = level
+	}
+}
+
+// WithReadOnly makes the transaction read-only.
+func WithReadOnly() TxOption {
+	return func(o *TxOptions) {
+		o.ReadOnly = true
+	}
+}
+
+// WithBackoff sets function that returns delay before n-th retry of the transaction, see ExponentialBackoff.
+func WithBackoff(backoff func(n int) time.Duration) TxOption {
+	return func(o *TxOptions) {
+		o.Backoff = backoff
+	}
+}
+
+// ExponentialBackoff returns backoff that starts with base delay and doubles it before each following retry, but not above max.
+// Random jitter of up to given fraction of the delay is added, so transactions that failed together do not retry together.
+func ExponentialBackoff(base, max time.Duration, jitter float64) func(n int) time.Duration {
+	return func(n int) time.Duration {
+		d := base
+		for i := 1; i < n && d < max; i++ {
+			d *= 2
+		}
+		if d > max {
+			d = max
+		}
+		if jitter > 0 {
+			d += time.Duration(rand.Float64() * jitter * float64(d))
+		}
+		return d
+	}
+}
+
+func newTxOptions(opts []TxOption) *TxOptions {
+	o := &TxOptions{}
+	for _, opt := range opts {
+		opt(o)
+	}
+	return o
+}
+
+func (o *TxOptions) sql() *sql.TxOptions {
+	return &sql.TxOptions{Isolation: o.Isolation, ReadOnly: o.ReadOnly}
+}
+
+// IsRetryable returns true if the transaction that failed with given error can succeed if run again.
+// It is true for serialization failures (40001), deadlocks (40P01) and RetryTransaction.
+func IsRetryable(err error) bool {
+	if errors.Is(err, RetryTransaction) {
+		return true
+	}
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) {
+		switch pqErr.Code {
+		case "40001", "40P01":
+			return true
+		}
+	}
+	return false
+}
+
+// RunInTransaction runs given function within a transaction, which is committed if the function returns no error, otherwise it is rolled back.
+// The transaction is run up to attempts times, as long as it fails with an error IsRetryable reports.
+func RunInTransaction(db *sql.DB, ctx context.Context, f func(tx *sql.Tx) error, attempts int, opts ...TxOption) (err error) {
+	o := newTxOptions(opts)
 	for n := 0; n < attempts; n++ {
+		if n > 0 && o.Backoff != nil {
+			select {
+			case <-time.After(o.Backoff(n)):
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		}
 		if err = func() error {
-			tx, err := db.BeginTx(ctx, nil)
+			tx, err := db.BeginTx(ctx, o.sql())
 			if err != nil {
 				return err
 			}
@@ -48,7 +249,7 @@ func RunInTransaction(db *sql.DB, ctx context.Context, f func(tx *sql.Tx) error,
 			}
 
 			return tx.Commit()
-		}(); err == RetryTransaction {
+		}(); IsRetryable(err) {
 			continue
 		}
 		return err
@@ -56,10778 +257,1783 @@ func RunInTransaction(db *sql.DB, ctx context.Context, f func(tx *sql.Tx) error,
 	return err
 }
 
-// Rows ...
-type Rows interface {
-	io.Closer
-	ColumnTypes() ([]*sql.ColumnType, error)
-	Columns() ([]string, error)
-	Err() error
-	Next() bool
-	NextResultSet() bool
-	Scan(dst ...interface{}) error
+var savepointSeq uint64
+
+func savepoint(ctx context.Context, tx *sql.Tx, name string) error {
+	_, err := tx.ExecContext(ctx, "SAVEPOINT "+pq.QuoteIdentifier(name))
+	return err
 }
 
-func joinClause(comp *Composer, jt JoinType, on string) (ok bool, err error) {
-	if jt != JoinDoNot {
-		switch jt {
-		case JoinInner:
-			if _, err = comp.WriteString(" INNER JOIN "); err != nil {
-				return
-			}
-		case JoinLeft:
-			if _, err = comp.WriteString(" LEFT JOIN "); err != nil {
-				return
-			}
-		case JoinRight:
-			if _, err = comp.WriteString(" RIGHT JOIN "); err != nil {
-				return
-			}
-		case JoinCross:
-			if _, err = comp.WriteString(" CROSS JOIN "); err != nil {
-				return
-			}
-		default:
-			return
-		}
-		if _, err = comp.WriteString(on); err != nil {
-			return
-		}
-		comp.Dirty = true
-		ok = true
-		return
+func rollbackToSavepoint(ctx context.Context, tx *sql.Tx, name string) error {
+	_, err := tx.ExecContext(ctx, "ROLLBACK TO SAVEPOINT "+pq.QuoteIdentifier(name))
+	return err
+}
+
+func releaseSavepoint(ctx context.Context, tx *sql.Tx, name string) error {
+	_, err := tx.ExecContext(ctx, "RELEASE SAVEPOINT "+pq.QuoteIdentifier(name))
+	return err
+}
+
+// RunInSavepoint runs given function within a savepoint of the tx transaction.
+// If the function returns an error, only changes it made are rolled back and the transaction can be continued,
+// otherwise the savepoint is released.
+func RunInSavepoint(ctx context.Context, tx *sql.Tx, f func(tx *sql.Tx) error) error {
+	name := "pqt_savepoint_" + strconv.FormatUint(atomic.AddUint64(&savepointSeq, 1), 10)
+	if err := savepoint(ctx, tx, name); err != nil {
+		return err
 	}
-	return
+	if err := f(tx); err != nil {
+		_ = rollbackToSavepoint(ctx, tx, name)
+		return err
+	}
+	return releaseSavepoint(ctx, tx, name)
 }
 
-const (
-	TableCategoryConstraintPrimaryKey         = "example.category_id_pkey"
-	TableCategoryConstraintParentIDForeignKey = "example.category_parent_id_fkey"
-	TableCategoryConstraintNameIndex          = "example.category_name_idx"
-)
+// Session describes role and settings applied to a transaction using SET LOCAL.
+// Row-level security policies can read them using current_user and current_setting.
+type Session struct {
+	// Role, if not empty, is set using SET LOCAL ROLE.
+	Role string
+	// Settings are set for the duration of the transaction, e.g. app.user_id.
+	Settings map[string]string
+}
 
-const (
-	TableCategory                = "example.category"
-	TableCategoryColumnContent   = "content"
-	TableCategoryColumnCreatedAt = "created_at"
-	TableCategoryColumnID        = "id"
-	TableCategoryColumnName      = "name"
-	TableCategoryColumnParentID  = "parent_id"
-	TableCategoryColumnUpdatedAt = "updated_at"
-)
+// ErrSessionOutsideTransaction is returned when repository that has a session is used outside of a transaction.
+// Settings applied using SET LOCAL last until the end of a transaction, without them policies would be evaluated for the login role.
+var ErrSessionOutsideTransaction = errors.New("session requires a transaction")
 
-var TableCategoryColumns = []string{
-	TableCategoryColumnContent,
-	TableCategoryColumnCreatedAt,
-	TableCategoryColumnID,
-	TableCategoryColumnName,
-	TableCategoryColumnParentID,
-	TableCategoryColumnUpdatedAt,
-}
-
-// CategoryEntity ...
-type CategoryEntity struct {
-	// Content ...
-	Content string
-	// CreatedAt ...
-	CreatedAt time.Time
-	// ID ...
-	ID int64
-	// Name ...
-	Name string
-	// ParentID ...
-	ParentID sql.NullInt64
-	// UpdatedAt ...
-	UpdatedAt pq.NullTime
-	// ChildCategory ...
-	ChildCategory []*CategoryEntity
-	// ParentCategory ...
-	ParentCategory *CategoryEntity
-	// Packages ...
-	Packages []*PackageEntity
-}
-
-func (e *CategoryEntity) Prop(cn string) (interface{}, bool) {
-	switch cn {
-
-	case TableCategoryColumnContent:
-		return &e.Content, true
-	case TableCategoryColumnCreatedAt:
-		return &e.CreatedAt, true
-	case TableCategoryColumnID:
-		return &e.ID, true
-	case TableCategoryColumnName:
-		return &e.Name, true
-	case TableCategoryColumnParentID:
-		return &e.ParentID, true
-	case TableCategoryColumnUpdatedAt:
-		return &e.UpdatedAt, true
-	default:
-		return nil, false
+// SessionFunc returns session of the given context, if nil is returned, nothing is applied.
+type SessionFunc func(ctx context.Context) (*Session, error)
+
+func applySession(ctx context.Context, tx *sql.Tx, fn SessionFunc) error {
+	s, err := fn(ctx)
+	if err != nil || s == nil {
+		return err
+	}
+	if s.Role != "" {
+		if _, err := tx.ExecContext(ctx, "SET LOCAL ROLE "+pq.QuoteIdentifier(s.Role)); err != nil {
+			return err
+		}
 	}
+	for name, value := range s.Settings {
+		if _, err := tx.ExecContext(ctx, "SELECT set_config($1, $2, true)", name, value); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
-func (e *CategoryEntity) Props(cns ...string) ([]interface{}, error) {
-	if len(cns) == 0 {
-		cns = TableCategoryColumns
+// Repositories holds repositories of all tables, they share database handle and log function.
+type Repositories struct {
+	DB  *sql.DB
+	Log LogFunc
+	// Session, if set, is applied at the beginning of transactions started by BeginTx and RunInTransaction.
+	// It is not inherited by repositories of the tables, set their Session to reject queries outside of a transaction.
+	Session      SessionFunc
+	Category     *CategoryRepositoryBase
+	Package      *PackageRepositoryBase
+	News         *NewsRepositoryBase
+	Comment      *CommentRepositoryBase
+	CategoryNews *CategoryNewsRepositoryBase
+	Complete     *CompleteRepositoryBase
+}
+
+// NewRepositories allocates repositories of all tables using given database handle and log function.
+func NewRepositories(db *sql.DB, log LogFunc) *Repositories {
+	return &Repositories{
+		DB:  db,
+		Log: log,
+		Category: &CategoryRepositoryBase{
+			Table:   TableCategory,
+			Columns: TableCategoryColumns,
+			DB:      db,
+			Log:     log,
+		},
+		Package: &PackageRepositoryBase{
+			Table:   TablePackage,
+			Columns: TablePackageColumns,
+			DB:      db,
+			Log:     log,
+		},
+		News: &NewsRepositoryBase{
+			Table:   TableNews,
+			Columns: TableNewsColumns,
+			DB:      db,
+			Log:     log,
+		},
+		Comment: &CommentRepositoryBase{
+			Table:   TableComment,
+			Columns: TableCommentColumns,
+			DB:      db,
+			Log:     log,
+		},
+		CategoryNews: &CategoryNewsRepositoryBase{
+			Table:   TableCategoryNews,
+			Columns: TableCategoryNewsColumns,
+			DB:      db,
+			Log:     log,
+		},
+		Complete: &CompleteRepositoryBase{
+			Table:   TableComplete,
+			Columns: TableCompleteColumns,
+			DB:      db,
+			Log:     log,
+		},
+	}
+}
+
+// RepositoriesTx holds repositories of all tables that share single transaction.
+type RepositoriesTx struct {
+	tx           *sql.Tx
+	Category     *CategoryRepositoryBaseTx
+	Package      *PackageRepositoryBaseTx
+	News         *NewsRepositoryBaseTx
+	Comment      *CommentRepositoryBaseTx
+	CategoryNews *CategoryNewsRepositoryBaseTx
+	Complete     *CompleteRepositoryBaseTx
+}
+
+// Tx returns repositories that run queries within given transaction.
+func (r *Repositories) Tx(tx *sql.Tx) (*RepositoriesTx, error) {
+	rtx := &RepositoriesTx{tx: tx}
+	if r.Category != nil {
+		repo, err := r.Category.Tx(tx)
+		if err != nil {
+			return nil, err
+		}
+		rtx.Category = repo
 	}
-	res := make([]interface{}, 0, len(cns))
-	for _, cn := range cns {
-		if prop, ok := e.Prop(cn); ok {
-			res = append(res, prop)
-		} else {
-			return nil, fmt.Errorf("unexpected column provided: %s", cn)
+	if r.Package != nil {
+		repo, err := r.Package.Tx(tx)
+		if err != nil {
+			return nil, err
 		}
+		rtx.Package = repo
 	}
-	return res, nil
-}
-
-// ScanCategoryRows helps to scan rows straight to the slice of entities.
-func ScanCategoryRows(rows Rows) (entities []*CategoryEntity, err error) {
-	for rows.Next() {
-		var ent CategoryEntity
-		err = rows.Scan(
-			&ent.Content,
-			&ent.CreatedAt,
-			&ent.ID,
-			&ent.Name,
-			&ent.ParentID,
-			&ent.UpdatedAt,
-		)
+	if r.News != nil {
+		repo, err := r.News.Tx(tx)
 		if err != nil {
-			return
+			return nil, err
 		}
-
-		entities = append(entities, &ent)
+		rtx.News = repo
 	}
-	if err = rows.Err(); err != nil {
-		return
+	if r.Comment != nil {
+		repo, err := r.Comment.Tx(tx)
+		if err != nil {
+			return nil, err
+		}
+		rtx.Comment = repo
 	}
-
-	return
-}
-
-// CategoryIterator is not thread safe.
-type CategoryIterator struct {
-	rows Rows
-	cols []string
-	expr *CategoryFindExpr
-}
-
-func (i *CategoryIterator) Next() bool {
-	return i.rows.Next()
-}
-
-func (i *CategoryIterator) Close() error {
-	return i.rows.Close()
-}
-
-func (i *CategoryIterator) Err() error {
-	return i.rows.Err()
-}
-
-// Columns is wrapper around sql.Rows.Columns method, that also cache output inside iterator.
-func (i *CategoryIterator) Columns() ([]string, error) {
-	if i.cols == nil {
-		cols, err := i.rows.Columns()
+	if r.CategoryNews != nil {
+		repo, err := r.CategoryNews.Tx(tx)
 		if err != nil {
 			return nil, err
 		}
-		i.cols = cols
+		rtx.CategoryNews = repo
 	}
-	return i.cols, nil
-}
-
-// Ent is wrapper around Category method that makes iterator more generic.
-func (i *CategoryIterator) Ent() (interface{}, error) {
-	return i.Category()
+	if r.Complete != nil {
+		repo, err := r.Complete.Tx(tx)
+		if err != nil {
+			return nil, err
+		}
+		rtx.Complete = repo
+	}
+	return rtx, nil
 }
 
-func (i *CategoryIterator) Category() (*CategoryEntity, error) {
-	var ent CategoryEntity
-	cols, err := i.Columns()
+// BeginTx starts transaction using given options and returns repositories that share it.
+func (r *Repositories) BeginTx(ctx context.Context, opts ...TxOption) (*RepositoriesTx, error) {
+	tx, err := r.DB.BeginTx(ctx, newTxOptions(opts).sql())
 	if err != nil {
 		return nil, err
 	}
-
-	props, err := ent.Props(cols...)
-	if err != nil {
-		return nil, err
+	if r.Session != nil {
+		if err := applySession(ctx, tx, r.Session); err != nil {
+			_ = tx.Rollback()
+			return nil, err
+		}
 	}
-	if err := i.rows.Scan(props...); err != nil {
+	rtx, err := r.Tx(tx)
+	if err != nil {
+		_ = tx.Rollback()
 		return nil, err
 	}
-	return &ent, nil
+	return rtx, nil
 }
 
-type CategoryCriteria struct {
-	Content                sql.NullString
-	CreatedAt              pq.NullTime
-	ID                     sql.NullInt64
-	Name                   sql.NullString
-	ParentID               sql.NullInt64
-	UpdatedAt              pq.NullTime
-	operator               string
-	child, sibling, parent *CategoryCriteria
-}
-
-func CategoryOperand(operator string, operands ...*CategoryCriteria) *CategoryCriteria {
-	if len(operands) == 0 {
-		return &CategoryCriteria{operator: operator}
-	}
-
-	parent := &CategoryCriteria{
-		operator: operator,
-		child:    operands[0],
-	}
-
-	for i := 0; i < len(operands); i++ {
-		if i < len(operands)-1 {
-			operands[i].sibling = operands[i+1]
+// RunInTransaction runs given function within a transaction started using given options.
+// The transaction is committed if the function returns no error, otherwise it is rolled back.
+// It is repeated up to attempts times as long as it fails with an error IsRetryable reports.
+func (r *Repositories) RunInTransaction(ctx context.Context, fn func(rtx *RepositoriesTx) error, attempts int, opts ...TxOption) error {
+	return RunInTransaction(r.DB, ctx, func(tx *sql.Tx) error {
+		if r.Session != nil {
+			if err := applySession(ctx, tx, r.Session); err != nil {
+				return err
+			}
 		}
-		operands[i].parent = parent
-	}
-
-	return parent
+		rtx, err := r.Tx(tx)
+		if err != nil {
+			return err
+		}
+		return fn(rtx)
+	}, attempts, opts...)
 }
 
-func CategoryOr(operands ...*CategoryCriteria) *CategoryCriteria {
-	return CategoryOperand("OR", operands...)
+// Commit commits the transaction.
+func (r *RepositoriesTx) Commit() error {
+	return r.tx.Commit()
 }
 
-func CategoryAnd(operands ...*CategoryCriteria) *CategoryCriteria {
-	return CategoryOperand("AND", operands...)
+// Rollback aborts the transaction.
+func (r *RepositoriesTx) Rollback() error {
+	return r.tx.Rollback()
 }
 
-type CategoryFindExpr struct {
-	Where         *CategoryCriteria
-	Offset, Limit int64
-	Columns       []string
-	OrderBy       []RowOrder
+// Savepoint establishes new savepoint within the transaction.
+func (r *RepositoriesTx) Savepoint(ctx context.Context, name string) error {
+	return savepoint(ctx, r.tx, name)
 }
 
-type CategoryJoin struct {
-	On, Where *CategoryCriteria
-	Fetch     bool
-	Kind      JoinType
+// RollbackTo rolls back all changes made after the savepoint was established, the transaction can be continued.
+func (r *RepositoriesTx) RollbackTo(ctx context.Context, name string) error {
+	return rollbackToSavepoint(ctx, r.tx, name)
 }
 
-type CategoryCountExpr struct {
-	Where *CategoryCriteria
+// Release destroys the savepoint, changes made after it was established are kept.
+func (r *RepositoriesTx) Release(ctx context.Context, name string) error {
+	return releaseSavepoint(ctx, r.tx, name)
 }
 
-type CategoryPatch struct {
-	Content   sql.NullString
-	CreatedAt pq.NullTime
-	Name      sql.NullString
-	ParentID  sql.NullInt64
-	UpdatedAt pq.NullTime
+// RunInTransaction runs given function within a savepoint of the transaction, see RunInSavepoint.
+// It allows functions that start their own transaction to be composed into a bigger one.
+func (r *RepositoriesTx) RunInTransaction(ctx context.Context, fn func(rtx *RepositoriesTx) error) error {
+	return RunInSavepoint(ctx, r.tx, func(*sql.Tx) error {
+		return fn(r)
+	})
 }
 
-type CategoryRepositoryBase struct {
-	Table   string
-	Columns []string
-	DB      *sql.DB
-	Log     LogFunc
+// StmtCache is a bounded cache of prepared statements, keyed by the query.
+// Repositories that share it have to use the same connection pool the cache was created for.
+// Once the cache is full, the least recently used statement is closed.
+type StmtCache struct {
+	db    *sql.DB
+	size  int
+	mu    sync.Mutex
+	lru   *list.List
+	stmts map[string]*list.Element
 }
 
-func (r *CategoryRepositoryBase) Tx(tx *sql.Tx) (*CategoryRepositoryBaseTx, error) {
-	return &CategoryRepositoryBaseTx{
-		base: r,
-		tx:   tx,
-	}, nil
+type cachedStmt struct {
+	query   string
+	stmt    *sql.Stmt
+	refs    int
+	evicted bool
 }
 
-func (r *CategoryRepositoryBase) BeginTx(ctx context.Context) (*CategoryRepositoryBaseTx, error) {
-	tx, err := r.DB.BeginTx(ctx, nil)
-	if err != nil {
-		return nil, err
+// NewStmtCache allocates StmtCache that holds up to size statements prepared on the db.
+func NewStmtCache(db *sql.DB, size int) *StmtCache {
+	if size < 1 {
+		size = 1
+	}
+	return &StmtCache{
+		db:    db,
+		size:  size,
+		lru:   list.New(),
+		stmts: make(map[string]*list.Element, size),
 	}
-	return r.Tx(tx)
 }
 
-func (r CategoryRepositoryBase) RunInTransaction(ctx context.Context, fn func(rtx *CategoryRepositoryBaseTx) error, attempts int) (err error) {
-	return RunInTransaction(r.DB, ctx, func(tx *sql.Tx) error {
-		rtx, err := r.Tx(tx)
-		if err != nil {
-			return err
-		}
-		return fn(rtx)
-	}, attempts)
+// Len returns number of cached statements.
+func (c *StmtCache) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.lru.Len()
 }
 
-func (r *CategoryRepositoryBase) InsertQuery(e *CategoryEntity, read bool) (string, []interface{}, error) {
-	insert := NewComposer(6)
-	columns := bytes.NewBuffer(nil)
-	buf := bytes.NewBufferString("INSERT INTO ")
-	buf.WriteString(r.Table)
+// Close closes all cached statements. Statements that are in use are closed once released.
+func (c *StmtCache) Close() (err error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
 
-	if columns.Len() > 0 {
-		if _, err := columns.WriteString(", "); err != nil {
-			return "", nil, err
+	for el := c.lru.Front(); el != nil; el = el.Next() {
+		if cerr := c.evict(el.Value.(*cachedStmt)); cerr != nil && err == nil {
+			err = cerr
 		}
 	}
-	if _, err := columns.WriteString(TableCategoryColumnContent); err != nil {
-		return "", nil, err
-	}
-	if insert.Dirty {
-		if _, err := insert.WriteString(", "); err != nil {
-			return "", nil, err
-		}
+	c.lru.Init()
+	c.stmts = make(map[string]*list.Element, c.size)
+	return err
+}
+
+// acquire returns statement of the query, preparing it if it is not cached yet.
+// Statement has to be released once it is not used anymore.
+func (c *StmtCache) acquire(ctx context.Context, query string) (*cachedStmt, error) {
+	if cs := c.get(query); cs != nil {
+		return cs, nil
 	}
-	if err := insert.WritePlaceholder(); err != nil {
-		return "", nil, err
+	stmt, err := c.db.PrepareContext(ctx, query)
+	if err != nil {
+		return nil, err
 	}
-	insert.Add(e.Content)
-	insert.Dirty = true
 
-	if !e.CreatedAt.IsZero() {
-		if columns.Len() > 0 {
-			if _, err := columns.WriteString(", "); err != nil {
-				return "", nil, err
-			}
-		}
-		if _, err := columns.WriteString(TableCategoryColumnCreatedAt); err != nil {
-			return "", nil, err
-		}
-		if insert.Dirty {
-			if _, err := insert.WriteString(", "); err != nil {
-				return "", nil, err
-			}
-		}
-		if err := insert.WritePlaceholder(); err != nil {
-			return "", nil, err
-		}
-		insert.Add(e.CreatedAt)
-		insert.Dirty = true
-	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
 
-	if columns.Len() > 0 {
-		if _, err := columns.WriteString(", "); err != nil {
-			return "", nil, err
-		}
-	}
-	if _, err := columns.WriteString(TableCategoryColumnName); err != nil {
-		return "", nil, err
+	if el, ok := c.stmts[query]; ok {
+		// Prepared concurrently, the cached one wins.
+		_ = stmt.Close()
+		c.lru.MoveToFront(el)
+		cs := el.Value.(*cachedStmt)
+		cs.refs++
+		return cs, nil
 	}
-	if insert.Dirty {
-		if _, err := insert.WriteString(", "); err != nil {
-			return "", nil, err
-		}
+	cs := &cachedStmt{query: query, stmt: stmt, refs: 1}
+	c.stmts[query] = c.lru.PushFront(cs)
+	for c.lru.Len() > c.size {
+		el := c.lru.Back()
+		c.lru.Remove(el)
+		delete(c.stmts, el.Value.(*cachedStmt).query)
+		_ = c.evict(el.Value.(*cachedStmt))
 	}
-	if err := insert.WritePlaceholder(); err != nil {
-		return "", nil, err
+	return cs, nil
+}
+
+func (c *StmtCache) get(query string) *cachedStmt {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	el, ok := c.stmts[query]
+	if !ok {
+		return nil
 	}
-	insert.Add(e.Name)
-	insert.Dirty = true
+	c.lru.MoveToFront(el)
+	cs := el.Value.(*cachedStmt)
+	cs.refs++
+	return cs
+}
 
-	if e.ParentID.Valid {
-		if columns.Len() > 0 {
-			if _, err := columns.WriteString(", "); err != nil {
-				return "", nil, err
-			}
-		}
-		if _, err := columns.WriteString(TableCategoryColumnParentID); err != nil {
-			return "", nil, err
-		}
-		if insert.Dirty {
-			if _, err := insert.WriteString(", "); err != nil {
-				return "", nil, err
-			}
-		}
-		if err := insert.WritePlaceholder(); err != nil {
-			return "", nil, err
-		}
-		insert.Add(e.ParentID)
-		insert.Dirty = true
+func (c *StmtCache) release(cs *cachedStmt) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	cs.refs--
+	if cs.evicted && cs.refs == 0 {
+		_ = cs.stmt.Close()
 	}
+}
 
-	if e.UpdatedAt.Valid {
-		if columns.Len() > 0 {
-			if _, err := columns.WriteString(", "); err != nil {
-				return "", nil, err
-			}
-		}
-		if _, err := columns.WriteString(TableCategoryColumnUpdatedAt); err != nil {
-			return "", nil, err
-		}
-		if insert.Dirty {
-			if _, err := insert.WriteString(", "); err != nil {
-				return "", nil, err
-			}
-		}
-		if err := insert.WritePlaceholder(); err != nil {
-			return "", nil, err
-		}
-		insert.Add(e.UpdatedAt)
-		insert.Dirty = true
-	}
-
-	if columns.Len() > 0 {
-		buf.WriteString(" (")
-		buf.ReadFrom(columns)
-		buf.WriteString(") VALUES (")
-		buf.ReadFrom(insert)
-		buf.WriteString(") ")
-		if read {
-			buf.WriteString("RETURNING ")
-			if len(r.Columns) > 0 {
-				buf.WriteString(strings.Join(r.Columns, ", "))
-			} else {
-				buf.WriteString("content, created_at, id, name, parent_id, updated_at")
-			}
-		}
+// evict marks statement as removed from the cache, it is closed right away if it is not in use.
+func (c *StmtCache) evict(cs *cachedStmt) error {
+	cs.evicted = true
+	if cs.refs == 0 {
+		return cs.stmt.Close()
 	}
-	return buf.String(), insert.Args(), nil
+	return nil
 }
 
-func (r *CategoryRepositoryBase) insert(ctx context.Context, tx *sql.Tx, e *CategoryEntity) (*CategoryEntity, error) {
-	query, args, err := r.InsertQuery(e, true)
+// queryRow executes query that is expected to return at most one row and scans it into dest.
+// If cache is given, the query is executed as a prepared statement, bound to the tx transaction if it is not nil.
+func queryRow(ctx context.Context, db *sql.DB, tx *sql.Tx, cache *StmtCache, query string, args []interface{}, dest ...interface{}) error {
+	if cache == nil {
+		if tx == nil {
+			return db.QueryRowContext(ctx, query, args...).Scan(dest...)
+		}
+		return tx.QueryRowContext(ctx, query, args...).Scan(dest...)
+	}
+	cs, err := cache.acquire(ctx, query)
 	if err != nil {
-		return nil, err
+		return err
 	}
+	defer cache.release(cs)
 
-	var row *sql.Row
-	if tx == nil {
-		row = r.DB.QueryRowContext(ctx, query, args...)
-	} else {
-		row = tx.QueryRowContext(ctx, query, args...)
-	}
-	err = row.Scan(
-		&e.Content,
-		&e.CreatedAt,
-		&e.ID,
-		&e.Name,
-		&e.ParentID,
-		&e.UpdatedAt,
-	)
-	if r.Log != nil {
+	stmt := cs.stmt
+	if tx != nil {
+		stmt = tx.StmtContext(ctx, stmt)
+		defer stmt.Close()
+	}
+	return stmt.QueryRowContext(ctx, args...).Scan(dest...)
+}
+
+// exec works like queryRow, but for queries that do not return rows.
+func exec(ctx context.Context, db *sql.DB, tx *sql.Tx, cache *StmtCache, query string, args ...interface{}) (sql.Result, error) {
+	if cache == nil {
 		if tx == nil {
-			r.Log(err, TableCategory, "insert", query, args...)
-		} else {
-			r.Log(err, TableCategory, "insert tx", query, args...)
+			return db.ExecContext(ctx, query, args...)
 		}
+		return tx.ExecContext(ctx, query, args...)
 	}
+	cs, err := cache.acquire(ctx, query)
 	if err != nil {
 		return nil, err
 	}
-	return e, nil
-}
+	defer cache.release(cs)
 
-func (r *CategoryRepositoryBase) Insert(ctx context.Context, e *CategoryEntity) (*CategoryEntity, error) {
-	return r.insert(ctx, nil, e)
+	stmt := cs.stmt
+	if tx != nil {
+		stmt = tx.StmtContext(ctx, stmt)
+		defer stmt.Close()
+	}
+	return stmt.ExecContext(ctx, args...)
 }
 
-func CategoryCriteriaWhereClause(comp *Composer, c *CategoryCriteria, id int) error {
-	if c.child == nil {
-		return _CategoryCriteriaWhereClause(comp, c, id)
-	}
-	node := c
-	sibling := false
-	for {
-		if !sibling {
-			if node.child != nil {
-				if node.parent != nil {
-					comp.WriteString("(")
-				}
-				node = node.child
-				continue
-			} else {
-				comp.Dirty = false
-				comp.WriteString("(")
-				if err := _CategoryCriteriaWhereClause(comp, node, id); err != nil {
-					return err
-				}
-				comp.WriteString(")")
-			}
-		}
-		if node.sibling != nil {
-			sibling = false
-			comp.WriteString(" ")
-			comp.WriteString(node.parent.operator)
-			comp.WriteString(" ")
-			node = node.sibling
-			continue
-		}
-		if node.parent != nil {
-			sibling = true
-			if node.parent.parent != nil {
-				comp.WriteString(")")
-			}
-			node = node.parent
-			continue
-		}
-
-		break
-	}
-	return nil
+// Rows ...
+type Rows interface {
+	io.Closer
+	ColumnTypes() ([]*sql.ColumnType, error)
+	Columns() ([]string, error)
+	Err() error
+	Next() bool
+	NextResultSet() bool
+	Scan(dst ...interface{}) error
 }
 
-func _CategoryCriteriaWhereClause(comp *Composer, c *CategoryCriteria, id int) error {
-	if c.Content.Valid {
-		if comp.Dirty {
-			comp.WriteString(" AND ")
-		}
-		if err := comp.WriteAlias(id); err != nil {
-			return err
-		}
-		if _, err := comp.WriteString(TableCategoryColumnContent); err != nil {
-			return err
-		}
-		if _, err := comp.WriteString("="); err != nil {
-			return err
-		}
-		if err := comp.WritePlaceholder(); err != nil {
-			return err
-		}
-		comp.Add(c.Content)
-		comp.Dirty = true
-	}
-	if c.CreatedAt.Valid {
-		if comp.Dirty {
-			comp.WriteString(" AND ")
-		}
-		if err := comp.WriteAlias(id); err != nil {
-			return err
-		}
-		if _, err := comp.WriteString(TableCategoryColumnCreatedAt); err != nil {
-			return err
-		}
-		if _, err := comp.WriteString("="); err != nil {
-			return err
+func joinClause(comp *Composer, jt JoinType, on string) (ok bool, err error) {
+	if jt != JoinDoNot {
+		switch jt {
+		case JoinInner:
+			if _, err = comp.WriteString(" INNER JOIN "); err != nil {
+				return
+			}
+		case JoinLeft:
+			if _, err = comp.WriteString(" LEFT JOIN "); err != nil {
+				return
+			}
+		case JoinRight:
+			if _, err = comp.WriteString(" RIGHT JOIN "); err != nil {
+				return
+			}
+		case JoinCross:
+			if _, err = comp.WriteString(" CROSS JOIN "); err != nil {
+				return
+			}
+		default:
+			return
 		}
-		if err := comp.WritePlaceholder(); err != nil {
-			return err
+		if _, err = comp.WriteString(on); err != nil {
+			return
 		}
-		comp.Add(c.CreatedAt)
 		comp.Dirty = true
+		ok = true
+		return
 	}
-	// id is an empty struct, ignore
+	return
+}
 
-	if c.Name.Valid {
-		if comp.Dirty {
-			comp.WriteString(" AND ")
-		}
-		if err := comp.WriteAlias(id); err != nil {
-			return err
-		}
-		if _, err := comp.WriteString(TableCategoryColumnName); err != nil {
-			return err
-		}
-		if _, err := comp.WriteString("="); err != nil {
-			return err
-		}
-		if err := comp.WritePlaceholder(); err != nil {
-			return err
-		}
-		comp.Add(c.Name)
-		comp.Dirty = true
+func lockClause(comp *Composer, rl RowLock, of string) error {
+	if !rl.Actionable() {
+		return nil
 	}
-	if c.ParentID.Valid {
-		if comp.Dirty {
-			comp.WriteString(" AND ")
-		}
-		if err := comp.WriteAlias(id); err != nil {
-			return err
-		}
-		if _, err := comp.WriteString(TableCategoryColumnParentID); err != nil {
-			return err
-		}
-		if _, err := comp.WriteString("="); err != nil {
-			return err
-		}
-		if err := comp.WritePlaceholder(); err != nil {
-			return err
-		}
-		comp.Add(c.ParentID)
-		comp.Dirty = true
+	if _, err := comp.WriteString(" "); err != nil {
+		return err
 	}
-	if c.UpdatedAt.Valid {
-		if comp.Dirty {
-			comp.WriteString(" AND ")
-		}
-		if err := comp.WriteAlias(id); err != nil {
+	if _, err := comp.WriteString(rl.Strength.String()); err != nil {
+		return err
+	}
+	if of != "" {
+		if _, err := comp.WriteString(" OF "); err != nil {
 			return err
 		}
-		if _, err := comp.WriteString(TableCategoryColumnUpdatedAt); err != nil {
+		if _, err := comp.WriteString(of); err != nil {
 			return err
 		}
-		if _, err := comp.WriteString("="); err != nil {
+	}
+	switch rl.Wait {
+	case LockSkipLocked:
+		if _, err := comp.WriteString(" SKIP LOCKED"); err != nil {
 			return err
 		}
-		if err := comp.WritePlaceholder(); err != nil {
+	case LockNoWait:
+		if _, err := comp.WriteString(" NOWAIT"); err != nil {
 			return err
 		}
-		comp.Add(c.UpdatedAt)
-		comp.Dirty = true
 	}
 	return nil
 }
 
-func (r *CategoryRepositoryBase) FindQuery(fe *CategoryFindExpr) (string, []interface{}, error) {
-	comp := NewComposer(6)
-	buf := bytes.NewBufferString("SELECT ")
-	if len(fe.Columns) == 0 {
-		buf.WriteString("t0.content, t0.created_at, t0.id, t0.name, t0.parent_id, t0.updated_at")
-	} else {
-		buf.WriteString(strings.Join(fe.Columns, ", "))
-	}
-	buf.WriteString(" FROM ")
-	buf.WriteString(r.Table)
-	buf.WriteString(" AS t0")
-	if comp.Dirty {
-		buf.ReadFrom(comp)
-		comp.Dirty = false
-	}
-	if fe.Where != nil {
-		if err := CategoryCriteriaWhereClause(comp, fe.Where, 0); err != nil {
-			return "", nil, err
-		}
-	}
-	if comp.Dirty {
-		if _, err := buf.WriteString(" WHERE "); err != nil {
-			return "", nil, err
-		}
-		buf.ReadFrom(comp)
-	}
-
-	if len(fe.OrderBy) > 0 {
-		i := 0
-		for _, order := range fe.OrderBy {
-			for _, columnName := range TableCategoryColumns {
-				if order.Name == columnName {
-					if i == 0 {
-						comp.WriteString(" ORDER BY ")
-					}
-					if i > 0 {
-						if _, err := comp.WriteString(", "); err != nil {
-							return "", nil, err
-						}
-					}
-					if _, err := comp.WriteString(order.Name); err != nil {
-						return "", nil, err
-					}
-					if order.Descending {
-						if _, err := comp.WriteString(" DESC"); err != nil {
-							return "", nil, err
-						}
-					}
-					i++
-					break
-				}
-			}
-		}
-	}
-	if fe.Offset > 0 {
-		if _, err := comp.WriteString(" OFFSET "); err != nil {
-			return "", nil, err
-		}
-		if err := comp.WritePlaceholder(); err != nil {
-			return "", nil, err
-		}
-		if _, err := comp.WriteString(" "); err != nil {
-			return "", nil, err
-		}
-		comp.Add(fe.Offset)
-	}
-	if fe.Limit > 0 {
-		if _, err := comp.WriteString(" LIMIT "); err != nil {
-			return "", nil, err
-		}
-		if err := comp.WritePlaceholder(); err != nil {
-			return "", nil, err
-		}
-		if _, err := comp.WriteString(" "); err != nil {
-			return "", nil, err
-		}
-		comp.Add(fe.Limit)
-	}
+// Projection is implemented by caller defined structs that selected columns can be scanned into.
+// Props returns pointers to fields of given columns, entities implement it as well.
+type Projection interface {
+	Props(cns ...string) ([]interface{}, error)
+}
+
+// ChangeOperation is a type of row change published by a table trigger.
+type ChangeOperation string
 
-	buf.ReadFrom(comp)
+const (
+	ChangeInsert ChangeOperation = "INSERT"
+	ChangeUpdate ChangeOperation = "UPDATE"
+	ChangeDelete ChangeOperation = "DELETE"
+)
 
-	return buf.String(), comp.Args(), nil
+// Listener is a subset of *pq.Listener methods change listeners rely on.
+// It makes it possible to replace the actual database connection in tests.
+type Listener interface {
+	Listen(channel string) error
+	Unlisten(channel string) error
+	NotificationChannel() <-chan *pq.Notification
 }
 
-func (r *CategoryRepositoryBase) find(ctx context.Context, tx *sql.Tx, fe *CategoryFindExpr) ([]*CategoryEntity, error) {
-	query, args, err := r.FindQuery(fe)
-	if err != nil {
-		return nil, err
-	}
-	var rows *sql.Rows
-	if tx == nil {
-		rows, err = r.DB.QueryContext(ctx, query, args...)
-	} else {
-		rows, err = tx.QueryContext(ctx, query, args...)
-	}
-	if r.Log != nil {
-		if tx == nil {
-			r.Log(err, TableCategory, "find", query, args...)
-		} else {
-			r.Log(err, TableCategory, "find tx", query, args...)
-		}
-	}
-	if err != nil {
-		return nil, err
-	}
-	defer rows.Close()
-	var (
-		entities []*CategoryEntity
-		props    []interface{}
-	)
-	for rows.Next() {
-		var ent CategoryEntity
-		if props, err = ent.Props(); err != nil {
-			return nil, err
-		}
-		err = rows.Scan(props...)
-		if err != nil {
-			return nil, err
-		}
+const (
+	JoinInner = iota
+	JoinLeft
+	JoinRight
+	JoinCross
+	JoinDoNot
+)
 
-		entities = append(entities, &ent)
-	}
-	err = rows.Err()
-	if r.Log != nil {
-		r.Log(err, TableCategory, "find", query, args...)
-	}
-	if err != nil {
-		return nil, err
+type JoinType int
+
+func (jt JoinType) String() string {
+	switch jt {
+
+	case JoinInner:
+		return "INNER JOIN"
+	case JoinLeft:
+		return "LEFT JOIN"
+	case JoinRight:
+		return "RIGHT JOIN"
+	case JoinCross:
+		return "CROSS JOIN"
+	default:
+		return ""
 	}
-	return entities, nil
 }
 
-func (r *CategoryRepositoryBase) Find(ctx context.Context, fe *CategoryFindExpr) ([]*CategoryEntity, error) {
-	return r.find(ctx, nil, fe)
+// Actionable returns true if JoinType is one of the known type except JoinDoNot.
+func (jt JoinType) Actionable() bool {
+	switch jt {
+	case JoinInner, JoinLeft, JoinRight, JoinCross:
+		return true
+	default:
+		return false
+	}
 }
 
-func (r *CategoryRepositoryBase) findIter(ctx context.Context, tx *sql.Tx, fe *CategoryFindExpr) (*CategoryIterator, error) {
-	query, args, err := r.FindQuery(fe)
-	if err != nil {
-		return nil, err
-	}
-	var rows *sql.Rows
-	if tx == nil {
-		rows, err = r.DB.QueryContext(ctx, query, args...)
-	} else {
-		rows, err = tx.QueryContext(ctx, query, args...)
-	}
-	if r.Log != nil {
-		if tx == nil {
-			r.Log(err, TableCategory, "find iter", query, args...)
-		} else {
-			r.Log(err, TableCategory, "find iter tx", query, args...)
-		}
+const (
+	LockDoNot LockStrength = iota
+	LockForUpdate
+	LockForNoKeyUpdate
+	LockForShare
+	LockForKeyShare
+)
+
+// LockStrength represents strength of a row level lock acquired by SELECT statement.
+type LockStrength int
+
+func (ls LockStrength) String() string {
+	switch ls {
+	case LockForUpdate:
+		return "FOR UPDATE"
+	case LockForNoKeyUpdate:
+		return "FOR NO KEY UPDATE"
+	case LockForShare:
+		return "FOR SHARE"
+	case LockForKeyShare:
+		return "FOR KEY SHARE"
+	default:
+		return ""
 	}
-	if err != nil {
-		return nil, err
+}
+
+// Actionable returns true if LockStrength is one of the known strengths except LockDoNot.
+func (ls LockStrength) Actionable() bool {
+	switch ls {
+	case LockForUpdate, LockForNoKeyUpdate, LockForShare, LockForKeyShare:
+		return true
+	default:
+		return false
 	}
-	return &CategoryIterator{
-		rows: rows,
-		expr: fe,
-		cols: fe.Columns,
-	}, nil
 }
 
-func (r *CategoryRepositoryBase) FindIter(ctx context.Context, fe *CategoryFindExpr) (*CategoryIterator, error) {
-	return r.findIter(ctx, nil, fe)
+const (
+	LockWait LockWaitPolicy = iota
+	LockSkipLocked
+	LockNoWait
+)
+
+// LockWaitPolicy determines what happens if a row cannot be locked immediately.
+type LockWaitPolicy int
+
+// RowLock represents locking clause that can be attached to a SELECT statement.
+// It can be used only within a transaction.
+type RowLock struct {
+	Strength LockStrength
+	Wait     LockWaitPolicy
 }
 
-func (r *CategoryRepositoryBase) findOneByID(ctx context.Context, tx *sql.Tx, pk int64) (*CategoryEntity, error) {
-	find := NewComposer(6)
-	find.WriteString("SELECT ")
-	if len(r.Columns) == 0 {
-		find.WriteString("content, created_at, id, name, parent_id, updated_at")
-	} else {
-		find.WriteString(strings.Join(r.Columns, ", "))
-	}
-	find.WriteString(" FROM ")
-	find.WriteString(TableCategory)
-	find.WriteString(" WHERE ")
-	find.WriteString(TableCategoryColumnID)
-	find.WriteString("=")
-	find.WritePlaceholder()
-	find.Add(pk)
-	var (
-		ent CategoryEntity
-	)
-	props, err := ent.Props(r.Columns...)
-	if err != nil {
-		return nil, err
-	}
-	if tx == nil {
-		err = r.DB.QueryRowContext(ctx, find.String(), find.Args()...).Scan(props...)
-	} else {
-		err = tx.QueryRowContext(ctx, find.String(), find.Args()...).Scan(props...)
-	}
-	if r.Log != nil {
-		if tx == nil {
-			r.Log(err, TableCategory, "find by primary key", find.String(), find.Args()...)
-		} else {
-			r.Log(err, TableCategory, "find by primary key tx", find.String(), find.Args()...)
-		}
+// Actionable returns true if lock strength is set.
+func (rl RowLock) Actionable() bool {
+	return rl.Strength.Actionable()
+}
+
+// ErrorConstraint returns the error constraint of err if it was produced by the pq library.
+// Otherwise, it returns empty string.
+func ErrorConstraint(err error) string {
+	if err == nil {
+		return ""
 	}
-	if err != nil {
-		return nil, err
+	var pqerr *pq.Error
+	if errors.As(err, &pqerr) {
+		return pqerr.Constraint
 	}
-	return &ent, nil
+
+	return ""
 }
 
-func (r *CategoryRepositoryBase) FindOneByID(ctx context.Context, pk int64) (*CategoryEntity, error) {
-	return r.findOneByID(ctx, nil, pk)
+type RowOrder struct {
+	Name       string
+	Descending bool
 }
 
-func (r *CategoryRepositoryBase) UpdateOneByIDQuery(pk int64, p *CategoryPatch) (string, []interface{}, error) {
-	buf := bytes.NewBufferString("UPDATE ")
-	buf.WriteString(r.Table)
-	update := NewComposer(6)
-	if p.Content.Valid {
-		if update.Dirty {
-			if _, err := update.WriteString(", "); err != nil {
-				return "", nil, err
-			}
-		}
-		if _, err := update.WriteString(TableCategoryColumnContent); err != nil {
-			return "", nil, err
-		}
-		if _, err := update.WriteString("="); err != nil {
-			return "", nil, err
-		}
-		if err := update.WritePlaceholder(); err != nil {
-			return "", nil, err
-		}
-		update.Add(p.Content)
-		update.Dirty = true
+type NullInt64Array struct {
+	pq.Int64Array
+	Valid bool
+}
 
+func (n *NullInt64Array) Scan(value interface{}) error {
+	if value == nil {
+		n.Int64Array, n.Valid = nil, false
+		return nil
 	}
-	if p.CreatedAt.Valid {
-		if update.Dirty {
-			if _, err := update.WriteString(", "); err != nil {
-				return "", nil, err
-			}
-		}
-		if _, err := update.WriteString(TableCategoryColumnCreatedAt); err != nil {
-			return "", nil, err
-		}
-		if _, err := update.WriteString("="); err != nil {
-			return "", nil, err
-		}
-		if err := update.WritePlaceholder(); err != nil {
-			return "", nil, err
-		}
-		update.Add(p.CreatedAt)
-		update.Dirty = true
+	n.Valid = true
+	return n.Int64Array.Scan(value)
+}
 
-	}
-	if p.Name.Valid {
-		if update.Dirty {
-			if _, err := update.WriteString(", "); err != nil {
-				return "", nil, err
-			}
-		}
-		if _, err := update.WriteString(TableCategoryColumnName); err != nil {
-			return "", nil, err
-		}
-		if _, err := update.WriteString("="); err != nil {
-			return "", nil, err
-		}
-		if err := update.WritePlaceholder(); err != nil {
-			return "", nil, err
-		}
-		update.Add(p.Name)
-		update.Dirty = true
+type NullFloat64Array struct {
+	pq.Float64Array
+	Valid bool
+}
 
+func (n *NullFloat64Array) Scan(value interface{}) error {
+	if value == nil {
+		n.Float64Array, n.Valid = nil, false
+		return nil
 	}
-	if p.ParentID.Valid {
-		if update.Dirty {
-			if _, err := update.WriteString(", "); err != nil {
-				return "", nil, err
-			}
-		}
-		if _, err := update.WriteString(TableCategoryColumnParentID); err != nil {
-			return "", nil, err
-		}
-		if _, err := update.WriteString("="); err != nil {
-			return "", nil, err
-		}
-		if err := update.WritePlaceholder(); err != nil {
-			return "", nil, err
-		}
-		update.Add(p.ParentID)
-		update.Dirty = true
-
-	}
-	if p.UpdatedAt.Valid {
-		if update.Dirty {
-			if _, err := update.WriteString(", "); err != nil {
-				return "", nil, err
-			}
-		}
-		if _, err := update.WriteString(TableCategoryColumnUpdatedAt); err != nil {
-			return "", nil, err
-		}
-		if _, err := update.WriteString("="); err != nil {
-			return "", nil, err
-		}
-		if err := update.WritePlaceholder(); err != nil {
-			return "", nil, err
-		}
-		update.Add(p.UpdatedAt)
-		update.Dirty = true
-
-	} else {
-		if update.Dirty {
-			if _, err := update.WriteString(", "); err != nil {
-				return "", nil, err
-			}
-		}
-		if _, err := update.WriteString(TableCategoryColumnUpdatedAt); err != nil {
-			return "", nil, err
-		}
-		if _, err := update.WriteString("=NOW()"); err != nil {
-			return "", nil, err
-		}
-		update.Dirty = true
-	}
-	if !update.Dirty {
-		return "", nil, errors.New("Category update failure, nothing to update")
-	}
-	buf.WriteString(" SET ")
-	buf.ReadFrom(update)
-	buf.WriteString(" WHERE ")
-
-	update.WriteString(TableCategoryColumnID)
-	update.WriteString("=")
-	update.WritePlaceholder()
-	update.Add(pk)
+	n.Valid = true
+	return n.Float64Array.Scan(value)
+}
 
-	buf.ReadFrom(update)
-	buf.WriteString(" RETURNING ")
-	if len(r.Columns) > 0 {
-		buf.WriteString(strings.Join(r.Columns, ", "))
-	} else {
-		buf.WriteString("content, created_at, id, name, parent_id, updated_at")
-	}
-	return buf.String(), update.Args(), nil
+type NullBoolArray struct {
+	pq.BoolArray
+	Valid bool
 }
 
-func (r *CategoryRepositoryBase) updateOneByID(ctx context.Context, tx *sql.Tx, pk int64, p *CategoryPatch) (*CategoryEntity, error) {
-	query, args, err := r.UpdateOneByIDQuery(pk, p)
-	if err != nil {
-		return nil, err
-	}
-	var ent CategoryEntity
-	props, err := ent.Props(r.Columns...)
-	if err != nil {
-		return nil, err
-	}
-	if tx == nil {
-		err = r.DB.QueryRowContext(ctx, query, args...).Scan(props...)
-	} else {
-		err = tx.QueryRowContext(ctx, query, args...).Scan(props...)
-	}
-	if r.Log != nil {
-		if tx == nil {
-			r.Log(err, TableCategory, "update by primary key", query, args...)
-		} else {
-			r.Log(err, TableCategory, "update by primary key tx", query, args...)
-		}
-	}
-	if err != nil {
-		return nil, err
+func (n *NullBoolArray) Scan(value interface{}) error {
+	if value == nil {
+		n.BoolArray, n.Valid = nil, false
+		return nil
 	}
-	return &ent, nil
+	n.Valid = true
+	return n.BoolArray.Scan(value)
 }
 
-func (r *CategoryRepositoryBase) UpdateOneByID(ctx context.Context, pk int64, p *CategoryPatch) (*CategoryEntity, error) {
-	return r.updateOneByID(ctx, nil, pk, p)
+type NullStringArray struct {
+	pq.StringArray
+	Valid bool
 }
 
-func (r *CategoryRepositoryBase) FindOneByIDAndUpdate(ctx context.Context, pk int64, p *CategoryPatch) (before, after *CategoryEntity, err error) {
-	find := NewComposer(6)
-	find.WriteString("SELECT ")
-	if len(r.Columns) == 0 {
-		find.WriteString("content, created_at, id, name, parent_id, updated_at")
-	} else {
-		find.WriteString(strings.Join(r.Columns, ", "))
-	}
-	find.WriteString(" FROM ")
-	find.WriteString(TableCategory)
-	find.WriteString(" WHERE ")
-	find.WriteString(TableCategoryColumnID)
-	find.WriteString("=")
-	find.WritePlaceholder()
-	find.Add(pk)
-	find.WriteString(" FOR UPDATE")
-	query, args, err := r.UpdateOneByIDQuery(pk, p)
-	if err != nil {
-		return
-	}
-	var (
-		oldEnt, newEnt CategoryEntity
-	)
-	oldProps, err := oldEnt.Props(r.Columns...)
-	if err != nil {
-		return
-	}
-	newProps, err := newEnt.Props(r.Columns...)
-	if err != nil {
-		return
-	}
-	tx, err := r.DB.Begin()
-	if err != nil {
-		return
-	}
-	err = tx.QueryRowContext(ctx, find.String(), find.Args()...).Scan(oldProps...)
-	if r.Log != nil {
-		r.Log(err, TableCategory, "find by primary key", find.String(), find.Args()...)
-	}
-	if err != nil {
-		tx.Rollback()
-		return
-	}
-	err = tx.QueryRowContext(ctx, query, args...).Scan(newProps...)
-	if r.Log != nil {
-		r.Log(err, TableCategory, "update by primary key", query, args...)
-	}
-	if err != nil {
-		tx.Rollback()
-		return
-	}
-	err = tx.Commit()
-	if err != nil {
-		return
+func (n *NullStringArray) Scan(value interface{}) error {
+	if value == nil {
+		n.StringArray, n.Valid = nil, false
+		return nil
 	}
-	return &oldEnt, &newEnt, nil
+	n.Valid = true
+	return n.StringArray.Scan(value)
 }
 
-func (r *CategoryRepositoryBase) UpsertQuery(e *CategoryEntity, p *CategoryPatch, inf ...string) (string, []interface{}, error) {
-	upsert := NewComposer(12)
-	columns := bytes.NewBuffer(nil)
-	buf := bytes.NewBufferString("INSERT INTO ")
-	buf.WriteString(r.Table)
-
-	if columns.Len() > 0 {
-		if _, err := columns.WriteString(", "); err != nil {
-			return "", nil, err
-		}
-	}
-	if _, err := columns.WriteString(TableCategoryColumnContent); err != nil {
-		return "", nil, err
-	}
-	if upsert.Dirty {
-		if _, err := upsert.WriteString(", "); err != nil {
-			return "", nil, err
-		}
-	}
-	if err := upsert.WritePlaceholder(); err != nil {
-		return "", nil, err
-	}
-	upsert.Add(e.Content)
-	upsert.Dirty = true
+type NullByteaArray struct {
+	pq.ByteaArray
+	Valid bool
+}
 
-	if !e.CreatedAt.IsZero() {
-		if columns.Len() > 0 {
-			if _, err := columns.WriteString(", "); err != nil {
-				return "", nil, err
-			}
-		}
-		if _, err := columns.WriteString(TableCategoryColumnCreatedAt); err != nil {
-			return "", nil, err
-		}
-		if upsert.Dirty {
-			if _, err := upsert.WriteString(", "); err != nil {
-				return "", nil, err
-			}
-		}
-		if err := upsert.WritePlaceholder(); err != nil {
-			return "", nil, err
-		}
-		upsert.Add(e.CreatedAt)
-		upsert.Dirty = true
+func (n *NullByteaArray) Scan(value interface{}) error {
+	if value == nil {
+		n.ByteaArray, n.Valid = nil, false
+		return nil
 	}
+	n.Valid = true
+	return n.ByteaArray.Scan(value)
+}
 
-	if columns.Len() > 0 {
-		if _, err := columns.WriteString(", "); err != nil {
-			return "", nil, err
-		}
-	}
-	if _, err := columns.WriteString(TableCategoryColumnName); err != nil {
-		return "", nil, err
-	}
-	if upsert.Dirty {
-		if _, err := upsert.WriteString(", "); err != nil {
-			return "", nil, err
-		}
-	}
-	if err := upsert.WritePlaceholder(); err != nil {
-		return "", nil, err
-	}
-	upsert.Add(e.Name)
-	upsert.Dirty = true
+const (
+	jsonArraySeparator     = ","
+	jsonArrayBeginningChar = "["
+	jsonArrayEndChar       = "]"
+)
 
-	if e.ParentID.Valid {
-		if columns.Len() > 0 {
-			if _, err := columns.WriteString(", "); err != nil {
-				return "", nil, err
-			}
-		}
-		if _, err := columns.WriteString(TableCategoryColumnParentID); err != nil {
-			return "", nil, err
-		}
-		if upsert.Dirty {
-			if _, err := upsert.WriteString(", "); err != nil {
-				return "", nil, err
-			}
-		}
-		if err := upsert.WritePlaceholder(); err != nil {
-			return "", nil, err
-		}
-		upsert.Add(e.ParentID)
-		upsert.Dirty = true
-	}
+// JSONArrayInt64 is a slice of int64s that implements necessary interfaces.
+type JSONArrayInt64 []int64
 
-	if e.UpdatedAt.Valid {
-		if columns.Len() > 0 {
-			if _, err := columns.WriteString(", "); err != nil {
-				return "", nil, err
-			}
-		}
-		if _, err := columns.WriteString(TableCategoryColumnUpdatedAt); err != nil {
-			return "", nil, err
-		}
-		if upsert.Dirty {
-			if _, err := upsert.WriteString(", "); err != nil {
-				return "", nil, err
-			}
-		}
-		if err := upsert.WritePlaceholder(); err != nil {
-			return "", nil, err
+// Scan satisfy sql.Scanner interface.
+func (a *JSONArrayInt64) Scan(src interface{}) error {
+	if src == nil {
+		if a == nil {
+			*a = make(JSONArrayInt64, 0)
 		}
-		upsert.Add(e.UpdatedAt)
-		upsert.Dirty = true
-	}
-
-	if upsert.Dirty {
-		buf.WriteString(" (")
-		buf.ReadFrom(columns)
-		buf.WriteString(") VALUES (")
-		buf.ReadFrom(upsert)
-		buf.WriteString(")")
+		return nil
 	}
-	buf.WriteString(" ON CONFLICT ")
-	if len(inf) > 0 {
-		upsert.Dirty = false
-		if p.Content.Valid {
-			if upsert.Dirty {
-				if _, err := upsert.WriteString(", "); err != nil {
-					return "", nil, err
-				}
-			}
-			if _, err := upsert.WriteString(TableCategoryColumnContent); err != nil {
-				return "", nil, err
-			}
-			if _, err := upsert.WriteString("="); err != nil {
-				return "", nil, err
-			}
-			if err := upsert.WritePlaceholder(); err != nil {
-				return "", nil, err
-			}
-			upsert.Add(p.Content)
-			upsert.Dirty = true
-
-		}
-		if p.CreatedAt.Valid {
-			if upsert.Dirty {
-				if _, err := upsert.WriteString(", "); err != nil {
-					return "", nil, err
-				}
-			}
-			if _, err := upsert.WriteString(TableCategoryColumnCreatedAt); err != nil {
-				return "", nil, err
-			}
-			if _, err := upsert.WriteString("="); err != nil {
-				return "", nil, err
-			}
-			if err := upsert.WritePlaceholder(); err != nil {
-				return "", nil, err
-			}
-			upsert.Add(p.CreatedAt)
-			upsert.Dirty = true
-
-		}
-		if p.Name.Valid {
-			if upsert.Dirty {
-				if _, err := upsert.WriteString(", "); err != nil {
-					return "", nil, err
-				}
-			}
-			if _, err := upsert.WriteString(TableCategoryColumnName); err != nil {
-				return "", nil, err
-			}
-			if _, err := upsert.WriteString("="); err != nil {
-				return "", nil, err
-			}
-			if err := upsert.WritePlaceholder(); err != nil {
-				return "", nil, err
-			}
-			upsert.Add(p.Name)
-			upsert.Dirty = true
-
-		}
-		if p.ParentID.Valid {
-			if upsert.Dirty {
-				if _, err := upsert.WriteString(", "); err != nil {
-					return "", nil, err
-				}
-			}
-			if _, err := upsert.WriteString(TableCategoryColumnParentID); err != nil {
-				return "", nil, err
-			}
-			if _, err := upsert.WriteString("="); err != nil {
-				return "", nil, err
-			}
-			if err := upsert.WritePlaceholder(); err != nil {
-				return "", nil, err
-			}
-			upsert.Add(p.ParentID)
-			upsert.Dirty = true
 
-		}
-		if p.UpdatedAt.Valid {
-			if upsert.Dirty {
-				if _, err := upsert.WriteString(", "); err != nil {
-					return "", nil, err
-				}
-			}
-			if _, err := upsert.WriteString(TableCategoryColumnUpdatedAt); err != nil {
-				return "", nil, err
-			}
-			if _, err := upsert.WriteString("="); err != nil {
-				return "", nil, err
-			}
-			if err := upsert.WritePlaceholder(); err != nil {
-				return "", nil, err
-			}
-			upsert.Add(p.UpdatedAt)
-			upsert.Dirty = true
+	var tmp []string
+	var srcs string
 
-		} else {
-			if upsert.Dirty {
-				if _, err := upsert.WriteString(", "); err != nil {
-					return "", nil, err
-				}
-			}
-			if _, err := upsert.WriteString(TableCategoryColumnUpdatedAt); err != nil {
-				return "", nil, err
-			}
-			if _, err := upsert.WriteString("=NOW()"); err != nil {
-				return "", nil, err
-			}
-			upsert.Dirty = true
-		}
-	}
-	if len(inf) > 0 && upsert.Dirty {
-		buf.WriteString("(")
-		for j, i := range inf {
-			if j != 0 {
-				buf.WriteString(", ")
-			}
-			buf.WriteString(i)
-		}
-		buf.WriteString(")")
-		buf.WriteString(" DO UPDATE SET ")
-		buf.ReadFrom(upsert)
-	} else {
-		buf.WriteString(" DO NOTHING ")
-	}
-	if upsert.Dirty {
-		buf.WriteString(" RETURNING ")
-		if len(r.Columns) > 0 {
-			buf.WriteString(strings.Join(r.Columns, ", "))
-		} else {
-			buf.WriteString("content, created_at, id, name, parent_id, updated_at")
-		}
+	switch t := src.(type) {
+	case []byte:
+		srcs = string(t)
+	case string:
+		srcs = t
+	default:
+		return fmt.Errorf("expected slice of bytes or string as a source argument in Scan, not %T", src)
 	}
-	return buf.String(), upsert.Args(), nil
-}
 
-func (r *CategoryRepositoryBase) upsert(ctx context.Context, tx *sql.Tx, e *CategoryEntity, p *CategoryPatch, inf ...string) (*CategoryEntity, error) {
-	query, args, err := r.UpsertQuery(e, p, inf...)
-	if err != nil {
-		return nil, err
-	}
+	l := len(srcs)
 
-	var row *sql.Row
-	if tx == nil {
-		row = r.DB.QueryRowContext(ctx, query, args...)
-	} else {
-		row = tx.QueryRowContext(ctx, query, args...)
-	}
-	err = row.Scan(
-		&e.Content,
-		&e.CreatedAt,
-		&e.ID,
-		&e.Name,
-		&e.ParentID,
-		&e.UpdatedAt,
-	)
-	if r.Log != nil {
-		if tx == nil {
-			r.Log(err, TableCategory, "upsert", query, args...)
-		} else {
-			r.Log(err, TableCategory, "upsert tx", query, args...)
-		}
-	}
-	if err != nil {
-		return nil, err
+	if l < 2 {
+		return fmt.Errorf("expected to get source argument in format '[1,2,...,N]', but got %s", srcs)
 	}
-	return e, nil
-}
 
-func (r *CategoryRepositoryBase) Upsert(ctx context.Context, e *CategoryEntity, p *CategoryPatch, inf ...string) (*CategoryEntity, error) {
-	return r.upsert(ctx, nil, e, p, inf...)
-}
-
-func (r *CategoryRepositoryBase) count(ctx context.Context, tx *sql.Tx, exp *CategoryCountExpr) (int64, error) {
-	query, args, err := r.FindQuery(&CategoryFindExpr{
-		Where:   exp.Where,
-		Columns: []string{"COUNT(*)"},
-	})
-	if err != nil {
-		return 0, err
-	}
-	var count int64
-	if tx == nil {
-		err = r.DB.QueryRowContext(ctx, query, args...).Scan(&count)
-	} else {
-		err = tx.QueryRowContext(ctx, query, args...).Scan(&count)
-	}
-	if r.Log != nil {
-		if tx == nil {
-			r.Log(err, TableCategory, "count", query, args...)
-		} else {
-			r.Log(err, TableCategory, "count tx", query, args...)
-		}
-	}
-	if err != nil {
-		return 0, err
+	if l == 2 {
+		*a = make(JSONArrayInt64, 0)
+		return nil
 	}
-	return count, nil
-}
-
-func (r *CategoryRepositoryBase) Count(ctx context.Context, exp *CategoryCountExpr) (int64, error) {
-	return r.count(ctx, nil, exp)
-}
 
-func (r *CategoryRepositoryBase) deleteOneByID(ctx context.Context, tx *sql.Tx, pk int64) (int64, error) {
-	find := NewComposer(6)
-	find.WriteString("DELETE FROM ")
-	find.WriteString(TableCategory)
-	find.WriteString(" WHERE ")
-	find.WriteString(TableCategoryColumnID)
-	find.WriteString("=")
-	find.WritePlaceholder()
-	find.Add(pk)
-	var (
-		err error
-		res sql.Result
-	)
-	if tx == nil {
-		res, err = r.DB.ExecContext(ctx, find.String(), find.Args()...)
-	} else {
-		res, err = tx.ExecContext(ctx, find.String(), find.Args()...)
-	}
-	if err != nil {
-		return 0, err
+	if string(srcs[0]) != jsonArrayBeginningChar || string(srcs[l-1]) != jsonArrayEndChar {
+		return fmt.Errorf("expected to get source argument in format '[1,2,...,N]', but got %s", srcs)
 	}
 
-	return res.RowsAffected()
-}
-
-func (r *CategoryRepositoryBase) DeleteOneByID(ctx context.Context, pk int64) (int64, error) {
-	return r.deleteOneByID(ctx, nil, pk)
-}
-
-type CategoryRepositoryBaseTx struct {
-	base *CategoryRepositoryBase
-	tx   *sql.Tx
-}
-
-func (r CategoryRepositoryBaseTx) Commit() error {
-	return r.tx.Commit()
-}
-
-func (r CategoryRepositoryBaseTx) Rollback() error {
-	return r.tx.Rollback()
-}
-
-func (r *CategoryRepositoryBaseTx) Insert(ctx context.Context, e *CategoryEntity) (*CategoryEntity, error) {
-	return r.base.insert(ctx, r.tx, e)
-}
-
-func (r *CategoryRepositoryBaseTx) Find(ctx context.Context, fe *CategoryFindExpr) ([]*CategoryEntity, error) {
-	return r.base.find(ctx, r.tx, fe)
-}
-
-func (r *CategoryRepositoryBaseTx) FindIter(ctx context.Context, fe *CategoryFindExpr) (*CategoryIterator, error) {
-	return r.base.findIter(ctx, r.tx, fe)
-}
-
-func (r *CategoryRepositoryBaseTx) FindOneByID(ctx context.Context, pk int64) (*CategoryEntity, error) {
-	return r.base.findOneByID(ctx, r.tx, pk)
-}
-
-func (r *CategoryRepositoryBaseTx) UpdateOneByID(ctx context.Context, pk int64, p *CategoryPatch) (*CategoryEntity, error) {
-	return r.base.updateOneByID(ctx, r.tx, pk, p)
-}
-
-func (r *CategoryRepositoryBaseTx) Upsert(ctx context.Context, e *CategoryEntity, p *CategoryPatch, inf ...string) (*CategoryEntity, error) {
-	return r.base.upsert(ctx, r.tx, e, p, inf...)
-}
-
-func (r *CategoryRepositoryBaseTx) Count(ctx context.Context, exp *CategoryCountExpr) (int64, error) {
-	return r.base.count(ctx, r.tx, exp)
-}
-
-func (r *CategoryRepositoryBaseTx) DeleteOneByID(ctx context.Context, pk int64) (int64, error) {
-	return r.base.deleteOneByID(ctx, r.tx, pk)
-}
-
-const (
-	TablePackageConstraintPrimaryKey           = "example.package_id_pkey"
-	TablePackageConstraintCategoryIDForeignKey = "example.package_category_id_fkey"
-)
-
-const (
-	TablePackage                 = "example.package"
-	TablePackageColumnBreak      = "break"
-	TablePackageColumnCategoryID = "category_id"
-	TablePackageColumnCreatedAt  = "created_at"
-	TablePackageColumnID         = "id"
-	TablePackageColumnUpdatedAt  = "updated_at"
-)
-
-var TablePackageColumns = []string{
-	TablePackageColumnBreak,
-	TablePackageColumnCategoryID,
-	TablePackageColumnCreatedAt,
-	TablePackageColumnID,
-	TablePackageColumnUpdatedAt,
-}
-
-// PackageEntity ...
-type PackageEntity struct {
-	// Break ...
-	Break sql.NullString
-	// CategoryID ...
-	CategoryID sql.NullInt64
-	// CreatedAt ...
-	CreatedAt time.Time
-	// ID ...
-	ID int64
-	// UpdatedAt ...
-	UpdatedAt pq.NullTime
-	// Category ...
-	Category *CategoryEntity
-}
-
-func (e *PackageEntity) Prop(cn string) (interface{}, bool) {
-	switch cn {
-
-	case TablePackageColumnBreak:
-		return &e.Break, true
-	case TablePackageColumnCategoryID:
-		return &e.CategoryID, true
-	case TablePackageColumnCreatedAt:
-		return &e.CreatedAt, true
-	case TablePackageColumnID:
-		return &e.ID, true
-	case TablePackageColumnUpdatedAt:
-		return &e.UpdatedAt, true
-	default:
-		return nil, false
-	}
-}
-
-func (e *PackageEntity) Props(cns ...string) ([]interface{}, error) {
-	if len(cns) == 0 {
-		cns = TablePackageColumns
-	}
-	res := make([]interface{}, 0, len(cns))
-	for _, cn := range cns {
-		if prop, ok := e.Prop(cn); ok {
-			res = append(res, prop)
-		} else {
-			return nil, fmt.Errorf("unexpected column provided: %s", cn)
-		}
-	}
-	return res, nil
-}
-
-// ScanPackageRows helps to scan rows straight to the slice of entities.
-func ScanPackageRows(rows Rows) (entities []*PackageEntity, err error) {
-	for rows.Next() {
-		var ent PackageEntity
-		err = rows.Scan(
-			&ent.Break,
-			&ent.CategoryID,
-			&ent.CreatedAt,
-			&ent.ID,
-			&ent.UpdatedAt,
-		)
-		if err != nil {
-			return
-		}
-
-		entities = append(entities, &ent)
-	}
-	if err = rows.Err(); err != nil {
-		return
-	}
-
-	return
-}
-
-// PackageIterator is not thread safe.
-type PackageIterator struct {
-	rows Rows
-	cols []string
-	expr *PackageFindExpr
-}
-
-func (i *PackageIterator) Next() bool {
-	return i.rows.Next()
-}
-
-func (i *PackageIterator) Close() error {
-	return i.rows.Close()
-}
-
-func (i *PackageIterator) Err() error {
-	return i.rows.Err()
-}
-
-// Columns is wrapper around sql.Rows.Columns method, that also cache output inside iterator.
-func (i *PackageIterator) Columns() ([]string, error) {
-	if i.cols == nil {
-		cols, err := i.rows.Columns()
-		if err != nil {
-			return nil, err
-		}
-		i.cols = cols
-	}
-	return i.cols, nil
-}
-
-// Ent is wrapper around Package method that makes iterator more generic.
-func (i *PackageIterator) Ent() (interface{}, error) {
-	return i.Package()
-}
-
-func (i *PackageIterator) Package() (*PackageEntity, error) {
-	var ent PackageEntity
-	cols, err := i.Columns()
-	if err != nil {
-		return nil, err
-	}
-
-	props, err := ent.Props(cols...)
-	if err != nil {
-		return nil, err
-	}
-	var prop []interface{}
-	if i.expr.JoinCategory != nil && i.expr.JoinCategory.Kind.Actionable() && i.expr.JoinCategory.Fetch {
-		ent.Category = &CategoryEntity{}
-		if prop, err = ent.Category.Props(); err != nil {
-			return nil, err
-		}
-		props = append(props, prop...)
-	}
-	if err := i.rows.Scan(props...); err != nil {
-		return nil, err
-	}
-	return &ent, nil
-}
-
-type PackageCriteria struct {
-	Break                  sql.NullString
-	CategoryID             sql.NullInt64
-	CreatedAt              pq.NullTime
-	ID                     sql.NullInt64
-	UpdatedAt              pq.NullTime
-	operator               string
-	child, sibling, parent *PackageCriteria
-}
-
-func PackageOperand(operator string, operands ...*PackageCriteria) *PackageCriteria {
-	if len(operands) == 0 {
-		return &PackageCriteria{operator: operator}
-	}
-
-	parent := &PackageCriteria{
-		operator: operator,
-		child:    operands[0],
-	}
-
-	for i := 0; i < len(operands); i++ {
-		if i < len(operands)-1 {
-			operands[i].sibling = operands[i+1]
-		}
-		operands[i].parent = parent
-	}
-
-	return parent
-}
-
-func PackageOr(operands ...*PackageCriteria) *PackageCriteria {
-	return PackageOperand("OR", operands...)
-}
-
-func PackageAnd(operands ...*PackageCriteria) *PackageCriteria {
-	return PackageOperand("AND", operands...)
-}
-
-type PackageFindExpr struct {
-	Where         *PackageCriteria
-	Offset, Limit int64
-	Columns       []string
-	OrderBy       []RowOrder
-	JoinCategory  *CategoryJoin
-}
-
-type PackageJoin struct {
-	On, Where    *PackageCriteria
-	Fetch        bool
-	Kind         JoinType
-	JoinCategory *CategoryJoin
-}
-
-type PackageCountExpr struct {
-	Where        *PackageCriteria
-	JoinCategory *CategoryJoin
-}
-
-type PackagePatch struct {
-	Break      sql.NullString
-	CategoryID sql.NullInt64
-	CreatedAt  pq.NullTime
-	UpdatedAt  pq.NullTime
-}
-
-type PackageRepositoryBase struct {
-	Table   string
-	Columns []string
-	DB      *sql.DB
-	Log     LogFunc
-}
-
-func (r *PackageRepositoryBase) Tx(tx *sql.Tx) (*PackageRepositoryBaseTx, error) {
-	return &PackageRepositoryBaseTx{
-		base: r,
-		tx:   tx,
-	}, nil
-}
-
-func (r *PackageRepositoryBase) BeginTx(ctx context.Context) (*PackageRepositoryBaseTx, error) {
-	tx, err := r.DB.BeginTx(ctx, nil)
-	if err != nil {
-		return nil, err
-	}
-	return r.Tx(tx)
-}
-
-func (r PackageRepositoryBase) RunInTransaction(ctx context.Context, fn func(rtx *PackageRepositoryBaseTx) error, attempts int) (err error) {
-	return RunInTransaction(r.DB, ctx, func(tx *sql.Tx) error {
-		rtx, err := r.Tx(tx)
+	tmp = strings.Split(string(srcs[1:l-1]), jsonArraySeparator)
+	*a = make(JSONArrayInt64, 0, len(tmp))
+	for i, v := range tmp {
+		j, err := strconv.ParseInt(v, 10, 64)
 		if err != nil {
-			return err
-		}
-		return fn(rtx)
-	}, attempts)
-}
-
-func (r *PackageRepositoryBase) InsertQuery(e *PackageEntity, read bool) (string, []interface{}, error) {
-	insert := NewComposer(5)
-	columns := bytes.NewBuffer(nil)
-	buf := bytes.NewBufferString("INSERT INTO ")
-	buf.WriteString(r.Table)
-
-	if e.Break.Valid {
-		if columns.Len() > 0 {
-			if _, err := columns.WriteString(", "); err != nil {
-				return "", nil, err
-			}
-		}
-		if _, err := columns.WriteString(TablePackageColumnBreak); err != nil {
-			return "", nil, err
-		}
-		if insert.Dirty {
-			if _, err := insert.WriteString(", "); err != nil {
-				return "", nil, err
-			}
-		}
-		if err := insert.WritePlaceholder(); err != nil {
-			return "", nil, err
-		}
-		insert.Add(e.Break)
-		insert.Dirty = true
-	}
-
-	if e.CategoryID.Valid {
-		if columns.Len() > 0 {
-			if _, err := columns.WriteString(", "); err != nil {
-				return "", nil, err
-			}
-		}
-		if _, err := columns.WriteString(TablePackageColumnCategoryID); err != nil {
-			return "", nil, err
-		}
-		if insert.Dirty {
-			if _, err := insert.WriteString(", "); err != nil {
-				return "", nil, err
-			}
-		}
-		if err := insert.WritePlaceholder(); err != nil {
-			return "", nil, err
-		}
-		insert.Add(e.CategoryID)
-		insert.Dirty = true
-	}
-
-	if !e.CreatedAt.IsZero() {
-		if columns.Len() > 0 {
-			if _, err := columns.WriteString(", "); err != nil {
-				return "", nil, err
-			}
-		}
-		if _, err := columns.WriteString(TablePackageColumnCreatedAt); err != nil {
-			return "", nil, err
-		}
-		if insert.Dirty {
-			if _, err := insert.WriteString(", "); err != nil {
-				return "", nil, err
-			}
-		}
-		if err := insert.WritePlaceholder(); err != nil {
-			return "", nil, err
-		}
-		insert.Add(e.CreatedAt)
-		insert.Dirty = true
-	}
-
-	if e.UpdatedAt.Valid {
-		if columns.Len() > 0 {
-			if _, err := columns.WriteString(", "); err != nil {
-				return "", nil, err
-			}
-		}
-		if _, err := columns.WriteString(TablePackageColumnUpdatedAt); err != nil {
-			return "", nil, err
-		}
-		if insert.Dirty {
-			if _, err := insert.WriteString(", "); err != nil {
-				return "", nil, err
-			}
-		}
-		if err := insert.WritePlaceholder(); err != nil {
-			return "", nil, err
-		}
-		insert.Add(e.UpdatedAt)
-		insert.Dirty = true
-	}
-
-	if columns.Len() > 0 {
-		buf.WriteString(" (")
-		buf.ReadFrom(columns)
-		buf.WriteString(") VALUES (")
-		buf.ReadFrom(insert)
-		buf.WriteString(") ")
-		if read {
-			buf.WriteString("RETURNING ")
-			if len(r.Columns) > 0 {
-				buf.WriteString(strings.Join(r.Columns, ", "))
-			} else {
-				buf.WriteString("break, category_id, created_at, id, updated_at")
-			}
-		}
-	}
-	return buf.String(), insert.Args(), nil
-}
-
-func (r *PackageRepositoryBase) insert(ctx context.Context, tx *sql.Tx, e *PackageEntity) (*PackageEntity, error) {
-	query, args, err := r.InsertQuery(e, true)
-	if err != nil {
-		return nil, err
-	}
-
-	var row *sql.Row
-	if tx == nil {
-		row = r.DB.QueryRowContext(ctx, query, args...)
-	} else {
-		row = tx.QueryRowContext(ctx, query, args...)
-	}
-	err = row.Scan(
-		&e.Break,
-		&e.CategoryID,
-		&e.CreatedAt,
-		&e.ID,
-		&e.UpdatedAt,
-	)
-	if r.Log != nil {
-		if tx == nil {
-			r.Log(err, TablePackage, "insert", query, args...)
-		} else {
-			r.Log(err, TablePackage, "insert tx", query, args...)
-		}
-	}
-	if err != nil {
-		return nil, err
-	}
-	return e, nil
-}
-
-func (r *PackageRepositoryBase) Insert(ctx context.Context, e *PackageEntity) (*PackageEntity, error) {
-	return r.insert(ctx, nil, e)
-}
-
-func PackageCriteriaWhereClause(comp *Composer, c *PackageCriteria, id int) error {
-	if c.child == nil {
-		return _PackageCriteriaWhereClause(comp, c, id)
-	}
-	node := c
-	sibling := false
-	for {
-		if !sibling {
-			if node.child != nil {
-				if node.parent != nil {
-					comp.WriteString("(")
-				}
-				node = node.child
-				continue
-			} else {
-				comp.Dirty = false
-				comp.WriteString("(")
-				if err := _PackageCriteriaWhereClause(comp, node, id); err != nil {
-					return err
-				}
-				comp.WriteString(")")
-			}
-		}
-		if node.sibling != nil {
-			sibling = false
-			comp.WriteString(" ")
-			comp.WriteString(node.parent.operator)
-			comp.WriteString(" ")
-			node = node.sibling
-			continue
-		}
-		if node.parent != nil {
-			sibling = true
-			if node.parent.parent != nil {
-				comp.WriteString(")")
-			}
-			node = node.parent
-			continue
-		}
-
-		break
-	}
-	return nil
-}
-
-func _PackageCriteriaWhereClause(comp *Composer, c *PackageCriteria, id int) error {
-	if c.Break.Valid {
-		if comp.Dirty {
-			comp.WriteString(" AND ")
-		}
-		if err := comp.WriteAlias(id); err != nil {
-			return err
-		}
-		if _, err := comp.WriteString(TablePackageColumnBreak); err != nil {
-			return err
-		}
-		if _, err := comp.WriteString("="); err != nil {
-			return err
-		}
-		if err := comp.WritePlaceholder(); err != nil {
-			return err
-		}
-		comp.Add(c.Break)
-		comp.Dirty = true
-	}
-	if c.CategoryID.Valid {
-		if comp.Dirty {
-			comp.WriteString(" AND ")
-		}
-		if err := comp.WriteAlias(id); err != nil {
-			return err
-		}
-		if _, err := comp.WriteString(TablePackageColumnCategoryID); err != nil {
-			return err
-		}
-		if _, err := comp.WriteString("="); err != nil {
-			return err
-		}
-		if err := comp.WritePlaceholder(); err != nil {
-			return err
-		}
-		comp.Add(c.CategoryID)
-		comp.Dirty = true
-	}
-	if c.CreatedAt.Valid {
-		if comp.Dirty {
-			comp.WriteString(" AND ")
-		}
-		if err := comp.WriteAlias(id); err != nil {
-			return err
-		}
-		if _, err := comp.WriteString(TablePackageColumnCreatedAt); err != nil {
-			return err
-		}
-		if _, err := comp.WriteString("="); err != nil {
-			return err
-		}
-		if err := comp.WritePlaceholder(); err != nil {
-			return err
-		}
-		comp.Add(c.CreatedAt)
-		comp.Dirty = true
-	}
-	// id is an empty struct, ignore
-
-	if c.UpdatedAt.Valid {
-		if comp.Dirty {
-			comp.WriteString(" AND ")
-		}
-		if err := comp.WriteAlias(id); err != nil {
-			return err
-		}
-		if _, err := comp.WriteString(TablePackageColumnUpdatedAt); err != nil {
-			return err
-		}
-		if _, err := comp.WriteString("="); err != nil {
-			return err
-		}
-		if err := comp.WritePlaceholder(); err != nil {
-			return err
-		}
-		comp.Add(c.UpdatedAt)
-		comp.Dirty = true
-	}
-	return nil
-}
-
-func (r *PackageRepositoryBase) FindQuery(fe *PackageFindExpr) (string, []interface{}, error) {
-	comp := NewComposer(5)
-	buf := bytes.NewBufferString("SELECT ")
-	if len(fe.Columns) == 0 {
-		buf.WriteString("t0.break, t0.category_id, t0.created_at, t0.id, t0.updated_at")
-	} else {
-		buf.WriteString(strings.Join(fe.Columns, ", "))
-	}
-	if fe.JoinCategory != nil && fe.JoinCategory.Kind.Actionable() && fe.JoinCategory.Fetch {
-		buf.WriteString(", t1.content, t1.created_at, t1.id, t1.name, t1.parent_id, t1.updated_at")
-	}
-	buf.WriteString(" FROM ")
-	buf.WriteString(r.Table)
-	buf.WriteString(" AS t0")
-	if fe.JoinCategory != nil && fe.JoinCategory.Kind.Actionable() {
-		joinClause(comp, fe.JoinCategory.Kind, "example.category AS t1 ON t0.category_id=t1.id")
-		if fe.JoinCategory.On != nil {
-			comp.Dirty = true
-			if err := CategoryCriteriaWhereClause(comp, fe.JoinCategory.On, 1); err != nil {
-				return "", nil, err
-			}
-		}
-	}
-	if comp.Dirty {
-		buf.ReadFrom(comp)
-		comp.Dirty = false
-	}
-	if fe.Where != nil {
-		if err := PackageCriteriaWhereClause(comp, fe.Where, 0); err != nil {
-			return "", nil, err
-		}
-	}
-	if fe.JoinCategory != nil && fe.JoinCategory.Kind.Actionable() && fe.JoinCategory.Where != nil {
-		if err := CategoryCriteriaWhereClause(comp, fe.JoinCategory.Where, 1); err != nil {
-			return "", nil, err
-		}
-	}
-	if comp.Dirty {
-		if _, err := buf.WriteString(" WHERE "); err != nil {
-			return "", nil, err
-		}
-		buf.ReadFrom(comp)
-	}
-
-	if len(fe.OrderBy) > 0 {
-		i := 0
-		for _, order := range fe.OrderBy {
-			for _, columnName := range TablePackageColumns {
-				if order.Name == columnName {
-					if i == 0 {
-						comp.WriteString(" ORDER BY ")
-					}
-					if i > 0 {
-						if _, err := comp.WriteString(", "); err != nil {
-							return "", nil, err
-						}
-					}
-					if _, err := comp.WriteString(order.Name); err != nil {
-						return "", nil, err
-					}
-					if order.Descending {
-						if _, err := comp.WriteString(" DESC"); err != nil {
-							return "", nil, err
-						}
-					}
-					i++
-					break
-				}
-			}
-		}
-	}
-	if fe.Offset > 0 {
-		if _, err := comp.WriteString(" OFFSET "); err != nil {
-			return "", nil, err
-		}
-		if err := comp.WritePlaceholder(); err != nil {
-			return "", nil, err
-		}
-		if _, err := comp.WriteString(" "); err != nil {
-			return "", nil, err
-		}
-		comp.Add(fe.Offset)
-	}
-	if fe.Limit > 0 {
-		if _, err := comp.WriteString(" LIMIT "); err != nil {
-			return "", nil, err
-		}
-		if err := comp.WritePlaceholder(); err != nil {
-			return "", nil, err
-		}
-		if _, err := comp.WriteString(" "); err != nil {
-			return "", nil, err
-		}
-		comp.Add(fe.Limit)
-	}
-
-	buf.ReadFrom(comp)
-
-	return buf.String(), comp.Args(), nil
-}
-
-func (r *PackageRepositoryBase) find(ctx context.Context, tx *sql.Tx, fe *PackageFindExpr) ([]*PackageEntity, error) {
-	query, args, err := r.FindQuery(fe)
-	if err != nil {
-		return nil, err
-	}
-	var rows *sql.Rows
-	if tx == nil {
-		rows, err = r.DB.QueryContext(ctx, query, args...)
-	} else {
-		rows, err = tx.QueryContext(ctx, query, args...)
-	}
-	if r.Log != nil {
-		if tx == nil {
-			r.Log(err, TablePackage, "find", query, args...)
-		} else {
-			r.Log(err, TablePackage, "find tx", query, args...)
-		}
-	}
-	if err != nil {
-		return nil, err
-	}
-	defer rows.Close()
-	var (
-		entities []*PackageEntity
-		props    []interface{}
-	)
-	for rows.Next() {
-		var ent PackageEntity
-		if props, err = ent.Props(); err != nil {
-			return nil, err
-		}
-		var prop []interface{}
-		if fe.JoinCategory != nil && fe.JoinCategory.Kind.Actionable() && fe.JoinCategory.Fetch {
-			ent.Category = &CategoryEntity{}
-			if prop, err = ent.Category.Props(); err != nil {
-				return nil, err
-			}
-			props = append(props, prop...)
-		}
-		err = rows.Scan(props...)
-		if err != nil {
-			return nil, err
-		}
-
-		entities = append(entities, &ent)
-	}
-	err = rows.Err()
-	if r.Log != nil {
-		r.Log(err, TablePackage, "find", query, args...)
-	}
-	if err != nil {
-		return nil, err
-	}
-	return entities, nil
-}
-
-func (r *PackageRepositoryBase) Find(ctx context.Context, fe *PackageFindExpr) ([]*PackageEntity, error) {
-	return r.find(ctx, nil, fe)
-}
-
-func (r *PackageRepositoryBase) findIter(ctx context.Context, tx *sql.Tx, fe *PackageFindExpr) (*PackageIterator, error) {
-	query, args, err := r.FindQuery(fe)
-	if err != nil {
-		return nil, err
-	}
-	var rows *sql.Rows
-	if tx == nil {
-		rows, err = r.DB.QueryContext(ctx, query, args...)
-	} else {
-		rows, err = tx.QueryContext(ctx, query, args...)
-	}
-	if r.Log != nil {
-		if tx == nil {
-			r.Log(err, TablePackage, "find iter", query, args...)
-		} else {
-			r.Log(err, TablePackage, "find iter tx", query, args...)
-		}
-	}
-	if err != nil {
-		return nil, err
-	}
-	return &PackageIterator{
-		rows: rows,
-		expr: fe,
-		cols: fe.Columns,
-	}, nil
-}
-
-func (r *PackageRepositoryBase) FindIter(ctx context.Context, fe *PackageFindExpr) (*PackageIterator, error) {
-	return r.findIter(ctx, nil, fe)
-}
-
-func (r *PackageRepositoryBase) findOneByID(ctx context.Context, tx *sql.Tx, pk int64) (*PackageEntity, error) {
-	find := NewComposer(5)
-	find.WriteString("SELECT ")
-	if len(r.Columns) == 0 {
-		find.WriteString("break, category_id, created_at, id, updated_at")
-	} else {
-		find.WriteString(strings.Join(r.Columns, ", "))
-	}
-	find.WriteString(" FROM ")
-	find.WriteString(TablePackage)
-	find.WriteString(" WHERE ")
-	find.WriteString(TablePackageColumnID)
-	find.WriteString("=")
-	find.WritePlaceholder()
-	find.Add(pk)
-	var (
-		ent PackageEntity
-	)
-	props, err := ent.Props(r.Columns...)
-	if err != nil {
-		return nil, err
-	}
-	if tx == nil {
-		err = r.DB.QueryRowContext(ctx, find.String(), find.Args()...).Scan(props...)
-	} else {
-		err = tx.QueryRowContext(ctx, find.String(), find.Args()...).Scan(props...)
-	}
-	if r.Log != nil {
-		if tx == nil {
-			r.Log(err, TablePackage, "find by primary key", find.String(), find.Args()...)
-		} else {
-			r.Log(err, TablePackage, "find by primary key tx", find.String(), find.Args()...)
-		}
-	}
-	if err != nil {
-		return nil, err
-	}
-	return &ent, nil
-}
-
-func (r *PackageRepositoryBase) FindOneByID(ctx context.Context, pk int64) (*PackageEntity, error) {
-	return r.findOneByID(ctx, nil, pk)
-}
-
-func (r *PackageRepositoryBase) UpdateOneByIDQuery(pk int64, p *PackagePatch) (string, []interface{}, error) {
-	buf := bytes.NewBufferString("UPDATE ")
-	buf.WriteString(r.Table)
-	update := NewComposer(5)
-	if p.Break.Valid {
-		if update.Dirty {
-			if _, err := update.WriteString(", "); err != nil {
-				return "", nil, err
-			}
-		}
-		if _, err := update.WriteString(TablePackageColumnBreak); err != nil {
-			return "", nil, err
-		}
-		if _, err := update.WriteString("="); err != nil {
-			return "", nil, err
-		}
-		if err := update.WritePlaceholder(); err != nil {
-			return "", nil, err
-		}
-		update.Add(p.Break)
-		update.Dirty = true
-
-	}
-	if p.CategoryID.Valid {
-		if update.Dirty {
-			if _, err := update.WriteString(", "); err != nil {
-				return "", nil, err
-			}
-		}
-		if _, err := update.WriteString(TablePackageColumnCategoryID); err != nil {
-			return "", nil, err
-		}
-		if _, err := update.WriteString("="); err != nil {
-			return "", nil, err
-		}
-		if err := update.WritePlaceholder(); err != nil {
-			return "", nil, err
-		}
-		update.Add(p.CategoryID)
-		update.Dirty = true
-
-	}
-	if p.CreatedAt.Valid {
-		if update.Dirty {
-			if _, err := update.WriteString(", "); err != nil {
-				return "", nil, err
-			}
-		}
-		if _, err := update.WriteString(TablePackageColumnCreatedAt); err != nil {
-			return "", nil, err
-		}
-		if _, err := update.WriteString("="); err != nil {
-			return "", nil, err
-		}
-		if err := update.WritePlaceholder(); err != nil {
-			return "", nil, err
-		}
-		update.Add(p.CreatedAt)
-		update.Dirty = true
-
-	}
-	if p.UpdatedAt.Valid {
-		if update.Dirty {
-			if _, err := update.WriteString(", "); err != nil {
-				return "", nil, err
-			}
-		}
-		if _, err := update.WriteString(TablePackageColumnUpdatedAt); err != nil {
-			return "", nil, err
-		}
-		if _, err := update.WriteString("="); err != nil {
-			return "", nil, err
-		}
-		if err := update.WritePlaceholder(); err != nil {
-			return "", nil, err
-		}
-		update.Add(p.UpdatedAt)
-		update.Dirty = true
-
-	} else {
-		if update.Dirty {
-			if _, err := update.WriteString(", "); err != nil {
-				return "", nil, err
-			}
-		}
-		if _, err := update.WriteString(TablePackageColumnUpdatedAt); err != nil {
-			return "", nil, err
-		}
-		if _, err := update.WriteString("=NOW()"); err != nil {
-			return "", nil, err
-		}
-		update.Dirty = true
-	}
-	if !update.Dirty {
-		return "", nil, errors.New("Package update failure, nothing to update")
-	}
-	buf.WriteString(" SET ")
-	buf.ReadFrom(update)
-	buf.WriteString(" WHERE ")
-
-	update.WriteString(TablePackageColumnID)
-	update.WriteString("=")
-	update.WritePlaceholder()
-	update.Add(pk)
-
-	buf.ReadFrom(update)
-	buf.WriteString(" RETURNING ")
-	if len(r.Columns) > 0 {
-		buf.WriteString(strings.Join(r.Columns, ", "))
-	} else {
-		buf.WriteString("break, category_id, created_at, id, updated_at")
-	}
-	return buf.String(), update.Args(), nil
-}
-
-func (r *PackageRepositoryBase) updateOneByID(ctx context.Context, tx *sql.Tx, pk int64, p *PackagePatch) (*PackageEntity, error) {
-	query, args, err := r.UpdateOneByIDQuery(pk, p)
-	if err != nil {
-		return nil, err
-	}
-	var ent PackageEntity
-	props, err := ent.Props(r.Columns...)
-	if err != nil {
-		return nil, err
-	}
-	if tx == nil {
-		err = r.DB.QueryRowContext(ctx, query, args...).Scan(props...)
-	} else {
-		err = tx.QueryRowContext(ctx, query, args...).Scan(props...)
-	}
-	if r.Log != nil {
-		if tx == nil {
-			r.Log(err, TablePackage, "update by primary key", query, args...)
-		} else {
-			r.Log(err, TablePackage, "update by primary key tx", query, args...)
-		}
-	}
-	if err != nil {
-		return nil, err
-	}
-	return &ent, nil
-}
-
-func (r *PackageRepositoryBase) UpdateOneByID(ctx context.Context, pk int64, p *PackagePatch) (*PackageEntity, error) {
-	return r.updateOneByID(ctx, nil, pk, p)
-}
-
-func (r *PackageRepositoryBase) FindOneByIDAndUpdate(ctx context.Context, pk int64, p *PackagePatch) (before, after *PackageEntity, err error) {
-	find := NewComposer(5)
-	find.WriteString("SELECT ")
-	if len(r.Columns) == 0 {
-		find.WriteString("break, category_id, created_at, id, updated_at")
-	} else {
-		find.WriteString(strings.Join(r.Columns, ", "))
-	}
-	find.WriteString(" FROM ")
-	find.WriteString(TablePackage)
-	find.WriteString(" WHERE ")
-	find.WriteString(TablePackageColumnID)
-	find.WriteString("=")
-	find.WritePlaceholder()
-	find.Add(pk)
-	find.WriteString(" FOR UPDATE")
-	query, args, err := r.UpdateOneByIDQuery(pk, p)
-	if err != nil {
-		return
-	}
-	var (
-		oldEnt, newEnt PackageEntity
-	)
-	oldProps, err := oldEnt.Props(r.Columns...)
-	if err != nil {
-		return
-	}
-	newProps, err := newEnt.Props(r.Columns...)
-	if err != nil {
-		return
-	}
-	tx, err := r.DB.Begin()
-	if err != nil {
-		return
-	}
-	err = tx.QueryRowContext(ctx, find.String(), find.Args()...).Scan(oldProps...)
-	if r.Log != nil {
-		r.Log(err, TablePackage, "find by primary key", find.String(), find.Args()...)
-	}
-	if err != nil {
-		tx.Rollback()
-		return
-	}
-	err = tx.QueryRowContext(ctx, query, args...).Scan(newProps...)
-	if r.Log != nil {
-		r.Log(err, TablePackage, "update by primary key", query, args...)
-	}
-	if err != nil {
-		tx.Rollback()
-		return
-	}
-	err = tx.Commit()
-	if err != nil {
-		return
-	}
-	return &oldEnt, &newEnt, nil
-}
-
-func (r *PackageRepositoryBase) UpsertQuery(e *PackageEntity, p *PackagePatch, inf ...string) (string, []interface{}, error) {
-	upsert := NewComposer(10)
-	columns := bytes.NewBuffer(nil)
-	buf := bytes.NewBufferString("INSERT INTO ")
-	buf.WriteString(r.Table)
-
-	if e.Break.Valid {
-		if columns.Len() > 0 {
-			if _, err := columns.WriteString(", "); err != nil {
-				return "", nil, err
-			}
-		}
-		if _, err := columns.WriteString(TablePackageColumnBreak); err != nil {
-			return "", nil, err
-		}
-		if upsert.Dirty {
-			if _, err := upsert.WriteString(", "); err != nil {
-				return "", nil, err
-			}
-		}
-		if err := upsert.WritePlaceholder(); err != nil {
-			return "", nil, err
-		}
-		upsert.Add(e.Break)
-		upsert.Dirty = true
-	}
-
-	if e.CategoryID.Valid {
-		if columns.Len() > 0 {
-			if _, err := columns.WriteString(", "); err != nil {
-				return "", nil, err
-			}
-		}
-		if _, err := columns.WriteString(TablePackageColumnCategoryID); err != nil {
-			return "", nil, err
-		}
-		if upsert.Dirty {
-			if _, err := upsert.WriteString(", "); err != nil {
-				return "", nil, err
-			}
-		}
-		if err := upsert.WritePlaceholder(); err != nil {
-			return "", nil, err
-		}
-		upsert.Add(e.CategoryID)
-		upsert.Dirty = true
-	}
-
-	if !e.CreatedAt.IsZero() {
-		if columns.Len() > 0 {
-			if _, err := columns.WriteString(", "); err != nil {
-				return "", nil, err
-			}
-		}
-		if _, err := columns.WriteString(TablePackageColumnCreatedAt); err != nil {
-			return "", nil, err
-		}
-		if upsert.Dirty {
-			if _, err := upsert.WriteString(", "); err != nil {
-				return "", nil, err
-			}
-		}
-		if err := upsert.WritePlaceholder(); err != nil {
-			return "", nil, err
-		}
-		upsert.Add(e.CreatedAt)
-		upsert.Dirty = true
-	}
-
-	if e.UpdatedAt.Valid {
-		if columns.Len() > 0 {
-			if _, err := columns.WriteString(", "); err != nil {
-				return "", nil, err
-			}
-		}
-		if _, err := columns.WriteString(TablePackageColumnUpdatedAt); err != nil {
-			return "", nil, err
-		}
-		if upsert.Dirty {
-			if _, err := upsert.WriteString(", "); err != nil {
-				return "", nil, err
-			}
-		}
-		if err := upsert.WritePlaceholder(); err != nil {
-			return "", nil, err
-		}
-		upsert.Add(e.UpdatedAt)
-		upsert.Dirty = true
-	}
-
-	if upsert.Dirty {
-		buf.WriteString(" (")
-		buf.ReadFrom(columns)
-		buf.WriteString(") VALUES (")
-		buf.ReadFrom(upsert)
-		buf.WriteString(")")
-	}
-	buf.WriteString(" ON CONFLICT ")
-	if len(inf) > 0 {
-		upsert.Dirty = false
-		if p.Break.Valid {
-			if upsert.Dirty {
-				if _, err := upsert.WriteString(", "); err != nil {
-					return "", nil, err
-				}
-			}
-			if _, err := upsert.WriteString(TablePackageColumnBreak); err != nil {
-				return "", nil, err
-			}
-			if _, err := upsert.WriteString("="); err != nil {
-				return "", nil, err
-			}
-			if err := upsert.WritePlaceholder(); err != nil {
-				return "", nil, err
-			}
-			upsert.Add(p.Break)
-			upsert.Dirty = true
-
-		}
-		if p.CategoryID.Valid {
-			if upsert.Dirty {
-				if _, err := upsert.WriteString(", "); err != nil {
-					return "", nil, err
-				}
-			}
-			if _, err := upsert.WriteString(TablePackageColumnCategoryID); err != nil {
-				return "", nil, err
-			}
-			if _, err := upsert.WriteString("="); err != nil {
-				return "", nil, err
-			}
-			if err := upsert.WritePlaceholder(); err != nil {
-				return "", nil, err
-			}
-			upsert.Add(p.CategoryID)
-			upsert.Dirty = true
-
-		}
-		if p.CreatedAt.Valid {
-			if upsert.Dirty {
-				if _, err := upsert.WriteString(", "); err != nil {
-					return "", nil, err
-				}
-			}
-			if _, err := upsert.WriteString(TablePackageColumnCreatedAt); err != nil {
-				return "", nil, err
-			}
-			if _, err := upsert.WriteString("="); err != nil {
-				return "", nil, err
-			}
-			if err := upsert.WritePlaceholder(); err != nil {
-				return "", nil, err
-			}
-			upsert.Add(p.CreatedAt)
-			upsert.Dirty = true
-
-		}
-		if p.UpdatedAt.Valid {
-			if upsert.Dirty {
-				if _, err := upsert.WriteString(", "); err != nil {
-					return "", nil, err
-				}
-			}
-			if _, err := upsert.WriteString(TablePackageColumnUpdatedAt); err != nil {
-				return "", nil, err
-			}
-			if _, err := upsert.WriteString("="); err != nil {
-				return "", nil, err
-			}
-			if err := upsert.WritePlaceholder(); err != nil {
-				return "", nil, err
-			}
-			upsert.Add(p.UpdatedAt)
-			upsert.Dirty = true
-
-		} else {
-			if upsert.Dirty {
-				if _, err := upsert.WriteString(", "); err != nil {
-					return "", nil, err
-				}
-			}
-			if _, err := upsert.WriteString(TablePackageColumnUpdatedAt); err != nil {
-				return "", nil, err
-			}
-			if _, err := upsert.WriteString("=NOW()"); err != nil {
-				return "", nil, err
-			}
-			upsert.Dirty = true
-		}
-	}
-	if len(inf) > 0 && upsert.Dirty {
-		buf.WriteString("(")
-		for j, i := range inf {
-			if j != 0 {
-				buf.WriteString(", ")
-			}
-			buf.WriteString(i)
-		}
-		buf.WriteString(")")
-		buf.WriteString(" DO UPDATE SET ")
-		buf.ReadFrom(upsert)
-	} else {
-		buf.WriteString(" DO NOTHING ")
-	}
-	if upsert.Dirty {
-		buf.WriteString(" RETURNING ")
-		if len(r.Columns) > 0 {
-			buf.WriteString(strings.Join(r.Columns, ", "))
-		} else {
-			buf.WriteString("break, category_id, created_at, id, updated_at")
-		}
-	}
-	return buf.String(), upsert.Args(), nil
-}
-
-func (r *PackageRepositoryBase) upsert(ctx context.Context, tx *sql.Tx, e *PackageEntity, p *PackagePatch, inf ...string) (*PackageEntity, error) {
-	query, args, err := r.UpsertQuery(e, p, inf...)
-	if err != nil {
-		return nil, err
-	}
-
-	var row *sql.Row
-	if tx == nil {
-		row = r.DB.QueryRowContext(ctx, query, args...)
-	} else {
-		row = tx.QueryRowContext(ctx, query, args...)
-	}
-	err = row.Scan(
-		&e.Break,
-		&e.CategoryID,
-		&e.CreatedAt,
-		&e.ID,
-		&e.UpdatedAt,
-	)
-	if r.Log != nil {
-		if tx == nil {
-			r.Log(err, TablePackage, "upsert", query, args...)
-		} else {
-			r.Log(err, TablePackage, "upsert tx", query, args...)
-		}
-	}
-	if err != nil {
-		return nil, err
-	}
-	return e, nil
-}
-
-func (r *PackageRepositoryBase) Upsert(ctx context.Context, e *PackageEntity, p *PackagePatch, inf ...string) (*PackageEntity, error) {
-	return r.upsert(ctx, nil, e, p, inf...)
-}
-
-func (r *PackageRepositoryBase) count(ctx context.Context, tx *sql.Tx, exp *PackageCountExpr) (int64, error) {
-	query, args, err := r.FindQuery(&PackageFindExpr{
-		Where:   exp.Where,
-		Columns: []string{"COUNT(*)"},
-
-		JoinCategory: exp.JoinCategory,
-	})
-	if err != nil {
-		return 0, err
-	}
-	var count int64
-	if tx == nil {
-		err = r.DB.QueryRowContext(ctx, query, args...).Scan(&count)
-	} else {
-		err = tx.QueryRowContext(ctx, query, args...).Scan(&count)
-	}
-	if r.Log != nil {
-		if tx == nil {
-			r.Log(err, TablePackage, "count", query, args...)
-		} else {
-			r.Log(err, TablePackage, "count tx", query, args...)
-		}
-	}
-	if err != nil {
-		return 0, err
-	}
-	return count, nil
-}
-
-func (r *PackageRepositoryBase) Count(ctx context.Context, exp *PackageCountExpr) (int64, error) {
-	return r.count(ctx, nil, exp)
-}
-
-func (r *PackageRepositoryBase) deleteOneByID(ctx context.Context, tx *sql.Tx, pk int64) (int64, error) {
-	find := NewComposer(5)
-	find.WriteString("DELETE FROM ")
-	find.WriteString(TablePackage)
-	find.WriteString(" WHERE ")
-	find.WriteString(TablePackageColumnID)
-	find.WriteString("=")
-	find.WritePlaceholder()
-	find.Add(pk)
-	var (
-		err error
-		res sql.Result
-	)
-	if tx == nil {
-		res, err = r.DB.ExecContext(ctx, find.String(), find.Args()...)
-	} else {
-		res, err = tx.ExecContext(ctx, find.String(), find.Args()...)
-	}
-	if err != nil {
-		return 0, err
-	}
-
-	return res.RowsAffected()
-}
-
-func (r *PackageRepositoryBase) DeleteOneByID(ctx context.Context, pk int64) (int64, error) {
-	return r.deleteOneByID(ctx, nil, pk)
-}
-
-type PackageRepositoryBaseTx struct {
-	base *PackageRepositoryBase
-	tx   *sql.Tx
-}
-
-func (r PackageRepositoryBaseTx) Commit() error {
-	return r.tx.Commit()
-}
-
-func (r PackageRepositoryBaseTx) Rollback() error {
-	return r.tx.Rollback()
-}
-
-func (r *PackageRepositoryBaseTx) Insert(ctx context.Context, e *PackageEntity) (*PackageEntity, error) {
-	return r.base.insert(ctx, r.tx, e)
-}
-
-func (r *PackageRepositoryBaseTx) Find(ctx context.Context, fe *PackageFindExpr) ([]*PackageEntity, error) {
-	return r.base.find(ctx, r.tx, fe)
-}
-
-func (r *PackageRepositoryBaseTx) FindIter(ctx context.Context, fe *PackageFindExpr) (*PackageIterator, error) {
-	return r.base.findIter(ctx, r.tx, fe)
-}
-
-func (r *PackageRepositoryBaseTx) FindOneByID(ctx context.Context, pk int64) (*PackageEntity, error) {
-	return r.base.findOneByID(ctx, r.tx, pk)
-}
-
-func (r *PackageRepositoryBaseTx) UpdateOneByID(ctx context.Context, pk int64, p *PackagePatch) (*PackageEntity, error) {
-	return r.base.updateOneByID(ctx, r.tx, pk, p)
-}
-
-func (r *PackageRepositoryBaseTx) Upsert(ctx context.Context, e *PackageEntity, p *PackagePatch, inf ...string) (*PackageEntity, error) {
-	return r.base.upsert(ctx, r.tx, e, p, inf...)
-}
-
-func (r *PackageRepositoryBaseTx) Count(ctx context.Context, exp *PackageCountExpr) (int64, error) {
-	return r.base.count(ctx, r.tx, exp)
-}
-
-func (r *PackageRepositoryBaseTx) DeleteOneByID(ctx context.Context, pk int64) (int64, error) {
-	return r.base.deleteOneByID(ctx, r.tx, pk)
-}
-
-const (
-	TableNewsConstraintPrimaryKey      = "example.news_id_pkey"
-	TableNewsConstraintTitleUnique     = "example.news_title_key"
-	TableNewsConstraintTitleLeadUnique = "example.news_title_lead_key"
-)
-
-const (
-	TableNews                        = "example.news"
-	TableNewsColumnContent           = "content"
-	TableNewsColumnContinue          = "continue"
-	TableNewsColumnCreatedAt         = "created_at"
-	TableNewsColumnDay               = "day"
-	TableNewsColumnID                = "id"
-	TableNewsColumnLead              = "lead"
-	TableNewsColumnMetaData          = "meta_data"
-	TableNewsColumnScore             = "score"
-	TableNewsColumnTitle             = "title"
-	TableNewsColumnUpdatedAt         = "updated_at"
-	TableNewsColumnVersion           = "version"
-	TableNewsColumnViewsDistribution = "views_distribution"
-)
-
-var TableNewsColumns = []string{
-	TableNewsColumnContent,
-	TableNewsColumnContinue,
-	TableNewsColumnCreatedAt,
-	TableNewsColumnDay,
-	TableNewsColumnID,
-	TableNewsColumnLead,
-	TableNewsColumnMetaData,
-	TableNewsColumnScore,
-	TableNewsColumnTitle,
-	TableNewsColumnUpdatedAt,
-	TableNewsColumnVersion,
-	TableNewsColumnViewsDistribution,
-}
-
-// NewsEntity ...
-type NewsEntity struct {
-	// Content ...
-	Content string
-	// Continue ...
-	Continue bool
-	// CreatedAt ...
-	CreatedAt time.Time
-	// Day ...
-	Day pq.NullTime
-	// ID ...
-	ID int64
-	// Lead ...
-	Lead sql.NullString
-	// MetaData ...
-	MetaData []byte
-	// Score ...
-	Score float64
-	// Title ...
-	Title string
-	// UpdatedAt ...
-	UpdatedAt pq.NullTime
-	// Version ...
-	Version int64
-	// ViewsDistribution ...
-	ViewsDistribution NullFloat64Array
-	// CommentsByNewsTitle ...
-	CommentsByNewsTitle []*CommentEntity
-	// Comments ...
-	Comments []*CommentEntity
-}
-
-func (e *NewsEntity) Prop(cn string) (interface{}, bool) {
-	switch cn {
-
-	case TableNewsColumnContent:
-		return &e.Content, true
-	case TableNewsColumnContinue:
-		return &e.Continue, true
-	case TableNewsColumnCreatedAt:
-		return &e.CreatedAt, true
-	case TableNewsColumnDay:
-		return &e.Day, true
-	case TableNewsColumnID:
-		return &e.ID, true
-	case TableNewsColumnLead:
-		return &e.Lead, true
-	case TableNewsColumnMetaData:
-		return &e.MetaData, true
-	case TableNewsColumnScore:
-		return &e.Score, true
-	case TableNewsColumnTitle:
-		return &e.Title, true
-	case TableNewsColumnUpdatedAt:
-		return &e.UpdatedAt, true
-	case TableNewsColumnVersion:
-		return &e.Version, true
-	case TableNewsColumnViewsDistribution:
-		return &e.ViewsDistribution, true
-	default:
-		return nil, false
-	}
-}
-
-func (e *NewsEntity) Props(cns ...string) ([]interface{}, error) {
-	if len(cns) == 0 {
-		cns = TableNewsColumns
-	}
-	res := make([]interface{}, 0, len(cns))
-	for _, cn := range cns {
-		if prop, ok := e.Prop(cn); ok {
-			res = append(res, prop)
-		} else {
-			return nil, fmt.Errorf("unexpected column provided: %s", cn)
-		}
-	}
-	return res, nil
-}
-
-// ScanNewsRows helps to scan rows straight to the slice of entities.
-func ScanNewsRows(rows Rows) (entities []*NewsEntity, err error) {
-	for rows.Next() {
-		var ent NewsEntity
-		err = rows.Scan(
-			&ent.Content,
-			&ent.Continue,
-			&ent.CreatedAt,
-			&ent.Day,
-			&ent.ID,
-			&ent.Lead,
-			&ent.MetaData,
-			&ent.Score,
-			&ent.Title,
-			&ent.UpdatedAt,
-			&ent.Version,
-			&ent.ViewsDistribution,
-		)
-		if err != nil {
-			return
-		}
-
-		entities = append(entities, &ent)
-	}
-	if err = rows.Err(); err != nil {
-		return
-	}
-
-	return
-}
-
-// NewsIterator is not thread safe.
-type NewsIterator struct {
-	rows Rows
-	cols []string
-	expr *NewsFindExpr
-}
-
-func (i *NewsIterator) Next() bool {
-	return i.rows.Next()
-}
-
-func (i *NewsIterator) Close() error {
-	return i.rows.Close()
-}
-
-func (i *NewsIterator) Err() error {
-	return i.rows.Err()
-}
-
-// Columns is wrapper around sql.Rows.Columns method, that also cache output inside iterator.
-func (i *NewsIterator) Columns() ([]string, error) {
-	if i.cols == nil {
-		cols, err := i.rows.Columns()
-		if err != nil {
-			return nil, err
-		}
-		i.cols = cols
-	}
-	return i.cols, nil
-}
-
-// Ent is wrapper around News method that makes iterator more generic.
-func (i *NewsIterator) Ent() (interface{}, error) {
-	return i.News()
-}
-
-func (i *NewsIterator) News() (*NewsEntity, error) {
-	var ent NewsEntity
-	cols, err := i.Columns()
-	if err != nil {
-		return nil, err
-	}
-
-	props, err := ent.Props(cols...)
-	if err != nil {
-		return nil, err
-	}
-	if err := i.rows.Scan(props...); err != nil {
-		return nil, err
-	}
-	return &ent, nil
-}
-
-type NewsCriteria struct {
-	Content                sql.NullString
-	Continue               sql.NullBool
-	CreatedAt              pq.NullTime
-	Day                    pq.NullTime
-	ID                     sql.NullInt64
-	Lead                   sql.NullString
-	MetaData               []byte
-	Score                  sql.NullFloat64
-	Title                  sql.NullString
-	UpdatedAt              pq.NullTime
-	Version                sql.NullInt64
-	ViewsDistribution      NullFloat64Array
-	operator               string
-	child, sibling, parent *NewsCriteria
-}
-
-func NewsOperand(operator string, operands ...*NewsCriteria) *NewsCriteria {
-	if len(operands) == 0 {
-		return &NewsCriteria{operator: operator}
-	}
-
-	parent := &NewsCriteria{
-		operator: operator,
-		child:    operands[0],
-	}
-
-	for i := 0; i < len(operands); i++ {
-		if i < len(operands)-1 {
-			operands[i].sibling = operands[i+1]
-		}
-		operands[i].parent = parent
-	}
-
-	return parent
-}
-
-func NewsOr(operands ...*NewsCriteria) *NewsCriteria {
-	return NewsOperand("OR", operands...)
-}
-
-func NewsAnd(operands ...*NewsCriteria) *NewsCriteria {
-	return NewsOperand("AND", operands...)
-}
-
-type NewsFindExpr struct {
-	Where         *NewsCriteria
-	Offset, Limit int64
-	Columns       []string
-	OrderBy       []RowOrder
-}
-
-type NewsJoin struct {
-	On, Where *NewsCriteria
-	Fetch     bool
-	Kind      JoinType
-}
-
-type NewsCountExpr struct {
-	Where *NewsCriteria
-}
-
-type NewsPatch struct {
-	Content           sql.NullString
-	Continue          sql.NullBool
-	CreatedAt         pq.NullTime
-	Day               pq.NullTime
-	Lead              sql.NullString
-	MetaData          []byte
-	Score             sql.NullFloat64
-	Title             sql.NullString
-	UpdatedAt         pq.NullTime
-	Version           sql.NullInt64
-	ViewsDistribution NullFloat64Array
-}
-
-type NewsRepositoryBase struct {
-	Table   string
-	Columns []string
-	DB      *sql.DB
-	Log     LogFunc
-}
-
-func (r *NewsRepositoryBase) Tx(tx *sql.Tx) (*NewsRepositoryBaseTx, error) {
-	return &NewsRepositoryBaseTx{
-		base: r,
-		tx:   tx,
-	}, nil
-}
-
-func (r *NewsRepositoryBase) BeginTx(ctx context.Context) (*NewsRepositoryBaseTx, error) {
-	tx, err := r.DB.BeginTx(ctx, nil)
-	if err != nil {
-		return nil, err
-	}
-	return r.Tx(tx)
-}
-
-func (r NewsRepositoryBase) RunInTransaction(ctx context.Context, fn func(rtx *NewsRepositoryBaseTx) error, attempts int) (err error) {
-	return RunInTransaction(r.DB, ctx, func(tx *sql.Tx) error {
-		rtx, err := r.Tx(tx)
-		if err != nil {
-			return err
-		}
-		return fn(rtx)
-	}, attempts)
-}
-
-func (r *NewsRepositoryBase) InsertQuery(e *NewsEntity, read bool) (string, []interface{}, error) {
-	insert := NewComposer(12)
-	columns := bytes.NewBuffer(nil)
-	buf := bytes.NewBufferString("INSERT INTO ")
-	buf.WriteString(r.Table)
-
-	if columns.Len() > 0 {
-		if _, err := columns.WriteString(", "); err != nil {
-			return "", nil, err
-		}
-	}
-	if _, err := columns.WriteString(TableNewsColumnContent); err != nil {
-		return "", nil, err
-	}
-	if insert.Dirty {
-		if _, err := insert.WriteString(", "); err != nil {
-			return "", nil, err
-		}
-	}
-	if err := insert.WritePlaceholder(); err != nil {
-		return "", nil, err
-	}
-	insert.Add(e.Content)
-	insert.Dirty = true
-
-	if columns.Len() > 0 {
-		if _, err := columns.WriteString(", "); err != nil {
-			return "", nil, err
-		}
-	}
-	if _, err := columns.WriteString(TableNewsColumnContinue); err != nil {
-		return "", nil, err
-	}
-	if insert.Dirty {
-		if _, err := insert.WriteString(", "); err != nil {
-			return "", nil, err
-		}
-	}
-	if err := insert.WritePlaceholder(); err != nil {
-		return "", nil, err
-	}
-	insert.Add(e.Continue)
-	insert.Dirty = true
-
-	if !e.CreatedAt.IsZero() {
-		if columns.Len() > 0 {
-			if _, err := columns.WriteString(", "); err != nil {
-				return "", nil, err
-			}
-		}
-		if _, err := columns.WriteString(TableNewsColumnCreatedAt); err != nil {
-			return "", nil, err
-		}
-		if insert.Dirty {
-			if _, err := insert.WriteString(", "); err != nil {
-				return "", nil, err
-			}
-		}
-		if err := insert.WritePlaceholder(); err != nil {
-			return "", nil, err
-		}
-		insert.Add(e.CreatedAt)
-		insert.Dirty = true
-	}
-
-	if e.Day.Valid {
-		if columns.Len() > 0 {
-			if _, err := columns.WriteString(", "); err != nil {
-				return "", nil, err
-			}
-		}
-		if _, err := columns.WriteString(TableNewsColumnDay); err != nil {
-			return "", nil, err
-		}
-		if insert.Dirty {
-			if _, err := insert.WriteString(", "); err != nil {
-				return "", nil, err
-			}
-		}
-		if err := insert.WritePlaceholder(); err != nil {
-			return "", nil, err
-		}
-		insert.Add(e.Day)
-		insert.Dirty = true
-	}
-
-	if e.Lead.Valid {
-		if columns.Len() > 0 {
-			if _, err := columns.WriteString(", "); err != nil {
-				return "", nil, err
-			}
-		}
-		if _, err := columns.WriteString(TableNewsColumnLead); err != nil {
-			return "", nil, err
-		}
-		if insert.Dirty {
-			if _, err := insert.WriteString(", "); err != nil {
-				return "", nil, err
-			}
-		}
-		if err := insert.WritePlaceholder(); err != nil {
-			return "", nil, err
-		}
-		insert.Add(e.Lead)
-		insert.Dirty = true
-	}
-
-	if e.MetaData != nil {
-		if columns.Len() > 0 {
-			if _, err := columns.WriteString(", "); err != nil {
-				return "", nil, err
-			}
-		}
-		if _, err := columns.WriteString(TableNewsColumnMetaData); err != nil {
-			return "", nil, err
-		}
-		if insert.Dirty {
-			if _, err := insert.WriteString(", "); err != nil {
-				return "", nil, err
-			}
-		}
-		if err := insert.WritePlaceholder(); err != nil {
-			return "", nil, err
-		}
-		insert.Add(e.MetaData)
-		insert.Dirty = true
-	}
-
-	if columns.Len() > 0 {
-		if _, err := columns.WriteString(", "); err != nil {
-			return "", nil, err
-		}
-	}
-	if _, err := columns.WriteString(TableNewsColumnScore); err != nil {
-		return "", nil, err
-	}
-	if insert.Dirty {
-		if _, err := insert.WriteString(", "); err != nil {
-			return "", nil, err
-		}
-	}
-	if err := insert.WritePlaceholder(); err != nil {
-		return "", nil, err
-	}
-	insert.Add(e.Score)
-	insert.Dirty = true
-
-	if columns.Len() > 0 {
-		if _, err := columns.WriteString(", "); err != nil {
-			return "", nil, err
-		}
-	}
-	if _, err := columns.WriteString(TableNewsColumnTitle); err != nil {
-		return "", nil, err
-	}
-	if insert.Dirty {
-		if _, err := insert.WriteString(", "); err != nil {
-			return "", nil, err
-		}
-	}
-	if err := insert.WritePlaceholder(); err != nil {
-		return "", nil, err
-	}
-	insert.Add(e.Title)
-	insert.Dirty = true
-
-	if e.UpdatedAt.Valid {
-		if columns.Len() > 0 {
-			if _, err := columns.WriteString(", "); err != nil {
-				return "", nil, err
-			}
-		}
-		if _, err := columns.WriteString(TableNewsColumnUpdatedAt); err != nil {
-			return "", nil, err
-		}
-		if insert.Dirty {
-			if _, err := insert.WriteString(", "); err != nil {
-				return "", nil, err
-			}
-		}
-		if err := insert.WritePlaceholder(); err != nil {
-			return "", nil, err
-		}
-		insert.Add(e.UpdatedAt)
-		insert.Dirty = true
-	}
-
-	if columns.Len() > 0 {
-		if _, err := columns.WriteString(", "); err != nil {
-			return "", nil, err
-		}
-	}
-	if _, err := columns.WriteString(TableNewsColumnVersion); err != nil {
-		return "", nil, err
-	}
-	if insert.Dirty {
-		if _, err := insert.WriteString(", "); err != nil {
-			return "", nil, err
-		}
-	}
-	if err := insert.WritePlaceholder(); err != nil {
-		return "", nil, err
-	}
-	insert.Add(e.Version)
-	insert.Dirty = true
-
-	if e.ViewsDistribution.Valid {
-		if columns.Len() > 0 {
-			if _, err := columns.WriteString(", "); err != nil {
-				return "", nil, err
-			}
-		}
-		if _, err := columns.WriteString(TableNewsColumnViewsDistribution); err != nil {
-			return "", nil, err
-		}
-		if insert.Dirty {
-			if _, err := insert.WriteString(", "); err != nil {
-				return "", nil, err
-			}
-		}
-		if err := insert.WritePlaceholder(); err != nil {
-			return "", nil, err
-		}
-		insert.Add(e.ViewsDistribution)
-		insert.Dirty = true
-	}
-
-	if columns.Len() > 0 {
-		buf.WriteString(" (")
-		buf.ReadFrom(columns)
-		buf.WriteString(") VALUES (")
-		buf.ReadFrom(insert)
-		buf.WriteString(") ")
-		if read {
-			buf.WriteString("RETURNING ")
-			if len(r.Columns) > 0 {
-				buf.WriteString(strings.Join(r.Columns, ", "))
-			} else {
-				buf.WriteString("content, continue, created_at, day, id, lead, meta_data, score, title, updated_at, version, views_distribution")
-			}
-		}
-	}
-	return buf.String(), insert.Args(), nil
-}
-
-func (r *NewsRepositoryBase) insert(ctx context.Context, tx *sql.Tx, e *NewsEntity) (*NewsEntity, error) {
-	query, args, err := r.InsertQuery(e, true)
-	if err != nil {
-		return nil, err
-	}
-
-	var row *sql.Row
-	if tx == nil {
-		row = r.DB.QueryRowContext(ctx, query, args...)
-	} else {
-		row = tx.QueryRowContext(ctx, query, args...)
-	}
-	err = row.Scan(
-		&e.Content,
-		&e.Continue,
-		&e.CreatedAt,
-		&e.Day,
-		&e.ID,
-		&e.Lead,
-		&e.MetaData,
-		&e.Score,
-		&e.Title,
-		&e.UpdatedAt,
-		&e.Version,
-		&e.ViewsDistribution,
-	)
-	if r.Log != nil {
-		if tx == nil {
-			r.Log(err, TableNews, "insert", query, args...)
-		} else {
-			r.Log(err, TableNews, "insert tx", query, args...)
-		}
-	}
-	if err != nil {
-		return nil, err
-	}
-	return e, nil
-}
-
-func (r *NewsRepositoryBase) Insert(ctx context.Context, e *NewsEntity) (*NewsEntity, error) {
-	return r.insert(ctx, nil, e)
-}
-
-func NewsCriteriaWhereClause(comp *Composer, c *NewsCriteria, id int) error {
-	if c.child == nil {
-		return _NewsCriteriaWhereClause(comp, c, id)
-	}
-	node := c
-	sibling := false
-	for {
-		if !sibling {
-			if node.child != nil {
-				if node.parent != nil {
-					comp.WriteString("(")
-				}
-				node = node.child
-				continue
-			} else {
-				comp.Dirty = false
-				comp.WriteString("(")
-				if err := _NewsCriteriaWhereClause(comp, node, id); err != nil {
-					return err
-				}
-				comp.WriteString(")")
-			}
-		}
-		if node.sibling != nil {
-			sibling = false
-			comp.WriteString(" ")
-			comp.WriteString(node.parent.operator)
-			comp.WriteString(" ")
-			node = node.sibling
-			continue
-		}
-		if node.parent != nil {
-			sibling = true
-			if node.parent.parent != nil {
-				comp.WriteString(")")
-			}
-			node = node.parent
-			continue
-		}
-
-		break
-	}
-	return nil
-}
-
-func _NewsCriteriaWhereClause(comp *Composer, c *NewsCriteria, id int) error {
-	if c.Content.Valid {
-		if comp.Dirty {
-			comp.WriteString(" AND ")
-		}
-		if err := comp.WriteAlias(id); err != nil {
-			return err
-		}
-		if _, err := comp.WriteString(TableNewsColumnContent); err != nil {
-			return err
-		}
-		if _, err := comp.WriteString("="); err != nil {
-			return err
-		}
-		if err := comp.WritePlaceholder(); err != nil {
-			return err
-		}
-		comp.Add(c.Content)
-		comp.Dirty = true
-	}
-	if c.Continue.Valid {
-		if comp.Dirty {
-			comp.WriteString(" AND ")
-		}
-		if err := comp.WriteAlias(id); err != nil {
-			return err
-		}
-		if _, err := comp.WriteString(TableNewsColumnContinue); err != nil {
-			return err
-		}
-		if _, err := comp.WriteString("="); err != nil {
-			return err
-		}
-		if err := comp.WritePlaceholder(); err != nil {
-			return err
-		}
-		comp.Add(c.Continue)
-		comp.Dirty = true
-	}
-	if c.CreatedAt.Valid {
-		if comp.Dirty {
-			comp.WriteString(" AND ")
-		}
-		if err := comp.WriteAlias(id); err != nil {
-			return err
-		}
-		if _, err := comp.WriteString(TableNewsColumnCreatedAt); err != nil {
-			return err
-		}
-		if _, err := comp.WriteString("="); err != nil {
-			return err
-		}
-		if err := comp.WritePlaceholder(); err != nil {
-			return err
-		}
-		comp.Add(c.CreatedAt)
-		comp.Dirty = true
-	}
-	if c.Day.Valid {
-		if comp.Dirty {
-			comp.WriteString(" AND ")
-		}
-		if err := comp.WriteAlias(id); err != nil {
-			return err
-		}
-		if _, err := comp.WriteString(TableNewsColumnDay); err != nil {
-			return err
-		}
-		if _, err := comp.WriteString("="); err != nil {
-			return err
-		}
-		if err := comp.WritePlaceholder(); err != nil {
-			return err
-		}
-		comp.Add(c.Day)
-		comp.Dirty = true
-	}
-	// id is an empty struct, ignore
-
-	if c.Lead.Valid {
-		if comp.Dirty {
-			comp.WriteString(" AND ")
-		}
-		if err := comp.WriteAlias(id); err != nil {
-			return err
-		}
-		if _, err := comp.WriteString(TableNewsColumnLead); err != nil {
-			return err
-		}
-		if _, err := comp.WriteString("="); err != nil {
-			return err
-		}
-		if err := comp.WritePlaceholder(); err != nil {
-			return err
-		}
-		comp.Add(c.Lead)
-		comp.Dirty = true
-	}
-	if c.MetaData != nil {
-		if comp.Dirty {
-			comp.WriteString(" AND ")
-		}
-		if err := comp.WriteAlias(id); err != nil {
-			return err
-		}
-		if _, err := comp.WriteString(TableNewsColumnMetaData); err != nil {
-			return err
-		}
-		if _, err := comp.WriteString("="); err != nil {
-			return err
-		}
-		if err := comp.WritePlaceholder(); err != nil {
-			return err
-		}
-		comp.Add(c.MetaData)
-		comp.Dirty = true
-	}
-	if c.Score.Valid {
-		if comp.Dirty {
-			comp.WriteString(" AND ")
-		}
-		if err := comp.WriteAlias(id); err != nil {
-			return err
-		}
-		if _, err := comp.WriteString(TableNewsColumnScore); err != nil {
-			return err
-		}
-		if _, err := comp.WriteString("="); err != nil {
-			return err
-		}
-		if err := comp.WritePlaceholder(); err != nil {
-			return err
-		}
-		comp.Add(c.Score)
-		comp.Dirty = true
-	}
-	if c.Title.Valid {
-		if comp.Dirty {
-			comp.WriteString(" AND ")
-		}
-		if err := comp.WriteAlias(id); err != nil {
-			return err
-		}
-		if _, err := comp.WriteString(TableNewsColumnTitle); err != nil {
-			return err
-		}
-		if _, err := comp.WriteString("="); err != nil {
-			return err
-		}
-		if err := comp.WritePlaceholder(); err != nil {
-			return err
-		}
-		comp.Add(c.Title)
-		comp.Dirty = true
-	}
-	if c.UpdatedAt.Valid {
-		if comp.Dirty {
-			comp.WriteString(" AND ")
-		}
-		if err := comp.WriteAlias(id); err != nil {
-			return err
-		}
-		if _, err := comp.WriteString(TableNewsColumnUpdatedAt); err != nil {
-			return err
-		}
-		if _, err := comp.WriteString("="); err != nil {
-			return err
-		}
-		if err := comp.WritePlaceholder(); err != nil {
-			return err
-		}
-		comp.Add(c.UpdatedAt)
-		comp.Dirty = true
-	}
-	if c.Version.Valid {
-		if comp.Dirty {
-			comp.WriteString(" AND ")
-		}
-		if err := comp.WriteAlias(id); err != nil {
-			return err
-		}
-		if _, err := comp.WriteString(TableNewsColumnVersion); err != nil {
-			return err
-		}
-		if _, err := comp.WriteString("="); err != nil {
-			return err
-		}
-		if err := comp.WritePlaceholder(); err != nil {
-			return err
-		}
-		comp.Add(c.Version)
-		comp.Dirty = true
-	}
-	if c.ViewsDistribution.Valid {
-		if comp.Dirty {
-			comp.WriteString(" AND ")
-		}
-		if err := comp.WriteAlias(id); err != nil {
-			return err
-		}
-		if _, err := comp.WriteString(TableNewsColumnViewsDistribution); err != nil {
-			return err
-		}
-		if _, err := comp.WriteString("="); err != nil {
-			return err
-		}
-		if err := comp.WritePlaceholder(); err != nil {
-			return err
-		}
-		comp.Add(c.ViewsDistribution)
-		comp.Dirty = true
-	}
-	return nil
-}
-
-func (r *NewsRepositoryBase) FindQuery(fe *NewsFindExpr) (string, []interface{}, error) {
-	comp := NewComposer(12)
-	buf := bytes.NewBufferString("SELECT ")
-	if len(fe.Columns) == 0 {
-		buf.WriteString("t0.content, t0.continue, t0.created_at, t0.day, t0.id, t0.lead, t0.meta_data, t0.score, t0.title, t0.updated_at, t0.version, t0.views_distribution")
-	} else {
-		buf.WriteString(strings.Join(fe.Columns, ", "))
-	}
-	buf.WriteString(" FROM ")
-	buf.WriteString(r.Table)
-	buf.WriteString(" AS t0")
-	if comp.Dirty {
-		buf.ReadFrom(comp)
-		comp.Dirty = false
-	}
-	if fe.Where != nil {
-		if err := NewsCriteriaWhereClause(comp, fe.Where, 0); err != nil {
-			return "", nil, err
-		}
-	}
-	if comp.Dirty {
-		if _, err := buf.WriteString(" WHERE "); err != nil {
-			return "", nil, err
-		}
-		buf.ReadFrom(comp)
-	}
-
-	if len(fe.OrderBy) > 0 {
-		i := 0
-		for _, order := range fe.OrderBy {
-			for _, columnName := range TableNewsColumns {
-				if order.Name == columnName {
-					if i == 0 {
-						comp.WriteString(" ORDER BY ")
-					}
-					if i > 0 {
-						if _, err := comp.WriteString(", "); err != nil {
-							return "", nil, err
-						}
-					}
-					if _, err := comp.WriteString(order.Name); err != nil {
-						return "", nil, err
-					}
-					if order.Descending {
-						if _, err := comp.WriteString(" DESC"); err != nil {
-							return "", nil, err
-						}
-					}
-					i++
-					break
-				}
-			}
-		}
-	}
-	if fe.Offset > 0 {
-		if _, err := comp.WriteString(" OFFSET "); err != nil {
-			return "", nil, err
-		}
-		if err := comp.WritePlaceholder(); err != nil {
-			return "", nil, err
-		}
-		if _, err := comp.WriteString(" "); err != nil {
-			return "", nil, err
-		}
-		comp.Add(fe.Offset)
-	}
-	if fe.Limit > 0 {
-		if _, err := comp.WriteString(" LIMIT "); err != nil {
-			return "", nil, err
-		}
-		if err := comp.WritePlaceholder(); err != nil {
-			return "", nil, err
-		}
-		if _, err := comp.WriteString(" "); err != nil {
-			return "", nil, err
-		}
-		comp.Add(fe.Limit)
-	}
-
-	buf.ReadFrom(comp)
-
-	return buf.String(), comp.Args(), nil
-}
-
-func (r *NewsRepositoryBase) find(ctx context.Context, tx *sql.Tx, fe *NewsFindExpr) ([]*NewsEntity, error) {
-	query, args, err := r.FindQuery(fe)
-	if err != nil {
-		return nil, err
-	}
-	var rows *sql.Rows
-	if tx == nil {
-		rows, err = r.DB.QueryContext(ctx, query, args...)
-	} else {
-		rows, err = tx.QueryContext(ctx, query, args...)
-	}
-	if r.Log != nil {
-		if tx == nil {
-			r.Log(err, TableNews, "find", query, args...)
-		} else {
-			r.Log(err, TableNews, "find tx", query, args...)
-		}
-	}
-	if err != nil {
-		return nil, err
-	}
-	defer rows.Close()
-	var (
-		entities []*NewsEntity
-		props    []interface{}
-	)
-	for rows.Next() {
-		var ent NewsEntity
-		if props, err = ent.Props(); err != nil {
-			return nil, err
-		}
-		err = rows.Scan(props...)
-		if err != nil {
-			return nil, err
-		}
-
-		entities = append(entities, &ent)
-	}
-	err = rows.Err()
-	if r.Log != nil {
-		r.Log(err, TableNews, "find", query, args...)
-	}
-	if err != nil {
-		return nil, err
-	}
-	return entities, nil
-}
-
-func (r *NewsRepositoryBase) Find(ctx context.Context, fe *NewsFindExpr) ([]*NewsEntity, error) {
-	return r.find(ctx, nil, fe)
-}
-
-func (r *NewsRepositoryBase) findIter(ctx context.Context, tx *sql.Tx, fe *NewsFindExpr) (*NewsIterator, error) {
-	query, args, err := r.FindQuery(fe)
-	if err != nil {
-		return nil, err
-	}
-	var rows *sql.Rows
-	if tx == nil {
-		rows, err = r.DB.QueryContext(ctx, query, args...)
-	} else {
-		rows, err = tx.QueryContext(ctx, query, args...)
-	}
-	if r.Log != nil {
-		if tx == nil {
-			r.Log(err, TableNews, "find iter", query, args...)
-		} else {
-			r.Log(err, TableNews, "find iter tx", query, args...)
-		}
-	}
-	if err != nil {
-		return nil, err
-	}
-	return &NewsIterator{
-		rows: rows,
-		expr: fe,
-		cols: fe.Columns,
-	}, nil
-}
-
-func (r *NewsRepositoryBase) FindIter(ctx context.Context, fe *NewsFindExpr) (*NewsIterator, error) {
-	return r.findIter(ctx, nil, fe)
-}
-
-func (r *NewsRepositoryBase) findOneByID(ctx context.Context, tx *sql.Tx, pk int64) (*NewsEntity, error) {
-	find := NewComposer(12)
-	find.WriteString("SELECT ")
-	if len(r.Columns) == 0 {
-		find.WriteString("content, continue, created_at, day, id, lead, meta_data, score, title, updated_at, version, views_distribution")
-	} else {
-		find.WriteString(strings.Join(r.Columns, ", "))
-	}
-	find.WriteString(" FROM ")
-	find.WriteString(TableNews)
-	find.WriteString(" WHERE ")
-	find.WriteString(TableNewsColumnID)
-	find.WriteString("=")
-	find.WritePlaceholder()
-	find.Add(pk)
-	var (
-		ent NewsEntity
-	)
-	props, err := ent.Props(r.Columns...)
-	if err != nil {
-		return nil, err
-	}
-	if tx == nil {
-		err = r.DB.QueryRowContext(ctx, find.String(), find.Args()...).Scan(props...)
-	} else {
-		err = tx.QueryRowContext(ctx, find.String(), find.Args()...).Scan(props...)
-	}
-	if r.Log != nil {
-		if tx == nil {
-			r.Log(err, TableNews, "find by primary key", find.String(), find.Args()...)
-		} else {
-			r.Log(err, TableNews, "find by primary key tx", find.String(), find.Args()...)
-		}
-	}
-	if err != nil {
-		return nil, err
-	}
-	return &ent, nil
-}
-
-func (r *NewsRepositoryBase) FindOneByID(ctx context.Context, pk int64) (*NewsEntity, error) {
-	return r.findOneByID(ctx, nil, pk)
-}
-
-func (r *NewsRepositoryBase) findOneByTitle(ctx context.Context, tx *sql.Tx, newsTitle string) (*NewsEntity, error) {
-	find := NewComposer(12)
-	find.WriteString("SELECT ")
-	if len(r.Columns) == 0 {
-		find.WriteString("content, continue, created_at, day, id, lead, meta_data, score, title, updated_at, version, views_distribution")
-	} else {
-		find.WriteString(strings.Join(r.Columns, ", "))
-	}
-	find.WriteString(" FROM ")
-	find.WriteString(TableNews)
-	find.WriteString(" WHERE ")
-	find.WriteString(TableNewsColumnTitle)
-	find.WriteString("=")
-	find.WritePlaceholder()
-	find.Add(newsTitle)
-
-	var (
-		ent NewsEntity
-	)
-	props, err := ent.Props(r.Columns...)
-	if err != nil {
-		return nil, err
-	}
-	if tx == nil {
-		err = r.DB.QueryRowContext(ctx, find.String(), find.Args()...).Scan(props...)
-	} else {
-		err = tx.QueryRowContext(ctx, find.String(), find.Args()...).Scan(props...)
-	}
-	if err != nil {
-		return nil, err
-	}
-
-	return &ent, nil
-}
-
-func (r *NewsRepositoryBase) findOneByTitleAndLead(ctx context.Context, tx *sql.Tx, newsTitle string, newsLead string) (*NewsEntity, error) {
-	find := NewComposer(12)
-	find.WriteString("SELECT ")
-	if len(r.Columns) == 0 {
-		find.WriteString("content, continue, created_at, day, id, lead, meta_data, score, title, updated_at, version, views_distribution")
-	} else {
-		find.WriteString(strings.Join(r.Columns, ", "))
-	}
-	find.WriteString(" FROM ")
-	find.WriteString(TableNews)
-	find.WriteString(" WHERE ")
-	find.WriteString(TableNewsColumnTitle)
-	find.WriteString("=")
-	find.WritePlaceholder()
-	find.Add(newsTitle)
-	find.WriteString(" AND ")
-	find.WriteString(TableNewsColumnLead)
-	find.WriteString("=")
-	find.WritePlaceholder()
-	find.Add(newsLead)
-
-	var (
-		ent NewsEntity
-	)
-	props, err := ent.Props(r.Columns...)
-	if err != nil {
-		return nil, err
-	}
-	if tx == nil {
-		err = r.DB.QueryRowContext(ctx, find.String(), find.Args()...).Scan(props...)
-	} else {
-		err = tx.QueryRowContext(ctx, find.String(), find.Args()...).Scan(props...)
-	}
-	if err != nil {
-		return nil, err
-	}
-
-	return &ent, nil
-}
-
-func (r *NewsRepositoryBase) FindOneByTitle(ctx context.Context, newsTitle string) (*NewsEntity, error) {
-	return r.findOneByTitle(ctx, nil, newsTitle)
-}
-func (r *NewsRepositoryBase) FindOneByTitleAndLead(ctx context.Context, newsTitle string, newsLead string) (*NewsEntity, error) {
-	return r.findOneByTitleAndLead(ctx, nil, newsTitle, newsLead)
-}
-
-func (r *NewsRepositoryBase) UpdateOneByIDQuery(pk int64, p *NewsPatch) (string, []interface{}, error) {
-	buf := bytes.NewBufferString("UPDATE ")
-	buf.WriteString(r.Table)
-	update := NewComposer(12)
-	if p.Content.Valid {
-		if update.Dirty {
-			if _, err := update.WriteString(", "); err != nil {
-				return "", nil, err
-			}
-		}
-		if _, err := update.WriteString(TableNewsColumnContent); err != nil {
-			return "", nil, err
-		}
-		if _, err := update.WriteString("="); err != nil {
-			return "", nil, err
-		}
-		if err := update.WritePlaceholder(); err != nil {
-			return "", nil, err
-		}
-		update.Add(p.Content)
-		update.Dirty = true
-
-	}
-	if p.Continue.Valid {
-		if update.Dirty {
-			if _, err := update.WriteString(", "); err != nil {
-				return "", nil, err
-			}
-		}
-		if _, err := update.WriteString(TableNewsColumnContinue); err != nil {
-			return "", nil, err
-		}
-		if _, err := update.WriteString("="); err != nil {
-			return "", nil, err
-		}
-		if err := update.WritePlaceholder(); err != nil {
-			return "", nil, err
-		}
-		update.Add(p.Continue)
-		update.Dirty = true
-
-	}
-	if p.CreatedAt.Valid {
-		if update.Dirty {
-			if _, err := update.WriteString(", "); err != nil {
-				return "", nil, err
-			}
-		}
-		if _, err := update.WriteString(TableNewsColumnCreatedAt); err != nil {
-			return "", nil, err
-		}
-		if _, err := update.WriteString("="); err != nil {
-			return "", nil, err
-		}
-		if err := update.WritePlaceholder(); err != nil {
-			return "", nil, err
-		}
-		update.Add(p.CreatedAt)
-		update.Dirty = true
-
-	}
-	if p.Day.Valid {
-		if update.Dirty {
-			if _, err := update.WriteString(", "); err != nil {
-				return "", nil, err
-			}
-		}
-		if _, err := update.WriteString(TableNewsColumnDay); err != nil {
-			return "", nil, err
-		}
-		if _, err := update.WriteString("="); err != nil {
-			return "", nil, err
-		}
-		if err := update.WritePlaceholder(); err != nil {
-			return "", nil, err
-		}
-		update.Add(p.Day)
-		update.Dirty = true
-
-	}
-	if p.Lead.Valid {
-		if update.Dirty {
-			if _, err := update.WriteString(", "); err != nil {
-				return "", nil, err
-			}
-		}
-		if _, err := update.WriteString(TableNewsColumnLead); err != nil {
-			return "", nil, err
-		}
-		if _, err := update.WriteString("="); err != nil {
-			return "", nil, err
-		}
-		if err := update.WritePlaceholder(); err != nil {
-			return "", nil, err
-		}
-		update.Add(p.Lead)
-		update.Dirty = true
-
-	}
-	if p.MetaData != nil {
-		if update.Dirty {
-			if _, err := update.WriteString(", "); err != nil {
-				return "", nil, err
-			}
-		}
-		if _, err := update.WriteString(TableNewsColumnMetaData); err != nil {
-			return "", nil, err
-		}
-		if _, err := update.WriteString("="); err != nil {
-			return "", nil, err
-		}
-		if err := update.WritePlaceholder(); err != nil {
-			return "", nil, err
-		}
-		update.Add(p.MetaData)
-		update.Dirty = true
-
-	}
-	if p.Score.Valid {
-		if update.Dirty {
-			if _, err := update.WriteString(", "); err != nil {
-				return "", nil, err
-			}
-		}
-		if _, err := update.WriteString(TableNewsColumnScore); err != nil {
-			return "", nil, err
-		}
-		if _, err := update.WriteString("="); err != nil {
-			return "", nil, err
-		}
-		if err := update.WritePlaceholder(); err != nil {
-			return "", nil, err
-		}
-		update.Add(p.Score)
-		update.Dirty = true
-
-	}
-	if p.Title.Valid {
-		if update.Dirty {
-			if _, err := update.WriteString(", "); err != nil {
-				return "", nil, err
-			}
-		}
-		if _, err := update.WriteString(TableNewsColumnTitle); err != nil {
-			return "", nil, err
-		}
-		if _, err := update.WriteString("="); err != nil {
-			return "", nil, err
-		}
-		if err := update.WritePlaceholder(); err != nil {
-			return "", nil, err
-		}
-		update.Add(p.Title)
-		update.Dirty = true
-
-	}
-	if p.UpdatedAt.Valid {
-		if update.Dirty {
-			if _, err := update.WriteString(", "); err != nil {
-				return "", nil, err
-			}
-		}
-		if _, err := update.WriteString(TableNewsColumnUpdatedAt); err != nil {
-			return "", nil, err
-		}
-		if _, err := update.WriteString("="); err != nil {
-			return "", nil, err
-		}
-		if err := update.WritePlaceholder(); err != nil {
-			return "", nil, err
-		}
-		update.Add(p.UpdatedAt)
-		update.Dirty = true
-
-	} else {
-		if update.Dirty {
-			if _, err := update.WriteString(", "); err != nil {
-				return "", nil, err
-			}
-		}
-		if _, err := update.WriteString(TableNewsColumnUpdatedAt); err != nil {
-			return "", nil, err
-		}
-		if _, err := update.WriteString("=NOW()"); err != nil {
-			return "", nil, err
-		}
-		update.Dirty = true
-	}
-	if p.Version.Valid {
-		if update.Dirty {
-			if _, err := update.WriteString(", "); err != nil {
-				return "", nil, err
-			}
-		}
-		if _, err := update.WriteString(TableNewsColumnVersion); err != nil {
-			return "", nil, err
-		}
-		if _, err := update.WriteString("="); err != nil {
-			return "", nil, err
-		}
-		if err := update.WritePlaceholder(); err != nil {
-			return "", nil, err
-		}
-		update.Add(p.Version)
-		update.Dirty = true
-
-	} else {
-		if update.Dirty {
-			if _, err := update.WriteString(", "); err != nil {
-				return "", nil, err
-			}
-		}
-		if _, err := update.WriteString(TableNewsColumnVersion); err != nil {
-			return "", nil, err
-		}
-		if _, err := update.WriteString("=version+1"); err != nil {
-			return "", nil, err
-		}
-		update.Dirty = true
-	}
-	if p.ViewsDistribution.Valid {
-		if update.Dirty {
-			if _, err := update.WriteString(", "); err != nil {
-				return "", nil, err
-			}
-		}
-		if _, err := update.WriteString(TableNewsColumnViewsDistribution); err != nil {
-			return "", nil, err
-		}
-		if _, err := update.WriteString("="); err != nil {
-			return "", nil, err
-		}
-		if err := update.WritePlaceholder(); err != nil {
-			return "", nil, err
-		}
-		update.Add(p.ViewsDistribution)
-		update.Dirty = true
-
-	}
-	if !update.Dirty {
-		return "", nil, errors.New("News update failure, nothing to update")
-	}
-	buf.WriteString(" SET ")
-	buf.ReadFrom(update)
-	buf.WriteString(" WHERE ")
-
-	update.WriteString(TableNewsColumnID)
-	update.WriteString("=")
-	update.WritePlaceholder()
-	update.Add(pk)
-
-	buf.ReadFrom(update)
-	buf.WriteString(" RETURNING ")
-	if len(r.Columns) > 0 {
-		buf.WriteString(strings.Join(r.Columns, ", "))
-	} else {
-		buf.WriteString("content, continue, created_at, day, id, lead, meta_data, score, title, updated_at, version, views_distribution")
-	}
-	return buf.String(), update.Args(), nil
-}
-
-func (r *NewsRepositoryBase) updateOneByID(ctx context.Context, tx *sql.Tx, pk int64, p *NewsPatch) (*NewsEntity, error) {
-	query, args, err := r.UpdateOneByIDQuery(pk, p)
-	if err != nil {
-		return nil, err
-	}
-	var ent NewsEntity
-	props, err := ent.Props(r.Columns...)
-	if err != nil {
-		return nil, err
-	}
-	if tx == nil {
-		err = r.DB.QueryRowContext(ctx, query, args...).Scan(props...)
-	} else {
-		err = tx.QueryRowContext(ctx, query, args...).Scan(props...)
-	}
-	if r.Log != nil {
-		if tx == nil {
-			r.Log(err, TableNews, "update by primary key", query, args...)
-		} else {
-			r.Log(err, TableNews, "update by primary key tx", query, args...)
-		}
-	}
-	if err != nil {
-		return nil, err
-	}
-	return &ent, nil
-}
-
-func (r *NewsRepositoryBase) UpdateOneByID(ctx context.Context, pk int64, p *NewsPatch) (*NewsEntity, error) {
-	return r.updateOneByID(ctx, nil, pk, p)
-}
-
-func (r *NewsRepositoryBase) FindOneByIDAndUpdate(ctx context.Context, pk int64, p *NewsPatch) (before, after *NewsEntity, err error) {
-	find := NewComposer(12)
-	find.WriteString("SELECT ")
-	if len(r.Columns) == 0 {
-		find.WriteString("content, continue, created_at, day, id, lead, meta_data, score, title, updated_at, version, views_distribution")
-	} else {
-		find.WriteString(strings.Join(r.Columns, ", "))
-	}
-	find.WriteString(" FROM ")
-	find.WriteString(TableNews)
-	find.WriteString(" WHERE ")
-	find.WriteString(TableNewsColumnID)
-	find.WriteString("=")
-	find.WritePlaceholder()
-	find.Add(pk)
-	find.WriteString(" FOR UPDATE")
-	query, args, err := r.UpdateOneByIDQuery(pk, p)
-	if err != nil {
-		return
-	}
-	var (
-		oldEnt, newEnt NewsEntity
-	)
-	oldProps, err := oldEnt.Props(r.Columns...)
-	if err != nil {
-		return
-	}
-	newProps, err := newEnt.Props(r.Columns...)
-	if err != nil {
-		return
-	}
-	tx, err := r.DB.Begin()
-	if err != nil {
-		return
-	}
-	err = tx.QueryRowContext(ctx, find.String(), find.Args()...).Scan(oldProps...)
-	if r.Log != nil {
-		r.Log(err, TableNews, "find by primary key", find.String(), find.Args()...)
-	}
-	if err != nil {
-		tx.Rollback()
-		return
-	}
-	err = tx.QueryRowContext(ctx, query, args...).Scan(newProps...)
-	if r.Log != nil {
-		r.Log(err, TableNews, "update by primary key", query, args...)
-	}
-	if err != nil {
-		tx.Rollback()
-		return
-	}
-	err = tx.Commit()
-	if err != nil {
-		return
-	}
-	return &oldEnt, &newEnt, nil
-}
-
-func (r *NewsRepositoryBase) UpdateOneByTitleQuery(newsTitle string, p *NewsPatch) (string, []interface{}, error) {
-	buf := bytes.NewBufferString("UPDATE ")
-	buf.WriteString(r.Table)
-	update := NewComposer(1)
-	if p.Content.Valid {
-		if update.Dirty {
-			if _, err := update.WriteString(", "); err != nil {
-				return "", nil, err
-			}
-		}
-		if _, err := update.WriteString(TableNewsColumnContent); err != nil {
-			return "", nil, err
-		}
-		if _, err := update.WriteString("="); err != nil {
-			return "", nil, err
-		}
-		if err := update.WritePlaceholder(); err != nil {
-			return "", nil, err
-		}
-		update.Add(p.Content)
-		update.Dirty = true
-
-	}
-	if p.Continue.Valid {
-		if update.Dirty {
-			if _, err := update.WriteString(", "); err != nil {
-				return "", nil, err
-			}
-		}
-		if _, err := update.WriteString(TableNewsColumnContinue); err != nil {
-			return "", nil, err
-		}
-		if _, err := update.WriteString("="); err != nil {
-			return "", nil, err
-		}
-		if err := update.WritePlaceholder(); err != nil {
-			return "", nil, err
-		}
-		update.Add(p.Continue)
-		update.Dirty = true
-
-	}
-	if p.CreatedAt.Valid {
-		if update.Dirty {
-			if _, err := update.WriteString(", "); err != nil {
-				return "", nil, err
-			}
-		}
-		if _, err := update.WriteString(TableNewsColumnCreatedAt); err != nil {
-			return "", nil, err
-		}
-		if _, err := update.WriteString("="); err != nil {
-			return "", nil, err
-		}
-		if err := update.WritePlaceholder(); err != nil {
-			return "", nil, err
-		}
-		update.Add(p.CreatedAt)
-		update.Dirty = true
-
-	}
-	if p.Day.Valid {
-		if update.Dirty {
-			if _, err := update.WriteString(", "); err != nil {
-				return "", nil, err
-			}
-		}
-		if _, err := update.WriteString(TableNewsColumnDay); err != nil {
-			return "", nil, err
-		}
-		if _, err := update.WriteString("="); err != nil {
-			return "", nil, err
-		}
-		if err := update.WritePlaceholder(); err != nil {
-			return "", nil, err
-		}
-		update.Add(p.Day)
-		update.Dirty = true
-
-	}
-	if p.Lead.Valid {
-		if update.Dirty {
-			if _, err := update.WriteString(", "); err != nil {
-				return "", nil, err
-			}
-		}
-		if _, err := update.WriteString(TableNewsColumnLead); err != nil {
-			return "", nil, err
-		}
-		if _, err := update.WriteString("="); err != nil {
-			return "", nil, err
-		}
-		if err := update.WritePlaceholder(); err != nil {
-			return "", nil, err
-		}
-		update.Add(p.Lead)
-		update.Dirty = true
-
-	}
-	if p.MetaData != nil {
-		if update.Dirty {
-			if _, err := update.WriteString(", "); err != nil {
-				return "", nil, err
-			}
-		}
-		if _, err := update.WriteString(TableNewsColumnMetaData); err != nil {
-			return "", nil, err
-		}
-		if _, err := update.WriteString("="); err != nil {
-			return "", nil, err
-		}
-		if err := update.WritePlaceholder(); err != nil {
-			return "", nil, err
-		}
-		update.Add(p.MetaData)
-		update.Dirty = true
-
-	}
-	if p.Score.Valid {
-		if update.Dirty {
-			if _, err := update.WriteString(", "); err != nil {
-				return "", nil, err
-			}
-		}
-		if _, err := update.WriteString(TableNewsColumnScore); err != nil {
-			return "", nil, err
-		}
-		if _, err := update.WriteString("="); err != nil {
-			return "", nil, err
-		}
-		if err := update.WritePlaceholder(); err != nil {
-			return "", nil, err
-		}
-		update.Add(p.Score)
-		update.Dirty = true
-
-	}
-	if p.Title.Valid {
-		if update.Dirty {
-			if _, err := update.WriteString(", "); err != nil {
-				return "", nil, err
-			}
-		}
-		if _, err := update.WriteString(TableNewsColumnTitle); err != nil {
-			return "", nil, err
-		}
-		if _, err := update.WriteString("="); err != nil {
-			return "", nil, err
-		}
-		if err := update.WritePlaceholder(); err != nil {
-			return "", nil, err
-		}
-		update.Add(p.Title)
-		update.Dirty = true
-
-	}
-	if p.UpdatedAt.Valid {
-		if update.Dirty {
-			if _, err := update.WriteString(", "); err != nil {
-				return "", nil, err
-			}
-		}
-		if _, err := update.WriteString(TableNewsColumnUpdatedAt); err != nil {
-			return "", nil, err
-		}
-		if _, err := update.WriteString("="); err != nil {
-			return "", nil, err
-		}
-		if err := update.WritePlaceholder(); err != nil {
-			return "", nil, err
-		}
-		update.Add(p.UpdatedAt)
-		update.Dirty = true
-
-	} else {
-		if update.Dirty {
-			if _, err := update.WriteString(", "); err != nil {
-				return "", nil, err
-			}
-		}
-		if _, err := update.WriteString(TableNewsColumnUpdatedAt); err != nil {
-			return "", nil, err
-		}
-		if _, err := update.WriteString("=NOW()"); err != nil {
-			return "", nil, err
-		}
-		update.Dirty = true
-	}
-	if p.Version.Valid {
-		if update.Dirty {
-			if _, err := update.WriteString(", "); err != nil {
-				return "", nil, err
-			}
-		}
-		if _, err := update.WriteString(TableNewsColumnVersion); err != nil {
-			return "", nil, err
-		}
-		if _, err := update.WriteString("="); err != nil {
-			return "", nil, err
-		}
-		if err := update.WritePlaceholder(); err != nil {
-			return "", nil, err
-		}
-		update.Add(p.Version)
-		update.Dirty = true
-
-	} else {
-		if update.Dirty {
-			if _, err := update.WriteString(", "); err != nil {
-				return "", nil, err
-			}
-		}
-		if _, err := update.WriteString(TableNewsColumnVersion); err != nil {
-			return "", nil, err
-		}
-		if _, err := update.WriteString("=version+1"); err != nil {
-			return "", nil, err
-		}
-		update.Dirty = true
-	}
-	if p.ViewsDistribution.Valid {
-		if update.Dirty {
-			if _, err := update.WriteString(", "); err != nil {
-				return "", nil, err
-			}
-		}
-		if _, err := update.WriteString(TableNewsColumnViewsDistribution); err != nil {
-			return "", nil, err
-		}
-		if _, err := update.WriteString("="); err != nil {
-			return "", nil, err
-		}
-		if err := update.WritePlaceholder(); err != nil {
-			return "", nil, err
-		}
-		update.Add(p.ViewsDistribution)
-		update.Dirty = true
-
-	}
-	if !update.Dirty {
-		return "", nil, errors.New("news update failure, nothing to update")
-	}
-	buf.WriteString(" SET ")
-	buf.ReadFrom(update)
-	buf.WriteString(" WHERE ")
-	update.WriteString(TableNewsColumnTitle)
-	update.WriteString("=")
-	update.WritePlaceholder()
-	update.Add(newsTitle)
-	buf.ReadFrom(update)
-	buf.WriteString(" RETURNING ")
-	if len(r.Columns) > 0 {
-		buf.WriteString(strings.Join(r.Columns, ", "))
-	} else {
-		buf.WriteString("content, continue, created_at, day, id, lead, meta_data, score, title, updated_at, version, views_distribution")
-	}
-	return buf.String(), update.Args(), nil
-}
-
-func (r *NewsRepositoryBase) UpdateOneByTitleAndLeadQuery(newsTitle string, newsLead string, p *NewsPatch) (string, []interface{}, error) {
-	buf := bytes.NewBufferString("UPDATE ")
-	buf.WriteString(r.Table)
-	update := NewComposer(2)
-	if p.Content.Valid {
-		if update.Dirty {
-			if _, err := update.WriteString(", "); err != nil {
-				return "", nil, err
-			}
-		}
-		if _, err := update.WriteString(TableNewsColumnContent); err != nil {
-			return "", nil, err
-		}
-		if _, err := update.WriteString("="); err != nil {
-			return "", nil, err
-		}
-		if err := update.WritePlaceholder(); err != nil {
-			return "", nil, err
-		}
-		update.Add(p.Content)
-		update.Dirty = true
-
-	}
-	if p.Continue.Valid {
-		if update.Dirty {
-			if _, err := update.WriteString(", "); err != nil {
-				return "", nil, err
-			}
-		}
-		if _, err := update.WriteString(TableNewsColumnContinue); err != nil {
-			return "", nil, err
-		}
-		if _, err := update.WriteString("="); err != nil {
-			return "", nil, err
-		}
-		if err := update.WritePlaceholder(); err != nil {
-			return "", nil, err
-		}
-		update.Add(p.Continue)
-		update.Dirty = true
-
-	}
-	if p.CreatedAt.Valid {
-		if update.Dirty {
-			if _, err := update.WriteString(", "); err != nil {
-				return "", nil, err
-			}
-		}
-		if _, err := update.WriteString(TableNewsColumnCreatedAt); err != nil {
-			return "", nil, err
-		}
-		if _, err := update.WriteString("="); err != nil {
-			return "", nil, err
-		}
-		if err := update.WritePlaceholder(); err != nil {
-			return "", nil, err
-		}
-		update.Add(p.CreatedAt)
-		update.Dirty = true
-
-	}
-	if p.Day.Valid {
-		if update.Dirty {
-			if _, err := update.WriteString(", "); err != nil {
-				return "", nil, err
-			}
-		}
-		if _, err := update.WriteString(TableNewsColumnDay); err != nil {
-			return "", nil, err
-		}
-		if _, err := update.WriteString("="); err != nil {
-			return "", nil, err
-		}
-		if err := update.WritePlaceholder(); err != nil {
-			return "", nil, err
-		}
-		update.Add(p.Day)
-		update.Dirty = true
-
-	}
-	if p.Lead.Valid {
-		if update.Dirty {
-			if _, err := update.WriteString(", "); err != nil {
-				return "", nil, err
-			}
-		}
-		if _, err := update.WriteString(TableNewsColumnLead); err != nil {
-			return "", nil, err
-		}
-		if _, err := update.WriteString("="); err != nil {
-			return "", nil, err
-		}
-		if err := update.WritePlaceholder(); err != nil {
-			return "", nil, err
-		}
-		update.Add(p.Lead)
-		update.Dirty = true
-
-	}
-	if p.MetaData != nil {
-		if update.Dirty {
-			if _, err := update.WriteString(", "); err != nil {
-				return "", nil, err
-			}
-		}
-		if _, err := update.WriteString(TableNewsColumnMetaData); err != nil {
-			return "", nil, err
-		}
-		if _, err := update.WriteString("="); err != nil {
-			return "", nil, err
-		}
-		if err := update.WritePlaceholder(); err != nil {
-			return "", nil, err
-		}
-		update.Add(p.MetaData)
-		update.Dirty = true
-
-	}
-	if p.Score.Valid {
-		if update.Dirty {
-			if _, err := update.WriteString(", "); err != nil {
-				return "", nil, err
-			}
-		}
-		if _, err := update.WriteString(TableNewsColumnScore); err != nil {
-			return "", nil, err
-		}
-		if _, err := update.WriteString("="); err != nil {
-			return "", nil, err
-		}
-		if err := update.WritePlaceholder(); err != nil {
-			return "", nil, err
-		}
-		update.Add(p.Score)
-		update.Dirty = true
-
-	}
-	if p.Title.Valid {
-		if update.Dirty {
-			if _, err := update.WriteString(", "); err != nil {
-				return "", nil, err
-			}
-		}
-		if _, err := update.WriteString(TableNewsColumnTitle); err != nil {
-			return "", nil, err
-		}
-		if _, err := update.WriteString("="); err != nil {
-			return "", nil, err
-		}
-		if err := update.WritePlaceholder(); err != nil {
-			return "", nil, err
-		}
-		update.Add(p.Title)
-		update.Dirty = true
-
-	}
-	if p.UpdatedAt.Valid {
-		if update.Dirty {
-			if _, err := update.WriteString(", "); err != nil {
-				return "", nil, err
-			}
-		}
-		if _, err := update.WriteString(TableNewsColumnUpdatedAt); err != nil {
-			return "", nil, err
-		}
-		if _, err := update.WriteString("="); err != nil {
-			return "", nil, err
-		}
-		if err := update.WritePlaceholder(); err != nil {
-			return "", nil, err
-		}
-		update.Add(p.UpdatedAt)
-		update.Dirty = true
-
-	} else {
-		if update.Dirty {
-			if _, err := update.WriteString(", "); err != nil {
-				return "", nil, err
-			}
-		}
-		if _, err := update.WriteString(TableNewsColumnUpdatedAt); err != nil {
-			return "", nil, err
-		}
-		if _, err := update.WriteString("=NOW()"); err != nil {
-			return "", nil, err
-		}
-		update.Dirty = true
-	}
-	if p.Version.Valid {
-		if update.Dirty {
-			if _, err := update.WriteString(", "); err != nil {
-				return "", nil, err
-			}
-		}
-		if _, err := update.WriteString(TableNewsColumnVersion); err != nil {
-			return "", nil, err
-		}
-		if _, err := update.WriteString("="); err != nil {
-			return "", nil, err
-		}
-		if err := update.WritePlaceholder(); err != nil {
-			return "", nil, err
-		}
-		update.Add(p.Version)
-		update.Dirty = true
-
-	} else {
-		if update.Dirty {
-			if _, err := update.WriteString(", "); err != nil {
-				return "", nil, err
-			}
-		}
-		if _, err := update.WriteString(TableNewsColumnVersion); err != nil {
-			return "", nil, err
-		}
-		if _, err := update.WriteString("=version+1"); err != nil {
-			return "", nil, err
-		}
-		update.Dirty = true
-	}
-	if p.ViewsDistribution.Valid {
-		if update.Dirty {
-			if _, err := update.WriteString(", "); err != nil {
-				return "", nil, err
-			}
-		}
-		if _, err := update.WriteString(TableNewsColumnViewsDistribution); err != nil {
-			return "", nil, err
-		}
-		if _, err := update.WriteString("="); err != nil {
-			return "", nil, err
-		}
-		if err := update.WritePlaceholder(); err != nil {
-			return "", nil, err
-		}
-		update.Add(p.ViewsDistribution)
-		update.Dirty = true
-
-	}
-	if !update.Dirty {
-		return "", nil, errors.New("news update failure, nothing to update")
-	}
-	buf.WriteString(" SET ")
-	buf.ReadFrom(update)
-	buf.WriteString(" WHERE ")
-	update.WriteString(TableNewsColumnTitle)
-	update.WriteString("=")
-	update.WritePlaceholder()
-	update.Add(newsTitle)
-	update.WriteString(" AND ")
-	update.WriteString(TableNewsColumnLead)
-	update.WriteString("=")
-	update.WritePlaceholder()
-	update.Add(newsLead)
-	buf.ReadFrom(update)
-	buf.WriteString(" RETURNING ")
-	if len(r.Columns) > 0 {
-		buf.WriteString(strings.Join(r.Columns, ", "))
-	} else {
-		buf.WriteString("content, continue, created_at, day, id, lead, meta_data, score, title, updated_at, version, views_distribution")
-	}
-	return buf.String(), update.Args(), nil
-}
-
-func (r *NewsRepositoryBase) updateOneByTitle(ctx context.Context, tx *sql.Tx, newsTitle string, p *NewsPatch) (*NewsEntity, error) {
-	query, args, err := r.UpdateOneByTitleQuery(newsTitle, p)
-	if err != nil {
-		return nil, err
-	}
-	var ent NewsEntity
-	props, err := ent.Props(r.Columns...)
-	if err != nil {
-		return nil, err
-	}
-
-	var row *sql.Row
-	if tx == nil {
-		row = r.DB.QueryRowContext(ctx, query, args...)
-	} else {
-		row = tx.QueryRowContext(ctx, query, args...)
-	}
-	err = row.Scan(props...)
-	if r.Log != nil {
-		if tx == nil {
-			r.Log(err, TableNews, "update one by unique", query, args...)
-		} else {
-			r.Log(err, TableNews, "update one by unique tx", query, args...)
-		}
-	}
-	if err != nil {
-		return nil, err
-	}
-	return &ent, nil
-}
-
-func (r *NewsRepositoryBase) updateOneByTitleAndLead(ctx context.Context, tx *sql.Tx, newsTitle string, newsLead string, p *NewsPatch) (*NewsEntity, error) {
-	query, args, err := r.UpdateOneByTitleAndLeadQuery(newsTitle, newsLead, p)
-	if err != nil {
-		return nil, err
-	}
-	var ent NewsEntity
-	props, err := ent.Props(r.Columns...)
-	if err != nil {
-		return nil, err
-	}
-
-	var row *sql.Row
-	if tx == nil {
-		row = r.DB.QueryRowContext(ctx, query, args...)
-	} else {
-		row = tx.QueryRowContext(ctx, query, args...)
-	}
-	err = row.Scan(props...)
-	if r.Log != nil {
-		if tx == nil {
-			r.Log(err, TableNews, "update one by unique", query, args...)
-		} else {
-			r.Log(err, TableNews, "update one by unique tx", query, args...)
-		}
-	}
-	if err != nil {
-		return nil, err
-	}
-	return &ent, nil
-}
-
-func (r *NewsRepositoryBase) UpdateOneByTitle(ctx context.Context, newsTitle string, p *NewsPatch) (*NewsEntity, error) {
-	return r.updateOneByTitle(ctx, nil, newsTitle, p)
-}
-
-func (r *NewsRepositoryBase) UpdateOneByTitleAndLead(ctx context.Context, newsTitle string, newsLead string, p *NewsPatch) (*NewsEntity, error) {
-	return r.updateOneByTitleAndLead(ctx, nil, newsTitle, newsLead, p)
-}
-
-func (r *NewsRepositoryBase) UpsertQuery(e *NewsEntity, p *NewsPatch, inf ...string) (string, []interface{}, error) {
-	upsert := NewComposer(24)
-	columns := bytes.NewBuffer(nil)
-	buf := bytes.NewBufferString("INSERT INTO ")
-	buf.WriteString(r.Table)
-
-	if columns.Len() > 0 {
-		if _, err := columns.WriteString(", "); err != nil {
-			return "", nil, err
-		}
-	}
-	if _, err := columns.WriteString(TableNewsColumnContent); err != nil {
-		return "", nil, err
-	}
-	if upsert.Dirty {
-		if _, err := upsert.WriteString(", "); err != nil {
-			return "", nil, err
-		}
-	}
-	if err := upsert.WritePlaceholder(); err != nil {
-		return "", nil, err
-	}
-	upsert.Add(e.Content)
-	upsert.Dirty = true
-
-	if columns.Len() > 0 {
-		if _, err := columns.WriteString(", "); err != nil {
-			return "", nil, err
-		}
-	}
-	if _, err := columns.WriteString(TableNewsColumnContinue); err != nil {
-		return "", nil, err
-	}
-	if upsert.Dirty {
-		if _, err := upsert.WriteString(", "); err != nil {
-			return "", nil, err
-		}
-	}
-	if err := upsert.WritePlaceholder(); err != nil {
-		return "", nil, err
-	}
-	upsert.Add(e.Continue)
-	upsert.Dirty = true
-
-	if !e.CreatedAt.IsZero() {
-		if columns.Len() > 0 {
-			if _, err := columns.WriteString(", "); err != nil {
-				return "", nil, err
-			}
-		}
-		if _, err := columns.WriteString(TableNewsColumnCreatedAt); err != nil {
-			return "", nil, err
-		}
-		if upsert.Dirty {
-			if _, err := upsert.WriteString(", "); err != nil {
-				return "", nil, err
-			}
-		}
-		if err := upsert.WritePlaceholder(); err != nil {
-			return "", nil, err
-		}
-		upsert.Add(e.CreatedAt)
-		upsert.Dirty = true
-	}
-
-	if e.Day.Valid {
-		if columns.Len() > 0 {
-			if _, err := columns.WriteString(", "); err != nil {
-				return "", nil, err
-			}
-		}
-		if _, err := columns.WriteString(TableNewsColumnDay); err != nil {
-			return "", nil, err
-		}
-		if upsert.Dirty {
-			if _, err := upsert.WriteString(", "); err != nil {
-				return "", nil, err
-			}
-		}
-		if err := upsert.WritePlaceholder(); err != nil {
-			return "", nil, err
-		}
-		upsert.Add(e.Day)
-		upsert.Dirty = true
-	}
-
-	if e.Lead.Valid {
-		if columns.Len() > 0 {
-			if _, err := columns.WriteString(", "); err != nil {
-				return "", nil, err
-			}
-		}
-		if _, err := columns.WriteString(TableNewsColumnLead); err != nil {
-			return "", nil, err
-		}
-		if upsert.Dirty {
-			if _, err := upsert.WriteString(", "); err != nil {
-				return "", nil, err
-			}
-		}
-		if err := upsert.WritePlaceholder(); err != nil {
-			return "", nil, err
-		}
-		upsert.Add(e.Lead)
-		upsert.Dirty = true
-	}
-
-	if e.MetaData != nil {
-		if columns.Len() > 0 {
-			if _, err := columns.WriteString(", "); err != nil {
-				return "", nil, err
-			}
-		}
-		if _, err := columns.WriteString(TableNewsColumnMetaData); err != nil {
-			return "", nil, err
-		}
-		if upsert.Dirty {
-			if _, err := upsert.WriteString(", "); err != nil {
-				return "", nil, err
-			}
-		}
-		if err := upsert.WritePlaceholder(); err != nil {
-			return "", nil, err
-		}
-		upsert.Add(e.MetaData)
-		upsert.Dirty = true
-	}
-
-	if columns.Len() > 0 {
-		if _, err := columns.WriteString(", "); err != nil {
-			return "", nil, err
-		}
-	}
-	if _, err := columns.WriteString(TableNewsColumnScore); err != nil {
-		return "", nil, err
-	}
-	if upsert.Dirty {
-		if _, err := upsert.WriteString(", "); err != nil {
-			return "", nil, err
-		}
-	}
-	if err := upsert.WritePlaceholder(); err != nil {
-		return "", nil, err
-	}
-	upsert.Add(e.Score)
-	upsert.Dirty = true
-
-	if columns.Len() > 0 {
-		if _, err := columns.WriteString(", "); err != nil {
-			return "", nil, err
-		}
-	}
-	if _, err := columns.WriteString(TableNewsColumnTitle); err != nil {
-		return "", nil, err
-	}
-	if upsert.Dirty {
-		if _, err := upsert.WriteString(", "); err != nil {
-			return "", nil, err
-		}
-	}
-	if err := upsert.WritePlaceholder(); err != nil {
-		return "", nil, err
-	}
-	upsert.Add(e.Title)
-	upsert.Dirty = true
-
-	if e.UpdatedAt.Valid {
-		if columns.Len() > 0 {
-			if _, err := columns.WriteString(", "); err != nil {
-				return "", nil, err
-			}
-		}
-		if _, err := columns.WriteString(TableNewsColumnUpdatedAt); err != nil {
-			return "", nil, err
-		}
-		if upsert.Dirty {
-			if _, err := upsert.WriteString(", "); err != nil {
-				return "", nil, err
-			}
-		}
-		if err := upsert.WritePlaceholder(); err != nil {
-			return "", nil, err
-		}
-		upsert.Add(e.UpdatedAt)
-		upsert.Dirty = true
-	}
-
-	if columns.Len() > 0 {
-		if _, err := columns.WriteString(", "); err != nil {
-			return "", nil, err
-		}
-	}
-	if _, err := columns.WriteString(TableNewsColumnVersion); err != nil {
-		return "", nil, err
-	}
-	if upsert.Dirty {
-		if _, err := upsert.WriteString(", "); err != nil {
-			return "", nil, err
-		}
-	}
-	if err := upsert.WritePlaceholder(); err != nil {
-		return "", nil, err
-	}
-	upsert.Add(e.Version)
-	upsert.Dirty = true
-
-	if e.ViewsDistribution.Valid {
-		if columns.Len() > 0 {
-			if _, err := columns.WriteString(", "); err != nil {
-				return "", nil, err
-			}
-		}
-		if _, err := columns.WriteString(TableNewsColumnViewsDistribution); err != nil {
-			return "", nil, err
-		}
-		if upsert.Dirty {
-			if _, err := upsert.WriteString(", "); err != nil {
-				return "", nil, err
-			}
-		}
-		if err := upsert.WritePlaceholder(); err != nil {
-			return "", nil, err
-		}
-		upsert.Add(e.ViewsDistribution)
-		upsert.Dirty = true
-	}
-
-	if upsert.Dirty {
-		buf.WriteString(" (")
-		buf.ReadFrom(columns)
-		buf.WriteString(") VALUES (")
-		buf.ReadFrom(upsert)
-		buf.WriteString(")")
-	}
-	buf.WriteString(" ON CONFLICT ")
-	if len(inf) > 0 {
-		upsert.Dirty = false
-		if p.Content.Valid {
-			if upsert.Dirty {
-				if _, err := upsert.WriteString(", "); err != nil {
-					return "", nil, err
-				}
-			}
-			if _, err := upsert.WriteString(TableNewsColumnContent); err != nil {
-				return "", nil, err
-			}
-			if _, err := upsert.WriteString("="); err != nil {
-				return "", nil, err
-			}
-			if err := upsert.WritePlaceholder(); err != nil {
-				return "", nil, err
-			}
-			upsert.Add(p.Content)
-			upsert.Dirty = true
-
-		}
-		if p.Continue.Valid {
-			if upsert.Dirty {
-				if _, err := upsert.WriteString(", "); err != nil {
-					return "", nil, err
-				}
-			}
-			if _, err := upsert.WriteString(TableNewsColumnContinue); err != nil {
-				return "", nil, err
-			}
-			if _, err := upsert.WriteString("="); err != nil {
-				return "", nil, err
-			}
-			if err := upsert.WritePlaceholder(); err != nil {
-				return "", nil, err
-			}
-			upsert.Add(p.Continue)
-			upsert.Dirty = true
-
-		}
-		if p.CreatedAt.Valid {
-			if upsert.Dirty {
-				if _, err := upsert.WriteString(", "); err != nil {
-					return "", nil, err
-				}
-			}
-			if _, err := upsert.WriteString(TableNewsColumnCreatedAt); err != nil {
-				return "", nil, err
-			}
-			if _, err := upsert.WriteString("="); err != nil {
-				return "", nil, err
-			}
-			if err := upsert.WritePlaceholder(); err != nil {
-				return "", nil, err
-			}
-			upsert.Add(p.CreatedAt)
-			upsert.Dirty = true
-
-		}
-		if p.Day.Valid {
-			if upsert.Dirty {
-				if _, err := upsert.WriteString(", "); err != nil {
-					return "", nil, err
-				}
-			}
-			if _, err := upsert.WriteString(TableNewsColumnDay); err != nil {
-				return "", nil, err
-			}
-			if _, err := upsert.WriteString("="); err != nil {
-				return "", nil, err
-			}
-			if err := upsert.WritePlaceholder(); err != nil {
-				return "", nil, err
-			}
-			upsert.Add(p.Day)
-			upsert.Dirty = true
-
-		}
-		if p.Lead.Valid {
-			if upsert.Dirty {
-				if _, err := upsert.WriteString(", "); err != nil {
-					return "", nil, err
-				}
-			}
-			if _, err := upsert.WriteString(TableNewsColumnLead); err != nil {
-				return "", nil, err
-			}
-			if _, err := upsert.WriteString("="); err != nil {
-				return "", nil, err
-			}
-			if err := upsert.WritePlaceholder(); err != nil {
-				return "", nil, err
-			}
-			upsert.Add(p.Lead)
-			upsert.Dirty = true
-
-		}
-		if p.MetaData != nil {
-			if upsert.Dirty {
-				if _, err := upsert.WriteString(", "); err != nil {
-					return "", nil, err
-				}
-			}
-			if _, err := upsert.WriteString(TableNewsColumnMetaData); err != nil {
-				return "", nil, err
-			}
-			if _, err := upsert.WriteString("="); err != nil {
-				return "", nil, err
-			}
-			if err := upsert.WritePlaceholder(); err != nil {
-				return "", nil, err
-			}
-			upsert.Add(p.MetaData)
-			upsert.Dirty = true
-
-		}
-		if p.Score.Valid {
-			if upsert.Dirty {
-				if _, err := upsert.WriteString(", "); err != nil {
-					return "", nil, err
-				}
-			}
-			if _, err := upsert.WriteString(TableNewsColumnScore); err != nil {
-				return "", nil, err
-			}
-			if _, err := upsert.WriteString("="); err != nil {
-				return "", nil, err
-			}
-			if err := upsert.WritePlaceholder(); err != nil {
-				return "", nil, err
-			}
-			upsert.Add(p.Score)
-			upsert.Dirty = true
-
-		}
-		if p.Title.Valid {
-			if upsert.Dirty {
-				if _, err := upsert.WriteString(", "); err != nil {
-					return "", nil, err
-				}
-			}
-			if _, err := upsert.WriteString(TableNewsColumnTitle); err != nil {
-				return "", nil, err
-			}
-			if _, err := upsert.WriteString("="); err != nil {
-				return "", nil, err
-			}
-			if err := upsert.WritePlaceholder(); err != nil {
-				return "", nil, err
-			}
-			upsert.Add(p.Title)
-			upsert.Dirty = true
-
-		}
-		if p.UpdatedAt.Valid {
-			if upsert.Dirty {
-				if _, err := upsert.WriteString(", "); err != nil {
-					return "", nil, err
-				}
-			}
-			if _, err := upsert.WriteString(TableNewsColumnUpdatedAt); err != nil {
-				return "", nil, err
-			}
-			if _, err := upsert.WriteString("="); err != nil {
-				return "", nil, err
-			}
-			if err := upsert.WritePlaceholder(); err != nil {
-				return "", nil, err
-			}
-			upsert.Add(p.UpdatedAt)
-			upsert.Dirty = true
-
-		} else {
-			if upsert.Dirty {
-				if _, err := upsert.WriteString(", "); err != nil {
-					return "", nil, err
-				}
-			}
-			if _, err := upsert.WriteString(TableNewsColumnUpdatedAt); err != nil {
-				return "", nil, err
-			}
-			if _, err := upsert.WriteString("=NOW()"); err != nil {
-				return "", nil, err
-			}
-			upsert.Dirty = true
-		}
-		if p.Version.Valid {
-			if upsert.Dirty {
-				if _, err := upsert.WriteString(", "); err != nil {
-					return "", nil, err
-				}
-			}
-			if _, err := upsert.WriteString(TableNewsColumnVersion); err != nil {
-				return "", nil, err
-			}
-			if _, err := upsert.WriteString("="); err != nil {
-				return "", nil, err
-			}
-			if err := upsert.WritePlaceholder(); err != nil {
-				return "", nil, err
-			}
-			upsert.Add(p.Version)
-			upsert.Dirty = true
-
-		} else {
-			if upsert.Dirty {
-				if _, err := upsert.WriteString(", "); err != nil {
-					return "", nil, err
-				}
-			}
-			if _, err := upsert.WriteString(TableNewsColumnVersion); err != nil {
-				return "", nil, err
-			}
-			if _, err := upsert.WriteString("=version+1"); err != nil {
-				return "", nil, err
-			}
-			upsert.Dirty = true
-		}
-		if p.ViewsDistribution.Valid {
-			if upsert.Dirty {
-				if _, err := upsert.WriteString(", "); err != nil {
-					return "", nil, err
-				}
-			}
-			if _, err := upsert.WriteString(TableNewsColumnViewsDistribution); err != nil {
-				return "", nil, err
-			}
-			if _, err := upsert.WriteString("="); err != nil {
-				return "", nil, err
-			}
-			if err := upsert.WritePlaceholder(); err != nil {
-				return "", nil, err
-			}
-			upsert.Add(p.ViewsDistribution)
-			upsert.Dirty = true
-
-		}
-	}
-	if len(inf) > 0 && upsert.Dirty {
-		buf.WriteString("(")
-		for j, i := range inf {
-			if j != 0 {
-				buf.WriteString(", ")
-			}
-			buf.WriteString(i)
-		}
-		buf.WriteString(")")
-		buf.WriteString(" DO UPDATE SET ")
-		buf.ReadFrom(upsert)
-	} else {
-		buf.WriteString(" DO NOTHING ")
-	}
-	if upsert.Dirty {
-		buf.WriteString(" RETURNING ")
-		if len(r.Columns) > 0 {
-			buf.WriteString(strings.Join(r.Columns, ", "))
-		} else {
-			buf.WriteString("content, continue, created_at, day, id, lead, meta_data, score, title, updated_at, version, views_distribution")
-		}
-	}
-	return buf.String(), upsert.Args(), nil
-}
-
-func (r *NewsRepositoryBase) upsert(ctx context.Context, tx *sql.Tx, e *NewsEntity, p *NewsPatch, inf ...string) (*NewsEntity, error) {
-	query, args, err := r.UpsertQuery(e, p, inf...)
-	if err != nil {
-		return nil, err
-	}
-
-	var row *sql.Row
-	if tx == nil {
-		row = r.DB.QueryRowContext(ctx, query, args...)
-	} else {
-		row = tx.QueryRowContext(ctx, query, args...)
-	}
-	err = row.Scan(
-		&e.Content,
-		&e.Continue,
-		&e.CreatedAt,
-		&e.Day,
-		&e.ID,
-		&e.Lead,
-		&e.MetaData,
-		&e.Score,
-		&e.Title,
-		&e.UpdatedAt,
-		&e.Version,
-		&e.ViewsDistribution,
-	)
-	if r.Log != nil {
-		if tx == nil {
-			r.Log(err, TableNews, "upsert", query, args...)
-		} else {
-			r.Log(err, TableNews, "upsert tx", query, args...)
-		}
-	}
-	if err != nil {
-		return nil, err
-	}
-	return e, nil
-}
-
-func (r *NewsRepositoryBase) Upsert(ctx context.Context, e *NewsEntity, p *NewsPatch, inf ...string) (*NewsEntity, error) {
-	return r.upsert(ctx, nil, e, p, inf...)
-}
-
-func (r *NewsRepositoryBase) count(ctx context.Context, tx *sql.Tx, exp *NewsCountExpr) (int64, error) {
-	query, args, err := r.FindQuery(&NewsFindExpr{
-		Where:   exp.Where,
-		Columns: []string{"COUNT(*)"},
-	})
-	if err != nil {
-		return 0, err
-	}
-	var count int64
-	if tx == nil {
-		err = r.DB.QueryRowContext(ctx, query, args...).Scan(&count)
-	} else {
-		err = tx.QueryRowContext(ctx, query, args...).Scan(&count)
-	}
-	if r.Log != nil {
-		if tx == nil {
-			r.Log(err, TableNews, "count", query, args...)
-		} else {
-			r.Log(err, TableNews, "count tx", query, args...)
-		}
-	}
-	if err != nil {
-		return 0, err
-	}
-	return count, nil
-}
-
-func (r *NewsRepositoryBase) Count(ctx context.Context, exp *NewsCountExpr) (int64, error) {
-	return r.count(ctx, nil, exp)
-}
-
-func (r *NewsRepositoryBase) deleteOneByID(ctx context.Context, tx *sql.Tx, pk int64) (int64, error) {
-	find := NewComposer(12)
-	find.WriteString("DELETE FROM ")
-	find.WriteString(TableNews)
-	find.WriteString(" WHERE ")
-	find.WriteString(TableNewsColumnID)
-	find.WriteString("=")
-	find.WritePlaceholder()
-	find.Add(pk)
-	var (
-		err error
-		res sql.Result
-	)
-	if tx == nil {
-		res, err = r.DB.ExecContext(ctx, find.String(), find.Args()...)
-	} else {
-		res, err = tx.ExecContext(ctx, find.String(), find.Args()...)
-	}
-	if err != nil {
-		return 0, err
-	}
-
-	return res.RowsAffected()
-}
-
-func (r *NewsRepositoryBase) DeleteOneByID(ctx context.Context, pk int64) (int64, error) {
-	return r.deleteOneByID(ctx, nil, pk)
-}
-
-type NewsRepositoryBaseTx struct {
-	base *NewsRepositoryBase
-	tx   *sql.Tx
-}
-
-func (r NewsRepositoryBaseTx) Commit() error {
-	return r.tx.Commit()
-}
-
-func (r NewsRepositoryBaseTx) Rollback() error {
-	return r.tx.Rollback()
-}
-
-func (r *NewsRepositoryBaseTx) Insert(ctx context.Context, e *NewsEntity) (*NewsEntity, error) {
-	return r.base.insert(ctx, r.tx, e)
-}
-
-func (r *NewsRepositoryBaseTx) Find(ctx context.Context, fe *NewsFindExpr) ([]*NewsEntity, error) {
-	return r.base.find(ctx, r.tx, fe)
-}
-
-func (r *NewsRepositoryBaseTx) FindIter(ctx context.Context, fe *NewsFindExpr) (*NewsIterator, error) {
-	return r.base.findIter(ctx, r.tx, fe)
-}
-
-func (r *NewsRepositoryBaseTx) FindOneByID(ctx context.Context, pk int64) (*NewsEntity, error) {
-	return r.base.findOneByID(ctx, r.tx, pk)
-}
-
-func (r *NewsRepositoryBaseTx) UpdateOneByID(ctx context.Context, pk int64, p *NewsPatch) (*NewsEntity, error) {
-	return r.base.updateOneByID(ctx, r.tx, pk, p)
-}
-
-func (r *NewsRepositoryBaseTx) UpdateOneByTitle(ctx context.Context, newsTitle string, p *NewsPatch) (*NewsEntity, error) {
-	return r.base.updateOneByTitle(ctx, r.tx, newsTitle, p)
-}
-
-func (r *NewsRepositoryBaseTx) UpdateOneByTitleAndLead(ctx context.Context, newsTitle string, newsLead string, p *NewsPatch) (*NewsEntity, error) {
-	return r.base.updateOneByTitleAndLead(ctx, r.tx, newsTitle, newsLead, p)
-}
-
-func (r *NewsRepositoryBaseTx) Upsert(ctx context.Context, e *NewsEntity, p *NewsPatch, inf ...string) (*NewsEntity, error) {
-	return r.base.upsert(ctx, r.tx, e, p, inf...)
-}
-
-func (r *NewsRepositoryBaseTx) Count(ctx context.Context, exp *NewsCountExpr) (int64, error) {
-	return r.base.count(ctx, r.tx, exp)
-}
-
-func (r *NewsRepositoryBaseTx) DeleteOneByID(ctx context.Context, pk int64) (int64, error) {
-	return r.base.deleteOneByID(ctx, r.tx, pk)
-}
-
-const (
-	TableCommentConstraintNewsTitleForeignKey = "example.comment_news_title_fkey"
-	TableCommentConstraintNewsTitleIndex      = "example.comment_news_title_idx"
-	TableCommentConstraintNewsIDForeignKey    = "example.comment_news_id_fkey"
-)
-
-const (
-	TableComment                 = "example.comment"
-	TableCommentColumnContent    = "content"
-	TableCommentColumnCreatedAt  = "created_at"
-	TableCommentColumnID         = "id"
-	TableCommentColumnIDMultiply = "id_multiply"
-	TableCommentColumnNewsID     = "news_id"
-	TableCommentColumnNewsTitle  = "news_title"
-	TableCommentColumnRightNow   = "right_now"
-	TableCommentColumnUpdatedAt  = "updated_at"
-)
-
-var TableCommentColumns = []string{
-	TableCommentColumnContent,
-	TableCommentColumnCreatedAt,
-	TableCommentColumnID,
-	TableCommentColumnIDMultiply,
-	TableCommentColumnNewsID,
-	TableCommentColumnNewsTitle,
-	TableCommentColumnRightNow,
-	TableCommentColumnUpdatedAt,
-}
-
-// CommentEntity ...
-type CommentEntity struct {
-	// Content ...
-	Content string
-	// CreatedAt ...
-	CreatedAt time.Time
-	// ID ...
-	ID sql.NullInt64
-	// IDMultiply ...
-	// IDMultiply is read only
-	IDMultiply int64
-	// NewsID ...
-	NewsID int64
-	// NewsTitle ...
-	NewsTitle string
-	// RightNow ...
-	// RightNow is read only
-	RightNow time.Time
-	// UpdatedAt ...
-	UpdatedAt pq.NullTime
-	// NewsByTitle ...
-	NewsByTitle *NewsEntity
-	// NewsByID ...
-	NewsByID *NewsEntity
-}
-
-func (e *CommentEntity) Prop(cn string) (interface{}, bool) {
-	switch cn {
-
-	case TableCommentColumnContent:
-		return &e.Content, true
-	case TableCommentColumnCreatedAt:
-		return &e.CreatedAt, true
-	case TableCommentColumnID:
-		return &e.ID, true
-	case TableCommentColumnIDMultiply:
-		return &e.IDMultiply, true
-	case TableCommentColumnNewsID:
-		return &e.NewsID, true
-	case TableCommentColumnNewsTitle:
-		return &e.NewsTitle, true
-	case TableCommentColumnRightNow:
-		return &e.RightNow, true
-	case TableCommentColumnUpdatedAt:
-		return &e.UpdatedAt, true
-	default:
-		return nil, false
-	}
-}
-
-func (e *CommentEntity) Props(cns ...string) ([]interface{}, error) {
-	if len(cns) == 0 {
-		cns = TableCommentColumns
-	}
-	res := make([]interface{}, 0, len(cns))
-	for _, cn := range cns {
-		if prop, ok := e.Prop(cn); ok {
-			res = append(res, prop)
-		} else {
-			return nil, fmt.Errorf("unexpected column provided: %s", cn)
-		}
-	}
-	return res, nil
-}
-
-// ScanCommentRows helps to scan rows straight to the slice of entities.
-func ScanCommentRows(rows Rows) (entities []*CommentEntity, err error) {
-	for rows.Next() {
-		var ent CommentEntity
-		err = rows.Scan(
-			&ent.Content,
-			&ent.CreatedAt,
-			&ent.ID,
-			&ent.IDMultiply,
-			&ent.NewsID,
-			&ent.NewsTitle,
-			&ent.RightNow,
-			&ent.UpdatedAt,
-		)
-		if err != nil {
-			return
-		}
-
-		entities = append(entities, &ent)
-	}
-	if err = rows.Err(); err != nil {
-		return
-	}
-
-	return
-}
-
-// CommentIterator is not thread safe.
-type CommentIterator struct {
-	rows Rows
-	cols []string
-	expr *CommentFindExpr
-}
-
-func (i *CommentIterator) Next() bool {
-	return i.rows.Next()
-}
-
-func (i *CommentIterator) Close() error {
-	return i.rows.Close()
-}
-
-func (i *CommentIterator) Err() error {
-	return i.rows.Err()
-}
-
-// Columns is wrapper around sql.Rows.Columns method, that also cache output inside iterator.
-func (i *CommentIterator) Columns() ([]string, error) {
-	if i.cols == nil {
-		cols, err := i.rows.Columns()
-		if err != nil {
-			return nil, err
-		}
-		i.cols = cols
-	}
-	return i.cols, nil
-}
-
-// Ent is wrapper around Comment method that makes iterator more generic.
-func (i *CommentIterator) Ent() (interface{}, error) {
-	return i.Comment()
-}
-
-func (i *CommentIterator) Comment() (*CommentEntity, error) {
-	var ent CommentEntity
-	cols, err := i.Columns()
-	if err != nil {
-		return nil, err
-	}
-
-	props, err := ent.Props(cols...)
-	if err != nil {
-		return nil, err
-	}
-	var prop []interface{}
-	if i.expr.JoinNewsByTitle != nil && i.expr.JoinNewsByTitle.Kind.Actionable() && i.expr.JoinNewsByTitle.Fetch {
-		ent.NewsByTitle = &NewsEntity{}
-		if prop, err = ent.NewsByTitle.Props(); err != nil {
-			return nil, err
-		}
-		props = append(props, prop...)
-	}
-	if i.expr.JoinNewsByID != nil && i.expr.JoinNewsByID.Kind.Actionable() && i.expr.JoinNewsByID.Fetch {
-		ent.NewsByID = &NewsEntity{}
-		if prop, err = ent.NewsByID.Props(); err != nil {
-			return nil, err
-		}
-		props = append(props, prop...)
-	}
-	if err := i.rows.Scan(props...); err != nil {
-		return nil, err
-	}
-	return &ent, nil
-}
-
-type CommentCriteria struct {
-	Content                sql.NullString
-	CreatedAt              pq.NullTime
-	ID                     sql.NullInt64
-	IDMultiply             sql.NullInt64
-	NewsID                 sql.NullInt64
-	NewsTitle              sql.NullString
-	RightNow               pq.NullTime
-	UpdatedAt              pq.NullTime
-	operator               string
-	child, sibling, parent *CommentCriteria
-}
-
-func CommentOperand(operator string, operands ...*CommentCriteria) *CommentCriteria {
-	if len(operands) == 0 {
-		return &CommentCriteria{operator: operator}
-	}
-
-	parent := &CommentCriteria{
-		operator: operator,
-		child:    operands[0],
-	}
-
-	for i := 0; i < len(operands); i++ {
-		if i < len(operands)-1 {
-			operands[i].sibling = operands[i+1]
-		}
-		operands[i].parent = parent
-	}
-
-	return parent
-}
-
-func CommentOr(operands ...*CommentCriteria) *CommentCriteria {
-	return CommentOperand("OR", operands...)
-}
-
-func CommentAnd(operands ...*CommentCriteria) *CommentCriteria {
-	return CommentOperand("AND", operands...)
-}
-
-type CommentFindExpr struct {
-	Where           *CommentCriteria
-	Offset, Limit   int64
-	Columns         []string
-	OrderBy         []RowOrder
-	JoinNewsByTitle *NewsJoin
-	JoinNewsByID    *NewsJoin
-}
-
-type CommentJoin struct {
-	On, Where       *CommentCriteria
-	Fetch           bool
-	Kind            JoinType
-	JoinNewsByTitle *NewsJoin
-	JoinNewsByID    *NewsJoin
-}
-
-type CommentCountExpr struct {
-	Where           *CommentCriteria
-	JoinNewsByTitle *NewsJoin
-	JoinNewsByID    *NewsJoin
-}
-
-type CommentPatch struct {
-	Content    sql.NullString
-	CreatedAt  pq.NullTime
-	ID         sql.NullInt64
-	IDMultiply sql.NullInt64
-	NewsID     sql.NullInt64
-	NewsTitle  sql.NullString
-	RightNow   pq.NullTime
-	UpdatedAt  pq.NullTime
-}
-
-type CommentRepositoryBase struct {
-	Table   string
-	Columns []string
-	DB      *sql.DB
-	Log     LogFunc
-}
-
-func (r *CommentRepositoryBase) Tx(tx *sql.Tx) (*CommentRepositoryBaseTx, error) {
-	return &CommentRepositoryBaseTx{
-		base: r,
-		tx:   tx,
-	}, nil
-}
-
-func (r *CommentRepositoryBase) BeginTx(ctx context.Context) (*CommentRepositoryBaseTx, error) {
-	tx, err := r.DB.BeginTx(ctx, nil)
-	if err != nil {
-		return nil, err
-	}
-	return r.Tx(tx)
-}
-
-func (r CommentRepositoryBase) RunInTransaction(ctx context.Context, fn func(rtx *CommentRepositoryBaseTx) error, attempts int) (err error) {
-	return RunInTransaction(r.DB, ctx, func(tx *sql.Tx) error {
-		rtx, err := r.Tx(tx)
-		if err != nil {
-			return err
-		}
-		return fn(rtx)
-	}, attempts)
-}
-
-func (r *CommentRepositoryBase) InsertQuery(e *CommentEntity, read bool) (string, []interface{}, error) {
-	insert := NewComposer(8)
-	columns := bytes.NewBuffer(nil)
-	buf := bytes.NewBufferString("INSERT INTO ")
-	buf.WriteString(r.Table)
-
-	if columns.Len() > 0 {
-		if _, err := columns.WriteString(", "); err != nil {
-			return "", nil, err
-		}
-	}
-	if _, err := columns.WriteString(TableCommentColumnContent); err != nil {
-		return "", nil, err
-	}
-	if insert.Dirty {
-		if _, err := insert.WriteString(", "); err != nil {
-			return "", nil, err
-		}
-	}
-	if err := insert.WritePlaceholder(); err != nil {
-		return "", nil, err
-	}
-	insert.Add(e.Content)
-	insert.Dirty = true
-
-	if !e.CreatedAt.IsZero() {
-		if columns.Len() > 0 {
-			if _, err := columns.WriteString(", "); err != nil {
-				return "", nil, err
-			}
-		}
-		if _, err := columns.WriteString(TableCommentColumnCreatedAt); err != nil {
-			return "", nil, err
-		}
-		if insert.Dirty {
-			if _, err := insert.WriteString(", "); err != nil {
-				return "", nil, err
-			}
-		}
-		if err := insert.WritePlaceholder(); err != nil {
-			return "", nil, err
-		}
-		insert.Add(e.CreatedAt)
-		insert.Dirty = true
-	}
-
-	if columns.Len() > 0 {
-		if _, err := columns.WriteString(", "); err != nil {
-			return "", nil, err
-		}
-	}
-	if _, err := columns.WriteString(TableCommentColumnNewsID); err != nil {
-		return "", nil, err
-	}
-	if insert.Dirty {
-		if _, err := insert.WriteString(", "); err != nil {
-			return "", nil, err
-		}
-	}
-	if err := insert.WritePlaceholder(); err != nil {
-		return "", nil, err
-	}
-	insert.Add(e.NewsID)
-	insert.Dirty = true
-
-	if columns.Len() > 0 {
-		if _, err := columns.WriteString(", "); err != nil {
-			return "", nil, err
-		}
-	}
-	if _, err := columns.WriteString(TableCommentColumnNewsTitle); err != nil {
-		return "", nil, err
-	}
-	if insert.Dirty {
-		if _, err := insert.WriteString(", "); err != nil {
-			return "", nil, err
-		}
-	}
-	if err := insert.WritePlaceholder(); err != nil {
-		return "", nil, err
-	}
-	insert.Add(e.NewsTitle)
-	insert.Dirty = true
-
-	if e.UpdatedAt.Valid {
-		if columns.Len() > 0 {
-			if _, err := columns.WriteString(", "); err != nil {
-				return "", nil, err
-			}
-		}
-		if _, err := columns.WriteString(TableCommentColumnUpdatedAt); err != nil {
-			return "", nil, err
-		}
-		if insert.Dirty {
-			if _, err := insert.WriteString(", "); err != nil {
-				return "", nil, err
-			}
-		}
-		if err := insert.WritePlaceholder(); err != nil {
-			return "", nil, err
-		}
-		insert.Add(e.UpdatedAt)
-		insert.Dirty = true
-	}
-
-	if columns.Len() > 0 {
-		buf.WriteString(" (")
-		buf.ReadFrom(columns)
-		buf.WriteString(") VALUES (")
-		buf.ReadFrom(insert)
-		buf.WriteString(") ")
-		if read {
-			buf.WriteString("RETURNING ")
-			if len(r.Columns) > 0 {
-				buf.WriteString(strings.Join(r.Columns, ", "))
-			} else {
-				buf.WriteString("content, created_at, id, multiply(id, id) AS id_multiply, news_id, news_title, now() AS right_now, updated_at")
-			}
-		}
-	}
-	return buf.String(), insert.Args(), nil
-}
-
-func (r *CommentRepositoryBase) insert(ctx context.Context, tx *sql.Tx, e *CommentEntity) (*CommentEntity, error) {
-	query, args, err := r.InsertQuery(e, true)
-	if err != nil {
-		return nil, err
-	}
-
-	var row *sql.Row
-	if tx == nil {
-		row = r.DB.QueryRowContext(ctx, query, args...)
-	} else {
-		row = tx.QueryRowContext(ctx, query, args...)
-	}
-	err = row.Scan(
-		&e.Content,
-		&e.CreatedAt,
-		&e.ID,
-		&e.IDMultiply,
-		&e.NewsID,
-		&e.NewsTitle,
-		&e.RightNow,
-		&e.UpdatedAt,
-	)
-	if r.Log != nil {
-		if tx == nil {
-			r.Log(err, TableComment, "insert", query, args...)
-		} else {
-			r.Log(err, TableComment, "insert tx", query, args...)
-		}
-	}
-	if err != nil {
-		return nil, err
-	}
-	return e, nil
-}
-
-func (r *CommentRepositoryBase) Insert(ctx context.Context, e *CommentEntity) (*CommentEntity, error) {
-	return r.insert(ctx, nil, e)
-}
-
-func CommentCriteriaWhereClause(comp *Composer, c *CommentCriteria, id int) error {
-	if c.child == nil {
-		return _CommentCriteriaWhereClause(comp, c, id)
-	}
-	node := c
-	sibling := false
-	for {
-		if !sibling {
-			if node.child != nil {
-				if node.parent != nil {
-					comp.WriteString("(")
-				}
-				node = node.child
-				continue
-			} else {
-				comp.Dirty = false
-				comp.WriteString("(")
-				if err := _CommentCriteriaWhereClause(comp, node, id); err != nil {
-					return err
-				}
-				comp.WriteString(")")
-			}
-		}
-		if node.sibling != nil {
-			sibling = false
-			comp.WriteString(" ")
-			comp.WriteString(node.parent.operator)
-			comp.WriteString(" ")
-			node = node.sibling
-			continue
-		}
-		if node.parent != nil {
-			sibling = true
-			if node.parent.parent != nil {
-				comp.WriteString(")")
-			}
-			node = node.parent
-			continue
-		}
-
-		break
-	}
-	return nil
-}
-
-func _CommentCriteriaWhereClause(comp *Composer, c *CommentCriteria, id int) error {
-	if c.Content.Valid {
-		if comp.Dirty {
-			comp.WriteString(" AND ")
-		}
-		if err := comp.WriteAlias(id); err != nil {
-			return err
-		}
-		if _, err := comp.WriteString(TableCommentColumnContent); err != nil {
-			return err
-		}
-		if _, err := comp.WriteString("="); err != nil {
-			return err
-		}
-		if err := comp.WritePlaceholder(); err != nil {
-			return err
-		}
-		comp.Add(c.Content)
-		comp.Dirty = true
-	}
-	if c.CreatedAt.Valid {
-		if comp.Dirty {
-			comp.WriteString(" AND ")
-		}
-		if err := comp.WriteAlias(id); err != nil {
-			return err
-		}
-		if _, err := comp.WriteString(TableCommentColumnCreatedAt); err != nil {
-			return err
-		}
-		if _, err := comp.WriteString("="); err != nil {
-			return err
-		}
-		if err := comp.WritePlaceholder(); err != nil {
-			return err
-		}
-		comp.Add(c.CreatedAt)
-		comp.Dirty = true
-	}
-	// id is an empty struct, ignore
-
-	if c.IDMultiply.Valid {
-		if comp.Dirty {
-			comp.WriteString(" AND ")
-		}
-		if _, err := comp.WriteString("multiply"); err != nil {
-			return err
-		}
-		if _, err := comp.WriteString("("); err != nil {
-			return err
-		}
-		if err := comp.WriteAlias(id); err != nil {
-			return err
-		}
-		if _, err := comp.WriteString(TableCommentColumnID); err != nil {
-			return err
-		}
-		if _, err := comp.WriteString(", "); err != nil {
-			return err
-		}
-		if err := comp.WriteAlias(id); err != nil {
-			return err
-		}
-		if _, err := comp.WriteString(TableCommentColumnID); err != nil {
-			return err
-		}
-		if _, err := comp.WriteString(")"); err != nil {
-			return err
-		}
-		if _, err := comp.WriteString("="); err != nil {
-			return err
-		}
-		if err := comp.WritePlaceholder(); err != nil {
-			return err
-		}
-		comp.Add(c.IDMultiply)
-		comp.Dirty = true
-	}
-	if c.NewsID.Valid {
-		if comp.Dirty {
-			comp.WriteString(" AND ")
-		}
-		if err := comp.WriteAlias(id); err != nil {
-			return err
-		}
-		if _, err := comp.WriteString(TableCommentColumnNewsID); err != nil {
-			return err
-		}
-		if _, err := comp.WriteString("="); err != nil {
-			return err
-		}
-		if err := comp.WritePlaceholder(); err != nil {
-			return err
-		}
-		comp.Add(c.NewsID)
-		comp.Dirty = true
-	}
-	if c.NewsTitle.Valid {
-		if comp.Dirty {
-			comp.WriteString(" AND ")
-		}
-		if err := comp.WriteAlias(id); err != nil {
-			return err
-		}
-		if _, err := comp.WriteString(TableCommentColumnNewsTitle); err != nil {
-			return err
-		}
-		if _, err := comp.WriteString("="); err != nil {
-			return err
-		}
-		if err := comp.WritePlaceholder(); err != nil {
-			return err
-		}
-		comp.Add(c.NewsTitle)
-		comp.Dirty = true
-	}
-	if c.RightNow.Valid {
-		if comp.Dirty {
-			comp.WriteString(" AND ")
-		}
-		if _, err := comp.WriteString("now"); err != nil {
-			return err
-		}
-		if _, err := comp.WriteString("("); err != nil {
-			return err
-		}
-		if _, err := comp.WriteString(")"); err != nil {
-			return err
-		}
-		if _, err := comp.WriteString("="); err != nil {
-			return err
-		}
-		if err := comp.WritePlaceholder(); err != nil {
-			return err
-		}
-		comp.Add(c.RightNow)
-		comp.Dirty = true
-	}
-	if c.UpdatedAt.Valid {
-		if comp.Dirty {
-			comp.WriteString(" AND ")
-		}
-		if err := comp.WriteAlias(id); err != nil {
-			return err
-		}
-		if _, err := comp.WriteString(TableCommentColumnUpdatedAt); err != nil {
-			return err
-		}
-		if _, err := comp.WriteString("="); err != nil {
-			return err
-		}
-		if err := comp.WritePlaceholder(); err != nil {
-			return err
-		}
-		comp.Add(c.UpdatedAt)
-		comp.Dirty = true
-	}
-	return nil
-}
-
-func (r *CommentRepositoryBase) FindQuery(fe *CommentFindExpr) (string, []interface{}, error) {
-	comp := NewComposer(8)
-	buf := bytes.NewBufferString("SELECT ")
-	if len(fe.Columns) == 0 {
-		buf.WriteString("t0.content, t0.created_at, t0.id, multiply(t0.id, t0.id) AS id_multiply, t0.news_id, t0.news_title, now() AS right_now, t0.updated_at")
-	} else {
-		buf.WriteString(strings.Join(fe.Columns, ", "))
-	}
-	if fe.JoinNewsByTitle != nil && fe.JoinNewsByTitle.Kind.Actionable() && fe.JoinNewsByTitle.Fetch {
-		buf.WriteString(", t1.content, t1.continue, t1.created_at, t1.day, t1.id, t1.lead, t1.meta_data, t1.score, t1.title, t1.updated_at, t1.version, t1.views_distribution")
-	}
-	if fe.JoinNewsByID != nil && fe.JoinNewsByID.Kind.Actionable() && fe.JoinNewsByID.Fetch {
-		buf.WriteString(", t2.content, t2.continue, t2.created_at, t2.day, t2.id, t2.lead, t2.meta_data, t2.score, t2.title, t2.updated_at, t2.version, t2.views_distribution")
-	}
-	buf.WriteString(" FROM ")
-	buf.WriteString(r.Table)
-	buf.WriteString(" AS t0")
-	if fe.JoinNewsByTitle != nil && fe.JoinNewsByTitle.Kind.Actionable() {
-		joinClause(comp, fe.JoinNewsByTitle.Kind, "example.news AS t1 ON t0.news_title=t1.title")
-		if fe.JoinNewsByTitle.On != nil {
-			comp.Dirty = true
-			if err := NewsCriteriaWhereClause(comp, fe.JoinNewsByTitle.On, 1); err != nil {
-				return "", nil, err
-			}
-		}
-	}
-	if fe.JoinNewsByID != nil && fe.JoinNewsByID.Kind.Actionable() {
-		joinClause(comp, fe.JoinNewsByID.Kind, "example.news AS t2 ON t0.news_id=t2.id")
-		if fe.JoinNewsByID.On != nil {
-			comp.Dirty = true
-			if err := NewsCriteriaWhereClause(comp, fe.JoinNewsByID.On, 2); err != nil {
-				return "", nil, err
-			}
-		}
-	}
-	if comp.Dirty {
-		buf.ReadFrom(comp)
-		comp.Dirty = false
-	}
-	if fe.Where != nil {
-		if err := CommentCriteriaWhereClause(comp, fe.Where, 0); err != nil {
-			return "", nil, err
-		}
-	}
-	if fe.JoinNewsByTitle != nil && fe.JoinNewsByTitle.Kind.Actionable() && fe.JoinNewsByTitle.Where != nil {
-		if err := NewsCriteriaWhereClause(comp, fe.JoinNewsByTitle.Where, 1); err != nil {
-			return "", nil, err
-		}
-	}
-	if fe.JoinNewsByID != nil && fe.JoinNewsByID.Kind.Actionable() && fe.JoinNewsByID.Where != nil {
-		if err := NewsCriteriaWhereClause(comp, fe.JoinNewsByID.Where, 2); err != nil {
-			return "", nil, err
-		}
-	}
-	if comp.Dirty {
-		if _, err := buf.WriteString(" WHERE "); err != nil {
-			return "", nil, err
-		}
-		buf.ReadFrom(comp)
-	}
-
-	if len(fe.OrderBy) > 0 {
-		i := 0
-		for _, order := range fe.OrderBy {
-			for _, columnName := range TableCommentColumns {
-				if order.Name == columnName {
-					if i == 0 {
-						comp.WriteString(" ORDER BY ")
-					}
-					if i > 0 {
-						if _, err := comp.WriteString(", "); err != nil {
-							return "", nil, err
-						}
-					}
-					if _, err := comp.WriteString(order.Name); err != nil {
-						return "", nil, err
-					}
-					if order.Descending {
-						if _, err := comp.WriteString(" DESC"); err != nil {
-							return "", nil, err
-						}
-					}
-					i++
-					break
-				}
-			}
-		}
-	}
-	if fe.Offset > 0 {
-		if _, err := comp.WriteString(" OFFSET "); err != nil {
-			return "", nil, err
-		}
-		if err := comp.WritePlaceholder(); err != nil {
-			return "", nil, err
-		}
-		if _, err := comp.WriteString(" "); err != nil {
-			return "", nil, err
-		}
-		comp.Add(fe.Offset)
-	}
-	if fe.Limit > 0 {
-		if _, err := comp.WriteString(" LIMIT "); err != nil {
-			return "", nil, err
-		}
-		if err := comp.WritePlaceholder(); err != nil {
-			return "", nil, err
-		}
-		if _, err := comp.WriteString(" "); err != nil {
-			return "", nil, err
-		}
-		comp.Add(fe.Limit)
-	}
-
-	buf.ReadFrom(comp)
-
-	return buf.String(), comp.Args(), nil
-}
-
-func (r *CommentRepositoryBase) find(ctx context.Context, tx *sql.Tx, fe *CommentFindExpr) ([]*CommentEntity, error) {
-	query, args, err := r.FindQuery(fe)
-	if err != nil {
-		return nil, err
-	}
-	var rows *sql.Rows
-	if tx == nil {
-		rows, err = r.DB.QueryContext(ctx, query, args...)
-	} else {
-		rows, err = tx.QueryContext(ctx, query, args...)
-	}
-	if r.Log != nil {
-		if tx == nil {
-			r.Log(err, TableComment, "find", query, args...)
-		} else {
-			r.Log(err, TableComment, "find tx", query, args...)
-		}
-	}
-	if err != nil {
-		return nil, err
-	}
-	defer rows.Close()
-	var (
-		entities []*CommentEntity
-		props    []interface{}
-	)
-	for rows.Next() {
-		var ent CommentEntity
-		if props, err = ent.Props(); err != nil {
-			return nil, err
-		}
-		var prop []interface{}
-		if fe.JoinNewsByTitle != nil && fe.JoinNewsByTitle.Kind.Actionable() && fe.JoinNewsByTitle.Fetch {
-			ent.NewsByTitle = &NewsEntity{}
-			if prop, err = ent.NewsByTitle.Props(); err != nil {
-				return nil, err
-			}
-			props = append(props, prop...)
-		}
-		if fe.JoinNewsByID != nil && fe.JoinNewsByID.Kind.Actionable() && fe.JoinNewsByID.Fetch {
-			ent.NewsByID = &NewsEntity{}
-			if prop, err = ent.NewsByID.Props(); err != nil {
-				return nil, err
-			}
-			props = append(props, prop...)
-		}
-		err = rows.Scan(props...)
-		if err != nil {
-			return nil, err
-		}
-
-		entities = append(entities, &ent)
-	}
-	err = rows.Err()
-	if r.Log != nil {
-		r.Log(err, TableComment, "find", query, args...)
-	}
-	if err != nil {
-		return nil, err
-	}
-	return entities, nil
-}
-
-func (r *CommentRepositoryBase) Find(ctx context.Context, fe *CommentFindExpr) ([]*CommentEntity, error) {
-	return r.find(ctx, nil, fe)
-}
-
-func (r *CommentRepositoryBase) findIter(ctx context.Context, tx *sql.Tx, fe *CommentFindExpr) (*CommentIterator, error) {
-	query, args, err := r.FindQuery(fe)
-	if err != nil {
-		return nil, err
-	}
-	var rows *sql.Rows
-	if tx == nil {
-		rows, err = r.DB.QueryContext(ctx, query, args...)
-	} else {
-		rows, err = tx.QueryContext(ctx, query, args...)
-	}
-	if r.Log != nil {
-		if tx == nil {
-			r.Log(err, TableComment, "find iter", query, args...)
-		} else {
-			r.Log(err, TableComment, "find iter tx", query, args...)
-		}
-	}
-	if err != nil {
-		return nil, err
-	}
-	return &CommentIterator{
-		rows: rows,
-		expr: fe,
-		cols: fe.Columns,
-	}, nil
-}
-
-func (r *CommentRepositoryBase) FindIter(ctx context.Context, fe *CommentFindExpr) (*CommentIterator, error) {
-	return r.findIter(ctx, nil, fe)
-}
-
-func (r *CommentRepositoryBase) UpsertQuery(e *CommentEntity, p *CommentPatch, inf ...string) (string, []interface{}, error) {
-	upsert := NewComposer(16)
-	columns := bytes.NewBuffer(nil)
-	buf := bytes.NewBufferString("INSERT INTO ")
-	buf.WriteString(r.Table)
-
-	if columns.Len() > 0 {
-		if _, err := columns.WriteString(", "); err != nil {
-			return "", nil, err
-		}
-	}
-	if _, err := columns.WriteString(TableCommentColumnContent); err != nil {
-		return "", nil, err
-	}
-	if upsert.Dirty {
-		if _, err := upsert.WriteString(", "); err != nil {
-			return "", nil, err
-		}
-	}
-	if err := upsert.WritePlaceholder(); err != nil {
-		return "", nil, err
-	}
-	upsert.Add(e.Content)
-	upsert.Dirty = true
-
-	if !e.CreatedAt.IsZero() {
-		if columns.Len() > 0 {
-			if _, err := columns.WriteString(", "); err != nil {
-				return "", nil, err
-			}
-		}
-		if _, err := columns.WriteString(TableCommentColumnCreatedAt); err != nil {
-			return "", nil, err
-		}
-		if upsert.Dirty {
-			if _, err := upsert.WriteString(", "); err != nil {
-				return "", nil, err
-			}
-		}
-		if err := upsert.WritePlaceholder(); err != nil {
-			return "", nil, err
-		}
-		upsert.Add(e.CreatedAt)
-		upsert.Dirty = true
-	}
-
-	if columns.Len() > 0 {
-		if _, err := columns.WriteString(", "); err != nil {
-			return "", nil, err
-		}
-	}
-	if _, err := columns.WriteString(TableCommentColumnNewsID); err != nil {
-		return "", nil, err
-	}
-	if upsert.Dirty {
-		if _, err := upsert.WriteString(", "); err != nil {
-			return "", nil, err
-		}
-	}
-	if err := upsert.WritePlaceholder(); err != nil {
-		return "", nil, err
-	}
-	upsert.Add(e.NewsID)
-	upsert.Dirty = true
-
-	if columns.Len() > 0 {
-		if _, err := columns.WriteString(", "); err != nil {
-			return "", nil, err
-		}
-	}
-	if _, err := columns.WriteString(TableCommentColumnNewsTitle); err != nil {
-		return "", nil, err
-	}
-	if upsert.Dirty {
-		if _, err := upsert.WriteString(", "); err != nil {
-			return "", nil, err
-		}
-	}
-	if err := upsert.WritePlaceholder(); err != nil {
-		return "", nil, err
-	}
-	upsert.Add(e.NewsTitle)
-	upsert.Dirty = true
-
-	if e.UpdatedAt.Valid {
-		if columns.Len() > 0 {
-			if _, err := columns.WriteString(", "); err != nil {
-				return "", nil, err
-			}
-		}
-		if _, err := columns.WriteString(TableCommentColumnUpdatedAt); err != nil {
-			return "", nil, err
-		}
-		if upsert.Dirty {
-			if _, err := upsert.WriteString(", "); err != nil {
-				return "", nil, err
-			}
-		}
-		if err := upsert.WritePlaceholder(); err != nil {
-			return "", nil, err
-		}
-		upsert.Add(e.UpdatedAt)
-		upsert.Dirty = true
-	}
-
-	if upsert.Dirty {
-		buf.WriteString(" (")
-		buf.ReadFrom(columns)
-		buf.WriteString(") VALUES (")
-		buf.ReadFrom(upsert)
-		buf.WriteString(")")
-	}
-	buf.WriteString(" ON CONFLICT ")
-	if len(inf) > 0 {
-		upsert.Dirty = false
-		if p.Content.Valid {
-			if upsert.Dirty {
-				if _, err := upsert.WriteString(", "); err != nil {
-					return "", nil, err
-				}
-			}
-			if _, err := upsert.WriteString(TableCommentColumnContent); err != nil {
-				return "", nil, err
-			}
-			if _, err := upsert.WriteString("="); err != nil {
-				return "", nil, err
-			}
-			if err := upsert.WritePlaceholder(); err != nil {
-				return "", nil, err
-			}
-			upsert.Add(p.Content)
-			upsert.Dirty = true
-
-		}
-		if p.CreatedAt.Valid {
-			if upsert.Dirty {
-				if _, err := upsert.WriteString(", "); err != nil {
-					return "", nil, err
-				}
-			}
-			if _, err := upsert.WriteString(TableCommentColumnCreatedAt); err != nil {
-				return "", nil, err
-			}
-			if _, err := upsert.WriteString("="); err != nil {
-				return "", nil, err
-			}
-			if err := upsert.WritePlaceholder(); err != nil {
-				return "", nil, err
-			}
-			upsert.Add(p.CreatedAt)
-			upsert.Dirty = true
-
-		}
-		if p.ID.Valid {
-			if upsert.Dirty {
-				if _, err := upsert.WriteString(", "); err != nil {
-					return "", nil, err
-				}
-			}
-			if _, err := upsert.WriteString(TableCommentColumnID); err != nil {
-				return "", nil, err
-			}
-			if _, err := upsert.WriteString("="); err != nil {
-				return "", nil, err
-			}
-			if err := upsert.WritePlaceholder(); err != nil {
-				return "", nil, err
-			}
-			upsert.Add(p.ID)
-			upsert.Dirty = true
-
-		}
-		if p.NewsID.Valid {
-			if upsert.Dirty {
-				if _, err := upsert.WriteString(", "); err != nil {
-					return "", nil, err
-				}
-			}
-			if _, err := upsert.WriteString(TableCommentColumnNewsID); err != nil {
-				return "", nil, err
-			}
-			if _, err := upsert.WriteString("="); err != nil {
-				return "", nil, err
-			}
-			if err := upsert.WritePlaceholder(); err != nil {
-				return "", nil, err
-			}
-			upsert.Add(p.NewsID)
-			upsert.Dirty = true
-
-		}
-		if p.NewsTitle.Valid {
-			if upsert.Dirty {
-				if _, err := upsert.WriteString(", "); err != nil {
-					return "", nil, err
-				}
-			}
-			if _, err := upsert.WriteString(TableCommentColumnNewsTitle); err != nil {
-				return "", nil, err
-			}
-			if _, err := upsert.WriteString("="); err != nil {
-				return "", nil, err
-			}
-			if err := upsert.WritePlaceholder(); err != nil {
-				return "", nil, err
-			}
-			upsert.Add(p.NewsTitle)
-			upsert.Dirty = true
-
-		}
-		if p.UpdatedAt.Valid {
-			if upsert.Dirty {
-				if _, err := upsert.WriteString(", "); err != nil {
-					return "", nil, err
-				}
-			}
-			if _, err := upsert.WriteString(TableCommentColumnUpdatedAt); err != nil {
-				return "", nil, err
-			}
-			if _, err := upsert.WriteString("="); err != nil {
-				return "", nil, err
-			}
-			if err := upsert.WritePlaceholder(); err != nil {
-				return "", nil, err
-			}
-			upsert.Add(p.UpdatedAt)
-			upsert.Dirty = true
-
-		} else {
-			if upsert.Dirty {
-				if _, err := upsert.WriteString(", "); err != nil {
-					return "", nil, err
-				}
-			}
-			if _, err := upsert.WriteString(TableCommentColumnUpdatedAt); err != nil {
-				return "", nil, err
-			}
-			if _, err := upsert.WriteString("=NOW()"); err != nil {
-				return "", nil, err
-			}
-			upsert.Dirty = true
-		}
-	}
-	if len(inf) > 0 && upsert.Dirty {
-		buf.WriteString("(")
-		for j, i := range inf {
-			if j != 0 {
-				buf.WriteString(", ")
-			}
-			buf.WriteString(i)
-		}
-		buf.WriteString(")")
-		buf.WriteString(" DO UPDATE SET ")
-		buf.ReadFrom(upsert)
-	} else {
-		buf.WriteString(" DO NOTHING ")
-	}
-	if upsert.Dirty {
-		buf.WriteString(" RETURNING ")
-		if len(r.Columns) > 0 {
-			buf.WriteString(strings.Join(r.Columns, ", "))
-		} else {
-			buf.WriteString("content, created_at, id, multiply(id, id) AS id_multiply, news_id, news_title, now() AS right_now, updated_at")
-		}
-	}
-	return buf.String(), upsert.Args(), nil
-}
-
-func (r *CommentRepositoryBase) upsert(ctx context.Context, tx *sql.Tx, e *CommentEntity, p *CommentPatch, inf ...string) (*CommentEntity, error) {
-	query, args, err := r.UpsertQuery(e, p, inf...)
-	if err != nil {
-		return nil, err
-	}
-
-	var row *sql.Row
-	if tx == nil {
-		row = r.DB.QueryRowContext(ctx, query, args...)
-	} else {
-		row = tx.QueryRowContext(ctx, query, args...)
-	}
-	err = row.Scan(
-		&e.Content,
-		&e.CreatedAt,
-		&e.ID,
-		&e.IDMultiply,
-		&e.NewsID,
-		&e.NewsTitle,
-		&e.RightNow,
-		&e.UpdatedAt,
-	)
-	if r.Log != nil {
-		if tx == nil {
-			r.Log(err, TableComment, "upsert", query, args...)
-		} else {
-			r.Log(err, TableComment, "upsert tx", query, args...)
-		}
-	}
-	if err != nil {
-		return nil, err
-	}
-	return e, nil
-}
-
-func (r *CommentRepositoryBase) Upsert(ctx context.Context, e *CommentEntity, p *CommentPatch, inf ...string) (*CommentEntity, error) {
-	return r.upsert(ctx, nil, e, p, inf...)
-}
-
-func (r *CommentRepositoryBase) count(ctx context.Context, tx *sql.Tx, exp *CommentCountExpr) (int64, error) {
-	query, args, err := r.FindQuery(&CommentFindExpr{
-		Where:   exp.Where,
-		Columns: []string{"COUNT(*)"},
-
-		JoinNewsByTitle: exp.JoinNewsByTitle,
-		JoinNewsByID:    exp.JoinNewsByID,
-	})
-	if err != nil {
-		return 0, err
-	}
-	var count int64
-	if tx == nil {
-		err = r.DB.QueryRowContext(ctx, query, args...).Scan(&count)
-	} else {
-		err = tx.QueryRowContext(ctx, query, args...).Scan(&count)
-	}
-	if r.Log != nil {
-		if tx == nil {
-			r.Log(err, TableComment, "count", query, args...)
-		} else {
-			r.Log(err, TableComment, "count tx", query, args...)
-		}
-	}
-	if err != nil {
-		return 0, err
-	}
-	return count, nil
-}
-
-func (r *CommentRepositoryBase) Count(ctx context.Context, exp *CommentCountExpr) (int64, error) {
-	return r.count(ctx, nil, exp)
-}
-
-type CommentRepositoryBaseTx struct {
-	base *CommentRepositoryBase
-	tx   *sql.Tx
-}
-
-func (r CommentRepositoryBaseTx) Commit() error {
-	return r.tx.Commit()
-}
-
-func (r CommentRepositoryBaseTx) Rollback() error {
-	return r.tx.Rollback()
-}
-
-func (r *CommentRepositoryBaseTx) Insert(ctx context.Context, e *CommentEntity) (*CommentEntity, error) {
-	return r.base.insert(ctx, r.tx, e)
-}
-
-func (r *CommentRepositoryBaseTx) Find(ctx context.Context, fe *CommentFindExpr) ([]*CommentEntity, error) {
-	return r.base.find(ctx, r.tx, fe)
-}
-
-func (r *CommentRepositoryBaseTx) FindIter(ctx context.Context, fe *CommentFindExpr) (*CommentIterator, error) {
-	return r.base.findIter(ctx, r.tx, fe)
-}
-
-func (r *CommentRepositoryBaseTx) Upsert(ctx context.Context, e *CommentEntity, p *CommentPatch, inf ...string) (*CommentEntity, error) {
-	return r.base.upsert(ctx, r.tx, e, p, inf...)
-}
-
-func (r *CommentRepositoryBaseTx) Count(ctx context.Context, exp *CommentCountExpr) (int64, error) {
-	return r.base.count(ctx, r.tx, exp)
-}
-
-const ()
-
-const (
-	TableComplete                                 = "example.complete"
-	TableCompleteColumnColumnBool                 = "column_bool"
-	TableCompleteColumnColumnBytea                = "column_bytea"
-	TableCompleteColumnColumnCharacter0           = "column_character_0"
-	TableCompleteColumnColumnCharacter100         = "column_character_100"
-	TableCompleteColumnColumnDecimal              = "column_decimal"
-	TableCompleteColumnColumnDoubleArray0         = "column_double_array_0"
-	TableCompleteColumnColumnDoubleArray100       = "column_double_array_100"
-	TableCompleteColumnColumnInteger              = "column_integer"
-	TableCompleteColumnColumnIntegerArray0        = "column_integer_array_0"
-	TableCompleteColumnColumnIntegerArray100      = "column_integer_array_100"
-	TableCompleteColumnColumnIntegerBig           = "column_integer_big"
-	TableCompleteColumnColumnIntegerBigArray0     = "column_integer_big_array_0"
-	TableCompleteColumnColumnIntegerBigArray100   = "column_integer_big_array_100"
-	TableCompleteColumnColumnIntegerSmall         = "column_integer_small"
-	TableCompleteColumnColumnIntegerSmallArray0   = "column_integer_small_array_0"
-	TableCompleteColumnColumnIntegerSmallArray100 = "column_integer_small_array_100"
-	TableCompleteColumnColumnJson                 = "column_json"
-	TableCompleteColumnColumnJsonNn               = "column_json_nn"
-	TableCompleteColumnColumnJsonNnD              = "column_json_nn_d"
-	TableCompleteColumnColumnJsonb                = "column_jsonb"
-	TableCompleteColumnColumnJsonbNn              = "column_jsonb_nn"
-	TableCompleteColumnColumnJsonbNnD             = "column_jsonb_nn_d"
-	TableCompleteColumnColumnNumeric              = "column_numeric"
-	TableCompleteColumnColumnReal                 = "column_real"
-	TableCompleteColumnColumnSerial               = "column_serial"
-	TableCompleteColumnColumnSerialBig            = "column_serial_big"
-	TableCompleteColumnColumnSerialSmall          = "column_serial_small"
-	TableCompleteColumnColumnText                 = "column_text"
-	TableCompleteColumnColumnTextArray0           = "column_text_array_0"
-	TableCompleteColumnColumnTextArray100         = "column_text_array_100"
-	TableCompleteColumnColumnTimestamp            = "column_timestamp"
-	TableCompleteColumnColumnTimestamptz          = "column_timestamptz"
-	TableCompleteColumnColumnUUID                 = "column_uuid"
-)
-
-var TableCompleteColumns = []string{
-	TableCompleteColumnColumnBool,
-	TableCompleteColumnColumnBytea,
-	TableCompleteColumnColumnCharacter0,
-	TableCompleteColumnColumnCharacter100,
-	TableCompleteColumnColumnDecimal,
-	TableCompleteColumnColumnDoubleArray0,
-	TableCompleteColumnColumnDoubleArray100,
-	TableCompleteColumnColumnInteger,
-	TableCompleteColumnColumnIntegerArray0,
-	TableCompleteColumnColumnIntegerArray100,
-	TableCompleteColumnColumnIntegerBig,
-	TableCompleteColumnColumnIntegerBigArray0,
-	TableCompleteColumnColumnIntegerBigArray100,
-	TableCompleteColumnColumnIntegerSmall,
-	TableCompleteColumnColumnIntegerSmallArray0,
-	TableCompleteColumnColumnIntegerSmallArray100,
-	TableCompleteColumnColumnJson,
-	TableCompleteColumnColumnJsonNn,
-	TableCompleteColumnColumnJsonNnD,
-	TableCompleteColumnColumnJsonb,
-	TableCompleteColumnColumnJsonbNn,
-	TableCompleteColumnColumnJsonbNnD,
-	TableCompleteColumnColumnNumeric,
-	TableCompleteColumnColumnReal,
-	TableCompleteColumnColumnSerial,
-	TableCompleteColumnColumnSerialBig,
-	TableCompleteColumnColumnSerialSmall,
-	TableCompleteColumnColumnText,
-	TableCompleteColumnColumnTextArray0,
-	TableCompleteColumnColumnTextArray100,
-	TableCompleteColumnColumnTimestamp,
-	TableCompleteColumnColumnTimestamptz,
-	TableCompleteColumnColumnUUID,
-}
-
-// CompleteEntity ...
-type CompleteEntity struct {
-	// ColumnBool ...
-	ColumnBool sql.NullBool
-	// ColumnBytea ...
-	ColumnBytea []byte
-	// ColumnCharacter0 ...
-	ColumnCharacter0 sql.NullString
-	// ColumnCharacter100 ...
-	ColumnCharacter100 sql.NullString
-	// ColumnDecimal ...
-	ColumnDecimal sql.NullFloat64
-	// ColumnDoubleArray0 ...
-	ColumnDoubleArray0 NullFloat64Array
-	// ColumnDoubleArray100 ...
-	ColumnDoubleArray100 NullFloat64Array
-	// ColumnInteger ...
-	ColumnInteger *int32
-	// ColumnIntegerArray0 ...
-	ColumnIntegerArray0 NullInt64Array
-	// ColumnIntegerArray100 ...
-	ColumnIntegerArray100 NullInt64Array
-	// ColumnIntegerBig ...
-	ColumnIntegerBig sql.NullInt64
-	// ColumnIntegerBigArray0 ...
-	ColumnIntegerBigArray0 NullInt64Array
-	// ColumnIntegerBigArray100 ...
-	ColumnIntegerBigArray100 NullInt64Array
-	// ColumnIntegerSmall ...
-	ColumnIntegerSmall *int16
-	// ColumnIntegerSmallArray0 ...
-	ColumnIntegerSmallArray0 NullInt64Array
-	// ColumnIntegerSmallArray100 ...
-	ColumnIntegerSmallArray100 NullInt64Array
-	// ColumnJson ...
-	ColumnJson []byte
-	// ColumnJsonNn ...
-	ColumnJsonNn []byte
-	// ColumnJsonNnD ...
-	ColumnJsonNnD []byte
-	// ColumnJsonb ...
-	ColumnJsonb []byte
-	// ColumnJsonbNn ...
-	ColumnJsonbNn []byte
-	// ColumnJsonbNnD ...
-	ColumnJsonbNnD []byte
-	// ColumnNumeric ...
-	ColumnNumeric sql.NullFloat64
-	// ColumnReal ...
-	ColumnReal *float32
-	// ColumnSerial ...
-	ColumnSerial *int32
-	// ColumnSerialBig ...
-	ColumnSerialBig sql.NullInt64
-	// ColumnSerialSmall ...
-	ColumnSerialSmall *int16
-	// ColumnText ...
-	ColumnText sql.NullString
-	// ColumnTextArray0 ...
-	ColumnTextArray0 NullStringArray
-	// ColumnTextArray100 ...
-	ColumnTextArray100 NullStringArray
-	// ColumnTimestamp ...
-	ColumnTimestamp pq.NullTime
-	// ColumnTimestamptz ...
-	ColumnTimestamptz pq.NullTime
-	// ColumnUUID ...
-	ColumnUUID sql.NullString
-}
-
-func (e *CompleteEntity) Prop(cn string) (interface{}, bool) {
-	switch cn {
-
-	case TableCompleteColumnColumnBool:
-		return &e.ColumnBool, true
-	case TableCompleteColumnColumnBytea:
-		return &e.ColumnBytea, true
-	case TableCompleteColumnColumnCharacter0:
-		return &e.ColumnCharacter0, true
-	case TableCompleteColumnColumnCharacter100:
-		return &e.ColumnCharacter100, true
-	case TableCompleteColumnColumnDecimal:
-		return &e.ColumnDecimal, true
-	case TableCompleteColumnColumnDoubleArray0:
-		return &e.ColumnDoubleArray0, true
-	case TableCompleteColumnColumnDoubleArray100:
-		return &e.ColumnDoubleArray100, true
-	case TableCompleteColumnColumnInteger:
-		return e.ColumnInteger, true
-	case TableCompleteColumnColumnIntegerArray0:
-		return &e.ColumnIntegerArray0, true
-	case TableCompleteColumnColumnIntegerArray100:
-		return &e.ColumnIntegerArray100, true
-	case TableCompleteColumnColumnIntegerBig:
-		return &e.ColumnIntegerBig, true
-	case TableCompleteColumnColumnIntegerBigArray0:
-		return &e.ColumnIntegerBigArray0, true
-	case TableCompleteColumnColumnIntegerBigArray100:
-		return &e.ColumnIntegerBigArray100, true
-	case TableCompleteColumnColumnIntegerSmall:
-		return e.ColumnIntegerSmall, true
-	case TableCompleteColumnColumnIntegerSmallArray0:
-		return &e.ColumnIntegerSmallArray0, true
-	case TableCompleteColumnColumnIntegerSmallArray100:
-		return &e.ColumnIntegerSmallArray100, true
-	case TableCompleteColumnColumnJson:
-		return &e.ColumnJson, true
-	case TableCompleteColumnColumnJsonNn:
-		return &e.ColumnJsonNn, true
-	case TableCompleteColumnColumnJsonNnD:
-		return &e.ColumnJsonNnD, true
-	case TableCompleteColumnColumnJsonb:
-		return &e.ColumnJsonb, true
-	case TableCompleteColumnColumnJsonbNn:
-		return &e.ColumnJsonbNn, true
-	case TableCompleteColumnColumnJsonbNnD:
-		return &e.ColumnJsonbNnD, true
-	case TableCompleteColumnColumnNumeric:
-		return &e.ColumnNumeric, true
-	case TableCompleteColumnColumnReal:
-		return e.ColumnReal, true
-	case TableCompleteColumnColumnSerial:
-		return e.ColumnSerial, true
-	case TableCompleteColumnColumnSerialBig:
-		return &e.ColumnSerialBig, true
-	case TableCompleteColumnColumnSerialSmall:
-		return e.ColumnSerialSmall, true
-	case TableCompleteColumnColumnText:
-		return &e.ColumnText, true
-	case TableCompleteColumnColumnTextArray0:
-		return &e.ColumnTextArray0, true
-	case TableCompleteColumnColumnTextArray100:
-		return &e.ColumnTextArray100, true
-	case TableCompleteColumnColumnTimestamp:
-		return &e.ColumnTimestamp, true
-	case TableCompleteColumnColumnTimestamptz:
-		return &e.ColumnTimestamptz, true
-	case TableCompleteColumnColumnUUID:
-		return &e.ColumnUUID, true
-	default:
-		return nil, false
-	}
-}
-
-func (e *CompleteEntity) Props(cns ...string) ([]interface{}, error) {
-	if len(cns) == 0 {
-		cns = TableCompleteColumns
-	}
-	res := make([]interface{}, 0, len(cns))
-	for _, cn := range cns {
-		if prop, ok := e.Prop(cn); ok {
-			res = append(res, prop)
-		} else {
-			return nil, fmt.Errorf("unexpected column provided: %s", cn)
-		}
-	}
-	return res, nil
-}
-
-// ScanCompleteRows helps to scan rows straight to the slice of entities.
-func ScanCompleteRows(rows Rows) (entities []*CompleteEntity, err error) {
-	for rows.Next() {
-		var ent CompleteEntity
-		err = rows.Scan(
-			&ent.ColumnBool,
-			&ent.ColumnBytea,
-			&ent.ColumnCharacter0,
-			&ent.ColumnCharacter100,
-			&ent.ColumnDecimal,
-			&ent.ColumnDoubleArray0,
-			&ent.ColumnDoubleArray100,
-			&ent.ColumnInteger,
-			&ent.ColumnIntegerArray0,
-			&ent.ColumnIntegerArray100,
-			&ent.ColumnIntegerBig,
-			&ent.ColumnIntegerBigArray0,
-			&ent.ColumnIntegerBigArray100,
-			&ent.ColumnIntegerSmall,
-			&ent.ColumnIntegerSmallArray0,
-			&ent.ColumnIntegerSmallArray100,
-			&ent.ColumnJson,
-			&ent.ColumnJsonNn,
-			&ent.ColumnJsonNnD,
-			&ent.ColumnJsonb,
-			&ent.ColumnJsonbNn,
-			&ent.ColumnJsonbNnD,
-			&ent.ColumnNumeric,
-			&ent.ColumnReal,
-			&ent.ColumnSerial,
-			&ent.ColumnSerialBig,
-			&ent.ColumnSerialSmall,
-			&ent.ColumnText,
-			&ent.ColumnTextArray0,
-			&ent.ColumnTextArray100,
-			&ent.ColumnTimestamp,
-			&ent.ColumnTimestamptz,
-			&ent.ColumnUUID,
-		)
-		if err != nil {
-			return
-		}
-
-		entities = append(entities, &ent)
-	}
-	if err = rows.Err(); err != nil {
-		return
-	}
-
-	return
-}
-
-// CompleteIterator is not thread safe.
-type CompleteIterator struct {
-	rows Rows
-	cols []string
-	expr *CompleteFindExpr
-}
-
-func (i *CompleteIterator) Next() bool {
-	return i.rows.Next()
-}
-
-func (i *CompleteIterator) Close() error {
-	return i.rows.Close()
-}
-
-func (i *CompleteIterator) Err() error {
-	return i.rows.Err()
-}
-
-// Columns is wrapper around sql.Rows.Columns method, that also cache output inside iterator.
-func (i *CompleteIterator) Columns() ([]string, error) {
-	if i.cols == nil {
-		cols, err := i.rows.Columns()
-		if err != nil {
-			return nil, err
-		}
-		i.cols = cols
-	}
-	return i.cols, nil
-}
-
-// Ent is wrapper around Complete method that makes iterator more generic.
-func (i *CompleteIterator) Ent() (interface{}, error) {
-	return i.Complete()
-}
-
-func (i *CompleteIterator) Complete() (*CompleteEntity, error) {
-	var ent CompleteEntity
-	cols, err := i.Columns()
-	if err != nil {
-		return nil, err
-	}
-
-	props, err := ent.Props(cols...)
-	if err != nil {
-		return nil, err
-	}
-	if err := i.rows.Scan(props...); err != nil {
-		return nil, err
-	}
-	return &ent, nil
-}
-
-type CompleteCriteria struct {
-	ColumnBool                 sql.NullBool
-	ColumnBytea                []byte
-	ColumnCharacter0           sql.NullString
-	ColumnCharacter100         sql.NullString
-	ColumnDecimal              sql.NullFloat64
-	ColumnDoubleArray0         NullFloat64Array
-	ColumnDoubleArray100       NullFloat64Array
-	ColumnInteger              *int32
-	ColumnIntegerArray0        NullInt64Array
-	ColumnIntegerArray100      NullInt64Array
-	ColumnIntegerBig           sql.NullInt64
-	ColumnIntegerBigArray0     NullInt64Array
-	ColumnIntegerBigArray100   NullInt64Array
-	ColumnIntegerSmall         *int16
-	ColumnIntegerSmallArray0   NullInt64Array
-	ColumnIntegerSmallArray100 NullInt64Array
-	ColumnJson                 []byte
-	ColumnJsonNn               []byte
-	ColumnJsonNnD              []byte
-	ColumnJsonb                []byte
-	ColumnJsonbNn              []byte
-	ColumnJsonbNnD             []byte
-	ColumnNumeric              sql.NullFloat64
-	ColumnReal                 *float32
-	ColumnSerial               *int32
-	ColumnSerialBig            sql.NullInt64
-	ColumnSerialSmall          *int16
-	ColumnText                 sql.NullString
-	ColumnTextArray0           NullStringArray
-	ColumnTextArray100         NullStringArray
-	ColumnTimestamp            pq.NullTime
-	ColumnTimestamptz          pq.NullTime
-	ColumnUUID                 sql.NullString
-	operator                   string
-	child, sibling, parent     *CompleteCriteria
-}
-
-func CompleteOperand(operator string, operands ...*CompleteCriteria) *CompleteCriteria {
-	if len(operands) == 0 {
-		return &CompleteCriteria{operator: operator}
-	}
-
-	parent := &CompleteCriteria{
-		operator: operator,
-		child:    operands[0],
-	}
-
-	for i := 0; i < len(operands); i++ {
-		if i < len(operands)-1 {
-			operands[i].sibling = operands[i+1]
-		}
-		operands[i].parent = parent
-	}
-
-	return parent
-}
-
-func CompleteOr(operands ...*CompleteCriteria) *CompleteCriteria {
-	return CompleteOperand("OR", operands...)
-}
-
-func CompleteAnd(operands ...*CompleteCriteria) *CompleteCriteria {
-	return CompleteOperand("AND", operands...)
-}
-
-type CompleteFindExpr struct {
-	Where         *CompleteCriteria
-	Offset, Limit int64
-	Columns       []string
-	OrderBy       []RowOrder
-}
-
-type CompleteJoin struct {
-	On, Where *CompleteCriteria
-	Fetch     bool
-	Kind      JoinType
-}
-
-type CompleteCountExpr struct {
-	Where *CompleteCriteria
-}
-
-type CompletePatch struct {
-	ColumnBool                 sql.NullBool
-	ColumnBytea                []byte
-	ColumnCharacter0           sql.NullString
-	ColumnCharacter100         sql.NullString
-	ColumnDecimal              sql.NullFloat64
-	ColumnDoubleArray0         NullFloat64Array
-	ColumnDoubleArray100       NullFloat64Array
-	ColumnInteger              *int32
-	ColumnIntegerArray0        NullInt64Array
-	ColumnIntegerArray100      NullInt64Array
-	ColumnIntegerBig           sql.NullInt64
-	ColumnIntegerBigArray0     NullInt64Array
-	ColumnIntegerBigArray100   NullInt64Array
-	ColumnIntegerSmall         *int16
-	ColumnIntegerSmallArray0   NullInt64Array
-	ColumnIntegerSmallArray100 NullInt64Array
-	ColumnJson                 []byte
-	ColumnJsonNn               []byte
-	ColumnJsonNnD              []byte
-	ColumnJsonb                []byte
-	ColumnJsonbNn              []byte
-	ColumnJsonbNnD             []byte
-	ColumnNumeric              sql.NullFloat64
-	ColumnReal                 *float32
-	ColumnSerial               *int32
-	ColumnSerialBig            sql.NullInt64
-	ColumnSerialSmall          *int16
-	ColumnText                 sql.NullString
-	ColumnTextArray0           NullStringArray
-	ColumnTextArray100         NullStringArray
-	ColumnTimestamp            pq.NullTime
-	ColumnTimestamptz          pq.NullTime
-	ColumnUUID                 sql.NullString
-}
-
-type CompleteRepositoryBase struct {
-	Table   string
-	Columns []string
-	DB      *sql.DB
-	Log     LogFunc
-}
-
-func (r *CompleteRepositoryBase) Tx(tx *sql.Tx) (*CompleteRepositoryBaseTx, error) {
-	return &CompleteRepositoryBaseTx{
-		base: r,
-		tx:   tx,
-	}, nil
-}
-
-func (r *CompleteRepositoryBase) BeginTx(ctx context.Context) (*CompleteRepositoryBaseTx, error) {
-	tx, err := r.DB.BeginTx(ctx, nil)
-	if err != nil {
-		return nil, err
-	}
-	return r.Tx(tx)
-}
-
-func (r CompleteRepositoryBase) RunInTransaction(ctx context.Context, fn func(rtx *CompleteRepositoryBaseTx) error, attempts int) (err error) {
-	return RunInTransaction(r.DB, ctx, func(tx *sql.Tx) error {
-		rtx, err := r.Tx(tx)
-		if err != nil {
-			return err
-		}
-		return fn(rtx)
-	}, attempts)
-}
-
-func (r *CompleteRepositoryBase) InsertQuery(e *CompleteEntity, read bool) (string, []interface{}, error) {
-	insert := NewComposer(33)
-	columns := bytes.NewBuffer(nil)
-	buf := bytes.NewBufferString("INSERT INTO ")
-	buf.WriteString(r.Table)
-
-	if e.ColumnBool.Valid {
-		if columns.Len() > 0 {
-			if _, err := columns.WriteString(", "); err != nil {
-				return "", nil, err
-			}
-		}
-		if _, err := columns.WriteString(TableCompleteColumnColumnBool); err != nil {
-			return "", nil, err
-		}
-		if insert.Dirty {
-			if _, err := insert.WriteString(", "); err != nil {
-				return "", nil, err
-			}
-		}
-		if err := insert.WritePlaceholder(); err != nil {
-			return "", nil, err
-		}
-		insert.Add(e.ColumnBool)
-		insert.Dirty = true
-	}
-
-	if e.ColumnBytea != nil {
-		if columns.Len() > 0 {
-			if _, err := columns.WriteString(", "); err != nil {
-				return "", nil, err
-			}
-		}
-		if _, err := columns.WriteString(TableCompleteColumnColumnBytea); err != nil {
-			return "", nil, err
-		}
-		if insert.Dirty {
-			if _, err := insert.WriteString(", "); err != nil {
-				return "", nil, err
-			}
-		}
-		if err := insert.WritePlaceholder(); err != nil {
-			return "", nil, err
-		}
-		insert.Add(e.ColumnBytea)
-		insert.Dirty = true
-	}
-
-	if e.ColumnCharacter0.Valid {
-		if columns.Len() > 0 {
-			if _, err := columns.WriteString(", "); err != nil {
-				return "", nil, err
-			}
-		}
-		if _, err := columns.WriteString(TableCompleteColumnColumnCharacter0); err != nil {
-			return "", nil, err
-		}
-		if insert.Dirty {
-			if _, err := insert.WriteString(", "); err != nil {
-				return "", nil, err
-			}
-		}
-		if err := insert.WritePlaceholder(); err != nil {
-			return "", nil, err
-		}
-		insert.Add(e.ColumnCharacter0)
-		insert.Dirty = true
-	}
-
-	if e.ColumnCharacter100.Valid {
-		if columns.Len() > 0 {
-			if _, err := columns.WriteString(", "); err != nil {
-				return "", nil, err
-			}
-		}
-		if _, err := columns.WriteString(TableCompleteColumnColumnCharacter100); err != nil {
-			return "", nil, err
-		}
-		if insert.Dirty {
-			if _, err := insert.WriteString(", "); err != nil {
-				return "", nil, err
-			}
-		}
-		if err := insert.WritePlaceholder(); err != nil {
-			return "", nil, err
-		}
-		insert.Add(e.ColumnCharacter100)
-		insert.Dirty = true
-	}
-
-	if e.ColumnDecimal.Valid {
-		if columns.Len() > 0 {
-			if _, err := columns.WriteString(", "); err != nil {
-				return "", nil, err
-			}
-		}
-		if _, err := columns.WriteString(TableCompleteColumnColumnDecimal); err != nil {
-			return "", nil, err
-		}
-		if insert.Dirty {
-			if _, err := insert.WriteString(", "); err != nil {
-				return "", nil, err
-			}
-		}
-		if err := insert.WritePlaceholder(); err != nil {
-			return "", nil, err
-		}
-		insert.Add(e.ColumnDecimal)
-		insert.Dirty = true
-	}
-
-	if e.ColumnDoubleArray0.Valid {
-		if columns.Len() > 0 {
-			if _, err := columns.WriteString(", "); err != nil {
-				return "", nil, err
-			}
-		}
-		if _, err := columns.WriteString(TableCompleteColumnColumnDoubleArray0); err != nil {
-			return "", nil, err
-		}
-		if insert.Dirty {
-			if _, err := insert.WriteString(", "); err != nil {
-				return "", nil, err
-			}
-		}
-		if err := insert.WritePlaceholder(); err != nil {
-			return "", nil, err
-		}
-		insert.Add(e.ColumnDoubleArray0)
-		insert.Dirty = true
-	}
-
-	if e.ColumnDoubleArray100.Valid {
-		if columns.Len() > 0 {
-			if _, err := columns.WriteString(", "); err != nil {
-				return "", nil, err
-			}
-		}
-		if _, err := columns.WriteString(TableCompleteColumnColumnDoubleArray100); err != nil {
-			return "", nil, err
-		}
-		if insert.Dirty {
-			if _, err := insert.WriteString(", "); err != nil {
-				return "", nil, err
-			}
-		}
-		if err := insert.WritePlaceholder(); err != nil {
-			return "", nil, err
-		}
-		insert.Add(e.ColumnDoubleArray100)
-		insert.Dirty = true
-	}
-
-	if e.ColumnInteger != nil {
-		if columns.Len() > 0 {
-			if _, err := columns.WriteString(", "); err != nil {
-				return "", nil, err
-			}
-		}
-		if _, err := columns.WriteString(TableCompleteColumnColumnInteger); err != nil {
-			return "", nil, err
-		}
-		if insert.Dirty {
-			if _, err := insert.WriteString(", "); err != nil {
-				return "", nil, err
-			}
-		}
-		if err := insert.WritePlaceholder(); err != nil {
-			return "", nil, err
-		}
-		insert.Add(e.ColumnInteger)
-		insert.Dirty = true
-	}
-
-	if e.ColumnIntegerArray0.Valid {
-		if columns.Len() > 0 {
-			if _, err := columns.WriteString(", "); err != nil {
-				return "", nil, err
-			}
-		}
-		if _, err := columns.WriteString(TableCompleteColumnColumnIntegerArray0); err != nil {
-			return "", nil, err
-		}
-		if insert.Dirty {
-			if _, err := insert.WriteString(", "); err != nil {
-				return "", nil, err
-			}
-		}
-		if err := insert.WritePlaceholder(); err != nil {
-			return "", nil, err
-		}
-		insert.Add(e.ColumnIntegerArray0)
-		insert.Dirty = true
-	}
-
-	if e.ColumnIntegerArray100.Valid {
-		if columns.Len() > 0 {
-			if _, err := columns.WriteString(", "); err != nil {
-				return "", nil, err
-			}
-		}
-		if _, err := columns.WriteString(TableCompleteColumnColumnIntegerArray100); err != nil {
-			return "", nil, err
-		}
-		if insert.Dirty {
-			if _, err := insert.WriteString(", "); err != nil {
-				return "", nil, err
-			}
-		}
-		if err := insert.WritePlaceholder(); err != nil {
-			return "", nil, err
-		}
-		insert.Add(e.ColumnIntegerArray100)
-		insert.Dirty = true
-	}
-
-	if e.ColumnIntegerBig.Valid {
-		if columns.Len() > 0 {
-			if _, err := columns.WriteString(", "); err != nil {
-				return "", nil, err
-			}
-		}
-		if _, err := columns.WriteString(TableCompleteColumnColumnIntegerBig); err != nil {
-			return "", nil, err
-		}
-		if insert.Dirty {
-			if _, err := insert.WriteString(", "); err != nil {
-				return "", nil, err
-			}
-		}
-		if err := insert.WritePlaceholder(); err != nil {
-			return "", nil, err
-		}
-		insert.Add(e.ColumnIntegerBig)
-		insert.Dirty = true
-	}
-
-	if e.ColumnIntegerBigArray0.Valid {
-		if columns.Len() > 0 {
-			if _, err := columns.WriteString(", "); err != nil {
-				return "", nil, err
-			}
-		}
-		if _, err := columns.WriteString(TableCompleteColumnColumnIntegerBigArray0); err != nil {
-			return "", nil, err
-		}
-		if insert.Dirty {
-			if _, err := insert.WriteString(", "); err != nil {
-				return "", nil, err
-			}
-		}
-		if err := insert.WritePlaceholder(); err != nil {
-			return "", nil, err
-		}
-		insert.Add(e.ColumnIntegerBigArray0)
-		insert.Dirty = true
-	}
-
-	if e.ColumnIntegerBigArray100.Valid {
-		if columns.Len() > 0 {
-			if _, err := columns.WriteString(", "); err != nil {
-				return "", nil, err
-			}
-		}
-		if _, err := columns.WriteString(TableCompleteColumnColumnIntegerBigArray100); err != nil {
-			return "", nil, err
-		}
-		if insert.Dirty {
-			if _, err := insert.WriteString(", "); err != nil {
-				return "", nil, err
-			}
-		}
-		if err := insert.WritePlaceholder(); err != nil {
-			return "", nil, err
-		}
-		insert.Add(e.ColumnIntegerBigArray100)
-		insert.Dirty = true
-	}
-
-	if e.ColumnIntegerSmall != nil {
-		if columns.Len() > 0 {
-			if _, err := columns.WriteString(", "); err != nil {
-				return "", nil, err
-			}
-		}
-		if _, err := columns.WriteString(TableCompleteColumnColumnIntegerSmall); err != nil {
-			return "", nil, err
-		}
-		if insert.Dirty {
-			if _, err := insert.WriteString(", "); err != nil {
-				return "", nil, err
-			}
-		}
-		if err := insert.WritePlaceholder(); err != nil {
-			return "", nil, err
-		}
-		insert.Add(e.ColumnIntegerSmall)
-		insert.Dirty = true
-	}
-
-	if e.ColumnIntegerSmallArray0.Valid {
-		if columns.Len() > 0 {
-			if _, err := columns.WriteString(", "); err != nil {
-				return "", nil, err
-			}
-		}
-		if _, err := columns.WriteString(TableCompleteColumnColumnIntegerSmallArray0); err != nil {
-			return "", nil, err
-		}
-		if insert.Dirty {
-			if _, err := insert.WriteString(", "); err != nil {
-				return "", nil, err
-			}
-		}
-		if err := insert.WritePlaceholder(); err != nil {
-			return "", nil, err
-		}
-		insert.Add(e.ColumnIntegerSmallArray0)
-		insert.Dirty = true
-	}
-
-	if e.ColumnIntegerSmallArray100.Valid {
-		if columns.Len() > 0 {
-			if _, err := columns.WriteString(", "); err != nil {
-				return "", nil, err
-			}
-		}
-		if _, err := columns.WriteString(TableCompleteColumnColumnIntegerSmallArray100); err != nil {
-			return "", nil, err
-		}
-		if insert.Dirty {
-			if _, err := insert.WriteString(", "); err != nil {
-				return "", nil, err
-			}
-		}
-		if err := insert.WritePlaceholder(); err != nil {
-			return "", nil, err
-		}
-		insert.Add(e.ColumnIntegerSmallArray100)
-		insert.Dirty = true
-	}
-
-	if e.ColumnJson != nil {
-		if columns.Len() > 0 {
-			if _, err := columns.WriteString(", "); err != nil {
-				return "", nil, err
-			}
-		}
-		if _, err := columns.WriteString(TableCompleteColumnColumnJson); err != nil {
-			return "", nil, err
-		}
-		if insert.Dirty {
-			if _, err := insert.WriteString(", "); err != nil {
-				return "", nil, err
-			}
-		}
-		if err := insert.WritePlaceholder(); err != nil {
-			return "", nil, err
-		}
-		insert.Add(e.ColumnJson)
-		insert.Dirty = true
-	}
-
-	if e.ColumnJsonNn != nil {
-		if columns.Len() > 0 {
-			if _, err := columns.WriteString(", "); err != nil {
-				return "", nil, err
-			}
-		}
-		if _, err := columns.WriteString(TableCompleteColumnColumnJsonNn); err != nil {
-			return "", nil, err
-		}
-		if insert.Dirty {
-			if _, err := insert.WriteString(", "); err != nil {
-				return "", nil, err
-			}
-		}
-		if err := insert.WritePlaceholder(); err != nil {
-			return "", nil, err
-		}
-		insert.Add(e.ColumnJsonNn)
-		insert.Dirty = true
-	}
-
-	if e.ColumnJsonNnD != nil {
-		if columns.Len() > 0 {
-			if _, err := columns.WriteString(", "); err != nil {
-				return "", nil, err
-			}
-		}
-		if _, err := columns.WriteString(TableCompleteColumnColumnJsonNnD); err != nil {
-			return "", nil, err
-		}
-		if insert.Dirty {
-			if _, err := insert.WriteString(", "); err != nil {
-				return "", nil, err
-			}
-		}
-		if err := insert.WritePlaceholder(); err != nil {
-			return "", nil, err
-		}
-		insert.Add(e.ColumnJsonNnD)
-		insert.Dirty = true
-	}
-
-	if e.ColumnJsonb != nil {
-		if columns.Len() > 0 {
-			if _, err := columns.WriteString(", "); err != nil {
-				return "", nil, err
-			}
-		}
-		if _, err := columns.WriteString(TableCompleteColumnColumnJsonb); err != nil {
-			return "", nil, err
-		}
-		if insert.Dirty {
-			if _, err := insert.WriteString(", "); err != nil {
-				return "", nil, err
-			}
-		}
-		if err := insert.WritePlaceholder(); err != nil {
-			return "", nil, err
-		}
-		insert.Add(e.ColumnJsonb)
-		insert.Dirty = true
-	}
-
-	if e.ColumnJsonbNn != nil {
-		if columns.Len() > 0 {
-			if _, err := columns.WriteString(", "); err != nil {
-				return "", nil, err
-			}
-		}
-		if _, err := columns.WriteString(TableCompleteColumnColumnJsonbNn); err != nil {
-			return "", nil, err
-		}
-		if insert.Dirty {
-			if _, err := insert.WriteString(", "); err != nil {
-				return "", nil, err
-			}
-		}
-		if err := insert.WritePlaceholder(); err != nil {
-			return "", nil, err
-		}
-		insert.Add(e.ColumnJsonbNn)
-		insert.Dirty = true
-	}
-
-	if e.ColumnJsonbNnD != nil {
-		if columns.Len() > 0 {
-			if _, err := columns.WriteString(", "); err != nil {
-				return "", nil, err
-			}
-		}
-		if _, err := columns.WriteString(TableCompleteColumnColumnJsonbNnD); err != nil {
-			return "", nil, err
-		}
-		if insert.Dirty {
-			if _, err := insert.WriteString(", "); err != nil {
-				return "", nil, err
-			}
-		}
-		if err := insert.WritePlaceholder(); err != nil {
-			return "", nil, err
-		}
-		insert.Add(e.ColumnJsonbNnD)
-		insert.Dirty = true
-	}
-
-	if e.ColumnNumeric.Valid {
-		if columns.Len() > 0 {
-			if _, err := columns.WriteString(", "); err != nil {
-				return "", nil, err
-			}
-		}
-		if _, err := columns.WriteString(TableCompleteColumnColumnNumeric); err != nil {
-			return "", nil, err
-		}
-		if insert.Dirty {
-			if _, err := insert.WriteString(", "); err != nil {
-				return "", nil, err
-			}
-		}
-		if err := insert.WritePlaceholder(); err != nil {
-			return "", nil, err
-		}
-		insert.Add(e.ColumnNumeric)
-		insert.Dirty = true
-	}
-
-	if e.ColumnReal != nil {
-		if columns.Len() > 0 {
-			if _, err := columns.WriteString(", "); err != nil {
-				return "", nil, err
-			}
-		}
-		if _, err := columns.WriteString(TableCompleteColumnColumnReal); err != nil {
-			return "", nil, err
-		}
-		if insert.Dirty {
-			if _, err := insert.WriteString(", "); err != nil {
-				return "", nil, err
-			}
-		}
-		if err := insert.WritePlaceholder(); err != nil {
-			return "", nil, err
-		}
-		insert.Add(e.ColumnReal)
-		insert.Dirty = true
-	}
-
-	if e.ColumnText.Valid {
-		if columns.Len() > 0 {
-			if _, err := columns.WriteString(", "); err != nil {
-				return "", nil, err
-			}
-		}
-		if _, err := columns.WriteString(TableCompleteColumnColumnText); err != nil {
-			return "", nil, err
-		}
-		if insert.Dirty {
-			if _, err := insert.WriteString(", "); err != nil {
-				return "", nil, err
-			}
-		}
-		if err := insert.WritePlaceholder(); err != nil {
-			return "", nil, err
-		}
-		insert.Add(e.ColumnText)
-		insert.Dirty = true
-	}
-
-	if e.ColumnTextArray0.Valid {
-		if columns.Len() > 0 {
-			if _, err := columns.WriteString(", "); err != nil {
-				return "", nil, err
-			}
-		}
-		if _, err := columns.WriteString(TableCompleteColumnColumnTextArray0); err != nil {
-			return "", nil, err
-		}
-		if insert.Dirty {
-			if _, err := insert.WriteString(", "); err != nil {
-				return "", nil, err
-			}
-		}
-		if err := insert.WritePlaceholder(); err != nil {
-			return "", nil, err
-		}
-		insert.Add(e.ColumnTextArray0)
-		insert.Dirty = true
-	}
-
-	if e.ColumnTextArray100.Valid {
-		if columns.Len() > 0 {
-			if _, err := columns.WriteString(", "); err != nil {
-				return "", nil, err
-			}
-		}
-		if _, err := columns.WriteString(TableCompleteColumnColumnTextArray100); err != nil {
-			return "", nil, err
-		}
-		if insert.Dirty {
-			if _, err := insert.WriteString(", "); err != nil {
-				return "", nil, err
-			}
-		}
-		if err := insert.WritePlaceholder(); err != nil {
-			return "", nil, err
-		}
-		insert.Add(e.ColumnTextArray100)
-		insert.Dirty = true
-	}
-
-	if e.ColumnTimestamp.Valid {
-		if columns.Len() > 0 {
-			if _, err := columns.WriteString(", "); err != nil {
-				return "", nil, err
-			}
-		}
-		if _, err := columns.WriteString(TableCompleteColumnColumnTimestamp); err != nil {
-			return "", nil, err
-		}
-		if insert.Dirty {
-			if _, err := insert.WriteString(", "); err != nil {
-				return "", nil, err
-			}
-		}
-		if err := insert.WritePlaceholder(); err != nil {
-			return "", nil, err
-		}
-		insert.Add(e.ColumnTimestamp)
-		insert.Dirty = true
-	}
-
-	if e.ColumnTimestamptz.Valid {
-		if columns.Len() > 0 {
-			if _, err := columns.WriteString(", "); err != nil {
-				return "", nil, err
-			}
-		}
-		if _, err := columns.WriteString(TableCompleteColumnColumnTimestamptz); err != nil {
-			return "", nil, err
-		}
-		if insert.Dirty {
-			if _, err := insert.WriteString(", "); err != nil {
-				return "", nil, err
-			}
-		}
-		if err := insert.WritePlaceholder(); err != nil {
-			return "", nil, err
-		}
-		insert.Add(e.ColumnTimestamptz)
-		insert.Dirty = true
-	}
-
-	if e.ColumnUUID.Valid {
-		if columns.Len() > 0 {
-			if _, err := columns.WriteString(", "); err != nil {
-				return "", nil, err
-			}
-		}
-		if _, err := columns.WriteString(TableCompleteColumnColumnUUID); err != nil {
-			return "", nil, err
-		}
-		if insert.Dirty {
-			if _, err := insert.WriteString(", "); err != nil {
-				return "", nil, err
-			}
-		}
-		if err := insert.WritePlaceholder(); err != nil {
-			return "", nil, err
-		}
-		insert.Add(e.ColumnUUID)
-		insert.Dirty = true
-	}
-
-	if columns.Len() > 0 {
-		buf.WriteString(" (")
-		buf.ReadFrom(columns)
-		buf.WriteString(") VALUES (")
-		buf.ReadFrom(insert)
-		buf.WriteString(") ")
-		if read {
-			buf.WriteString("RETURNING ")
-			if len(r.Columns) > 0 {
-				buf.WriteString(strings.Join(r.Columns, ", "))
-			} else {
-				buf.WriteString("column_bool, column_bytea, column_character_0, column_character_100, column_decimal, column_double_array_0, column_double_array_100, column_integer, column_integer_array_0, column_integer_array_100, column_integer_big, column_integer_big_array_0, column_integer_big_array_100, column_integer_small, column_integer_small_array_0, column_integer_small_array_100, column_json, column_json_nn, column_json_nn_d, column_jsonb, column_jsonb_nn, column_jsonb_nn_d, column_numeric, column_real, column_serial, column_serial_big, column_serial_small, column_text, column_text_array_0, column_text_array_100, column_timestamp, column_timestamptz, column_uuid")
-			}
-		}
-	}
-	return buf.String(), insert.Args(), nil
-}
-
-func (r *CompleteRepositoryBase) insert(ctx context.Context, tx *sql.Tx, e *CompleteEntity) (*CompleteEntity, error) {
-	query, args, err := r.InsertQuery(e, true)
-	if err != nil {
-		return nil, err
-	}
-
-	var row *sql.Row
-	if tx == nil {
-		row = r.DB.QueryRowContext(ctx, query, args...)
-	} else {
-		row = tx.QueryRowContext(ctx, query, args...)
-	}
-	err = row.Scan(
-		&e.ColumnBool,
-		&e.ColumnBytea,
-		&e.ColumnCharacter0,
-		&e.ColumnCharacter100,
-		&e.ColumnDecimal,
-		&e.ColumnDoubleArray0,
-		&e.ColumnDoubleArray100,
-		&e.ColumnInteger,
-		&e.ColumnIntegerArray0,
-		&e.ColumnIntegerArray100,
-		&e.ColumnIntegerBig,
-		&e.ColumnIntegerBigArray0,
-		&e.ColumnIntegerBigArray100,
-		&e.ColumnIntegerSmall,
-		&e.ColumnIntegerSmallArray0,
-		&e.ColumnIntegerSmallArray100,
-		&e.ColumnJson,
-		&e.ColumnJsonNn,
-		&e.ColumnJsonNnD,
-		&e.ColumnJsonb,
-		&e.ColumnJsonbNn,
-		&e.ColumnJsonbNnD,
-		&e.ColumnNumeric,
-		&e.ColumnReal,
-		&e.ColumnSerial,
-		&e.ColumnSerialBig,
-		&e.ColumnSerialSmall,
-		&e.ColumnText,
-		&e.ColumnTextArray0,
-		&e.ColumnTextArray100,
-		&e.ColumnTimestamp,
-		&e.ColumnTimestamptz,
-		&e.ColumnUUID,
-	)
-	if r.Log != nil {
-		if tx == nil {
-			r.Log(err, TableComplete, "insert", query, args...)
-		} else {
-			r.Log(err, TableComplete, "insert tx", query, args...)
-		}
-	}
-	if err != nil {
-		return nil, err
-	}
-	return e, nil
-}
-
-func (r *CompleteRepositoryBase) Insert(ctx context.Context, e *CompleteEntity) (*CompleteEntity, error) {
-	return r.insert(ctx, nil, e)
-}
-
-func CompleteCriteriaWhereClause(comp *Composer, c *CompleteCriteria, id int) error {
-	if c.child == nil {
-		return _CompleteCriteriaWhereClause(comp, c, id)
-	}
-	node := c
-	sibling := false
-	for {
-		if !sibling {
-			if node.child != nil {
-				if node.parent != nil {
-					comp.WriteString("(")
-				}
-				node = node.child
-				continue
-			} else {
-				comp.Dirty = false
-				comp.WriteString("(")
-				if err := _CompleteCriteriaWhereClause(comp, node, id); err != nil {
-					return err
-				}
-				comp.WriteString(")")
-			}
-		}
-		if node.sibling != nil {
-			sibling = false
-			comp.WriteString(" ")
-			comp.WriteString(node.parent.operator)
-			comp.WriteString(" ")
-			node = node.sibling
-			continue
-		}
-		if node.parent != nil {
-			sibling = true
-			if node.parent.parent != nil {
-				comp.WriteString(")")
-			}
-			node = node.parent
-			continue
-		}
-
-		break
-	}
-	return nil
-}
-
-func _CompleteCriteriaWhereClause(comp *Composer, c *CompleteCriteria, id int) error {
-	if c.ColumnBool.Valid {
-		if comp.Dirty {
-			comp.WriteString(" AND ")
-		}
-		if err := comp.WriteAlias(id); err != nil {
-			return err
-		}
-		if _, err := comp.WriteString(TableCompleteColumnColumnBool); err != nil {
-			return err
-		}
-		if _, err := comp.WriteString("="); err != nil {
-			return err
-		}
-		if err := comp.WritePlaceholder(); err != nil {
-			return err
-		}
-		comp.Add(c.ColumnBool)
-		comp.Dirty = true
-	}
-	if c.ColumnBytea != nil {
-		if comp.Dirty {
-			comp.WriteString(" AND ")
-		}
-		if err := comp.WriteAlias(id); err != nil {
-			return err
-		}
-		if _, err := comp.WriteString(TableCompleteColumnColumnBytea); err != nil {
-			return err
-		}
-		if _, err := comp.WriteString("="); err != nil {
-			return err
-		}
-		if err := comp.WritePlaceholder(); err != nil {
-			return err
-		}
-		comp.Add(c.ColumnBytea)
-		comp.Dirty = true
-	}
-	if c.ColumnCharacter0.Valid {
-		if comp.Dirty {
-			comp.WriteString(" AND ")
-		}
-		if err := comp.WriteAlias(id); err != nil {
-			return err
-		}
-		if _, err := comp.WriteString(TableCompleteColumnColumnCharacter0); err != nil {
-			return err
-		}
-		if _, err := comp.WriteString("="); err != nil {
-			return err
-		}
-		if err := comp.WritePlaceholder(); err != nil {
-			return err
-		}
-		comp.Add(c.ColumnCharacter0)
-		comp.Dirty = true
-	}
-	if c.ColumnCharacter100.Valid {
-		if comp.Dirty {
-			comp.WriteString(" AND ")
-		}
-		if err := comp.WriteAlias(id); err != nil {
-			return err
-		}
-		if _, err := comp.WriteString(TableCompleteColumnColumnCharacter100); err != nil {
-			return err
-		}
-		if _, err := comp.WriteString("="); err != nil {
-			return err
-		}
-		if err := comp.WritePlaceholder(); err != nil {
-			return err
-		}
-		comp.Add(c.ColumnCharacter100)
-		comp.Dirty = true
-	}
-	if c.ColumnDecimal.Valid {
-		if comp.Dirty {
-			comp.WriteString(" AND ")
-		}
-		if err := comp.WriteAlias(id); err != nil {
-			return err
-		}
-		if _, err := comp.WriteString(TableCompleteColumnColumnDecimal); err != nil {
-			return err
-		}
-		if _, err := comp.WriteString("="); err != nil {
-			return err
-		}
-		if err := comp.WritePlaceholder(); err != nil {
-			return err
-		}
-		comp.Add(c.ColumnDecimal)
-		comp.Dirty = true
-	}
-	if c.ColumnDoubleArray0.Valid {
-		if comp.Dirty {
-			comp.WriteString(" AND ")
-		}
-		if err := comp.WriteAlias(id); err != nil {
-			return err
-		}
-		if _, err := comp.WriteString(TableCompleteColumnColumnDoubleArray0); err != nil {
-			return err
-		}
-		if _, err := comp.WriteString("="); err != nil {
-			return err
-		}
-		if err := comp.WritePlaceholder(); err != nil {
-			return err
-		}
-		comp.Add(c.ColumnDoubleArray0)
-		comp.Dirty = true
-	}
-	if c.ColumnDoubleArray100.Valid {
-		if comp.Dirty {
-			comp.WriteString(" AND ")
-		}
-		if err := comp.WriteAlias(id); err != nil {
-			return err
-		}
-		if _, err := comp.WriteString(TableCompleteColumnColumnDoubleArray100); err != nil {
-			return err
-		}
-		if _, err := comp.WriteString("="); err != nil {
-			return err
-		}
-		if err := comp.WritePlaceholder(); err != nil {
-			return err
-		}
-		comp.Add(c.ColumnDoubleArray100)
-		comp.Dirty = true
-	}
-	if c.ColumnInteger != nil {
-		if comp.Dirty {
-			comp.WriteString(" AND ")
-		}
-		if err := comp.WriteAlias(id); err != nil {
-			return err
-		}
-		if _, err := comp.WriteString(TableCompleteColumnColumnInteger); err != nil {
-			return err
-		}
-		if _, err := comp.WriteString("="); err != nil {
-			return err
-		}
-		if err := comp.WritePlaceholder(); err != nil {
-			return err
-		}
-		comp.Add(c.ColumnInteger)
-		comp.Dirty = true
-	}
-	if c.ColumnIntegerArray0.Valid {
-		if comp.Dirty {
-			comp.WriteString(" AND ")
-		}
-		if err := comp.WriteAlias(id); err != nil {
-			return err
-		}
-		if _, err := comp.WriteString(TableCompleteColumnColumnIntegerArray0); err != nil {
-			return err
-		}
-		if _, err := comp.WriteString("="); err != nil {
-			return err
-		}
-		if err := comp.WritePlaceholder(); err != nil {
-			return err
-		}
-		comp.Add(c.ColumnIntegerArray0)
-		comp.Dirty = true
-	}
-	if c.ColumnIntegerArray100.Valid {
-		if comp.Dirty {
-			comp.WriteString(" AND ")
-		}
-		if err := comp.WriteAlias(id); err != nil {
-			return err
-		}
-		if _, err := comp.WriteString(TableCompleteColumnColumnIntegerArray100); err != nil {
-			return err
-		}
-		if _, err := comp.WriteString("="); err != nil {
-			return err
-		}
-		if err := comp.WritePlaceholder(); err != nil {
-			return err
-		}
-		comp.Add(c.ColumnIntegerArray100)
-		comp.Dirty = true
-	}
-	if c.ColumnIntegerBig.Valid {
-		if comp.Dirty {
-			comp.WriteString(" AND ")
-		}
-		if err := comp.WriteAlias(id); err != nil {
-			return err
-		}
-		if _, err := comp.WriteString(TableCompleteColumnColumnIntegerBig); err != nil {
-			return err
-		}
-		if _, err := comp.WriteString("="); err != nil {
-			return err
-		}
-		if err := comp.WritePlaceholder(); err != nil {
-			return err
-		}
-		comp.Add(c.ColumnIntegerBig)
-		comp.Dirty = true
-	}
-	if c.ColumnIntegerBigArray0.Valid {
-		if comp.Dirty {
-			comp.WriteString(" AND ")
-		}
-		if err := comp.WriteAlias(id); err != nil {
-			return err
-		}
-		if _, err := comp.WriteString(TableCompleteColumnColumnIntegerBigArray0); err != nil {
-			return err
-		}
-		if _, err := comp.WriteString("="); err != nil {
-			return err
-		}
-		if err := comp.WritePlaceholder(); err != nil {
-			return err
-		}
-		comp.Add(c.ColumnIntegerBigArray0)
-		comp.Dirty = true
-	}
-	if c.ColumnIntegerBigArray100.Valid {
-		if comp.Dirty {
-			comp.WriteString(" AND ")
-		}
-		if err := comp.WriteAlias(id); err != nil {
-			return err
-		}
-		if _, err := comp.WriteString(TableCompleteColumnColumnIntegerBigArray100); err != nil {
-			return err
-		}
-		if _, err := comp.WriteString("="); err != nil {
-			return err
-		}
-		if err := comp.WritePlaceholder(); err != nil {
-			return err
-		}
-		comp.Add(c.ColumnIntegerBigArray100)
-		comp.Dirty = true
-	}
-	if c.ColumnIntegerSmall != nil {
-		if comp.Dirty {
-			comp.WriteString(" AND ")
-		}
-		if err := comp.WriteAlias(id); err != nil {
-			return err
-		}
-		if _, err := comp.WriteString(TableCompleteColumnColumnIntegerSmall); err != nil {
-			return err
-		}
-		if _, err := comp.WriteString("="); err != nil {
-			return err
-		}
-		if err := comp.WritePlaceholder(); err != nil {
-			return err
-		}
-		comp.Add(c.ColumnIntegerSmall)
-		comp.Dirty = true
-	}
-	if c.ColumnIntegerSmallArray0.Valid {
-		if comp.Dirty {
-			comp.WriteString(" AND ")
-		}
-		if err := comp.WriteAlias(id); err != nil {
-			return err
-		}
-		if _, err := comp.WriteString(TableCompleteColumnColumnIntegerSmallArray0); err != nil {
-			return err
-		}
-		if _, err := comp.WriteString("="); err != nil {
-			return err
-		}
-		if err := comp.WritePlaceholder(); err != nil {
-			return err
-		}
-		comp.Add(c.ColumnIntegerSmallArray0)
-		comp.Dirty = true
-	}
-	if c.ColumnIntegerSmallArray100.Valid {
-		if comp.Dirty {
-			comp.WriteString(" AND ")
-		}
-		if err := comp.WriteAlias(id); err != nil {
-			return err
-		}
-		if _, err := comp.WriteString(TableCompleteColumnColumnIntegerSmallArray100); err != nil {
-			return err
-		}
-		if _, err := comp.WriteString("="); err != nil {
-			return err
-		}
-		if err := comp.WritePlaceholder(); err != nil {
-			return err
-		}
-		comp.Add(c.ColumnIntegerSmallArray100)
-		comp.Dirty = true
-	}
-	if c.ColumnJson != nil {
-		if comp.Dirty {
-			comp.WriteString(" AND ")
-		}
-		if err := comp.WriteAlias(id); err != nil {
-			return err
-		}
-		if _, err := comp.WriteString(TableCompleteColumnColumnJson); err != nil {
-			return err
-		}
-		if _, err := comp.WriteString("="); err != nil {
-			return err
-		}
-		if err := comp.WritePlaceholder(); err != nil {
-			return err
-		}
-		comp.Add(c.ColumnJson)
-		comp.Dirty = true
-	}
-	if c.ColumnJsonNn != nil {
-		if comp.Dirty {
-			comp.WriteString(" AND ")
-		}
-		if err := comp.WriteAlias(id); err != nil {
-			return err
-		}
-		if _, err := comp.WriteString(TableCompleteColumnColumnJsonNn); err != nil {
-			return err
-		}
-		if _, err := comp.WriteString("="); err != nil {
-			return err
-		}
-		if err := comp.WritePlaceholder(); err != nil {
-			return err
-		}
-		comp.Add(c.ColumnJsonNn)
-		comp.Dirty = true
-	}
-	if c.ColumnJsonNnD != nil {
-		if comp.Dirty {
-			comp.WriteString(" AND ")
-		}
-		if err := comp.WriteAlias(id); err != nil {
-			return err
-		}
-		if _, err := comp.WriteString(TableCompleteColumnColumnJsonNnD); err != nil {
-			return err
-		}
-		if _, err := comp.WriteString("="); err != nil {
-			return err
-		}
-		if err := comp.WritePlaceholder(); err != nil {
-			return err
-		}
-		comp.Add(c.ColumnJsonNnD)
-		comp.Dirty = true
-	}
-	if c.ColumnJsonb != nil {
-		if comp.Dirty {
-			comp.WriteString(" AND ")
-		}
-		if err := comp.WriteAlias(id); err != nil {
-			return err
-		}
-		if _, err := comp.WriteString(TableCompleteColumnColumnJsonb); err != nil {
-			return err
-		}
-		if _, err := comp.WriteString("="); err != nil {
-			return err
-		}
-		if err := comp.WritePlaceholder(); err != nil {
-			return err
-		}
-		comp.Add(c.ColumnJsonb)
-		comp.Dirty = true
-	}
-	if c.ColumnJsonbNn != nil {
-		if comp.Dirty {
-			comp.WriteString(" AND ")
-		}
-		if err := comp.WriteAlias(id); err != nil {
-			return err
-		}
-		if _, err := comp.WriteString(TableCompleteColumnColumnJsonbNn); err != nil {
-			return err
-		}
-		if _, err := comp.WriteString("="); err != nil {
-			return err
-		}
-		if err := comp.WritePlaceholder(); err != nil {
-			return err
-		}
-		comp.Add(c.ColumnJsonbNn)
-		comp.Dirty = true
-	}
-	if c.ColumnJsonbNnD != nil {
-		if comp.Dirty {
-			comp.WriteString(" AND ")
-		}
-		if err := comp.WriteAlias(id); err != nil {
-			return err
-		}
-		if _, err := comp.WriteString(TableCompleteColumnColumnJsonbNnD); err != nil {
-			return err
-		}
-		if _, err := comp.WriteString("="); err != nil {
-			return err
-		}
-		if err := comp.WritePlaceholder(); err != nil {
-			return err
-		}
-		comp.Add(c.ColumnJsonbNnD)
-		comp.Dirty = true
-	}
-	if c.ColumnNumeric.Valid {
-		if comp.Dirty {
-			comp.WriteString(" AND ")
-		}
-		if err := comp.WriteAlias(id); err != nil {
-			return err
-		}
-		if _, err := comp.WriteString(TableCompleteColumnColumnNumeric); err != nil {
-			return err
-		}
-		if _, err := comp.WriteString("="); err != nil {
-			return err
-		}
-		if err := comp.WritePlaceholder(); err != nil {
-			return err
-		}
-		comp.Add(c.ColumnNumeric)
-		comp.Dirty = true
-	}
-	if c.ColumnReal != nil {
-		if comp.Dirty {
-			comp.WriteString(" AND ")
-		}
-		if err := comp.WriteAlias(id); err != nil {
-			return err
-		}
-		if _, err := comp.WriteString(TableCompleteColumnColumnReal); err != nil {
-			return err
-		}
-		if _, err := comp.WriteString("="); err != nil {
-			return err
-		}
-		if err := comp.WritePlaceholder(); err != nil {
-			return err
-		}
-		comp.Add(c.ColumnReal)
-		comp.Dirty = true
-	}
-	if c.ColumnSerial != nil {
-		if comp.Dirty {
-			comp.WriteString(" AND ")
-		}
-		if err := comp.WriteAlias(id); err != nil {
-			return err
-		}
-		if _, err := comp.WriteString(TableCompleteColumnColumnSerial); err != nil {
-			return err
-		}
-		if _, err := comp.WriteString("="); err != nil {
-			return err
-		}
-		if err := comp.WritePlaceholder(); err != nil {
-			return err
-		}
-		comp.Add(c.ColumnSerial)
-		comp.Dirty = true
-	}
-	// column_serial_big is an empty struct, ignore
-
-	if c.ColumnSerialSmall != nil {
-		if comp.Dirty {
-			comp.WriteString(" AND ")
-		}
-		if err := comp.WriteAlias(id); err != nil {
-			return err
-		}
-		if _, err := comp.WriteString(TableCompleteColumnColumnSerialSmall); err != nil {
-			return err
-		}
-		if _, err := comp.WriteString("="); err != nil {
-			return err
-		}
-		if err := comp.WritePlaceholder(); err != nil {
-			return err
-		}
-		comp.Add(c.ColumnSerialSmall)
-		comp.Dirty = true
-	}
-	if c.ColumnText.Valid {
-		if comp.Dirty {
-			comp.WriteString(" AND ")
-		}
-		if err := comp.WriteAlias(id); err != nil {
-			return err
-		}
-		if _, err := comp.WriteString(TableCompleteColumnColumnText); err != nil {
-			return err
-		}
-		if _, err := comp.WriteString("="); err != nil {
-			return err
-		}
-		if err := comp.WritePlaceholder(); err != nil {
-			return err
-		}
-		comp.Add(c.ColumnText)
-		comp.Dirty = true
-	}
-	if c.ColumnTextArray0.Valid {
-		if comp.Dirty {
-			comp.WriteString(" AND ")
-		}
-		if err := comp.WriteAlias(id); err != nil {
-			return err
-		}
-		if _, err := comp.WriteString(TableCompleteColumnColumnTextArray0); err != nil {
-			return err
-		}
-		if _, err := comp.WriteString("="); err != nil {
-			return err
-		}
-		if err := comp.WritePlaceholder(); err != nil {
-			return err
-		}
-		comp.Add(c.ColumnTextArray0)
-		comp.Dirty = true
-	}
-	if c.ColumnTextArray100.Valid {
-		if comp.Dirty {
-			comp.WriteString(" AND ")
-		}
-		if err := comp.WriteAlias(id); err != nil {
-			return err
-		}
-		if _, err := comp.WriteString(TableCompleteColumnColumnTextArray100); err != nil {
-			return err
-		}
-		if _, err := comp.WriteString("="); err != nil {
-			return err
-		}
-		if err := comp.WritePlaceholder(); err != nil {
-			return err
-		}
-		comp.Add(c.ColumnTextArray100)
-		comp.Dirty = true
-	}
-	if c.ColumnTimestamp.Valid {
-		if comp.Dirty {
-			comp.WriteString(" AND ")
-		}
-		if err := comp.WriteAlias(id); err != nil {
-			return err
-		}
-		if _, err := comp.WriteString(TableCompleteColumnColumnTimestamp); err != nil {
-			return err
-		}
-		if _, err := comp.WriteString("="); err != nil {
-			return err
-		}
-		if err := comp.WritePlaceholder(); err != nil {
-			return err
-		}
-		comp.Add(c.ColumnTimestamp)
-		comp.Dirty = true
-	}
-	if c.ColumnTimestamptz.Valid {
-		if comp.Dirty {
-			comp.WriteString(" AND ")
-		}
-		if err := comp.WriteAlias(id); err != nil {
-			return err
-		}
-		if _, err := comp.WriteString(TableCompleteColumnColumnTimestamptz); err != nil {
-			return err
-		}
-		if _, err := comp.WriteString("="); err != nil {
-			return err
-		}
-		if err := comp.WritePlaceholder(); err != nil {
-			return err
-		}
-		comp.Add(c.ColumnTimestamptz)
-		comp.Dirty = true
-	}
-	if c.ColumnUUID.Valid {
-		if comp.Dirty {
-			comp.WriteString(" AND ")
-		}
-		if err := comp.WriteAlias(id); err != nil {
-			return err
-		}
-		if _, err := comp.WriteString(TableCompleteColumnColumnUUID); err != nil {
-			return err
-		}
-		if _, err := comp.WriteString("="); err != nil {
-			return err
-		}
-		if err := comp.WritePlaceholder(); err != nil {
-			return err
-		}
-		comp.Add(c.ColumnUUID)
-		comp.Dirty = true
-	}
-	return nil
-}
-
-func (r *CompleteRepositoryBase) FindQuery(fe *CompleteFindExpr) (string, []interface{}, error) {
-	comp := NewComposer(33)
-	buf := bytes.NewBufferString("SELECT ")
-	if len(fe.Columns) == 0 {
-		buf.WriteString("t0.column_bool, t0.column_bytea, t0.column_character_0, t0.column_character_100, t0.column_decimal, t0.column_double_array_0, t0.column_double_array_100, t0.column_integer, t0.column_integer_array_0, t0.column_integer_array_100, t0.column_integer_big, t0.column_integer_big_array_0, t0.column_integer_big_array_100, t0.column_integer_small, t0.column_integer_small_array_0, t0.column_integer_small_array_100, t0.column_json, t0.column_json_nn, t0.column_json_nn_d, t0.column_jsonb, t0.column_jsonb_nn, t0.column_jsonb_nn_d, t0.column_numeric, t0.column_real, t0.column_serial, t0.column_serial_big, t0.column_serial_small, t0.column_text, t0.column_text_array_0, t0.column_text_array_100, t0.column_timestamp, t0.column_timestamptz, t0.column_uuid")
-	} else {
-		buf.WriteString(strings.Join(fe.Columns, ", "))
-	}
-	buf.WriteString(" FROM ")
-	buf.WriteString(r.Table)
-	buf.WriteString(" AS t0")
-	if comp.Dirty {
-		buf.ReadFrom(comp)
-		comp.Dirty = false
-	}
-	if fe.Where != nil {
-		if err := CompleteCriteriaWhereClause(comp, fe.Where, 0); err != nil {
-			return "", nil, err
-		}
-	}
-	if comp.Dirty {
-		if _, err := buf.WriteString(" WHERE "); err != nil {
-			return "", nil, err
-		}
-		buf.ReadFrom(comp)
-	}
-
-	if len(fe.OrderBy) > 0 {
-		i := 0
-		for _, order := range fe.OrderBy {
-			for _, columnName := range TableCompleteColumns {
-				if order.Name == columnName {
-					if i == 0 {
-						comp.WriteString(" ORDER BY ")
-					}
-					if i > 0 {
-						if _, err := comp.WriteString(", "); err != nil {
-							return "", nil, err
-						}
-					}
-					if _, err := comp.WriteString(order.Name); err != nil {
-						return "", nil, err
-					}
-					if order.Descending {
-						if _, err := comp.WriteString(" DESC"); err != nil {
-							return "", nil, err
-						}
-					}
-					i++
-					break
-				}
-			}
-		}
-	}
-	if fe.Offset > 0 {
-		if _, err := comp.WriteString(" OFFSET "); err != nil {
-			return "", nil, err
-		}
-		if err := comp.WritePlaceholder(); err != nil {
-			return "", nil, err
-		}
-		if _, err := comp.WriteString(" "); err != nil {
-			return "", nil, err
-		}
-		comp.Add(fe.Offset)
-	}
-	if fe.Limit > 0 {
-		if _, err := comp.WriteString(" LIMIT "); err != nil {
-			return "", nil, err
-		}
-		if err := comp.WritePlaceholder(); err != nil {
-			return "", nil, err
-		}
-		if _, err := comp.WriteString(" "); err != nil {
-			return "", nil, err
-		}
-		comp.Add(fe.Limit)
-	}
-
-	buf.ReadFrom(comp)
-
-	return buf.String(), comp.Args(), nil
-}
-
-func (r *CompleteRepositoryBase) find(ctx context.Context, tx *sql.Tx, fe *CompleteFindExpr) ([]*CompleteEntity, error) {
-	query, args, err := r.FindQuery(fe)
-	if err != nil {
-		return nil, err
-	}
-	var rows *sql.Rows
-	if tx == nil {
-		rows, err = r.DB.QueryContext(ctx, query, args...)
-	} else {
-		rows, err = tx.QueryContext(ctx, query, args...)
-	}
-	if r.Log != nil {
-		if tx == nil {
-			r.Log(err, TableComplete, "find", query, args...)
-		} else {
-			r.Log(err, TableComplete, "find tx", query, args...)
-		}
-	}
-	if err != nil {
-		return nil, err
-	}
-	defer rows.Close()
-	var (
-		entities []*CompleteEntity
-		props    []interface{}
-	)
-	for rows.Next() {
-		var ent CompleteEntity
-		if props, err = ent.Props(); err != nil {
-			return nil, err
-		}
-		err = rows.Scan(props...)
-		if err != nil {
-			return nil, err
-		}
-
-		entities = append(entities, &ent)
-	}
-	err = rows.Err()
-	if r.Log != nil {
-		r.Log(err, TableComplete, "find", query, args...)
-	}
-	if err != nil {
-		return nil, err
-	}
-	return entities, nil
-}
-
-func (r *CompleteRepositoryBase) Find(ctx context.Context, fe *CompleteFindExpr) ([]*CompleteEntity, error) {
-	return r.find(ctx, nil, fe)
-}
-
-func (r *CompleteRepositoryBase) findIter(ctx context.Context, tx *sql.Tx, fe *CompleteFindExpr) (*CompleteIterator, error) {
-	query, args, err := r.FindQuery(fe)
-	if err != nil {
-		return nil, err
-	}
-	var rows *sql.Rows
-	if tx == nil {
-		rows, err = r.DB.QueryContext(ctx, query, args...)
-	} else {
-		rows, err = tx.QueryContext(ctx, query, args...)
-	}
-	if r.Log != nil {
-		if tx == nil {
-			r.Log(err, TableComplete, "find iter", query, args...)
-		} else {
-			r.Log(err, TableComplete, "find iter tx", query, args...)
-		}
-	}
-	if err != nil {
-		return nil, err
-	}
-	return &CompleteIterator{
-		rows: rows,
-		expr: fe,
-		cols: fe.Columns,
-	}, nil
-}
-
-func (r *CompleteRepositoryBase) FindIter(ctx context.Context, fe *CompleteFindExpr) (*CompleteIterator, error) {
-	return r.findIter(ctx, nil, fe)
-}
-
-func (r *CompleteRepositoryBase) UpsertQuery(e *CompleteEntity, p *CompletePatch, inf ...string) (string, []interface{}, error) {
-	upsert := NewComposer(66)
-	columns := bytes.NewBuffer(nil)
-	buf := bytes.NewBufferString("INSERT INTO ")
-	buf.WriteString(r.Table)
-
-	if e.ColumnBool.Valid {
-		if columns.Len() > 0 {
-			if _, err := columns.WriteString(", "); err != nil {
-				return "", nil, err
-			}
-		}
-		if _, err := columns.WriteString(TableCompleteColumnColumnBool); err != nil {
-			return "", nil, err
-		}
-		if upsert.Dirty {
-			if _, err := upsert.WriteString(", "); err != nil {
-				return "", nil, err
-			}
-		}
-		if err := upsert.WritePlaceholder(); err != nil {
-			return "", nil, err
-		}
-		upsert.Add(e.ColumnBool)
-		upsert.Dirty = true
-	}
-
-	if e.ColumnBytea != nil {
-		if columns.Len() > 0 {
-			if _, err := columns.WriteString(", "); err != nil {
-				return "", nil, err
-			}
-		}
-		if _, err := columns.WriteString(TableCompleteColumnColumnBytea); err != nil {
-			return "", nil, err
-		}
-		if upsert.Dirty {
-			if _, err := upsert.WriteString(", "); err != nil {
-				return "", nil, err
-			}
-		}
-		if err := upsert.WritePlaceholder(); err != nil {
-			return "", nil, err
-		}
-		upsert.Add(e.ColumnBytea)
-		upsert.Dirty = true
-	}
-
-	if e.ColumnCharacter0.Valid {
-		if columns.Len() > 0 {
-			if _, err := columns.WriteString(", "); err != nil {
-				return "", nil, err
-			}
-		}
-		if _, err := columns.WriteString(TableCompleteColumnColumnCharacter0); err != nil {
-			return "", nil, err
-		}
-		if upsert.Dirty {
-			if _, err := upsert.WriteString(", "); err != nil {
-				return "", nil, err
-			}
-		}
-		if err := upsert.WritePlaceholder(); err != nil {
-			return "", nil, err
-		}
-		upsert.Add(e.ColumnCharacter0)
-		upsert.Dirty = true
-	}
-
-	if e.ColumnCharacter100.Valid {
-		if columns.Len() > 0 {
-			if _, err := columns.WriteString(", "); err != nil {
-				return "", nil, err
-			}
-		}
-		if _, err := columns.WriteString(TableCompleteColumnColumnCharacter100); err != nil {
-			return "", nil, err
-		}
-		if upsert.Dirty {
-			if _, err := upsert.WriteString(", "); err != nil {
-				return "", nil, err
-			}
-		}
-		if err := upsert.WritePlaceholder(); err != nil {
-			return "", nil, err
-		}
-		upsert.Add(e.ColumnCharacter100)
-		upsert.Dirty = true
-	}
-
-	if e.ColumnDecimal.Valid {
-		if columns.Len() > 0 {
-			if _, err := columns.WriteString(", "); err != nil {
-				return "", nil, err
-			}
-		}
-		if _, err := columns.WriteString(TableCompleteColumnColumnDecimal); err != nil {
-			return "", nil, err
-		}
-		if upsert.Dirty {
-			if _, err := upsert.WriteString(", "); err != nil {
-				return "", nil, err
-			}
-		}
-		if err := upsert.WritePlaceholder(); err != nil {
-			return "", nil, err
-		}
-		upsert.Add(e.ColumnDecimal)
-		upsert.Dirty = true
-	}
-
-	if e.ColumnDoubleArray0.Valid {
-		if columns.Len() > 0 {
-			if _, err := columns.WriteString(", "); err != nil {
-				return "", nil, err
-			}
-		}
-		if _, err := columns.WriteString(TableCompleteColumnColumnDoubleArray0); err != nil {
-			return "", nil, err
-		}
-		if upsert.Dirty {
-			if _, err := upsert.WriteString(", "); err != nil {
-				return "", nil, err
-			}
-		}
-		if err := upsert.WritePlaceholder(); err != nil {
-			return "", nil, err
-		}
-		upsert.Add(e.ColumnDoubleArray0)
-		upsert.Dirty = true
-	}
-
-	if e.ColumnDoubleArray100.Valid {
-		if columns.Len() > 0 {
-			if _, err := columns.WriteString(", "); err != nil {
-				return "", nil, err
-			}
-		}
-		if _, err := columns.WriteString(TableCompleteColumnColumnDoubleArray100); err != nil {
-			return "", nil, err
-		}
-		if upsert.Dirty {
-			if _, err := upsert.WriteString(", "); err != nil {
-				return "", nil, err
-			}
-		}
-		if err := upsert.WritePlaceholder(); err != nil {
-			return "", nil, err
-		}
-		upsert.Add(e.ColumnDoubleArray100)
-		upsert.Dirty = true
-	}
-
-	if e.ColumnInteger != nil {
-		if columns.Len() > 0 {
-			if _, err := columns.WriteString(", "); err != nil {
-				return "", nil, err
-			}
-		}
-		if _, err := columns.WriteString(TableCompleteColumnColumnInteger); err != nil {
-			return "", nil, err
-		}
-		if upsert.Dirty {
-			if _, err := upsert.WriteString(", "); err != nil {
-				return "", nil, err
-			}
-		}
-		if err := upsert.WritePlaceholder(); err != nil {
-			return "", nil, err
-		}
-		upsert.Add(e.ColumnInteger)
-		upsert.Dirty = true
-	}
-
-	if e.ColumnIntegerArray0.Valid {
-		if columns.Len() > 0 {
-			if _, err := columns.WriteString(", "); err != nil {
-				return "", nil, err
-			}
-		}
-		if _, err := columns.WriteString(TableCompleteColumnColumnIntegerArray0); err != nil {
-			return "", nil, err
-		}
-		if upsert.Dirty {
-			if _, err := upsert.WriteString(", "); err != nil {
-				return "", nil, err
-			}
-		}
-		if err := upsert.WritePlaceholder(); err != nil {
-			return "", nil, err
-		}
-		upsert.Add(e.ColumnIntegerArray0)
-		upsert.Dirty = true
-	}
-
-	if e.ColumnIntegerArray100.Valid {
-		if columns.Len() > 0 {
-			if _, err := columns.WriteString(", "); err != nil {
-				return "", nil, err
-			}
-		}
-		if _, err := columns.WriteString(TableCompleteColumnColumnIntegerArray100); err != nil {
-			return "", nil, err
-		}
-		if upsert.Dirty {
-			if _, err := upsert.WriteString(", "); err != nil {
-				return "", nil, err
-			}
-		}
-		if err := upsert.WritePlaceholder(); err != nil {
-			return "", nil, err
-		}
-		upsert.Add(e.ColumnIntegerArray100)
-		upsert.Dirty = true
-	}
-
-	if e.ColumnIntegerBig.Valid {
-		if columns.Len() > 0 {
-			if _, err := columns.WriteString(", "); err != nil {
-				return "", nil, err
-			}
-		}
-		if _, err := columns.WriteString(TableCompleteColumnColumnIntegerBig); err != nil {
-			return "", nil, err
-		}
-		if upsert.Dirty {
-			if _, err := upsert.WriteString(", "); err != nil {
-				return "", nil, err
-			}
-		}
-		if err := upsert.WritePlaceholder(); err != nil {
-			return "", nil, err
-		}
-		upsert.Add(e.ColumnIntegerBig)
-		upsert.Dirty = true
-	}
-
-	if e.ColumnIntegerBigArray0.Valid {
-		if columns.Len() > 0 {
-			if _, err := columns.WriteString(", "); err != nil {
-				return "", nil, err
-			}
-		}
-		if _, err := columns.WriteString(TableCompleteColumnColumnIntegerBigArray0); err != nil {
-			return "", nil, err
-		}
-		if upsert.Dirty {
-			if _, err := upsert.WriteString(", "); err != nil {
-				return "", nil, err
-			}
-		}
-		if err := upsert.WritePlaceholder(); err != nil {
-			return "", nil, err
-		}
-		upsert.Add(e.ColumnIntegerBigArray0)
-		upsert.Dirty = true
-	}
-
-	if e.ColumnIntegerBigArray100.Valid {
-		if columns.Len() > 0 {
-			if _, err := columns.WriteString(", "); err != nil {
-				return "", nil, err
-			}
-		}
-		if _, err := columns.WriteString(TableCompleteColumnColumnIntegerBigArray100); err != nil {
-			return "", nil, err
-		}
-		if upsert.Dirty {
-			if _, err := upsert.WriteString(", "); err != nil {
-				return "", nil, err
-			}
-		}
-		if err := upsert.WritePlaceholder(); err != nil {
-			return "", nil, err
-		}
-		upsert.Add(e.ColumnIntegerBigArray100)
-		upsert.Dirty = true
-	}
-
-	if e.ColumnIntegerSmall != nil {
-		if columns.Len() > 0 {
-			if _, err := columns.WriteString(", "); err != nil {
-				return "", nil, err
-			}
-		}
-		if _, err := columns.WriteString(TableCompleteColumnColumnIntegerSmall); err != nil {
-			return "", nil, err
-		}
-		if upsert.Dirty {
-			if _, err := upsert.WriteString(", "); err != nil {
-				return "", nil, err
-			}
-		}
-		if err := upsert.WritePlaceholder(); err != nil {
-			return "", nil, err
-		}
-		upsert.Add(e.ColumnIntegerSmall)
-		upsert.Dirty = true
-	}
-
-	if e.ColumnIntegerSmallArray0.Valid {
-		if columns.Len() > 0 {
-			if _, err := columns.WriteString(", "); err != nil {
-				return "", nil, err
-			}
-		}
-		if _, err := columns.WriteString(TableCompleteColumnColumnIntegerSmallArray0); err != nil {
-			return "", nil, err
-		}
-		if upsert.Dirty {
-			if _, err := upsert.WriteString(", "); err != nil {
-				return "", nil, err
-			}
-		}
-		if err := upsert.WritePlaceholder(); err != nil {
-			return "", nil, err
-		}
-		upsert.Add(e.ColumnIntegerSmallArray0)
-		upsert.Dirty = true
-	}
-
-	if e.ColumnIntegerSmallArray100.Valid {
-		if columns.Len() > 0 {
-			if _, err := columns.WriteString(", "); err != nil {
-				return "", nil, err
-			}
-		}
-		if _, err := columns.WriteString(TableCompleteColumnColumnIntegerSmallArray100); err != nil {
-			return "", nil, err
-		}
-		if upsert.Dirty {
-			if _, err := upsert.WriteString(", "); err != nil {
-				return "", nil, err
-			}
-		}
-		if err := upsert.WritePlaceholder(); err != nil {
-			return "", nil, err
-		}
-		upsert.Add(e.ColumnIntegerSmallArray100)
-		upsert.Dirty = true
-	}
-
-	if e.ColumnJson != nil {
-		if columns.Len() > 0 {
-			if _, err := columns.WriteString(", "); err != nil {
-				return "", nil, err
-			}
-		}
-		if _, err := columns.WriteString(TableCompleteColumnColumnJson); err != nil {
-			return "", nil, err
-		}
-		if upsert.Dirty {
-			if _, err := upsert.WriteString(", "); err != nil {
-				return "", nil, err
-			}
-		}
-		if err := upsert.WritePlaceholder(); err != nil {
-			return "", nil, err
-		}
-		upsert.Add(e.ColumnJson)
-		upsert.Dirty = true
-	}
-
-	if e.ColumnJsonNn != nil {
-		if columns.Len() > 0 {
-			if _, err := columns.WriteString(", "); err != nil {
-				return "", nil, err
-			}
-		}
-		if _, err := columns.WriteString(TableCompleteColumnColumnJsonNn); err != nil {
-			return "", nil, err
-		}
-		if upsert.Dirty {
-			if _, err := upsert.WriteString(", "); err != nil {
-				return "", nil, err
-			}
-		}
-		if err := upsert.WritePlaceholder(); err != nil {
-			return "", nil, err
-		}
-		upsert.Add(e.ColumnJsonNn)
-		upsert.Dirty = true
-	}
-
-	if e.ColumnJsonNnD != nil {
-		if columns.Len() > 0 {
-			if _, err := columns.WriteString(", "); err != nil {
-				return "", nil, err
-			}
-		}
-		if _, err := columns.WriteString(TableCompleteColumnColumnJsonNnD); err != nil {
-			return "", nil, err
-		}
-		if upsert.Dirty {
-			if _, err := upsert.WriteString(", "); err != nil {
-				return "", nil, err
-			}
-		}
-		if err := upsert.WritePlaceholder(); err != nil {
-			return "", nil, err
-		}
-		upsert.Add(e.ColumnJsonNnD)
-		upsert.Dirty = true
-	}
-
-	if e.ColumnJsonb != nil {
-		if columns.Len() > 0 {
-			if _, err := columns.WriteString(", "); err != nil {
-				return "", nil, err
-			}
-		}
-		if _, err := columns.WriteString(TableCompleteColumnColumnJsonb); err != nil {
-			return "", nil, err
-		}
-		if upsert.Dirty {
-			if _, err := upsert.WriteString(", "); err != nil {
-				return "", nil, err
-			}
-		}
-		if err := upsert.WritePlaceholder(); err != nil {
-			return "", nil, err
-		}
-		upsert.Add(e.ColumnJsonb)
-		upsert.Dirty = true
-	}
-
-	if e.ColumnJsonbNn != nil {
-		if columns.Len() > 0 {
-			if _, err := columns.WriteString(", "); err != nil {
-				return "", nil, err
-			}
-		}
-		if _, err := columns.WriteString(TableCompleteColumnColumnJsonbNn); err != nil {
-			return "", nil, err
-		}
-		if upsert.Dirty {
-			if _, err := upsert.WriteString(", "); err != nil {
-				return "", nil, err
-			}
-		}
-		if err := upsert.WritePlaceholder(); err != nil {
-			return "", nil, err
-		}
-		upsert.Add(e.ColumnJsonbNn)
-		upsert.Dirty = true
-	}
-
-	if e.ColumnJsonbNnD != nil {
-		if columns.Len() > 0 {
-			if _, err := columns.WriteString(", "); err != nil {
-				return "", nil, err
-			}
-		}
-		if _, err := columns.WriteString(TableCompleteColumnColumnJsonbNnD); err != nil {
-			return "", nil, err
-		}
-		if upsert.Dirty {
-			if _, err := upsert.WriteString(", "); err != nil {
-				return "", nil, err
-			}
-		}
-		if err := upsert.WritePlaceholder(); err != nil {
-			return "", nil, err
-		}
-		upsert.Add(e.ColumnJsonbNnD)
-		upsert.Dirty = true
-	}
-
-	if e.ColumnNumeric.Valid {
-		if columns.Len() > 0 {
-			if _, err := columns.WriteString(", "); err != nil {
-				return "", nil, err
-			}
-		}
-		if _, err := columns.WriteString(TableCompleteColumnColumnNumeric); err != nil {
-			return "", nil, err
-		}
-		if upsert.Dirty {
-			if _, err := upsert.WriteString(", "); err != nil {
-				return "", nil, err
-			}
-		}
-		if err := upsert.WritePlaceholder(); err != nil {
-			return "", nil, err
-		}
-		upsert.Add(e.ColumnNumeric)
-		upsert.Dirty = true
-	}
-
-	if e.ColumnReal != nil {
-		if columns.Len() > 0 {
-			if _, err := columns.WriteString(", "); err != nil {
-				return "", nil, err
-			}
-		}
-		if _, err := columns.WriteString(TableCompleteColumnColumnReal); err != nil {
-			return "", nil, err
-		}
-		if upsert.Dirty {
-			if _, err := upsert.WriteString(", "); err != nil {
-				return "", nil, err
-			}
-		}
-		if err := upsert.WritePlaceholder(); err != nil {
-			return "", nil, err
-		}
-		upsert.Add(e.ColumnReal)
-		upsert.Dirty = true
-	}
-
-	if e.ColumnText.Valid {
-		if columns.Len() > 0 {
-			if _, err := columns.WriteString(", "); err != nil {
-				return "", nil, err
-			}
-		}
-		if _, err := columns.WriteString(TableCompleteColumnColumnText); err != nil {
-			return "", nil, err
-		}
-		if upsert.Dirty {
-			if _, err := upsert.WriteString(", "); err != nil {
-				return "", nil, err
-			}
-		}
-		if err := upsert.WritePlaceholder(); err != nil {
-			return "", nil, err
-		}
-		upsert.Add(e.ColumnText)
-		upsert.Dirty = true
-	}
-
-	if e.ColumnTextArray0.Valid {
-		if columns.Len() > 0 {
-			if _, err := columns.WriteString(", "); err != nil {
-				return "", nil, err
-			}
-		}
-		if _, err := columns.WriteString(TableCompleteColumnColumnTextArray0); err != nil {
-			return "", nil, err
-		}
-		if upsert.Dirty {
-			if _, err := upsert.WriteString(", "); err != nil {
-				return "", nil, err
-			}
-		}
-		if err := upsert.WritePlaceholder(); err != nil {
-			return "", nil, err
-		}
-		upsert.Add(e.ColumnTextArray0)
-		upsert.Dirty = true
-	}
-
-	if e.ColumnTextArray100.Valid {
-		if columns.Len() > 0 {
-			if _, err := columns.WriteString(", "); err != nil {
-				return "", nil, err
-			}
-		}
-		if _, err := columns.WriteString(TableCompleteColumnColumnTextArray100); err != nil {
-			return "", nil, err
-		}
-		if upsert.Dirty {
-			if _, err := upsert.WriteString(", "); err != nil {
-				return "", nil, err
-			}
-		}
-		if err := upsert.WritePlaceholder(); err != nil {
-			return "", nil, err
-		}
-		upsert.Add(e.ColumnTextArray100)
-		upsert.Dirty = true
-	}
-
-	if e.ColumnTimestamp.Valid {
-		if columns.Len() > 0 {
-			if _, err := columns.WriteString(", "); err != nil {
-				return "", nil, err
-			}
-		}
-		if _, err := columns.WriteString(TableCompleteColumnColumnTimestamp); err != nil {
-			return "", nil, err
-		}
-		if upsert.Dirty {
-			if _, err := upsert.WriteString(", "); err != nil {
-				return "", nil, err
-			}
-		}
-		if err := upsert.WritePlaceholder(); err != nil {
-			return "", nil, err
-		}
-		upsert.Add(e.ColumnTimestamp)
-		upsert.Dirty = true
-	}
-
-	if e.ColumnTimestamptz.Valid {
-		if columns.Len() > 0 {
-			if _, err := columns.WriteString(", "); err != nil {
-				return "", nil, err
-			}
-		}
-		if _, err := columns.WriteString(TableCompleteColumnColumnTimestamptz); err != nil {
-			return "", nil, err
-		}
-		if upsert.Dirty {
-			if _, err := upsert.WriteString(", "); err != nil {
-				return "", nil, err
-			}
-		}
-		if err := upsert.WritePlaceholder(); err != nil {
-			return "", nil, err
-		}
-		upsert.Add(e.ColumnTimestamptz)
-		upsert.Dirty = true
-	}
-
-	if e.ColumnUUID.Valid {
-		if columns.Len() > 0 {
-			if _, err := columns.WriteString(", "); err != nil {
-				return "", nil, err
-			}
-		}
-		if _, err := columns.WriteString(TableCompleteColumnColumnUUID); err != nil {
-			return "", nil, err
-		}
-		if upsert.Dirty {
-			if _, err := upsert.WriteString(", "); err != nil {
-				return "", nil, err
-			}
-		}
-		if err := upsert.WritePlaceholder(); err != nil {
-			return "", nil, err
-		}
-		upsert.Add(e.ColumnUUID)
-		upsert.Dirty = true
-	}
-
-	if upsert.Dirty {
-		buf.WriteString(" (")
-		buf.ReadFrom(columns)
-		buf.WriteString(") VALUES (")
-		buf.ReadFrom(upsert)
-		buf.WriteString(")")
-	}
-	buf.WriteString(" ON CONFLICT ")
-	if len(inf) > 0 {
-		upsert.Dirty = false
-		if p.ColumnBool.Valid {
-			if upsert.Dirty {
-				if _, err := upsert.WriteString(", "); err != nil {
-					return "", nil, err
-				}
-			}
-			if _, err := upsert.WriteString(TableCompleteColumnColumnBool); err != nil {
-				return "", nil, err
-			}
-			if _, err := upsert.WriteString("="); err != nil {
-				return "", nil, err
-			}
-			if err := upsert.WritePlaceholder(); err != nil {
-				return "", nil, err
-			}
-			upsert.Add(p.ColumnBool)
-			upsert.Dirty = true
-
-		}
-		if p.ColumnBytea != nil {
-			if upsert.Dirty {
-				if _, err := upsert.WriteString(", "); err != nil {
-					return "", nil, err
-				}
-			}
-			if _, err := upsert.WriteString(TableCompleteColumnColumnBytea); err != nil {
-				return "", nil, err
-			}
-			if _, err := upsert.WriteString("="); err != nil {
-				return "", nil, err
-			}
-			if err := upsert.WritePlaceholder(); err != nil {
-				return "", nil, err
-			}
-			upsert.Add(p.ColumnBytea)
-			upsert.Dirty = true
-
-		}
-		if p.ColumnCharacter0.Valid {
-			if upsert.Dirty {
-				if _, err := upsert.WriteString(", "); err != nil {
-					return "", nil, err
-				}
-			}
-			if _, err := upsert.WriteString(TableCompleteColumnColumnCharacter0); err != nil {
-				return "", nil, err
-			}
-			if _, err := upsert.WriteString("="); err != nil {
-				return "", nil, err
-			}
-			if err := upsert.WritePlaceholder(); err != nil {
-				return "", nil, err
-			}
-			upsert.Add(p.ColumnCharacter0)
-			upsert.Dirty = true
-
-		}
-		if p.ColumnCharacter100.Valid {
-			if upsert.Dirty {
-				if _, err := upsert.WriteString(", "); err != nil {
-					return "", nil, err
-				}
-			}
-			if _, err := upsert.WriteString(TableCompleteColumnColumnCharacter100); err != nil {
-				return "", nil, err
-			}
-			if _, err := upsert.WriteString("="); err != nil {
-				return "", nil, err
-			}
-			if err := upsert.WritePlaceholder(); err != nil {
-				return "", nil, err
-			}
-			upsert.Add(p.ColumnCharacter100)
-			upsert.Dirty = true
-
-		}
-		if p.ColumnDecimal.Valid {
-			if upsert.Dirty {
-				if _, err := upsert.WriteString(", "); err != nil {
-					return "", nil, err
-				}
-			}
-			if _, err := upsert.WriteString(TableCompleteColumnColumnDecimal); err != nil {
-				return "", nil, err
-			}
-			if _, err := upsert.WriteString("="); err != nil {
-				return "", nil, err
-			}
-			if err := upsert.WritePlaceholder(); err != nil {
-				return "", nil, err
-			}
-			upsert.Add(p.ColumnDecimal)
-			upsert.Dirty = true
-
-		}
-		if p.ColumnDoubleArray0.Valid {
-			if upsert.Dirty {
-				if _, err := upsert.WriteString(", "); err != nil {
-					return "", nil, err
-				}
-			}
-			if _, err := upsert.WriteString(TableCompleteColumnColumnDoubleArray0); err != nil {
-				return "", nil, err
-			}
-			if _, err := upsert.WriteString("="); err != nil {
-				return "", nil, err
-			}
-			if err := upsert.WritePlaceholder(); err != nil {
-				return "", nil, err
-			}
-			upsert.Add(p.ColumnDoubleArray0)
-			upsert.Dirty = true
-
-		}
-		if p.ColumnDoubleArray100.Valid {
-			if upsert.Dirty {
-				if _, err := upsert.WriteString(", "); err != nil {
-					return "", nil, err
-				}
-			}
-			if _, err := upsert.WriteString(TableCompleteColumnColumnDoubleArray100); err != nil {
-				return "", nil, err
-			}
-			if _, err := upsert.WriteString("="); err != nil {
-				return "", nil, err
-			}
-			if err := upsert.WritePlaceholder(); err != nil {
-				return "", nil, err
-			}
-			upsert.Add(p.ColumnDoubleArray100)
-			upsert.Dirty = true
-
-		}
-		if p.ColumnInteger != nil {
-			if upsert.Dirty {
-				if _, err := upsert.WriteString(", "); err != nil {
-					return "", nil, err
-				}
-			}
-			if _, err := upsert.WriteString(TableCompleteColumnColumnInteger); err != nil {
-				return "", nil, err
-			}
-			if _, err := upsert.WriteString("="); err != nil {
-				return "", nil, err
-			}
-			if err := upsert.WritePlaceholder(); err != nil {
-				return "", nil, err
-			}
-			upsert.Add(p.ColumnInteger)
-			upsert.Dirty = true
-
-		}
-		if p.ColumnIntegerArray0.Valid {
-			if upsert.Dirty {
-				if _, err := upsert.WriteString(", "); err != nil {
-					return "", nil, err
-				}
-			}
-			if _, err := upsert.WriteString(TableCompleteColumnColumnIntegerArray0); err != nil {
-				return "", nil, err
-			}
-			if _, err := upsert.WriteString("="); err != nil {
-				return "", nil, err
-			}
-			if err := upsert.WritePlaceholder(); err != nil {
-				return "", nil, err
-			}
-			upsert.Add(p.ColumnIntegerArray0)
-			upsert.Dirty = true
-
-		}
-		if p.ColumnIntegerArray100.Valid {
-			if upsert.Dirty {
-				if _, err := upsert.WriteString(", "); err != nil {
-					return "", nil, err
-				}
-			}
-			if _, err := upsert.WriteString(TableCompleteColumnColumnIntegerArray100); err != nil {
-				return "", nil, err
-			}
-			if _, err := upsert.WriteString("="); err != nil {
-				return "", nil, err
-			}
-			if err := upsert.WritePlaceholder(); err != nil {
-				return "", nil, err
-			}
-			upsert.Add(p.ColumnIntegerArray100)
-			upsert.Dirty = true
-
-		}
-		if p.ColumnIntegerBig.Valid {
-			if upsert.Dirty {
-				if _, err := upsert.WriteString(", "); err != nil {
-					return "", nil, err
-				}
-			}
-			if _, err := upsert.WriteString(TableCompleteColumnColumnIntegerBig); err != nil {
-				return "", nil, err
-			}
-			if _, err := upsert.WriteString("="); err != nil {
-				return "", nil, err
-			}
-			if err := upsert.WritePlaceholder(); err != nil {
-				return "", nil, err
-			}
-			upsert.Add(p.ColumnIntegerBig)
-			upsert.Dirty = true
-
-		}
-		if p.ColumnIntegerBigArray0.Valid {
-			if upsert.Dirty {
-				if _, err := upsert.WriteString(", "); err != nil {
-					return "", nil, err
-				}
-			}
-			if _, err := upsert.WriteString(TableCompleteColumnColumnIntegerBigArray0); err != nil {
-				return "", nil, err
-			}
-			if _, err := upsert.WriteString("="); err != nil {
-				return "", nil, err
-			}
-			if err := upsert.WritePlaceholder(); err != nil {
-				return "", nil, err
-			}
-			upsert.Add(p.ColumnIntegerBigArray0)
-			upsert.Dirty = true
-
-		}
-		if p.ColumnIntegerBigArray100.Valid {
-			if upsert.Dirty {
-				if _, err := upsert.WriteString(", "); err != nil {
-					return "", nil, err
-				}
-			}
-			if _, err := upsert.WriteString(TableCompleteColumnColumnIntegerBigArray100); err != nil {
-				return "", nil, err
-			}
-			if _, err := upsert.WriteString("="); err != nil {
-				return "", nil, err
-			}
-			if err := upsert.WritePlaceholder(); err != nil {
-				return "", nil, err
-			}
-			upsert.Add(p.ColumnIntegerBigArray100)
-			upsert.Dirty = true
-
-		}
-		if p.ColumnIntegerSmall != nil {
-			if upsert.Dirty {
-				if _, err := upsert.WriteString(", "); err != nil {
-					return "", nil, err
-				}
-			}
-			if _, err := upsert.WriteString(TableCompleteColumnColumnIntegerSmall); err != nil {
-				return "", nil, err
-			}
-			if _, err := upsert.WriteString("="); err != nil {
-				return "", nil, err
-			}
-			if err := upsert.WritePlaceholder(); err != nil {
-				return "", nil, err
-			}
-			upsert.Add(p.ColumnIntegerSmall)
-			upsert.Dirty = true
-
-		}
-		if p.ColumnIntegerSmallArray0.Valid {
-			if upsert.Dirty {
-				if _, err := upsert.WriteString(", "); err != nil {
-					return "", nil, err
-				}
-			}
-			if _, err := upsert.WriteString(TableCompleteColumnColumnIntegerSmallArray0); err != nil {
-				return "", nil, err
-			}
-			if _, err := upsert.WriteString("="); err != nil {
-				return "", nil, err
-			}
-			if err := upsert.WritePlaceholder(); err != nil {
-				return "", nil, err
-			}
-			upsert.Add(p.ColumnIntegerSmallArray0)
-			upsert.Dirty = true
-
-		}
-		if p.ColumnIntegerSmallArray100.Valid {
-			if upsert.Dirty {
-				if _, err := upsert.WriteString(", "); err != nil {
-					return "", nil, err
-				}
-			}
-			if _, err := upsert.WriteString(TableCompleteColumnColumnIntegerSmallArray100); err != nil {
-				return "", nil, err
-			}
-			if _, err := upsert.WriteString("="); err != nil {
-				return "", nil, err
-			}
-			if err := upsert.WritePlaceholder(); err != nil {
-				return "", nil, err
-			}
-			upsert.Add(p.ColumnIntegerSmallArray100)
-			upsert.Dirty = true
-
-		}
-		if p.ColumnJson != nil {
-			if upsert.Dirty {
-				if _, err := upsert.WriteString(", "); err != nil {
-					return "", nil, err
-				}
-			}
-			if _, err := upsert.WriteString(TableCompleteColumnColumnJson); err != nil {
-				return "", nil, err
-			}
-			if _, err := upsert.WriteString("="); err != nil {
-				return "", nil, err
-			}
-			if err := upsert.WritePlaceholder(); err != nil {
-				return "", nil, err
-			}
-			upsert.Add(p.ColumnJson)
-			upsert.Dirty = true
-
-		}
-		if p.ColumnJsonNn != nil {
-			if upsert.Dirty {
-				if _, err := upsert.WriteString(", "); err != nil {
-					return "", nil, err
-				}
-			}
-			if _, err := upsert.WriteString(TableCompleteColumnColumnJsonNn); err != nil {
-				return "", nil, err
-			}
-			if _, err := upsert.WriteString("="); err != nil {
-				return "", nil, err
-			}
-			if err := upsert.WritePlaceholder(); err != nil {
-				return "", nil, err
-			}
-			upsert.Add(p.ColumnJsonNn)
-			upsert.Dirty = true
-
-		}
-		if p.ColumnJsonNnD != nil {
-			if upsert.Dirty {
-				if _, err := upsert.WriteString(", "); err != nil {
-					return "", nil, err
-				}
-			}
-			if _, err := upsert.WriteString(TableCompleteColumnColumnJsonNnD); err != nil {
-				return "", nil, err
-			}
-			if _, err := upsert.WriteString("="); err != nil {
-				return "", nil, err
-			}
-			if err := upsert.WritePlaceholder(); err != nil {
-				return "", nil, err
-			}
-			upsert.Add(p.ColumnJsonNnD)
-			upsert.Dirty = true
-
-		}
-		if p.ColumnJsonb != nil {
-			if upsert.Dirty {
-				if _, err := upsert.WriteString(", "); err != nil {
-					return "", nil, err
-				}
-			}
-			if _, err := upsert.WriteString(TableCompleteColumnColumnJsonb); err != nil {
-				return "", nil, err
-			}
-			if _, err := upsert.WriteString("="); err != nil {
-				return "", nil, err
-			}
-			if err := upsert.WritePlaceholder(); err != nil {
-				return "", nil, err
-			}
-			upsert.Add(p.ColumnJsonb)
-			upsert.Dirty = true
-
-		}
-		if p.ColumnJsonbNn != nil {
-			if upsert.Dirty {
-				if _, err := upsert.WriteString(", "); err != nil {
-					return "", nil, err
-				}
-			}
-			if _, err := upsert.WriteString(TableCompleteColumnColumnJsonbNn); err != nil {
-				return "", nil, err
-			}
-			if _, err := upsert.WriteString("="); err != nil {
-				return "", nil, err
-			}
-			if err := upsert.WritePlaceholder(); err != nil {
-				return "", nil, err
-			}
-			upsert.Add(p.ColumnJsonbNn)
-			upsert.Dirty = true
-
-		}
-		if p.ColumnJsonbNnD != nil {
-			if upsert.Dirty {
-				if _, err := upsert.WriteString(", "); err != nil {
-					return "", nil, err
-				}
-			}
-			if _, err := upsert.WriteString(TableCompleteColumnColumnJsonbNnD); err != nil {
-				return "", nil, err
-			}
-			if _, err := upsert.WriteString("="); err != nil {
-				return "", nil, err
-			}
-			if err := upsert.WritePlaceholder(); err != nil {
-				return "", nil, err
-			}
-			upsert.Add(p.ColumnJsonbNnD)
-			upsert.Dirty = true
-
-		}
-		if p.ColumnNumeric.Valid {
-			if upsert.Dirty {
-				if _, err := upsert.WriteString(", "); err != nil {
-					return "", nil, err
-				}
-			}
-			if _, err := upsert.WriteString(TableCompleteColumnColumnNumeric); err != nil {
-				return "", nil, err
-			}
-			if _, err := upsert.WriteString("="); err != nil {
-				return "", nil, err
-			}
-			if err := upsert.WritePlaceholder(); err != nil {
-				return "", nil, err
-			}
-			upsert.Add(p.ColumnNumeric)
-			upsert.Dirty = true
-
-		}
-		if p.ColumnReal != nil {
-			if upsert.Dirty {
-				if _, err := upsert.WriteString(", "); err != nil {
-					return "", nil, err
-				}
-			}
-			if _, err := upsert.WriteString(TableCompleteColumnColumnReal); err != nil {
-				return "", nil, err
-			}
-			if _, err := upsert.WriteString("="); err != nil {
-				return "", nil, err
-			}
-			if err := upsert.WritePlaceholder(); err != nil {
-				return "", nil, err
-			}
-			upsert.Add(p.ColumnReal)
-			upsert.Dirty = true
-
-		}
-		if p.ColumnSerial != nil {
-			if upsert.Dirty {
-				if _, err := upsert.WriteString(", "); err != nil {
-					return "", nil, err
-				}
-			}
-			if _, err := upsert.WriteString(TableCompleteColumnColumnSerial); err != nil {
-				return "", nil, err
-			}
-			if _, err := upsert.WriteString("="); err != nil {
-				return "", nil, err
-			}
-			if err := upsert.WritePlaceholder(); err != nil {
-				return "", nil, err
-			}
-			upsert.Add(p.ColumnSerial)
-			upsert.Dirty = true
-
-		}
-		if p.ColumnSerialBig.Valid {
-			if upsert.Dirty {
-				if _, err := upsert.WriteString(", "); err != nil {
-					return "", nil, err
-				}
-			}
-			if _, err := upsert.WriteString(TableCompleteColumnColumnSerialBig); err != nil {
-				return "", nil, err
-			}
-			if _, err := upsert.WriteString("="); err != nil {
-				return "", nil, err
-			}
-			if err := upsert.WritePlaceholder(); err != nil {
-				return "", nil, err
-			}
-			upsert.Add(p.ColumnSerialBig)
-			upsert.Dirty = true
-
-		}
-		if p.ColumnSerialSmall != nil {
-			if upsert.Dirty {
-				if _, err := upsert.WriteString(", "); err != nil {
-					return "", nil, err
-				}
-			}
-			if _, err := upsert.WriteString(TableCompleteColumnColumnSerialSmall); err != nil {
-				return "", nil, err
-			}
-			if _, err := upsert.WriteString("="); err != nil {
-				return "", nil, err
-			}
-			if err := upsert.WritePlaceholder(); err != nil {
-				return "", nil, err
-			}
-			upsert.Add(p.ColumnSerialSmall)
-			upsert.Dirty = true
-
-		}
-		if p.ColumnText.Valid {
-			if upsert.Dirty {
-				if _, err := upsert.WriteString(", "); err != nil {
-					return "", nil, err
-				}
-			}
-			if _, err := upsert.WriteString(TableCompleteColumnColumnText); err != nil {
-				return "", nil, err
-			}
-			if _, err := upsert.WriteString("="); err != nil {
-				return "", nil, err
-			}
-			if err := upsert.WritePlaceholder(); err != nil {
-				return "", nil, err
-			}
-			upsert.Add(p.ColumnText)
-			upsert.Dirty = true
-
-		}
-		if p.ColumnTextArray0.Valid {
-			if upsert.Dirty {
-				if _, err := upsert.WriteString(", "); err != nil {
-					return "", nil, err
-				}
-			}
-			if _, err := upsert.WriteString(TableCompleteColumnColumnTextArray0); err != nil {
-				return "", nil, err
-			}
-			if _, err := upsert.WriteString("="); err != nil {
-				return "", nil, err
-			}
-			if err := upsert.WritePlaceholder(); err != nil {
-				return "", nil, err
-			}
-			upsert.Add(p.ColumnTextArray0)
-			upsert.Dirty = true
-
-		}
-		if p.ColumnTextArray100.Valid {
-			if upsert.Dirty {
-				if _, err := upsert.WriteString(", "); err != nil {
-					return "", nil, err
-				}
-			}
-			if _, err := upsert.WriteString(TableCompleteColumnColumnTextArray100); err != nil {
-				return "", nil, err
-			}
-			if _, err := upsert.WriteString("="); err != nil {
-				return "", nil, err
-			}
-			if err := upsert.WritePlaceholder(); err != nil {
-				return "", nil, err
-			}
-			upsert.Add(p.ColumnTextArray100)
-			upsert.Dirty = true
-
-		}
-		if p.ColumnTimestamp.Valid {
-			if upsert.Dirty {
-				if _, err := upsert.WriteString(", "); err != nil {
-					return "", nil, err
-				}
-			}
-			if _, err := upsert.WriteString(TableCompleteColumnColumnTimestamp); err != nil {
-				return "", nil, err
-			}
-			if _, err := upsert.WriteString("="); err != nil {
-				return "", nil, err
-			}
-			if err := upsert.WritePlaceholder(); err != nil {
-				return "", nil, err
-			}
-			upsert.Add(p.ColumnTimestamp)
-			upsert.Dirty = true
-
+			return fmt.Errorf("expected to get source argument in format '[1,2,...,N]', but got %s at index %d", v, i)
 		}
-		if p.ColumnTimestamptz.Valid {
-			if upsert.Dirty {
-				if _, err := upsert.WriteString(", "); err != nil {
-					return "", nil, err
-				}
-			}
-			if _, err := upsert.WriteString(TableCompleteColumnColumnTimestamptz); err != nil {
-				return "", nil, err
-			}
-			if _, err := upsert.WriteString("="); err != nil {
-				return "", nil, err
-			}
-			if err := upsert.WritePlaceholder(); err != nil {
-				return "", nil, err
-			}
-			upsert.Add(p.ColumnTimestamptz)
-			upsert.Dirty = true
 
-		}
-		if p.ColumnUUID.Valid {
-			if upsert.Dirty {
-				if _, err := upsert.WriteString(", "); err != nil {
-					return "", nil, err
-				}
-			}
-			if _, err := upsert.WriteString(TableCompleteColumnColumnUUID); err != nil {
-				return "", nil, err
-			}
-			if _, err := upsert.WriteString("="); err != nil {
-				return "", nil, err
-			}
-			if err := upsert.WritePlaceholder(); err != nil {
-				return "", nil, err
-			}
-			upsert.Add(p.ColumnUUID)
-			upsert.Dirty = true
+		*a = append(*a, j)
+	}
 
-		}
+	return nil
+}
+
+// Value satisfy driver.Valuer interface.
+func (a JSONArrayInt64) Value() (driver.Value, error) {
+	var (
+		buffer bytes.Buffer
+		err    error
+	)
+
+	if _, err = buffer.WriteString(jsonArrayBeginningChar); err != nil {
+		return nil, err
 	}
-	if len(inf) > 0 && upsert.Dirty {
-		buf.WriteString("(")
-		for j, i := range inf {
-			if j != 0 {
-				buf.WriteString(", ")
+
+	for i, v := range a {
+		if i > 0 {
+			if _, err := buffer.WriteString(jsonArraySeparator); err != nil {
+				return nil, err
 			}
-			buf.WriteString(i)
 		}
-		buf.WriteString(")")
-		buf.WriteString(" DO UPDATE SET ")
-		buf.ReadFrom(upsert)
-	} else {
-		buf.WriteString(" DO NOTHING ")
-	}
-	if upsert.Dirty {
-		buf.WriteString(" RETURNING ")
-		if len(r.Columns) > 0 {
-			buf.WriteString(strings.Join(r.Columns, ", "))
-		} else {
-			buf.WriteString("column_bool, column_bytea, column_character_0, column_character_100, column_decimal, column_double_array_0, column_double_array_100, column_integer, column_integer_array_0, column_integer_array_100, column_integer_big, column_integer_big_array_0, column_integer_big_array_100, column_integer_small, column_integer_small_array_0, column_integer_small_array_100, column_json, column_json_nn, column_json_nn_d, column_jsonb, column_jsonb_nn, column_jsonb_nn_d, column_numeric, column_real, column_serial, column_serial_big, column_serial_small, column_text, column_text_array_0, column_text_array_100, column_timestamp, column_timestamptz, column_uuid")
+		if _, err := buffer.WriteString(strconv.FormatInt(v, 10)); err != nil {
+			return nil, err
 		}
 	}
-	return buf.String(), upsert.Args(), nil
-}
 
-func (r *CompleteRepositoryBase) upsert(ctx context.Context, tx *sql.Tx, e *CompleteEntity, p *CompletePatch, inf ...string) (*CompleteEntity, error) {
-	query, args, err := r.UpsertQuery(e, p, inf...)
-	if err != nil {
+	if _, err = buffer.WriteString(jsonArrayEndChar); err != nil {
 		return nil, err
 	}
 
-	var row *sql.Row
-	if tx == nil {
-		row = r.DB.QueryRowContext(ctx, query, args...)
-	} else {
-		row = tx.QueryRowContext(ctx, query, args...)
-	}
-	err = row.Scan(
-		&e.ColumnBool,
-		&e.ColumnBytea,
-		&e.ColumnCharacter0,
-		&e.ColumnCharacter100,
-		&e.ColumnDecimal,
-		&e.ColumnDoubleArray0,
-		&e.ColumnDoubleArray100,
-		&e.ColumnInteger,
-		&e.ColumnIntegerArray0,
-		&e.ColumnIntegerArray100,
-		&e.ColumnIntegerBig,
-		&e.ColumnIntegerBigArray0,
-		&e.ColumnIntegerBigArray100,
-		&e.ColumnIntegerSmall,
-		&e.ColumnIntegerSmallArray0,
-		&e.ColumnIntegerSmallArray100,
-		&e.ColumnJson,
-		&e.ColumnJsonNn,
-		&e.ColumnJsonNnD,
-		&e.ColumnJsonb,
-		&e.ColumnJsonbNn,
-		&e.ColumnJsonbNnD,
-		&e.ColumnNumeric,
-		&e.ColumnReal,
-		&e.ColumnSerial,
-		&e.ColumnSerialBig,
-		&e.ColumnSerialSmall,
-		&e.ColumnText,
-		&e.ColumnTextArray0,
-		&e.ColumnTextArray100,
-		&e.ColumnTimestamp,
-		&e.ColumnTimestamptz,
-		&e.ColumnUUID,
-	)
-	if r.Log != nil {
-		if tx == nil {
-			r.Log(err, TableComplete, "upsert", query, args...)
-		} else {
-			r.Log(err, TableComplete, "upsert tx", query, args...)
+	return buffer.Bytes(), nil
+}
+
+// JSONArrayString is a slice of strings that implements necessary interfaces.
+type JSONArrayString []string
+
+// Scan satisfy sql.Scanner interface.
+func (a *JSONArrayString) Scan(src interface{}) error {
+	if src == nil {
+		if a == nil {
+			*a = make(JSONArrayString, 0)
 		}
+		return nil
 	}
-	if err != nil {
-		return nil, err
+
+	switch t := src.(type) {
+	case []byte:
+		return json.Unmarshal(t, a)
+	default:
+		return fmt.Errorf("expected slice of bytes or string as a source argument in Scan, not %T", src)
 	}
-	return e, nil
 }
 
-func (r *CompleteRepositoryBase) Upsert(ctx context.Context, e *CompleteEntity, p *CompletePatch, inf ...string) (*CompleteEntity, error) {
-	return r.upsert(ctx, nil, e, p, inf...)
+// Value satisfy driver.Valuer interface.
+func (a JSONArrayString) Value() (driver.Value, error) {
+	return json.Marshal(a)
 }
 
-func (r *CompleteRepositoryBase) count(ctx context.Context, tx *sql.Tx, exp *CompleteCountExpr) (int64, error) {
-	query, args, err := r.FindQuery(&CompleteFindExpr{
-		Where:   exp.Where,
-		Columns: []string{"COUNT(*)"},
-	})
-	if err != nil {
-		return 0, err
+// JSONArrayFloat64 is a slice of int64s that implements necessary interfaces.
+type JSONArrayFloat64 []float64
+
+// Scan satisfy sql.Scanner interface.
+func (a *JSONArrayFloat64) Scan(src interface{}) error {
+	if src == nil {
+		if a == nil {
+			*a = make(JSONArrayFloat64, 0)
+		}
+		return nil
 	}
-	var count int64
-	if tx == nil {
-		err = r.DB.QueryRowContext(ctx, query, args...).Scan(&count)
-	} else {
-		err = tx.QueryRowContext(ctx, query, args...).Scan(&count)
+
+	var (
+		tmp  []string
+		srcs string
+	)
+
+	switch t := src.(type) {
+	case []byte:
+		srcs = string(t)
+	case string:
+		srcs = t
+	default:
+		return fmt.Errorf("expected slice of bytes or string as a source argument in Scan, not %T", src)
 	}
-	if r.Log != nil {
-		if tx == nil {
-			r.Log(err, TableComplete, "count", query, args...)
-		} else {
-			r.Log(err, TableComplete, "count tx", query, args...)
-		}
+
+	l := len(srcs)
+
+	if l < 2 {
+		return fmt.Errorf("expected to get source argument in format '[1.3,2.4,...,N.M]', but got %s", srcs)
 	}
-	if err != nil {
-		return 0, err
+
+	if l == 2 {
+		*a = make(JSONArrayFloat64, 0)
+		return nil
 	}
-	return count, nil
-}
 
-func (r *CompleteRepositoryBase) Count(ctx context.Context, exp *CompleteCountExpr) (int64, error) {
-	return r.count(ctx, nil, exp)
-}
+	if string(srcs[0]) != jsonArrayBeginningChar || string(srcs[l-1]) != jsonArrayEndChar {
+		return fmt.Errorf("expected to get source argument in format '[1.3,2.4,...,N.M]', but got %s", srcs)
+	}
 
-type CompleteRepositoryBaseTx struct {
-	base *CompleteRepositoryBase
-	tx   *sql.Tx
-}
+	tmp = strings.Split(string(srcs[1:l-1]), jsonArraySeparator)
+	*a = make(JSONArrayFloat64, 0, len(tmp))
+	for i, v := range tmp {
+		j, err := strconv.ParseFloat(v, 64)
+		if err != nil {
+			return fmt.Errorf("expected to get source argument in format '[1.3,2.4,...,N.M]', but got %s at index %d", v, i)
+		}
 
-func (r CompleteRepositoryBaseTx) Commit() error {
-	return r.tx.Commit()
-}
+		*a = append(*a, j)
+	}
 
-func (r CompleteRepositoryBaseTx) Rollback() error {
-	return r.tx.Rollback()
+	return nil
 }
 
-func (r *CompleteRepositoryBaseTx) Insert(ctx context.Context, e *CompleteEntity) (*CompleteEntity, error) {
-	return r.base.insert(ctx, r.tx, e)
-}
+// Value satisfy driver.Valuer interface.
+func (a JSONArrayFloat64) Value() (driver.Value, error) {
+	var (
+		buffer bytes.Buffer
+		err    error
+	)
 
-func (r *CompleteRepositoryBaseTx) Find(ctx context.Context, fe *CompleteFindExpr) ([]*CompleteEntity, error) {
-	return r.base.find(ctx, r.tx, fe)
-}
+	if _, err = buffer.WriteString(jsonArrayBeginningChar); err != nil {
+		return nil, err
+	}
 
-func (r *CompleteRepositoryBaseTx) FindIter(ctx context.Context, fe *CompleteFindExpr) (*CompleteIterator, error) {
-	return r.base.findIter(ctx, r.tx, fe)
-}
+	for i, v := range a {
+		if i > 0 {
+			if _, err := buffer.WriteString(jsonArraySeparator); err != nil {
+				return nil, err
+			}
+		}
+		if _, err := buffer.WriteString(strconv.FormatFloat(v, 'f', -1, 64)); err != nil {
+			return nil, err
+		}
+	}
 
-func (r *CompleteRepositoryBaseTx) Upsert(ctx context.Context, e *CompleteEntity, p *CompletePatch, inf ...string) (*CompleteEntity, error) {
-	return r.base.upsert(ctx, r.tx, e, p, inf...)
-}
+	if _, err = buffer.WriteString(jsonArrayEndChar); err != nil {
+		return nil, err
+	}
 
-func (r *CompleteRepositoryBaseTx) Count(ctx context.Context, exp *CompleteCountExpr) (int64, error) {
-	return r.base.count(ctx, r.tx, exp)
+	return buffer.Bytes(), nil
 }
 
-const (
-	JoinInner = iota
-	JoinLeft
-	JoinRight
-	JoinCross
-	JoinDoNot
+var (
+	// Space is a shorthand composition option that holds space.
+	Space = &CompositionOpts{
+		Joint: " ",
+	}
+	// And is a shorthand composition option that holds AND operator.
+	And = &CompositionOpts{
+		Joint: " AND ",
+	}
+	// Or is a shorthand composition option that holds OR operator.
+	Or = &CompositionOpts{
+		Joint: " OR ",
+	}
+	// Comma is a shorthand composition option that holds comma.
+	Comma = &CompositionOpts{
+		Joint: ", ",
+	}
 )
 
-type JoinType int
-
-func (jt JoinType) String() string {
-	switch jt {
+// CompositionOpts is a container for modification that can be applied.
+type CompositionOpts struct {
+	Joint                           string
+	PlaceholderFuncs, SelectorFuncs []string
+	PlaceholderCast, SelectorCast   string
+	IsJSON                          bool
+	IsDynamic                       bool
+}
 
-	case JoinInner:
-		return "INNER JOIN"
-	case JoinLeft:
-		return "LEFT JOIN"
-	case JoinRight:
-		return "RIGHT JOIN"
-	case JoinCross:
-		return "CROSS JOIN"
-	default:
-		return ""
-	}
+// CompositionWriter is a simple wrapper for WriteComposition function.
+type CompositionWriter interface {
+	// WriteComposition is a function that allow custom struct type to be used as a part of criteria.
+	// It gives possibility to write custom query based on object that implements this interface.
+	WriteComposition(string, *Composer, *CompositionOpts) error
 }
 
-// Actionable returns true if JoinType is one of the known type except JoinDoNot.
-func (jt JoinType) Actionable() bool {
-	switch jt {
-	case JoinInner, JoinLeft, JoinRight, JoinCross:
-		return true
-	default:
-		return false
-	}
+// Composer holds buffer, arguments and placeholders count.
+// In combination with external buffet can be also used to also generate sub-queries.
+// To do that simply write buffer to the parent buffer, composer will hold all arguments and remember number of last placeholder.
+type Composer struct {
+	buf     bytes.Buffer
+	args    []interface{}
+	counter int
+	Dirty   bool
 }
 
-// ErrorConstraint returns the error constraint of err if it was produced by the pq library.
-// Otherwise, it returns empty string.
-func ErrorConstraint(err error) string {
-	if err == nil {
-		return ""
-	}
-	if pqerr, ok := err.(*pq.Error); ok {
-		return pqerr.Constraint
+// NewComposer allocates new Composer with inner slice of arguments of given size.
+func NewComposer(size int64) *Composer {
+	return &Composer{
+		counter: 1,
+		args:    make([]interface{}, 0, size),
 	}
+}
 
-	return ""
+// WriteString appends the contents of s to the query buffer, growing the buffer as
+// needed. The return value n is the length of s; err is always nil. If the
+// buffer becomes too large, WriteString will panic with bytes ErrTooLarge.
+func (c *Composer) WriteString(s string) (int, error) {
+	return c.buf.WriteString(s)
 }
 
-type RowOrder struct {
-	Name       string
-	Descending bool
+// Write implements io Writer interface.
+func (c *Composer) Write(b []byte) (int, error) {
+	return c.buf.Write(b)
 }
 
-type NullInt64Array struct {
-	pq.Int64Array
-	Valid bool
+// Read implements io Reader interface.
+func (c *Composer) Read(b []byte) (int, error) {
+	return c.buf.Read(b)
 }
 
-func (n *NullInt64Array) Scan(value interface{}) error {
-	if value == nil {
-		n.Int64Array, n.Valid = nil, false
-		return nil
-	}
-	n.Valid = true
-	return n.Int64Array.Scan(value)
+// ResetBuf resets internal buffer.
+func (c *Composer) ResetBuf() {
+	c.buf.Reset()
 }
 
-type NullFloat64Array struct {
-	pq.Float64Array
-	Valid bool
+// String implements fmt Stringer interface.
+func (c *Composer) String() string {
+	return c.buf.String()
 }
 
-func (n *NullFloat64Array) Scan(value interface{}) error {
-	if value == nil {
-		n.Float64Array, n.Valid = nil, false
-		return nil
+// WritePlaceholder writes appropriate placeholder to the query buffer based on current state of the composer.
+func (c *Composer) WritePlaceholder() error {
+	if _, err := c.buf.WriteString("$"); err != nil {
+		return err
+	}
+	if _, err := c.buf.WriteString(strconv.Itoa(c.counter)); err != nil {
+		return err
 	}
-	n.Valid = true
-	return n.Float64Array.Scan(value)
-}
 
-type NullBoolArray struct {
-	pq.BoolArray
-	Valid bool
+	c.counter++
+	return nil
 }
 
-func (n *NullBoolArray) Scan(value interface{}) error {
-	if value == nil {
-		n.BoolArray, n.Valid = nil, false
+func (c *Composer) WriteAlias(i int) error {
+	if i < 0 {
 		return nil
 	}
-	n.Valid = true
-	return n.BoolArray.Scan(value)
+	if _, err := c.buf.WriteString("t"); err != nil {
+		return err
+	}
+	if _, err := c.buf.WriteString(strconv.Itoa(i)); err != nil {
+		return err
+	}
+	if _, err := c.buf.WriteString("."); err != nil {
+		return err
+	}
+	return nil
 }
 
-type NullStringArray struct {
-	pq.StringArray
-	Valid bool
+// Len returns number of arguments.
+func (c *Composer) Len() int {
+	return c.counter
 }
 
-func (n *NullStringArray) Scan(value interface{}) error {
-	if value == nil {
-		n.StringArray, n.Valid = nil, false
-		return nil
-	}
-	n.Valid = true
-	return n.StringArray.Scan(value)
+// Add appends list with new element.
+func (c *Composer) Add(arg interface{}) {
+	c.args = append(c.args, arg)
 }
 
-type NullByteaArray struct {
-	pq.ByteaArray
-	Valid bool
+// Args returns all arguments stored as a slice.
+func (c *Composer) Args() []interface{} {
+	return c.args
 }
 
-func (n *NullByteaArray) Scan(value interface{}) error {
-	if value == nil {
-		n.ByteaArray, n.Valid = nil, false
+// Interval represents Postgres interval type.
+// Only the default (postgres) interval output style is supported.
+// Month is assumed to have 30 days and year 365 days.
+type Interval struct {
+	Duration time.Duration
+	Valid    bool
+}
+
+// Scan implements the sql.Scanner interface.
+func (i *Interval) Scan(src interface{}) error {
+	var s string
+	switch t := src.(type) {
+	case nil:
+		*i = Interval{}
 		return nil
+	case []byte:
+		s = string(t)
+	case string:
+		s = t
+	default:
+		return fmt.Errorf("expected slice of bytes or string as a source argument in Scan, not %T", src)
 	}
-	n.Valid = true
-	return n.ByteaArray.Scan(value)
-}
 
-const (
-	jsonArraySeparator     = ","
-	jsonArrayBeginningChar = "["
-	jsonArrayEndChar       = "]"
-)
+	var d time.Duration
+	fields := strings.Fields(s)
+	for n := 0; n < len(fields); n++ {
+		if strings.Contains(fields[n], ":") {
+			hms := strings.Split(strings.TrimLeft(fields[n], "+-"), ":")
+			if len(hms) != 3 {
+				return fmt.Errorf("invalid interval: %s", s)
+			}
+			t, err := time.ParseDuration(hms[0] + "h" + hms[1] + "m" + hms[2] + "s")
+			if err != nil {
+				return fmt.Errorf("invalid interval: %s", s)
+			}
+			if strings.HasPrefix(fields[n], "-") {
+				t = -t
+			}
+			d += t
+			continue
+		}
+		if n+1 == len(fields) {
+			return fmt.Errorf("invalid interval: %s", s)
+		}
+		v, err := strconv.ParseInt(fields[n], 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid interval: %s", s)
+		}
+		n++
+		switch strings.TrimSuffix(fields[n], "s") {
+		case "year":
+			d += time.Duration(v) * 365 * 24 * time.Hour
+		case "mon":
+			d += time.Duration(v) * 30 * 24 * time.Hour
+		case "day":
+			d += time.Duration(v) * 24 * time.Hour
+		default:
+			return fmt.Errorf("invalid interval: %s", s)
+		}
+	}
 
-// JSONArrayInt64 is a slice of int64s that implements necessary interfaces.
-type JSONArrayInt64 []int64
+	*i = Interval{Duration: d, Valid: true}
+	return nil
+}
 
-// Scan satisfy sql.Scanner interface.
-func (a *JSONArrayInt64) Scan(src interface{}) error {
-	if src == nil {
-		if a == nil {
-			*a = make(JSONArrayInt64, 0)
-		}
-		return nil
+// Value implements the driver.Valuer interface.
+func (i Interval) Value() (driver.Value, error) {
+	if !i.Valid {
+		return nil, nil
 	}
+	return fmt.Sprintf("%d microseconds", int64(i.Duration/time.Microsecond)), nil
+}
 
-	var tmp []string
-	var srcs string
+type rangeBounds struct {
+	lower, upper                   string
+	lowerInclusive, upperInclusive bool
+	empty                          bool
+}
 
+func scanRange(src interface{}) (*rangeBounds, error) {
+	var s string
 	switch t := src.(type) {
+	case nil:
+		return nil, nil
 	case []byte:
-		srcs = string(t)
+		s = string(t)
 	case string:
-		srcs = t
+		s = t
 	default:
-		return fmt.Errorf("expected slice of bytes or string as a source argument in Scan, not %T", src)
+		return nil, fmt.Errorf("expected slice of bytes or string as a source argument in Scan, not %T", src)
 	}
-
-	l := len(srcs)
-
-	if l < 2 {
-		return fmt.Errorf("expected to get source argument in format '[1,2,...,N]', but got %s", srcs)
+	if s == "empty" {
+		return &rangeBounds{empty: true}, nil
 	}
-
-	if l == 2 {
-		*a = make(JSONArrayInt64, 0)
-		return nil
+	if len(s) < 3 {
+		return nil, fmt.Errorf("invalid range: %s", s)
 	}
-
-	if string(srcs[0]) != jsonArrayBeginningChar || string(srcs[l-1]) != jsonArrayEndChar {
-		return fmt.Errorf("expected to get source argument in format '[1,2,...,N]', but got %s", srcs)
+	parts := strings.SplitN(s[1:len(s)-1], ",", 2)
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("invalid range: %s", s)
 	}
+	return &rangeBounds{
+		lower:          strings.Trim(parts[0], `"`),
+		upper:          strings.Trim(parts[1], `"`),
+		lowerInclusive: s[0] == '[',
+		upperInclusive: s[len(s)-1] == ']',
+	}, nil
+}
 
-	tmp = strings.Split(string(srcs[1:l-1]), jsonArraySeparator)
-	*a = make(JSONArrayInt64, 0, len(tmp))
-	for i, v := range tmp {
-		j, err := strconv.ParseInt(v, 10, 64)
-		if err != nil {
-			return fmt.Errorf("expected to get source argument in format '[1,2,...,N]', but got %s at index %d", v, i)
-		}
-
-		*a = append(*a, j)
+func (b rangeBounds) String() string {
+	if b.empty {
+		return "empty"
 	}
-
-	return nil
+	buf := bytes.NewBuffer(nil)
+	if b.lowerInclusive {
+		buf.WriteString("[")
+	} else {
+		buf.WriteString("(")
+	}
+	if b.lower != "" {
+		buf.WriteString(strconv.Quote(b.lower))
+	}
+	buf.WriteString(",")
+	if b.upper != "" {
+		buf.WriteString(strconv.Quote(b.upper))
+	}
+	if b.upperInclusive {
+		buf.WriteString("]")
+	} else {
+		buf.WriteString(")")
+	}
+	return buf.String()
 }
 
-// Value satisfy driver.Valuer interface.
-func (a JSONArrayInt64) Value() (driver.Value, error) {
-	var (
-		buffer bytes.Buffer
-		err    error
-	)
+// Int64Range represents Postgres int4range and int8range types.
+type Int64Range struct {
+	Lower, Upper                   int64
+	LowerInclusive, UpperInclusive bool
+	// LowerInfinite and UpperInfinite are true if the range is unbounded on given side.
+	LowerInfinite, UpperInfinite bool
+	Empty                        bool
+	Valid                        bool
+}
 
-	if _, err = buffer.WriteString(jsonArrayBeginningChar); err != nil {
-		return nil, err
+// Scan implements the sql.Scanner interface.
+func (r *Int64Range) Scan(src interface{}) error {
+	b, err := scanRange(src)
+	if err != nil || b == nil {
+		*r = Int64Range{}
+		return err
 	}
-
-	for i, v := range a {
-		if i > 0 {
-			if _, err := buffer.WriteString(jsonArraySeparator); err != nil {
-				return nil, err
-			}
+	*r = Int64Range{
+		LowerInclusive: b.lowerInclusive,
+		UpperInclusive: b.upperInclusive,
+		LowerInfinite:  b.lower == "" && !b.empty,
+		UpperInfinite:  b.upper == "" && !b.empty,
+		Empty:          b.empty,
+		Valid:          true,
+	}
+	if b.lower != "" {
+		if r.Lower, err = strconv.ParseInt(b.lower, 10, 64); err != nil {
+			return err
 		}
-		if _, err := buffer.WriteString(strconv.FormatInt(v, 10)); err != nil {
-			return nil, err
+	}
+	if b.upper != "" {
+		if r.Upper, err = strconv.ParseInt(b.upper, 10, 64); err != nil {
+			return err
 		}
 	}
+	return nil
+}
 
-	if _, err = buffer.WriteString(jsonArrayEndChar); err != nil {
-		return nil, err
+// Value implements the driver.Valuer interface.
+func (r Int64Range) Value() (driver.Value, error) {
+	if !r.Valid {
+		return nil, nil
 	}
-
-	return buffer.Bytes(), nil
+	b := rangeBounds{lowerInclusive: r.LowerInclusive, upperInclusive: r.UpperInclusive, empty: r.Empty}
+	if !r.LowerInfinite {
+		b.lower = strconv.FormatInt(r.Lower, 10)
+	}
+	if !r.UpperInfinite {
+		b.upper = strconv.FormatInt(r.Upper, 10)
+	}
+	return b.String(), nil
 }
 
-// JSONArrayString is a slice of strings that implements necessary interfaces.
-type JSONArrayString []string
+// Float64Range represents Postgres numrange type.
+type Float64Range struct {
+	Lower, Upper                   float64
+	LowerInclusive, UpperInclusive bool
+	// LowerInfinite and UpperInfinite are true if the range is unbounded on given side.
+	LowerInfinite, UpperInfinite bool
+	Empty                        bool
+	Valid                        bool
+}
 
-// Scan satisfy sql.Scanner interface.
-func (a *JSONArrayString) Scan(src interface{}) error {
-	if src == nil {
-		if a == nil {
-			*a = make(JSONArrayString, 0)
+// Scan implements the sql.Scanner interface.
+func (r *Float64Range) Scan(src interface{}) error {
+	b, err := scanRange(src)
+	if err != nil || b == nil {
+		*r = Float64Range{}
+		return err
+	}
+	*r = Float64Range{
+		LowerInclusive: b.lowerInclusive,
+		UpperInclusive: b.upperInclusive,
+		LowerInfinite:  b.lower == "" && !b.empty,
+		UpperInfinite:  b.upper == "" && !b.empty,
+		Empty:          b.empty,
+		Valid:          true,
+	}
+	if b.lower != "" {
+		if r.Lower, err = strconv.ParseFloat(b.lower, 64); err != nil {
+			return err
 		}
-		return nil
 	}
-
-	switch t := src.(type) {
-	case []byte:
-		return json.Unmarshal(t, a)
-	default:
-		return fmt.Errorf("expected slice of bytes or string as a source argument in Scan, not %T", src)
+	if b.upper != "" {
+		if r.Upper, err = strconv.ParseFloat(b.upper, 64); err != nil {
+			return err
+		}
 	}
+	return nil
 }
 
-// Value satisfy driver.Valuer interface.
-func (a JSONArrayString) Value() (driver.Value, error) {
-	return json.Marshal(a)
+// Value implements the driver.Valuer interface.
+func (r Float64Range) Value() (driver.Value, error) {
+	if !r.Valid {
+		return nil, nil
+	}
+	b := rangeBounds{lowerInclusive: r.LowerInclusive, upperInclusive: r.UpperInclusive, empty: r.Empty}
+	if !r.LowerInfinite {
+		b.lower = strconv.FormatFloat(r.Lower, 'f', -1, 64)
+	}
+	if !r.UpperInfinite {
+		b.upper = strconv.FormatFloat(r.Upper, 'f', -1, 64)
+	}
+	return b.String(), nil
 }
 
-// JSONArrayFloat64 is a slice of int64s that implements necessary interfaces.
-type JSONArrayFloat64 []float64
+var timeLayouts = []string{
+	"2006-01-02 15:04:05.999999999Z07:00",
+	"2006-01-02 15:04:05.999999999Z07",
+	"2006-01-02 15:04:05.999999999",
+	"2006-01-02",
+	"15:04:05.999999999Z07",
+	"15:04:05.999999999",
+}
 
-// Scan satisfy sql.Scanner interface.
-func (a *JSONArrayFloat64) Scan(src interface{}) error {
-	if src == nil {
-		if a == nil {
-			*a = make(JSONArrayFloat64, 0)
+func parseTime(s string) (time.Time, error) {
+	for _, layout := range timeLayouts {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t, nil
 		}
-		return nil
 	}
+	return time.Time{}, errors.New("invalid time: " + s)
+}
 
-	var (
-		tmp  []string
-		srcs string
-	)
+// TimeRange represents Postgres tsrange, tstzrange and daterange types.
+type TimeRange struct {
+	Lower, Upper                   time.Time
+	LowerInclusive, UpperInclusive bool
+	// LowerInfinite and UpperInfinite are true if the range is unbounded on given side.
+	LowerInfinite, UpperInfinite bool
+	Empty                        bool
+	Valid                        bool
+}
 
-	switch t := src.(type) {
-	case []byte:
-		srcs = string(t)
-	case string:
-		srcs = t
-	default:
-		return fmt.Errorf("expected slice of bytes or string as a source argument in Scan, not %T", src)
+// Scan implements the sql.Scanner interface.
+func (r *TimeRange) Scan(src interface{}) error {
+	b, err := scanRange(src)
+	if err != nil || b == nil {
+		*r = TimeRange{}
+		return err
 	}
-
-	l := len(srcs)
-
-	if l < 2 {
-		return fmt.Errorf("expected to get source argument in format '[1.3,2.4,...,N.M]', but got %s", srcs)
+	*r = TimeRange{
+		LowerInclusive: b.lowerInclusive,
+		UpperInclusive: b.upperInclusive,
+		LowerInfinite:  b.lower == "" && !b.empty,
+		UpperInfinite:  b.upper == "" && !b.empty,
+		Empty:          b.empty,
+		Valid:          true,
 	}
-
-	if l == 2 {
-		*a = make(JSONArrayFloat64, 0)
-		return nil
+	if b.lower != "" {
+		if r.Lower, err = parseTime(b.lower); err != nil {
+			return err
+		}
+	}
+	if b.upper != "" {
+		if r.Upper, err = parseTime(b.upper); err != nil {
+			return err
+		}
 	}
+	return nil
+}
 
-	if string(srcs[0]) != jsonArrayBeginningChar || string(srcs[l-1]) != jsonArrayEndChar {
-		return fmt.Errorf("expected to get source argument in format '[1.3,2.4,...,N.M]', but got %s", srcs)
+// Value implements the driver.Valuer interface.
+func (r TimeRange) Value() (driver.Value, error) {
+	if !r.Valid {
+		return nil, nil
 	}
+	b := rangeBounds{lowerInclusive: r.LowerInclusive, upperInclusive: r.UpperInclusive, empty: r.Empty}
+	if !r.LowerInfinite {
+		b.lower = r.Lower.Format(timeLayouts[0])
+	}
+	if !r.UpperInfinite {
+		b.upper = r.Upper.Format(timeLayouts[0])
+	}
+	return b.String(), nil
+}
 
-	tmp = strings.Split(string(srcs[1:l-1]), jsonArraySeparator)
-	*a = make(JSONArrayFloat64, 0, len(tmp))
-	for i, v := range tmp {
-		j, err := strconv.ParseFloat(v, 64)
+// TimeArray represents one-dimensional array of Postgres timestamp, timestamptz, date, time or timetz types.
+type TimeArray []time.Time
+
+// Scan implements the sql.Scanner interface.
+func (a *TimeArray) Scan(src interface{}) error {
+	var sa pq.StringArray
+	if err := sa.Scan(src); err != nil {
+		return err
+	}
+	if sa == nil {
+		*a = nil
+		return nil
+	}
+	tmp := make(TimeArray, 0, len(sa))
+	for _, s := range sa {
+		t, err := parseTime(s)
 		if err != nil {
-			return fmt.Errorf("expected to get source argument in format '[1.3,2.4,...,N.M]', but got %s at index %d", v, i)
+			return err
 		}
-
-		*a = append(*a, j)
+		tmp = append(tmp, t)
 	}
-
+	*a = tmp
 	return nil
 }
 
-// Value satisfy driver.Valuer interface.
-func (a JSONArrayFloat64) Value() (driver.Value, error) {
-	var (
-		buffer bytes.Buffer
-		err    error
-	)
-
-	if _, err = buffer.WriteString(jsonArrayBeginningChar); err != nil {
-		return nil, err
+// Value implements the driver.Valuer interface.
+func (a TimeArray) Value() (driver.Value, error) {
+	if a == nil {
+		return nil, nil
 	}
-
-	for i, v := range a {
-		if i > 0 {
-			if _, err := buffer.WriteString(jsonArraySeparator); err != nil {
-				return nil, err
-			}
-		}
-		if _, err := buffer.WriteString(strconv.FormatFloat(v, 'f', -1, 64)); err != nil {
-			return nil, err
-		}
+	sa := make(pq.StringArray, 0, len(a))
+	for _, t := range a {
+		sa = append(sa, t.Format(timeLayouts[0]))
 	}
+	return sa.Value()
+}
 
-	if _, err = buffer.WriteString(jsonArrayEndChar); err != nil {
-		return nil, err
+type NullTimeArray struct {
+	TimeArray
+	Valid bool
+}
+
+func (n *NullTimeArray) Scan(value interface{}) error {
+	if value == nil {
+		n.TimeArray, n.Valid = nil, false
+		return nil
 	}
+	n.Valid = true
+	return n.TimeArray.Scan(value)
+}
 
-	return buffer.Bytes(), nil
+// Point represents Postgres point type.
+type Point struct {
+	X, Y  float64
+	Valid bool
 }
 
-var (
-	// Space is a shorthand composition option that holds space.
-	Space = &CompositionOpts{
-		Joint: " ",
+// Scan implements the sql.Scanner interface.
+func (p *Point) Scan(src interface{}) error {
+	var s string
+	switch t := src.(type) {
+	case nil:
+		*p = Point{}
+		return nil
+	case []byte:
+		s = string(t)
+	case string:
+		s = t
+	default:
+		return fmt.Errorf("expected slice of bytes or string as a source argument in Scan, not %T", src)
 	}
-	// And is a shorthand composition option that holds AND operator.
-	And = &CompositionOpts{
-		Joint: " AND ",
+	xy := strings.Split(strings.Trim(s, "()"), ",")
+	if len(xy) != 2 {
+		return fmt.Errorf("invalid point: %s", s)
 	}
-	// Or is a shorthand composition option that holds OR operator.
-	Or = &CompositionOpts{
-		Joint: " OR ",
+	x, err := strconv.ParseFloat(xy[0], 64)
+	if err != nil {
+		return err
 	}
-	// Comma is a shorthand composition option that holds comma.
-	Comma = &CompositionOpts{
-		Joint: ", ",
+	y, err := strconv.ParseFloat(xy[1], 64)
+	if err != nil {
+		return err
+	}
+	*p = Point{X: x, Y: y, Valid: true}
+	return nil
+}
+
+// Value implements the driver.Valuer interface.
+func (p Point) Value() (driver.Value, error) {
+	if !p.Valid {
+		return nil, nil
 	}
+	return fmt.Sprintf("(%s,%s)", strconv.FormatFloat(p.X, 'f', -1, 64), strconv.FormatFloat(p.Y, 'f', -1, 64)), nil
+}
+
+// TextSearchMode defines how the text search query is parsed.
+type TextSearchMode int
+
+const (
+	// TextSearchWeb uses websearch_to_tsquery, it supports quoted phrases, OR and - operators.
+	TextSearchWeb TextSearchMode = iota
+	// TextSearchPlain uses plainto_tsquery, all words are required.
+	TextSearchPlain
 )
 
-// CompositionOpts is a container for modification that can be applied.
-type CompositionOpts struct {
-	Joint                           string
-	PlaceholderFuncs, SelectorFuncs []string
-	PlaceholderCast, SelectorCast   string
-	IsJSON                          bool
-	IsDynamic                       bool
+// TextSearch is a full-text search query.
+type TextSearch struct {
+	Query string
+	Mode  TextSearchMode
 }
 
-// CompositionWriter is a simple wrapper for WriteComposition function.
-type CompositionWriter interface {
-	// WriteComposition is a function that allow custom struct type to be used as a part of criteria.
-	// It gives possibility to write custom query based on object that implements this interface.
-	WriteComposition(string, *Composer, *CompositionOpts) error
+// TextSearchHeadline requests ts_headline snippet of the matching document.
+type TextSearchHeadline struct {
+	TextSearch
+	// Options are passed to ts_headline as they are, e.g. "MaxWords=35, MinWords=15".
+	Options string
 }
 
-// Composer holds buffer, arguments and placeholders count.
-// In combination with external buffet can be also used to also generate sub-queries.
-// To do that simply write buffer to the parent buffer, composer will hold all arguments and remember number of last placeholder.
-type Composer struct {
-	buf     bytes.Buffer
-	args    []interface{}
-	counter int
-	Dirty   bool
+// JSONPredicate compares text extracted from JSONB document at given path with a value.
+type JSONPredicate struct {
+	// Path is a list of object keys or array indexes.
+	// Single element path is treated as an object key (->>), longer paths (#>>) can address array elements as well.
+	Path []string
+	// Operator is one of =, <>, <, <=, >, >=, LIKE or ILIKE. If empty, = is used.
+	Operator string
+	// Value extracted text is compared with.
+	// Numbers, booleans and time.Time cause extracted text to be casted to NUMERIC, BOOL and TIMESTAMPTZ respectively.
+	Value interface{}
 }
 
-// NewComposer allocates new Composer with inner slice of arguments of given size.
-func NewComposer(size int64) *Composer {
-	return &Composer{
-		counter: 1,
-		args:    make([]interface{}, 0, size),
-	}
+// JSONSet replaces value at given path of JSONB document, missing key is created.
+type JSONSet struct {
+	Path  []string
+	Value []byte
 }
 
-// WriteString appends the contents of s to the query buffer, growing the buffer as
-// needed. The return value n is the length of s; err is always nil. If the
-// buffer becomes too large, WriteString will panic with bytes ErrTooLarge.
-func (c *Composer) WriteString(s string) (int, error) {
-	return c.buf.WriteString(s)
+// FieldError describes entity or patch field which value violates a constraint of the corresponding column.
+type FieldError struct {
+	// Field is the name of the struct field.
+	Field string
+	// Column is the name of the column the field maps to.
+	Column string
+	Reason string
 }
 
-// Write implements io Writer interface.
-func (c *Composer) Write(b []byte) (int, error) {
-	return c.buf.Write(b)
+// Error implements error interface.
+func (e *FieldError) Error() string {
+	return e.Field + " " + e.Reason
 }
 
-// Read implements io Reader interface.
-func (c *Composer) Read(b []byte) (int, error) {
-	return c.buf.Read(b)
+// ValidationError is returned by Validate methods, it lists all fields that did not pass the validation.
+type ValidationError []*FieldError
+
+// Error implements error interface.
+func (e ValidationError) Error() string {
+	msgs := make([]string, 0, len(e))
+	for _, fe := range e {
+		msgs = append(msgs, fe.Error())
+	}
+	return "validation failure: " + strings.Join(msgs, ", ")
 }
 
-// ResetBuf resets internal buffer.
-func (c *Composer) ResetBuf() {
-	c.buf.Reset()
+// numericFits returns true if value can be stored in numeric column of given precision and scale.
+func numericFits(v float64, precision, scale int) bool {
+	return math.Abs(math.Round(v*math.Pow10(scale))) < math.Pow10(precision)
 }
 
-// String implements fmt Stringer interface.
-func (c *Composer) String() string {
-	return c.buf.String()
+func textSearchQuery(comp *Composer, config string, ts *TextSearch) error {
+	switch ts.Mode {
+	case TextSearchWeb:
+		if _, err := comp.WriteString("websearch_to_tsquery('"); err != nil {
+			return err
+		}
+	case TextSearchPlain:
+		if _, err := comp.WriteString("plainto_tsquery('"); err != nil {
+			return err
+		}
+	default:
+		return errors.New("unknown text search mode: " + strconv.Itoa(int(ts.Mode)))
+	}
+	if _, err := comp.WriteString(config); err != nil {
+		return err
+	}
+	if _, err := comp.WriteString("', "); err != nil {
+		return err
+	}
+	if err := comp.WritePlaceholder(); err != nil {
+		return err
+	}
+	if _, err := comp.WriteString(")"); err != nil {
+		return err
+	}
+	comp.Add(ts.Query)
+	return nil
 }
 
-// WritePlaceholder writes appropriate placeholder to the query buffer based on current state of the composer.
-func (c *Composer) WritePlaceholder() error {
-	if _, err := c.buf.WriteString("$"); err != nil {
+func jsonPredicateClause(comp *Composer, column string, id int, p JSONPredicate) error {
+	if len(p.Path) == 0 {
+		return errors.New("json predicate requires path")
+	}
+	op := p.Operator
+	switch op {
+	case "":
+		op = "="
+	case "=", "<>", "<", "<=", ">", ">=", "LIKE", "ILIKE":
+	default:
+		return errors.New("unsupported json predicate operator: " + op)
+	}
+	var cast string
+	switch p.Value.(type) {
+	case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64, float32, float64:
+		cast = "::NUMERIC"
+	case bool:
+		cast = "::BOOL"
+	case time.Time:
+		cast = "::TIMESTAMPTZ"
+	}
+
+	if _, err := comp.WriteString("("); err != nil {
 		return err
 	}
-	if _, err := c.buf.WriteString(strconv.Itoa(c.counter)); err != nil {
+	if err := comp.WriteAlias(id); err != nil {
+		return err
+	}
+	if _, err := comp.WriteString(column); err != nil {
+		return err
+	}
+	if len(p.Path) == 1 {
+		if _, err := comp.WriteString("->>"); err != nil {
+			return err
+		}
+		if err := comp.WritePlaceholder(); err != nil {
+			return err
+		}
+		comp.Add(p.Path[0])
+	} else {
+		if _, err := comp.WriteString("#>>"); err != nil {
+			return err
+		}
+		if err := comp.WritePlaceholder(); err != nil {
+			return err
+		}
+		comp.Add(pq.StringArray(p.Path))
+	}
+	if _, err := comp.WriteString(")" + cast + " " + op + " "); err != nil {
 		return err
 	}
+	if err := comp.WritePlaceholder(); err != nil {
+		return err
+	}
+	comp.Add(p.Value)
+	return nil
+}
 
-	c.counter++
+// jsonPatchClause writes expression that applies jsonb_set, || and - modifications to the column, in that order.
+func jsonPatchClause(comp *Composer, column string, set []JSONSet, merge []byte, remove pq.StringArray) error {
+	if len(remove) > 0 {
+		if _, err := comp.WriteString("("); err != nil {
+			return err
+		}
+	}
+	if merge != nil {
+		if _, err := comp.WriteString("("); err != nil {
+			return err
+		}
+	}
+	for range set {
+		if _, err := comp.WriteString("jsonb_set("); err != nil {
+			return err
+		}
+	}
+	if _, err := comp.WriteString("coalesce(" + column + ", '{}')"); err != nil {
+		return err
+	}
+	for _, s := range set {
+		if _, err := comp.WriteString(", "); err != nil {
+			return err
+		}
+		if err := comp.WritePlaceholder(); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString("::TEXT[], "); err != nil {
+			return err
+		}
+		if err := comp.WritePlaceholder(); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString("::JSONB)"); err != nil {
+			return err
+		}
+		comp.Add(pq.StringArray(s.Path))
+		comp.Add(s.Value)
+	}
+	if merge != nil {
+		if _, err := comp.WriteString(" || "); err != nil {
+			return err
+		}
+		if err := comp.WritePlaceholder(); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString("::JSONB)"); err != nil {
+			return err
+		}
+		comp.Add(merge)
+	}
+	if len(remove) > 0 {
+		if _, err := comp.WriteString(" - "); err != nil {
+			return err
+		}
+		if err := comp.WritePlaceholder(); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString("::TEXT[])"); err != nil {
+			return err
+		}
+		comp.Add(remove)
+	}
 	return nil
 }
 
-func (c *Composer) WriteAlias(i int) error {
-	if i < 0 {
-		return nil
+// subqueryAliasOffset separates aliases of relationship subqueries from aliases of the outer query and its joins.
+const subqueryAliasOffset = 100
+
+// existsClause starts correlated EXISTS (or NOT EXISTS) subquery that selects from the table aliased as sub.
+// The caller is responsible for closing parenthesis.
+func existsClause(comp *Composer, not bool, table string, sub int) error {
+	if comp.Dirty {
+		if _, err := comp.WriteString(" AND "); err != nil {
+			return err
+		}
 	}
-	if _, err := c.buf.WriteString("t"); err != nil {
+	if not {
+		if _, err := comp.WriteString("NOT "); err != nil {
+			return err
+		}
+	}
+	if _, err := comp.WriteString("EXISTS (SELECT 1 FROM "); err != nil {
 		return err
 	}
-	if _, err := c.buf.WriteString(strconv.Itoa(i)); err != nil {
+	if _, err := comp.WriteString(table); err != nil {
 		return err
 	}
-	if _, err := c.buf.WriteString("."); err != nil {
+	if _, err := comp.WriteString(" AS t"); err != nil {
 		return err
 	}
-	return nil
+	_, err := comp.WriteString(strconv.Itoa(sub))
+	return err
 }
 
-// Len returns number of arguments.
-func (c *Composer) Len() int {
-	return c.counter
+// correlate writes condition that compares column of the left alias with column of the right alias.
+func correlate(comp *Composer, left int, leftColumn string, right int, rightColumn string) error {
+	if err := comp.WriteAlias(left); err != nil {
+		return err
+	}
+	if _, err := comp.WriteString(leftColumn); err != nil {
+		return err
+	}
+	if _, err := comp.WriteString("="); err != nil {
+		return err
+	}
+	if err := comp.WriteAlias(right); err != nil {
+		return err
+	}
+	_, err := comp.WriteString(rightColumn)
+	return err
 }
 
-// Add appends list with new element.
-func (c *Composer) Add(arg interface{}) {
-	c.args = append(c.args, arg)
+// Fixtures builds entities which mandatory columns are filled with random, but valid values.
+// Values depend only on the seed and on the order in which fixtures are built,
+// so tests that use the same seed get the same fixtures.
+type Fixtures struct {
+	// DB is used to insert fixtures and parents of required foreign keys.
+	DB   *sql.DB
+	rand *rand.Rand
+	seq  int64
 }
 
-// Args returns all arguments stored as a slice.
-func (c *Composer) Args() []interface{} {
-	return c.args
+// NewFixtures allocates Fixtures which values are generated using given seed.
+func NewFixtures(db *sql.DB, seed int64) *Fixtures {
+	return &Fixtures{
+		DB:   db,
+		rand: rand.New(rand.NewSource(seed)),
+	}
+}
+
+const fixtureAlphabet = "abcdefghijklmnopqrstuvwxyz0123456789"
+
+// int64 returns random positive number, unique numbers are taken from a sequence instead.
+func (f *Fixtures) int64(unique bool) int64 {
+	f.seq++
+	if unique {
+		return f.seq
+	}
+	return 1 + f.rand.Int63n(1000)
+}
+
+// float64 returns random whole number lower than max, so it fits numeric columns of limited precision.
+func (f *Fixtures) float64(max int64) float64 {
+	return float64(f.rand.Int63n(max))
 }
 
-/// SQL ...
-const SQL = `
--- sql schema beginning
--- do not modify, generated by pqt
-
-CREATE SCHEMA IF NOT EXISTS example; 
-
-CREATE OR REPLACE FUNCTION multiply(x BIGINT, y BIGINT) RETURNS BIGINT
-	AS 'SELECT x * y'
-	LANGUAGE SQL
-	VOLATILE;
-
-CREATE TABLE IF NOT EXISTS example.category (
-	content TEXT NOT NULL,
-	created_at TIMESTAMPTZ DEFAULT NOW() NOT NULL,
-	id BIGSERIAL,
-	name TEXT NOT NULL,
-	parent_id BIGINT,
-	updated_at TIMESTAMPTZ,
-
-	CONSTRAINT "example.category_id_pkey" PRIMARY KEY (id),
-	CONSTRAINT "example.category_parent_id_fkey" FOREIGN KEY (parent_id) REFERENCES example.category (id)
-);
-CREATE INDEX IF NOT EXISTS "example.category_name_idx" ON example.category (name);
-
-CREATE TABLE IF NOT EXISTS example.package (
-	break TEXT,
-	category_id BIGINT,
-	created_at TIMESTAMPTZ DEFAULT NOW() NOT NULL,
-	id BIGSERIAL,
-	updated_at TIMESTAMPTZ,
-
-	CONSTRAINT "example.package_id_pkey" PRIMARY KEY (id),
-	CONSTRAINT "example.package_category_id_fkey" FOREIGN KEY (category_id) REFERENCES example.category (id)
-);
-
-CREATE TABLE IF NOT EXISTS example.news (
-	content TEXT NOT NULL,
-	continue BOOL DEFAULT false NOT NULL,
-	created_at TIMESTAMPTZ DEFAULT NOW() NOT NULL,
-	day DATE,
-	id BIGSERIAL,
-	lead TEXT,
-	meta_data JSONB,
-	score NUMERIC(20,8) DEFAULT 0 NOT NULL,
-	title TEXT NOT NULL,
-	updated_at TIMESTAMPTZ,
-	version BIGINT NOT NULL,
-	views_distribution DOUBLE PRECISION[168],
-
-	CONSTRAINT "example.news_id_pkey" PRIMARY KEY (id),
-	CONSTRAINT "example.news_title_key" UNIQUE (title),
-	CONSTRAINT "example.news_title_lead_key" UNIQUE (title, lead)
-);
-
-CREATE TABLE IF NOT EXISTS example.comment (
-	content TEXT NOT NULL,
-	created_at TIMESTAMPTZ DEFAULT NOW() NOT NULL,
-	id BIGSERIAL,
-	news_id BIGINT NOT NULL,
-	news_title TEXT NOT NULL,
-	updated_at TIMESTAMPTZ,
-
-	CONSTRAINT "example.comment_news_title_fkey" FOREIGN KEY (news_title) REFERENCES example.news (title),
-	CONSTRAINT "example.comment_news_id_fkey" FOREIGN KEY (news_id) REFERENCES example.news (id)
-);
-CREATE INDEX IF NOT EXISTS "example.comment_news_title_idx" ON example.comment (news_title);
-
-CREATE TABLE IF NOT EXISTS example.complete (
-	column_bool BOOL,
-	column_bytea BYTEA,
-	column_character_0 CHARACTER[0],
-	column_character_100 CHARACTER[100],
-	column_decimal DECIMAL(20,8),
-	column_double_array_0 DOUBLE PRECISION[],
-	column_double_array_100 DOUBLE PRECISION[100],
-	column_integer INTEGER,
-	column_integer_array_0 INTEGER[],
-	column_integer_array_100 INTEGER[100],
-	column_integer_big BIGINT,
-	column_integer_big_array_0 BIGINT[],
-	column_integer_big_array_100 BIGINT[100],
-	column_integer_small SMALLINT,
-	column_integer_small_array_0 SMALLINT[],
-	column_integer_small_array_100 SMALLINT[100],
-	column_json JSON,
-	column_json_nn JSON NOT NULL,
-	column_json_nn_d JSON DEFAULT '{"field": 1}' NOT NULL,
-	column_jsonb JSONB,
-	column_jsonb_nn JSONB NOT NULL,
-	column_jsonb_nn_d JSONB DEFAULT '{"field": 1}' NOT NULL,
-	column_numeric NUMERIC(20,8),
-	column_real REAL,
-	column_serial SERIAL,
-	column_serial_big BIGSERIAL,
-	column_serial_small SMALLSERIAL,
-	column_text TEXT,
-	column_text_array_0 TEXT[],
-	column_text_array_100 TEXT[100],
-	column_timestamp TIMESTAMP,
-	column_timestamptz TIMESTAMPTZ,
-	column_uuid UUID
-);
-
--- sql schema end
-`
+// string returns random string of given length, or 16 characters long if length is 0.
+// Unique strings end with a sequence number.
+func (f *Fixtures) string(length int, unique bool) string {
+	if length <= 0 || length > 16 {
+		length = 16
+	}
+	b := make([]byte, length)
+	for i := range b {
+		b[i] = fixtureAlphabet[f.rand.Intn(len(fixtureAlphabet))]
+	}
+	if unique {
+		f.seq++
+		seq := strconv.FormatInt(f.seq, 36)
+		if len(seq) > length {
+			seq = seq[len(seq)-length:]
+		}
+		copy(b[length-len(seq):], seq)
+	}
+	return string(b)
+}
+
+func (f *Fixtures) oneOf(values ...string) string {
+	return values[f.rand.Intn(len(values))]
+}
+
+func (f *Fixtures) uuid() string {
+	b := f.bytes()
+	b[6] = b[6]&0x0f | 0x40
+	b[8] = b[8]&0x3f | 0x80
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:])
+}
+
+func (f *Fixtures) bool() bool {
+	return f.rand.Intn(2) == 1
+}
+
+// time returns random point in time after 2000-01-01, rounded to seconds.
+func (f *Fixtures) time() time.Time {
+	return time.Unix(946684800+f.rand.Int63n(1<<30), 0).UTC()
+}
+
+func (f *Fixtures) bytes() []byte {
+	b := make([]byte, 16)
+	for i := range b {
+		b[i] = byte(f.rand.Intn(256))
+	}
+	return b
+}
@@ -0,0 +1,61 @@
+package model_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/piotrkowalczuk/pqt/example/app/internal/model"
+)
+
+func TestCategoryNewsRepositoryBase_FindOneByKey(t *testing.T) {
+	s := setup(t)
+	defer s.teardown(t)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	category, err := s.category.Insert(ctx, &model.CategoryEntity{
+		Name:    "name",
+		Content: "content",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	news, err := s.news.Insert(ctx, &model.NewsEntity{
+		Title:   "title",
+		Content: "content",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	inserted, err := s.categoryNews.Insert(ctx, &model.CategoryNewsEntity{
+		CategoryID: category.ID,
+		NewsID:     news.ID,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	got, err := s.categoryNews.FindOneByKey(ctx, inserted.Key())
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	if got.Key() != inserted.Key() {
+		t.Errorf("wrong key, expected %v but got %v", inserted.Key(), got.Key())
+	}
+
+	_, err = s.categoryNews.FindOneByKey(ctx, model.CategoryNewsKey{CategoryID: category.ID, NewsID: news.ID + 1})
+	if err != sql.ErrNoRows {
+		t.Errorf("expected %s, got %v", sql.ErrNoRows, err)
+	}
+
+	affected, err := s.categoryNews.DeleteOneByKey(ctx, inserted.Key())
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	if affected != 1 {
+		t.Errorf("wrong number of affected rows, expected 1 but got %d", affected)
+	}
+}
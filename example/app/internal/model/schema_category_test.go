@@ -157,6 +157,33 @@ var testCategoryFindData = map[string]struct {
 		},
 		query: "SELECT t0.content, t0.created_at, t0.id, t0.name, t0.parent_id, t0.updated_at FROM example.category AS t0 WHERE t0.content=$1 AND t0.created_at=$2 AND t0.name=$3 AND t0.updated_at=$4",
 	},
+	"no-packages": {
+		expr: model.CategoryFindExpr{
+			Where: (&model.CategoryCriteria{}).HasNoPackages(nil),
+		},
+		query: "SELECT t0.content, t0.created_at, t0.id, t0.name, t0.parent_id, t0.updated_at FROM example.category AS t0 WHERE NOT EXISTS (SELECT 1 FROM example.package AS t100 WHERE t100.category_id=t0.id)",
+	},
+	"news-with-comments": {
+		expr: model.CategoryFindExpr{
+			Where: (&model.CategoryCriteria{}).HasNewss(model.NewsOr(
+				&model.NewsCriteria{Title: sql.NullString{String: "title", Valid: true}},
+				(&model.NewsCriteria{}).HasComments(&model.CommentCriteria{Content: sql.NullString{String: "content", Valid: true}}),
+			)),
+		},
+		query: "SELECT t0.content, t0.created_at, t0.id, t0.name, t0.parent_id, t0.updated_at FROM example.category AS t0 WHERE EXISTS (SELECT 1 FROM example.category_news AS t100 INNER JOIN example.news AS t101 ON t100.news_id=t101.id WHERE t100.category_id=t0.id AND ((t101.title=$1) OR (EXISTS (SELECT 1 FROM example.comment AS t201 WHERE t201.news_id=t101.id AND t201.content=$2))))",
+	},
+	"lock": {
+		expr: model.CategoryFindExpr{
+			Where: &model.CategoryCriteria{
+				Name: sql.NullString{String: "games", Valid: true},
+			},
+			Lock: model.RowLock{
+				Strength: model.LockForUpdate,
+				Wait:     model.LockSkipLocked,
+			},
+		},
+		query: "SELECT t0.content, t0.created_at, t0.id, t0.name, t0.parent_id, t0.updated_at FROM example.category AS t0 WHERE t0.name=$1 FOR UPDATE SKIP LOCKED",
+	},
 }
 
 func BenchmarkCategoryRepositoryBase_FindQuery(b *testing.B) {
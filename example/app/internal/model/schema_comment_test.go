@@ -152,6 +152,20 @@ func TestCommentRepositoryBase_FindQuery(t *testing.T) {
 	}
 }
 
+func TestCommentRepositoryBase_FindQuery_withSchema(t *testing.T) {
+	r := &model.CommentRepositoryBase{Table: model.WithSchema(model.TableComment, "tenant")}
+	query, _, err := r.FindQuery(&model.CommentFindExpr{
+		JoinNewsByTitle: &model.NewsJoin{Kind: model.JoinInner},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	expected := "SELECT t0.content, t0.created_at, t0.id, multiply(t0.id, t0.id) AS id_multiply, t0.news_id, t0.news_title, now() AS right_now, t0.updated_at FROM tenant.comment AS t0 INNER JOIN tenant.news AS t1 ON t0.news_title=t1.title"
+	if query != expected {
+		t.Errorf("wrong output, expected:\n	%s\nbut got:\n	%s", expected, query)
+	}
+}
+
 func TestCommentRepositoryBase_Find(t *testing.T) {
 	s := setup(t)
 	defer s.teardown(t)
@@ -239,6 +253,49 @@ func TestCommentRepositoryBase_Count(t *testing.T) {
 	}
 }
 
+func TestCommentRepositoryBase_RunInTransaction_session(t *testing.T) {
+	s := setup(t)
+	defer s.teardown(t)
+
+	populateNews(t, s.news, 10)
+	populateComment(t, s.comment, 10)
+
+	s.comment.Session = func(ctx context.Context) (*model.Session, error) {
+		return &model.Session{
+			Role:     "example_reader",
+			Settings: map[string]string{"example.news_title": "title-3"},
+		}, nil
+	}
+	err := s.comment.RunInTransaction(context.Background(), func(rtx *model.CommentRepositoryBaseTx) error {
+		got, err := rtx.Find(context.Background(), &model.CommentFindExpr{})
+		if err != nil {
+			return err
+		}
+		if len(got) != 1 || got[0].NewsTitle != "title-3" {
+			t.Errorf("policy expected to limit visible comments, got: %v", got)
+		}
+		return nil
+	}, 1)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+}
+
+func TestCommentRepositoryBase_Find_sessionOutsideTransaction(t *testing.T) {
+	r := &model.CommentRepositoryBase{
+		Table: model.TableComment,
+		Session: func(ctx context.Context) (*model.Session, error) {
+			return &model.Session{Role: "example_reader"}, nil
+		},
+	}
+	if _, err := r.Find(context.Background(), &model.CommentFindExpr{}); err != model.ErrSessionOutsideTransaction {
+		t.Errorf("wrong error, expected %v but got %v", model.ErrSessionOutsideTransaction, err)
+	}
+	if _, err := r.Insert(context.Background(), &model.CommentEntity{}); err != model.ErrSessionOutsideTransaction {
+		t.Errorf("wrong error, expected %v but got %v", model.ErrSessionOutsideTransaction, err)
+	}
+}
+
 func populateComment(t testing.TB, r *model.CommentRepositoryBase, nb int) {
 	for i := 1; i <= nb; i++ {
 		_, err := r.Insert(context.Background(), &model.CommentEntity{
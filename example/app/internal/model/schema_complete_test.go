@@ -138,6 +138,39 @@ var testCompleteInsertData = map[string]struct {
 				Valid:  true,
 				String: "a0eebc99-9c0b-4ef8-bb6d-6bb9bd380a1111",
 			},
+			ColumnInterval: model.Interval{
+				Valid:    true,
+				Duration: 90 * time.Minute,
+			},
+			ColumnTime: pq.NullTime{
+				Valid: true,
+				Time:  time.Now(),
+			},
+			ColumnInet: sql.NullString{
+				Valid:  true,
+				String: "127.0.0.1",
+			},
+			ColumnIntegerBigRange: model.Int64Range{
+				Valid:          true,
+				Lower:          1,
+				Upper:          10,
+				LowerInclusive: true,
+			},
+			ColumnNumericRange: model.Float64Range{
+				Valid:         true,
+				Lower:         1.5,
+				UpperInfinite: true,
+			},
+			ColumnTimestamptzRange: model.TimeRange{
+				Valid:         true,
+				Lower:         time.Now(),
+				UpperInfinite: true,
+			},
+			ColumnPoint: model.Point{
+				Valid: true,
+				X:     1.5,
+				Y:     -2,
+			},
 		},
 		query: "INSERT INTO example.complete (" + columns(model.TableCompleteColumns, "column_serial", "column_serial_big", "column_serial_small") + ") VALUES (" + func(args []string) (r string) {
 			for i := range args {
@@ -51,3 +51,67 @@ func TestErrorConstraint_nonSQL(t *testing.T) {
 		t.Fatalf("wrong constraint, expected empty string but got %s", got)
 	}
 }
+
+func TestErrorConstraint_wrapped(t *testing.T) {
+	err := wrapError(&pq.Error{Constraint: TableNewsConstraintTitleUnique})
+	if got := ErrorConstraint(err); got != TableNewsConstraintTitleUnique {
+		t.Fatalf("wrong constraint, expected %s but got %s", TableNewsConstraintTitleUnique, got)
+	}
+}
+
+func TestWrapError(t *testing.T) {
+	err := wrapError(&pq.Error{
+		Code:       "23505",
+		Table:      "news",
+		Constraint: TableNewsConstraintTitleLeadUnique,
+		Detail:     "Key (title, lead)=(title, lead) already exists.",
+	})
+
+	if !errors.Is(err, ErrNewsConstraintTitleLeadUnique) {
+		t.Errorf("expected %s, got %v", ErrNewsConstraintTitleLeadUnique, err)
+	}
+	if errors.Is(err, ErrNewsConstraintTitleUnique) {
+		t.Errorf("unexpected match of %s", ErrNewsConstraintTitleUnique)
+	}
+	var ce *ConstraintError
+	if !errors.As(err, &ce) {
+		t.Fatalf("expected constraint error, got %T", err)
+	}
+	if ce.Constraint.Table != TableNews || len(ce.Constraint.Columns) != 2 {
+		t.Errorf("wrong constraint: %#v", ce.Constraint)
+	}
+	if ce.Values["title"] != "title" || ce.Values["lead"] != "lead" {
+		t.Errorf("wrong values: %v", ce.Values)
+	}
+	var pqErr *pq.Error
+	if !errors.As(err, &pqErr) || pqErr.Code != "23505" {
+		t.Errorf("driver error expected to be unwrapped, got %v", pqErr)
+	}
+}
+
+func TestWrapError_foreignKey(t *testing.T) {
+	err := wrapError(&pq.Error{
+		Constraint: TableCommentConstraintNewsIDForeignKey,
+		Detail:     `Key (news_id)=(5) is not present in table "news".`,
+	})
+
+	var ce *ConstraintError
+	if !errors.As(err, &ce) || ce.Constraint != ErrCommentConstraintNewsIDForeignKey {
+		t.Fatalf("expected violation of %s, got %v", ErrCommentConstraintNewsIDForeignKey, err)
+	}
+	if ce.Values["news_id"] != "5" {
+		t.Errorf("wrong values: %v", ce.Values)
+	}
+}
+
+func TestWrapError_unknown(t *testing.T) {
+	normal := errors.New("normal error")
+	if err := wrapError(normal); err != normal {
+		t.Errorf("expected error to be returned unchanged, got %v", err)
+	}
+
+	var ce *ConstraintError
+	if err := wrapError(&pq.Error{Constraint: "unknown", Table: "other"}); !errors.As(err, &ce) || ce.Constraint.Name != "unknown" {
+		t.Errorf("expected constraint error, got %v", err)
+	}
+}
@@ -223,6 +223,55 @@ var testNewsFindData = map[string]struct {
 		},
 		query: "SELECT " + join(model.TableNewsColumns, 0) + " FROM example.news AS t0 WHERE t0.content=$1 AND t0.continue=$2 AND t0.created_at=$3 AND t0.lead=$4 AND t0.meta_data=$5 AND t0.score=$6 AND t0.title=$7 AND t0.updated_at=$8 AND t0.views_distribution=$9 ORDER BY title DESC, lead OFFSET $10  LIMIT $11 ",
 	},
+	"relationships": {
+		expr: model.NewsFindExpr{
+			Where: (&model.NewsCriteria{Title: sql.NullString{String: "title", Valid: true}}).
+				HasComments(&model.CommentCriteria{Content: sql.NullString{String: "content", Valid: true}}).
+				HasNoCategorys(nil),
+		},
+		query: "SELECT " + join(model.TableNewsColumns, 0) + " FROM example.news AS t0 WHERE t0.title=$1 AND EXISTS (SELECT 1 FROM example.comment AS t100 WHERE t100.news_id=t0.id AND t100.content=$2) AND NOT EXISTS (SELECT 1 FROM example.category_news AS t100 INNER JOIN example.category AS t101 ON t100.category_id=t101.id WHERE t100.news_id=t0.id)",
+	},
+	"array-operators": {
+		expr: model.NewsFindExpr{
+			Where: &model.NewsCriteria{
+				ViewsDistributionContains: model.NullFloat64Array{Float64Array: pq.Float64Array{1, 2}, Valid: true},
+				ViewsDistributionOverlap:  model.NullFloat64Array{Float64Array: pq.Float64Array{3}, Valid: true},
+				ViewsDistributionAny:      sql.NullFloat64{Float64: 4, Valid: true},
+			},
+		},
+		query: "SELECT " + join(model.TableNewsColumns, 0) + " FROM example.news AS t0 WHERE t0.views_distribution @> $1 AND t0.views_distribution && $2 AND $3 = ANY(t0.views_distribution)",
+	},
+	"jsonb": {
+		expr: model.NewsFindExpr{
+			Where: &model.NewsCriteria{
+				MetaDataContains:   []byte(`{"published": true}`),
+				MetaDataHasKey:     sql.NullString{String: "author", Valid: true},
+				MetaDataHasAnyKeys: pq.StringArray{"a", "b"},
+				MetaDataHasAllKeys: pq.StringArray{"c", "d"},
+				MetaDataPath: []model.JSONPredicate{
+					{Path: []string{"author"}, Value: "John"},
+					{Path: []string{"stats", "views"}, Operator: ">", Value: 100},
+				},
+				MetaDataMatch:  sql.NullString{String: "$.stats.views > 10", Valid: true},
+				MetaDataExists: sql.NullString{String: "$.tags[*] ? (@ == \"go\")", Valid: true},
+			},
+		},
+		query: "SELECT " + join(model.TableNewsColumns, 0) + " FROM example.news AS t0 WHERE t0.meta_data @> $1 AND t0.meta_data ? $2 AND t0.meta_data ?| $3 AND t0.meta_data ?& $4 AND t0.meta_data @@ $5 AND t0.meta_data @? $6 AND (t0.meta_data->>$7) = $8 AND (t0.meta_data#>>$9)::NUMERIC > $10",
+	},
+	"text-search": {
+		expr: model.NewsFindExpr{
+			Where: &model.NewsCriteria{
+				DocumentSearch: &model.TextSearch{Query: "golang postgres", Mode: model.TextSearchPlain},
+			},
+			DocumentRank: &model.TextSearch{Query: "golang"},
+			DocumentHeadline: &model.TextSearchHeadline{
+				TextSearch: model.TextSearch{Query: "golang"},
+				Options:    "MaxWords=10",
+			},
+			OrderBy: []model.RowOrder{{Name: "title"}},
+		},
+		query: "SELECT " + join(model.TableNewsColumns, 0) + ", ts_headline('english', coalesce(t0.title, '') || ' ' || coalesce(t0.lead, '') || ' ' || coalesce(t0.content, ''), websearch_to_tsquery('english', $1), $2) AS document_headline FROM example.news AS t0 WHERE t0.document @@ plainto_tsquery('english', $3) ORDER BY ts_rank(t0.document, websearch_to_tsquery('english', $4)) DESC, title",
+	},
 }
 
 func BenchmarkNewsRepositoryBase_FindQuery(b *testing.B) {
@@ -261,6 +310,22 @@ func TestNewsRepositoryBase_FindQuery(t *testing.T) {
 	}
 }
 
+func TestNewsRepositoryBase_FindQuery_relationshipsWithSchema(t *testing.T) {
+	r := &model.NewsRepositoryBase{Table: model.WithSchema(model.TableNews, "tenant")}
+	query, _, err := r.FindQuery(&model.NewsFindExpr{
+		Where: (&model.NewsCriteria{}).
+			HasComments(nil).
+			HasCategorys(&model.CategoryCriteria{Name: sql.NullString{String: "sport", Valid: true}}),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	expected := "SELECT t0.content, t0.continue, t0.created_at, t0.day, t0.document, t0.id, t0.lead, t0.meta_data, t0.score, t0.title, t0.updated_at, t0.version, t0.views_distribution FROM tenant.news AS t0 WHERE EXISTS (SELECT 1 FROM tenant.comment AS t100 WHERE t100.news_id=t0.id) AND EXISTS (SELECT 1 FROM tenant.category_news AS t100 INNER JOIN tenant.category AS t101 ON t100.category_id=t101.id WHERE t100.news_id=t0.id AND t101.name=$1)"
+	if query != expected {
+		t.Errorf("wrong output, expected:\n	%s\nbut got:\n	%s", expected, query)
+	}
+}
+
 func TestNewsRepositoryBase_DeleteOneByID(t *testing.T) {
 	s := setup(t)
 	defer s.teardown(t)
@@ -301,7 +366,7 @@ func TestNewsRepositoryBase_FindIter(t *testing.T) {
 	expected := 10
 	populateNews(t, s.news, expected)
 	iter, err := s.news.FindIter(context.Background(), &model.NewsFindExpr{
-		Columns: []string{model.TableNewsColumnID, model.TableNewsColumnContent},
+		Columns: []model.NewsColumn{model.TableNewsColumnID, model.TableNewsColumnContent},
 	})
 	if err != nil {
 		t.Fatalf("unexpected error: %s", err.Error())
@@ -449,6 +514,17 @@ var testNewsUpdateData = map[string]struct {
 		},
 		query: "UPDATE example.news SET content=$1, continue=$2, created_at=$3, lead=$4, meta_data=$5, score=$6, title=$7, updated_at=$8, version=$9, views_distribution=$10 WHERE id=$11 RETURNING " + strings.Join(model.TableNewsColumns, ", "),
 	},
+	"jsonb": {
+		patch: model.NewsPatch{
+			MetaDataSet: []model.JSONSet{
+				{Path: []string{"author", "name"}, Value: []byte(`"John"`)},
+				{Path: []string{"tags", "0"}, Value: []byte(`"go"`)},
+			},
+			MetaDataMerge:  []byte(`{"published": true}`),
+			MetaDataRemove: pq.StringArray{"draft"},
+		},
+		query: "UPDATE example.news SET meta_data=((jsonb_set(jsonb_set(coalesce(meta_data, '{}'), $1::TEXT[], $2::JSONB), $3::TEXT[], $4::JSONB) || $5::JSONB) - $6::TEXT[]), updated_at=NOW(), version=version+1 WHERE id=$7 RETURNING " + strings.Join(model.TableNewsColumns, ", "),
+	},
 }
 
 func BenchmarkNewsRepositoryBase_UpdateOneByIDQuery(b *testing.B) {
@@ -757,6 +833,94 @@ func TestNewsRepositoryBase_UpsertQuery(t *testing.T) {
 	}
 }
 
+var testNewsUpsertManyData = map[string]struct {
+	expr     *model.NewsUpsertExpr
+	entities []*model.NewsEntity
+	query    string
+}{
+	"do-nothing": {
+		entities: []*model.NewsEntity{{Title: "title", Content: "content"}},
+		query:    "INSERT INTO example.news AS t0 (content, continue, created_at, day, lead, meta_data, score, title, updated_at, version, views_distribution) VALUES ($1, $2, DEFAULT, DEFAULT, DEFAULT, DEFAULT, $3, $4, DEFAULT, $5, DEFAULT) ON CONFLICT DO NOTHING RETURNING " + strings.Join(model.TableNewsColumns, ", "),
+	},
+	"do-nothing-target": {
+		expr:     &model.NewsUpsertExpr{Target: model.NewsConflictTitleLeadUnique},
+		entities: []*model.NewsEntity{{Title: "title", Content: "content", Lead: sql.NullString{String: "lead", Valid: true}}},
+		query:    "INSERT INTO example.news AS t0 (content, continue, created_at, day, lead, meta_data, score, title, updated_at, version, views_distribution) VALUES ($1, $2, DEFAULT, DEFAULT, $3, DEFAULT, $4, $5, DEFAULT, $6, DEFAULT) ON CONFLICT ON CONSTRAINT \"example.news_title_lead_key\" DO NOTHING RETURNING " + strings.Join(model.TableNewsColumns, ", "),
+	},
+	"conditional-update": {
+		expr: &model.NewsUpsertExpr{
+			Target: model.NewsConflictTitleUnique,
+			Patch:  &model.NewsPatch{Content: sql.NullString{String: "content", Valid: true}},
+			Where:  &model.NewsCriteria{Version: sql.NullInt64{Int64: 1, Valid: true}},
+		},
+		entities: []*model.NewsEntity{
+			{Title: "title-1", Content: "content"},
+			{Title: "title-2", Content: "content", Lead: sql.NullString{String: "lead", Valid: true}},
+		},
+		query: "INSERT INTO example.news AS t0 (content, continue, created_at, day, lead, meta_data, score, title, updated_at, version, views_distribution) VALUES ($1, $2, DEFAULT, DEFAULT, DEFAULT, DEFAULT, $3, $4, DEFAULT, $5, DEFAULT), ($6, $7, DEFAULT, DEFAULT, $8, DEFAULT, $9, $10, DEFAULT, $11, DEFAULT) ON CONFLICT ON CONSTRAINT \"example.news_title_key\" DO UPDATE SET content=$12, updated_at=NOW(), version=version+1 WHERE t0.version=$13 RETURNING " + strings.Join(model.TableNewsColumns, ", "),
+	},
+}
+
+func TestNewsRepositoryBase_UpsertManyQuery(t *testing.T) {
+	s := setup(t)
+	defer s.teardown(t)
+
+	for hint, given := range testNewsUpsertManyData {
+		t.Run(hint, func(t *testing.T) {
+			query, _, err := s.news.UpsertManyQuery(given.expr, given.entities...)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err.Error())
+			}
+			if given.query != query {
+				t.Errorf("wrong output, expected:\n	%s\nbut got:\n	%s", given.query, query)
+			}
+		})
+	}
+}
+
+func TestNewsRepositoryBase_UpsertManyQuery_missingTarget(t *testing.T) {
+	s := setup(t)
+	defer s.teardown(t)
+
+	_, _, err := s.news.UpsertManyQuery(&model.NewsUpsertExpr{
+		Patch: &model.NewsPatch{Content: sql.NullString{String: "content", Valid: true}},
+	}, &model.NewsEntity{Title: "title", Content: "content"})
+	if err == nil {
+		t.Fatal("expected error")
+	}
+}
+
+func TestNewsRepositoryBase_UpsertOne(t *testing.T) {
+	s := setup(t)
+	defer s.teardown(t)
+
+	ctx := context.Background()
+	ent, inserted, err := s.news.UpsertOne(ctx, &model.NewsEntity{Title: "upsert-one", Content: "content"}, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	if !inserted || ent.ID == 0 {
+		t.Fatal("entity expected to be inserted")
+	}
+	_, inserted, err = s.news.UpsertOne(ctx, &model.NewsEntity{Title: "upsert-one", Content: "content"}, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	if inserted {
+		t.Fatal("conflicting entity expected to be left untouched")
+	}
+	got, inserted, err := s.news.UpsertOne(ctx, &model.NewsEntity{Title: "upsert-one", Content: "content"}, &model.NewsUpsertExpr{
+		Target: model.NewsConflictTitleUnique,
+		Patch:  &model.NewsPatch{Content: sql.NullString{String: "updated", Valid: true}},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	if !inserted || got.ID != ent.ID || got.Content != "updated" {
+		t.Fatalf("conflicting entity expected to be updated, got: %v", got)
+	}
+}
+
 func TestNewsRepositoryBaseTx_Insert(t *testing.T) {
 	s := setup(t)
 	defer s.teardown(t)
@@ -870,3 +1034,106 @@ func TestNewsRepositoryBase_RunInTransaction(t *testing.T) {
 		return nil
 	}, 2)
 }
+
+type fakeListener struct {
+	channels      []string
+	notifications chan *pq.Notification
+}
+
+func (fl *fakeListener) Listen(channel string) error {
+	fl.channels = append(fl.channels, channel)
+	return nil
+}
+
+func (fl *fakeListener) Unlisten(channel string) error {
+	return nil
+}
+
+func (fl *fakeListener) NotificationChannel() <-chan *pq.Notification {
+	return fl.notifications
+}
+
+func TestNewsChangeListener_Listen(t *testing.T) {
+	fl := &fakeListener{notifications: make(chan *pq.Notification, 3)}
+	fl.notifications <- nil
+	fl.notifications <- &pq.Notification{
+		Channel: model.TableNewsChannel,
+		Extra:   `{"operation": "UPDATE", "key": {"id": 42}, "columns": ["title", "lead"]}`,
+	}
+	fl.notifications <- &pq.Notification{
+		Channel: model.TableNewsChannel,
+		Extra:   `{"operation": "DELETE", "key": {"id": 43}, "columns": []}`,
+	}
+	close(fl.notifications)
+
+	var got []*model.NewsChange
+	l := &model.NewsChangeListener{Listener: fl}
+	err := l.Listen(context.Background(), func(c *model.NewsChange) error {
+		got = append(got, c)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	if len(fl.channels) != 1 || fl.channels[0] != model.TableNewsChannel {
+		t.Errorf("wrong channels: %v", fl.channels)
+	}
+	if len(got) != 2 {
+		t.Fatalf("wrong number of changes, expected %d but got %d", 2, len(got))
+	}
+	if got[0].Operation != model.ChangeUpdate || got[0].ID != 42 || !got[0].Changed(model.TableNewsColumnTitle) {
+		t.Errorf("wrong change: %#v", got[0])
+	}
+	if got[1].Operation != model.ChangeDelete || got[1].ID != 43 || got[1].Changed(model.TableNewsColumnTitle) {
+		t.Errorf("wrong change: %#v", got[1])
+	}
+}
+
+func TestNewsRepositoryBase_History(t *testing.T) {
+	s := setup(t)
+	defer s.teardown(t)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	inserted, err := s.news.Insert(ctx, &model.NewsEntity{
+		Title:   "title - history",
+		Content: "content - history",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	beforeUpdate := time.Now()
+	if _, err = s.news.UpdateOneByID(ctx, inserted.ID, &model.NewsPatch{
+		Title: sql.NullString{String: "title - history (edited)", Valid: true},
+	}); err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	if _, err = s.news.DeleteOneByID(ctx, inserted.ID); err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	history, err := s.news.History(ctx, inserted.ID)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	if len(history) != 3 {
+		t.Fatalf("wrong number of versions, expected %d but got %d", 3, len(history))
+	}
+	for i, op := range []string{"INSERT", "UPDATE", "DELETE"} {
+		if history[i].HistoryOperation != op {
+			t.Errorf("wrong operation of version #%d, expected %s but got %s", i, op, history[i].HistoryOperation)
+		}
+	}
+
+	got, err := s.news.FindOneByIDAsOf(ctx, inserted.ID, beforeUpdate)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	if got.Title != inserted.Title {
+		t.Errorf("wrong title, expected %s but got %s", inserted.Title, got.Title)
+	}
+	if _, err = s.news.FindOneByIDAsOf(ctx, inserted.ID, time.Now()); err != sql.ErrNoRows {
+		t.Errorf("expected sql.ErrNoRows, got %v", err)
+	}
+}
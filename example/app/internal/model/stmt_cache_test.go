@@ -0,0 +1,56 @@
+package model_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/piotrkowalczuk/pqt/example/app/internal/model"
+)
+
+func TestStmtCache(t *testing.T) {
+	s := setup(t)
+	defer s.teardown(t)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	cache := model.NewStmtCache(s.db, 2)
+	defer cache.Close()
+
+	s.news.StmtCache = cache
+	for i := 0; i < 3; i++ {
+		ent, err := s.news.Insert(ctx, &model.NewsEntity{
+			Title:   "title-" + string(rune('a'+i)),
+			Content: "content",
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err.Error())
+		}
+		if _, err := s.news.FindOneByID(ctx, ent.ID); err != nil {
+			t.Fatalf("unexpected error: %s", err.Error())
+		}
+		if _, err := s.news.FindOneByTitle(ctx, ent.Title); err != nil {
+			t.Fatalf("unexpected error: %s", err.Error())
+		}
+	}
+	if cache.Len() != 2 {
+		t.Errorf("cache should be limited to 2 statements, got %d", cache.Len())
+	}
+
+	err := s.news.RunInTransaction(ctx, func(rtx *model.NewsRepositoryBaseTx) error {
+		ent, err := rtx.FindOneByTitle(ctx, "title-a")
+		if err != nil {
+			return err
+		}
+		_, err = rtx.DeleteOneByID(ctx, ent.ID)
+		return err
+	}, 1)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	if _, err := s.news.FindOneByTitle(ctx, "title-a"); err != sql.ErrNoRows {
+		t.Errorf("expected %s, got %v", sql.ErrNoRows, err)
+	}
+}
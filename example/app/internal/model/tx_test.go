@@ -0,0 +1,50 @@
+package model_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/piotrkowalczuk/pqt/example/app/internal/model"
+)
+
+func TestIsRetryable(t *testing.T) {
+	cases := map[string]struct {
+		err       error
+		retryable bool
+	}{
+		"nil":                   {err: nil},
+		"other":                 {err: errors.New("other")},
+		"retry-transaction":     {err: model.RetryTransaction, retryable: true},
+		"serialization-failure": {err: &pq.Error{Code: "40001"}, retryable: true},
+		"deadlock":              {err: &pq.Error{Code: "40P01"}, retryable: true},
+		"wrapped":               {err: fmt.Errorf("insert: %w", &pq.Error{Code: "40001"}), retryable: true},
+		"unique-violation":      {err: &pq.Error{Code: "23505"}},
+	}
+
+	for hint, c := range cases {
+		t.Run(hint, func(t *testing.T) {
+			if got := model.IsRetryable(c.err); got != c.retryable {
+				t.Errorf("expected %t, got %t", c.retryable, got)
+			}
+		})
+	}
+}
+
+func TestExponentialBackoff(t *testing.T) {
+	backoff := model.ExponentialBackoff(10*time.Millisecond, 50*time.Millisecond, 0)
+	for n, expected := range []time.Duration{10, 20, 40, 50, 50} {
+		if got := backoff(n + 1); got != expected*time.Millisecond {
+			t.Errorf("retry %d: expected %s, got %s", n+1, expected*time.Millisecond, got)
+		}
+	}
+
+	jittered := model.ExponentialBackoff(10*time.Millisecond, time.Second, 0.5)
+	for i := 0; i < 100; i++ {
+		if got := jittered(1); got < 10*time.Millisecond || got > 15*time.Millisecond {
+			t.Fatalf("delay out of range: %s", got)
+		}
+	}
+}
@@ -0,0 +1,137 @@
+package model
+
+import (
+	"testing"
+	"time"
+)
+
+func TestInterval_Scan(t *testing.T) {
+	cases := map[string]time.Duration{
+		"00:00:01":                          time.Second,
+		"-00:00:01.5":                       -1500 * time.Millisecond,
+		"3 days":                            72 * time.Hour,
+		"1 day -02:00:00":                   22 * time.Hour,
+		"1 year 2 mons 3 days 04:05:06.789": (365+60+3)*24*time.Hour + 4*time.Hour + 5*time.Minute + 6789*time.Millisecond,
+	}
+
+	for given, expected := range cases {
+		t.Run(given, func(t *testing.T) {
+			var i Interval
+			if err := i.Scan([]byte(given)); err != nil {
+				t.Fatalf("unexpected error: %s", err.Error())
+			}
+			if !i.Valid || i.Duration != expected {
+				t.Errorf("wrong interval, expected %s but got %s", expected, i.Duration)
+			}
+		})
+	}
+}
+
+func TestInterval_Value(t *testing.T) {
+	v, err := Interval{Duration: 90 * time.Minute, Valid: true}.Value()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	if v != "5400000000 microseconds" {
+		t.Errorf("wrong value: %v", v)
+	}
+	if v, _ := (Interval{}).Value(); v != nil {
+		t.Errorf("invalid interval should be null, got: %v", v)
+	}
+}
+
+func TestInt64Range(t *testing.T) {
+	cases := map[string]Int64Range{
+		"[1,10)":    {Lower: 1, Upper: 10, LowerInclusive: true, Valid: true},
+		"(,5]":      {Upper: 5, UpperInclusive: true, LowerInfinite: true, Valid: true},
+		"[-3,)":     {Lower: -3, LowerInclusive: true, UpperInfinite: true, Valid: true},
+		"empty":     {Empty: true, Valid: true},
+		`("1","2")`: {Lower: 1, Upper: 2, Valid: true},
+	}
+
+	for given, expected := range cases {
+		t.Run(given, func(t *testing.T) {
+			var r Int64Range
+			if err := r.Scan(given); err != nil {
+				t.Fatalf("unexpected error: %s", err.Error())
+			}
+			if r != expected {
+				t.Fatalf("wrong range, expected %v but got %v", expected, r)
+			}
+
+			v, err := r.Value()
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err.Error())
+			}
+			var back Int64Range
+			if err := back.Scan(v); err != nil {
+				t.Fatalf("unexpected error: %s", err.Error())
+			}
+			if back != r {
+				t.Errorf("range changed after round trip, expected %v but got %v", r, back)
+			}
+		})
+	}
+}
+
+func TestTimeRange_Scan(t *testing.T) {
+	var r TimeRange
+	if err := r.Scan([]byte(`["2010-01-01 14:30:00+00","2010-01-01 15:30:00.5+02")`)); err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	if !r.Lower.Equal(time.Date(2010, 1, 1, 14, 30, 0, 0, time.UTC)) {
+		t.Errorf("wrong lower bound: %s", r.Lower)
+	}
+	if !r.Upper.Equal(time.Date(2010, 1, 1, 13, 30, 0, 5e8, time.UTC)) {
+		t.Errorf("wrong upper bound: %s", r.Upper)
+	}
+	if !r.LowerInclusive || r.UpperInclusive {
+		t.Errorf("wrong bounds inclusivity: %v", r)
+	}
+
+	if err := r.Scan([]byte(`[2010-01-01,2010-01-05)`)); err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	if !r.Upper.Equal(time.Date(2010, 1, 5, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("wrong upper bound: %s", r.Upper)
+	}
+}
+
+func TestPoint(t *testing.T) {
+	var p Point
+	if err := p.Scan([]byte("(1.5,-2)")); err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	if p != (Point{X: 1.5, Y: -2, Valid: true}) {
+		t.Errorf("wrong point: %v", p)
+	}
+	if v, _ := p.Value(); v != "(1.5,-2)" {
+		t.Errorf("wrong value: %v", v)
+	}
+}
+
+func TestTimeArray(t *testing.T) {
+	given := TimeArray{
+		time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC),
+		time.Date(2021, 6, 7, 8, 9, 10, 500, time.UTC),
+	}
+	v, err := given.Value()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	var got NullTimeArray
+	if err := got.Scan([]byte(v.(string))); err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	if !got.Valid || len(got.TimeArray) != len(given) {
+		t.Fatalf("wrong array, expected %v but got %v", given, got)
+	}
+	for i := range given {
+		if !given[i].Equal(got.TimeArray[i]) {
+			t.Errorf("wrong element %d, expected %s but got %s", i, given[i], got.TimeArray[i])
+		}
+	}
+	if err := got.Scan(nil); err != nil || got.Valid {
+		t.Errorf("null array expected, got %v (%v)", got, err)
+	}
+}
@@ -0,0 +1,92 @@
+package model_test
+
+import (
+	"database/sql"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/lib/pq"
+	"github.com/piotrkowalczuk/pqt/example/app/internal/model"
+)
+
+func TestNewsEntity_Validate(t *testing.T) {
+	cases := map[string]struct {
+		entity model.NewsEntity
+		fields []string
+	}{
+		"valid": {
+			entity: model.NewsEntity{Title: "title", Content: "content", Score: 123.45678901},
+		},
+		"score-overflow": {
+			entity: model.NewsEntity{Score: 1e12},
+			fields: []string{"Score"},
+		},
+		"views-distribution-too-long": {
+			entity: model.NewsEntity{ViewsDistribution: model.NullFloat64Array{Float64Array: make(pq.Float64Array, 169), Valid: true}},
+			fields: []string{"ViewsDistribution"},
+		},
+		"views-distribution-null": {
+			entity: model.NewsEntity{ViewsDistribution: model.NullFloat64Array{Float64Array: make(pq.Float64Array, 169)}},
+		},
+	}
+
+	for hint, c := range cases {
+		t.Run(hint, func(t *testing.T) {
+			assertValidationError(t, c.entity.Validate(), c.fields...)
+		})
+	}
+}
+
+func TestCompleteEntity_Validate(t *testing.T) {
+	err := (&model.CompleteEntity{
+		ColumnCharacter100:    sql.NullString{String: strings.Repeat("ż", 101), Valid: true},
+		ColumnDecimal:         sql.NullFloat64{Float64: -1e13, Valid: true},
+		ColumnIntegerArray100: model.NullInt64Array{Int64Array: make(pq.Int64Array, 101), Valid: true},
+		ColumnTextArray100:    model.NullStringArray{StringArray: make(pq.StringArray, 100), Valid: true},
+		ColumnJsonbNn:         []byte("{}"),
+	}).Validate()
+
+	assertValidationError(t, err, "ColumnCharacter100", "ColumnDecimal", "ColumnIntegerArray100", "ColumnJsonNn")
+}
+
+func TestNewsPatch_Validate(t *testing.T) {
+	err := (&model.NewsPatch{
+		Score: sql.NullFloat64{Float64: 1e12, Valid: true},
+	}).Validate()
+
+	assertValidationError(t, err, "Score")
+}
+
+func TestNewsRepositoryBase_InsertQuery_validate(t *testing.T) {
+	ent := &model.NewsEntity{Title: "title", Content: "content", Score: 1e12}
+
+	if _, _, err := (&model.NewsRepositoryBase{Table: model.TableNews}).InsertQuery(ent, true); err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	_, _, err := (&model.NewsRepositoryBase{Table: model.TableNews, Validate: true}).InsertQuery(ent, true)
+	assertValidationError(t, err, "Score")
+}
+
+func assertValidationError(t *testing.T, err error, fields ...string) {
+	t.Helper()
+
+	if len(fields) == 0 {
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err.Error())
+		}
+		return
+	}
+	var verr model.ValidationError
+	if !errors.As(err, &verr) {
+		t.Fatalf("expected validation error, got %v", err)
+	}
+	if len(verr) != len(fields) {
+		t.Fatalf("expected %d field errors, got %d: %s", len(fields), len(verr), verr.Error())
+	}
+	for i, field := range fields {
+		if verr[i].Field != field {
+			t.Errorf("expected field error of %s, got %s", field, verr[i].Field)
+		}
+	}
+}
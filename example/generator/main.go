@@ -9,7 +9,7 @@ import (
 	"github.com/piotrkowalczuk/pqt/pqtsql"
 )
 
-const version = 9.5
+const version = 12
 
 var (
 	acronyms = map[string]string{
@@ -26,38 +26,35 @@ var (
 )
 
 func main() {
-	file, err := os.Create("schema.pqt.go")
-	if err != nil {
-		log.Fatal(err)
-	}
-	defer file.Close()
-
 	// For simplicity it is going to be empty.
 	sch := schema("example")
-	fmt.Fprint(file, `
-        // Code generated by pqt.
-        // source: cmd/app/main.go
-        // DO NOT EDIT!
-    `)
 	goGen := pqtgogen.Generator{
 		Pkg:     "model",
 		Version: version,
 		Plugins: []pqtgogen.Plugin{
 			&generator{},
 		},
-		Components: pqtgogen.ComponentAll,
+		Components: pqtgogen.ComponentAll | pqtgogen.ComponentFixtures,
 	}
 	sqlGen := &pqtsql.Generator{Version: version}
 
-	err = goGen.GenerateTo(sch, file)
+	if err := goGen.GenerateDir(sch, "."); err != nil {
+		log.Fatal(err)
+	}
+
+	file, err := os.Create("sql.pqt.go")
 	if err != nil {
 		log.Fatal(err)
 	}
-	fmt.Fprint(file, "/// SQL ...\n")
+	defer file.Close()
+
+	fmt.Fprint(file, "// Code generated by pqt. DO NOT EDIT.\n\n")
+	fmt.Fprint(file, "package model\n\n")
+	fmt.Fprint(file, "// SQL ...\n")
 	fmt.Fprint(file, "const SQL = `\n")
 
 	if err := sqlGen.GenerateTo(sch, file); err != nil {
 		log.Fatal(err)
 	}
-	fmt.Fprint(file, "`")
+	fmt.Fprint(file, "`\n")
 }
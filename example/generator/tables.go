@@ -20,13 +20,15 @@ func schema(sn string) *pqt.Schema {
 	}
 	title := pqt.NewColumn("title", pqt.TypeText(), pqt.WithNotNull(), pqt.WithUnique())
 	lead := pqt.NewColumn("lead", pqt.TypeText())
+	content := pqt.NewColumn("content", pqt.TypeText(), pqt.WithNotNull())
+	document := pqt.NewColumn("document", pqt.TypeTSVector(), pqt.WithTextSearch("english", title, lead, content))
 
-	news := pqt.NewTable("news", pqt.WithTableIfNotExists()).
+	news := pqt.NewTable("news", pqt.WithTableIfNotExists(), pqt.WithNotify(""), pqt.WithHistory()).
 		AddColumn(pqt.NewColumn("id", pqt.TypeSerialBig(), pqt.WithPrimaryKey())).
 		AddColumn(title).
 		AddColumn(lead).
 		AddColumn(pqt.NewColumn("continue", pqt.TypeBool(), pqt.WithNotNull(), pqt.WithDefault("false"))).
-		AddColumn(pqt.NewColumn("content", pqt.TypeText(), pqt.WithNotNull())).
+		AddColumn(content).
 		AddColumn(pqt.NewColumn("day", pqt.TypeDate())).
 		AddColumn(pqt.NewColumn("score", pqt.TypeNumeric(20, 8), pqt.WithNotNull(), pqt.WithDefault("0"))).
 		AddColumn(pqt.NewColumn("views_distribution", pqt.TypeDoubleArray(168))).
@@ -35,7 +37,11 @@ func schema(sn string) *pqt.Schema {
 			pqt.WithNotNull(),
 			pqt.WithDefault("version+1", pqt.EventUpdate),
 		)).
-		AddUnique(title, lead)
+		AddColumn(document).
+		AddUnique(title, lead).
+		AddIndexUsing(pqt.IndexMethodGIN, document)
+
+	reader := pqt.NewRole("example_reader")
 
 	commentID := pqt.NewColumn("id", pqt.TypeSerialBig())
 	comment := pqt.NewTable("comment", pqt.WithTableIfNotExists()).
@@ -49,10 +55,16 @@ func schema(sn string) *pqt.Schema {
 			pqt.WithReference(title, pqt.WithBidirectional(), pqt.WithOwnerName("comments_by_news_title"), pqt.WithInversedName("news_by_title")),
 		)).
 		AddColumn(pqt.NewDynamicColumn("right_now", pqt.FunctionNow())).
-		AddColumn(pqt.NewDynamicColumn("id_multiply", multiply, commentID, commentID))
+		AddColumn(pqt.NewDynamicColumn("id_multiply", multiply, commentID, commentID)).
+		AddGrant(pqt.NewGrant([]pqt.Privilege{pqt.PrivilegeSelect}, []*pqt.Role{reader})).
+		AddPolicy(pqt.NewPolicy("comment_by_news_title",
+			pqt.WithPolicyCommand(pqt.PolicyCommandSelect),
+			pqt.WithPolicyRoles(reader),
+			pqt.WithUsing("news_title = current_setting('example.news_title', true)"),
+		))
 
 	categoryName := pqt.NewColumn("name", pqt.TypeText(), pqt.WithNotNull())
-	category := pqt.NewTable("category", pqt.WithTableIfNotExists()).
+	category := pqt.NewTable("category", pqt.WithTableIfNotExists(), pqt.WithHistory()).
 		AddColumn(pqt.NewColumn("id", pqt.TypeSerialBig(), pqt.WithPrimaryKey())).
 		AddColumn(categoryName).
 		AddColumn(pqt.NewColumn("content", pqt.TypeText(), pqt.WithNotNull())).
@@ -82,7 +94,8 @@ func schema(sn string) *pqt.Schema {
 
 	comment.AddRelationship(pqt.ManyToOne(news, pqt.WithBidirectional(), pqt.WithInversedName("news_by_id")), pqt.WithNotNull())
 
-	pqt.ManyToMany(category, news, pqt.WithBidirectional())
+	categoryNews := pqt.NewTable("category_news", pqt.WithTableIfNotExists()).
+		AddRelationship(pqt.ManyToMany(category, news, pqt.WithBidirectional(), pqt.WithThroughPrimaryKey()), pqt.WithNotNull())
 
 	complete := pqt.NewTable("complete", pqt.WithTableIfNotExists()).
 		AddColumn(pqt.NewColumn("column_jsonb", pqt.TypeJSONB())).
@@ -117,13 +130,25 @@ func schema(sn string) *pqt.Schema {
 		AddColumn(pqt.NewColumn("column_text_array_100", pqt.TypeTextArray(100))).
 		AddColumn(pqt.NewColumn("column_timestamp", pqt.TypeTimestamp())).
 		AddColumn(pqt.NewColumn("column_timestamptz", pqt.TypeTimestampTZ())).
-		AddColumn(pqt.NewColumn("column_uuid", pqt.TypeUUID()))
+		AddColumn(pqt.NewColumn("column_uuid", pqt.TypeUUID())).
+		AddColumn(pqt.NewColumn("column_interval", pqt.TypeInterval())).
+		AddColumn(pqt.NewColumn("column_time", pqt.TypeTime())).
+		AddColumn(pqt.NewColumn("column_inet", pqt.TypeInet())).
+		AddColumn(pqt.NewColumn("column_integer_big_range", pqt.TypeIntegerBigRange())).
+		AddColumn(pqt.NewColumn("column_numeric_range", pqt.TypeNumericRange())).
+		AddColumn(pqt.NewColumn("column_timestamptz_range", pqt.TypeTimestampTZRange())).
+		AddColumn(pqt.NewColumn("column_point", pqt.TypePoint())).
+		AddColumn(pqt.NewColumn("column_uuid_array", pqt.TypeArray(pqt.TypeUUID()))).
+		AddColumn(pqt.NewColumn("column_bool_array", pqt.TypeArray(pqt.TypeBool()))).
+		AddColumn(pqt.NewColumn("column_timestamptz_array", pqt.TypeArray(pqt.TypeTimestampTZ())))
 
 	return pqt.NewSchema(sn, pqt.WithSchemaIfNotExists()).
+		AddRole(reader).
 		AddTable(category).
 		AddTable(pkg).
 		AddTable(news).
 		AddTable(comment).
+		AddTable(categoryNews).
 		AddTable(complete).
 		AddFunction(multiply)
 }
@@ -32,7 +32,40 @@ type %sEntity struct{`, pqtfmt.Public(t.Name))
 			)
 		}
 	}
-	g.Print(`}`)
+	g.textSearchEntityFields(t)
+	g.Printf(`
+// fields holds fields loaded by a projection, see Loaded.
+fields %s
+}`, pqtfmt.Public(t.Name, "fields"))
+}
+
+// EntityKey generates struct that identifies a row of a table which primary key spans multiple columns.
+func (g *Generator) EntityKey(t *pqt.Table) {
+	pk, ok := g.primaryKey(t)
+	if !ok || len(pk.Columns) < 2 {
+		return
+	}
+
+	g.Printf(`
+// %s identifies single %s row.
+type %s struct {`, pk.Type, t.Name, pk.Type)
+	for _, c := range pk.Columns {
+		g.Printf(`
+%s %s`, pqtfmt.Public(c.Name), g.columnType(c, pqtgo.ModeMandatory))
+	}
+	g.Printf(`
+}
+
+// %s returns primary key of the entity.
+func (e *%sEntity) %s() %s {
+	return %s{`, pqtfmt.Public("key"), pqtfmt.Public(t.Name), pqtfmt.Public("key"), pk.Type, pk.Type)
+	for _, c := range pk.Columns {
+		g.Printf(`
+%s: e.%s,`, pqtfmt.Public(c.Name), pqtfmt.Public(c.Name))
+	}
+	g.Print(`
+	}
+}`)
 }
 
 func (g *Generator) EntityProp(t *pqt.Table) {
@@ -75,6 +108,8 @@ ColumnsLoop:
 				g.Printf(`if e.%s == nil { e.%s = []bool{} }`, pn, pn)
 			case "pq.ByteaArray":
 				g.Printf(`if e.%s == nil { e.%s = [][]byte{} }`, pn, pn)
+			case "TimeArray":
+				g.Printf(`if e.%s == nil { e.%s = TimeArray{} }`, pn, pn)
 			}
 
 			g.Printf(`
@@ -103,9 +138,11 @@ func (g *Generator) EntityProps(t *pqt.Table) {
 	g.Printf(`
 		func (e *%sEntity) %s(cns ...string) ([]interface{}, error) {`, pqtfmt.Public(t.Name), pqtfmt.Public("props"))
 	g.Printf(`
-		if len(cns) == 0 {
+		projection := len(cns) > 0
+		if !projection {
 			cns = %s
 		}
+		e.fields = %s{}
 		res := make([]interface{}, 0, len(cns))
 		for _, cn := range cns {
 			if prop, ok := e.%s(cn); ok {
@@ -113,9 +150,13 @@ func (g *Generator) EntityProps(t *pqt.Table) {
 			} else {
 				return nil, fmt.Errorf("unexpected column provided: %%s", cn)
 			}
+			if projection {
+				e.fields.add(cn)
+			}
 		}
 		return res, nil`,
 		pqtfmt.Public("table", t.Name, "columns"),
+		pqtfmt.Public(t.Name, "fields"),
 		pqtfmt.Public("prop"),
 	)
 	g.Print(`
@@ -18,7 +18,7 @@ func TestGenerator_Entity(t *testing.T) {
 		return t
 	}
 	expected := func(columnName, columnType string) string {
-		return fmt.Sprintf("\n// ExampleEntity ...\ntype ExampleEntity struct{\n// %s ...\nA %s}", columnName, columnType)
+		return fmt.Sprintf("\n// ExampleEntity ...\ntype ExampleEntity struct{\n// %s ...\nA %s\n// fields holds fields loaded by a projection, see Loaded.\nfields ExampleFields}", columnName, columnType)
 	}
 	cases := map[string]struct {
 		table *pqt.Table
@@ -26,7 +26,7 @@ func TestGenerator_Entity(t *testing.T) {
 	}{
 		"simple": {
 			table: table(nil),
-			exp:   "\n// ExampleEntity ...\ntype ExampleEntity struct{}",
+			exp:   "\n// ExampleEntity ...\ntype ExampleEntity struct{\n// fields holds fields loaded by a projection, see Loaded.\nfields ExampleFields}",
 		},
 		"column-bool": {
 			table: table(pqt.NewColumn("a", pqt.TypeBool())),
@@ -69,7 +69,9 @@ type ExampleEntity struct{
 Age *int32
 // Dynamic ...
 // Dynamic is read only
-Dynamic int32}`,
+Dynamic int32
+// fields holds fields loaded by a projection, see Loaded.
+fields ExampleFields}`,
 		},
 	}
 
@@ -113,6 +115,8 @@ type T1Entity struct {
 	IntegersSmall pq.Int64Array
 	// Texts ...
 	Texts pq.StringArray
+	// fields holds fields loaded by a projection, see Loaded.
+	fields T1Fields
 }
 
 func (e *T1Entity) Prop(cn string) (interface{}, bool) {
@@ -169,12 +173,16 @@ type T2Entity struct {
 	Example sql.NullString
 	// T1 ...
 	T1 *T1Entity
+	// fields holds fields loaded by a projection, see Loaded.
+	fields T2Fields
 }
 
 func (e *T2Entity) Props(cns ...string) ([]interface{}, error) {
-	if len(cns) == 0 {
+	projection := len(cns) > 0
+	if !projection {
 		cns = TableT2Columns
 	}
+	e.fields = T2Fields{}
 	res := make([]interface{}, 0, len(cns))
 	for _, cn := range cns {
 		if prop, ok := e.Prop(cn); ok {
@@ -182,11 +190,41 @@ func (e *T2Entity) Props(cns ...string) ([]interface{}, error) {
 		} else {
 			return nil, fmt.Errorf("unexpected column provided: %s", cn)
 		}
+		if projection {
+			e.fields.add(cn)
+		}
 	}
 	return res, nil
 }`)
 }
 
 type scanClausePlugin struct{
+}
 
-}
\ No newline at end of file
+func TestGenerator_EntityKey(t *testing.T) {
+	tenantID := pqt.NewColumn("tenant_id", pqt.TypeIntegerBig())
+	id := pqt.NewColumn("id", pqt.TypeUUID())
+	t1 := pqt.NewTable("t1").
+		AddColumn(tenantID).
+		AddColumn(id).
+		AddColumn(pqt.NewColumn("age", pqt.TypeInteger())).
+		AddPrimaryKey(tenantID, id)
+
+	g := &gogen.Generator{}
+	g.Reset()
+	g.EntityKey(t1)
+	testutil.AssertOutput(t, g.Printer, `
+// T1Key identifies single t1 row.
+type T1Key struct {
+	TenantID int64
+	ID       string
+}
+
+// Key returns primary key of the entity.
+func (e *T1Entity) Key() T1Key {
+	return T1Key{
+		TenantID: e.TenantID,
+		ID:       e.ID,
+	}
+}`)
+}
@@ -0,0 +1,162 @@
+package gogen
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/piotrkowalczuk/pqt"
+	"github.com/piotrkowalczuk/pqt/pqtfmt"
+)
+
+// constraintError is a constraint that generated code exposes as a sentinel error.
+type constraintError struct {
+	Var, Type string
+	*pqt.Constraint
+}
+
+// constraintErrors returns constraints of the table that a statement can violate.
+// Constraints that would be exposed under the same name as a previous one are skipped.
+func constraintErrors(t *pqt.Table) []constraintError {
+	var (
+		errs []constraintError
+		seen = make(map[string]bool)
+	)
+	for _, c := range t.Constraints {
+		name := pqt.JoinColumns(c.PrimaryColumns, "_")
+		ce := constraintError{Constraint: c}
+		switch c.Type {
+		case pqt.ConstraintTypePrimaryKey:
+			ce.Var, ce.Type = pqtfmt.Public("err", t.Name, "constraintPrimaryKey"), "primary key"
+		case pqt.ConstraintTypeUnique:
+			ce.Var, ce.Type = pqtfmt.Public("err", t.Name, "constraint", name, "Unique"), "unique"
+		case pqt.ConstraintTypeUniqueIndex:
+			ce.Var, ce.Type = pqtfmt.Public("err", t.Name, "constraint", name, "UniqueIndex"), "unique index"
+		case pqt.ConstraintTypeForeignKey:
+			ce.Var, ce.Type = pqtfmt.Public("err", t.Name, "constraint", name, "ForeignKey"), "foreign key"
+		case pqt.ConstraintTypeCheck:
+			ce.Var, ce.Type = pqtfmt.Public("err", t.Name, "constraint", name, "Check"), "check"
+		case pqt.ConstraintTypeExclusion:
+			ce.Var, ce.Type = pqtfmt.Public("err", t.Name, "constraint", name, "Exclusion"), "exclusion"
+		default:
+			continue
+		}
+		if seen[ce.Var] {
+			continue
+		}
+		seen[ce.Var] = true
+		errs = append(errs, ce)
+	}
+	return errs
+}
+
+// ConstraintErrorStatics generates Constraint and ConstraintError types
+// and the function repositories use to turn driver errors into ConstraintError.
+func (g *Generator) ConstraintErrorStatics(s *pqt.Schema) {
+	g.Print(`
+// Constraint describes constraint of a table.
+// Generated constraints are sentinel errors, so their violations can be matched using errors.Is.
+type Constraint struct {
+	Name string
+	// Type is one of primary key, unique, unique index, foreign key, check or exclusion.
+	Type    string
+	Table   string
+	Columns []string
+}
+
+// Error implements error interface.
+func (c *Constraint) Error() string {
+	return "violation of " + c.Type + " constraint " + c.Name
+}
+
+// ConstraintError is returned by repositories if a statement violates a constraint.
+type ConstraintError struct {
+	Constraint *Constraint
+	// Values maps columns of the constraint to the values that violated it, if the database reported them.
+	Values map[string]string
+	Err    *pq.Error
+}
+
+// Error implements error interface.
+func (e *ConstraintError) Error() string {
+	return e.Err.Error()
+}
+
+// Unwrap returns the driver error.
+func (e *ConstraintError) Unwrap() error {
+	return e.Err
+}
+
+// Is returns true if target is the violated constraint.
+func (e *ConstraintError) Is(target error) bool {
+	c, ok := target.(*Constraint)
+	return ok && c.Name == e.Constraint.Name
+}
+
+var constraintDetail = regexp.MustCompile("^Key \\((.+?)\\)=\\((.*)\\) (already exists|is not present|is still referenced|conflicts with)")
+
+var constraints = map[string]*Constraint{`)
+	for _, t := range s.Tables {
+		for _, ce := range constraintErrors(t) {
+			g.Printf(`
+	"%s": %s,`, ce.Constraint.String(), ce.Var)
+		}
+	}
+	g.Print(`
+}
+
+// wrapError turns constraint violation reported by the pq library into ConstraintError, other errors are returned unchanged.
+func wrapError(err error) error {
+	pqErr, ok := err.(*pq.Error)
+	if !ok || pqErr.Constraint == "" {
+		return err
+	}
+	c, ok := constraints[pqErr.Constraint]
+	if !ok {
+		c = &Constraint{Name: pqErr.Constraint, Table: pqErr.Table}
+	}
+	ce := &ConstraintError{Constraint: c, Err: pqErr}
+	if m := constraintDetail.FindStringSubmatch(pqErr.Detail); m != nil {
+		columns, values := strings.Split(m[1], ", "), strings.Split(m[2], ", ")
+		if len(columns) == len(values) {
+			ce.Values = make(map[string]string, len(columns))
+			for i, column := range columns {
+				ce.Values[column] = values[i]
+			}
+		}
+	}
+	return ce
+}`)
+}
+
+// ConstraintErrors generates sentinel errors of the table constraints.
+func (g *Generator) ConstraintErrors(t *pqt.Table) {
+	errs := constraintErrors(t)
+	if len(errs) == 0 {
+		return
+	}
+	g.Print(`
+var (`)
+	for _, ce := range errs {
+		columns := make([]string, 0, len(ce.PrimaryColumns))
+		for _, c := range ce.PrimaryColumns {
+			columns = append(columns, fmt.Sprintf("%q", c.Name))
+		}
+		g.Printf(`
+	// %s is returned if %s constraint %s is violated.
+	%s = &Constraint{
+		Name:    "%s",
+		Type:    "%s",
+		Table:   "%s",
+		Columns: []string{%s},
+	}`,
+			ce.Var, ce.Type, ce.Constraint.String(),
+			ce.Var,
+			ce.Constraint.String(),
+			ce.Type,
+			t.FullName(),
+			strings.Join(columns, ", "),
+		)
+	}
+	g.Print(`
+)`)
+}
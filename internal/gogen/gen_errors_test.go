@@ -0,0 +1,57 @@
+package gogen_test
+
+import (
+	"testing"
+
+	"github.com/piotrkowalczuk/pqt"
+	"github.com/piotrkowalczuk/pqt/internal/gogen"
+	"github.com/piotrkowalczuk/pqt/internal/testutil"
+)
+
+func TestGenerator_ConstraintErrors(t *testing.T) {
+	t1 := pqt.NewTable("t1").
+		AddColumn(pqt.NewColumn("id", pqt.TypeSerialBig(), pqt.WithPrimaryKey()))
+	name := pqt.NewColumn("name", pqt.TypeText(), pqt.WithNotNull(), pqt.WithIndex())
+	age := pqt.NewColumn("age", pqt.TypeInteger(), pqt.WithCheck("age > 0"))
+	t2 := pqt.NewTable("t2").
+		AddColumn(pqt.NewColumn("id", pqt.TypeSerialBig(), pqt.WithPrimaryKey())).
+		AddColumn(name).
+		AddColumn(age).
+		AddUnique(name, age).
+		AddRelationship(pqt.ManyToOne(t1))
+	pqt.NewSchema("example").AddTable(t1).AddTable(t2)
+
+	g := &gogen.Generator{}
+	g.ConstraintErrors(t2)
+	testutil.AssertOutput(t, g.Printer, `
+var (
+	// ErrT2ConstraintPrimaryKey is returned if primary key constraint example.t2_id_pkey is violated.
+	ErrT2ConstraintPrimaryKey = &Constraint{
+		Name:    "example.t2_id_pkey",
+		Type:    "primary key",
+		Table:   "example.t2",
+		Columns: []string{"id"},
+	}
+	// ErrT2ConstraintAgeCheck is returned if check constraint example.t2_age_check is violated.
+	ErrT2ConstraintAgeCheck = &Constraint{
+		Name:    "example.t2_age_check",
+		Type:    "check",
+		Table:   "example.t2",
+		Columns: []string{"age"},
+	}
+	// ErrT2ConstraintNameAgeUnique is returned if unique constraint example.t2_name_age_key is violated.
+	ErrT2ConstraintNameAgeUnique = &Constraint{
+		Name:    "example.t2_name_age_key",
+		Type:    "unique",
+		Table:   "example.t2",
+		Columns: []string{"name", "age"},
+	}
+	// ErrT2ConstraintT1IDForeignKey is returned if foreign key constraint example.t2_t1_id_fkey is violated.
+	ErrT2ConstraintT1IDForeignKey = &Constraint{
+		Name:    "example.t2_t1_id_fkey",
+		Type:    "foreign key",
+		Table:   "example.t2",
+		Columns: []string{"t1_id"},
+	}
+)`)
+}
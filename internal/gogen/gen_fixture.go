@@ -0,0 +1,344 @@
+package gogen
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/piotrkowalczuk/pqt"
+	"github.com/piotrkowalczuk/pqt/pqtfmt"
+	"github.com/piotrkowalczuk/pqt/pqtgo"
+)
+
+// FixtureStatics generates Fixtures type and random value generators fixture builders use.
+func (g *Generator) FixtureStatics() {
+	g.Printf(`
+// Fixtures builds entities which mandatory columns are filled with random, but valid values.
+// Values depend only on the seed and on the order in which fixtures are built,
+// so tests that use the same seed get the same fixtures.
+type Fixtures struct {
+	// DB is used to insert fixtures and parents of required foreign keys.
+	DB   *sql.DB
+	rand *rand.Rand
+	seq  int64
+}
+
+// NewFixtures allocates Fixtures which values are generated using given seed.
+func NewFixtures(db *sql.DB, seed int64) *Fixtures {
+	return &Fixtures{
+		DB:   db,
+		rand: rand.New(rand.NewSource(seed)),
+	}
+}
+
+const fixtureAlphabet = "abcdefghijklmnopqrstuvwxyz0123456789"
+
+// int64 returns random positive number, unique numbers are taken from a sequence instead.
+func (f *Fixtures) int64(unique bool) int64 {
+	f.seq++
+	if unique {
+		return f.seq
+	}
+	return 1 + f.rand.Int63n(1000)
+}
+
+// float64 returns random whole number lower than max, so it fits numeric columns of limited precision.
+func (f *Fixtures) float64(max int64) float64 {
+	return float64(f.rand.Int63n(max))
+}
+
+// string returns random string of given length, or 16 characters long if length is 0.
+// Unique strings end with a sequence number.
+func (f *Fixtures) string(length int, unique bool) string {
+	if length <= 0 || length > 16 {
+		length = 16
+	}
+	b := make([]byte, length)
+	for i := range b {
+		b[i] = fixtureAlphabet[f.rand.Intn(len(fixtureAlphabet))]
+	}
+	if unique {
+		f.seq++
+		seq := strconv.FormatInt(f.seq, 36)
+		if len(seq) > length {
+			seq = seq[len(seq)-length:]
+		}
+		copy(b[length-len(seq):], seq)
+	}
+	return string(b)
+}
+
+func (f *Fixtures) oneOf(values ...string) string {
+	return values[f.rand.Intn(len(values))]
+}
+
+func (f *Fixtures) uuid() string {
+	b := f.bytes()
+	b[6] = b[6]&0x0f | 0x40
+	b[8] = b[8]&0x3f | 0x80
+	return fmt.Sprintf("%%x-%%x-%%x-%%x-%%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:])
+}
+
+func (f *Fixtures) bool() bool {
+	return f.rand.Intn(2) == 1
+}
+
+// time returns random point in time after 2000-01-01, rounded to seconds.
+func (f *Fixtures) time() time.Time {
+	return time.Unix(946684800+f.rand.Int63n(1<<30), 0).UTC()
+}
+
+func (f *Fixtures) bytes() []byte {
+	b := make([]byte, 16)
+	for i := range b {
+		b[i] = byte(f.rand.Intn(256))
+	}
+	return b
+}`)
+}
+
+// Fixture generates fixture builders of the table.
+func (g *Generator) Fixture(t *pqt.Table) {
+	entityName := pqtfmt.Public(t.Name)
+	parents := g.fixtureParents(t)
+
+	g.Printf(`
+// %s returns %sEntity which mandatory columns are filled with random values, overrides are applied afterwards.
+// If overrides leave a required foreign key unset, parent fixture is inserted and referenced.
+func (f *Fixtures) %s(ctx context.Context, overrides ...func(*%sEntity)) (*%sEntity, error) {
+	e := &%sEntity{`,
+		pqtfmt.Public("new", t.Name, "fixture"), entityName,
+		pqtfmt.Public("new", t.Name, "fixture"), entityName, entityName,
+		entityName,
+	)
+	for _, c := range t.Columns {
+		if _, ok := parents[c]; ok || !isFixtureColumn(c) {
+			continue
+		}
+		if v, ok := g.fixtureValue(c); ok {
+			g.Printf(`
+		%s: %s,`, pqtfmt.Public(c.Name), v)
+		}
+	}
+	g.Print(`
+	}
+	for _, o := range overrides {
+		o(e)
+	}`)
+	// Columns that reference the same table are resolved using single parent.
+	var (
+		tables  []*pqt.Table
+		columns = make(map[*pqt.Table]pqt.Columns)
+	)
+	for _, c := range t.Columns {
+		ref, ok := parents[c]
+		if !ok {
+			continue
+		}
+		if _, ok := columns[ref.Table]; !ok {
+			tables = append(tables, ref.Table)
+		}
+		columns[ref.Table] = append(columns[ref.Table], c)
+	}
+	for _, pt := range tables {
+		unset := make([]string, 0, len(columns[pt]))
+		for _, c := range columns[pt] {
+			unset = append(unset, fmt.Sprintf("e.%s == %s", pqtfmt.Public(c.Name), zeroValue(g.columnType(c, pqtgo.ModeMandatory))))
+		}
+		g.Printf(`
+	if %s {
+		parent, err := f.%s(ctx)
+		if err != nil {
+			return nil, err
+		}`,
+			strings.Join(unset, " || "),
+			pqtfmt.Public("insert", pt.Name, "fixture"),
+		)
+		for i, c := range columns[pt] {
+			if len(unset) == 1 {
+				g.Printf(`
+		e.%s = parent.%s`, pqtfmt.Public(c.Name), pqtfmt.Public(parents[c].Name))
+				continue
+			}
+			g.Printf(`
+		if %s {
+			e.%s = parent.%s
+		}`, unset[i], pqtfmt.Public(c.Name), pqtfmt.Public(parents[c].Name))
+		}
+		g.Print(`
+	}`)
+	}
+	g.Print(`
+	return e, nil
+}`)
+	g.NewLine()
+
+	g.Printf(`
+// %s works like %s, but it inserts the fixture as well.
+func (f *Fixtures) %s(ctx context.Context, overrides ...func(*%sEntity)) (*%sEntity, error) {
+	e, err := f.%s(ctx, overrides...)
+	if err != nil {
+		return nil, err
+	}
+	return (&%sRepositoryBase{%s: %s, %s: f.DB}).%s(ctx, e)
+}`,
+		pqtfmt.Public("insert", t.Name, "fixture"), pqtfmt.Public("new", t.Name, "fixture"),
+		pqtfmt.Public("insert", t.Name, "fixture"), entityName, entityName,
+		pqtfmt.Public("new", t.Name, "fixture"),
+		entityName, pqtfmt.Public("table"), pqtfmt.Public("table", t.Name), pqtfmt.Public("db"), pqtfmt.Public("insert"),
+	)
+}
+
+// isFixtureColumn returns true if fixture has to provide value of the column, because database does not.
+func isFixtureColumn(c *pqt.Column) bool {
+	if !isInsertable(c) || !(c.NotNull || isPrimaryKey(c)) {
+		return false
+	}
+	_, ok := c.DefaultOn(pqt.EventInsert)
+	return !ok
+}
+
+// fixtureParents returns columns of required, single column foreign keys that fixture can resolve by inserting parent fixture.
+// Columns are mapped to the columns they reference.
+func (g *Generator) fixtureParents(t *pqt.Table) map[*pqt.Column]*pqt.Column {
+	parents := make(map[*pqt.Column]*pqt.Column)
+	for _, fk := range t.Constraints {
+		if fk.Type != pqt.ConstraintTypeForeignKey || len(fk.PrimaryColumns) != 1 || len(fk.Columns) != 1 {
+			continue
+		}
+		c, ref := fk.PrimaryColumns[0], fk.Columns[0]
+		if ref.Table == nil || ref.Table == t || !isFixtureColumn(c) {
+			continue
+		}
+		typ := g.columnType(c, pqtgo.ModeMandatory)
+		// Parent entity field has to be assignable to the column and comparable with its zero value.
+		if zeroValue(typ) == "" || typ != g.columnType(ref, pqtgo.ModeDefault) {
+			continue
+		}
+		parents[c] = ref
+	}
+	return parents
+}
+
+func zeroValue(typ string) string {
+	switch typ {
+	case "string":
+		return `""`
+	case "int", "int8", "int16", "int32", "int64", "uint", "uint8", "uint16", "uint32", "uint64", "float32", "float64":
+		return "0"
+	}
+	return ""
+}
+
+// fixtureValue returns expression that evaluates to random value of the column.
+// It returns false for types random value of which might not be valid, those are left for overrides.
+func (g *Generator) fixtureValue(c *pqt.Column) (string, bool) {
+	typ := g.columnType(c, pqtgo.ModeMandatory)
+	sqlType := c.Type
+	if mt, ok := sqlType.(pqt.MappableType); ok {
+		sqlType = mt.From
+	}
+	switch typ {
+	case "int64":
+		return fmt.Sprintf("f.int64(%t)", isUniqueColumn(c)), true
+	case "int", "int8", "int16", "int32", "uint", "uint8", "uint16", "uint32", "uint64":
+		return fmt.Sprintf("%s(f.int64(%t))", typ, isUniqueColumn(c)), true
+	case "float64":
+		return fmt.Sprintf("f.float64(%d)", fixtureNumericLimit(sqlType)), true
+	case "float32":
+		return fmt.Sprintf("float32(f.float64(%d))", fixtureNumericLimit(sqlType)), true
+	case "bool":
+		return "f.bool()", true
+	case "time.Time":
+		return "f.time()", true
+	case "[]byte":
+		if sqlType == pqt.TypeJSON() || sqlType == pqt.TypeJSONB() {
+			return `[]byte("{}")`, true
+		}
+		return "f.bytes()", true
+	case "pq.Int64Array", "pq.Float64Array", "pq.StringArray", "pq.BoolArray", "pq.ByteaArray", "TimeArray":
+		return typ + "{}", true
+	case "string":
+		if et, ok := sqlType.(pqt.EnumeratedType); ok && len(et.Enums) > 0 {
+			return fmt.Sprintf("f.oneOf(%s)", strings.Join(quoteAll(et.Enums), ", ")), true
+		}
+		if sqlType == pqt.TypeUUID() {
+			return "f.uuid()", true
+		}
+		if length, ok := fixtureTextLength(sqlType); ok {
+			return fmt.Sprintf("f.string(%d, %t)", length, isUniqueColumn(c)), true
+		}
+	}
+	return "", false
+}
+
+// fixtureTextLength returns maximum length of character type values (0 if not limited), or false if type is not a character type.
+func fixtureTextLength(t pqt.Type) (int, bool) {
+	if t == pqt.TypeText() || t == pqt.TypeCitext() {
+		return 0, true
+	}
+	var length int
+	for _, format := range []string{"VARCHAR(%d)", "CHARACTER[%d]"} {
+		if _, err := fmt.Sscanf(t.String(), format, &length); err == nil {
+			return length, true
+		}
+	}
+	return 0, t.String() == "VARCHAR"
+}
+
+// fixtureNumericLimit returns exclusive upper bound of whole numbers given type can store, but not more than 1000.
+func fixtureNumericLimit(t pqt.Type) int64 {
+	limit := int64(1000)
+	precision, scale, ok := numericPrecision(t)
+	if !ok {
+		return limit
+	}
+	max := int64(1)
+	for i := 0; i < precision-scale && max < limit; i++ {
+		max *= 10
+	}
+	if max < limit {
+		return max
+	}
+	return limit
+}
+
+// numericPrecision returns precision and scale of numeric (or decimal) type, or false if type is not a numeric one or the precision is not specified.
+func numericPrecision(t pqt.Type) (precision, scale int, ok bool) {
+	name := strings.Replace(t.String(), "DECIMAL", "NUMERIC", 1)
+	if _, err := fmt.Sscanf(name, "NUMERIC(%d,%d)", &precision, &scale); err == nil {
+		return precision, scale, true
+	}
+	if _, err := fmt.Sscanf(name, "NUMERIC(%d)", &precision); err == nil {
+		return precision, 0, true
+	}
+	return 0, 0, false
+}
+
+// isUniqueColumn returns true if column values have to be unique, on their own or as a part of a constraint.
+func isUniqueColumn(c *pqt.Column) bool {
+	if c.Unique || isPrimaryKey(c) {
+		return true
+	}
+	if c.Table == nil {
+		return false
+	}
+	for _, u := range c.Table.Constraints {
+		if u.Type != pqt.ConstraintTypeUnique && u.Type != pqt.ConstraintTypeUniqueIndex {
+			continue
+		}
+		for _, uc := range u.PrimaryColumns {
+			if uc == c {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func quoteAll(values []string) []string {
+	quoted := make([]string, 0, len(values))
+	for _, v := range values {
+		quoted = append(quoted, fmt.Sprintf("%q", v))
+	}
+	return quoted
+}
@@ -0,0 +1,59 @@
+package gogen_test
+
+import (
+	"go/types"
+	"testing"
+
+	"github.com/piotrkowalczuk/pqt"
+	"github.com/piotrkowalczuk/pqt/internal/gogen"
+	"github.com/piotrkowalczuk/pqt/internal/testutil"
+	"github.com/piotrkowalczuk/pqt/pqtgo"
+)
+
+func TestGenerator_Fixture(t *testing.T) {
+	parentID := pqt.NewColumn("id", pqt.TypeSerialBig(), pqt.WithPrimaryKey())
+	pqt.NewTable("parent").AddColumn(parentID)
+
+	t1 := pqt.NewTable("t1").
+		AddColumn(pqt.NewColumn("id", pqt.TypeSerialBig(), pqt.WithPrimaryKey())).
+		AddColumn(pqt.NewColumn("code", pqt.TypeVarchar(4), pqt.WithNotNull(), pqt.WithUnique())).
+		AddColumn(pqt.NewColumn("kind", pqt.TypeEnumerated("kind", "a", "b"), pqt.WithNotNull(), pqt.WithTypeMapping(pqtgo.BuiltinType(types.String)))).
+		AddColumn(pqt.NewColumn("amount", pqt.TypeNumeric(4, 2), pqt.WithNotNull())).
+		AddColumn(pqt.NewColumn("created_at", pqt.TypeTimestampTZ(), pqt.WithNotNull(), pqt.WithDefault("NOW()"))).
+		AddColumn(pqt.NewColumn("note", pqt.TypeText())).
+		AddColumn(pqt.NewColumn("parent_id", pqt.TypeIntegerBig(), pqt.WithNotNull(), pqt.WithReference(parentID)))
+
+	g := &gogen.Generator{}
+	g.Reset()
+	g.Fixture(t1)
+	testutil.AssertOutput(t, g.Printer, `
+// NewT1Fixture returns T1Entity which mandatory columns are filled with random values, overrides are applied afterwards.
+// If overrides leave a required foreign key unset, parent fixture is inserted and referenced.
+func (f *Fixtures) NewT1Fixture(ctx context.Context, overrides ...func(*T1Entity)) (*T1Entity, error) {
+	e := &T1Entity{
+		Amount: f.float64(100),
+		Code:   f.string(4, true),
+		Kind:   f.oneOf("a", "b"),
+	}
+	for _, o := range overrides {
+		o(e)
+	}
+	if e.ParentID == 0 {
+		parent, err := f.InsertParentFixture(ctx)
+		if err != nil {
+			return nil, err
+		}
+		e.ParentID = parent.ID
+	}
+	return e, nil
+}
+
+// InsertT1Fixture works like NewT1Fixture, but it inserts the fixture as well.
+func (f *Fixtures) InsertT1Fixture(ctx context.Context, overrides ...func(*T1Entity)) (*T1Entity, error) {
+	e, err := f.NewT1Fixture(ctx, overrides...)
+	if err != nil {
+		return nil, err
+	}
+	return (&T1RepositoryBase{Table: TableT1, DB: f.DB}).Insert(ctx, e)
+}`)
+}
@@ -2,6 +2,7 @@ package gogen
 
 import (
 	"fmt"
+	"strconv"
 	"text/template"
 
 	"github.com/piotrkowalczuk/pqt"
@@ -64,8 +65,24 @@ type %sCriteria struct {`, tableName)
 		if t := g.columnType(c, pqtgo.ModeCriteria); t != "<nil>" {
 			g.Printf(`
 %s %s`, pqtfmt.Public(c.Name), t)
+			if et, ok := g.arrayElemType(c); ok && !c.IsDynamic {
+				g.Printf(`
+// %s matches rows where %s contains all given elements (@>).
+%s %s
+// %s matches rows where %s has any elements in common with given ones (&&).
+%s %s
+// %s matches rows where any element of %s equals given value (= ANY).
+%s %s`,
+					pqtfmt.Public(c.Name, "contains"), c.Name, pqtfmt.Public(c.Name, "contains"), t,
+					pqtfmt.Public(c.Name, "overlap"), c.Name, pqtfmt.Public(c.Name, "overlap"), t,
+					pqtfmt.Public(c.Name, "any"), c.Name, pqtfmt.Public(c.Name, "any"), et,
+				)
+			}
+			g.textSearchCriteriaFields(c)
+			g.jsonCriteriaFields(c)
 		}
 	}
+	g.relationshipCriteriaFields(t)
 	g.Printf(`
 	operator string
 	child, sibling, parent *%sCriteria
@@ -75,7 +92,13 @@ type %sCriteria struct {`, tableName)
 func (g *Generator) Errors() {
 	g.Printf(`
 // RetryTransaction can be returned by user defined function when a transaction is rolled back and logic repeated.
-var RetryTransaction = errors.New("retry transaction")`)
+var RetryTransaction = errors.New("retry transaction")
+
+// ErrRowLockOutsideTransaction is returned when row locking clause is requested outside of a transaction.
+var ErrRowLockOutsideTransaction = errors.New("row locking clause requires a transaction")
+
+// ErrMultipleRowLocks is returned when more than one row locking clause is passed to a finder.
+var ErrMultipleRowLocks = errors.New("only one row locking clause can be requested")`)
 }
 
 func (g *Generator) Operand(t *pqt.Table) {
@@ -137,6 +160,58 @@ var %s = []string{`, pqtfmt.Public("table", t.Name, "columns"))
 
 }
 
+// Rows generates constants that hold primary keys of named static rows of the table.
+// Primary key columns have to be of a type that Go constant can hold.
+func (g *Generator) Rows(t *pqt.Table) {
+	pk := t.PrimaryKeyColumns()
+	if len(pk) == 0 {
+		return
+	}
+	types := make([]string, 0, len(pk))
+	for _, c := range pk {
+		typ := g.columnType(c, pqtgo.ModeMandatory)
+		switch typ {
+		case "string", "bool", "int", "int8", "int16", "int32", "int64", "uint", "uint8", "uint16", "uint32", "uint64", "float32", "float64":
+		default:
+			return
+		}
+		types = append(types, typ)
+	}
+
+	var started bool
+	for _, r := range t.Rows {
+		if r.Name == "" {
+			continue
+		}
+		if !started {
+			g.Print(`
+const (`)
+			started = true
+		}
+		for i, c := range pk {
+			v, _ := r.Value(c)
+			name := pqtfmt.Public(t.Name, r.Name, c.Name)
+			g.Printf(`
+	// %s is %s of %s static row.
+	%s %s = %s`, name, c.Name, r.Name, name, types[i], rowValue(v))
+		}
+	}
+	if started {
+		g.Print(`
+)`)
+	}
+}
+
+func rowValue(v interface{}) string {
+	switch val := v.(type) {
+	case string:
+		return strconv.Quote(val)
+	case float32, float64:
+		return fmt.Sprintf("%g", val)
+	}
+	return fmt.Sprintf("%v", v)
+}
+
 func (g *Generator) Constraints(t *pqt.Table) {
 	g.Printf(`
 const (`)
@@ -175,13 +250,24 @@ type %sFindExpr struct {`, pqtfmt.Public(t.Name))
 	g.Printf(`
 %s, %s int64`, pqtfmt.Public("offset"), pqtfmt.Public("limit"))
 	g.Printf(`
-%s []string`, pqtfmt.Public("columns"))
+%s []%s`, pqtfmt.Public("columns"), pqtfmt.Public(t.Name, "column"))
 	g.Printf(`
 %s []RowOrder`, pqtfmt.Public("orderBy"))
+	g.Printf(`
+%s RowLock`, pqtfmt.Public("lock"))
 	for _, r := range joinableRelationships(t) {
 		g.Printf(`
 %s *%sJoin`, pqtfmt.Public("join", or(r.InversedName, r.InversedTable.Name)), pqtfmt.Public(r.InversedTable.Name))
 	}
+	g.textSearchFindExprFields(t)
+	for _, plugin := range g.Plugins {
+		if p, ok := plugin.(findExprFieldsPlugin); ok {
+			if txt := p.FindExprFields(t); txt != "" {
+				g.Printf(`
+%s`, txt)
+			}
+		}
+	}
 	g.Print(`
 }`)
 }
@@ -222,7 +308,7 @@ type %sPatch struct {`, pqtfmt.Public(t.Name))
 
 ArgumentsLoop:
 	for _, c := range t.Columns {
-		if c.PrimaryKey {
+		if isPrimaryKey(c) || c.IsGeneratedAlways() {
 			continue ArgumentsLoop
 		}
 
@@ -232,6 +318,7 @@ ArgumentsLoop:
 				pqtfmt.Public(c.Name),
 				t,
 			)
+			g.jsonPatchFields(c)
 		}
 	}
 	g.Print(`
@@ -308,6 +395,7 @@ func (i *%sIterator) %s() (*%sEntity, error) {
 		var prop []interface{}`)
 	}
 	g.scanJoinableRelationships(t, "i.expr")
+	g.textSearchScan(t, "i.expr")
 
 	g.Print(`
 	if err := i.rows.Scan(props...); err != nil {
@@ -321,11 +409,13 @@ func (g *Generator) WhereClause(t *pqt.Table) {
 	name := pqtfmt.Public(t.Name)
 	fnName := fmt.Sprintf("%sCriteriaWhereClause", name)
 	g.Printf(`
-		func %s(comp *Composer, c *%sCriteria, id int) (error) {`, fnName, name)
+		// %s writes conditions of the criteria tree into the composer.
+		// The id is the alias of the table named table, tables of related rows are resolved relative to its schema.
+		func %s(comp *Composer, c *%sCriteria, id int, table string) (error) {`, fnName, fnName, name)
 
 	g.Printf(`
 	if c.child == nil {
-		return _%s(comp, c, id)
+		return _%s(comp, c, id, table)
 	}
 	node := c
 	sibling := false
@@ -340,7 +430,7 @@ func (g *Generator) WhereClause(t *pqt.Table) {
 			} else {
 				comp.Dirty = false
 				comp.WriteString("(")
-				if err := _%s(comp, node, id); err != nil {
+				if err := _%s(comp, node, id, table); err != nil {
 					return err
 				}
 				comp.WriteString(")")
@@ -370,7 +460,7 @@ func (g *Generator) WhereClause(t *pqt.Table) {
 
 	g.Printf(`
 
-		func _%sCriteriaWhereClause(comp *Composer, c *%sCriteria, id int) (error) {`, name, name)
+		func _%sCriteriaWhereClause(comp *Composer, c *%sCriteria, id int, table string) (error) {`, name, name)
 ColumnsLoop:
 	for _, c := range t.Columns {
 		braces := 0
@@ -411,8 +501,8 @@ ColumnsLoop:
 				if !c.%s.IsZero() {`, pqtfmt.Public(c.Name))
 		}
 
-		g.Print(
-			`if comp.Dirty {
+		g.Print(`
+			if comp.Dirty {
 				comp.WriteString(" AND ")
 			}`)
 
@@ -475,12 +565,89 @@ ColumnsLoop:
 			pqtfmt.Public(c.Name),
 		)
 		closeBrace(g, braces)
+		if !c.IsDynamic {
+			g.arrayOperatorsWhereClause(c)
+			g.textSearchWhereClause(c)
+			g.jsonWhereClause(c)
+		}
 	}
+	g.relationshipWhereClause(t)
 	g.Print(`
 	return nil`)
 	closeBrace(g, 1)
 }
 
+func (g *Generator) arrayOperatorsWhereClause(c *pqt.Column) {
+	et, ok := g.arrayElemType(c)
+	if !ok {
+		return
+	}
+	column := pqtfmt.Public("table", c.Table.Name, "column", c.Name)
+	for _, op := range []struct{ suffix, operator string }{
+		{suffix: "contains", operator: " @> "},
+		{suffix: "overlap", operator: " && "},
+	} {
+		g.Printf(`
+			if c.%s.Valid {
+				if comp.Dirty {
+					comp.WriteString(" AND ")
+				}
+				if err := comp.WriteAlias(id); err != nil {
+					return err
+				}
+				if _, err := comp.WriteString(%s); err != nil {
+					return err
+				}
+				if _, err := comp.WriteString("%s"); err != nil {
+					return err
+				}
+				if err := comp.WritePlaceholder(); err != nil {
+					return err
+				}
+				comp.Add(c.%s)
+				comp.Dirty=true
+			}`,
+			pqtfmt.Public(c.Name, op.suffix),
+			column,
+			op.operator,
+			pqtfmt.Public(c.Name, op.suffix),
+		)
+	}
+
+	if et == "[]byte" {
+		g.Printf(`
+			if c.%s != nil {`, pqtfmt.Public(c.Name, "any"))
+	} else {
+		g.Printf(`
+			if c.%s.Valid {`, pqtfmt.Public(c.Name, "any"))
+	}
+	g.Printf(`
+			if comp.Dirty {
+				comp.WriteString(" AND ")
+			}
+			if err := comp.WritePlaceholder(); err != nil {
+				return err
+			}
+			if _, err := comp.WriteString(" = ANY("); err != nil {
+				return err
+			}
+			if err := comp.WriteAlias(id); err != nil {
+				return err
+			}
+			if _, err := comp.WriteString(%s); err != nil {
+				return err
+			}
+			if _, err := comp.WriteString(")"); err != nil {
+				return err
+			}
+			comp.Add(c.%s)
+			comp.Dirty=true
+		}`,
+		column,
+		pqtfmt.Public(c.Name, "any"),
+	)
+}
+
 func (g *Generator) JoinClause() {
 	g.Print(`
 	func joinClause(comp *Composer, jt JoinType, on string) (ok bool, err error) {
@@ -516,6 +683,40 @@ func (g *Generator) JoinClause() {
 	}`)
 }
 
+func (g *Generator) LockClause() {
+	g.Print(`
+func lockClause(comp *Composer, rl RowLock, of string) error {
+	if !rl.Actionable() {
+		return nil
+	}
+	if _, err := comp.WriteString(" "); err != nil {
+		return err
+	}
+	if _, err := comp.WriteString(rl.Strength.String()); err != nil {
+		return err
+	}
+	if of != "" {
+		if _, err := comp.WriteString(" OF "); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(of); err != nil {
+			return err
+		}
+	}
+	switch rl.Wait {
+	case LockSkipLocked:
+		if _, err := comp.WriteString(" SKIP LOCKED"); err != nil {
+			return err
+		}
+	case LockNoWait:
+		if _, err := comp.WriteString(" NOWAIT"); err != nil {
+			return err
+		}
+	}
+	return nil
+}`)
+}
+
 func (g *Generator) ScanRows(t *pqt.Table) {
 	entityName := pqtfmt.Public(t.Name)
 	funcName := pqtfmt.Public("scan", t.Name, "rows")
@@ -546,6 +747,31 @@ func (g *Generator) ScanRows(t *pqt.Table) {
 	}`)
 }
 
+// SchemaFuncs generates functions that allow to point repositories to a schema chosen at runtime.
+func (g *Generator) SchemaFuncs() {
+	g.Print(`
+// WithSchema returns table name qualified with the given schema instead of the one it was generated with,
+// e.g. Table: WithSchema(TableNews, "tenant") makes a repository query tenant.news.
+// Joined tables of the same schema follow the repository table.
+func WithSchema(table, schema string) string {
+	if i := strings.LastIndex(table, "."); i >= 0 {
+		table = table[i+1:]
+	}
+	if schema == "" {
+		return table
+	}
+	return schema + "." + table
+}
+
+// inSchemaOf returns table name qualified with the schema of the other table.
+func inSchemaOf(table, other string) string {
+	if i := strings.LastIndex(other, "."); i >= 0 {
+		return WithSchema(table, other[:i])
+	}
+	return WithSchema(table, "")
+}`)
+}
+
 func (g *Generator) Funcs() {
 	g.Print(`
 	// LogFunc represents function that can be passed into repository to log query result.
@@ -567,6 +793,15 @@ func (g *Generator) Interfaces() {
 }
 
 func (g *Generator) Statics() {
+	g.statics(true)
+}
+
+// RepositoryStatics works like Statics but without array types, so they can be generated into a separate package.
+func (g *Generator) RepositoryStatics() {
+	g.statics(false)
+}
+
+func (g *Generator) statics(arrays bool) {
 	g.Print(`
 const (
 	JoinInner = iota
@@ -604,13 +839,71 @@ func (jt JoinType) Actionable() bool {
 	}
 }
 
+const (
+	LockDoNot LockStrength = iota
+	LockForUpdate
+	LockForNoKeyUpdate
+	LockForShare
+	LockForKeyShare
+)
+
+// LockStrength represents strength of a row level lock acquired by SELECT statement.
+type LockStrength int
+
+func (ls LockStrength) String() string {
+	switch ls {
+	case LockForUpdate:
+		return "FOR UPDATE"
+	case LockForNoKeyUpdate:
+		return "FOR NO KEY UPDATE"
+	case LockForShare:
+		return "FOR SHARE"
+	case LockForKeyShare:
+		return "FOR KEY SHARE"
+	default:
+		return ""
+	}
+}
+
+// Actionable returns true if LockStrength is one of the known strengths except LockDoNot.
+func (ls LockStrength) Actionable() bool {
+	switch ls {
+	case LockForUpdate, LockForNoKeyUpdate, LockForShare, LockForKeyShare:
+		return true
+	default:
+		return false
+	}
+}
+
+const (
+	LockWait LockWaitPolicy = iota
+	LockSkipLocked
+	LockNoWait
+)
+
+// LockWaitPolicy determines what happens if a row cannot be locked immediately.
+type LockWaitPolicy int
+
+// RowLock represents locking clause that can be attached to a SELECT statement.
+// It can be used only within a transaction.
+type RowLock struct {
+	Strength LockStrength
+	Wait     LockWaitPolicy
+}
+
+// Actionable returns true if lock strength is set.
+func (rl RowLock) Actionable() bool {
+	return rl.Strength.Actionable()
+}
+
 // ErrorConstraint returns the error constraint of err if it was produced by the pq library.
 // Otherwise, it returns empty string.
 func ErrorConstraint(err error) string {
 	if err == nil {
 		return ""
 	}
-	if pqerr, ok := err.(*pq.Error); ok {
+	var pqerr *pq.Error
+	if errors.As(err, &pqerr) {
 		return pqerr.Constraint
 	}
 
@@ -620,8 +913,141 @@ func ErrorConstraint(err error) string {
 type RowOrder struct {
 	Name string
 	Descending bool
+}`)
+	if arrays {
+		g.ArrayTypes()
+	}
+	g.Print(`
+
+
+var (
+	// Space is a shorthand composition option that holds space.
+	Space = &CompositionOpts{
+		Joint: " ",
+	}
+	// And is a shorthand composition option that holds AND operator.
+	And = &CompositionOpts{
+		Joint: " AND ",
+	}
+	// Or is a shorthand composition option that holds OR operator.
+	Or = &CompositionOpts{
+		Joint: " OR ",
+	}
+	// Comma is a shorthand composition option that holds comma.
+	Comma = &CompositionOpts{
+		Joint: ", ",
+	}
+)
+
+// CompositionOpts is a container for modification that can be applied.
+type CompositionOpts struct {
+	Joint                         string
+	PlaceholderFuncs, SelectorFuncs []string
+	PlaceholderCast, SelectorCast   string
+	IsJSON                        bool
+	IsDynamic                     bool
+}
+
+// CompositionWriter is a simple wrapper for WriteComposition function.
+type CompositionWriter interface {
+	// WriteComposition is a function that allow custom struct type to be used as a part of criteria.
+	// It gives possibility to write custom query based on object that implements this interface.
+	WriteComposition(string, *Composer, *CompositionOpts) error
+}
+
+// Composer holds buffer, arguments and placeholders count.
+// In combination with external buffet can be also used to also generate sub-queries.
+// To do that simply write buffer to the parent buffer, composer will hold all arguments and remember number of last placeholder.
+type Composer struct {
+	buf     bytes.Buffer
+	args    []interface{}
+	counter int
+	Dirty   bool
+}
+
+// NewComposer allocates new Composer with inner slice of arguments of given size.
+func NewComposer(size int64) *Composer {
+	return &Composer{
+		counter: 1,
+		args:    make([]interface{}, 0, size),
+	}
+}
+
+// WriteString appends the contents of s to the query buffer, growing the buffer as
+// needed. The return value n is the length of s; err is always nil. If the
+// buffer becomes too large, WriteString will panic with bytes ErrTooLarge.
+func (c *Composer) WriteString(s string) (int, error) {
+	return c.buf.WriteString(s)
 }
 
+// Write implements io Writer interface.
+func (c *Composer) Write(b []byte) (int, error) {
+	return c.buf.Write(b)
+}
+
+// Read implements io Reader interface.
+func (c *Composer) Read(b []byte) (int, error) {
+	return c.buf.Read(b)
+}
+
+// ResetBuf resets internal buffer.
+func (c *Composer) ResetBuf() {
+	c.buf.Reset()
+}
+
+// String implements fmt Stringer interface.
+func (c *Composer) String() string {
+	return c.buf.String()
+}
+
+// WritePlaceholder writes appropriate placeholder to the query buffer based on current state of the composer.
+func (c *Composer) WritePlaceholder() error {
+	if _, err := c.buf.WriteString("$"); err != nil {
+		return err
+	}
+	if _, err := c.buf.WriteString(strconv.Itoa(c.counter)); err != nil {
+		return err
+	}
+
+	c.counter++
+	return nil
+}
+
+func (c *Composer) WriteAlias(i int) error {
+	if i < 0 {
+		return nil
+	}
+	if _, err := c.buf.WriteString("t"); err != nil {
+		return err
+	}
+	if _, err := c.buf.WriteString(strconv.Itoa(i)); err != nil {
+		return err
+	}
+	if _, err := c.buf.WriteString("."); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Len returns number of arguments.
+func (c *Composer) Len() int {
+	return c.counter
+}
+
+// Add appends list with new element.
+func (c *Composer) Add(arg interface{}) {
+	c.args = append(c.args, arg)
+}
+
+// Args returns all arguments stored as a slice.
+func (c *Composer) Args() []interface{} {
+	return c.args
+}`)
+}
+
+func (g *Generator) ArrayTypes() {
+	g.Print(`
+
 type NullInt64Array struct {
 	pq.Int64Array
 	Valid  bool
@@ -888,131 +1314,6 @@ func (a JSONArrayFloat64) Value() (driver.Value, error) {
 	}
 
 	return buffer.Bytes(), nil
-}
-
-
-var (
-	// Space is a shorthand composition option that holds space.
-	Space = &CompositionOpts{
-		Joint: " ",
-	}
-	// And is a shorthand composition option that holds AND operator.
-	And = &CompositionOpts{
-		Joint: " AND ",
-	}
-	// Or is a shorthand composition option that holds OR operator.
-	Or = &CompositionOpts{
-		Joint: " OR ",
-	}
-	// Comma is a shorthand composition option that holds comma.
-	Comma = &CompositionOpts{
-		Joint: ", ",
-	}
-)
-
-// CompositionOpts is a container for modification that can be applied.
-type CompositionOpts struct {
-	Joint                         string
-	PlaceholderFuncs, SelectorFuncs []string
-	PlaceholderCast, SelectorCast   string
-	IsJSON                        bool
-	IsDynamic                     bool
-}
-
-// CompositionWriter is a simple wrapper for WriteComposition function.
-type CompositionWriter interface {
-	// WriteComposition is a function that allow custom struct type to be used as a part of criteria.
-	// It gives possibility to write custom query based on object that implements this interface.
-	WriteComposition(string, *Composer, *CompositionOpts) error
-}
-
-// Composer holds buffer, arguments and placeholders count.
-// In combination with external buffet can be also used to also generate sub-queries.
-// To do that simply write buffer to the parent buffer, composer will hold all arguments and remember number of last placeholder.
-type Composer struct {
-	buf     bytes.Buffer
-	args    []interface{}
-	counter int
-	Dirty   bool
-}
-
-// NewComposer allocates new Composer with inner slice of arguments of given size.
-func NewComposer(size int64) *Composer {
-	return &Composer{
-		counter: 1,
-		args:    make([]interface{}, 0, size),
-	}
-}
-
-// WriteString appends the contents of s to the query buffer, growing the buffer as
-// needed. The return value n is the length of s; err is always nil. If the
-// buffer becomes too large, WriteString will panic with bytes ErrTooLarge.
-func (c *Composer) WriteString(s string) (int, error) {
-	return c.buf.WriteString(s)
-}
-
-// Write implements io Writer interface.
-func (c *Composer) Write(b []byte) (int, error) {
-	return c.buf.Write(b)
-}
-
-// Read implements io Reader interface.
-func (c *Composer) Read(b []byte) (int, error) {
-	return c.buf.Read(b)
-}
-
-// ResetBuf resets internal buffer.
-func (c *Composer) ResetBuf() {
-	c.buf.Reset()
-}
-
-// String implements fmt Stringer interface.
-func (c *Composer) String() string {
-	return c.buf.String()
-}
-
-// WritePlaceholder writes appropriate placeholder to the query buffer based on current state of the composer.
-func (c *Composer) WritePlaceholder() error {
-	if _, err := c.buf.WriteString("$"); err != nil {
-		return err
-	}
-	if _, err := c.buf.WriteString(strconv.Itoa(c.counter)); err != nil {
-		return err
-	}
-
-	c.counter++
-	return nil
-}
-
-func (c *Composer) WriteAlias(i int) error {
-	if i < 0 {
-		return nil
-	}
-	if _, err := c.buf.WriteString("t"); err != nil {
-		return err
-	}
-	if _, err := c.buf.WriteString(strconv.Itoa(i)); err != nil {
-		return err
-	}
-	if _, err := c.buf.WriteString("."); err != nil {
-		return err
-	}
-	return nil
-}
-
-// Len returns number of arguments.
-func (c *Composer) Len() int {
-	return c.counter
-}
-
-// Add appends list with new element.
-func (c *Composer) Add(arg interface{}) {
-	c.args = append(c.args, arg)
-}
-
-// Args returns all arguments stored as a slice.
-func (c *Composer) Args() []interface{} {
-	return c.args
 }`)
 }
 
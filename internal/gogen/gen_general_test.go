@@ -119,6 +119,55 @@ child, sibling, parent *ExampleCriteria
 			table: table(pqt.NewColumn("a", pqt.TypeIntegerBig(), pqt.WithNotNull())),
 			exp:   expected(testColumn{"A", "sql.NullInt64"}),
 		},
+		"column-uuid-array": {
+			table: table(pqt.NewColumn("a", pqt.TypeArray(pqt.TypeUUID()))),
+			exp: `
+type ExampleCriteria struct {
+	A NullStringArray
+	// AContains matches rows where a contains all given elements (@>).
+	AContains NullStringArray
+	// AOverlap matches rows where a has any elements in common with given ones (&&).
+	AOverlap NullStringArray
+	// AAny matches rows where any element of a equals given value (= ANY).
+	AAny                   sql.NullString
+	operator               string
+	child, sibling, parent *ExampleCriteria
+}`,
+		},
+		"column-text-search": {
+			table: table(pqt.NewColumn("a", pqt.TypeTSVector(), pqt.WithTextSearch("english"))),
+			exp: `
+type ExampleCriteria struct {
+	A sql.NullString
+	// ASearch matches rows which a matches given query (@@).
+	ASearch                *TextSearch
+	operator               string
+	child, sibling, parent *ExampleCriteria
+}`,
+		},
+		"column-jsonb": {
+			table: table(pqt.NewColumn("a", pqt.TypeJSONB())),
+			exp: `
+type ExampleCriteria struct {
+	A []byte
+	// AContains matches rows where a contains given JSON document (@>).
+	AContains []byte
+	// AHasKey matches rows where a has given top-level key (?).
+	AHasKey sql.NullString
+	// AHasAnyKeys matches rows where a has any of given top-level keys (?|).
+	AHasAnyKeys pq.StringArray
+	// AHasAllKeys matches rows where a has all of given top-level keys (?&).
+	AHasAllKeys pq.StringArray
+	// APath matches rows where all predicates on values extracted from a are true (->>, #>>).
+	APath []JSONPredicate
+	// AMatch matches rows where jsonpath predicate check on a returns true (@@).
+	AMatch sql.NullString
+	// AExists matches rows where jsonpath returns any item for a (@?).
+	AExists                sql.NullString
+	operator               string
+	child, sibling, parent *ExampleCriteria
+}`,
+		},
 		"dynamic": {
 			table: func() *pqt.Table {
 				age := pqt.NewColumn("age", pqt.TypeInteger())
@@ -187,6 +236,10 @@ type T2RepositoryBase struct {
 	Columns []string
 	DB      *sql.DB
 	Log     LogFunc
+	// Validate, if true, makes insert, update and upsert methods validate entities and patches before querying the database.
+	Validate bool
+	// StmtCache, if set, is used to prepare queries of fixed shape (insert, delete and find by key) once and reuse them.
+	StmtCache *StmtCache
 }`)
 }
 
@@ -215,6 +268,25 @@ var TableT2Columns = []string{
 }`)
 }
 
+func TestGenerator_Rows(t *testing.T) {
+	status := pqt.NewTable("status").
+		AddColumn(pqt.NewColumn("id", pqt.TypeIntegerSmall(), pqt.WithPrimaryKey())).
+		AddColumn(pqt.NewColumn("name", pqt.TypeText(), pqt.WithNotNull())).
+		AddRow("active", map[string]interface{}{"id": 1, "name": "active"}).
+		AddRow("", map[string]interface{}{"id": 2, "name": "unnamed"}).
+		AddRow("closed", map[string]interface{}{"id": 3, "name": "closed"})
+
+	g := &gogen.Generator{}
+	g.Rows(status)
+	testutil.AssertOutput(t, g.Printer, `
+const (
+	// StatusActiveID is id of active static row.
+	StatusActiveID int16 = 1
+	// StatusClosedID is id of closed static row.
+	StatusClosedID int16 = 3
+)`)
+}
+
 func TestGenerator_Constraints(t *testing.T) {
 	name := pqt.NewColumn("name", pqt.TypeText(), pqt.WithNotNull(), pqt.WithIndex())
 	description := pqt.NewColumn("description", pqt.TypeText(), pqt.WithColumnShortName("desc"))
@@ -256,8 +328,9 @@ func TestGenerator_FindExpr(t *testing.T) {
 type T2FindExpr struct {
 	Where         *T2Criteria
 	Offset, Limit int64
-	Columns       []string
+	Columns       []T2Column
 	OrderBy       []RowOrder
+	Lock          RowLock
 	JoinT1        *T1Join
 }`)
 }
@@ -322,6 +395,19 @@ type ExamplePatch struct {`)
 			),
 			exp: expected(testColumn{"B", "sql.NullBool"}),
 		},
+		"column-jsonb": {
+			table: table(pqt.NewColumn("a", pqt.TypeJSONB())),
+			exp: `
+type ExamplePatch struct {
+	A []byte
+	// ASet replaces values at given paths of a (jsonb_set).
+	ASet []JSONSet
+	// AMerge is concatenated with a, top-level keys are overwritten (||).
+	AMerge []byte
+	// ARemove lists top-level keys removed from a (-).
+	ARemove pq.StringArray
+}`,
+		},
 		"column-bool": {
 			table: table(pqt.NewColumn("a", pqt.TypeBool())),
 			exp:   expected(testColumn{"A", "sql.NullBool"}),
@@ -358,6 +444,14 @@ type ExamplePatch struct {`)
 			}(),
 			exp: expected(testColumn{"Age", "*int32"}, testColumn{"Dynamic", "*int32"}),
 		},
+		"generated": {
+			table: table(
+				pqt.NewColumn("a", pqt.TypeIntegerBig(), pqt.WithIdentity(pqt.IdentityAlways)),
+				pqt.NewColumn("b", pqt.TypeIntegerBig(), pqt.WithIdentity(pqt.IdentityByDefault)),
+				pqt.NewColumn("c", pqt.TypeIntegerBig(), pqt.WithGenerated("b * 2")),
+			),
+			exp: expected(testColumn{"B", "sql.NullInt64"}),
+		},
 	}
 
 	for hint, c := range cases {
@@ -452,9 +546,11 @@ type T1Criteria struct {`
 }`
 
 		res += fmt.Sprintf(`
-func T1CriteriaWhereClause(comp *Composer, c *T1Criteria, id int) error {
+// T1CriteriaWhereClause writes conditions of the criteria tree into the composer.
+// The id is the alias of the table named table, tables of related rows are resolved relative to its schema.
+func T1CriteriaWhereClause(comp *Composer, c *T1Criteria, id int, table string) error {
 	if c.child == nil {
-		return _T1CriteriaWhereClause(comp, c, id)
+		return _T1CriteriaWhereClause(comp, c, id, table)
 	}
 	node := c
 	sibling := false
@@ -469,7 +565,7 @@ func T1CriteriaWhereClause(comp *Composer, c *T1Criteria, id int) error {
 			} else {
 				comp.Dirty = false
 				comp.WriteString("(")
-				if err := _T1CriteriaWhereClause(comp, node, id); err != nil {
+				if err := _T1CriteriaWhereClause(comp, node, id, table); err != nil {
 					return err
 				}
 				comp.WriteString(")")
@@ -509,7 +605,7 @@ func T1CriteriaWhereClause(comp *Composer, c *T1Criteria, id int) error {
 			hint: "sql-null-type",
 			col:  pqt.NewColumn("xyz", pqt.TypeIntegerBig()),
 			exp: exp("sql.NullInt64", `
-func _T1CriteriaWhereClause(comp *Composer, c *T1Criteria, id int) error {
+func _T1CriteriaWhereClause(comp *Composer, c *T1Criteria, id int, table string) error {
 	if c.Xyz.Valid {
 		if comp.Dirty {
 			comp.WriteString(" AND ")
@@ -536,7 +632,7 @@ func _T1CriteriaWhereClause(comp *Composer, c *T1Criteria, id int) error {
 			hint: "pointer",
 			col:  pqt.NewColumn("xyz", pqt.TypeInteger()),
 			exp: exp("*int32", `
-func _T1CriteriaWhereClause(comp *Composer, c *T1Criteria, id int) error {
+func _T1CriteriaWhereClause(comp *Composer, c *T1Criteria, id int, table string) error {
 	if c.Xyz != nil {
 		if comp.Dirty {
 			comp.WriteString(" AND ")
@@ -563,7 +659,7 @@ func _T1CriteriaWhereClause(comp *Composer, c *T1Criteria, id int) error {
 			hint: "non-pointer-time",
 			col:  pqt.NewColumn("xyz", pqtgo.TypeCustom(time.Now(), time.Now(), time.Now())),
 			exp: exp("time.Time", `
-func _T1CriteriaWhereClause(comp *Composer, c *T1Criteria, id int) error {
+func _T1CriteriaWhereClause(comp *Composer, c *T1Criteria, id int, table string) error {
 	if !c.Xyz.IsZero() {
 		if comp.Dirty {
 			comp.WriteString(" AND ")
@@ -590,7 +686,7 @@ func _T1CriteriaWhereClause(comp *Composer, c *T1Criteria, id int) error {
 			hint: "nullable-time",
 			col:  pqt.NewColumn("xyz", pqt.TypeTimestampTZ(), pqt.WithNotNull()),
 			exp: exp("pq.NullTime", `
-func _T1CriteriaWhereClause(comp *Composer, c *T1Criteria, id int) error {
+func _T1CriteriaWhereClause(comp *Composer, c *T1Criteria, id int, table string) error {
 	if c.Xyz.Valid {
 		if comp.Dirty {
 			comp.WriteString(" AND ")
@@ -617,7 +713,7 @@ func _T1CriteriaWhereClause(comp *Composer, c *T1Criteria, id int) error {
 			hint: "unknown",
 			col:  pqt.NewColumn("xyz", pqtgo.TypeCustom(struct{}{}, struct{}{}, nil)),
 			exp: exp("none", `
-func _T1CriteriaWhereClause(comp *Composer, c *T1Criteria, id int) error {
+func _T1CriteriaWhereClause(comp *Composer, c *T1Criteria, id int, table string) error {
     			return nil
     		}`),
 		},
@@ -640,7 +736,7 @@ func _T1CriteriaWhereClause(comp *Composer, c *T1Criteria, id int) error {
 				return dyn
 			}(),
 			exp: exp("sql.NullInt64", `
-func _T1CriteriaWhereClause(comp *Composer, c *T1Criteria, id int) error {
+func _T1CriteriaWhereClause(comp *Composer, c *T1Criteria, id int, table string) error {
 	if c.Xyz.Valid {
 		if comp.Dirty {
 			comp.WriteString(" AND ")
@@ -704,6 +800,42 @@ func TestGenerator_JoinClause(t *testing.T) {
 	}
 }
 
+func TestGenerator_LockClause(t *testing.T) {
+	g := &gogen.Generator{}
+	g.LockClause()
+	testutil.AssertOutput(t, g.Printer, `
+func lockClause(comp *Composer, rl RowLock, of string) error {
+	if !rl.Actionable() {
+		return nil
+	}
+	if _, err := comp.WriteString(" "); err != nil {
+		return err
+	}
+	if _, err := comp.WriteString(rl.Strength.String()); err != nil {
+		return err
+	}
+	if of != "" {
+		if _, err := comp.WriteString(" OF "); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(of); err != nil {
+			return err
+		}
+	}
+	switch rl.Wait {
+	case LockSkipLocked:
+		if _, err := comp.WriteString(" SKIP LOCKED"); err != nil {
+			return err
+		}
+	case LockNoWait:
+		if _, err := comp.WriteString(" NOWAIT"); err != nil {
+			return err
+		}
+	}
+	return nil
+}`)
+}
+
 func TestGenerator_ScanRows(t *testing.T) {
 	t1 := pqt.NewTable("t1")
 	t2 := pqt.NewTable("t2").
@@ -720,6 +852,8 @@ type T2Entity struct {
 	Example sql.NullString
 	// T1 ...
 	T1 *T1Entity
+	// fields holds fields loaded by a projection, see Loaded.
+	fields T2Fields
 }
 
 // ScanT2Rows helps to scan rows straight to the slice of entities.
@@ -751,6 +885,10 @@ func TestGenerator_withNoArgument(t *testing.T) {
 		"Statics",
 		"RunInTransaction",
 		"JoinClause",
+		"LockClause",
+		"Listener",
+		"ArrayTypes",
+		"RepositoryStatics",
 	}
 
 	for _, c := range cases {
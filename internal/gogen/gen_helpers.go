@@ -13,10 +13,100 @@ import (
 
 func (g *Generator) RunInTransaction() {
 	g.Printf(`
-func RunInTransaction(db *sql.DB, ctx context.Context, f func(tx *sql.Tx) error, attempts int) (err error) {
+// TxOptions configures transactions started by BeginTx and RunInTransaction.
+type TxOptions struct {
+	// Isolation is isolation level of the transaction, if zero, the database default is used.
+	Isolation sql.IsolationLevel
+	// ReadOnly makes the transaction read-only.
+	ReadOnly bool
+	// Backoff returns delay before n-th retry of RunInTransaction, if nil, the transaction is retried immediately.
+	Backoff func(n int) time.Duration
+}
+
+// TxOption configures transactions started by BeginTx and RunInTransaction.
+type TxOption func(*TxOptions)
+
+// WithIsolation sets isolation level of the transaction.
+func WithIsolation(level sql.IsolationLevel) TxOption {
+	return func(o *TxOptions) {
+		o.Isolation = level
+	}
+}
+
+// WithReadOnly makes the transaction read-only.
+func WithReadOnly() TxOption {
+	return func(o *TxOptions) {
+		o.ReadOnly = true
+	}
+}
+
+// WithBackoff sets function that returns delay before n-th retry of the transaction, see ExponentialBackoff.
+func WithBackoff(backoff func(n int) time.Duration) TxOption {
+	return func(o *TxOptions) {
+		o.Backoff = backoff
+	}
+}
+
+// ExponentialBackoff returns backoff that starts with base delay and doubles it before each following retry, but not above max.
+// Random jitter of up to given fraction of the delay is added, so transactions that failed together do not retry together.
+func ExponentialBackoff(base, max time.Duration, jitter float64) func(n int) time.Duration {
+	return func(n int) time.Duration {
+		d := base
+		for i := 1; i < n && d < max; i++ {
+			d *= 2
+		}
+		if d > max {
+			d = max
+		}
+		if jitter > 0 {
+			d += time.Duration(rand.Float64() * jitter * float64(d))
+		}
+		return d
+	}
+}
+
+func newTxOptions(opts []TxOption) *TxOptions {
+	o := &TxOptions{}
+	for _, opt := range opts {
+		opt(o)
+	}
+	return o
+}
+
+func (o *TxOptions) sql() *sql.TxOptions {
+	return &sql.TxOptions{Isolation: o.Isolation, ReadOnly: o.ReadOnly}
+}
+
+// IsRetryable returns true if the transaction that failed with given error can succeed if run again.
+// It is true for serialization failures (40001), deadlocks (40P01) and RetryTransaction.
+func IsRetryable(err error) bool {
+	if errors.Is(err, RetryTransaction) {
+		return true
+	}
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) {
+		switch pqErr.Code {
+		case "40001", "40P01":
+			return true
+		}
+	}
+	return false
+}
+
+// RunInTransaction runs given function within a transaction, which is committed if the function returns no error, otherwise it is rolled back.
+// The transaction is run up to attempts times, as long as it fails with an error IsRetryable reports.
+func RunInTransaction(db *sql.DB, ctx context.Context, f func(tx *sql.Tx) error, attempts int, opts ...TxOption) (err error) {
+	o := newTxOptions(opts)
 	for n := 0; n < attempts; n++ {
+		if n > 0 && o.Backoff != nil {
+			select {
+			case <-time.After(o.Backoff(n)):
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		}
 		if err = func () error {
-			tx, err := db.BeginTx(ctx, nil)
+			tx, err := db.BeginTx(ctx, o.sql())
 			if err != nil {
 				return err
 			}
@@ -36,12 +126,44 @@ func RunInTransaction(db *sql.DB, ctx context.Context, f func(tx *sql.Tx) error,
 			}
 
 			return tx.Commit()
-		}(); err == RetryTransaction {
+		}(); IsRetryable(err) {
 			continue
 		}
 		return err
 	}
 	return err
+}
+
+var savepointSeq uint64
+
+func savepoint(ctx context.Context, tx *sql.Tx, name string) error {
+	_, err := tx.ExecContext(ctx, "SAVEPOINT "+pq.QuoteIdentifier(name))
+	return err
+}
+
+func rollbackToSavepoint(ctx context.Context, tx *sql.Tx, name string) error {
+	_, err := tx.ExecContext(ctx, "ROLLBACK TO SAVEPOINT "+pq.QuoteIdentifier(name))
+	return err
+}
+
+func releaseSavepoint(ctx context.Context, tx *sql.Tx, name string) error {
+	_, err := tx.ExecContext(ctx, "RELEASE SAVEPOINT "+pq.QuoteIdentifier(name))
+	return err
+}
+
+// RunInSavepoint runs given function within a savepoint of the tx transaction.
+// If the function returns an error, only changes it made are rolled back and the transaction can be continued,
+// otherwise the savepoint is released.
+func RunInSavepoint(ctx context.Context, tx *sql.Tx, f func(tx *sql.Tx) error) error {
+	name := "pqt_savepoint_" + strconv.FormatUint(atomic.AddUint64(&savepointSeq, 1), 10)
+	if err := savepoint(ctx, tx, name); err != nil {
+		return err
+	}
+	if err := f(tx); err != nil {
+		_ = rollbackToSavepoint(ctx, tx, name)
+		return err
+	}
+	return releaseSavepoint(ctx, tx, name)
 }`)
 }
 
@@ -50,7 +172,7 @@ func (g *Generator) isArray(c *pqt.Column, m int32) bool {
 		return true
 	}
 
-	return g.isType(c, m, "pq.StringArray", "pq.Int64Array", "pq.BoolArray", "pq.Float64Array", "pq.ByteaArray", "pq.GenericArray")
+	return g.isType(c, m, "pq.StringArray", "pq.Int64Array", "pq.BoolArray", "pq.Float64Array", "pq.ByteaArray", "pq.GenericArray", "TimeArray")
 }
 
 func (g *Generator) columnType(c *pqt.Column, m int32) string {
@@ -111,9 +233,36 @@ func (g *Generator) isNullable(c *pqt.Column, m int32) bool {
 		"NullByteaArray",
 		"NullStringArray",
 		"NullBoolArray",
+		"NullTimeArray",
+		"Interval",
+		"Int64Range",
+		"Float64Range",
+		"TimeRange",
+		"Point",
 	)
 }
 
+// arrayElemType returns criteria type of an element of an array column.
+// It is used by the array operators that compare against a single element.
+func (g *Generator) arrayElemType(c *pqt.Column) (string, bool) {
+	switch g.columnType(c, pqtgo.ModeCriteria) {
+	case "NullInt64Array":
+		return "sql.NullInt64", true
+	case "NullFloat64Array":
+		return "sql.NullFloat64", true
+	case "NullBoolArray":
+		return "sql.NullBool", true
+	case "NullStringArray":
+		return "sql.NullString", true
+	case "NullTimeArray":
+		return "pq.NullTime", true
+	case "NullByteaArray":
+		return "[]byte", true
+	default:
+		return "", false
+	}
+}
+
 func (g *Generator) canBeNil(c *pqt.Column, m int32) bool {
 	if tp, ok := c.Type.(pqt.MappableType); ok {
 		for _, mapto := range tp.Mapping {
@@ -157,54 +306,73 @@ func (g *Generator) selectList(t *pqt.Table, nb int) {
 		if i != 0 {
 			g.Print(", ")
 		}
-		if c.IsDynamic {
-			g.Printf("%s(", c.Func.Name)
-			for i, arg := range c.Func.Args {
-				if arg.Type != c.Columns[i].Type {
-					fmt.Printf("wrong function (%s) argument type, expected %v but got %v\n", c.Func.Name, arg.Type, c.Columns[i].Type)
-				}
-				if i != 0 {
-					g.Print(", ")
-				}
-				if nb > -1 {
-					g.Printf("t%d.%s", nb, c.Columns[i].Name)
-				} else {
-					g.Printf("%s", c.Columns[i].Name)
-				}
+		g.selectColumn(c, nb)
+	}
+}
+
+// selectColumn writes select list expression of the column, qualified with t<nb> alias unless nb is negative.
+func (g *Generator) selectColumn(c *pqt.Column, nb int) {
+	if c.IsDynamic {
+		g.Printf("%s(", c.Func.Name)
+		for i, arg := range c.Func.Args {
+			if arg.Type != c.Columns[i].Type {
+				fmt.Printf("wrong function (%s) argument type, expected %v but got %v\n", c.Func.Name, arg.Type, c.Columns[i].Type)
+			}
+			if i != 0 {
+				g.Print(", ")
 			}
-			g.Printf(") AS %s", c.Name)
-		} else {
 			if nb > -1 {
-				g.Printf("t%d.%s", nb, c.Name)
+				g.Printf("t%d.%s", nb, c.Columns[i].Name)
 			} else {
-				g.Printf("%s", c.Name)
+				g.Printf("%s", c.Columns[i].Name)
 			}
 		}
+		g.Printf(") AS %s", c.Name)
+	} else {
+		if nb > -1 {
+			g.Printf("t%d.%s", nb, c.Name)
+		} else {
+			g.Printf("%s", c.Name)
+		}
+	}
+}
+
+// isInsertable returns false for columns which value is always provided by the database.
+func isInsertable(c *pqt.Column) bool {
+	if c.IsDynamic {
+		return false
+	}
+	switch c.Type {
+	case pqt.TypeSerial(), pqt.TypeSerialBig(), pqt.TypeSerialSmall():
+		return false
 	}
+	// Identity and sequence columns behave like serial ones, database provides the value.
+	return !c.IsGeneratedAlways() && c.Identity == "" && c.Sequence == nil
+}
+
+// insertConditions returns conditions that entity field has to meet to be inserted.
+// If none is returned, the field is always inserted.
+func (g *Generator) insertConditions(c *pqt.Column) []string {
+	var conds []string
+	if g.canBeNil(c, pqtgo.ModeDefault) {
+		conds = append(conds, fmt.Sprintf("e.%s != nil", pqtfmt.Public(c.Name)))
+	}
+	if g.isNullable(c, pqtgo.ModeDefault) {
+		conds = append(conds, fmt.Sprintf("e.%s.Valid", pqtfmt.Public(c.Name)))
+	}
+	if g.isType(c, pqtgo.ModeDefault, "time.Time") {
+		conds = append(conds, fmt.Sprintf("!e.%s.IsZero()", pqtfmt.Public(c.Name)))
+	}
+	return conds
 }
 
 func (g *Generator) generateRepositoryInsertClause(c *pqt.Column, sel string) {
 	braces := 0
 
-	switch c.Type {
-	case pqt.TypeSerial(), pqt.TypeSerialBig(), pqt.TypeSerialSmall():
-		return
-	default:
-		if g.canBeNil(c, pqtgo.ModeDefault) {
-			g.Printf(`
-					if e.%s != nil {`,
-				pqtfmt.Public(c.Name),
-			)
-			braces++
-		}
-		if g.isNullable(c, pqtgo.ModeDefault) {
+	if isInsertable(c) {
+		for _, cond := range g.insertConditions(c) {
 			g.Printf(`
-					if e.%s.Valid {`, pqtfmt.Public(c.Name))
-			braces++
-		}
-		if g.isType(c, pqtgo.ModeDefault, "time.Time") {
-			g.Printf(`
-					if !e.%s.IsZero() {`, pqtfmt.Public(c.Name))
+					if %s {`, cond)
 			braces++
 		}
 		g.Printf(strings.Replace(`
@@ -236,7 +404,7 @@ func (g *Generator) generateRepositoryInsertClause(c *pqt.Column, sel string) {
 }
 
 func (g *Generator) generateRepositorySetClause(c *pqt.Column, sel string) {
-	if c.PrimaryKey {
+	if isPrimaryKey(c) || c.IsGeneratedAlways() {
 		return
 	}
 	for _, plugin := range g.Plugins {
@@ -318,6 +486,7 @@ func (g *Generator) generateRepositorySetClause(c *pqt.Column, sel string) {
 	}
 
 	closeBrace(g, braces)
+	g.jsonSetClause(c, sel)
 }
 
 func (g *Generator) scanJoinableRelationships(t *pqt.Table, sel string) {
@@ -351,6 +520,35 @@ func (g *Generator) scanJoinableRelationships(t *pqt.Table, sel string) {
 	}
 }
 
+// EntityField describes a single field of the generated entity.
+// Field corresponds either to a column or to a relationship.
+type EntityField struct {
+	Name, Type   string
+	ReadOnly     bool
+	Column       *pqt.Column
+	Relationship *pqt.Relationship
+}
+
+// EntityFields returns fields of the generated entity in the same order they are generated.
+func (g *Generator) EntityFields(t *pqt.Table) []EntityField {
+	var fields []EntityField
+	for f := range g.entityPropertiesGenerator(t) {
+		fields = append(fields, EntityField{
+			Name:         f.Name,
+			Type:         f.Type,
+			ReadOnly:     f.ReadOnly,
+			Column:       f.Column,
+			Relationship: f.Relationship,
+		})
+	}
+	return fields
+}
+
+// ColumnType returns Go type of the column in given mode, plugins are taken into account.
+func (g *Generator) ColumnType(c *pqt.Column, m int32) string {
+	return g.columnType(c, m)
+}
+
 // entityPropertiesGenerator produces struct field definition for each column and relationship defined on a table.
 // It thread differently relationship differently based on ownership.
 func (g *Generator) entityPropertiesGenerator(t *pqt.Table) chan structField {
@@ -359,29 +557,29 @@ func (g *Generator) entityPropertiesGenerator(t *pqt.Table) chan structField {
 	go func(out chan structField) {
 		for _, c := range t.Columns {
 			if t := g.columnType(c, pqtgo.ModeDefault); t != "<nil>" {
-				out <- structField{Name: pqtfmt.Public(c.Name), Type: t, ReadOnly: c.IsDynamic}
+				out <- structField{Name: pqtfmt.Public(c.Name), Type: t, ReadOnly: c.IsDynamic || c.IsGeneratedAlways(), Column: c}
 			}
 		}
 
 		for _, r := range t.OwnedRelationships {
 			switch r.Type {
 			case pqt.RelationshipTypeOneToMany:
-				out <- structField{Name: pqtfmt.Public(or(r.InversedName, r.InversedTable.Name+"s")), Type: fmt.Sprintf("[]*%sEntity", pqtfmt.Public(r.InversedTable.Name))}
+				out <- structField{Name: pqtfmt.Public(or(r.InversedName, r.InversedTable.Name+"s")), Type: fmt.Sprintf("[]*%sEntity", pqtfmt.Public(r.InversedTable.Name)), Relationship: r}
 			case pqt.RelationshipTypeOneToOne:
-				out <- structField{Name: pqtfmt.Public(or(r.InversedName, r.InversedTable.Name)), Type: fmt.Sprintf("*%sEntity", pqtfmt.Public(r.InversedTable.Name))}
+				out <- structField{Name: pqtfmt.Public(or(r.InversedName, r.InversedTable.Name)), Type: fmt.Sprintf("*%sEntity", pqtfmt.Public(r.InversedTable.Name)), Relationship: r}
 			case pqt.RelationshipTypeManyToOne:
-				out <- structField{Name: pqtfmt.Public(or(r.InversedName, r.InversedTable.Name)), Type: fmt.Sprintf("*%sEntity", pqtfmt.Public(r.InversedTable.Name))}
+				out <- structField{Name: pqtfmt.Public(or(r.InversedName, r.InversedTable.Name)), Type: fmt.Sprintf("*%sEntity", pqtfmt.Public(r.InversedTable.Name)), Relationship: r}
 			}
 		}
 
 		for _, r := range t.InversedRelationships {
 			switch r.Type {
 			case pqt.RelationshipTypeOneToMany:
-				out <- structField{Name: pqtfmt.Public(or(r.OwnerName, r.OwnerTable.Name)), Type: fmt.Sprintf("*%sEntity", pqtfmt.Public(r.OwnerTable.Name))}
+				out <- structField{Name: pqtfmt.Public(or(r.OwnerName, r.OwnerTable.Name)), Type: fmt.Sprintf("*%sEntity", pqtfmt.Public(r.OwnerTable.Name)), Relationship: r}
 			case pqt.RelationshipTypeOneToOne:
-				out <- structField{Name: pqtfmt.Public(or(r.OwnerName, r.OwnerTable.Name)), Type: fmt.Sprintf("*%sEntity", pqtfmt.Public(r.OwnerTable.Name))}
+				out <- structField{Name: pqtfmt.Public(or(r.OwnerName, r.OwnerTable.Name)), Type: fmt.Sprintf("*%sEntity", pqtfmt.Public(r.OwnerTable.Name)), Relationship: r}
 			case pqt.RelationshipTypeManyToOne:
-				out <- structField{Name: pqtfmt.Public(or(r.OwnerName, r.OwnerTable.Name+"s")), Type: fmt.Sprintf("[]*%sEntity", pqtfmt.Public(r.OwnerTable.Name))}
+				out <- structField{Name: pqtfmt.Public(or(r.OwnerName, r.OwnerTable.Name+"s")), Type: fmt.Sprintf("[]*%sEntity", pqtfmt.Public(r.OwnerTable.Name)), Relationship: r}
 			}
 		}
 
@@ -392,9 +590,9 @@ func (g *Generator) entityPropertiesGenerator(t *pqt.Table) chan structField {
 
 			switch {
 			case r.OwnerTable == t:
-				out <- structField{Name: pqtfmt.Public(or(r.InversedName, r.InversedTable.Name+"s")), Type: fmt.Sprintf("[]*%sEntity", pqtfmt.Public(r.InversedTable.Name))}
+				out <- structField{Name: pqtfmt.Public(or(r.InversedName, r.InversedTable.Name+"s")), Type: fmt.Sprintf("[]*%sEntity", pqtfmt.Public(r.InversedTable.Name)), Relationship: r}
 			case r.InversedTable == t:
-				out <- structField{Name: pqtfmt.Public(or(r.OwnerName, r.OwnerTable.Name+"s")), Type: fmt.Sprintf("[]*%sEntity", pqtfmt.Public(r.OwnerTable.Name))}
+				out <- structField{Name: pqtfmt.Public(or(r.OwnerName, r.OwnerTable.Name+"s")), Type: fmt.Sprintf("[]*%sEntity", pqtfmt.Public(r.OwnerTable.Name)), Relationship: r}
 			}
 		}
 
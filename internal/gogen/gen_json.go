@@ -0,0 +1,319 @@
+package gogen
+
+import (
+	"github.com/piotrkowalczuk/pqt"
+	"github.com/piotrkowalczuk/pqt/pqtfmt"
+	"github.com/piotrkowalczuk/pqt/pqtgo"
+)
+
+// isJSONB returns true if JSONB operators can be generated for the column.
+// Columns which representation is changed by a plugin are skipped.
+func (g *Generator) isJSONB(c *pqt.Column) bool {
+	return !c.IsDynamic && c.Type == pqt.TypeJSONB() && g.columnType(c, pqtgo.ModeCriteria) == "[]byte"
+}
+
+func hasJSONB(s *pqt.Schema) bool {
+	for _, t := range s.Tables {
+		for _, c := range t.Columns {
+			if !c.IsDynamic && c.Type == pqt.TypeJSONB() {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (g *Generator) jsonTypes() {
+	g.Print(`
+// JSONPredicate compares text extracted from JSONB document at given path with a value.
+type JSONPredicate struct {
+	// Path is a list of object keys or array indexes.
+	// Single element path is treated as an object key (->>), longer paths (#>>) can address array elements as well.
+	Path []string
+	// Operator is one of =, <>, <, <=, >, >=, LIKE or ILIKE. If empty, = is used.
+	Operator string
+	// Value extracted text is compared with.
+	// Numbers, booleans and time.Time cause extracted text to be casted to NUMERIC, BOOL and TIMESTAMPTZ respectively.
+	Value interface{}
+}
+
+// JSONSet replaces value at given path of JSONB document, missing key is created.
+type JSONSet struct {
+	Path  []string
+	Value []byte
+}
+`)
+}
+
+// JSONStatics generates helpers that write JSONB predicates and modifications, if any table of the schema has JSONB columns.
+func (g *Generator) JSONStatics(s *pqt.Schema) {
+	if !hasJSONB(s) {
+		return
+	}
+	g.Print(`
+func jsonPredicateClause(comp *Composer, column string, id int, p JSONPredicate) error {
+	if len(p.Path) == 0 {
+		return errors.New("json predicate requires path")
+	}
+	op := p.Operator
+	switch op {
+	case "":
+		op = "="
+	case "=", "<>", "<", "<=", ">", ">=", "LIKE", "ILIKE":
+	default:
+		return errors.New("unsupported json predicate operator: " + op)
+	}
+	var cast string
+	switch p.Value.(type) {
+	case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64, float32, float64:
+		cast = "::NUMERIC"
+	case bool:
+		cast = "::BOOL"
+	case time.Time:
+		cast = "::TIMESTAMPTZ"
+	}
+
+	if _, err := comp.WriteString("("); err != nil {
+		return err
+	}
+	if err := comp.WriteAlias(id); err != nil {
+		return err
+	}
+	if _, err := comp.WriteString(column); err != nil {
+		return err
+	}
+	if len(p.Path) == 1 {
+		if _, err := comp.WriteString("->>"); err != nil {
+			return err
+		}
+		if err := comp.WritePlaceholder(); err != nil {
+			return err
+		}
+		comp.Add(p.Path[0])
+	} else {
+		if _, err := comp.WriteString("#>>"); err != nil {
+			return err
+		}
+		if err := comp.WritePlaceholder(); err != nil {
+			return err
+		}
+		comp.Add(pq.StringArray(p.Path))
+	}
+	if _, err := comp.WriteString(")" + cast + " " + op + " "); err != nil {
+		return err
+	}
+	if err := comp.WritePlaceholder(); err != nil {
+		return err
+	}
+	comp.Add(p.Value)
+	return nil
+}
+
+// jsonPatchClause writes expression that applies jsonb_set, || and - modifications to the column, in that order.
+func jsonPatchClause(comp *Composer, column string, set []JSONSet, merge []byte, remove pq.StringArray) error {
+	if len(remove) > 0 {
+		if _, err := comp.WriteString("("); err != nil {
+			return err
+		}
+	}
+	if merge != nil {
+		if _, err := comp.WriteString("("); err != nil {
+			return err
+		}
+	}
+	for range set {
+		if _, err := comp.WriteString("jsonb_set("); err != nil {
+			return err
+		}
+	}
+	if _, err := comp.WriteString("coalesce(" + column + ", '{}')"); err != nil {
+		return err
+	}
+	for _, s := range set {
+		if _, err := comp.WriteString(", "); err != nil {
+			return err
+		}
+		if err := comp.WritePlaceholder(); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString("::TEXT[], "); err != nil {
+			return err
+		}
+		if err := comp.WritePlaceholder(); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString("::JSONB)"); err != nil {
+			return err
+		}
+		comp.Add(pq.StringArray(s.Path))
+		comp.Add(s.Value)
+	}
+	if merge != nil {
+		if _, err := comp.WriteString(" || "); err != nil {
+			return err
+		}
+		if err := comp.WritePlaceholder(); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString("::JSONB)"); err != nil {
+			return err
+		}
+		comp.Add(merge)
+	}
+	if len(remove) > 0 {
+		if _, err := comp.WriteString(" - "); err != nil {
+			return err
+		}
+		if err := comp.WritePlaceholder(); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString("::TEXT[])"); err != nil {
+			return err
+		}
+		comp.Add(remove)
+	}
+	return nil
+}
+`)
+}
+
+func (g *Generator) jsonCriteriaFields(c *pqt.Column) {
+	if !g.isJSONB(c) {
+		return
+	}
+	name := pqtfmt.Public(c.Name)
+	g.Printf(`
+// %sContains matches rows where %s contains given JSON document (@>).
+%sContains []byte
+// %sHasKey matches rows where %s has given top-level key (?).
+%sHasKey sql.NullString
+// %sHasAnyKeys matches rows where %s has any of given top-level keys (?|).
+%sHasAnyKeys pq.StringArray
+// %sHasAllKeys matches rows where %s has all of given top-level keys (?&).
+%sHasAllKeys pq.StringArray
+// %sPath matches rows where all predicates on values extracted from %s are true (->>, #>>).
+%sPath []JSONPredicate
+// %sMatch matches rows where jsonpath predicate check on %s returns true (@@).
+%sMatch sql.NullString
+// %sExists matches rows where jsonpath returns any item for %s (@?).
+%sExists sql.NullString`,
+		name, c.Name, name,
+		name, c.Name, name,
+		name, c.Name, name,
+		name, c.Name, name,
+		name, c.Name, name,
+		name, c.Name, name,
+		name, c.Name, name,
+	)
+}
+
+func (g *Generator) jsonWhereClause(c *pqt.Column) {
+	if !g.isJSONB(c) {
+		return
+	}
+	column := pqtfmt.Public("table", c.Table.Name, "column", c.Name)
+	for _, op := range []struct{ suffix, cond, operator string }{
+		{suffix: "contains", cond: "c.%s != nil", operator: " @> "},
+		{suffix: "hasKey", cond: "c.%s.Valid", operator: " ? "},
+		{suffix: "hasAnyKeys", cond: "len(c.%s) > 0", operator: " ?| "},
+		{suffix: "hasAllKeys", cond: "len(c.%s) > 0", operator: " ?& "},
+		{suffix: "match", cond: "c.%s.Valid", operator: " @@ "},
+		{suffix: "exists", cond: "c.%s.Valid", operator: " @? "},
+	} {
+		field := pqtfmt.Public(c.Name, op.suffix)
+		g.Printf(`
+			if `+op.cond+` {
+				if comp.Dirty {
+					comp.WriteString(" AND ")
+				}
+				if err := comp.WriteAlias(id); err != nil {
+					return err
+				}
+				if _, err := comp.WriteString(%s); err != nil {
+					return err
+				}
+				if _, err := comp.WriteString("%s"); err != nil {
+					return err
+				}
+				if err := comp.WritePlaceholder(); err != nil {
+					return err
+				}
+				comp.Add(c.%s)
+				comp.Dirty=true
+			}`,
+			field,
+			column,
+			op.operator,
+			field,
+		)
+	}
+	g.Printf(`
+		for _, p := range c.%s {
+			if comp.Dirty {
+				comp.WriteString(" AND ")
+			}
+			if err := jsonPredicateClause(comp, %s, id, p); err != nil {
+				return err
+			}
+			comp.Dirty=true
+		}`,
+		pqtfmt.Public(c.Name, "path"),
+		column,
+	)
+}
+
+func (g *Generator) jsonPatchFields(c *pqt.Column) {
+	if !g.isJSONB(c) {
+		return
+	}
+	name := pqtfmt.Public(c.Name)
+	g.Printf(`
+// %sSet replaces values at given paths of %s (jsonb_set).
+%sSet []JSONSet
+// %sMerge is concatenated with %s, top-level keys are overwritten (||).
+%sMerge []byte
+// %sRemove lists top-level keys removed from %s (-).
+%sRemove pq.StringArray`,
+		name, c.Name, name,
+		name, c.Name, name,
+		name, c.Name, name,
+	)
+}
+
+func (g *Generator) jsonSetClause(c *pqt.Column, sel string) {
+	if !g.isJSONB(c) {
+		return
+	}
+	name := pqtfmt.Public(c.Name)
+	column := pqtfmt.Public("table", c.Table.Name, "column", c.Name)
+	g.Printf(`
+		if len(p.%sSet) > 0 || p.%sMerge != nil || len(p.%sRemove) > 0 {
+			if p.%s != nil {
+				return "", nil, errors.New("%s: value and JSON modifications cannot be used together")
+			}
+			if %s.Dirty {
+				if _, err := %s.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := %s.WriteString(%s); err != nil {
+				return "", nil, err
+			}
+			if _, err := %s.WriteString("="); err != nil {
+				return "", nil, err
+			}
+			if err := jsonPatchClause(%s, %s, p.%sSet, p.%sMerge, p.%sRemove); err != nil {
+				return "", nil, err
+			}
+			%s.Dirty=true
+		}`,
+		name, name, name,
+		name,
+		c.Name,
+		sel, sel,
+		sel, column,
+		sel,
+		sel, column, name, name, name,
+		sel,
+	)
+}
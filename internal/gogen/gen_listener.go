@@ -0,0 +1,160 @@
+package gogen
+
+import (
+	"github.com/piotrkowalczuk/pqt"
+	"github.com/piotrkowalczuk/pqt/pqtfmt"
+	"github.com/piotrkowalczuk/pqt/pqtgo"
+)
+
+// Listener generates code shared by all change listeners.
+func (g *Generator) Listener() {
+	g.Print(`
+// ChangeOperation is a type of row change published by a table trigger.
+type ChangeOperation string
+
+const (
+	ChangeInsert ChangeOperation = "INSERT"
+	ChangeUpdate ChangeOperation = "UPDATE"
+	ChangeDelete ChangeOperation = "DELETE"
+)
+
+// Listener is a subset of *pq.Listener methods change listeners rely on.
+// It makes it possible to replace the actual database connection in tests.
+type Listener interface {
+	Listen(channel string) error
+	Unlisten(channel string) error
+	NotificationChannel() <-chan *pq.Notification
+}`)
+}
+
+// ChangeListener generates change type, payload decoder and listener for a table with notifications enabled.
+// Change is identified by the primary key, or by XxxKey struct if the key spans multiple columns.
+func (g *Generator) ChangeListener(t *pqt.Table) {
+	if !t.Notify {
+		return
+	}
+	pk, ok := g.primaryKey(t)
+	if !ok {
+		return
+	}
+
+	entityName := pqtfmt.Public(t.Name)
+	keyName := pqtfmt.Public(pk.Name)
+
+	g.Printf(`
+const Table%sChannel = "%s"
+
+// %sChange represents single change of a %s row.
+type %sChange struct {
+	Operation ChangeOperation
+	%s %s
+	Columns []string
+}
+
+// Changed returns true if given column was modified by the change.
+func (c *%sChange) Changed(column string) bool {
+	for _, col := range c.Columns {
+		if col == column {
+			return true
+		}
+	}
+	return false
+}
+
+// Decode%sChange decodes notification payload published by the %s table trigger.
+func Decode%sChange(payload string) (*%sChange, error) {
+	var msg struct {
+		Operation ChangeOperation `+"`"+`json:"operation"`+"`"+`
+		Key struct {`,
+		entityName, t.Channel(),
+		entityName, t.Name,
+		entityName,
+		keyName, pk.Type,
+		entityName,
+		entityName, t.Name,
+		entityName, entityName,
+	)
+	for _, c := range pk.Columns {
+		g.Printf(`
+			%s %s `+"`"+`json:"%s"`+"`",
+			pqtfmt.Public(c.Name), g.columnType(c, pqtgo.ModeMandatory), c.Name,
+		)
+	}
+	g.Printf(`
+		} `+"`"+`json:"key"`+"`"+`
+		Columns []string `+"`"+`json:"columns"`+"`"+`
+	}
+	if err := json.Unmarshal([]byte(payload), &msg); err != nil {
+		return nil, err
+	}
+	return &%sChange{
+		Operation: msg.Operation,`, entityName)
+	if len(pk.Columns) == 1 {
+		g.Printf(`
+		%s: msg.Key.%s,`, keyName, keyName)
+	} else {
+		g.Printf(`
+		%s: %s{`, keyName, pk.Type)
+		for _, c := range pk.Columns {
+			g.Printf(`
+			%s: msg.Key.%s,`, pqtfmt.Public(c.Name), pqtfmt.Public(c.Name))
+		}
+		g.Print(`
+		},`)
+	}
+	g.Printf(`
+		Columns: msg.Columns,
+	}, nil
+}
+
+// %sChangeListener receives changes published on Table%sChannel.
+// Listener should not be shared with other change listeners, otherwise notifications will be lost.
+type %sChangeListener struct {
+	Listener Listener
+	Log LogFunc
+}
+
+// Listen subscribes to Table%sChannel and calls fn for every change,
+// until context is done, notification channel is closed or fn returns an error.
+func (l *%sChangeListener) Listen(ctx context.Context, fn func(*%sChange) error) error {
+	if err := l.Listener.Listen(Table%sChannel); err != nil {
+		return err
+	}
+	defer l.Listener.Unlisten(Table%sChannel)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case n, ok := <-l.Listener.NotificationChannel():
+			if !ok {
+				return nil
+			}
+			// nil notification is sent after the connection has been re-established.
+			if n == nil || n.Channel != Table%sChannel {
+				continue
+			}
+			change, err := Decode%sChange(n.Extra)
+			if l.Log != nil {
+				l.Log(err, Table%s, "listen", n.Extra)
+			}
+			if err != nil {
+				return err
+			}
+			if err := fn(change); err != nil {
+				return err
+			}
+		}
+	}
+}`,
+		entityName, entityName,
+		entityName,
+		entityName,
+		entityName, entityName,
+		entityName,
+		entityName,
+		entityName,
+		entityName,
+		entityName,
+	)
+}
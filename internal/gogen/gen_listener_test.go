@@ -0,0 +1,208 @@
+package gogen_test
+
+import (
+	"testing"
+
+	"github.com/piotrkowalczuk/pqt"
+	"github.com/piotrkowalczuk/pqt/internal/gogen"
+	"github.com/piotrkowalczuk/pqt/internal/testutil"
+)
+
+func TestGenerator_ChangeListener(t *testing.T) {
+	t1 := pqt.NewTable("t1", pqt.WithNotify("")).
+		AddColumn(pqt.NewColumn("id", pqt.TypeSerialBig(), pqt.WithPrimaryKey())).
+		AddColumn(pqt.NewColumn("age", pqt.TypeInteger()))
+	pqt.NewSchema("s1").AddTable(t1)
+
+	g := &gogen.Generator{}
+	g.Reset()
+	g.ChangeListener(t1)
+	testutil.AssertOutput(t, g.Printer, `
+const TableT1Channel = "s1_t1"
+
+// T1Change represents single change of a t1 row.
+type T1Change struct {
+	Operation ChangeOperation
+	ID        int64
+	Columns   []string
+}
+
+// Changed returns true if given column was modified by the change.
+func (c *T1Change) Changed(column string) bool {
+	for _, col := range c.Columns {
+		if col == column {
+			return true
+		}
+	}
+	return false
+}
+
+// DecodeT1Change decodes notification payload published by the t1 table trigger.
+func DecodeT1Change(payload string) (*T1Change, error) {
+	var msg struct {
+		Operation ChangeOperation `+"`"+`json:"operation"`+"`"+`
+		Key       struct {
+			ID int64 `+"`"+`json:"id"`+"`"+`
+		} `+"`"+`json:"key"`+"`"+`
+		Columns []string `+"`"+`json:"columns"`+"`"+`
+	}
+	if err := json.Unmarshal([]byte(payload), &msg); err != nil {
+		return nil, err
+	}
+	return &T1Change{
+		Operation: msg.Operation,
+		ID:        msg.Key.ID,
+		Columns:   msg.Columns,
+	}, nil
+}
+
+// T1ChangeListener receives changes published on TableT1Channel.
+// Listener should not be shared with other change listeners, otherwise notifications will be lost.
+type T1ChangeListener struct {
+	Listener Listener
+	Log      LogFunc
+}
+
+// Listen subscribes to TableT1Channel and calls fn for every change,
+// until context is done, notification channel is closed or fn returns an error.
+func (l *T1ChangeListener) Listen(ctx context.Context, fn func(*T1Change) error) error {
+	if err := l.Listener.Listen(TableT1Channel); err != nil {
+		return err
+	}
+	defer l.Listener.Unlisten(TableT1Channel)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case n, ok := <-l.Listener.NotificationChannel():
+			if !ok {
+				return nil
+			}
+			// nil notification is sent after the connection has been re-established.
+			if n == nil || n.Channel != TableT1Channel {
+				continue
+			}
+			change, err := DecodeT1Change(n.Extra)
+			if l.Log != nil {
+				l.Log(err, TableT1, "listen", n.Extra)
+			}
+			if err != nil {
+				return err
+			}
+			if err := fn(change); err != nil {
+				return err
+			}
+		}
+	}
+}`)
+}
+
+func TestGenerator_ChangeListener_disabled(t *testing.T) {
+	t1 := pqt.NewTable("t1").
+		AddColumn(pqt.NewColumn("id", pqt.TypeSerialBig(), pqt.WithPrimaryKey()))
+
+	g := &gogen.Generator{}
+	g.Reset()
+	g.ChangeListener(t1)
+	if g.Len() != 0 {
+		t.Errorf("unexpected output: %s", g.String())
+	}
+}
+
+func TestGenerator_ChangeListener_compositeKey(t *testing.T) {
+	tenant := pqt.NewColumn("tenant_id", pqt.TypeIntegerBig())
+	id := pqt.NewColumn("id", pqt.TypeIntegerBig())
+	t1 := pqt.NewTable("t1", pqt.WithNotify("")).
+		AddColumn(tenant).
+		AddColumn(id).
+		AddPrimaryKey(tenant, id)
+	pqt.NewSchema("s1").AddTable(t1)
+
+	g := &gogen.Generator{}
+	g.Reset()
+	g.ChangeListener(t1)
+	testutil.AssertOutput(t, g.Printer, `
+const TableT1Channel = "s1_t1"
+
+// T1Change represents single change of a t1 row.
+type T1Change struct {
+	Operation ChangeOperation
+	Key       T1Key
+	Columns   []string
+}
+
+// Changed returns true if given column was modified by the change.
+func (c *T1Change) Changed(column string) bool {
+	for _, col := range c.Columns {
+		if col == column {
+			return true
+		}
+	}
+	return false
+}
+
+// DecodeT1Change decodes notification payload published by the t1 table trigger.
+func DecodeT1Change(payload string) (*T1Change, error) {
+	var msg struct {
+		Operation ChangeOperation `+"`"+`json:"operation"`+"`"+`
+		Key       struct {
+			TenantID int64 `+"`"+`json:"tenant_id"`+"`"+`
+			ID       int64 `+"`"+`json:"id"`+"`"+`
+		} `+"`"+`json:"key"`+"`"+`
+		Columns []string `+"`"+`json:"columns"`+"`"+`
+	}
+	if err := json.Unmarshal([]byte(payload), &msg); err != nil {
+		return nil, err
+	}
+	return &T1Change{
+		Operation: msg.Operation,
+		Key: T1Key{
+			TenantID: msg.Key.TenantID,
+			ID:       msg.Key.ID,
+		},
+		Columns: msg.Columns,
+	}, nil
+}
+
+// T1ChangeListener receives changes published on TableT1Channel.
+// Listener should not be shared with other change listeners, otherwise notifications will be lost.
+type T1ChangeListener struct {
+	Listener Listener
+	Log      LogFunc
+}
+
+// Listen subscribes to TableT1Channel and calls fn for every change,
+// until context is done, notification channel is closed or fn returns an error.
+func (l *T1ChangeListener) Listen(ctx context.Context, fn func(*T1Change) error) error {
+	if err := l.Listener.Listen(TableT1Channel); err != nil {
+		return err
+	}
+	defer l.Listener.Unlisten(TableT1Channel)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case n, ok := <-l.Listener.NotificationChannel():
+			if !ok {
+				return nil
+			}
+			// nil notification is sent after the connection has been re-established.
+			if n == nil || n.Channel != TableT1Channel {
+				continue
+			}
+			change, err := DecodeT1Change(n.Extra)
+			if l.Log != nil {
+				l.Log(err, TableT1, "listen", n.Extra)
+			}
+			if err != nil {
+				return err
+			}
+			if err := fn(change); err != nil {
+				return err
+			}
+		}
+	}
+}`)
+}
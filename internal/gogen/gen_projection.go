@@ -0,0 +1,203 @@
+package gogen
+
+import (
+	"github.com/piotrkowalczuk/pqt"
+	"github.com/piotrkowalczuk/pqt/pqtfmt"
+)
+
+// FieldMask generates set of entity fields that tracks which of them were loaded by a projection.
+func (g *Generator) FieldMask(t *pqt.Table) {
+	entityName := pqtfmt.Public(t.Name)
+	fieldsName := pqtfmt.Public(t.Name, "fields")
+	indexName := pqtfmt.Private(t.Name, "fieldIndex")
+
+	g.Printf(`
+// %s is a set of %sEntity fields, identified by their columns.
+type %s [%d]uint64
+
+// Has reports whether the set contains field of given column.
+func (f %s) Has(cn string) bool {
+	i := %s(cn)
+	return i >= 0 && f[i/64]&(1<<uint(i%%64)) != 0
+}
+
+func (f *%s) add(cn string) {
+	if i := %s(cn); i >= 0 {
+		f[i/64] |= 1 << uint(i%%64)
+	}
+}
+
+// %s returns position of the column in %s, or -1 if there is no such column.
+func %s(cn string) int {
+	switch cn {`,
+		fieldsName, entityName,
+		fieldsName, (len(t.Columns)+63)/64,
+		fieldsName,
+		indexName,
+		fieldsName,
+		indexName,
+		indexName, pqtfmt.Public("table", t.Name, "columns"),
+		indexName,
+	)
+	for i, c := range t.Columns {
+		g.Printf(`
+	case %s:
+		return %d`, pqtfmt.Public("table", t.Name, "column", c.Name), i)
+	}
+	g.Printf(`
+	}
+	return -1
+}
+
+// Loaded reports whether field of given column was loaded by the query that returned the entity.
+// Entities loaded with all columns, as well as entities built by hand, report every field as loaded.
+func (e *%sEntity) Loaded(cn string) bool {
+	if e.fields == (%s{}) {
+		return %s(cn) >= 0
+	}
+	return e.fields.Has(cn)
+}`, entityName, fieldsName, indexName)
+}
+
+// ColumnSelector generates type that find expressions use to select a subset of columns.
+func (g *Generator) ColumnSelector(t *pqt.Table) {
+	columnName := pqtfmt.Public(t.Name, "column")
+
+	g.Printf(`
+// %s is a column of the %s table, one of %s, that find expression selects.
+type %s string
+
+// %s selects number of rows instead of columns, it is used by count queries.
+const %s %s = "COUNT(*)"
+
+// selectExpr returns select list expression of the column, or an error if the table has no such column.
+func (c %s) selectExpr() (string, error) {
+	switch c {
+	case %s:
+		return string(c), nil`,
+		columnName, t.Name, pqtfmt.Public("table", t.Name, "columns"),
+		columnName,
+		pqtfmt.Private(t.Name, "columnCount"),
+		pqtfmt.Private(t.Name, "columnCount"), columnName,
+		columnName,
+		pqtfmt.Private(t.Name, "columnCount"),
+	)
+	for _, c := range t.Columns {
+		g.Printf(`
+	case %s:
+		return "`, pqtfmt.Public("table", t.Name, "column", c.Name))
+		g.selectColumn(c, 0)
+		g.Print(`", nil`)
+	}
+	g.Printf(`
+	}
+	return "", fmt.Errorf("unexpected column provided: %%q", string(c))
+}
+
+func %s(cs []%s) []string {
+	if len(cs) == 0 {
+		return nil
+	}
+	names := make([]string, 0, len(cs))
+	for _, c := range cs {
+		names = append(names, string(c))
+	}
+	return names
+}`, pqtfmt.Private(t.Name, "columnNames"), columnName)
+}
+
+// Projection generates interface of caller defined structs that find projections are scanned into.
+func (g *Generator) Projection() {
+	g.Print(`
+// Projection is implemented by caller defined structs that selected columns can be scanned into.
+// Props returns pointers to fields of given columns, entities implement it as well.
+type Projection interface {
+	Props(cns ...string) ([]interface{}, error)
+}`)
+}
+
+func (g *Generator) RepositoryMethodFindProjection(t *pqt.Table) {
+	entityName := pqtfmt.Public(t.Name)
+
+	g.Printf(`
+// %s works like %s, but scans each row into the projection returned by next.
+// Selected columns, see %sFindExpr.%s, are passed to its Props method.
+func (r *%sRepositoryBase) %s(ctx context.Context, fe *%sFindExpr, next func() Projection) error {
+	return r.%s(ctx, nil, fe, next)
+}`,
+		pqtfmt.Public("findProjection"), pqtfmt.Public("find"),
+		entityName, pqtfmt.Public("columns"),
+		entityName, pqtfmt.Public("findProjection"), entityName,
+		pqtfmt.Private("findProjection"),
+	)
+}
+
+func (g *Generator) RepositoryTxMethodFindProjection(t *pqt.Table) {
+	entityName := pqtfmt.Public(t.Name)
+
+	g.Printf(`
+func (r *%sRepositoryBaseTx) %s(ctx context.Context, fe *%sFindExpr, next func() Projection) error {
+	return r.base.%s(ctx, r.tx, fe, next)
+}`,
+		entityName, pqtfmt.Public("findProjection"), entityName,
+		pqtfmt.Private("findProjection"),
+	)
+}
+
+func (g *Generator) RepositoryMethodPrivateFindProjection(t *pqt.Table) {
+	entityName := pqtfmt.Public(t.Name)
+
+	g.Printf(`
+func (r *%sRepositoryBase) %s(ctx context.Context, tx *sql.Tx, fe *%sFindExpr, next func() Projection) error {`,
+		entityName, pqtfmt.Private("findProjection"), entityName,
+	)
+	g.sessionOutsideTransaction(t, "")
+	g.Printf(`
+	if tx == nil && fe.%s.Actionable() {
+		return ErrRowLockOutsideTransaction
+	}
+	query, args, err := r.%sQuery(fe)
+	if err != nil {
+		return err
+	}
+	var rows *sql.Rows
+	if tx == nil {
+		rows, err = r.%s.QueryContext(ctx, query, args...)
+	} else {
+		rows, err = tx.QueryContext(ctx, query, args...)
+	}
+	if r.%s != nil {
+		if tx == nil {
+			r.%s(err, Table%s, "find projection", query, args...)
+		} else {
+			r.%s(err, Table%s, "find projection tx", query, args...)
+		}
+	}
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	cols, err := rows.Columns()
+	if err != nil {
+		return err
+	}
+	for rows.Next() {
+		props, err := next().Props(cols...)
+		if err != nil {
+			return err
+		}
+		if err := rows.Scan(props...); err != nil {
+			return err
+		}
+	}
+	return rows.Err()
+}`,
+		pqtfmt.Public("lock"),
+		pqtfmt.Public("find"),
+		pqtfmt.Public("db"),
+		pqtfmt.Public("log"),
+		pqtfmt.Public("log"), entityName,
+		pqtfmt.Public("log"), entityName,
+	)
+}
@@ -0,0 +1,149 @@
+package gogen_test
+
+import (
+	"testing"
+
+	"github.com/piotrkowalczuk/pqt"
+	"github.com/piotrkowalczuk/pqt/internal/gogen"
+	"github.com/piotrkowalczuk/pqt/internal/testutil"
+)
+
+func projectionTable() *pqt.Table {
+	id := pqt.NewColumn("id", pqt.TypeSerialBig(), pqt.WithPrimaryKey())
+	t := pqt.NewTable("t1").
+		AddColumn(id).
+		AddColumn(pqt.NewColumn("name", pqt.TypeText())).
+		AddColumn(pqt.NewDynamicColumn("double", &pqt.Function{
+			Name: "double",
+			Type: pqt.TypeIntegerBig(),
+			Args: []*pqt.FunctionArg{{Name: "x", Type: pqt.TypeSerialBig()}},
+		}, id))
+	pqt.NewSchema("example").AddTable(t)
+	return t
+}
+
+func TestGenerator_FieldMask(t *testing.T) {
+	g := &gogen.Generator{}
+	g.FieldMask(projectionTable())
+	testutil.AssertOutput(t, g.Printer, `
+// T1Fields is a set of T1Entity fields, identified by their columns.
+type T1Fields [1]uint64
+
+// Has reports whether the set contains field of given column.
+func (f T1Fields) Has(cn string) bool {
+	i := t1FieldIndex(cn)
+	return i >= 0 && f[i/64]&(1<<uint(i%64)) != 0
+}
+
+func (f *T1Fields) add(cn string) {
+	if i := t1FieldIndex(cn); i >= 0 {
+		f[i/64] |= 1 << uint(i%64)
+	}
+}
+
+// t1FieldIndex returns position of the column in TableT1Columns, or -1 if there is no such column.
+func t1FieldIndex(cn string) int {
+	switch cn {
+	case TableT1ColumnDouble:
+		return 0
+	case TableT1ColumnID:
+		return 1
+	case TableT1ColumnName:
+		return 2
+	}
+	return -1
+}
+
+// Loaded reports whether field of given column was loaded by the query that returned the entity.
+// Entities loaded with all columns, as well as entities built by hand, report every field as loaded.
+func (e *T1Entity) Loaded(cn string) bool {
+	if e.fields == (T1Fields{}) {
+		return t1FieldIndex(cn) >= 0
+	}
+	return e.fields.Has(cn)
+}`)
+}
+
+func TestGenerator_ColumnSelector(t *testing.T) {
+	g := &gogen.Generator{}
+	g.ColumnSelector(projectionTable())
+	testutil.AssertOutput(t, g.Printer, `
+// T1Column is a column of the t1 table, one of TableT1Columns, that find expression selects.
+type T1Column string
+
+// t1ColumnCount selects number of rows instead of columns, it is used by count queries.
+const t1ColumnCount T1Column = "COUNT(*)"
+
+// selectExpr returns select list expression of the column, or an error if the table has no such column.
+func (c T1Column) selectExpr() (string, error) {
+	switch c {
+	case t1ColumnCount:
+		return string(c), nil
+	case TableT1ColumnDouble:
+		return "double(t0.id) AS double", nil
+	case TableT1ColumnID:
+		return "t0.id", nil
+	case TableT1ColumnName:
+		return "t0.name", nil
+	}
+	return "", fmt.Errorf("unexpected column provided: %q", string(c))
+}
+
+func t1ColumnNames(cs []T1Column) []string {
+	if len(cs) == 0 {
+		return nil
+	}
+	names := make([]string, 0, len(cs))
+	for _, c := range cs {
+		names = append(names, string(c))
+	}
+	return names
+}`)
+}
+
+func TestGenerator_RepositoryMethodPrivateFindProjection(t *testing.T) {
+	g := &gogen.Generator{}
+	g.RepositoryMethodPrivateFindProjection(projectionTable())
+	testutil.AssertOutput(t, g.Printer, `
+func (r *T1RepositoryBase) findProjection(ctx context.Context, tx *sql.Tx, fe *T1FindExpr, next func() Projection) error {
+	if tx == nil && fe.Lock.Actionable() {
+		return ErrRowLockOutsideTransaction
+	}
+	query, args, err := r.FindQuery(fe)
+	if err != nil {
+		return err
+	}
+	var rows *sql.Rows
+	if tx == nil {
+		rows, err = r.DB.QueryContext(ctx, query, args...)
+	} else {
+		rows, err = tx.QueryContext(ctx, query, args...)
+	}
+	if r.Log != nil {
+		if tx == nil {
+			r.Log(err, TableT1, "find projection", query, args...)
+		} else {
+			r.Log(err, TableT1, "find projection tx", query, args...)
+		}
+	}
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	cols, err := rows.Columns()
+	if err != nil {
+		return err
+	}
+	for rows.Next() {
+		props, err := next().Props(cols...)
+		if err != nil {
+			return err
+		}
+		if err := rows.Scan(props...); err != nil {
+			return err
+		}
+	}
+	return rows.Err()
+}`)
+}
@@ -0,0 +1,259 @@
+package gogen
+
+import (
+	"fmt"
+
+	"github.com/piotrkowalczuk/pqt"
+	"github.com/piotrkowalczuk/pqt/pqtfmt"
+)
+
+// relationshipPredicate describes relationship that criteria can filter on using correlated subquery.
+type relationshipPredicate struct {
+	// name is the name of the entity property of the relationship.
+	name   string
+	target *pqt.Table
+	// through is the table of many to many relationship, nil for direct ones.
+	through *pqt.Table
+	// columns of the table are compared with link columns of the through table or, if there is none, the target table.
+	columns, link pqt.Columns
+	// join columns of the through table reference target columns.
+	join, targetColumns pqt.Columns
+}
+
+func relationshipPredicates(t *pqt.Table) (preds []relationshipPredicate) {
+	for _, r := range t.InversedRelationships {
+		if len(r.OwnerColumns) == 0 || len(r.OwnerColumns) != len(r.InversedColumns) {
+			continue
+		}
+		name := or(r.OwnerName, r.OwnerTable.Name)
+		if r.Type == pqt.RelationshipTypeManyToOne {
+			name = or(r.OwnerName, r.OwnerTable.Name+"s")
+		}
+		preds = append(preds, relationshipPredicate{
+			name:    pqtfmt.Public(name),
+			target:  r.OwnerTable,
+			columns: r.InversedColumns,
+			link:    r.OwnerColumns,
+		})
+	}
+	for _, r := range t.ManyToManyRelationships {
+		if r.Type != pqt.RelationshipTypeManyToMany || r.OwnerTable == r.InversedTable || r.ThroughTable == nil {
+			continue
+		}
+		target, name := r.InversedTable, or(r.InversedName, r.InversedTable.Name+"s")
+		if r.InversedTable == t {
+			target, name = r.OwnerTable, or(r.OwnerName, r.OwnerTable.Name+"s")
+		}
+		link, columns := throughColumns(r, t)
+		join, targetColumns := throughColumns(r, target)
+		if len(link) == 0 || len(link) != len(columns) || len(join) == 0 || len(join) != len(targetColumns) {
+			continue
+		}
+		preds = append(preds, relationshipPredicate{
+			name:          pqtfmt.Public(name),
+			target:        target,
+			through:       r.ThroughTable,
+			columns:       columns,
+			link:          link,
+			join:          join,
+			targetColumns: targetColumns,
+		})
+	}
+	return preds
+}
+
+// throughColumns returns columns of the through table that reference given side of many to many relationship,
+// along with referenced columns.
+func throughColumns(r *pqt.Relationship, side *pqt.Table) (through, referenced pqt.Columns) {
+	for _, c := range r.ThroughTable.Constraints {
+		if c.Type == pqt.ConstraintTypeForeignKey && c.Table == side {
+			return c.PrimaryColumns, c.Columns
+		}
+	}
+	return nil, nil
+}
+
+func hasRelationshipPredicates(s *pqt.Schema) bool {
+	for _, t := range s.Tables {
+		if len(relationshipPredicates(t)) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// RelationshipStatics generates helpers shared by relationship predicates, if any table of the schema has them.
+func (g *Generator) RelationshipStatics(s *pqt.Schema) {
+	if !hasRelationshipPredicates(s) {
+		return
+	}
+	g.Print(`
+// subqueryAliasOffset separates aliases of relationship subqueries from aliases of the outer query and its joins.
+const subqueryAliasOffset = 100
+
+// existsClause starts correlated EXISTS (or NOT EXISTS) subquery that selects from the table aliased as sub.
+// The caller is responsible for closing parenthesis.
+func existsClause(comp *Composer, not bool, table string, sub int) error {
+	if comp.Dirty {
+		if _, err := comp.WriteString(" AND "); err != nil {
+			return err
+		}
+	}
+	if not {
+		if _, err := comp.WriteString("NOT "); err != nil {
+			return err
+		}
+	}
+	if _, err := comp.WriteString("EXISTS (SELECT 1 FROM "); err != nil {
+		return err
+	}
+	if _, err := comp.WriteString(table); err != nil {
+		return err
+	}
+	if _, err := comp.WriteString(" AS t"); err != nil {
+		return err
+	}
+	_, err := comp.WriteString(strconv.Itoa(sub))
+	return err
+}
+
+// correlate writes condition that compares column of the left alias with column of the right alias.
+func correlate(comp *Composer, left int, leftColumn string, right int, rightColumn string) error {
+	if err := comp.WriteAlias(left); err != nil {
+		return err
+	}
+	if _, err := comp.WriteString(leftColumn); err != nil {
+		return err
+	}
+	if _, err := comp.WriteString("="); err != nil {
+		return err
+	}
+	if err := comp.WriteAlias(right); err != nil {
+		return err
+	}
+	_, err := comp.WriteString(rightColumn)
+	return err
+}`)
+}
+
+func (g *Generator) relationshipCriteriaFields(t *pqt.Table) {
+	for _, p := range relationshipPredicates(t) {
+		g.Printf(`
+	has%s, hasNo%s *%sCriteria`, p.name, p.name, pqtfmt.Public(p.target.Name))
+	}
+}
+
+// CriteriaRelationships generates methods that filter rows by existence of related rows.
+func (g *Generator) CriteriaRelationships(t *pqt.Table) {
+	name := pqtfmt.Public(t.Name)
+	for _, p := range relationshipPredicates(t) {
+		target := pqtfmt.Public(p.target.Name)
+		for _, m := range []struct{ prefix, field, doc string }{
+			{prefix: "Has", field: "has", doc: "have at least one related %s that meets given criteria"},
+			{prefix: "HasNo", field: "hasNo", doc: "have no related %s that meets given criteria"},
+		} {
+			g.Printf(`
+
+// %s%s matches %s rows that `+m.doc+`, nil criteria matches any.
+func (c *%sCriteria) %s%s(where *%sCriteria) *%sCriteria {
+	if where == nil {
+		where = &%sCriteria{}
+	}
+	c.%s%s = where
+	return c
+}`,
+				m.prefix, p.name, t.Name, p.target.Name,
+				name, m.prefix, p.name, target, name,
+				target,
+				m.field, p.name,
+			)
+		}
+	}
+}
+
+// relatedTable returns expression that evaluates to the name of the other table in the where clause of t criteria.
+// Like tableReference, tables of the same schema follow the schema of the table the clause is written for.
+func relatedTable(t, other *pqt.Table) string {
+	if other == t {
+		return "table"
+	}
+	if other.Schema == t.Schema {
+		return fmt.Sprintf("inSchemaOf(%s, table)", pqtfmt.Public("table", other.Name))
+	}
+	return pqtfmt.Public("table", other.Name)
+}
+
+func (g *Generator) relationshipWhereClause(t *pqt.Table) {
+	for _, p := range relationshipPredicates(t) {
+		target := pqtfmt.Public(p.target.Name)
+		from, alias := p.target, "sub"
+		if p.through != nil {
+			from, alias = p.through, "sub+1"
+		}
+		g.Printf(`
+	for _, rel := range []struct {
+		where *%sCriteria
+		not   bool
+	}{{where: c.has%s}, {where: c.hasNo%s, not: true}} {
+		if rel.where == nil {
+			continue
+		}
+		sub := id + subqueryAliasOffset
+		if err := existsClause(comp, rel.not, %s, sub); err != nil {
+			return err
+		}`,
+			target, p.name, p.name,
+			relatedTable(t, from),
+		)
+		if p.through != nil {
+			g.Printf(`
+		if _, err := comp.WriteString(" INNER JOIN " + %s + " AS t" + strconv.Itoa(sub+1) + " ON "); err != nil {
+			return err
+		}`, relatedTable(t, p.target))
+			g.correlations(p.join, "sub", p.targetColumns, "sub+1")
+		}
+		g.Print(`
+		if _, err := comp.WriteString(" WHERE "); err != nil {
+			return err
+		}`)
+		g.correlations(p.link, "sub", p.columns, "id")
+		g.Printf(`
+		comp.Dirty = true
+		if rel.where.child != nil {
+			if _, err := comp.WriteString(" AND ("); err != nil {
+				return err
+			}
+		}
+		if err := %sCriteriaWhereClause(comp, rel.where, %s, %s); err != nil {
+			return err
+		}
+		if rel.where.child != nil {
+			if _, err := comp.WriteString(")"); err != nil {
+				return err
+			}
+		}
+		if _, err := comp.WriteString(")"); err != nil {
+			return err
+		}
+		comp.Dirty = true
+	}`, target, alias, relatedTable(t, p.target))
+	}
+}
+
+func (g *Generator) correlations(left pqt.Columns, leftAlias string, right pqt.Columns, rightAlias string) {
+	for i, c := range left {
+		if i > 0 {
+			g.Print(`
+		if _, err := comp.WriteString(" AND "); err != nil {
+			return err
+		}`)
+		}
+		g.Printf(`
+		if err := correlate(comp, %s, %s, %s, %s); err != nil {
+			return err
+		}`,
+			leftAlias, pqtfmt.Public("table", c.Table.Name, "column", c.Name),
+			rightAlias, pqtfmt.Public("table", right[i].Table.Name, "column", right[i].Name),
+		)
+	}
+}
@@ -0,0 +1,218 @@
+package gogen_test
+
+import (
+	"testing"
+
+	"github.com/piotrkowalczuk/pqt"
+	"github.com/piotrkowalczuk/pqt/internal/gogen"
+	"github.com/piotrkowalczuk/pqt/internal/testutil"
+)
+
+func relationshipTable() *pqt.Table {
+	author := pqt.NewTable("author").
+		AddColumn(pqt.NewColumn("id", pqt.TypeSerialBig(), pqt.WithPrimaryKey()))
+	book := pqt.NewTable("book").
+		AddColumn(pqt.NewColumn("id", pqt.TypeSerialBig(), pqt.WithPrimaryKey())).
+		AddRelationship(pqt.ManyToOne(author, pqt.WithBidirectional()))
+	tag := pqt.NewTable("tag").
+		AddColumn(pqt.NewColumn("id", pqt.TypeSerialBig(), pqt.WithPrimaryKey()))
+	authorTag := pqt.NewTable("author_tag").
+		AddRelationship(pqt.ManyToMany(author, tag, pqt.WithBidirectional()))
+	pqt.NewSchema("example").AddTable(author).AddTable(book).AddTable(tag).AddTable(authorTag)
+	return author
+}
+
+func TestGenerator_CriteriaRelationships(t *testing.T) {
+	g := &gogen.Generator{}
+	g.CriteriaRelationships(relationshipTable())
+	testutil.AssertOutput(t, g.Printer, `
+
+// HasBooks matches author rows that have at least one related book that meets given criteria, nil criteria matches any.
+func (c *AuthorCriteria) HasBooks(where *BookCriteria) *AuthorCriteria {
+	if where == nil {
+		where = &BookCriteria{}
+	}
+	c.hasBooks = where
+	return c
+}
+
+// HasNoBooks matches author rows that have no related book that meets given criteria, nil criteria matches any.
+func (c *AuthorCriteria) HasNoBooks(where *BookCriteria) *AuthorCriteria {
+	if where == nil {
+		where = &BookCriteria{}
+	}
+	c.hasNoBooks = where
+	return c
+}
+
+// HasTags matches author rows that have at least one related tag that meets given criteria, nil criteria matches any.
+func (c *AuthorCriteria) HasTags(where *TagCriteria) *AuthorCriteria {
+	if where == nil {
+		where = &TagCriteria{}
+	}
+	c.hasTags = where
+	return c
+}
+
+// HasNoTags matches author rows that have no related tag that meets given criteria, nil criteria matches any.
+func (c *AuthorCriteria) HasNoTags(where *TagCriteria) *AuthorCriteria {
+	if where == nil {
+		where = &TagCriteria{}
+	}
+	c.hasNoTags = where
+	return c
+}`)
+}
+
+func TestGenerator_WhereClause_relationships(t *testing.T) {
+	g := &gogen.Generator{}
+	g.WhereClause(relationshipTable())
+	testutil.AssertOutput(t, g.Printer, `
+		// AuthorCriteriaWhereClause writes conditions of the criteria tree into the composer.
+		// The id is the alias of the table named table, tables of related rows are resolved relative to its schema.
+		func AuthorCriteriaWhereClause(comp *Composer, c *AuthorCriteria, id int, table string) error {
+			if c.child == nil {
+				return _AuthorCriteriaWhereClause(comp, c, id, table)
+			}
+			node := c
+			sibling := false
+			for {
+				if !sibling {
+					if node.child != nil {
+						if node.parent != nil {
+							comp.WriteString("(")
+						}
+						node = node.child
+						continue
+					} else {
+						comp.Dirty = false
+						comp.WriteString("(")
+						if err := _AuthorCriteriaWhereClause(comp, node, id, table); err != nil {
+							return err
+						}
+						comp.WriteString(")")
+					}
+				}
+				if node.sibling != nil {
+					sibling = false
+					comp.WriteString(" ")
+					comp.WriteString(node.parent.operator)
+					comp.WriteString(" ")
+					node = node.sibling
+					continue
+				}
+				if node.parent != nil {
+					sibling = true
+					if node.parent.parent != nil {
+						comp.WriteString(")")
+					}
+					node = node.parent
+					continue
+				}
+
+				break
+			}
+			return nil
+		}
+
+		func _AuthorCriteriaWhereClause(comp *Composer, c *AuthorCriteria, id int, table string) error {
+			if c.ID.Valid {
+				if comp.Dirty {
+					comp.WriteString(" AND ")
+				}
+				if err := comp.WriteAlias(id); err != nil {
+					return err
+				}
+				if _, err := comp.WriteString(TableAuthorColumnID); err != nil {
+					return err
+				}
+				if _, err := comp.WriteString("="); err != nil {
+					return err
+				}
+				if err := comp.WritePlaceholder(); err != nil {
+					return err
+				}
+				comp.Add(c.ID)
+				comp.Dirty = true
+			}
+			for _, rel := range []struct {
+				where *BookCriteria
+				not   bool
+			}{{where: c.hasBooks}, {where: c.hasNoBooks, not: true}} {
+				if rel.where == nil {
+					continue
+				}
+				sub := id + subqueryAliasOffset
+				if err := existsClause(comp, rel.not, inSchemaOf(TableBook, table), sub); err != nil {
+					return err
+				}
+				if _, err := comp.WriteString(" WHERE "); err != nil {
+					return err
+				}
+				if err := correlate(comp, sub, TableBookColumnAuthorID, id, TableAuthorColumnID); err != nil {
+					return err
+				}
+				comp.Dirty = true
+				if rel.where.child != nil {
+					if _, err := comp.WriteString(" AND ("); err != nil {
+						return err
+					}
+				}
+				if err := BookCriteriaWhereClause(comp, rel.where, sub, inSchemaOf(TableBook, table)); err != nil {
+					return err
+				}
+				if rel.where.child != nil {
+					if _, err := comp.WriteString(")"); err != nil {
+						return err
+					}
+				}
+				if _, err := comp.WriteString(")"); err != nil {
+					return err
+				}
+				comp.Dirty = true
+			}
+			for _, rel := range []struct {
+				where *TagCriteria
+				not   bool
+			}{{where: c.hasTags}, {where: c.hasNoTags, not: true}} {
+				if rel.where == nil {
+					continue
+				}
+				sub := id + subqueryAliasOffset
+				if err := existsClause(comp, rel.not, inSchemaOf(TableAuthorTag, table), sub); err != nil {
+					return err
+				}
+				if _, err := comp.WriteString(" INNER JOIN " + inSchemaOf(TableTag, table) + " AS t" + strconv.Itoa(sub+1) + " ON "); err != nil {
+					return err
+				}
+				if err := correlate(comp, sub, TableAuthorTagColumnTagID, sub+1, TableTagColumnID); err != nil {
+					return err
+				}
+				if _, err := comp.WriteString(" WHERE "); err != nil {
+					return err
+				}
+				if err := correlate(comp, sub, TableAuthorTagColumnAuthorID, id, TableAuthorColumnID); err != nil {
+					return err
+				}
+				comp.Dirty = true
+				if rel.where.child != nil {
+					if _, err := comp.WriteString(" AND ("); err != nil {
+						return err
+					}
+				}
+				if err := TagCriteriaWhereClause(comp, rel.where, sub+1, inSchemaOf(TableTag, table)); err != nil {
+					return err
+				}
+				if rel.where.child != nil {
+					if _, err := comp.WriteString(")"); err != nil {
+						return err
+					}
+				}
+				if _, err := comp.WriteString(")"); err != nil {
+					return err
+				}
+				comp.Dirty = true
+			}
+			return nil
+		}`)
+}
@@ -0,0 +1,160 @@
+package gogen
+
+import (
+	"github.com/piotrkowalczuk/pqt"
+	"github.com/piotrkowalczuk/pqt/pqtfmt"
+)
+
+// Repositories generates registry of repositories of all tables of the schema,
+// that allows them to share single transaction.
+func (g *Generator) Repositories(s *pqt.Schema) {
+	rls := hasRowLevelSecurity(s)
+
+	g.Print(`
+// Repositories holds repositories of all tables, they share database handle and log function.
+type Repositories struct {
+	DB  *sql.DB
+	Log LogFunc`)
+	if rls {
+		g.Print(`
+	// Session, if set, is applied at the beginning of transactions started by BeginTx and RunInTransaction.
+	// It is not inherited by repositories of the tables, set their Session to reject queries outside of a transaction.
+	Session SessionFunc`)
+	}
+	for _, t := range s.Tables {
+		g.Printf(`
+	%s *%sRepositoryBase`, pqtfmt.Public(t.Name), pqtfmt.Public(t.Name))
+	}
+	g.Print(`
+}
+
+// NewRepositories allocates repositories of all tables using given database handle and log function.
+func NewRepositories(db *sql.DB, log LogFunc) *Repositories {
+	return &Repositories{
+		DB:  db,
+		Log: log,`)
+	for _, t := range s.Tables {
+		g.Printf(`
+		%s: &%sRepositoryBase{
+			%s: %s,
+			%s: %s,
+			%s: db,
+			%s: log,
+		},`,
+			pqtfmt.Public(t.Name), pqtfmt.Public(t.Name),
+			pqtfmt.Public("table"), pqtfmt.Public("table", t.Name),
+			pqtfmt.Public("columns"), pqtfmt.Public("table", t.Name, "columns"),
+			pqtfmt.Public("db"),
+			pqtfmt.Public("log"),
+		)
+	}
+	g.Print(`
+	}
+}
+
+// RepositoriesTx holds repositories of all tables that share single transaction.
+type RepositoriesTx struct {
+	tx *sql.Tx`)
+	for _, t := range s.Tables {
+		g.Printf(`
+	%s *%sRepositoryBaseTx`, pqtfmt.Public(t.Name), pqtfmt.Public(t.Name))
+	}
+	g.Print(`
+}
+
+// Tx returns repositories that run queries within given transaction.
+func (r *Repositories) Tx(tx *sql.Tx) (*RepositoriesTx, error) {
+	rtx := &RepositoriesTx{tx: tx}`)
+	for _, t := range s.Tables {
+		g.Printf(`
+	if r.%s != nil {
+		repo, err := r.%s.Tx(tx)
+		if err != nil {
+			return nil, err
+		}
+		rtx.%s = repo
+	}`, pqtfmt.Public(t.Name), pqtfmt.Public(t.Name), pqtfmt.Public(t.Name))
+	}
+	g.Print(`
+	return rtx, nil
+}
+
+// BeginTx starts transaction using given options and returns repositories that share it.
+func (r *Repositories) BeginTx(ctx context.Context, opts ...TxOption) (*RepositoriesTx, error) {
+	tx, err := r.DB.BeginTx(ctx, newTxOptions(opts).sql())
+	if err != nil {
+		return nil, err
+	}`)
+	if rls {
+		g.Print(`
+	if r.Session != nil {
+		if err := applySession(ctx, tx, r.Session); err != nil {
+			_ = tx.Rollback()
+			return nil, err
+		}
+	}`)
+	}
+	g.Print(`
+	rtx, err := r.Tx(tx)
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, err
+	}
+	return rtx, nil
+}
+
+// RunInTransaction runs given function within a transaction started using given options.
+// The transaction is committed if the function returns no error, otherwise it is rolled back.
+// It is repeated up to attempts times as long as it fails with an error IsRetryable reports.
+func (r *Repositories) RunInTransaction(ctx context.Context, fn func(rtx *RepositoriesTx) error, attempts int, opts ...TxOption) error {
+	return RunInTransaction(r.DB, ctx, func(tx *sql.Tx) error {`)
+	if rls {
+		g.Print(`
+		if r.Session != nil {
+			if err := applySession(ctx, tx, r.Session); err != nil {
+				return err
+			}
+		}`)
+	}
+	g.Print(`
+		rtx, err := r.Tx(tx)
+		if err != nil {
+			return err
+		}
+		return fn(rtx)
+	}, attempts, opts...)
+}
+
+// Commit commits the transaction.
+func (r *RepositoriesTx) Commit() error {
+	return r.tx.Commit()
+}
+
+// Rollback aborts the transaction.
+func (r *RepositoriesTx) Rollback() error {
+	return r.tx.Rollback()
+}
+
+// Savepoint establishes new savepoint within the transaction.
+func (r *RepositoriesTx) Savepoint(ctx context.Context, name string) error {
+	return savepoint(ctx, r.tx, name)
+}
+
+// RollbackTo rolls back all changes made after the savepoint was established, the transaction can be continued.
+func (r *RepositoriesTx) RollbackTo(ctx context.Context, name string) error {
+	return rollbackToSavepoint(ctx, r.tx, name)
+}
+
+// Release destroys the savepoint, changes made after it was established are kept.
+func (r *RepositoriesTx) Release(ctx context.Context, name string) error {
+	return releaseSavepoint(ctx, r.tx, name)
+}
+
+// RunInTransaction runs given function within a savepoint of the transaction, see RunInSavepoint.
+// It allows functions that start their own transaction to be composed into a bigger one.
+func (r *RepositoriesTx) RunInTransaction(ctx context.Context, fn func(rtx *RepositoriesTx) error) error {
+	return RunInSavepoint(ctx, r.tx, func(*sql.Tx) error {
+		return fn(r)
+	})
+}`)
+}
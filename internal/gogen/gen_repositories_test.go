@@ -0,0 +1,130 @@
+package gogen_test
+
+import (
+	"testing"
+
+	"github.com/piotrkowalczuk/pqt"
+	"github.com/piotrkowalczuk/pqt/internal/gogen"
+	"github.com/piotrkowalczuk/pqt/internal/testutil"
+)
+
+func TestGenerator_Repositories(t *testing.T) {
+	t1 := pqt.NewTable("t1", pqt.WithRowLevelSecurity()).AddColumn(pqt.NewColumn("id", pqt.TypeSerialBig(), pqt.WithPrimaryKey()))
+
+	g := &gogen.Generator{}
+	g.Repositories(pqt.NewSchema("example").AddTable(t1))
+	testutil.AssertOutput(t, g.Printer, `
+// Repositories holds repositories of all tables, they share database handle and log function.
+type Repositories struct {
+	DB  *sql.DB
+	Log LogFunc
+	// Session, if set, is applied at the beginning of transactions started by BeginTx and RunInTransaction.
+	// It is not inherited by repositories of the tables, set their Session to reject queries outside of a transaction.
+	Session SessionFunc
+	T1      *T1RepositoryBase
+}
+
+// NewRepositories allocates repositories of all tables using given database handle and log function.
+func NewRepositories(db *sql.DB, log LogFunc) *Repositories {
+	return &Repositories{
+		DB:  db,
+		Log: log,
+		T1: &T1RepositoryBase{
+			Table:   TableT1,
+			Columns: TableT1Columns,
+			DB:      db,
+			Log:     log,
+		},
+	}
+}
+
+// RepositoriesTx holds repositories of all tables that share single transaction.
+type RepositoriesTx struct {
+	tx *sql.Tx
+	T1 *T1RepositoryBaseTx
+}
+
+// Tx returns repositories that run queries within given transaction.
+func (r *Repositories) Tx(tx *sql.Tx) (*RepositoriesTx, error) {
+	rtx := &RepositoriesTx{tx: tx}
+	if r.T1 != nil {
+		repo, err := r.T1.Tx(tx)
+		if err != nil {
+			return nil, err
+		}
+		rtx.T1 = repo
+	}
+	return rtx, nil
+}
+
+// BeginTx starts transaction using given options and returns repositories that share it.
+func (r *Repositories) BeginTx(ctx context.Context, opts ...TxOption) (*RepositoriesTx, error) {
+	tx, err := r.DB.BeginTx(ctx, newTxOptions(opts).sql())
+	if err != nil {
+		return nil, err
+	}
+	if r.Session != nil {
+		if err := applySession(ctx, tx, r.Session); err != nil {
+			_ = tx.Rollback()
+			return nil, err
+		}
+	}
+	rtx, err := r.Tx(tx)
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, err
+	}
+	return rtx, nil
+}
+
+// RunInTransaction runs given function within a transaction started using given options.
+// The transaction is committed if the function returns no error, otherwise it is rolled back.
+// It is repeated up to attempts times as long as it fails with an error IsRetryable reports.
+func (r *Repositories) RunInTransaction(ctx context.Context, fn func(rtx *RepositoriesTx) error, attempts int, opts ...TxOption) error {
+	return RunInTransaction(r.DB, ctx, func(tx *sql.Tx) error {
+		if r.Session != nil {
+			if err := applySession(ctx, tx, r.Session); err != nil {
+				return err
+			}
+		}
+		rtx, err := r.Tx(tx)
+		if err != nil {
+			return err
+		}
+		return fn(rtx)
+	}, attempts, opts...)
+}
+
+// Commit commits the transaction.
+func (r *RepositoriesTx) Commit() error {
+	return r.tx.Commit()
+}
+
+// Rollback aborts the transaction.
+func (r *RepositoriesTx) Rollback() error {
+	return r.tx.Rollback()
+}
+
+// Savepoint establishes new savepoint within the transaction.
+func (r *RepositoriesTx) Savepoint(ctx context.Context, name string) error {
+	return savepoint(ctx, r.tx, name)
+}
+
+// RollbackTo rolls back all changes made after the savepoint was established, the transaction can be continued.
+func (r *RepositoriesTx) RollbackTo(ctx context.Context, name string) error {
+	return rollbackToSavepoint(ctx, r.tx, name)
+}
+
+// Release destroys the savepoint, changes made after it was established are kept.
+func (r *RepositoriesTx) Release(ctx context.Context, name string) error {
+	return releaseSavepoint(ctx, r.tx, name)
+}
+
+// RunInTransaction runs given function within a savepoint of the transaction, see RunInSavepoint.
+// It allows functions that start their own transaction to be composed into a bigger one.
+func (r *RepositoriesTx) RunInTransaction(ctx context.Context, fn func(rtx *RepositoriesTx) error) error {
+	return RunInSavepoint(ctx, r.tx, func(*sql.Tx) error {
+		return fn(r)
+	})
+}`)
+}
@@ -11,14 +11,33 @@ type %sRepositoryBase struct {
 	%s string
 	%s []string
 	%s *sql.DB
-	%s LogFunc
-}`,
+	%s LogFunc`,
 		pqtfmt.Public(t.Name),
 		pqtfmt.Public("table"),
 		pqtfmt.Public("columns"),
 		pqtfmt.Public("db"),
 		pqtfmt.Public("log"),
 	)
+	if t.RowLevelSecurity {
+		g.Printf(`
+	// %s, if set, is applied at the beginning of transactions started by BeginTx and RunInTransaction.
+	// Queries outside of a transaction fail with ErrSessionOutsideTransaction.
+	%s SessionFunc`,
+			pqtfmt.Public("session"),
+			pqtfmt.Public("session"),
+		)
+	}
+	g.Printf(`
+	// %s, if true, makes insert, update and upsert methods validate entities and patches before querying the database.
+	%s bool
+	// %s, if set, is used to prepare queries of fixed shape (insert, delete and find by key) once and reuse them.
+	%s *StmtCache
+}`,
+		pqtfmt.Public("validate"),
+		pqtfmt.Public("validate"),
+		pqtfmt.Public("stmtCache"),
+		pqtfmt.Public("stmtCache"),
+	)
 }
 
 func (g *Generator) RepositoryMethodTx(t *pqt.Table) {
@@ -40,36 +59,42 @@ func (g *Generator) RepositoryMethodTx(t *pqt.Table) {
 
 func (g *Generator) RepositoryMethodBeginTx(t *pqt.Table) {
 	g.Printf(`
-		func (r *%sRepositoryBase) %s(ctx context.Context) (*%sRepositoryBaseTx, error) {`,
+		func (r *%sRepositoryBase) %s(ctx context.Context, opts ...TxOption) (*%sRepositoryBaseTx, error) {`,
 		pqtfmt.Public(t.Name),
 		pqtfmt.Public("beginTx"),
 		pqtfmt.Public(t.Name),
 	)
 	g.Printf(`
-	tx, err := r.%s.BeginTx(ctx, nil)
+	tx, err := r.%s.BeginTx(ctx, newTxOptions(opts).sql())
 	if err != nil {
 		return nil, err
-	}
+	}`,
+		pqtfmt.Public("db"),
+	)
+	g.applySession(t, `_ = tx.Rollback()
+			return nil, err`)
+	g.Printf(`
 	return r.%s(tx)
 }`,
-		pqtfmt.Public("db"),
 		pqtfmt.Public("tx"),
 	)
 }
 
 func (g *Generator) RepositoryMethodRunInTransaction(t *pqt.Table) {
 	g.Printf(`
-func (r %sRepositoryBase) RunInTransaction(ctx context.Context, fn func(rtx *%sRepositoryBaseTx) error, attempts int) (err error) {
-	return RunInTransaction(r.%s, ctx, func(tx *sql.Tx) error {
+func (r %sRepositoryBase) RunInTransaction(ctx context.Context, fn func(rtx *%sRepositoryBaseTx) error, attempts int, opts ...TxOption) (err error) {
+	return RunInTransaction(r.%s, ctx, func(tx *sql.Tx) error {`,
+		pqtfmt.Public(t.Name),
+		pqtfmt.Public(t.Name),
+		pqtfmt.Public("db"),
+	)
+	g.applySession(t, "return err")
+	g.Print(`
 		rtx, err := r.Tx(tx)
 		if err != nil {
 			return err
 		}
 		return fn(rtx)
-	}, attempts)
-}`,
-		pqtfmt.Public(t.Name),
-		pqtfmt.Public(t.Name),
-		pqtfmt.Public("db"),
-	)
+	}, attempts, opts...)
+}`)
 }
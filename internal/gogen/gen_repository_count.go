@@ -28,16 +28,19 @@ func (g *Generator) RepositoryMethodPrivateCount(t *pqt.Table) {
 
 	g.Printf(`
 		func (r *%sRepositoryBase) %s(ctx context.Context, tx *sql.Tx, exp *%sCountExpr) (int64, error) {`, entityName, pqtfmt.Private("count"), entityName)
+	g.sessionOutsideTransaction(t, "0, ")
 	g.Printf(`
 		query, args, err := r.%sQuery(&%sFindExpr{
 			%s: exp.%s,
-			%s: []string{"COUNT(*)"},
+			%s: []%s{%s},
 		`,
 		pqtfmt.Public("find"),
 		pqtfmt.Public(entityName),
 		pqtfmt.Public("where"),
 		pqtfmt.Public("where"),
 		pqtfmt.Public("columns"),
+		pqtfmt.Public(t.Name, "column"),
+		pqtfmt.Private(t.Name, "columnCount"),
 	)
 	for _, r := range joinableRelationships(t) {
 		g.Printf(`
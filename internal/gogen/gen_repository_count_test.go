@@ -23,12 +23,16 @@ type T1RepositoryBase struct {
 	Columns []string
 	DB      *sql.DB
 	Log     LogFunc
+	// Validate, if true, makes insert, update and upsert methods validate entities and patches before querying the database.
+	Validate bool
+	// StmtCache, if set, is used to prepare queries of fixed shape (insert, delete and find by key) once and reuse them.
+	StmtCache *StmtCache
 }
 
 func (r *T1RepositoryBase) count(ctx context.Context, tx *sql.Tx, exp *T1CountExpr) (int64, error) {
 	query, args, err := r.FindQuery(&T1FindExpr{
 		Where:   exp.Where,
-		Columns: []string{"COUNT(*)"},
+		Columns: []T1Column{t1ColumnCount},
 	})
 	if err != nil {
 		return 0, err
@@ -3,12 +3,11 @@ package gogen
 import (
 	"github.com/piotrkowalczuk/pqt"
 	"github.com/piotrkowalczuk/pqt/pqtfmt"
-	"github.com/piotrkowalczuk/pqt/pqtgo"
 )
 
 func (g *Generator) RepositoryMethodDeleteOneByPrimaryKey(t *pqt.Table) {
 	entityName := pqtfmt.Public(t.Name)
-	pk, ok := t.PrimaryKey()
+	pk, ok := g.primaryKey(t)
 	if !ok {
 		return
 	}
@@ -19,14 +18,14 @@ func (g *Generator) RepositoryMethodDeleteOneByPrimaryKey(t *pqt.Table) {
 		}`,
 		entityName,
 		pqtfmt.Public("deleteOneBy", pk.Name),
-		g.columnType(pk, pqtgo.ModeMandatory),
+		pk.Type,
 		pqtfmt.Private("deleteOneBy", pk.Name),
 	)
 }
 
 func (g *Generator) RepositoryTxMethodDeleteOneByPrimaryKey(t *pqt.Table) {
 	entityName := pqtfmt.Public(t.Name)
-	pk, ok := t.PrimaryKey()
+	pk, ok := g.primaryKey(t)
 	if !ok {
 		return
 	}
@@ -37,14 +36,14 @@ func (g *Generator) RepositoryTxMethodDeleteOneByPrimaryKey(t *pqt.Table) {
 		}`,
 		entityName,
 		pqtfmt.Public("deleteOneBy", pk.Name),
-		g.columnType(pk, pqtgo.ModeMandatory),
+		pk.Type,
 		pqtfmt.Private("deleteOneBy", pk.Name),
 	)
 }
 
 func (g *Generator) RepositoryMethodPrivateDeleteOneByPrimaryKey(t *pqt.Table) {
 	entityName := pqtfmt.Public(t.Name)
-	pk, ok := t.PrimaryKey()
+	pk, ok := g.primaryKey(t)
 	if !ok {
 		return
 	}
@@ -53,36 +52,26 @@ func (g *Generator) RepositoryMethodPrivateDeleteOneByPrimaryKey(t *pqt.Table) {
 		func (r *%sRepositoryBase) %s(ctx context.Context, tx *sql.Tx, pk %s) (int64, error) {`,
 		entityName,
 		pqtfmt.Private("DeleteOneBy", pk.Name),
-		g.columnType(pk, pqtgo.ModeMandatory),
+		pk.Type,
 	)
+	g.sessionOutsideTransaction(t, "0, ")
 	g.Printf(`
 		find := NewComposer(%d)
 		find.WriteString("DELETE FROM ")
-		find.WriteString(%s)
-		find.WriteString(" WHERE ")
-		find.WriteString(%s)
-		find.WriteString("=")
-		find.WritePlaceholder()
-		find.Add(pk)`, len(t.Columns),
-		pqtfmt.Public("table", t.Name),
-		pqtfmt.Public("table", t.Name, "column", pk.Name),
+		find.WriteString(r.%s)
+		find.WriteString(" WHERE ")`, len(t.Columns),
+		pqtfmt.Public("table"),
 	)
+	g.primaryKeyClause(t, pk, "find")
 
 	g.Printf(`
-		var (
-			err error
-			res sql.Result
-		)
-		if tx == nil {
-			res, err = r.%s.ExecContext(ctx, find.String(), find.Args()...)
-		} else {
-			res, err = tx.ExecContext(ctx, find.String(), find.Args()...)
-		}`,
+		res, err := exec(ctx, r.%s, tx, r.%s, find.String(), find.Args()...)`,
 		pqtfmt.Public("db"),
+		pqtfmt.Public("stmtCache"),
 	)
 	g.Print(`
 		if err != nil {
-				return 0, err
+				return 0, wrapError(err)
 			}
 
 		return res.RowsAffected()
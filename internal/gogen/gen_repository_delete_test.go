@@ -23,30 +23,62 @@ type T1RepositoryBase struct {
 	Columns []string
 	DB      *sql.DB
 	Log     LogFunc
+	// Validate, if true, makes insert, update and upsert methods validate entities and patches before querying the database.
+	Validate bool
+	// StmtCache, if set, is used to prepare queries of fixed shape (insert, delete and find by key) once and reuse them.
+	StmtCache *StmtCache
 }
 
 func (r *T1RepositoryBase) deleteOneByID(ctx context.Context, tx *sql.Tx, pk int64) (int64, error) {
 	find := NewComposer(2)
 	find.WriteString("DELETE FROM ")
-	find.WriteString(TableT1)
+	find.WriteString(r.Table)
 	find.WriteString(" WHERE ")
 	find.WriteString(TableT1ColumnID)
 	find.WriteString("=")
 	find.WritePlaceholder()
 	find.Add(pk)
-	var (
-		err error
-		res sql.Result
-	)
-	if tx == nil {
-		res, err = r.DB.ExecContext(ctx, find.String(), find.Args()...)
-	} else {
-		res, err = tx.ExecContext(ctx, find.String(), find.Args()...)
-	}
+	res, err := exec(ctx, r.DB, tx, r.StmtCache, find.String(), find.Args()...)
 	if err != nil {
-		return 0, err
+		return 0, wrapError(err)
 	}
 
 	return res.RowsAffected()
 }`)
 }
+
+func TestGenerator_RepositoryMethodPrivateDeleteOneByPrimaryKey_composite(t *testing.T) {
+	tenantID := pqt.NewColumn("tenant_id", pqt.TypeIntegerBig())
+	id := pqt.NewColumn("id", pqt.TypeIntegerBig())
+	t1 := pqt.NewTable("t1").
+		AddColumn(tenantID).
+		AddColumn(id).
+		AddColumn(pqt.NewColumn("age", pqt.TypeInteger())).
+		AddPrimaryKey(tenantID, id)
+
+	g := &gogen.Generator{}
+	g.Reset()
+	g.RepositoryMethodPrivateDeleteOneByPrimaryKey(t1)
+	testutil.AssertOutput(t, g.Printer, `
+		func (r *T1RepositoryBase) deleteOneByKey(ctx context.Context, tx *sql.Tx, pk T1Key) (int64, error) {
+			find := NewComposer(3)
+			find.WriteString("DELETE FROM ")
+			find.WriteString(r.Table)
+			find.WriteString(" WHERE ")
+			find.WriteString(TableT1ColumnTenantID)
+			find.WriteString("=")
+			find.WritePlaceholder()
+			find.Add(pk.TenantID)
+			find.WriteString(" AND ")
+			find.WriteString(TableT1ColumnID)
+			find.WriteString("=")
+			find.WritePlaceholder()
+			find.Add(pk.ID)
+			res, err := exec(ctx, r.DB, tx, r.StmtCache, find.String(), find.Args()...)
+			if err != nil {
+				return 0, wrapError(err)
+			}
+
+			return res.RowsAffected()
+		}`)
+}
@@ -48,6 +48,11 @@ func (g *Generator) RepositoryMethodPrivateFindIter(t *pqt.Table) {
 		entityName,
 		entityName,
 	)
+	g.sessionOutsideTransaction(t, "nil, ")
+	g.Printf(`
+			if tx == nil && fe.%s.Actionable() {
+				return nil, ErrRowLockOutsideTransaction
+			}`, pqtfmt.Public("lock"))
 	g.Printf(`
 			query, args, err := r.%sQuery(fe)
 			if err != nil {
@@ -84,9 +89,9 @@ func (g *Generator) RepositoryMethodPrivateFindIter(t *pqt.Table) {
 			return &%sIterator{
 				rows: rows,
 				expr: fe,
-				cols: fe.Columns,
+				cols: %s(fe.%s),
 		}, nil
-	}`, pqtfmt.Public(t.Name))
+	}`, pqtfmt.Public(t.Name), pqtfmt.Private(t.Name, "columnNames"), pqtfmt.Public("columns"))
 }
 
 func (g *Generator) RepositoryMethodFindQuery(t *pqt.Table) {
@@ -102,7 +107,16 @@ func (g *Generator) RepositoryMethodFindQuery(t *pqt.Table) {
 	g.selectList(t, 0)
 	g.Printf(`")
 		} else {
-			buf.WriteString(strings.Join(fe.%s, ", "))
+			for i, c := range fe.%s {
+				if i > 0 {
+					buf.WriteString(", ")
+				}
+				expr, err := c.selectExpr()
+				if err != nil {
+					return "", nil, err
+				}
+				buf.WriteString(expr)
+			}
 		}`, pqtfmt.Public("columns"))
 	// Generate select clause for joinable tables if needed.
 	for nb, r := range joinableRelationships(t) {
@@ -121,6 +135,7 @@ func (g *Generator) RepositoryMethodFindQuery(t *pqt.Table) {
 		g.Print(`")`)
 		closeBrace(g, 1)
 	}
+	g.textSearchSelect(t)
 	g.Printf(`
 		buf.WriteString(" FROM ")
 		buf.WriteString(r.%s)
@@ -141,10 +156,10 @@ func (g *Generator) RepositoryMethodFindQuery(t *pqt.Table) {
 			joinPropertyName,
 		)
 		g.Printf(`
-			joinClause(comp, fe.%s.%s, "%s AS t%d ON `,
+			joinClause(comp, fe.%s.%s, %s+" AS t%d ON `,
 			joinPropertyName,
 			pqtfmt.Public("kind"),
-			r.InversedTable.FullName(),
+			tableReference(t, r.InversedTable),
 			nb+1,
 		)
 
@@ -159,7 +174,7 @@ func (g *Generator) RepositoryMethodFindQuery(t *pqt.Table) {
 		g.Printf(`
 		if fe.%s.%s != nil {
 			comp.Dirty = true
-			if err := %sCriteriaWhereClause(comp, fe.%s.%s, %d); err != nil {
+			if err := %sCriteriaWhereClause(comp, fe.%s.%s, %d, %s); err != nil {
 				return "", nil, err
 			}
 		}`,
@@ -169,6 +184,7 @@ func (g *Generator) RepositoryMethodFindQuery(t *pqt.Table) {
 			joinPropertyName,
 			pqtfmt.Public("on"),
 			nb+1,
+			tableReference(t, r.InversedTable),
 		)
 
 		closeBrace(g, 1)
@@ -180,20 +196,21 @@ func (g *Generator) RepositoryMethodFindQuery(t *pqt.Table) {
 		comp.Dirty = false
 	}
 	if fe.%s != nil {
-		if err := %sCriteriaWhereClause(comp, fe.%s, 0); err != nil {
+		if err := %sCriteriaWhereClause(comp, fe.%s, 0, r.%s); err != nil {
 			return "", nil, err
 		}
 	}`,
 		pqtfmt.Public("where"),
 		pqtfmt.Public(t.Name),
 		pqtfmt.Public("where"),
+		pqtfmt.Public("table"),
 	)
 
 	for nb, r := range joinableRelationships(t) {
 		joinPropertyName := pqtfmt.Public("join", or(r.InversedName, r.InversedTable.Name))
 		g.Printf(`
 		if fe.%s != nil && fe.%s.Kind.Actionable() && fe.%s.%s != nil {
-			if err := %sCriteriaWhereClause(comp, fe.%s.%s, %d); err != nil {
+			if err := %sCriteriaWhereClause(comp, fe.%s.%s, %d, %s); err != nil {
 				return "", nil, err
 			}
 		}`,
@@ -205,6 +222,7 @@ func (g *Generator) RepositoryMethodFindQuery(t *pqt.Table) {
 			joinPropertyName,
 			pqtfmt.Public("where"),
 			nb+1,
+			tableReference(t, r.InversedTable),
 		)
 	}
 
@@ -217,9 +235,10 @@ func (g *Generator) RepositoryMethodFindQuery(t *pqt.Table) {
 		}
 	`)
 
+	order := g.textSearchOrder(t)
 	g.Printf(`
 	if len(fe.%s) > 0 {
-		i:=0
+		i:=%s
 		for _, order := range fe.%s {
 			for _, columnName := range %s {
 				if order.Name == columnName {
@@ -271,6 +290,7 @@ func (g *Generator) RepositoryMethodFindQuery(t *pqt.Table) {
 	}
 `,
 		pqtfmt.Public("orderBy"),
+		order,
 		pqtfmt.Public("orderBy"),
 		pqtfmt.Public("table", t.Name, "columns"),
 		pqtfmt.Public("offset"),
@@ -279,6 +299,25 @@ func (g *Generator) RepositoryMethodFindQuery(t *pqt.Table) {
 		pqtfmt.Public("limit"),
 	)
 
+	g.Printf(`	if fe.%s.Actionable() {
+		var of string`, pqtfmt.Public("lock"))
+	for _, r := range joinableRelationships(t) {
+		joinPropertyName := pqtfmt.Public("join", or(r.InversedName, r.InversedTable.Name))
+		g.Printf(`
+		if fe.%s != nil && fe.%s.Kind.Actionable() {
+			of = "t0"
+		}`,
+			joinPropertyName,
+			joinPropertyName,
+		)
+	}
+	g.Printf(`
+		if err := lockClause(comp, fe.%s, of); err != nil {
+			return "", nil, err
+		}
+	}
+`, pqtfmt.Public("lock"))
+
 	g.Print(`
 	buf.ReadFrom(comp)
 
@@ -288,18 +327,18 @@ func (g *Generator) RepositoryMethodFindQuery(t *pqt.Table) {
 
 func (g *Generator) RepositoryMethodFindOneByPrimaryKey(t *pqt.Table) {
 	entityName := pqtfmt.Public(t.Name)
-	pk, ok := t.PrimaryKey()
+	pk, ok := g.primaryKey(t)
 	if !ok {
 		return
 	}
 
 	g.Printf(`
 		func (r *%sRepositoryBase) %s(ctx context.Context, pk %s) (*%sEntity, error) {
-			return r.%s(ctx, nil, pk)
+			return r.%s(ctx, nil, pk, RowLock{})
 		}`,
 		entityName,
 		pqtfmt.Public("findOneBy", pk.Name),
-		g.columnType(pk, pqtgo.ModeMandatory),
+		pk.Type,
 		entityName,
 		pqtfmt.Private("findOneBy", pk.Name),
 	)
@@ -307,18 +346,25 @@ func (g *Generator) RepositoryMethodFindOneByPrimaryKey(t *pqt.Table) {
 
 func (g *Generator) RepositoryTxMethodFindOneByPrimaryKey(t *pqt.Table) {
 	entityName := pqtfmt.Public(t.Name)
-	pk, ok := t.PrimaryKey()
+	pk, ok := g.primaryKey(t)
 	if !ok {
 		return
 	}
 
 	g.Printf(`
-		func (r *%sRepositoryBaseTx) %s(ctx context.Context, pk %s) (*%sEntity, error) {
-			return r.base.%s(ctx, r.tx, pk)
+		func (r *%sRepositoryBaseTx) %s(ctx context.Context, pk %s, lock ...RowLock) (*%sEntity, error) {
+			if len(lock) > 1 {
+				return nil, ErrMultipleRowLocks
+			}
+			var rl RowLock
+			if len(lock) > 0 {
+				rl = lock[0]
+			}
+			return r.base.%s(ctx, r.tx, pk, rl)
 		}`,
 		entityName,
 		pqtfmt.Public("findOneBy", pk.Name),
-		g.columnType(pk, pqtgo.ModeMandatory),
+		pk.Type,
 		entityName,
 		pqtfmt.Private("findOneBy", pk.Name),
 	)
@@ -326,18 +372,19 @@ func (g *Generator) RepositoryTxMethodFindOneByPrimaryKey(t *pqt.Table) {
 
 func (g *Generator) RepositoryMethodPrivateFindOneByPrimaryKey(t *pqt.Table) {
 	entityName := pqtfmt.Public(t.Name)
-	pk, ok := t.PrimaryKey()
+	pk, ok := g.primaryKey(t)
 	if !ok {
 		return
 	}
 
 	g.Printf(`
-		func (r *%sRepositoryBase) %s(ctx context.Context, tx *sql.Tx, pk %s) (*%sEntity, error) {`,
+		func (r *%sRepositoryBase) %s(ctx context.Context, tx *sql.Tx, pk %s, lock RowLock) (*%sEntity, error) {`,
 		entityName,
 		pqtfmt.Private("findOneBy", pk.Name),
-		g.columnType(pk, pqtgo.ModeMandatory),
+		pk.Type,
 		entityName,
 	)
+	g.sessionOutsideTransaction(t, "nil, ")
 	g.Printf(`
 		find := NewComposer(%d)
 		find.WriteString("SELECT ")
@@ -352,17 +399,23 @@ func (g *Generator) RepositoryMethodPrivateFindOneByPrimaryKey(t *pqt.Table) {
 
 	g.Printf(`
 		find.WriteString(" FROM ")
-		find.WriteString(%s)
-		find.WriteString(" WHERE ")
-		find.WriteString(%s)
-		find.WriteString("=")
-		find.WritePlaceholder()
-		find.Add(pk)
+		find.WriteString(r.%s)
+		find.WriteString(" WHERE ")`,
+		pqtfmt.Public("table"),
+	)
+	g.primaryKeyClause(t, pk, "find")
+	g.Printf(`
+		if lock.Actionable() {
+			if tx == nil {
+				return nil, ErrRowLockOutsideTransaction
+			}
+			if err := lockClause(find, lock, ""); err != nil {
+				return nil, err
+			}
+		}
 		var (
 			ent %sEntity
 		)`,
-		pqtfmt.Public("table", t.Name),
-		pqtfmt.Public("table", t.Name, "column", pk.Name),
 		entityName,
 	)
 
@@ -371,14 +424,11 @@ func (g *Generator) RepositoryMethodPrivateFindOneByPrimaryKey(t *pqt.Table) {
 		if err != nil {
 			return nil, err
 		}
-		if tx == nil {
-			err = r.%s.QueryRowContext(ctx, find.String(), find.Args()...).Scan(props...)
-		} else {
-			err = tx.QueryRowContext(ctx, find.String(), find.Args()...).Scan(props...)
-		}`,
+		err = queryRow(ctx, r.%s, tx, r.%s, find.String(), find.Args(), props...)`,
 		pqtfmt.Public("props"),
 		pqtfmt.Public("columns"),
 		pqtfmt.Public("db"),
+		pqtfmt.Public("stmtCache"),
 	)
 	g.Printf(`
 		if r.%s != nil {
@@ -403,13 +453,13 @@ func (g *Generator) RepositoryMethodPrivateFindOneByPrimaryKey(t *pqt.Table) {
 
 func (g *Generator) RepositoryMethodFindOneByPrimaryKeyAndUpdate(t *pqt.Table) {
 	entityName := pqtfmt.Public(t.Name)
-	pk, ok := t.PrimaryKey()
+	pk, ok := g.primaryKey(t)
 	if !ok {
 		return
 	}
 
 	g.Printf(`
-		func (r *%sRepositoryBase) %s(ctx context.Context, pk %s, p *%sPatch) (before, after *%sEntity, err error) {`, entityName, pqtfmt.Public("findOneBy", pk.Name, "AndUpdate"), g.columnType(pk, pqtgo.ModeMandatory), entityName, entityName)
+		func (r *%sRepositoryBase) %s(ctx context.Context, pk %s, p *%sPatch) (before, after *%sEntity, err error) {`, entityName, pqtfmt.Public("findOneBy", pk.Name, "AndUpdate"), pk.Type, entityName, entityName)
 
 	g.Printf(`
 		find := NewComposer(%d)
@@ -425,16 +475,13 @@ func (g *Generator) RepositoryMethodFindOneByPrimaryKeyAndUpdate(t *pqt.Table) {
 
 	g.Printf(`
 		find.WriteString(" FROM ")
-		find.WriteString(%s)
-		find.WriteString(" WHERE ")
-		find.WriteString(%s)
-		find.WriteString("=")
-		find.WritePlaceholder()
-		find.Add(pk)
-		find.WriteString(" FOR UPDATE")`,
-		pqtfmt.Public("table", t.Name),
-		pqtfmt.Public("table", t.Name, "column", pk.Name),
+		find.WriteString(r.%s)
+		find.WriteString(" WHERE ")`,
+		pqtfmt.Public("table"),
 	)
+	g.primaryKeyClause(t, pk, "find")
+	g.Print(`
+		find.WriteString(" FOR UPDATE")`)
 	g.Printf(`
 		query, args, err := r.%sQuery(pk, p)
 		if err != nil {
@@ -488,6 +535,7 @@ func (g *Generator) RepositoryMethodFindOneByPrimaryKeyAndUpdate(t *pqt.Table) {
 		}
 		if err != nil {
 			tx.Rollback()
+			err = wrapError(err)
 			return
 		}`,
 		pqtfmt.Public("log"),
@@ -544,6 +592,11 @@ func (g *Generator) RepositoryMethodPrivateFind(t *pqt.Table) {
 		entityName,
 		entityName,
 	)
+	g.sessionOutsideTransaction(t, "nil, ")
+	g.Printf(`
+			if tx == nil && fe.%s.Actionable() {
+				return nil, ErrRowLockOutsideTransaction
+			}`, pqtfmt.Public("lock"))
 	g.Printf(`
 			query, args, err := r.%sQuery(fe)
 			if err != nil {
@@ -583,12 +636,14 @@ func (g *Generator) RepositoryMethodPrivateFind(t *pqt.Table) {
 			entities []*%sEntity
 			props []interface{}
 		)
+		cols := %s(fe.%s)
 		for rows.Next() {
 			var ent %sEntity
-			if props, err = ent.%s(); err != nil {
+			if props, err = ent.%s(cols...); err != nil {
 				return nil, err
 			}`,
 		entityName,
+		pqtfmt.Private(t.Name, "columnNames"), pqtfmt.Public("columns"),
 		pqtfmt.Public(t.Name),
 		pqtfmt.Public("props"),
 	)
@@ -597,6 +652,7 @@ func (g *Generator) RepositoryMethodPrivateFind(t *pqt.Table) {
 		var prop []interface{}`)
 	}
 	g.scanJoinableRelationships(t, "fe")
+	g.textSearchScan(t, "fe")
 	g.Print(`
 			err = rows.Scan(props...)
 			if err != nil {
@@ -647,7 +703,7 @@ func (g *Generator) RepositoryMethodFindOneByUniqueConstraint(t *pqt.Table) {
 
 		g.Printf(`
 			func (r *%sRepositoryBase) %s(ctx context.Context, %s) (*%sEntity, error) {
-				return r.%s(ctx, nil, %s)
+				return r.%s(ctx, nil, %s, RowLock{})
 			}`,
 			entityName,
 			pqtfmt.Public(method...),
@@ -684,8 +740,15 @@ func (g *Generator) RepositoryTxMethodFindOneByUniqueConstraint(t *pqt.Table) {
 		}
 
 		g.Printf(`
-			func (r *%sRepositoryBaseTx) %s(ctx context.Context, %s) (*%sEntity, error) {
-				return r.%s(ctx, r.tx, %s)
+			func (r *%sRepositoryBaseTx) %s(ctx context.Context, %s, lock ...RowLock) (*%sEntity, error) {
+				if len(lock) > 1 {
+					return nil, ErrMultipleRowLocks
+				}
+				var rl RowLock
+				if len(lock) > 0 {
+					rl = lock[0]
+				}
+				return r.base.%s(ctx, r.tx, %s, rl)
 			}`,
 			entityName,
 			pqtfmt.Public(method...),
@@ -722,12 +785,13 @@ func (g *Generator) RepositoryMethodPrivateFindOneByUniqueConstraint(t *pqt.Tabl
 		}
 
 		g.Printf(`
-			func (r *%sRepositoryBase) %s(ctx context.Context, tx *sql.Tx, %s) (*%sEntity, error) {`,
+			func (r *%sRepositoryBase) %s(ctx context.Context, tx *sql.Tx, %s, lock RowLock) (*%sEntity, error) {`,
 			entityName,
 			pqtfmt.Private(method...),
 			arguments,
 			entityName,
 		)
+		g.sessionOutsideTransaction(t, "nil, ")
 		g.Printf(`
 			find := NewComposer(%d)
 			find.WriteString("SELECT ")
@@ -747,9 +811,9 @@ func (g *Generator) RepositoryMethodPrivateFindOneByUniqueConstraint(t *pqt.Tabl
 
 		g.Printf(`
 			find.WriteString(" FROM ")
-			find.WriteString(%s)
+			find.WriteString(r.%s)
 			find.WriteString(" WHERE %s")`,
-			pqtfmt.Public("table", t.Name),
+			pqtfmt.Public("table"),
 			partialClause,
 		)
 		for i, c := range u.PrimaryColumns {
@@ -763,6 +827,16 @@ func (g *Generator) RepositoryMethodPrivateFindOneByUniqueConstraint(t *pqt.Tabl
 		find.Add(%s)
 		`, pqtfmt.Public("table", t.Name, "column", c.Name), pqtfmt.Private(columnForeignName(c)))
 		}
+		g.Print(`
+			if lock.Actionable() {
+				if tx == nil {
+					return nil, ErrRowLockOutsideTransaction
+				}
+				if err := lockClause(find, lock, ""); err != nil {
+					return nil, err
+				}
+			}
+`)
 
 		g.Printf(`
 			var (
@@ -772,15 +846,12 @@ func (g *Generator) RepositoryMethodPrivateFindOneByUniqueConstraint(t *pqt.Tabl
 			if err != nil {
 				return nil, err
 			}
-			if tx == nil {
-				err = r.%s.QueryRowContext(ctx, find.String(), find.Args()...).Scan(props...)
-			} else {
-				err = tx.QueryRowContext(ctx, find.String(), find.Args()...).Scan(props...)
-			}`,
+			err = queryRow(ctx, r.%s, tx, r.%s, find.String(), find.Args(), props...)`,
 			entityName,
 			pqtfmt.Public("props"),
 			pqtfmt.Public("columns"),
 			pqtfmt.Public("db"),
+			pqtfmt.Public("stmtCache"),
 		)
 		g.Print(`
 			if err != nil {
@@ -22,9 +22,16 @@ type T1RepositoryBase struct {
 	Columns []string
 	DB      *sql.DB
 	Log     LogFunc
+	// Validate, if true, makes insert, update and upsert methods validate entities and patches before querying the database.
+	Validate bool
+	// StmtCache, if set, is used to prepare queries of fixed shape (insert, delete and find by key) once and reuse them.
+	StmtCache *StmtCache
 }
 
 func (r *T1RepositoryBase) findIter(ctx context.Context, tx *sql.Tx, fe *T1FindExpr) (*T1Iterator, error) {
+	if tx == nil && fe.Lock.Actionable() {
+		return nil, ErrRowLockOutsideTransaction
+	}
 	query, args, err := r.FindQuery(fe)
 	if err != nil {
 		return nil, err
@@ -48,7 +55,7 @@ func (r *T1RepositoryBase) findIter(ctx context.Context, tx *sql.Tx, fe *T1FindE
 	return &T1Iterator{
 		rows: rows,
 		expr: fe,
-		cols: fe.Columns,
+		cols: t1ColumnNames(fe.Columns),
 	}, nil
 }`)
 }
@@ -72,6 +79,10 @@ type T2RepositoryBase struct {
 	Columns []string
 	DB      *sql.DB
 	Log     LogFunc
+	// Validate, if true, makes insert, update and upsert methods validate entities and patches before querying the database.
+	Validate bool
+	// StmtCache, if set, is used to prepare queries of fixed shape (insert, delete and find by key) once and reuse them.
+	StmtCache *StmtCache
 }
 
 func (r *T2RepositoryBase) FindQuery(fe *T2FindExpr) (string, []interface{}, error) {
@@ -80,7 +91,16 @@ func (r *T2RepositoryBase) FindQuery(fe *T2FindExpr) (string, []interface{}, err
 	if len(fe.Columns) == 0 {
 		buf.WriteString("t0.abc, t0.t1_id, t0.xyz")
 	} else {
-		buf.WriteString(strings.Join(fe.Columns, ", "))
+		for i, c := range fe.Columns {
+			if i > 0 {
+				buf.WriteString(", ")
+			}
+			expr, err := c.selectExpr()
+			if err != nil {
+				return "", nil, err
+			}
+			buf.WriteString(expr)
+		}
 	}
 	if fe.JoinT1 != nil && fe.JoinT1.Kind.Actionable() && fe.JoinT1.Fetch {
 		buf.WriteString(", t1.age, t1.id")
@@ -89,10 +109,10 @@ func (r *T2RepositoryBase) FindQuery(fe *T2FindExpr) (string, []interface{}, err
 	buf.WriteString(r.Table)
 	buf.WriteString(" AS t0")
 	if fe.JoinT1 != nil && fe.JoinT1.Kind.Actionable(){
-		joinClause(comp, fe.JoinT1.Kind, "t1 AS t1 ON t0.t1_id=t1.id")
+		joinClause(comp, fe.JoinT1.Kind, inSchemaOf(TableT1, r.Table)+" AS t1 ON t0.t1_id=t1.id")
 		if fe.JoinT1.On != nil {
 			comp.Dirty = true
-			if err := T1CriteriaWhereClause(comp, fe.JoinT1.On, 1); err != nil {
+			if err := T1CriteriaWhereClause(comp, fe.JoinT1.On, 1, inSchemaOf(TableT1, r.Table)); err != nil {
 				return "", nil, err
 			}
 		}
@@ -102,12 +122,12 @@ func (r *T2RepositoryBase) FindQuery(fe *T2FindExpr) (string, []interface{}, err
 		comp.Dirty = false
 	}
 	if fe.Where != nil {
-		if err := T2CriteriaWhereClause(comp, fe.Where, 0); err != nil {
+		if err := T2CriteriaWhereClause(comp, fe.Where, 0, r.Table); err != nil {
 			return "", nil, err
 		}
 	}
 	if fe.JoinT1 != nil && fe.JoinT1.Kind.Actionable() && fe.JoinT1.Where != nil {
-		if err := T1CriteriaWhereClause(comp, fe.JoinT1.Where, 1); err != nil {
+		if err := T1CriteriaWhereClause(comp, fe.JoinT1.Where, 1, inSchemaOf(TableT1, r.Table)); err != nil {
 			return "", nil, err
 		}
 	}
@@ -169,6 +189,15 @@ func (r *T2RepositoryBase) FindQuery(fe *T2FindExpr) (string, []interface{}, err
 		}
 		comp.Add(fe.Limit)
 	}
+	if fe.Lock.Actionable() {
+		var of string
+		if fe.JoinT1 != nil && fe.JoinT1.Kind.Actionable() {
+			of = "t0"
+		}
+		if err := lockClause(comp, fe.Lock, of); err != nil {
+			return "", nil, err
+		}
+	}
 
 	buf.ReadFrom(comp)
 
@@ -187,6 +216,10 @@ type T1RepositoryBase struct {
 	Columns []string
 	DB      *sql.DB
 	Log     LogFunc
+	// Validate, if true, makes insert, update and upsert methods validate entities and patches before querying the database.
+	Validate bool
+	// StmtCache, if set, is used to prepare queries of fixed shape (insert, delete and find by key) once and reuse them.
+	StmtCache *StmtCache
 }`)
 
 	t1.AddColumn(pqt.NewColumn("id", pqt.TypeSerialBig(), pqt.WithPrimaryKey())).
@@ -201,9 +234,13 @@ type T1RepositoryBase struct {
 	Columns []string
 	DB      *sql.DB
 	Log     LogFunc
+	// Validate, if true, makes insert, update and upsert methods validate entities and patches before querying the database.
+	Validate bool
+	// StmtCache, if set, is used to prepare queries of fixed shape (insert, delete and find by key) once and reuse them.
+	StmtCache *StmtCache
 }
 
-func (r *T1RepositoryBase) findOneByID(ctx context.Context, tx *sql.Tx, pk int64) (*T1Entity, error) {
+func (r *T1RepositoryBase) findOneByID(ctx context.Context, tx *sql.Tx, pk int64, lock RowLock) (*T1Entity, error) {
 	find := NewComposer(2)
 	find.WriteString("SELECT ")
 	if len(r.Columns) == 0 {
@@ -212,12 +249,20 @@ func (r *T1RepositoryBase) findOneByID(ctx context.Context, tx *sql.Tx, pk int64
 		find.WriteString(strings.Join(r.Columns, ", "))
 	}
 	find.WriteString(" FROM ")
-	find.WriteString(TableT1)
+	find.WriteString(r.Table)
 	find.WriteString(" WHERE ")
 	find.WriteString(TableT1ColumnID)
 	find.WriteString("=")
 	find.WritePlaceholder()
 	find.Add(pk)
+	if lock.Actionable() {
+		if tx == nil {
+			return nil, ErrRowLockOutsideTransaction
+		}
+		if err := lockClause(find, lock, ""); err != nil {
+			return nil, err
+		}
+	}
 	var (
 		ent T1Entity
 	)
@@ -225,11 +270,7 @@ func (r *T1RepositoryBase) findOneByID(ctx context.Context, tx *sql.Tx, pk int64
 	if err != nil {
 		return nil, err
 	}
-	if tx == nil {
-		err = r.DB.QueryRowContext(ctx, find.String(), find.Args()...).Scan(props...)
-	} else {
-		err = tx.QueryRowContext(ctx, find.String(), find.Args()...).Scan(props...)
-	}
+	err = queryRow(ctx, r.DB, tx, r.StmtCache, find.String(), find.Args(), props...)
 	if r.Log != nil {
 		if tx == nil {
 			r.Log(err, TableT1, "find by primary key", find.String(), find.Args()...)
@@ -259,9 +300,16 @@ type T1RepositoryBase struct {
 	Columns []string
 	DB      *sql.DB
 	Log     LogFunc
+	// Validate, if true, makes insert, update and upsert methods validate entities and patches before querying the database.
+	Validate bool
+	// StmtCache, if set, is used to prepare queries of fixed shape (insert, delete and find by key) once and reuse them.
+	StmtCache *StmtCache
 }
 
 func (r *T1RepositoryBase) find(ctx context.Context, tx *sql.Tx, fe *T1FindExpr) ([]*T1Entity, error) {
+	if tx == nil && fe.Lock.Actionable() {
+		return nil, ErrRowLockOutsideTransaction
+	}
 	query, args, err := r.FindQuery(fe)
 	if err != nil {
 		return nil, err
@@ -287,9 +335,10 @@ func (r *T1RepositoryBase) find(ctx context.Context, tx *sql.Tx, fe *T1FindExpr)
 		entities []*T1Entity
 		props []interface{}
 	)
+	cols := t1ColumnNames(fe.Columns)
 	for rows.Next() {
 		var ent T1Entity
-		if props, err = ent.Props(); err != nil {
+		if props, err = ent.Props(cols...); err != nil {
 			return nil, err
 		}
 		err = rows.Scan(props...)
@@ -331,9 +380,13 @@ type T1RepositoryBase struct {
 	Columns []string
 	DB      *sql.DB
 	Log     LogFunc
+	// Validate, if true, makes insert, update and upsert methods validate entities and patches before querying the database.
+	Validate bool
+	// StmtCache, if set, is used to prepare queries of fixed shape (insert, delete and find by key) once and reuse them.
+	StmtCache *StmtCache
 }
 
-func (r *T1RepositoryBase) findOneByFirstNameAndLastName(ctx context.Context, tx *sql.Tx, t1FirstName string, t1LastName string) (*T1Entity, error) {
+func (r *T1RepositoryBase) findOneByFirstNameAndLastName(ctx context.Context, tx *sql.Tx, t1FirstName string, t1LastName string, lock RowLock) (*T1Entity, error) {
 	find := NewComposer(4)
 	find.WriteString("SELECT ")
 	if len(r.Columns) == 0 {
@@ -342,7 +395,7 @@ func (r *T1RepositoryBase) findOneByFirstNameAndLastName(ctx context.Context, tx
 		find.WriteString(strings.Join(r.Columns, ", "))
 	}
 	find.WriteString(" FROM ")
-	find.WriteString(TableT1)
+	find.WriteString(r.Table)
 	find.WriteString(" WHERE ")
 	find.WriteString(TableT1ColumnFirstName)
 	find.WriteString("=")
@@ -354,6 +407,15 @@ func (r *T1RepositoryBase) findOneByFirstNameAndLastName(ctx context.Context, tx
 	find.WritePlaceholder()
 	find.Add(t1LastName)
 
+	if lock.Actionable() {
+		if tx == nil {
+			return nil, ErrRowLockOutsideTransaction
+		}
+		if err := lockClause(find, lock, ""); err != nil {
+			return nil, err
+		}
+	}
+
 	var (
 		ent T1Entity
 	)
@@ -361,11 +423,7 @@ func (r *T1RepositoryBase) findOneByFirstNameAndLastName(ctx context.Context, tx
 	if err != nil {
 		return nil, err
 	}
-	if tx == nil {
-		err = r.DB.QueryRowContext(ctx, find.String(), find.Args()...).Scan(props...)
-	} else {
-		err = tx.QueryRowContext(ctx, find.String(), find.Args()...).Scan(props...)
-	}
+	err = queryRow(ctx, r.DB, tx, r.StmtCache, find.String(), find.Args(), props...)
 	if err != nil {
 		return nil, err
 	}
@@ -373,7 +431,7 @@ func (r *T1RepositoryBase) findOneByFirstNameAndLastName(ctx context.Context, tx
 	return &ent, nil
 }
 
-func (r *T1RepositoryBase) findOneByFirstNameAndLastNameAndAgeWhereAgeIsGreaterThanZero(ctx context.Context, tx *sql.Tx, t1FirstName string, t1LastName string, t1Age int64) (*T1Entity, error) {
+func (r *T1RepositoryBase) findOneByFirstNameAndLastNameAndAgeWhereAgeIsGreaterThanZero(ctx context.Context, tx *sql.Tx, t1FirstName string, t1LastName string, t1Age int64, lock RowLock) (*T1Entity, error) {
 	find := NewComposer(4)
 	find.WriteString("SELECT ")
 	if len(r.Columns) == 0 {
@@ -382,7 +440,7 @@ func (r *T1RepositoryBase) findOneByFirstNameAndLastNameAndAgeWhereAgeIsGreaterT
 		find.WriteString(strings.Join(r.Columns, ", "))
 	}
 	find.WriteString(" FROM ")
-	find.WriteString(TableT1)
+	find.WriteString(r.Table)
 	find.WriteString(" WHERE age>0 AND ")
 	find.WriteString(TableT1ColumnFirstName)
 	find.WriteString("=")
@@ -399,6 +457,15 @@ func (r *T1RepositoryBase) findOneByFirstNameAndLastNameAndAgeWhereAgeIsGreaterT
 	find.WritePlaceholder()
 	find.Add(t1Age)
 
+	if lock.Actionable() {
+		if tx == nil {
+			return nil, ErrRowLockOutsideTransaction
+		}
+		if err := lockClause(find, lock, ""); err != nil {
+			return nil, err
+		}
+	}
+
 	var (
 		ent T1Entity
 	)
@@ -406,11 +473,7 @@ func (r *T1RepositoryBase) findOneByFirstNameAndLastNameAndAgeWhereAgeIsGreaterT
 	if err != nil {
 		return nil, err
 	}
-	if tx == nil {
-		err = r.DB.QueryRowContext(ctx, find.String(), find.Args()...).Scan(props...)
-	} else {
-		err = tx.QueryRowContext(ctx, find.String(), find.Args()...).Scan(props...)
-	}
+	err = queryRow(ctx, r.DB, tx, r.StmtCache, find.String(), find.Args(), props...)
 	if err != nil {
 		return nil, err
 	}
@@ -430,10 +493,14 @@ type T1RepositoryBase struct {
 	Columns []string
 	DB      *sql.DB
 	Log     LogFunc
+	// Validate, if true, makes insert, update and upsert methods validate entities and patches before querying the database.
+	Validate bool
+	// StmtCache, if set, is used to prepare queries of fixed shape (insert, delete and find by key) once and reuse them.
+	StmtCache *StmtCache
 }
 
 func (r *T1RepositoryBase) FindOneByID(ctx context.Context, t1ID int32) (*T1Entity, error) {
-	return r.findOneByID(ctx, nil, t1ID)
+	return r.findOneByID(ctx, nil, t1ID, RowLock{})
 }`)
 
 	g.Reset()
@@ -451,9 +518,49 @@ type T2RepositoryBase struct {
 	Columns []string
 	DB      *sql.DB
 	Log     LogFunc
+	// Validate, if true, makes insert, update and upsert methods validate entities and patches before querying the database.
+	Validate bool
+	// StmtCache, if set, is used to prepare queries of fixed shape (insert, delete and find by key) once and reuse them.
+	StmtCache *StmtCache
 }
 
 func (r *T2RepositoryBase) FindOneByXAndY(ctx context.Context, t2X int32, t2Y int32) (*T2Entity, error) {
-	return r.findOneByXAndY(ctx, nil, t2X, t2Y)
+	return r.findOneByXAndY(ctx, nil, t2X, t2Y, RowLock{})
+}`)
+}
+
+func TestGenerator_RepositoryTxMethodFindOneByPrimaryKey(t *testing.T) {
+	t1 := pqt.NewTable("t1").
+		AddColumn(pqt.NewColumn("id", pqt.TypeSerialBig(), pqt.WithPrimaryKey())).
+		AddColumn(pqt.NewColumn("age", pqt.TypeInteger(), pqt.WithUnique()))
+	g := &gogen.Generator{}
+	g.RepositoryTx(t1)
+	g.RepositoryTxMethodFindOneByPrimaryKey(t1)
+	g.RepositoryTxMethodFindOneByUniqueConstraint(t1)
+	testutil.AssertOutput(t, g.Printer, `
+type T1RepositoryBaseTx struct {
+	base *T1RepositoryBase
+	tx   *sql.Tx
+}
+
+func (r *T1RepositoryBaseTx) FindOneByID(ctx context.Context, pk int64, lock ...RowLock) (*T1Entity, error) {
+	if len(lock) > 1 {
+		return nil, ErrMultipleRowLocks
+	}
+	var rl RowLock
+	if len(lock) > 0 {
+		rl = lock[0]
+	}
+	return r.base.findOneByID(ctx, r.tx, pk, rl)
+}
+func (r *T1RepositoryBaseTx) FindOneByAge(ctx context.Context, t1Age int32, lock ...RowLock) (*T1Entity, error) {
+	if len(lock) > 1 {
+		return nil, ErrMultipleRowLocks
+	}
+	var rl RowLock
+	if len(lock) > 0 {
+		rl = lock[0]
+	}
+	return r.base.findOneByAge(ctx, r.tx, t1Age, rl)
 }`)
 }
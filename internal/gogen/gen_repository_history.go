@@ -0,0 +1,299 @@
+package gogen
+
+import (
+	"github.com/piotrkowalczuk/pqt"
+	"github.com/piotrkowalczuk/pqt/pqtfmt"
+)
+
+func (g *Generator) HistoryEntity(t *pqt.Table) {
+	h := t.HistoryTable()
+	if h == nil {
+		return
+	}
+	entityName := pqtfmt.Public(t.Name)
+
+	g.Printf(`
+const (
+	%s = "%s"
+	%s = "%s"
+	%s = "%s"
+	%s = "%s"
+)
+
+// %sHistoryEntity is a single version of a %s row stored in the history table.
+type %sHistoryEntity struct {
+	%sEntity
+	// %s is a type of the change (INSERT, UPDATE or DELETE) that produced this version.
+	%s string
+	// %s is a time of the change.
+	%s time.Time
+	// %s is an author of the change, if it was provided.
+	%s sql.NullString
+}`,
+		pqtfmt.Public("table", h.Name), h.FullName(),
+		pqtfmt.Public("table", h.Name, "column", pqt.HistoryColumnOperation), pqt.HistoryColumnOperation,
+		pqtfmt.Public("table", h.Name, "column", pqt.HistoryColumnAt), pqt.HistoryColumnAt,
+		pqtfmt.Public("table", h.Name, "column", pqt.HistoryColumnActor), pqt.HistoryColumnActor,
+		entityName, t.Name,
+		entityName,
+		entityName,
+		pqtfmt.Public(pqt.HistoryColumnOperation), pqtfmt.Public(pqt.HistoryColumnOperation),
+		pqtfmt.Public(pqt.HistoryColumnAt), pqtfmt.Public(pqt.HistoryColumnAt),
+		pqtfmt.Public(pqt.HistoryColumnActor), pqtfmt.Public(pqt.HistoryColumnActor),
+	)
+}
+
+func (g *Generator) RepositoryMethodHistory(t *pqt.Table) {
+	entityName := pqtfmt.Public(t.Name)
+	pk, ok := g.primaryKey(t)
+	if !ok || !t.History {
+		return
+	}
+
+	g.Printf(`
+		func (r *%sRepositoryBase) %s(ctx context.Context, pk %s) ([]*%sHistoryEntity, error) {
+			return r.%s(ctx, nil, pk)
+		}`,
+		entityName,
+		pqtfmt.Public("history"),
+		pk.Type,
+		entityName,
+		pqtfmt.Private("history"),
+	)
+}
+
+func (g *Generator) RepositoryTxMethodHistory(t *pqt.Table) {
+	entityName := pqtfmt.Public(t.Name)
+	pk, ok := g.primaryKey(t)
+	if !ok || !t.History {
+		return
+	}
+
+	g.Printf(`
+		func (r *%sRepositoryBaseTx) %s(ctx context.Context, pk %s) ([]*%sHistoryEntity, error) {
+			return r.base.%s(ctx, r.tx, pk)
+		}`,
+		entityName,
+		pqtfmt.Public("history"),
+		pk.Type,
+		entityName,
+		pqtfmt.Private("history"),
+	)
+}
+
+func (g *Generator) RepositoryMethodPrivateHistory(t *pqt.Table) {
+	entityName := pqtfmt.Public(t.Name)
+	pk, ok := g.primaryKey(t)
+	if !ok || !t.History {
+		return
+	}
+	h := t.HistoryTable()
+
+	g.Printf(`
+		func (r *%sRepositoryBase) %s(ctx context.Context, tx *sql.Tx, pk %s) ([]*%sHistoryEntity, error) {`,
+		entityName,
+		pqtfmt.Private("history"),
+		pk.Type,
+		entityName,
+	)
+	g.sessionOutsideTransaction(t, "nil, ")
+	g.historySelect(t, h)
+	g.Print(`
+		find.WriteString(" WHERE ")`)
+	g.primaryKeyClause(t, pk, "find")
+	g.Printf(`
+		find.WriteString(" ORDER BY ")
+		find.WriteString(%s)
+
+		var (
+			rows *sql.Rows
+			err error
+		)
+		if tx == nil {
+			rows, err = r.%s.QueryContext(ctx, find.String(), find.Args()...)
+		} else {
+			rows, err = tx.QueryContext(ctx, find.String(), find.Args()...)
+		}
+		if r.%s != nil {
+			if tx == nil {
+				r.%s(err, Table%s, "history", find.String(), find.Args()...)
+			} else {
+				r.%s(err, Table%s, "history tx", find.String(), find.Args()...)
+			}
+		}
+		if err != nil {
+			return nil, err
+		}
+		defer rows.Close()
+
+		var entities []*%sHistoryEntity
+		for rows.Next() {
+			var ent %sHistoryEntity
+			props, err := ent.%s(r.%s...)
+			if err != nil {
+				return nil, err
+			}
+			err = rows.Scan(append(props, &ent.%s, &ent.%s, &ent.%s)...)
+			if err != nil {
+				return nil, err
+			}
+
+			entities = append(entities, &ent)
+		}
+		if err = rows.Err(); err != nil {
+			return nil, err
+		}
+		return entities, nil
+	}`,
+		pqtfmt.Public("table", h.Name, "column", pqt.HistoryColumnAt),
+		pqtfmt.Public("db"),
+		pqtfmt.Public("log"),
+		pqtfmt.Public("log"),
+		entityName,
+		pqtfmt.Public("log"),
+		entityName,
+		entityName,
+		entityName,
+		pqtfmt.Public("props"),
+		pqtfmt.Public("columns"),
+		pqtfmt.Public(pqt.HistoryColumnOperation),
+		pqtfmt.Public(pqt.HistoryColumnAt),
+		pqtfmt.Public(pqt.HistoryColumnActor),
+	)
+}
+
+func (g *Generator) RepositoryMethodFindOneByPrimaryKeyAsOf(t *pqt.Table) {
+	entityName := pqtfmt.Public(t.Name)
+	pk, ok := g.primaryKey(t)
+	if !ok || !t.History {
+		return
+	}
+
+	g.Printf(`
+		// %s returns version of the row that was current at given time.
+		// If the row did not exist at that time, sql.ErrNoRows is returned.
+		func (r *%sRepositoryBase) %s(ctx context.Context, pk %s, at time.Time) (*%sEntity, error) {
+			return r.%s(ctx, nil, pk, at)
+		}`,
+		pqtfmt.Public("findOneBy", pk.Name, "asOf"),
+		entityName,
+		pqtfmt.Public("findOneBy", pk.Name, "asOf"),
+		pk.Type,
+		entityName,
+		pqtfmt.Private("findOneBy", pk.Name, "asOf"),
+	)
+}
+
+func (g *Generator) RepositoryTxMethodFindOneByPrimaryKeyAsOf(t *pqt.Table) {
+	entityName := pqtfmt.Public(t.Name)
+	pk, ok := g.primaryKey(t)
+	if !ok || !t.History {
+		return
+	}
+
+	g.Printf(`
+		func (r *%sRepositoryBaseTx) %s(ctx context.Context, pk %s, at time.Time) (*%sEntity, error) {
+			return r.base.%s(ctx, r.tx, pk, at)
+		}`,
+		entityName,
+		pqtfmt.Public("findOneBy", pk.Name, "asOf"),
+		pk.Type,
+		entityName,
+		pqtfmt.Private("findOneBy", pk.Name, "asOf"),
+	)
+}
+
+func (g *Generator) RepositoryMethodPrivateFindOneByPrimaryKeyAsOf(t *pqt.Table) {
+	entityName := pqtfmt.Public(t.Name)
+	pk, ok := g.primaryKey(t)
+	if !ok || !t.History {
+		return
+	}
+	h := t.HistoryTable()
+
+	g.Printf(`
+		func (r *%sRepositoryBase) %s(ctx context.Context, tx *sql.Tx, pk %s, at time.Time) (*%sEntity, error) {`,
+		entityName,
+		pqtfmt.Private("findOneBy", pk.Name, "asOf"),
+		pk.Type,
+		entityName,
+	)
+	g.sessionOutsideTransaction(t, "nil, ")
+	g.historySelect(t, h)
+	g.Print(`
+		find.WriteString(" WHERE ")`)
+	g.primaryKeyClause(t, pk, "find")
+	g.Printf(`
+		find.WriteString(" AND ")
+		find.WriteString(%s)
+		find.WriteString("<=")
+		find.WritePlaceholder()
+		find.Add(at)
+		find.WriteString(" ORDER BY ")
+		find.WriteString(%s)
+		find.WriteString(" DESC LIMIT 1")
+
+		var ent %sHistoryEntity
+		props, err := ent.%s(r.%s...)
+		if err != nil {
+			return nil, err
+		}
+		props = append(props, &ent.%s, &ent.%s, &ent.%s)
+		if tx == nil {
+			err = r.%s.QueryRowContext(ctx, find.String(), find.Args()...).Scan(props...)
+		} else {
+			err = tx.QueryRowContext(ctx, find.String(), find.Args()...).Scan(props...)
+		}
+		if r.%s != nil {
+			if tx == nil {
+				r.%s(err, Table%s, "find by primary key as of", find.String(), find.Args()...)
+			} else {
+				r.%s(err, Table%s, "find by primary key as of tx", find.String(), find.Args()...)
+			}
+		}
+		if err != nil {
+			return nil, err
+		}
+		if ent.%s == "DELETE" {
+			return nil, sql.ErrNoRows
+		}
+		return &ent.%sEntity, nil
+	}`,
+		pqtfmt.Public("table", h.Name, "column", pqt.HistoryColumnAt),
+		pqtfmt.Public("table", h.Name, "column", pqt.HistoryColumnAt),
+		entityName,
+		pqtfmt.Public("props"),
+		pqtfmt.Public("columns"),
+		pqtfmt.Public(pqt.HistoryColumnOperation),
+		pqtfmt.Public(pqt.HistoryColumnAt),
+		pqtfmt.Public(pqt.HistoryColumnActor),
+		pqtfmt.Public("db"),
+		pqtfmt.Public("log"),
+		pqtfmt.Public("log"),
+		entityName,
+		pqtfmt.Public("log"),
+		entityName,
+		pqtfmt.Public(pqt.HistoryColumnOperation),
+		entityName,
+	)
+}
+
+func (g *Generator) historySelect(t, h *pqt.Table) {
+	g.Printf(`
+		find := NewComposer(%d)
+		find.WriteString("SELECT ")
+		if len(r.%s) == 0 {
+			find.WriteString("`,
+		len(t.Columns), pqtfmt.Public("columns"))
+	g.selectList(t, -1)
+	g.Printf(`")
+		} else {
+			find.WriteString(strings.Join(r.%s, ", "))
+		}
+		find.WriteString(", %s, %s, %s FROM ")
+		find.WriteString(%s)`,
+		pqtfmt.Public("columns"),
+		pqt.HistoryColumnOperation, pqt.HistoryColumnAt, pqt.HistoryColumnActor,
+		tableReference(t, h),
+	)
+}
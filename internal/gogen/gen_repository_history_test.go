@@ -0,0 +1,304 @@
+package gogen_test
+
+import (
+	"testing"
+
+	"github.com/piotrkowalczuk/pqt"
+	"github.com/piotrkowalczuk/pqt/internal/gogen"
+	"github.com/piotrkowalczuk/pqt/internal/testutil"
+)
+
+func historyTable() *pqt.Table {
+	t1 := pqt.NewTable("t1", pqt.WithHistory()).
+		AddColumn(pqt.NewColumn("id", pqt.TypeSerialBig(), pqt.WithPrimaryKey())).
+		AddColumn(pqt.NewColumn("age", pqt.TypeInteger()))
+	pqt.NewSchema("s1").AddTable(t1)
+	return t1
+}
+
+func TestGenerator_HistoryEntity(t *testing.T) {
+	t1 := historyTable()
+
+	g := &gogen.Generator{}
+	g.Reset()
+	g.HistoryEntity(t1)
+	testutil.AssertOutput(t, g.Printer, `
+const (
+	TableT1History                       = "s1.t1_history"
+	TableT1HistoryColumnHistoryOperation = "history_operation"
+	TableT1HistoryColumnHistoryAt        = "history_at"
+	TableT1HistoryColumnHistoryActor     = "history_actor"
+)
+
+// T1HistoryEntity is a single version of a t1 row stored in the history table.
+type T1HistoryEntity struct {
+	T1Entity
+	// HistoryOperation is a type of the change (INSERT, UPDATE or DELETE) that produced this version.
+	HistoryOperation string
+	// HistoryAt is a time of the change.
+	HistoryAt time.Time
+	// HistoryActor is an author of the change, if it was provided.
+	HistoryActor sql.NullString
+}`)
+}
+
+func TestGenerator_RepositoryMethodPrivateHistory(t *testing.T) {
+	t1 := historyTable()
+
+	g := &gogen.Generator{}
+	g.Reset()
+	g.Repository(t1)
+	g.RepositoryMethodPrivateHistory(t1)
+	g.RepositoryMethodHistory(t1)
+	testutil.AssertOutput(t, g.Printer, `
+type T1RepositoryBase struct {
+	Table   string
+	Columns []string
+	DB      *sql.DB
+	Log     LogFunc
+	// Validate, if true, makes insert, update and upsert methods validate entities and patches before querying the database.
+	Validate bool
+	// StmtCache, if set, is used to prepare queries of fixed shape (insert, delete and find by key) once and reuse them.
+	StmtCache *StmtCache
+}
+
+func (r *T1RepositoryBase) history(ctx context.Context, tx *sql.Tx, pk int64) ([]*T1HistoryEntity, error) {
+	find := NewComposer(2)
+	find.WriteString("SELECT ")
+	if len(r.Columns) == 0 {
+		find.WriteString("age, id")
+	} else {
+		find.WriteString(strings.Join(r.Columns, ", "))
+	}
+	find.WriteString(", history_operation, history_at, history_actor FROM ")
+	find.WriteString(inSchemaOf(TableT1History, r.Table))
+	find.WriteString(" WHERE ")
+	find.WriteString(TableT1ColumnID)
+	find.WriteString("=")
+	find.WritePlaceholder()
+	find.Add(pk)
+	find.WriteString(" ORDER BY ")
+	find.WriteString(TableT1HistoryColumnHistoryAt)
+
+	var (
+		rows *sql.Rows
+		err  error
+	)
+	if tx == nil {
+		rows, err = r.DB.QueryContext(ctx, find.String(), find.Args()...)
+	} else {
+		rows, err = tx.QueryContext(ctx, find.String(), find.Args()...)
+	}
+	if r.Log != nil {
+		if tx == nil {
+			r.Log(err, TableT1, "history", find.String(), find.Args()...)
+		} else {
+			r.Log(err, TableT1, "history tx", find.String(), find.Args()...)
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var entities []*T1HistoryEntity
+	for rows.Next() {
+		var ent T1HistoryEntity
+		props, err := ent.Props(r.Columns...)
+		if err != nil {
+			return nil, err
+		}
+		err = rows.Scan(append(props, &ent.HistoryOperation, &ent.HistoryAt, &ent.HistoryActor)...)
+		if err != nil {
+			return nil, err
+		}
+
+		entities = append(entities, &ent)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+	return entities, nil
+}
+func (r *T1RepositoryBase) History(ctx context.Context, pk int64) ([]*T1HistoryEntity, error) {
+	return r.history(ctx, nil, pk)
+}`)
+}
+
+func TestGenerator_RepositoryMethodPrivateFindOneByPrimaryKeyAsOf(t *testing.T) {
+	t1 := historyTable()
+
+	g := &gogen.Generator{}
+	g.Reset()
+	g.Repository(t1)
+	g.RepositoryMethodPrivateFindOneByPrimaryKeyAsOf(t1)
+	g.RepositoryMethodFindOneByPrimaryKeyAsOf(t1)
+	testutil.AssertOutput(t, g.Printer, `
+type T1RepositoryBase struct {
+	Table   string
+	Columns []string
+	DB      *sql.DB
+	Log     LogFunc
+	// Validate, if true, makes insert, update and upsert methods validate entities and patches before querying the database.
+	Validate bool
+	// StmtCache, if set, is used to prepare queries of fixed shape (insert, delete and find by key) once and reuse them.
+	StmtCache *StmtCache
+}
+
+func (r *T1RepositoryBase) findOneByIDAsOf(ctx context.Context, tx *sql.Tx, pk int64, at time.Time) (*T1Entity, error) {
+	find := NewComposer(2)
+	find.WriteString("SELECT ")
+	if len(r.Columns) == 0 {
+		find.WriteString("age, id")
+	} else {
+		find.WriteString(strings.Join(r.Columns, ", "))
+	}
+	find.WriteString(", history_operation, history_at, history_actor FROM ")
+	find.WriteString(inSchemaOf(TableT1History, r.Table))
+	find.WriteString(" WHERE ")
+	find.WriteString(TableT1ColumnID)
+	find.WriteString("=")
+	find.WritePlaceholder()
+	find.Add(pk)
+	find.WriteString(" AND ")
+	find.WriteString(TableT1HistoryColumnHistoryAt)
+	find.WriteString("<=")
+	find.WritePlaceholder()
+	find.Add(at)
+	find.WriteString(" ORDER BY ")
+	find.WriteString(TableT1HistoryColumnHistoryAt)
+	find.WriteString(" DESC LIMIT 1")
+
+	var ent T1HistoryEntity
+	props, err := ent.Props(r.Columns...)
+	if err != nil {
+		return nil, err
+	}
+	props = append(props, &ent.HistoryOperation, &ent.HistoryAt, &ent.HistoryActor)
+	if tx == nil {
+		err = r.DB.QueryRowContext(ctx, find.String(), find.Args()...).Scan(props...)
+	} else {
+		err = tx.QueryRowContext(ctx, find.String(), find.Args()...).Scan(props...)
+	}
+	if r.Log != nil {
+		if tx == nil {
+			r.Log(err, TableT1, "find by primary key as of", find.String(), find.Args()...)
+		} else {
+			r.Log(err, TableT1, "find by primary key as of tx", find.String(), find.Args()...)
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	if ent.HistoryOperation == "DELETE" {
+		return nil, sql.ErrNoRows
+	}
+	return &ent.T1Entity, nil
+}
+
+// FindOneByIDAsOf returns version of the row that was current at given time.
+// If the row did not exist at that time, sql.ErrNoRows is returned.
+func (r *T1RepositoryBase) FindOneByIDAsOf(ctx context.Context, pk int64, at time.Time) (*T1Entity, error) {
+	return r.findOneByIDAsOf(ctx, nil, pk, at)
+}`)
+}
+
+func TestGenerator_RepositoryTxMethodHistory(t *testing.T) {
+	t1 := historyTable()
+
+	g := &gogen.Generator{}
+	g.Reset()
+	g.RepositoryTx(t1)
+	g.RepositoryTxMethodHistory(t1)
+	g.RepositoryTxMethodFindOneByPrimaryKeyAsOf(t1)
+	testutil.AssertOutput(t, g.Printer, `
+type T1RepositoryBaseTx struct {
+	base *T1RepositoryBase
+	tx   *sql.Tx
+}
+
+func (r *T1RepositoryBaseTx) History(ctx context.Context, pk int64) ([]*T1HistoryEntity, error) {
+	return r.base.history(ctx, r.tx, pk)
+}
+func (r *T1RepositoryBaseTx) FindOneByIDAsOf(ctx context.Context, pk int64, at time.Time) (*T1Entity, error) {
+	return r.base.findOneByIDAsOf(ctx, r.tx, pk, at)
+}`)
+}
+
+func TestGenerator_RepositoryMethodPrivateFindOneByPrimaryKeyAsOf_compositeKey(t *testing.T) {
+	tenant := pqt.NewColumn("tenant_id", pqt.TypeIntegerBig())
+	id := pqt.NewColumn("id", pqt.TypeIntegerBig())
+	t1 := pqt.NewTable("t1", pqt.WithHistory()).
+		AddColumn(tenant).
+		AddColumn(id).
+		AddPrimaryKey(tenant, id)
+	pqt.NewSchema("s1").AddTable(t1)
+
+	g := &gogen.Generator{}
+	g.Reset()
+	g.RepositoryMethodHistory(t1)
+	g.RepositoryTxMethodFindOneByPrimaryKeyAsOf(t1)
+	g.RepositoryMethodPrivateFindOneByPrimaryKeyAsOf(t1)
+	testutil.AssertOutput(t, g.Printer, `
+		func (r *T1RepositoryBase) History(ctx context.Context, pk T1Key) ([]*T1HistoryEntity, error) {
+			return r.history(ctx, nil, pk)
+		}
+		func (r *T1RepositoryBaseTx) FindOneByKeyAsOf(ctx context.Context, pk T1Key, at time.Time) (*T1Entity, error) {
+			return r.base.findOneByKeyAsOf(ctx, r.tx, pk, at)
+		}
+		func (r *T1RepositoryBase) findOneByKeyAsOf(ctx context.Context, tx *sql.Tx, pk T1Key, at time.Time) (*T1Entity, error) {
+			find := NewComposer(2)
+			find.WriteString("SELECT ")
+			if len(r.Columns) == 0 {
+				find.WriteString("id, tenant_id")
+			} else {
+				find.WriteString(strings.Join(r.Columns, ", "))
+			}
+			find.WriteString(", history_operation, history_at, history_actor FROM ")
+			find.WriteString(inSchemaOf(TableT1History, r.Table))
+			find.WriteString(" WHERE ")
+			find.WriteString(TableT1ColumnTenantID)
+			find.WriteString("=")
+			find.WritePlaceholder()
+			find.Add(pk.TenantID)
+			find.WriteString(" AND ")
+			find.WriteString(TableT1ColumnID)
+			find.WriteString("=")
+			find.WritePlaceholder()
+			find.Add(pk.ID)
+			find.WriteString(" AND ")
+			find.WriteString(TableT1HistoryColumnHistoryAt)
+			find.WriteString("<=")
+			find.WritePlaceholder()
+			find.Add(at)
+			find.WriteString(" ORDER BY ")
+			find.WriteString(TableT1HistoryColumnHistoryAt)
+			find.WriteString(" DESC LIMIT 1")
+
+			var ent T1HistoryEntity
+			props, err := ent.Props(r.Columns...)
+			if err != nil {
+				return nil, err
+			}
+			props = append(props, &ent.HistoryOperation, &ent.HistoryAt, &ent.HistoryActor)
+			if tx == nil {
+				err = r.DB.QueryRowContext(ctx, find.String(), find.Args()...).Scan(props...)
+			} else {
+				err = tx.QueryRowContext(ctx, find.String(), find.Args()...).Scan(props...)
+			}
+			if r.Log != nil {
+				if tx == nil {
+					r.Log(err, TableT1, "find by primary key as of", find.String(), find.Args()...)
+				} else {
+					r.Log(err, TableT1, "find by primary key as of tx", find.String(), find.Args()...)
+				}
+			}
+			if err != nil {
+				return nil, err
+			}
+			if ent.HistoryOperation == "DELETE" {
+				return nil, sql.ErrNoRows
+			}
+			return &ent.T1Entity, nil
+		}`)
+}
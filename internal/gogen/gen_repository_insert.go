@@ -34,21 +34,17 @@ func (g *Generator) RepositoryMethodPrivateInsert(t *pqt.Table) {
 
 	g.Printf(`
 		func (r *%sRepositoryBase) %s(ctx context.Context, tx *sql.Tx, e *%sEntity) (*%sEntity, error) {`, entityName, pqtfmt.Private("insert"), entityName, entityName)
+	g.sessionOutsideTransaction(t, "nil, ")
 	g.Printf(`
 			query, args, err := r.%sQuery(e, true)
 			if err != nil {
 				return nil, err
 			}
 
-			var row *sql.Row
-			if tx == nil {
-				row = r.%s.QueryRowContext(ctx, query, args...)
-			} else {
-				row = tx.QueryRowContext(ctx, query, args...)
-			}
-			err = row.Scan(`,
+			err = queryRow(ctx, r.%s, tx, r.%s, query, args,`,
 		pqtfmt.Public("insert"),
 		pqtfmt.Public("db"),
+		pqtfmt.Public("stmtCache"),
 	)
 
 	for _, c := range t.Columns {
@@ -65,7 +61,7 @@ func (g *Generator) RepositoryMethodPrivateInsert(t *pqt.Table) {
 			}
 		}
 		if err != nil {
-			return nil, err
+			return nil, wrapError(err)
 		}
 		return e, nil
 	}`,
@@ -82,6 +78,7 @@ func (g *Generator) RepositoryMethodInsertQuery(t *pqt.Table) {
 
 	g.Printf(`
 		func (r *%sRepositoryBase) %sQuery(e *%sEntity, read bool) (string, []interface{}, error) {`, entityName, pqtfmt.Public("insert"), entityName)
+	g.validateQuery("", "e")
 	g.Printf(`
 		insert := NewComposer(%d)
 		columns := bytes.NewBuffer(nil)
@@ -21,6 +21,10 @@ type T2RepositoryBase struct {
 	Columns []string
 	DB      *sql.DB
 	Log     LogFunc
+	// Validate, if true, makes insert, update and upsert methods validate entities and patches before querying the database.
+	Validate bool
+	// StmtCache, if set, is used to prepare queries of fixed shape (insert, delete and find by key) once and reuse them.
+	StmtCache *StmtCache
 }
 
 func (r *T2RepositoryBase) insert(ctx context.Context, tx *sql.Tx, e *T2Entity) (*T2Entity, error) {
@@ -29,13 +33,7 @@ func (r *T2RepositoryBase) insert(ctx context.Context, tx *sql.Tx, e *T2Entity)
 		return nil, err
 	}
 
-	var row *sql.Row
-	if tx == nil {
-		row = r.DB.QueryRowContext(ctx, query, args...)
-	} else {
-		row = tx.QueryRowContext(ctx, query, args...)
-	}
-	err = row.Scan(
+	err = queryRow(ctx, r.DB, tx, r.StmtCache, query, args,
 		&e.ID,
 	)
 	if r.Log != nil {
@@ -46,7 +44,7 @@ func (r *T2RepositoryBase) insert(ctx context.Context, tx *sql.Tx, e *T2Entity)
 		}
 	}
 	if err != nil {
-		return nil, err
+		return nil, wrapError(err)
 	}
 	return e, nil
 }`)
@@ -88,9 +86,18 @@ type T2RepositoryBase struct {
 	Columns []string
 	DB      *sql.DB
 	Log     LogFunc
+	// Validate, if true, makes insert, update and upsert methods validate entities and patches before querying the database.
+	Validate bool
+	// StmtCache, if set, is used to prepare queries of fixed shape (insert, delete and find by key) once and reuse them.
+	StmtCache *StmtCache
 }
 
 func (r *T2RepositoryBase) InsertQuery(e *T2Entity, read bool) (string, []interface{}, error) {
+	if r.Validate {
+		if err := e.Validate(); err != nil {
+			return "", nil, err
+		}
+	}
 	insert := NewComposer(7)
 	columns := bytes.NewBuffer(nil)
 	buf := bytes.NewBufferString("INSERT INTO ")
@@ -217,3 +224,78 @@ func (r *T2RepositoryBase) InsertQuery(e *T2Entity, read bool) (string, []interf
 	return buf.String(), insert.Args(), nil
 }`)
 }
+
+func TestGenerator_RepositoryInsertQuery_generated(t *testing.T) {
+	seq := pqt.NewSequence("t1_number")
+	t1 := pqt.NewTable("t1").
+		AddColumn(pqt.NewColumn("id", pqt.TypeIntegerBig(), pqt.WithPrimaryKey(), pqt.WithIdentity(pqt.IdentityAlways))).
+		AddColumn(pqt.NewColumn("position", pqt.TypeIntegerBig(), pqt.WithIdentity(pqt.IdentityByDefault))).
+		AddColumn(pqt.NewColumn("number", pqt.TypeIntegerBig(), pqt.WithSequence(seq))).
+		AddColumn(pqt.NewColumn("name", pqt.TypeText(), pqt.WithNotNull())).
+		AddColumn(pqt.NewColumn("slug", pqt.TypeText(), pqt.WithGenerated("lower(name)")))
+	pqt.NewSchema("generated_test").AddSequence(seq).AddTable(t1)
+
+	g := &gogen.Generator{}
+	g.Repository(t1) // Is here so output can be properly formatted
+	g.RepositoryMethodInsertQuery(t1)
+
+	testutil.AssertOutput(t, g.Printer, `
+type T1RepositoryBase struct {
+	Table   string
+	Columns []string
+	DB      *sql.DB
+	Log     LogFunc
+	// Validate, if true, makes insert, update and upsert methods validate entities and patches before querying the database.
+	Validate bool
+	// StmtCache, if set, is used to prepare queries of fixed shape (insert, delete and find by key) once and reuse them.
+	StmtCache *StmtCache
+}
+
+func (r *T1RepositoryBase) InsertQuery(e *T1Entity, read bool) (string, []interface{}, error) {
+	if r.Validate {
+		if err := e.Validate(); err != nil {
+			return "", nil, err
+		}
+	}
+	insert := NewComposer(5)
+	columns := bytes.NewBuffer(nil)
+	buf := bytes.NewBufferString("INSERT INTO ")
+	buf.WriteString(r.Table)
+
+	if columns.Len() > 0 {
+		if _, err := columns.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+	}
+	if _, err := columns.WriteString(TableT1ColumnName); err != nil {
+		return "", nil, err
+	}
+	if insert.Dirty {
+		if _, err := insert.WriteString(", "); err != nil {
+			return "", nil, err
+		}
+	}
+	if err := insert.WritePlaceholder(); err != nil {
+		return "", nil, err
+	}
+	insert.Add(e.Name)
+	insert.Dirty = true
+
+	if columns.Len() > 0 {
+		buf.WriteString(" (")
+		buf.ReadFrom(columns)
+		buf.WriteString(") VALUES (")
+		buf.ReadFrom(insert)
+		buf.WriteString(") ")
+		if read {
+			buf.WriteString("RETURNING ")
+			if len(r.Columns) > 0 {
+				buf.WriteString(strings.Join(r.Columns, ", "))
+			} else {
+				buf.WriteString("id, name, number, position, slug")
+			}
+		}
+	}
+	return buf.String(), insert.Args(), nil
+}`)
+}
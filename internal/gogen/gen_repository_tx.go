@@ -33,3 +33,35 @@ func (r %sRepositoryBaseTx) Rollback() error {
 		pqtfmt.Public(t.Name),
 	)
 }
+
+// RepositoryTxMethodSavepoint generates methods that manage savepoints of the transaction.
+func (g *Generator) RepositoryTxMethodSavepoint(t *pqt.Table) {
+	g.Printf(`
+// Savepoint establishes new savepoint within the transaction.
+func (r *%sRepositoryBaseTx) Savepoint(ctx context.Context, name string) error {
+	return savepoint(ctx, r.tx, name)
+}
+
+// RollbackTo rolls back all changes made after the savepoint was established, the transaction can be continued.
+func (r *%sRepositoryBaseTx) RollbackTo(ctx context.Context, name string) error {
+	return rollbackToSavepoint(ctx, r.tx, name)
+}
+
+// Release destroys the savepoint, changes made after it was established are kept.
+func (r *%sRepositoryBaseTx) Release(ctx context.Context, name string) error {
+	return releaseSavepoint(ctx, r.tx, name)
+}
+
+// RunInTransaction runs given function within a savepoint of the transaction, see RunInSavepoint.
+func (r *%sRepositoryBaseTx) RunInTransaction(ctx context.Context, fn func(rtx *%sRepositoryBaseTx) error) error {
+	return RunInSavepoint(ctx, r.tx, func(*sql.Tx) error {
+		return fn(r)
+	})
+}`,
+		pqtfmt.Public(t.Name),
+		pqtfmt.Public(t.Name),
+		pqtfmt.Public(t.Name),
+		pqtfmt.Public(t.Name),
+		pqtfmt.Public(t.Name),
+	)
+}
@@ -10,13 +10,13 @@ import (
 
 func (g *Generator) RepositoryMethodUpdateOneByPrimaryKey(t *pqt.Table) {
 	entityName := pqtfmt.Public(t.Name)
-	pk, ok := t.PrimaryKey()
+	pk, ok := g.primaryKey(t)
 	if !ok {
 		return
 	}
 
 	g.Printf(`
-		func (r *%sRepositoryBase) %s(ctx context.Context, pk %s, p *%sPatch) (*%sEntity, error) {`, entityName, pqtfmt.Public("updateOneBy", pk.Name), g.columnType(pk, pqtgo.ModeMandatory), entityName, entityName)
+		func (r *%sRepositoryBase) %s(ctx context.Context, pk %s, p *%sPatch) (*%sEntity, error) {`, entityName, pqtfmt.Public("updateOneBy", pk.Name), pk.Type, entityName, entityName)
 	g.Printf(`
 		return r.%s(ctx, nil, pk, p)
 		}`,
@@ -26,13 +26,13 @@ func (g *Generator) RepositoryMethodUpdateOneByPrimaryKey(t *pqt.Table) {
 
 func (g *Generator) RepositoryTxMethodUpdateOneByPrimaryKey(t *pqt.Table) {
 	entityName := pqtfmt.Public(t.Name)
-	pk, ok := t.PrimaryKey()
+	pk, ok := g.primaryKey(t)
 	if !ok {
 		return
 	}
 
 	g.Printf(`
-		func (r *%sRepositoryBaseTx) %s(ctx context.Context, pk %s, p *%sPatch) (*%sEntity, error) {`, entityName, pqtfmt.Public("updateOneBy", pk.Name), g.columnType(pk, pqtgo.ModeMandatory), entityName, entityName)
+		func (r *%sRepositoryBaseTx) %s(ctx context.Context, pk %s, p *%sPatch) (*%sEntity, error) {`, entityName, pqtfmt.Public("updateOneBy", pk.Name), pk.Type, entityName, entityName)
 	g.Printf(`
 		return r.base.%s(ctx, r.tx, pk, p)
 		}`,
@@ -42,13 +42,14 @@ func (g *Generator) RepositoryTxMethodUpdateOneByPrimaryKey(t *pqt.Table) {
 
 func (g *Generator) RepositoryMethodPrivateUpdateOneByPrimaryKey(t *pqt.Table) {
 	entityName := pqtfmt.Public(t.Name)
-	pk, ok := t.PrimaryKey()
+	pk, ok := g.primaryKey(t)
 	if !ok {
 		return
 	}
 
 	g.Printf(`
-		func (r *%sRepositoryBase) %s(ctx context.Context, tx *sql.Tx, pk %s, p *%sPatch) (*%sEntity, error) {`, entityName, pqtfmt.Private("updateOneBy", pk.Name), g.columnType(pk, pqtgo.ModeMandatory), entityName, entityName)
+		func (r *%sRepositoryBase) %s(ctx context.Context, tx *sql.Tx, pk %s, p *%sPatch) (*%sEntity, error) {`, entityName, pqtfmt.Private("updateOneBy", pk.Name), pk.Type, entityName, entityName)
+	g.sessionOutsideTransaction(t, "nil, ")
 	g.Printf(`
 		query, args, err := r.%sQuery(pk, p)
 		if err != nil {
@@ -81,7 +82,7 @@ func (g *Generator) RepositoryMethodPrivateUpdateOneByPrimaryKey(t *pqt.Table) {
 			}
 		}
 		if err != nil {
-			return nil, err
+			return nil, wrapError(err)
 		}
 		return &ent, nil
 	}`,
@@ -95,7 +96,7 @@ func (g *Generator) RepositoryMethodPrivateUpdateOneByPrimaryKey(t *pqt.Table) {
 
 func (g *Generator) RepositoryMethodUpdateOneByPrimaryKeyQuery(t *pqt.Table) {
 	entityName := pqtfmt.Public(t.Name)
-	pk, ok := t.PrimaryKey()
+	pk, ok := g.primaryKey(t)
 	if !ok {
 		return
 	}
@@ -104,9 +105,10 @@ func (g *Generator) RepositoryMethodUpdateOneByPrimaryKeyQuery(t *pqt.Table) {
 		func (r *%sRepositoryBase) %sQuery(pk %s, p *%sPatch) (string, []interface{}, error) {`,
 		entityName,
 		pqtfmt.Public("UpdateOneBy", pk.Name),
-		g.columnType(pk, pqtgo.ModeMandatory),
+		pk.Type,
 		entityName,
 	)
+	g.validateQuery("", "p")
 	g.Printf(`
 		buf := bytes.NewBufferString("UPDATE ")
 		buf.WriteString(r.%s)
@@ -123,22 +125,19 @@ func (g *Generator) RepositoryMethodUpdateOneByPrimaryKeyQuery(t *pqt.Table) {
 		return "", nil, errors.New("%s update failure, nothing to update")
 	}`, entityName)
 
-	g.Printf(`
+	g.Print(`
 		buf.WriteString(" SET ")
 		buf.ReadFrom(update)
 		buf.WriteString(" WHERE ")
-
-		update.WriteString(%s)
-		update.WriteString("=")
-		update.WritePlaceholder()
-		update.Add(pk)
+`)
+	g.primaryKeyClause(t, pk, "update")
+	g.Printf(`
 
 		buf.ReadFrom(update)
 		buf.WriteString(" RETURNING ")
 		if len(r.%s) > 0 {
 			buf.WriteString(strings.Join(r.%s, ", "))
 		} else {`,
-		pqtfmt.Public("table", t.Name, "column", pk.Name),
 		pqtfmt.Public("columns"),
 		pqtfmt.Public("columns"),
 	)
@@ -186,6 +185,7 @@ func (g *Generator) RepositoryMethodUpdateOneByUniqueConstraintQuery(t *pqt.Tabl
 			arguments,
 			entityName,
 		)
+		g.validateQuery("", "p")
 
 		g.Printf(`
 			buf := bytes.NewBufferString("UPDATE ")
@@ -359,6 +359,7 @@ func (g *Generator) RepositoryMethodPrivateUpdateOneByUniqueConstraint(t *pqt.Ta
 			entityName,
 		)
 
+		g.sessionOutsideTransaction(t, "nil, ")
 		g.Printf(`
 			query, args, err := r.%s(%s, p)
 			if err != nil {
@@ -396,7 +397,7 @@ func (g *Generator) RepositoryMethodPrivateUpdateOneByUniqueConstraint(t *pqt.Ta
 					}
 				}
 				if err != nil {
-					return nil, err
+					return nil, wrapError(err)
 				}
 				return &ent, nil
 			}`,
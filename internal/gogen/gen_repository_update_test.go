@@ -23,6 +23,10 @@ type T2RepositoryBase struct {
 	Columns []string
 	DB      *sql.DB
 	Log     LogFunc
+	// Validate, if true, makes insert, update and upsert methods validate entities and patches before querying the database.
+	Validate bool
+	// StmtCache, if set, is used to prepare queries of fixed shape (insert, delete and find by key) once and reuse them.
+	StmtCache *StmtCache
 }
 
 func (r *T2RepositoryBase) updateOneByID(ctx context.Context, tx *sql.Tx, pk int64, p *T2Patch) (*T2Entity, error) {
@@ -48,7 +52,7 @@ func (r *T2RepositoryBase) updateOneByID(ctx context.Context, tx *sql.Tx, pk int
 		}
 	}
 	if err != nil {
-		return nil, err
+		return nil, wrapError(err)
 	}
 	return &ent, nil
 }`)
@@ -66,6 +70,10 @@ type T0RepositoryBase struct {
 	Columns []string
 	DB      *sql.DB
 	Log     LogFunc
+	// Validate, if true, makes insert, update and upsert methods validate entities and patches before querying the database.
+	Validate bool
+	// StmtCache, if set, is used to prepare queries of fixed shape (insert, delete and find by key) once and reuse them.
+	StmtCache *StmtCache
 }`)
 
 	t1 := pqt.NewTable("t1").
@@ -83,9 +91,18 @@ type T1RepositoryBase struct {
 	Columns []string
 	DB      *sql.DB
 	Log     LogFunc
+	// Validate, if true, makes insert, update and upsert methods validate entities and patches before querying the database.
+	Validate bool
+	// StmtCache, if set, is used to prepare queries of fixed shape (insert, delete and find by key) once and reuse them.
+	StmtCache *StmtCache
 }
 
 func (r *T1RepositoryBase) UpdateOneByIDQuery(pk int64, p *T1Patch) (string, []interface{}, error) {
+	if r.Validate {
+		if err := p.Validate(); err != nil {
+			return "", nil, err
+		}
+	}
 	buf := bytes.NewBufferString("UPDATE ")
 	buf.WriteString(r.Table)
 	update := NewComposer(1)
@@ -124,6 +141,10 @@ type T0RepositoryBase struct {
 	Columns []string
 	DB      *sql.DB
 	Log     LogFunc
+	// Validate, if true, makes insert, update and upsert methods validate entities and patches before querying the database.
+	Validate bool
+	// StmtCache, if set, is used to prepare queries of fixed shape (insert, delete and find by key) once and reuse them.
+	StmtCache *StmtCache
 }`)
 
 	firstName := pqt.NewColumn("first_name", pqt.TypeText())
@@ -149,9 +170,18 @@ type T1RepositoryBase struct {
 	Columns []string
 	DB      *sql.DB
 	Log     LogFunc
+	// Validate, if true, makes insert, update and upsert methods validate entities and patches before querying the database.
+	Validate bool
+	// StmtCache, if set, is used to prepare queries of fixed shape (insert, delete and find by key) once and reuse them.
+	StmtCache *StmtCache
 }
 
 func (r *T1RepositoryBase) UpdateOneByFirstNameAndLastNameAndAgeQuery(t1FirstName string, t1LastName string, t1Age int64, p *T1Patch) (string, []interface{}, error) {
+	if r.Validate {
+		if err := p.Validate(); err != nil {
+			return "", nil, err
+		}
+	}
 	buf := bytes.NewBufferString("UPDATE ")
 	buf.WriteString(r.Table)
 	update := NewComposer(3)
@@ -243,6 +273,11 @@ func (r *T1RepositoryBase) UpdateOneByFirstNameAndLastNameAndAgeQuery(t1FirstNam
 }
 
 func (r *T1RepositoryBase) UpdateOneByFirstNameAndLastNameWhereAgeIsNotSetQuery(t1FirstName string, t1LastName string, p *T1Patch) (string, []interface{}, error) {
+	if r.Validate {
+		if err := p.Validate(); err != nil {
+			return "", nil, err
+		}
+	}
 	buf := bytes.NewBufferString("UPDATE ")
 	buf.WriteString(r.Table)
 	update := NewComposer(2)
@@ -341,6 +376,10 @@ type T0RepositoryBase struct {
 	Columns []string
 	DB      *sql.DB
 	Log     LogFunc
+	// Validate, if true, makes insert, update and upsert methods validate entities and patches before querying the database.
+	Validate bool
+	// StmtCache, if set, is used to prepare queries of fixed shape (insert, delete and find by key) once and reuse them.
+	StmtCache *StmtCache
 }`)
 
 	firstName := pqt.NewColumn("first_name", pqt.TypeText())
@@ -366,6 +405,10 @@ type T1RepositoryBase struct {
 	Columns []string
 	DB      *sql.DB
 	Log     LogFunc
+	// Validate, if true, makes insert, update and upsert methods validate entities and patches before querying the database.
+	Validate bool
+	// StmtCache, if set, is used to prepare queries of fixed shape (insert, delete and find by key) once and reuse them.
+	StmtCache *StmtCache
 }
 
 func (r *T1RepositoryBase) updateOneByFirstNameAndLastNameAndAge(ctx context.Context, tx *sql.Tx, t1FirstName string, t1LastName string, t1Age int64, p *T1Patch) (*T1Entity, error) {
@@ -394,7 +437,7 @@ func (r *T1RepositoryBase) updateOneByFirstNameAndLastNameAndAge(ctx context.Con
 		}
 	}
 	if err != nil {
-		return nil, err
+		return nil, wrapError(err)
 	}
 	return &ent, nil
 }
@@ -425,7 +468,7 @@ func (r *T1RepositoryBase) updateOneByFirstNameAndLastNameWhereAgeIsNotSet(ctx c
 		}
 	}
 	if err != nil {
-		return nil, err
+		return nil, wrapError(err)
 	}
 	return &ent, nil
 }`)
@@ -1,6 +1,8 @@
 package gogen
 
 import (
+	"strings"
+
 	"github.com/piotrkowalczuk/pqt"
 	"github.com/piotrkowalczuk/pqt/pqtfmt"
 )
@@ -52,6 +54,7 @@ func (g *Generator) RepositoryMethodPrivateUpsert(t *pqt.Table) {
 		entityName,
 		entityName,
 	)
+	g.sessionOutsideTransaction(t, "nil, ")
 	g.Printf(`
 			query, args, err := r.%sQuery(e, p, inf...)
 			if err != nil {
@@ -83,7 +86,7 @@ func (g *Generator) RepositoryMethodPrivateUpsert(t *pqt.Table) {
 			}
 		}
 		if err != nil {
-			return nil, err
+			return nil, wrapError(err)
 		}
 		return e, nil
 	}`,
@@ -109,6 +112,7 @@ func (g *Generator) RepositoryMethodUpsertQuery(t *pqt.Table) {
 		entityName,
 		entityName,
 	)
+	g.validateQuery("", "e", "p != nil", "p")
 	g.Printf(`
 		upsert := NewComposer(%d)
 		columns := bytes.NewBuffer(nil)
@@ -178,3 +182,413 @@ func (g *Generator) RepositoryMethodUpsertQuery(t *pqt.Table) {
 		return buf.String(), upsert.Args(), nil
 	}`)
 }
+
+// conflictTargets returns primary key, unique constraints and unique indexes of the table,
+// each of them can be used as a conflict target.
+func conflictTargets(t *pqt.Table) []*pqt.Constraint {
+	var targets []*pqt.Constraint
+	for _, c := range t.Constraints {
+		switch c.Type {
+		case pqt.ConstraintTypePrimaryKey, pqt.ConstraintTypeUnique, pqt.ConstraintTypeUniqueIndex:
+			targets = append(targets, c)
+		}
+	}
+	return targets
+}
+
+func conflictTargetName(c *pqt.Constraint) string {
+	switch c.Type {
+	case pqt.ConstraintTypePrimaryKey:
+		return pqtfmt.Public(c.PrimaryTable.Name, "conflictPrimaryKey")
+	case pqt.ConstraintTypeUnique:
+		return pqtfmt.Public(c.PrimaryTable.Name, "conflict", pqt.JoinColumns(c.PrimaryColumns, "_"), "unique")
+	}
+	name := []string{c.PrimaryTable.Name, "conflict", pqt.JoinColumns(c.PrimaryColumns, "_"), "uniqueIndex"}
+	if len(c.Where) > 0 && len(c.MethodSuffix) > 0 {
+		name = append(name, "where", c.MethodSuffix)
+	}
+	return pqtfmt.Public(name...)
+}
+
+func hasInsertableColumns(t *pqt.Table) bool {
+	for _, c := range t.Columns {
+		if isInsertable(c) {
+			return true
+		}
+	}
+	return false
+}
+
+// UpsertExpr generates conflict target type, a variable for each constraint that can be used as a target and upsert expression.
+func (g *Generator) UpsertExpr(t *pqt.Table) {
+	if g.Version < 9.5 || !hasInsertableColumns(t) {
+		return
+	}
+
+	entityName := pqtfmt.Public(t.Name)
+
+	g.Printf(`
+// %sConflictTarget is a conflict target of %s upsert, only %sConflict* variables can be used.
+type %sConflictTarget struct {
+	constraint string
+	columns    []string
+	where      string
+}
+
+func (ct %sConflictTarget) write(buf *bytes.Buffer) {
+	switch {
+	case ct.constraint != "":
+		buf.WriteString(" ON CONSTRAINT \"")
+		buf.WriteString(ct.constraint)
+		buf.WriteString("\"")
+	case len(ct.columns) > 0:
+		buf.WriteString(" (")
+		buf.WriteString(strings.Join(ct.columns, ", "))
+		buf.WriteString(")")
+		if ct.where != "" {
+			buf.WriteString(" WHERE ")
+			buf.WriteString(ct.where)
+		}
+	}
+}`,
+		entityName, t.Name, entityName,
+		entityName,
+		entityName,
+	)
+
+	if targets := conflictTargets(t); len(targets) > 0 {
+		g.Print(`
+
+var (`)
+		for _, c := range targets {
+			switch c.Type {
+			case pqt.ConstraintTypeUniqueIndex:
+				columns := make([]string, 0, len(c.PrimaryColumns))
+				for _, pc := range c.PrimaryColumns {
+					columns = append(columns, pqtfmt.Public("table", t.Name, "column", pc.Name))
+				}
+				g.Printf(`
+	// %s targets unique index on %s.
+	%s = %sConflictTarget{columns: []string{%s}, where: %q}`,
+					conflictTargetName(c), pqt.JoinColumns(c.PrimaryColumns, ", "),
+					conflictTargetName(c), entityName, strings.Join(columns, ", "), c.Where,
+				)
+			case pqt.ConstraintTypePrimaryKey:
+				g.Printf(`
+	// %s targets primary key constraint.
+	%s = %sConflictTarget{constraint: %s}`,
+					conflictTargetName(c),
+					conflictTargetName(c), entityName, pqtfmt.Public("table", t.Name, "constraintPrimaryKey"),
+				)
+			default:
+				g.Printf(`
+	// %s targets unique constraint on %s.
+	%s = %sConflictTarget{constraint: %s}`,
+					conflictTargetName(c), pqt.JoinColumns(c.PrimaryColumns, ", "),
+					conflictTargetName(c), entityName, pqtfmt.Public("table", t.Name, "constraint", pqt.JoinColumns(c.PrimaryColumns, "_"), "Unique"),
+				)
+			}
+		}
+		g.Print(`
+)`)
+	}
+
+	g.Printf(`
+
+// %sUpsertExpr describes how conflicts are resolved by UpsertOne and UpsertMany.
+type %sUpsertExpr struct {
+	// Target is required if Patch is set, DO NOTHING can be used without it.
+	Target %sConflictTarget
+	// Patch is applied to the conflicting row (DO UPDATE), if nil or empty the row is left untouched (DO NOTHING).
+	Patch *%sPatch
+	// Where limits DO UPDATE to the conflicting rows that match the criteria, other rows are left untouched.
+	Where *%sCriteria
+}`,
+		entityName,
+		entityName,
+		entityName,
+		entityName,
+		entityName,
+	)
+}
+
+func (g *Generator) RepositoryMethodUpsertManyQuery(t *pqt.Table) {
+	if g.Version < 9.5 || !hasInsertableColumns(t) {
+		return
+	}
+
+	entityName := pqtfmt.Public(t.Name)
+
+	var columns []string
+	for _, c := range t.Columns {
+		if isInsertable(c) {
+			columns = append(columns, pqtfmt.Public("table", t.Name, "column", c.Name))
+		}
+	}
+
+	g.Printf(`
+		func (r *%sRepositoryBase) %sQuery(ue *%sUpsertExpr, es ...*%sEntity) (string, []interface{}, error) {
+			if len(es) == 0 {
+				return "", nil, errors.New("upsert requires at least one entity")
+			}
+			if ue == nil {
+				ue = &%sUpsertExpr{}
+			}
+			if r.%s {
+				for _, e := range es {
+					if err := e.Validate(); err != nil {
+						return "", nil, err
+					}
+				}
+				if ue.Patch != nil {
+					if err := ue.Patch.Validate(); err != nil {
+						return "", nil, err
+					}
+				}
+			}
+			upsert := NewComposer(%d)
+			buf := bytes.NewBufferString("INSERT INTO ")
+			buf.WriteString(r.%s)
+			buf.WriteString(" AS t0 (")
+			buf.WriteString(%s)
+			buf.WriteString(") VALUES ")
+			for i, e := range es {
+				if i != 0 {
+					if _, err := upsert.WriteString(", "); err != nil {
+						return "", nil, err
+					}
+				}
+				if _, err := upsert.WriteString("("); err != nil {
+					return "", nil, err
+				}`,
+		entityName,
+		pqtfmt.Public("upsertMany"),
+		entityName,
+		entityName,
+		entityName,
+		pqtfmt.Public("validate"),
+		len(columns),
+		pqtfmt.Public("table"),
+		strings.Join(columns, ` + ", " + `),
+	)
+
+	first := true
+	for _, c := range t.Columns {
+		if !isInsertable(c) {
+			continue
+		}
+		if !first {
+			g.Print(`
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}`)
+		}
+		first = false
+
+		conds := g.insertConditions(c)
+		if len(conds) > 0 {
+			g.Printf(`
+				if %s {`, strings.Join(conds, " && "))
+		}
+		g.Printf(`
+				if err := upsert.WritePlaceholder(); err != nil {
+					return "", nil, err
+				}
+				upsert.Add(e.%s)`, pqtfmt.Public(c.Name))
+		if len(conds) > 0 {
+			g.Print(`
+				} else if _, err := upsert.WriteString("DEFAULT"); err != nil {
+					return "", nil, err
+				}`)
+		}
+	}
+
+	g.Print(`
+				if _, err := upsert.WriteString(")"); err != nil {
+					return "", nil, err
+				}
+			}
+			buf.ReadFrom(upsert)
+			buf.WriteString(" ON CONFLICT")
+			ue.Target.write(buf)
+
+			upsert.Dirty=false
+			if p := ue.Patch; p != nil {`)
+	for _, c := range t.Columns {
+		if c.IsDynamic {
+			continue
+		}
+		g.generateRepositorySetClause(c, "upsert")
+	}
+	g.Printf(`
+			}
+			if !upsert.Dirty {
+				buf.WriteString(" DO NOTHING")
+			} else {
+				if ue.Target.constraint == "" && len(ue.Target.columns) == 0 {
+					return "", nil, errors.New("upsert with patch requires conflict target")
+				}
+				buf.WriteString(" DO UPDATE SET ")
+				buf.ReadFrom(upsert)
+				if ue.Where != nil {
+					upsert.Dirty=false
+					if err := %sCriteriaWhereClause(upsert, ue.Where, 0, r.%s); err != nil {
+						return "", nil, err
+					}
+					if upsert.Dirty {
+						buf.WriteString(" WHERE ")
+						buf.ReadFrom(upsert)
+					}
+				}
+			}
+			buf.WriteString(" RETURNING ")
+			if len(r.%s) > 0 {
+				buf.WriteString(strings.Join(r.%s, ", "))
+			} else {`,
+		entityName,
+		pqtfmt.Public("table"),
+		pqtfmt.Public("columns"),
+		pqtfmt.Public("columns"),
+	)
+	g.Print(`
+		buf.WriteString("`)
+	g.selectList(t, -1)
+	g.Print(`")
+	}
+		return buf.String(), upsert.Args(), nil
+	}`)
+}
+
+func (g *Generator) RepositoryMethodPrivateUpsertMany(t *pqt.Table) {
+	if g.Version < 9.5 || !hasInsertableColumns(t) {
+		return
+	}
+
+	entityName := pqtfmt.Public(t.Name)
+
+	g.Printf(`
+		func (r *%sRepositoryBase) %s(ctx context.Context, tx *sql.Tx, ue *%sUpsertExpr, es ...*%sEntity) ([]*%sEntity, error) {`,
+		entityName,
+		pqtfmt.Private("upsertMany"),
+		entityName,
+		entityName,
+		entityName,
+	)
+	g.sessionOutsideTransaction(t, "nil, ")
+	g.Printf(`
+			query, args, err := r.%sQuery(ue, es...)
+			if err != nil {
+				return nil, err
+			}
+			var rows *sql.Rows
+			if tx == nil {
+				rows, err = r.%s.QueryContext(ctx, query, args...)
+			} else {
+				rows, err = tx.QueryContext(ctx, query, args...)
+			}
+			if r.%s != nil {
+				if tx == nil {
+					r.%s(err, Table%s, "upsert many", query, args...)
+				} else {
+					r.%s(err, Table%s, "upsert many tx", query, args...)
+				}
+			}
+			if err != nil {
+				return nil, wrapError(err)
+			}
+			defer rows.Close()
+
+			var entities []*%sEntity
+			for rows.Next() {
+				var ent %sEntity
+				props, err := ent.%s(r.%s...)
+				if err != nil {
+					return nil, err
+				}
+				if err = rows.Scan(props...); err != nil {
+					return nil, err
+				}
+				entities = append(entities, &ent)
+			}
+			if err = rows.Err(); err != nil {
+				return nil, wrapError(err)
+			}
+			return entities, nil
+		}`,
+		pqtfmt.Public("upsertMany"),
+		pqtfmt.Public("db"),
+		pqtfmt.Public("log"),
+		pqtfmt.Public("log"),
+		entityName,
+		pqtfmt.Public("log"),
+		entityName,
+		entityName,
+		entityName,
+		pqtfmt.Public("props"),
+		pqtfmt.Public("columns"),
+	)
+}
+
+func (g *Generator) RepositoryMethodUpsertMany(t *pqt.Table) {
+	if g.Version < 9.5 || !hasInsertableColumns(t) {
+		return
+	}
+
+	entityName := pqtfmt.Public(t.Name)
+
+	g.Printf(`
+		// %s inserts entities in a single statement, conflicts are resolved as described by the expression.
+		// Returned entities are those inserted or updated, rows left untouched by DO NOTHING or Where are omitted.
+		func (r *%sRepositoryBase) %s(ctx context.Context, ue *%sUpsertExpr, es ...*%sEntity) ([]*%sEntity, error) {
+			return r.%s(ctx, nil, ue, es...)
+		}
+
+		// %s inserts the entity, conflict is resolved as described by the expression.
+		// Returned flag is false if the row was neither inserted nor updated.
+		func (r *%sRepositoryBase) %s(ctx context.Context, e *%sEntity, ue *%sUpsertExpr) (*%sEntity, bool, error) {
+			return r.%s(ctx, nil, e, ue)
+		}
+
+		func (r *%sRepositoryBase) %s(ctx context.Context, tx *sql.Tx, e *%sEntity, ue *%sUpsertExpr) (*%sEntity, bool, error) {
+			entities, err := r.%s(ctx, tx, ue, e)
+			if err != nil {
+				return nil, false, err
+			}
+			if len(entities) == 0 {
+				return e, false, nil
+			}
+			*e = *entities[0]
+			return e, true, nil
+		}`,
+		pqtfmt.Public("upsertMany"),
+		entityName, pqtfmt.Public("upsertMany"), entityName, entityName, entityName,
+		pqtfmt.Private("upsertMany"),
+		pqtfmt.Public("upsertOne"),
+		entityName, pqtfmt.Public("upsertOne"), entityName, entityName, entityName,
+		pqtfmt.Private("upsertOne"),
+		entityName, pqtfmt.Private("upsertOne"), entityName, entityName, entityName,
+		pqtfmt.Private("upsertMany"),
+	)
+}
+
+func (g *Generator) RepositoryTxMethodUpsertMany(t *pqt.Table) {
+	if g.Version < 9.5 || !hasInsertableColumns(t) {
+		return
+	}
+
+	entityName := pqtfmt.Public(t.Name)
+
+	g.Printf(`
+		func (r *%sRepositoryBaseTx) %s(ctx context.Context, ue *%sUpsertExpr, es ...*%sEntity) ([]*%sEntity, error) {
+			return r.base.%s(ctx, r.tx, ue, es...)
+		}
+
+		func (r *%sRepositoryBaseTx) %s(ctx context.Context, e *%sEntity, ue *%sUpsertExpr) (*%sEntity, bool, error) {
+			return r.base.%s(ctx, r.tx, e, ue)
+		}`,
+		entityName, pqtfmt.Public("upsertMany"), entityName, entityName, entityName,
+		pqtfmt.Private("upsertMany"),
+		entityName, pqtfmt.Public("upsertOne"), entityName, entityName, entityName,
+		pqtfmt.Private("upsertOne"),
+	)
+}
@@ -24,6 +24,10 @@ type T2RepositoryBase struct {
 	Columns []string
 	DB      *sql.DB
 	Log     LogFunc
+	// Validate, if true, makes insert, update and upsert methods validate entities and patches before querying the database.
+	Validate bool
+	// StmtCache, if set, is used to prepare queries of fixed shape (insert, delete and find by key) once and reuse them.
+	StmtCache *StmtCache
 }`)
 
 	g = &gogen.Generator{Version: 9.5}
@@ -35,6 +39,10 @@ type T2RepositoryBase struct {
 	Columns []string
 	DB      *sql.DB
 	Log     LogFunc
+	// Validate, if true, makes insert, update and upsert methods validate entities and patches before querying the database.
+	Validate bool
+	// StmtCache, if set, is used to prepare queries of fixed shape (insert, delete and find by key) once and reuse them.
+	StmtCache *StmtCache
 }
 
 func (r *T2RepositoryBase) upsert(ctx context.Context, tx *sql.Tx, e *T2Entity, p *T2Patch, inf ...string) (*T2Entity, error) {
@@ -61,7 +69,7 @@ func (r *T2RepositoryBase) upsert(ctx context.Context, tx *sql.Tx, e *T2Entity,
 		} 
 	}
 	if err != nil {
-		return nil, err
+		return nil, wrapError(err)
 	}
 	return e, nil
 }`)
@@ -103,6 +111,10 @@ type T2RepositoryBase struct {
 	Columns []string
 	DB      *sql.DB
 	Log     LogFunc
+	// Validate, if true, makes insert, update and upsert methods validate entities and patches before querying the database.
+	Validate bool
+	// StmtCache, if set, is used to prepare queries of fixed shape (insert, delete and find by key) once and reuse them.
+	StmtCache *StmtCache
 }`)
 	g = &gogen.Generator{Version: 9.5}
 	g.Repository(t2) // Is here so output can be properly formatted
@@ -114,9 +126,23 @@ type T2RepositoryBase struct {
 	Columns []string
 	DB      *sql.DB
 	Log     LogFunc
+	// Validate, if true, makes insert, update and upsert methods validate entities and patches before querying the database.
+	Validate bool
+	// StmtCache, if set, is used to prepare queries of fixed shape (insert, delete and find by key) once and reuse them.
+	StmtCache *StmtCache
 }
 
 func (r *T2RepositoryBase) UpsertQuery(e *T2Entity, p *T2Patch, inf ...string) (string, []interface{}, error) {
+	if r.Validate {
+		if err := e.Validate(); err != nil {
+			return "", nil, err
+		}
+		if p != nil {
+			if err := p.Validate(); err != nil {
+				return "", nil, err
+			}
+		}
+	}
 	upsert := NewComposer(14)
 	columns := bytes.NewBuffer(nil)
 	buf := bytes.NewBufferString("INSERT INTO ")
@@ -375,3 +401,56 @@ func (r *T2RepositoryBase) UpsertQuery(e *T2Entity, p *T2Patch, inf ...string) (
 	return buf.String(), upsert.Args(), nil
 }`)
 }
+
+func TestGenerator_UpsertExpr(t *testing.T) {
+	name := pqt.NewColumn("name", pqt.TypeText(), pqt.WithNotNull())
+	t1 := pqt.NewTable("t1").
+		AddColumn(pqt.NewColumn("id", pqt.TypeSerialBig(), pqt.WithPrimaryKey())).
+		AddColumn(name).
+		AddColumn(pqt.NewColumn("deleted_at", pqt.TypeTimestampTZ()))
+	t1.AddUniqueIndex("active", "deleted_at IS NULL", name)
+
+	g := &gogen.Generator{Version: 9.5}
+	g.UpsertExpr(t1)
+	testutil.AssertOutput(t, g.Printer, `
+// T1ConflictTarget is a conflict target of t1 upsert, only T1Conflict* variables can be used.
+type T1ConflictTarget struct {
+	constraint string
+	columns    []string
+	where      string
+}
+
+func (ct T1ConflictTarget) write(buf *bytes.Buffer) {
+	switch {
+	case ct.constraint != "":
+		buf.WriteString(" ON CONSTRAINT \"")
+		buf.WriteString(ct.constraint)
+		buf.WriteString("\"")
+	case len(ct.columns) > 0:
+		buf.WriteString(" (")
+		buf.WriteString(strings.Join(ct.columns, ", "))
+		buf.WriteString(")")
+		if ct.where != "" {
+			buf.WriteString(" WHERE ")
+			buf.WriteString(ct.where)
+		}
+	}
+}
+
+var (
+	// T1ConflictPrimaryKey targets primary key constraint.
+	T1ConflictPrimaryKey = T1ConflictTarget{constraint: TableT1ConstraintPrimaryKey}
+	// T1ConflictNameUniqueIndexWhereActive targets unique index on name.
+	T1ConflictNameUniqueIndexWhereActive = T1ConflictTarget{columns: []string{TableT1ColumnName}, where: "deleted_at IS NULL"}
+)
+
+// T1UpsertExpr describes how conflicts are resolved by UpsertOne and UpsertMany.
+type T1UpsertExpr struct {
+	// Target is required if Patch is set, DO NOTHING can be used without it.
+	Target T1ConflictTarget
+	// Patch is applied to the conflicting row (DO UPDATE), if nil or empty the row is left untouched (DO NOTHING).
+	Patch *T1Patch
+	// Where limits DO UPDATE to the conflicting rows that match the criteria, other rows are left untouched.
+	Where *T1Criteria
+}`)
+}
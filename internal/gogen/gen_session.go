@@ -0,0 +1,90 @@
+package gogen
+
+import (
+	"github.com/piotrkowalczuk/pqt"
+	"github.com/piotrkowalczuk/pqt/pqtfmt"
+)
+
+func hasRowLevelSecurity(s *pqt.Schema) bool {
+	for _, t := range s.Tables {
+		if t.RowLevelSecurity {
+			return true
+		}
+	}
+	return false
+}
+
+// Session generates Session type and the function that applies it to a transaction.
+// It is generated only if any table of the schema has row-level security enabled.
+func (g *Generator) Session(s *pqt.Schema) {
+	if !hasRowLevelSecurity(s) {
+		return
+	}
+	g.Print(`
+// Session describes role and settings applied to a transaction using SET LOCAL.
+// Row-level security policies can read them using current_user and current_setting.
+type Session struct {
+	// Role, if not empty, is set using SET LOCAL ROLE.
+	Role string
+	// Settings are set for the duration of the transaction, e.g. app.user_id.
+	Settings map[string]string
+}
+
+// ErrSessionOutsideTransaction is returned when repository that has a session is used outside of a transaction.
+// Settings applied using SET LOCAL last until the end of a transaction, without them policies would be evaluated for the login role.
+var ErrSessionOutsideTransaction = errors.New("session requires a transaction")
+
+// SessionFunc returns session of the given context, if nil is returned, nothing is applied.
+type SessionFunc func(ctx context.Context) (*Session, error)
+
+func applySession(ctx context.Context, tx *sql.Tx, fn SessionFunc) error {
+	s, err := fn(ctx)
+	if err != nil || s == nil {
+		return err
+	}
+	if s.Role != "" {
+		if _, err := tx.ExecContext(ctx, "SET LOCAL ROLE "+pq.QuoteIdentifier(s.Role)); err != nil {
+			return err
+		}
+	}
+	for name, value := range s.Settings {
+		if _, err := tx.ExecContext(ctx, "SELECT set_config($1, $2, true)", name, value); err != nil {
+			return err
+		}
+	}
+	return nil
+}`)
+}
+
+// applySession generates code that applies session of the repository to the tx transaction.
+// Given statements handle an error.
+func (g *Generator) applySession(t *pqt.Table, onError string) {
+	if !t.RowLevelSecurity {
+		return
+	}
+	g.Printf(`
+	if r.%s != nil {
+		if err := applySession(ctx, tx, r.%s); err != nil {
+			%s
+		}
+	}`,
+		pqtfmt.Public("session"),
+		pqtfmt.Public("session"),
+		onError,
+	)
+}
+
+// sessionOutsideTransaction generates code that rejects queries outside of a transaction if the repository has a session.
+// Given values precede the error in the return statement.
+func (g *Generator) sessionOutsideTransaction(t *pqt.Table, zero string) {
+	if !t.RowLevelSecurity {
+		return
+	}
+	g.Printf(`
+	if tx == nil && r.%s != nil {
+		return %sErrSessionOutsideTransaction
+	}`,
+		pqtfmt.Public("session"),
+		zero,
+	)
+}
@@ -0,0 +1,89 @@
+package gogen_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/piotrkowalczuk/pqt"
+	"github.com/piotrkowalczuk/pqt/internal/gogen"
+	"github.com/piotrkowalczuk/pqt/internal/testutil"
+)
+
+func TestGenerator_Session(t *testing.T) {
+	t1 := pqt.NewTable("t1").AddColumn(pqt.NewColumn("id", pqt.TypeSerialBig(), pqt.WithPrimaryKey()))
+
+	g := &gogen.Generator{}
+	g.Session(pqt.NewSchema("example").AddTable(t1))
+	if g.Len() != 0 {
+		t.Errorf("session is not expected without row-level security, got:\n%s", g.String())
+	}
+
+	t1.RowLevelSecurity = true
+	g = &gogen.Generator{}
+	g.Repository(t1)
+	g.RepositoryMethodBeginTx(t1)
+	testutil.AssertOutput(t, g.Printer, `
+type T1RepositoryBase struct {
+	Table   string
+	Columns []string
+	DB      *sql.DB
+	Log     LogFunc
+	// Session, if set, is applied at the beginning of transactions started by BeginTx and RunInTransaction.
+	// Queries outside of a transaction fail with ErrSessionOutsideTransaction.
+	Session SessionFunc
+	// Validate, if true, makes insert, update and upsert methods validate entities and patches before querying the database.
+	Validate bool
+	// StmtCache, if set, is used to prepare queries of fixed shape (insert, delete and find by key) once and reuse them.
+	StmtCache *StmtCache
+}
+
+func (r *T1RepositoryBase) BeginTx(ctx context.Context, opts ...TxOption) (*T1RepositoryBaseTx, error) {
+	tx, err := r.DB.BeginTx(ctx, newTxOptions(opts).sql())
+	if err != nil {
+		return nil, err
+	}
+	if r.Session != nil {
+		if err := applySession(ctx, tx, r.Session); err != nil {
+			_ = tx.Rollback()
+			return nil, err
+		}
+	}
+	return r.Tx(tx)
+}`)
+}
+
+func TestGenerator_sessionOutsideTransaction(t *testing.T) {
+	t1 := pqt.NewTable("t1").
+		AddColumn(pqt.NewColumn("id", pqt.TypeSerialBig(), pqt.WithPrimaryKey()))
+	pqt.NewSchema("example").AddTable(t1)
+
+	g := &gogen.Generator{}
+	g.RepositoryMethodPrivateCount(t1)
+	if strings.Contains(g.String(), "ErrSessionOutsideTransaction") {
+		t.Errorf("session check is not expected without row-level security, got:\n%s", g.String())
+	}
+
+	t1.RowLevelSecurity = true
+	g = &gogen.Generator{}
+	g.RepositoryMethodPrivateDeleteOneByPrimaryKey(t1)
+	testutil.AssertOutput(t, g.Printer, `
+		func (r *T1RepositoryBase) deleteOneByID(ctx context.Context, tx *sql.Tx, pk int64) (int64, error) {
+			if tx == nil && r.Session != nil {
+				return 0, ErrSessionOutsideTransaction
+			}
+			find := NewComposer(1)
+			find.WriteString("DELETE FROM ")
+			find.WriteString(r.Table)
+			find.WriteString(" WHERE ")
+			find.WriteString(TableT1ColumnID)
+			find.WriteString("=")
+			find.WritePlaceholder()
+			find.Add(pk)
+			res, err := exec(ctx, r.DB, tx, r.StmtCache, find.String(), find.Args()...)
+			if err != nil {
+				return 0, wrapError(err)
+			}
+
+			return res.RowsAffected()
+		}`)
+}
@@ -0,0 +1,170 @@
+package gogen
+
+// StmtCache generates bounded cache of prepared statements and helpers that execute queries using it.
+func (g *Generator) StmtCache() {
+	g.Print(`
+// StmtCache is a bounded cache of prepared statements, keyed by the query.
+// Repositories that share it have to use the same connection pool the cache was created for.
+// Once the cache is full, the least recently used statement is closed.
+type StmtCache struct {
+	db    *sql.DB
+	size  int
+	mu    sync.Mutex
+	lru   *list.List
+	stmts map[string]*list.Element
+}
+
+type cachedStmt struct {
+	query   string
+	stmt    *sql.Stmt
+	refs    int
+	evicted bool
+}
+
+// NewStmtCache allocates StmtCache that holds up to size statements prepared on the db.
+func NewStmtCache(db *sql.DB, size int) *StmtCache {
+	if size < 1 {
+		size = 1
+	}
+	return &StmtCache{
+		db:    db,
+		size:  size,
+		lru:   list.New(),
+		stmts: make(map[string]*list.Element, size),
+	}
+}
+
+// Len returns number of cached statements.
+func (c *StmtCache) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.lru.Len()
+}
+
+// Close closes all cached statements. Statements that are in use are closed once released.
+func (c *StmtCache) Close() (err error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for el := c.lru.Front(); el != nil; el = el.Next() {
+		if cerr := c.evict(el.Value.(*cachedStmt)); cerr != nil && err == nil {
+			err = cerr
+		}
+	}
+	c.lru.Init()
+	c.stmts = make(map[string]*list.Element, c.size)
+	return err
+}
+
+// acquire returns statement of the query, preparing it if it is not cached yet.
+// Statement has to be released once it is not used anymore.
+func (c *StmtCache) acquire(ctx context.Context, query string) (*cachedStmt, error) {
+	if cs := c.get(query); cs != nil {
+		return cs, nil
+	}
+	stmt, err := c.db.PrepareContext(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if el, ok := c.stmts[query]; ok {
+		// Prepared concurrently, the cached one wins.
+		_ = stmt.Close()
+		c.lru.MoveToFront(el)
+		cs := el.Value.(*cachedStmt)
+		cs.refs++
+		return cs, nil
+	}
+	cs := &cachedStmt{query: query, stmt: stmt, refs: 1}
+	c.stmts[query] = c.lru.PushFront(cs)
+	for c.lru.Len() > c.size {
+		el := c.lru.Back()
+		c.lru.Remove(el)
+		delete(c.stmts, el.Value.(*cachedStmt).query)
+		_ = c.evict(el.Value.(*cachedStmt))
+	}
+	return cs, nil
+}
+
+func (c *StmtCache) get(query string) *cachedStmt {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	el, ok := c.stmts[query]
+	if !ok {
+		return nil
+	}
+	c.lru.MoveToFront(el)
+	cs := el.Value.(*cachedStmt)
+	cs.refs++
+	return cs
+}
+
+func (c *StmtCache) release(cs *cachedStmt) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	cs.refs--
+	if cs.evicted && cs.refs == 0 {
+		_ = cs.stmt.Close()
+	}
+}
+
+// evict marks statement as removed from the cache, it is closed right away if it is not in use.
+func (c *StmtCache) evict(cs *cachedStmt) error {
+	cs.evicted = true
+	if cs.refs == 0 {
+		return cs.stmt.Close()
+	}
+	return nil
+}
+
+// queryRow executes query that is expected to return at most one row and scans it into dest.
+// If cache is given, the query is executed as a prepared statement, bound to the tx transaction if it is not nil.
+func queryRow(ctx context.Context, db *sql.DB, tx *sql.Tx, cache *StmtCache, query string, args []interface{}, dest ...interface{}) error {
+	if cache == nil {
+		if tx == nil {
+			return db.QueryRowContext(ctx, query, args...).Scan(dest...)
+		}
+		return tx.QueryRowContext(ctx, query, args...).Scan(dest...)
+	}
+	cs, err := cache.acquire(ctx, query)
+	if err != nil {
+		return err
+	}
+	defer cache.release(cs)
+
+	stmt := cs.stmt
+	if tx != nil {
+		stmt = tx.StmtContext(ctx, stmt)
+		defer stmt.Close()
+	}
+	return stmt.QueryRowContext(ctx, args...).Scan(dest...)
+}
+
+// exec works like queryRow, but for queries that do not return rows.
+func exec(ctx context.Context, db *sql.DB, tx *sql.Tx, cache *StmtCache, query string, args ...interface{}) (sql.Result, error) {
+	if cache == nil {
+		if tx == nil {
+			return db.ExecContext(ctx, query, args...)
+		}
+		return tx.ExecContext(ctx, query, args...)
+	}
+	cs, err := cache.acquire(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer cache.release(cs)
+
+	stmt := cs.stmt
+	if tx != nil {
+		stmt = tx.StmtContext(ctx, stmt)
+		defer stmt.Close()
+	}
+	return stmt.ExecContext(ctx, args...)
+}`)
+}
@@ -0,0 +1,230 @@
+package gogen
+
+import (
+	"github.com/piotrkowalczuk/pqt"
+	"github.com/piotrkowalczuk/pqt/pqtfmt"
+)
+
+func (g *Generator) textSearchTypes() {
+	g.Print(`
+// TextSearchMode defines how the text search query is parsed.
+type TextSearchMode int
+
+const (
+	// TextSearchWeb uses websearch_to_tsquery, it supports quoted phrases, OR and - operators.
+	TextSearchWeb TextSearchMode = iota
+	// TextSearchPlain uses plainto_tsquery, all words are required.
+	TextSearchPlain
+)
+
+// TextSearch is a full-text search query.
+type TextSearch struct {
+	Query string
+	Mode  TextSearchMode
+}
+
+// TextSearchHeadline requests ts_headline snippet of the matching document.
+type TextSearchHeadline struct {
+	TextSearch
+	// Options are passed to ts_headline as they are, e.g. "MaxWords=35, MinWords=15".
+	Options string
+}
+`)
+}
+
+// TextSearchStatics generates helper that writes text search queries, if any table of the schema has searchable columns.
+func (g *Generator) TextSearchStatics(s *pqt.Schema) {
+	if !hasTextSearch(s) {
+		return
+	}
+	g.Print(`
+func textSearchQuery(comp *Composer, config string, ts *TextSearch) error {
+	switch ts.Mode {
+	case TextSearchWeb:
+		if _, err := comp.WriteString("websearch_to_tsquery('"); err != nil {
+			return err
+		}
+	case TextSearchPlain:
+		if _, err := comp.WriteString("plainto_tsquery('"); err != nil {
+			return err
+		}
+	default:
+		return errors.New("unknown text search mode: " + strconv.Itoa(int(ts.Mode)))
+	}
+	if _, err := comp.WriteString(config); err != nil {
+		return err
+	}
+	if _, err := comp.WriteString("', "); err != nil {
+		return err
+	}
+	if err := comp.WritePlaceholder(); err != nil {
+		return err
+	}
+	if _, err := comp.WriteString(")"); err != nil {
+		return err
+	}
+	comp.Add(ts.Query)
+	return nil
+}
+`)
+}
+
+func (g *Generator) textSearchCriteriaFields(c *pqt.Column) {
+	if c.TextSearch == nil || c.IsDynamic {
+		return
+	}
+	g.Printf(`
+// %s matches rows which %s matches given query (@@).
+%s *TextSearch`, pqtfmt.Public(c.Name, "search"), c.Name, pqtfmt.Public(c.Name, "search"))
+}
+
+func (g *Generator) textSearchWhereClause(c *pqt.Column) {
+	if c.TextSearch == nil || c.IsDynamic {
+		return
+	}
+	g.Printf(`
+		if c.%s != nil {
+			if comp.Dirty {
+				comp.WriteString(" AND ")
+			}
+			if err := comp.WriteAlias(id); err != nil {
+				return err
+			}
+			if _, err := comp.WriteString(%s); err != nil {
+				return err
+			}
+			if _, err := comp.WriteString(" @@ "); err != nil {
+				return err
+			}
+			if err := textSearchQuery(comp, "%s", c.%s); err != nil {
+				return err
+			}
+			comp.Dirty=true
+		}`,
+		pqtfmt.Public(c.Name, "search"),
+		pqtfmt.Public("table", c.Table.Name, "column", c.Name),
+		c.TextSearch.Config,
+		pqtfmt.Public(c.Name, "search"),
+	)
+}
+
+func (g *Generator) textSearchFindExprFields(t *pqt.Table) {
+	for _, c := range textSearchColumns(t) {
+		g.Printf(`
+// %s orders rows by ts_rank of %s against given query, before any other order.
+%s *TextSearch`, pqtfmt.Public(c.Name, "rank"), c.Name, pqtfmt.Public(c.Name, "rank"))
+	}
+	for _, c := range headlineColumns(t) {
+		g.Printf(`
+// %s, if set, populates %sEntity.%s.
+%s *TextSearchHeadline`, pqtfmt.Public(c.Name, "headline"), pqtfmt.Public(t.Name), pqtfmt.Public(c.Name, "headline"), pqtfmt.Public(c.Name, "headline"))
+	}
+}
+
+func (g *Generator) textSearchEntityFields(t *pqt.Table) {
+	for _, c := range headlineColumns(t) {
+		g.Printf(`
+// %s is a ts_headline snippet of %s, it is populated only if requested by %sFindExpr.%s.
+%s sql.NullString`,
+			pqtfmt.Public(c.Name, "headline"),
+			c.Name,
+			pqtfmt.Public(t.Name),
+			pqtfmt.Public(c.Name, "headline"),
+			pqtfmt.Public(c.Name, "headline"),
+		)
+	}
+}
+
+func (g *Generator) textSearchSelect(t *pqt.Table) {
+	for _, c := range headlineColumns(t) {
+		g.Printf(`
+		if fe.%s != nil {
+			if _, err := comp.WriteString(", ts_headline('%s', %s, "); err != nil {
+				return "", nil, err
+			}
+			if err := textSearchQuery(comp, "%s", &fe.%s.TextSearch); err != nil {
+				return "", nil, err
+			}
+			if fe.%s.Options != "" {
+				if _, err := comp.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+				if err := comp.WritePlaceholder(); err != nil {
+					return "", nil, err
+				}
+				comp.Add(fe.%s.Options)
+			}
+			if _, err := comp.WriteString(") AS %s"); err != nil {
+				return "", nil, err
+			}
+			buf.ReadFrom(comp)
+		}`,
+			pqtfmt.Public(c.Name, "headline"),
+			c.TextSearch.Config,
+			c.TextSearch.Document("t0."),
+			c.TextSearch.Config,
+			pqtfmt.Public(c.Name, "headline"),
+			pqtfmt.Public(c.Name, "headline"),
+			pqtfmt.Public(c.Name, "headline"),
+			c.Name+"_headline",
+		)
+	}
+}
+
+// textSearchOrder returns name of the variable that holds number of already written order expressions.
+func (g *Generator) textSearchOrder(t *pqt.Table) string {
+	cols := textSearchColumns(t)
+	if len(cols) == 0 {
+		return "0"
+	}
+	g.Print(`
+	ranked := 0`)
+	for _, c := range cols {
+		g.Printf(`
+	if fe.%s != nil {
+		if ranked == 0 {
+			comp.WriteString(" ORDER BY ")
+		} else {
+			comp.WriteString(", ")
+		}
+		if _, err := comp.WriteString("ts_rank(t0.%s, "); err != nil {
+			return "", nil, err
+		}
+		if err := textSearchQuery(comp, "%s", fe.%s); err != nil {
+			return "", nil, err
+		}
+		if _, err := comp.WriteString(") DESC"); err != nil {
+			return "", nil, err
+		}
+		ranked++
+	}`,
+			pqtfmt.Public(c.Name, "rank"),
+			c.Name,
+			c.TextSearch.Config,
+			pqtfmt.Public(c.Name, "rank"),
+		)
+	}
+	return "ranked"
+}
+
+func (g *Generator) textSearchScan(t *pqt.Table, sel string) {
+	for _, c := range headlineColumns(t) {
+		g.Printf(`
+			if %s.%s != nil {
+				props = append(props, &ent.%s)
+			}`,
+			sel,
+			pqtfmt.Public(c.Name, "headline"),
+			pqtfmt.Public(c.Name, "headline"),
+		)
+	}
+}
+
+func hasTextSearch(s *pqt.Schema) bool {
+	for _, t := range s.Tables {
+		if len(textSearchColumns(t)) > 0 {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,487 @@
+package gogen
+
+import (
+	"github.com/piotrkowalczuk/pqt"
+	"github.com/piotrkowalczuk/pqt/pqtgo"
+)
+
+// TypeStatics generates scanners for types that the driver does not support, but only those that given schema uses.
+func (g *Generator) TypeStatics(s *pqt.Schema) {
+	used := make(map[string]bool)
+	for _, t := range s.Tables {
+		for _, c := range t.Columns {
+			for _, m := range []int32{pqtgo.ModeDefault, pqtgo.ModeMandatory, pqtgo.ModeCriteria} {
+				used[g.columnType(c, m)] = true
+			}
+		}
+	}
+
+	if used["Interval"] {
+		g.interval()
+	}
+	if used["Int64Range"] || used["Float64Range"] || used["TimeRange"] {
+		g.rangeBounds()
+	}
+	if used["Int64Range"] {
+		g.int64Range()
+	}
+	if used["Float64Range"] {
+		g.float64Range()
+	}
+	if used["TimeRange"] || used["TimeArray"] || used["NullTimeArray"] {
+		g.timeParsing()
+	}
+	if used["TimeRange"] {
+		g.timeRange()
+	}
+	if used["TimeArray"] || used["NullTimeArray"] {
+		g.timeArray()
+	}
+	if used["Point"] {
+		g.point()
+	}
+	if hasTextSearch(s) {
+		g.textSearchTypes()
+	}
+	if hasJSONB(s) {
+		g.jsonTypes()
+	}
+}
+
+func (g *Generator) interval() {
+	g.Printf(`
+// Interval represents Postgres interval type.
+// Only the default (postgres) interval output style is supported.
+// Month is assumed to have 30 days and year 365 days.
+type Interval struct {
+	Duration time.Duration
+	Valid bool
+}
+
+// Scan implements the sql.Scanner interface.
+func (i *Interval) Scan(src interface{}) error {
+	var s string
+	switch t := src.(type) {
+	case nil:
+		*i = Interval{}
+		return nil
+	case []byte:
+		s = string(t)
+	case string:
+		s = t
+	default:
+		return fmt.Errorf("expected slice of bytes or string as a source argument in Scan, not %%T", src)
+	}
+
+	var d time.Duration
+	fields := strings.Fields(s)
+	for n := 0; n < len(fields); n++ {
+		if strings.Contains(fields[n], ":") {
+			hms := strings.Split(strings.TrimLeft(fields[n], "+-"), ":")
+			if len(hms) != 3 {
+				return fmt.Errorf("invalid interval: %%s", s)
+			}
+			t, err := time.ParseDuration(hms[0] + "h" + hms[1] + "m" + hms[2] + "s")
+			if err != nil {
+				return fmt.Errorf("invalid interval: %%s", s)
+			}
+			if strings.HasPrefix(fields[n], "-") {
+				t = -t
+			}
+			d += t
+			continue
+		}
+		if n+1 == len(fields) {
+			return fmt.Errorf("invalid interval: %%s", s)
+		}
+		v, err := strconv.ParseInt(fields[n], 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid interval: %%s", s)
+		}
+		n++
+		switch strings.TrimSuffix(fields[n], "s") {
+		case "year":
+			d += time.Duration(v) * 365 * 24 * time.Hour
+		case "mon":
+			d += time.Duration(v) * 30 * 24 * time.Hour
+		case "day":
+			d += time.Duration(v) * 24 * time.Hour
+		default:
+			return fmt.Errorf("invalid interval: %%s", s)
+		}
+	}
+
+	*i = Interval{Duration: d, Valid: true}
+	return nil
+}
+
+// Value implements the driver.Valuer interface.
+func (i Interval) Value() (driver.Value, error) {
+	if !i.Valid {
+		return nil, nil
+	}
+	return fmt.Sprintf("%%d microseconds", int64(i.Duration/time.Microsecond)), nil
+}
+`)
+}
+
+func (g *Generator) rangeBounds() {
+	g.Printf(`
+type rangeBounds struct {
+	lower, upper string
+	lowerInclusive, upperInclusive bool
+	empty bool
+}
+
+func scanRange(src interface{}) (*rangeBounds, error) {
+	var s string
+	switch t := src.(type) {
+	case nil:
+		return nil, nil
+	case []byte:
+		s = string(t)
+	case string:
+		s = t
+	default:
+		return nil, fmt.Errorf("expected slice of bytes or string as a source argument in Scan, not %%T", src)
+	}
+	if s == "empty" {
+		return &rangeBounds{empty: true}, nil
+	}
+	if len(s) < 3 {
+		return nil, fmt.Errorf("invalid range: %%s", s)
+	}
+	parts := strings.SplitN(s[1:len(s)-1], ",", 2)
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("invalid range: %%s", s)
+	}
+	return &rangeBounds{
+		lower: strings.Trim(parts[0], ` + "`" + `"` + "`" + `),
+		upper: strings.Trim(parts[1], ` + "`" + `"` + "`" + `),
+		lowerInclusive: s[0] == '[',
+		upperInclusive: s[len(s)-1] == ']',
+	}, nil
+}
+
+func (b rangeBounds) String() string {
+	if b.empty {
+		return "empty"
+	}
+	buf := bytes.NewBuffer(nil)
+	if b.lowerInclusive {
+		buf.WriteString("[")
+	} else {
+		buf.WriteString("(")
+	}
+	if b.lower != "" {
+		buf.WriteString(strconv.Quote(b.lower))
+	}
+	buf.WriteString(",")
+	if b.upper != "" {
+		buf.WriteString(strconv.Quote(b.upper))
+	}
+	if b.upperInclusive {
+		buf.WriteString("]")
+	} else {
+		buf.WriteString(")")
+	}
+	return buf.String()
+}
+`)
+}
+
+func (g *Generator) int64Range() {
+	g.Print(`
+// Int64Range represents Postgres int4range and int8range types.
+type Int64Range struct {
+	Lower, Upper int64
+	LowerInclusive, UpperInclusive bool
+	// LowerInfinite and UpperInfinite are true if the range is unbounded on given side.
+	LowerInfinite, UpperInfinite bool
+	Empty bool
+	Valid bool
+}
+
+// Scan implements the sql.Scanner interface.
+func (r *Int64Range) Scan(src interface{}) error {
+	b, err := scanRange(src)
+	if err != nil || b == nil {
+		*r = Int64Range{}
+		return err
+	}
+	*r = Int64Range{
+		LowerInclusive: b.lowerInclusive,
+		UpperInclusive: b.upperInclusive,
+		LowerInfinite: b.lower == "" && !b.empty,
+		UpperInfinite: b.upper == "" && !b.empty,
+		Empty: b.empty,
+		Valid: true,
+	}
+	if b.lower != "" {
+		if r.Lower, err = strconv.ParseInt(b.lower, 10, 64); err != nil {
+			return err
+		}
+	}
+	if b.upper != "" {
+		if r.Upper, err = strconv.ParseInt(b.upper, 10, 64); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Value implements the driver.Valuer interface.
+func (r Int64Range) Value() (driver.Value, error) {
+	if !r.Valid {
+		return nil, nil
+	}
+	b := rangeBounds{lowerInclusive: r.LowerInclusive, upperInclusive: r.UpperInclusive, empty: r.Empty}
+	if !r.LowerInfinite {
+		b.lower = strconv.FormatInt(r.Lower, 10)
+	}
+	if !r.UpperInfinite {
+		b.upper = strconv.FormatInt(r.Upper, 10)
+	}
+	return b.String(), nil
+}
+`)
+}
+
+func (g *Generator) float64Range() {
+	g.Print(`
+// Float64Range represents Postgres numrange type.
+type Float64Range struct {
+	Lower, Upper float64
+	LowerInclusive, UpperInclusive bool
+	// LowerInfinite and UpperInfinite are true if the range is unbounded on given side.
+	LowerInfinite, UpperInfinite bool
+	Empty bool
+	Valid bool
+}
+
+// Scan implements the sql.Scanner interface.
+func (r *Float64Range) Scan(src interface{}) error {
+	b, err := scanRange(src)
+	if err != nil || b == nil {
+		*r = Float64Range{}
+		return err
+	}
+	*r = Float64Range{
+		LowerInclusive: b.lowerInclusive,
+		UpperInclusive: b.upperInclusive,
+		LowerInfinite: b.lower == "" && !b.empty,
+		UpperInfinite: b.upper == "" && !b.empty,
+		Empty: b.empty,
+		Valid: true,
+	}
+	if b.lower != "" {
+		if r.Lower, err = strconv.ParseFloat(b.lower, 64); err != nil {
+			return err
+		}
+	}
+	if b.upper != "" {
+		if r.Upper, err = strconv.ParseFloat(b.upper, 64); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Value implements the driver.Valuer interface.
+func (r Float64Range) Value() (driver.Value, error) {
+	if !r.Valid {
+		return nil, nil
+	}
+	b := rangeBounds{lowerInclusive: r.LowerInclusive, upperInclusive: r.UpperInclusive, empty: r.Empty}
+	if !r.LowerInfinite {
+		b.lower = strconv.FormatFloat(r.Lower, 'f', -1, 64)
+	}
+	if !r.UpperInfinite {
+		b.upper = strconv.FormatFloat(r.Upper, 'f', -1, 64)
+	}
+	return b.String(), nil
+}
+`)
+}
+
+func (g *Generator) timeParsing() {
+	g.Print(`
+var timeLayouts = []string{
+	"2006-01-02 15:04:05.999999999Z07:00",
+	"2006-01-02 15:04:05.999999999Z07",
+	"2006-01-02 15:04:05.999999999",
+	"2006-01-02",
+	"15:04:05.999999999Z07",
+	"15:04:05.999999999",
+}
+
+func parseTime(s string) (time.Time, error) {
+	for _, layout := range timeLayouts {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, errors.New("invalid time: " + s)
+}
+`)
+}
+
+func (g *Generator) timeRange() {
+	g.Print(`
+// TimeRange represents Postgres tsrange, tstzrange and daterange types.
+type TimeRange struct {
+	Lower, Upper time.Time
+	LowerInclusive, UpperInclusive bool
+	// LowerInfinite and UpperInfinite are true if the range is unbounded on given side.
+	LowerInfinite, UpperInfinite bool
+	Empty bool
+	Valid bool
+}
+
+// Scan implements the sql.Scanner interface.
+func (r *TimeRange) Scan(src interface{}) error {
+	b, err := scanRange(src)
+	if err != nil || b == nil {
+		*r = TimeRange{}
+		return err
+	}
+	*r = TimeRange{
+		LowerInclusive: b.lowerInclusive,
+		UpperInclusive: b.upperInclusive,
+		LowerInfinite: b.lower == "" && !b.empty,
+		UpperInfinite: b.upper == "" && !b.empty,
+		Empty: b.empty,
+		Valid: true,
+	}
+	if b.lower != "" {
+		if r.Lower, err = parseTime(b.lower); err != nil {
+			return err
+		}
+	}
+	if b.upper != "" {
+		if r.Upper, err = parseTime(b.upper); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Value implements the driver.Valuer interface.
+func (r TimeRange) Value() (driver.Value, error) {
+	if !r.Valid {
+		return nil, nil
+	}
+	b := rangeBounds{lowerInclusive: r.LowerInclusive, upperInclusive: r.UpperInclusive, empty: r.Empty}
+	if !r.LowerInfinite {
+		b.lower = r.Lower.Format(timeLayouts[0])
+	}
+	if !r.UpperInfinite {
+		b.upper = r.Upper.Format(timeLayouts[0])
+	}
+	return b.String(), nil
+}
+`)
+}
+
+func (g *Generator) point() {
+	g.Printf(`
+// Point represents Postgres point type.
+type Point struct {
+	X, Y float64
+	Valid bool
+}
+
+// Scan implements the sql.Scanner interface.
+func (p *Point) Scan(src interface{}) error {
+	var s string
+	switch t := src.(type) {
+	case nil:
+		*p = Point{}
+		return nil
+	case []byte:
+		s = string(t)
+	case string:
+		s = t
+	default:
+		return fmt.Errorf("expected slice of bytes or string as a source argument in Scan, not %%T", src)
+	}
+	xy := strings.Split(strings.Trim(s, "()"), ",")
+	if len(xy) != 2 {
+		return fmt.Errorf("invalid point: %%s", s)
+	}
+	x, err := strconv.ParseFloat(xy[0], 64)
+	if err != nil {
+		return err
+	}
+	y, err := strconv.ParseFloat(xy[1], 64)
+	if err != nil {
+		return err
+	}
+	*p = Point{X: x, Y: y, Valid: true}
+	return nil
+}
+
+// Value implements the driver.Valuer interface.
+func (p Point) Value() (driver.Value, error) {
+	if !p.Valid {
+		return nil, nil
+	}
+	return fmt.Sprintf("(%%s,%%s)", strconv.FormatFloat(p.X, 'f', -1, 64), strconv.FormatFloat(p.Y, 'f', -1, 64)), nil
+}
+`)
+}
+
+func (g *Generator) timeArray() {
+	g.Print(`
+// TimeArray represents one-dimensional array of Postgres timestamp, timestamptz, date, time or timetz types.
+type TimeArray []time.Time
+
+// Scan implements the sql.Scanner interface.
+func (a *TimeArray) Scan(src interface{}) error {
+	var sa pq.StringArray
+	if err := sa.Scan(src); err != nil {
+		return err
+	}
+	if sa == nil {
+		*a = nil
+		return nil
+	}
+	tmp := make(TimeArray, 0, len(sa))
+	for _, s := range sa {
+		t, err := parseTime(s)
+		if err != nil {
+			return err
+		}
+		tmp = append(tmp, t)
+	}
+	*a = tmp
+	return nil
+}
+
+// Value implements the driver.Valuer interface.
+func (a TimeArray) Value() (driver.Value, error) {
+	if a == nil {
+		return nil, nil
+	}
+	sa := make(pq.StringArray, 0, len(a))
+	for _, t := range a {
+		sa = append(sa, t.Format(timeLayouts[0]))
+	}
+	return sa.Value()
+}
+
+type NullTimeArray struct {
+	TimeArray
+	Valid bool
+}
+
+func (n *NullTimeArray) Scan(value interface{}) error {
+	if value == nil {
+		n.TimeArray, n.Valid = nil, false
+		return nil
+	}
+	n.Valid = true
+	return n.TimeArray.Scan(value)
+}
+`)
+}
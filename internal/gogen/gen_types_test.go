@@ -0,0 +1,122 @@
+package gogen_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/piotrkowalczuk/pqt"
+	"github.com/piotrkowalczuk/pqt/internal/gogen"
+)
+
+func TestGenerator_TypeStatics(t *testing.T) {
+	cases := map[string]struct {
+		column   *pqt.Column
+		expected []string
+		missing  []string
+	}{
+		"none": {
+			column:  pqt.NewColumn("name", pqt.TypeText()),
+			missing: []string{"type Interval", "type rangeBounds", "type Point"},
+		},
+		"interval": {
+			column:   pqt.NewColumn("duration", pqt.TypeInterval()),
+			expected: []string{"type Interval struct"},
+			missing:  []string{"type rangeBounds"},
+		},
+		"range": {
+			column:   pqt.NewColumn("period", pqt.TypeTimestampTZRange()),
+			expected: []string{"type rangeBounds struct", "type TimeRange struct"},
+			missing:  []string{"type Int64Range", "type Float64Range"},
+		},
+		"text-search": {
+			column:   pqt.NewColumn("document", pqt.TypeTSVector(), pqt.WithTextSearch("simple")),
+			expected: []string{"type TextSearch struct", "type TextSearchHeadline struct"},
+			missing:  []string{"func textSearchQuery("},
+		},
+		"jsonb": {
+			column:   pqt.NewColumn("payload", pqt.TypeJSONB()),
+			expected: []string{"type JSONPredicate struct", "type JSONSet struct"},
+			missing:  []string{"func jsonPredicateClause(", "func jsonPatchClause("},
+		},
+		"point": {
+			column:   pqt.NewColumn("location", pqt.TypePoint()),
+			expected: []string{"type Point struct"},
+		},
+	}
+
+	for hint, c := range cases {
+		t.Run(hint, func(t *testing.T) {
+			s := pqt.NewSchema("public").AddTable(pqt.NewTable("example").AddColumn(c.column))
+			g := &gogen.Generator{}
+			g.TypeStatics(s)
+			if g.Err != nil {
+				t.Fatalf("unexpected error: %s", g.Err)
+			}
+			out := g.String()
+			for _, exp := range c.expected {
+				if !strings.Contains(out, exp) {
+					t.Errorf("output should contain %q", exp)
+				}
+			}
+			for _, miss := range c.missing {
+				if strings.Contains(out, miss) {
+					t.Errorf("output should not contain %q", miss)
+				}
+			}
+		})
+	}
+}
+
+func TestGenerator_TextSearchStatics(t *testing.T) {
+	for hint, c := range map[string]struct {
+		column   *pqt.Column
+		expected bool
+	}{
+		"none":        {column: pqt.NewColumn("name", pqt.TypeText())},
+		"text-search": {column: pqt.NewColumn("document", pqt.TypeTSVector(), pqt.WithTextSearch("simple")), expected: true},
+	} {
+		t.Run(hint, func(t *testing.T) {
+			s := pqt.NewSchema("public").AddTable(pqt.NewTable("example").AddColumn(c.column))
+			g := &gogen.Generator{}
+			g.TextSearchStatics(s)
+			if g.Err != nil {
+				t.Fatalf("unexpected error: %s", g.Err)
+			}
+			out := g.String()
+			if got := strings.Contains(out, "func textSearchQuery(comp *Composer,"); got != c.expected {
+				t.Errorf("output should contain textSearchQuery: %t, got:\n%s", c.expected, out)
+			}
+			if strings.Contains(out, "type TextSearch ") {
+				t.Error("output should not contain text search types")
+			}
+		})
+	}
+}
+
+func TestGenerator_JSONStatics(t *testing.T) {
+	for hint, c := range map[string]struct {
+		column   *pqt.Column
+		expected bool
+	}{
+		"none":  {column: pqt.NewColumn("name", pqt.TypeText())},
+		"jsonb": {column: pqt.NewColumn("payload", pqt.TypeJSONB()), expected: true},
+	} {
+		t.Run(hint, func(t *testing.T) {
+			s := pqt.NewSchema("public").AddTable(pqt.NewTable("example").AddColumn(c.column))
+			g := &gogen.Generator{}
+			g.JSONStatics(s)
+			if g.Err != nil {
+				t.Fatalf("unexpected error: %s", g.Err)
+			}
+			out := g.String()
+			for _, exp := range []string{"func jsonPredicateClause(comp *Composer,", "func jsonPatchClause(comp *Composer,"} {
+				if got := strings.Contains(out, exp); got != c.expected {
+					t.Errorf("output should contain %q: %t", exp, c.expected)
+				}
+			}
+			if strings.Contains(out, "type JSONSet ") {
+				t.Error("output should not contain JSON types")
+			}
+		})
+	}
+}
@@ -0,0 +1,427 @@
+package gogen
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/piotrkowalczuk/pqt"
+	"github.com/piotrkowalczuk/pqt/pqtfmt"
+	"github.com/piotrkowalczuk/pqt/pqtgo"
+)
+
+const (
+	valueString = iota + 1
+	valueNumber
+	valueArray
+)
+
+// fieldValue describes how generated code reads value of entity or patch field.
+type fieldValue struct {
+	// kind is one of valueString, valueNumber or valueArray.
+	kind int
+	// guard is a condition the field has to meet to hold a value, empty if it always does.
+	guard string
+	expr  string
+	// typ is Go type of the expression, if it is a number.
+	typ string
+}
+
+// fieldCheck is a condition that is true if field value violates a constraint.
+type fieldCheck struct {
+	cond, reason string
+}
+
+// ValidationStatics generates types returned by Validate methods of entities and patches.
+func (g *Generator) ValidationStatics(s *pqt.Schema) {
+	g.Print(`
+// FieldError describes entity or patch field which value violates a constraint of the corresponding column.
+type FieldError struct {
+	// Field is the name of the struct field.
+	Field string
+	// Column is the name of the column the field maps to.
+	Column string
+	Reason string
+}
+
+// Error implements error interface.
+func (e *FieldError) Error() string {
+	return e.Field + " " + e.Reason
+}
+
+// ValidationError is returned by Validate methods, it lists all fields that did not pass the validation.
+type ValidationError []*FieldError
+
+// Error implements error interface.
+func (e ValidationError) Error() string {
+	msgs := make([]string, 0, len(e))
+	for _, fe := range e {
+		msgs = append(msgs, fe.Error())
+	}
+	return "validation failure: " + strings.Join(msgs, ", ")
+}
+`)
+	if hasNumericPrecision(s) {
+		g.Print(`
+// numericFits returns true if value can be stored in numeric column of given precision and scale.
+func numericFits(v float64, precision, scale int) bool {
+	return math.Abs(math.Round(v*math.Pow10(scale))) < math.Pow10(precision)
+}
+`)
+	}
+}
+
+// EntityValidate generates Validate method that checks entity against constraints that can be verified without querying the database.
+func (g *Generator) EntityValidate(t *pqt.Table) {
+	entityName := pqtfmt.Public(t.Name)
+
+	g.Printf(`
+// Validate checks if entity meets NOT NULL, length, precision, enum, array size and simple check constraints of the table.
+// It returns ValidationError that lists all violations, if any.
+func (e *%sEntity) Validate() error {`, entityName)
+	var columns []*pqt.Column
+	for _, c := range t.Columns {
+		if c.IsDynamic || c.IsGeneratedAlways() {
+			continue
+		}
+		columns = append(columns, c)
+	}
+	g.validateFields(t, columns, "e", pqtgo.ModeDefault, true)
+	g.Print(`
+}`)
+}
+
+// PatchValidate generates Validate method that checks fields set by the patch.
+func (g *Generator) PatchValidate(t *pqt.Table) {
+	entityName := pqtfmt.Public(t.Name)
+
+	g.Printf(`
+// Validate checks if values set by the patch meet length, precision, enum, array size and simple check constraints of the table.
+// It returns ValidationError that lists all violations, if any.
+func (p *%sPatch) Validate() error {`, entityName)
+	var columns []*pqt.Column
+	for _, c := range t.Columns {
+		if isPrimaryKey(c) || c.IsGeneratedAlways() {
+			continue
+		}
+		columns = append(columns, c)
+	}
+	g.validateFields(t, columns, "p", pqtgo.ModeOptional, false)
+	g.Print(`
+}`)
+}
+
+// validateQuery generates code that, if repository requires it, validates given entities or patches before the query is built.
+// Values are given as pairs of a condition (can be empty) and an expression.
+func (g *Generator) validateQuery(values ...string) {
+	g.Printf(`
+		if r.%s {`, pqtfmt.Public("validate"))
+	for i := 0; i < len(values); i += 2 {
+		if values[i] != "" {
+			g.Printf(`
+			if %s {`, values[i])
+		}
+		g.Printf(`
+			if err := %s.Validate(); err != nil {
+				return "", nil, err
+			}`, values[i+1])
+		if values[i] != "" {
+			g.Print(`
+			}`)
+		}
+	}
+	g.Print(`
+		}`)
+}
+
+func (g *Generator) validateFields(t *pqt.Table, columns []*pqt.Column, recv string, m int32, notNull bool) {
+	var empty = true
+	for _, c := range columns {
+		if g.columnType(c, m) == "<nil>" {
+			continue
+		}
+		var checks []fieldCheck
+		if notNull {
+			checks = g.notNullChecks(c)
+		}
+		v, ok := g.fieldValue(c, m, recv)
+		if !ok && len(checks) == 0 {
+			continue
+		}
+		var valueChecks []fieldCheck
+		if ok {
+			valueChecks = valueFieldChecks(c, v)
+		}
+		if len(checks) == 0 && len(valueChecks) == 0 {
+			continue
+		}
+		if empty {
+			g.Print(`
+	var errs ValidationError`)
+			empty = false
+		}
+		for _, chk := range checks {
+			g.validationError(t, c, chk)
+		}
+		if len(valueChecks) == 0 {
+			continue
+		}
+		if v.guard != "" {
+			g.Printf(`
+	if %s {`, v.guard)
+		}
+		for _, chk := range valueChecks {
+			g.validationError(t, c, chk)
+		}
+		if v.guard != "" {
+			g.Print(`
+	}`)
+		}
+	}
+	if empty {
+		g.Print(`
+	return nil`)
+		return
+	}
+	g.Print(`
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil`)
+}
+
+func (g *Generator) validationError(t *pqt.Table, c *pqt.Column, chk fieldCheck) {
+	g.Printf(`
+	if %s {
+		errs = append(errs, &FieldError{Field: "%s", Column: %s, Reason: %q})
+	}`,
+		chk.cond,
+		pqtfmt.Public(c.Name),
+		pqtfmt.Public("table", t.Name, "column", c.Name),
+		chk.reason,
+	)
+}
+
+// notNullChecks returns check that fails if entity field of NOT NULL column would be omitted by insert.
+// Columns which value is provided by the database are not checked.
+func (g *Generator) notNullChecks(c *pqt.Column) []fieldCheck {
+	if (!c.NotNull && !isPrimaryKey(c)) || !isInsertable(c) {
+		return nil
+	}
+	if _, ok := c.DefaultOn(pqt.EventInsert); ok {
+		return nil
+	}
+	conds := g.insertConditions(c)
+	if len(conds) == 0 {
+		return nil
+	}
+	negated := make([]string, 0, len(conds))
+	for _, cond := range conds {
+		switch {
+		case strings.HasPrefix(cond, "!"):
+			negated = append(negated, strings.TrimPrefix(cond, "!"))
+		case strings.HasSuffix(cond, " != nil"):
+			negated = append(negated, strings.TrimSuffix(cond, " != nil")+" == nil")
+		default:
+			negated = append(negated, "!"+cond)
+		}
+	}
+	return []fieldCheck{{cond: strings.Join(negated, " || "), reason: "must not be null"}}
+}
+
+// fieldValue returns false if type of the field is not supported by the validation.
+func (g *Generator) fieldValue(c *pqt.Column, m int32, recv string) (fieldValue, bool) {
+	f := recv + "." + pqtfmt.Public(c.Name)
+	switch typ := g.columnType(c, m); typ {
+	case "string":
+		return fieldValue{kind: valueString, expr: f}, true
+	case "*string":
+		return fieldValue{kind: valueString, guard: f + " != nil", expr: "*" + f}, true
+	case "sql.NullString":
+		return fieldValue{kind: valueString, guard: f + ".Valid", expr: f + ".String"}, true
+	case "int", "int8", "int16", "int32", "int64", "float32", "float64":
+		return fieldValue{kind: valueNumber, expr: f, typ: typ}, true
+	case "*int", "*int8", "*int16", "*int32", "*int64", "*float32", "*float64":
+		return fieldValue{kind: valueNumber, guard: f + " != nil", expr: "*" + f, typ: typ[1:]}, true
+	case "sql.NullInt64":
+		return fieldValue{kind: valueNumber, guard: f + ".Valid", expr: f + ".Int64", typ: "int64"}, true
+	case "sql.NullFloat64":
+		return fieldValue{kind: valueNumber, guard: f + ".Valid", expr: f + ".Float64", typ: "float64"}, true
+	case "pq.Int64Array", "pq.Float64Array", "pq.StringArray", "pq.BoolArray", "pq.ByteaArray", "TimeArray":
+		return fieldValue{kind: valueArray, expr: f}, true
+	case "NullInt64Array", "NullFloat64Array", "NullStringArray", "NullBoolArray", "NullByteaArray", "NullTimeArray":
+		return fieldValue{kind: valueArray, guard: f + ".Valid", expr: f + "." + strings.TrimPrefix(typ, "Null")}, true
+	default:
+		if strings.HasPrefix(typ, "[]") && typ != "[]byte" {
+			return fieldValue{kind: valueArray, expr: f}, true
+		}
+		return fieldValue{}, false
+	}
+}
+
+// valueFieldChecks returns checks of field value that follow from the column type and its check expression.
+func valueFieldChecks(c *pqt.Column, v fieldValue) []fieldCheck {
+	var checks []fieldCheck
+
+	sqlType := c.Type
+	if mt, ok := sqlType.(pqt.MappableType); ok {
+		sqlType = mt.From
+	}
+	switch v.kind {
+	case valueString:
+		if et, ok := sqlType.(pqt.EnumeratedType); ok && len(et.Enums) > 0 {
+			conds := make([]string, 0, len(et.Enums))
+			for _, e := range quoteAll(et.Enums) {
+				conds = append(conds, v.expr+" != "+e)
+			}
+			checks = append(checks, fieldCheck{
+				cond:   strings.Join(conds, " && "),
+				reason: "must be one of " + strings.Join(et.Enums, ", "),
+			})
+		}
+		if length, ok := fixtureTextLength(sqlType); ok && length > 0 {
+			checks = append(checks, fieldCheck{
+				cond:   fmt.Sprintf("utf8.RuneCountInString(%s) > %d", v.expr, length),
+				reason: fmt.Sprintf("is longer than %d characters", length),
+			})
+		}
+	case valueNumber:
+		if precision, scale, ok := numericPrecision(sqlType); ok {
+			checks = append(checks, fieldCheck{
+				cond:   fmt.Sprintf("!numericFits(%s, %d, %d)", v.float64(), precision, scale),
+				reason: fmt.Sprintf("does not fit numeric(%d,%d)", precision, scale),
+			})
+		}
+	case valueArray:
+		if length, ok := arrayLength(sqlType); ok {
+			checks = append(checks, fieldCheck{
+				cond:   fmt.Sprintf("len(%s) > %d", v.expr, length),
+				reason: fmt.Sprintf("has more than %d elements", length),
+			})
+		}
+	}
+	if cond, ok := checkCondition(c, v); ok {
+		checks = append(checks, fieldCheck{
+			cond:   cond,
+			reason: "violates check " + c.Check,
+		})
+	}
+	return checks
+}
+
+// float64 returns expression converted to float64, if necessary.
+func (v fieldValue) float64() string {
+	if v.typ == "float64" {
+		return v.expr
+	}
+	return "float64(" + v.expr + ")"
+}
+
+// arrayLength returns declared size of single dimension array type.
+func arrayLength(t pqt.Type) (int, bool) {
+	name := t.String()
+	if strings.HasPrefix(name, "CHARACTER[") || strings.Count(name, "[") != 1 {
+		return 0, false
+	}
+	var length int
+	if _, err := fmt.Sscanf(name[strings.Index(name, "["):], "[%d]", &length); err != nil || length <= 0 {
+		return 0, false
+	}
+	return length, true
+}
+
+var (
+	checkAnd  = regexp.MustCompile(`(?i)\s+and\s+`)
+	checkPart = regexp.MustCompile(`^(?:((?i:length|char_length|character_length))\s*\(\s*"?(\w+)"?\s*\)|"?(\w+)"?)\s*(<=|>=|<>|!=|=|<|>)\s*('(?:[^']|'')*'|-?\d+(?:\.\d+)?)$`)
+	negatedOp = map[string]string{
+		"<":  ">=",
+		"<=": ">",
+		">":  "<=",
+		">=": "<",
+		"=":  "!=",
+		"<>": "==",
+		"!=": "==",
+	}
+)
+
+// checkCondition translates check expression of the column into Go condition that is true if value violates it.
+// Only conjunctions of comparisons of the column (or its length) against a literal are supported,
+// false is returned for anything else.
+func checkCondition(c *pqt.Column, v fieldValue) (string, bool) {
+	if c.Check == "" {
+		return "", false
+	}
+	var conds []string
+	for _, part := range checkAnd.Split(trimParentheses(c.Check), -1) {
+		m := checkPart.FindStringSubmatch(trimParentheses(part))
+		if m == nil {
+			return "", false
+		}
+		fn, col, op, lit := m[1], m[2]+m[3], m[4], m[5]
+		if col != c.Name {
+			return "", false
+		}
+		var expr string
+		switch {
+		case fn != "":
+			if v.kind != valueString || strings.ContainsAny(lit, "'.") {
+				return "", false
+			}
+			expr = "utf8.RuneCountInString(" + v.expr + ")"
+		case strings.HasPrefix(lit, "'"):
+			if v.kind != valueString {
+				return "", false
+			}
+			expr = v.expr
+			lit = strconv.Quote(strings.Replace(lit[1:len(lit)-1], "''", "'", -1))
+		default:
+			if v.kind != valueNumber {
+				return "", false
+			}
+			expr = v.expr
+			if strings.Contains(lit, ".") && !strings.HasPrefix(v.typ, "float") {
+				expr = v.float64()
+			}
+		}
+		conds = append(conds, expr+" "+negatedOp[op]+" "+lit)
+	}
+	return strings.Join(conds, " || "), true
+}
+
+// trimParentheses removes parentheses that enclose whole expression.
+func trimParentheses(s string) string {
+	s = strings.TrimSpace(s)
+	for strings.HasPrefix(s, "(") && strings.HasSuffix(s, ")") {
+		depth := 0
+		for i, r := range s[:len(s)-1] {
+			switch r {
+			case '(':
+				depth++
+			case ')':
+				depth--
+			}
+			if depth == 0 && i < len(s)-1 {
+				return s
+			}
+		}
+		s = strings.TrimSpace(s[1 : len(s)-1])
+	}
+	return s
+}
+
+// hasNumericPrecision returns true if any column of the schema is of numeric type of limited precision.
+func hasNumericPrecision(s *pqt.Schema) bool {
+	for _, t := range s.Tables {
+		for _, c := range t.Columns {
+			sqlType := c.Type
+			if mt, ok := sqlType.(pqt.MappableType); ok {
+				sqlType = mt.From
+			}
+			if _, _, ok := numericPrecision(sqlType); ok {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,116 @@
+package gogen_test
+
+import (
+	"go/types"
+	"testing"
+
+	"github.com/piotrkowalczuk/pqt"
+	"github.com/piotrkowalczuk/pqt/internal/gogen"
+	"github.com/piotrkowalczuk/pqt/internal/testutil"
+	"github.com/piotrkowalczuk/pqt/pqtgo"
+)
+
+func validateTable() *pqt.Table {
+	t := pqt.NewTable("t1").
+		AddColumn(pqt.NewColumn("id", pqt.TypeSerialBig(), pqt.WithPrimaryKey())).
+		AddColumn(pqt.NewColumn("name", pqt.TypeVarchar(10), pqt.WithNotNull(), pqt.WithCheck("length(name) >= 3"))).
+		AddColumn(pqt.NewColumn("kind", pqt.TypeEnumerated("kind", "a", "b"), pqt.WithNotNull(), pqt.WithTypeMapping(pqtgo.BuiltinType(types.String)))).
+		AddColumn(pqt.NewColumn("age", pqt.TypeInteger(), pqt.WithCheck("(age > 0 AND age < 150)"))).
+		AddColumn(pqt.NewColumn("price", pqt.TypeNumeric(5, 2), pqt.WithCheck("price >= 0.5"))).
+		AddColumn(pqt.NewColumn("tags", pqt.TypeTextArray(3))).
+		AddColumn(pqt.NewColumn("data", pqt.TypeJSONB(), pqt.WithNotNull())).
+		AddColumn(pqt.NewColumn("note", pqt.TypeText(), pqt.WithCheck("note <> 'n/a' OR note IS NULL")))
+	pqt.NewSchema("example").AddTable(t)
+	return t
+}
+
+func TestGenerator_EntityValidate(t *testing.T) {
+	g := &gogen.Generator{}
+	g.EntityValidate(validateTable())
+	testutil.AssertOutput(t, g.Printer, `
+// Validate checks if entity meets NOT NULL, length, precision, enum, array size and simple check constraints of the table.
+// It returns ValidationError that lists all violations, if any.
+func (e *T1Entity) Validate() error {
+	var errs ValidationError
+	if e.Age != nil {
+		if *e.Age <= 0 || *e.Age >= 150 {
+			errs = append(errs, &FieldError{Field: "Age", Column: TableT1ColumnAge, Reason: "violates check (age > 0 AND age < 150)"})
+		}
+	}
+	if e.Data == nil {
+		errs = append(errs, &FieldError{Field: "Data", Column: TableT1ColumnData, Reason: "must not be null"})
+	}
+	if e.Kind != "a" && e.Kind != "b" {
+		errs = append(errs, &FieldError{Field: "Kind", Column: TableT1ColumnKind, Reason: "must be one of a, b"})
+	}
+	if utf8.RuneCountInString(e.Name) > 10 {
+		errs = append(errs, &FieldError{Field: "Name", Column: TableT1ColumnName, Reason: "is longer than 10 characters"})
+	}
+	if utf8.RuneCountInString(e.Name) < 3 {
+		errs = append(errs, &FieldError{Field: "Name", Column: TableT1ColumnName, Reason: "violates check length(name) >= 3"})
+	}
+	if e.Price.Valid {
+		if !numericFits(e.Price.Float64, 5, 2) {
+			errs = append(errs, &FieldError{Field: "Price", Column: TableT1ColumnPrice, Reason: "does not fit numeric(5,2)"})
+		}
+		if e.Price.Float64 < 0.5 {
+			errs = append(errs, &FieldError{Field: "Price", Column: TableT1ColumnPrice, Reason: "violates check price >= 0.5"})
+		}
+	}
+	if e.Tags.Valid {
+		if len(e.Tags.StringArray) > 3 {
+			errs = append(errs, &FieldError{Field: "Tags", Column: TableT1ColumnTags, Reason: "has more than 3 elements"})
+		}
+	}
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}`)
+}
+
+func TestGenerator_PatchValidate(t *testing.T) {
+	g := &gogen.Generator{}
+	g.PatchValidate(validateTable())
+	testutil.AssertOutput(t, g.Printer, `
+// Validate checks if values set by the patch meet length, precision, enum, array size and simple check constraints of the table.
+// It returns ValidationError that lists all violations, if any.
+func (p *T1Patch) Validate() error {
+	var errs ValidationError
+	if p.Age != nil {
+		if *p.Age <= 0 || *p.Age >= 150 {
+			errs = append(errs, &FieldError{Field: "Age", Column: TableT1ColumnAge, Reason: "violates check (age > 0 AND age < 150)"})
+		}
+	}
+	if p.Kind != nil {
+		if *p.Kind != "a" && *p.Kind != "b" {
+			errs = append(errs, &FieldError{Field: "Kind", Column: TableT1ColumnKind, Reason: "must be one of a, b"})
+		}
+	}
+	if p.Name.Valid {
+		if utf8.RuneCountInString(p.Name.String) > 10 {
+			errs = append(errs, &FieldError{Field: "Name", Column: TableT1ColumnName, Reason: "is longer than 10 characters"})
+		}
+		if utf8.RuneCountInString(p.Name.String) < 3 {
+			errs = append(errs, &FieldError{Field: "Name", Column: TableT1ColumnName, Reason: "violates check length(name) >= 3"})
+		}
+	}
+	if p.Price.Valid {
+		if !numericFits(p.Price.Float64, 5, 2) {
+			errs = append(errs, &FieldError{Field: "Price", Column: TableT1ColumnPrice, Reason: "does not fit numeric(5,2)"})
+		}
+		if p.Price.Float64 < 0.5 {
+			errs = append(errs, &FieldError{Field: "Price", Column: TableT1ColumnPrice, Reason: "violates check price >= 0.5"})
+		}
+	}
+	if p.Tags.Valid {
+		if len(p.Tags.StringArray) > 3 {
+			errs = append(errs, &FieldError{Field: "Tags", Column: TableT1ColumnTags, Reason: "has more than 3 elements"})
+		}
+	}
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}`)
+}
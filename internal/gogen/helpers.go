@@ -13,10 +13,12 @@ import (
 )
 
 type structField struct {
-	Name     string
-	Type     string
-	Tags     reflect.StructTag
-	ReadOnly bool
+	Name         string
+	Type         string
+	Tags         reflect.StructTag
+	ReadOnly     bool
+	Column       *pqt.Column
+	Relationship *pqt.Relationship
 }
 
 func closeBrace(w io.Writer, n int) {
@@ -32,7 +34,7 @@ func columnMode(c *pqt.Column, m int32) int32 {
 	case pqtgo.ModeMandatory:
 	case pqtgo.ModeOptional:
 	default:
-		if c.NotNull || c.PrimaryKey {
+		if c.NotNull || isPrimaryKey(c) {
 			m = pqtgo.ModeMandatory
 		}
 	}
@@ -64,6 +66,88 @@ func hasJoinableRelationships(t *pqt.Table) bool {
 	return len(joinableRelationships(t)) > 0
 }
 
+// tableReference returns expression that evaluates to the name of the other table in queries of the t table repository.
+// Tables of the same schema follow the schema repository table was pointed to at runtime.
+func tableReference(t, other *pqt.Table) string {
+	if other == t {
+		return "r." + pqtfmt.Public("table")
+	}
+	if other.Schema == t.Schema {
+		return fmt.Sprintf("inSchemaOf(%s, r.%s)", pqtfmt.Public("table", other.Name), pqtfmt.Public("table"))
+	}
+	return pqtfmt.Public("table", other.Name)
+}
+
+// primaryKey describes argument of the by primary key methods.
+type primaryKey struct {
+	// Name is a part of the method names, e.g. FindOneByID or FindOneByKey.
+	Name string
+	// Type is a type of the column or, if key spans multiple columns, XxxKey struct.
+	Type    string
+	Columns pqt.Columns
+}
+
+func (g *Generator) primaryKey(t *pqt.Table) (*primaryKey, bool) {
+	columns := t.PrimaryKeyColumns()
+	switch len(columns) {
+	case 0:
+		return nil, false
+	case 1:
+		return &primaryKey{
+			Name:    columns[0].Name,
+			Type:    g.columnType(columns[0], pqtgo.ModeMandatory),
+			Columns: columns,
+		}, true
+	default:
+		return &primaryKey{
+			Name:    "key",
+			Type:    pqtfmt.Public(t.Name, "key"),
+			Columns: columns,
+		}, true
+	}
+}
+
+// primaryKeyClause writes code that appends primary key condition to the composer.
+// Key is expected to be available as pk variable.
+func (g *Generator) primaryKeyClause(t *pqt.Table, pk *primaryKey, composer string) {
+	for i, c := range pk.Columns {
+		if i != 0 {
+			g.Printf(`
+		%s.WriteString(" AND ")`, composer)
+		}
+		arg := "pk"
+		if len(pk.Columns) > 1 {
+			arg = "pk." + pqtfmt.Public(c.Name)
+		}
+		g.Printf(`
+		%s.WriteString(%s)
+		%s.WriteString("=")
+		%s.WritePlaceholder()
+		%s.Add(%s)`,
+			composer, pqtfmt.Public("table", t.Name, "column", c.Name),
+			composer,
+			composer,
+			composer, arg,
+		)
+	}
+}
+
+// isPrimaryKey returns true if column is a primary key or a part of it.
+func isPrimaryKey(c *pqt.Column) bool {
+	if c.PrimaryKey {
+		return true
+	}
+	if c.Table == nil {
+		return false
+	}
+	for _, pk := range c.Table.PrimaryKeyColumns() {
+		if pk == c {
+			return true
+		}
+	}
+	return false
+}
+
 func uniqueConstraints(t *pqt.Table) []*pqt.Constraint {
 	var unique []*pqt.Constraint
 	for _, c := range t.Constraints {
@@ -107,3 +191,22 @@ func functionName(f *pqt.Function) string {
 	}
 	return f.Name
 }
+
+func textSearchColumns(t *pqt.Table) (cols []*pqt.Column) {
+	for _, c := range t.Columns {
+		if c.TextSearch != nil && !c.IsDynamic {
+			cols = append(cols, c)
+		}
+	}
+	return
+}
+
+// headlineColumns returns text search columns which source columns are known, so headline can be produced.
+func headlineColumns(t *pqt.Table) (cols []*pqt.Column) {
+	for _, c := range textSearchColumns(t) {
+		if len(c.TextSearch.Columns) > 0 {
+			cols = append(cols, c)
+		}
+	}
+	return
+}
@@ -19,3 +19,7 @@ type Plugin interface {
 	ScanClause(*pqt.Column) string
 	Static(*pqt.Schema) string
 }
+
+type findExprFieldsPlugin interface {
+	FindExprFields(*pqt.Table) string
+}
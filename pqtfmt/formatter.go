@@ -1,6 +1,7 @@
 package pqtfmt
 
 import (
+	"fmt"
 	"go/types"
 	"reflect"
 	"strings"
@@ -75,6 +76,8 @@ func Type(t pqt.Type, m int32) string {
 		return generateTypeBuiltin(tt, m)
 	case pqt.BaseType:
 		return generateTypeBase(tt, m)
+	case pqt.ArrayType:
+		return generateTypeArray(tt, m)
 	case pqtgo.CustomType:
 		return generateCustomType(tt, m)
 	}
@@ -207,6 +210,24 @@ func generateTypeBase(t pqt.Type, m int32) string {
 		return "[]byte"
 	case pqt.TypeUUID():
 		return chooseType("string", "sql.NullString", "sql.NullString", m)
+	case pqt.TypeTime(), pqt.TypeTimeTZ():
+		return chooseType("time.Time", "pq.NullTime", "pq.NullTime", m)
+	case pqt.TypeInet(), pqt.TypeCIDR(), pqt.TypeMacAddr(), pqt.TypeMoney(), pqt.TypeXML(), pqt.TypeCitext(), pqt.TypeTSVector(), pqt.TypeTSQuery():
+		return chooseType("string", "sql.NullString", "sql.NullString", m)
+	case pqt.TypeLine(), pqt.TypeLineSegment(), pqt.TypeBox(), pqt.TypePath(), pqt.TypePolygon(), pqt.TypeCircle():
+		return chooseType("string", "sql.NullString", "sql.NullString", m)
+	case pqt.TypeInterval():
+		return "Interval"
+	case pqt.TypeIntegerRange(), pqt.TypeIntegerBigRange():
+		return "Int64Range"
+	case pqt.TypeNumericRange():
+		return "Float64Range"
+	case pqt.TypeTimestampRange(), pqt.TypeTimestampTZRange(), pqt.TypeDateRange():
+		return "TimeRange"
+	case pqt.TypePoint():
+		return "Point"
+	case pqt.TypeHstore():
+		return "hstore.Hstore"
 	default:
 		gt := t.String()
 		switch {
@@ -218,7 +239,7 @@ func generateTypeBase(t pqt.Type, m int32) string {
 			return chooseType("pq.StringArray", "NullStringArray", "NullStringArray", m)
 		case strings.HasPrefix(gt, "DECIMAL"), strings.HasPrefix(gt, "NUMERIC"):
 			return chooseType("float64", "sql.NullFloat64", "sql.NullFloat64", m)
-		case strings.HasPrefix(gt, "VARCHAR"), strings.HasPrefix(gt, "CHARACTER"):
+		case strings.HasPrefix(gt, "VARCHAR"), strings.HasPrefix(gt, "CHARACTER"), strings.HasPrefix(gt, "BIT"), strings.HasPrefix(gt, "VARBIT"):
 			return chooseType("string", "sql.NullString", "sql.NullString", m)
 		default:
 			return "interface{}"
@@ -226,6 +247,39 @@ func generateTypeBase(t pqt.Type, m int32) string {
 	}
 }
 
+// generateTypeArray maps single dimensional arrays to the driver array types or their null-able wrappers.
+// Multidimensional arrays cannot be scanned by the driver, so they have no Go representation.
+func generateTypeArray(t pqt.ArrayType, m int32) string {
+	if t.Dimensions() > 1 {
+		panic(fmt.Sprintf("pqtfmt: multidimensional array %s cannot be mapped to a Go type", t))
+	}
+	elem := t.Elem
+	if mt, ok := elem.(pqt.MappableType); ok {
+		elem = mt.From
+	}
+	bt, ok := elem.(pqt.BaseType)
+	if !ok {
+		return chooseType("pq.StringArray", "NullStringArray", "NullStringArray", m)
+	}
+	switch bt {
+	case pqt.TypeIntegerSmall(), pqt.TypeInteger(), pqt.TypeIntegerBig(), pqt.TypeSerialSmall(), pqt.TypeSerial(), pqt.TypeSerialBig():
+		return chooseType("pq.Int64Array", "NullInt64Array", "NullInt64Array", m)
+	case pqt.TypeReal(), pqt.TypeDoublePrecision():
+		return chooseType("pq.Float64Array", "NullFloat64Array", "NullFloat64Array", m)
+	case pqt.TypeBool():
+		return chooseType("pq.BoolArray", "NullBoolArray", "NullBoolArray", m)
+	case pqt.TypeBytea():
+		return chooseType("pq.ByteaArray", "NullByteaArray", "NullByteaArray", m)
+	case pqt.TypeTimestamp(), pqt.TypeTimestampTZ(), pqt.TypeDate(), pqt.TypeTime(), pqt.TypeTimeTZ():
+		return chooseType("TimeArray", "NullTimeArray", "NullTimeArray", m)
+	default:
+		if gt := bt.String(); strings.HasPrefix(gt, "DECIMAL") || strings.HasPrefix(gt, "NUMERIC") {
+			return chooseType("pq.Float64Array", "NullFloat64Array", "NullFloat64Array", m)
+		}
+		return chooseType("pq.StringArray", "NullStringArray", "NullStringArray", m)
+	}
+}
+
 func chooseType(tm, to, tc string, m int32) string {
 	switch m {
 	case pqtgo.ModeCriteria:
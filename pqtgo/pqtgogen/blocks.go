@@ -0,0 +1,327 @@
+package pqtgogen
+
+import (
+	"github.com/piotrkowalczuk/pqt"
+	"github.com/piotrkowalczuk/pqt/internal/gogen"
+)
+
+// Names of the blocks generated once per schema.
+// Templates for those blocks are executed with *SchemaView.
+const (
+	// BlockHead holds log function, errors, interfaces and clause helpers.
+	BlockHead = "head"
+	// BlockStatics holds join and lock types, array types, composer and plugins statics.
+	BlockStatics = "statics"
+)
+
+// Names of the blocks generated for each table, in order of generation.
+// Templates for those blocks are executed with *TableView.
+const (
+	BlockConstraints   = "constraints"
+	BlockColumns       = "columns"
+	BlockEntity        = "entity"
+	BlockHistoryEntity = "history.entity"
+	BlockListener      = "listener"
+	BlockScanRows      = "scan.rows"
+	BlockIterator      = "iterator"
+	BlockCriteria      = "criteria"
+	BlockFindExpr      = "find.expr"
+	BlockCountExpr     = "count.expr"
+	BlockPatch         = "patch"
+	BlockRepository    = "repository"
+	BlockInsert        = "insert"
+	BlockFind          = "find"
+	BlockHistory       = "history"
+	BlockUpdate        = "update"
+	BlockUpsert        = "upsert"
+	BlockCount         = "count"
+	BlockDelete        = "delete"
+	BlockTx            = "tx"
+	BlockTxInsert      = "tx.insert"
+	BlockTxFind        = "tx.find"
+	BlockTxHistory     = "tx.history"
+	BlockTxUpdate      = "tx.update"
+	BlockTxUpsert      = "tx.upsert"
+	BlockTxCount       = "tx.count"
+	BlockTxDelete      = "tx.delete"
+	BlockFixture       = "fixture"
+)
+
+type tableMethod func(*gogen.Generator, *pqt.Table)
+
+type tableBlock struct {
+	name    string
+	part    part
+	enabled func(t *pqt.Table, c Component) bool
+	methods []tableMethod
+	// component is set for blocks that generate XxxRepositoryBase methods of a single component.
+	component Component
+}
+
+func always(*pqt.Table, Component) bool { return true }
+
+func enabledIf(mask Component) func(*pqt.Table, Component) bool {
+	return func(_ *pqt.Table, c Component) bool {
+		return c&mask != 0
+	}
+}
+
+func enabledIfHistory(mask Component) func(*pqt.Table, Component) bool {
+	return func(t *pqt.Table, c Component) bool {
+		return t.History && c&mask != 0
+	}
+}
+
+// enabledIfFixtures enables fixtures only along with insert component, fixtures are inserted using repositories.
+func enabledIfFixtures(_ *pqt.Table, c Component) bool {
+	return c&ComponentFixtures != 0 && c&ComponentInsert != 0
+}
+
+var tableBlocks = []tableBlock{
+	{name: BlockConstraints, part: partEntity, enabled: always, methods: []tableMethod{
+		(*gogen.Generator).Constraints,
+	}},
+	{name: BlockColumns, part: partEntity, enabled: always, methods: []tableMethod{
+		(*gogen.Generator).Columns,
+		(*gogen.Generator).Rows,
+	}},
+	{name: BlockEntity, part: partEntity, enabled: always, methods: []tableMethod{
+		(*gogen.Generator).Entity,
+		(*gogen.Generator).EntityKey,
+		(*gogen.Generator).EntityProp,
+		(*gogen.Generator).EntityProps,
+		(*gogen.Generator).FieldMask,
+		(*gogen.Generator).EntityValidate,
+	}},
+	{name: BlockHistoryEntity, part: partEntity, enabled: func(t *pqt.Table, _ Component) bool { return t.History }, methods: []tableMethod{
+		(*gogen.Generator).HistoryEntity,
+	}},
+	{name: BlockListener, part: partRepository, enabled: func(t *pqt.Table, _ Component) bool { return t.Notify }, methods: []tableMethod{
+		(*gogen.Generator).ChangeListener,
+	}},
+	{name: BlockScanRows, part: partRepository, enabled: enabledIf(ComponentHelpers), methods: []tableMethod{
+		(*gogen.Generator).ScanRows,
+	}},
+	{name: BlockIterator, part: partRepository, enabled: enabledIf(ComponentFind | ComponentCount), methods: []tableMethod{
+		(*gogen.Generator).Iterator,
+	}},
+	{name: BlockCriteria, part: partRepository, enabled: enabledIf(ComponentFind | ComponentCount | ComponentUpsert), methods: []tableMethod{
+		(*gogen.Generator).Criteria,
+		(*gogen.Generator).CriteriaRelationships,
+		(*gogen.Generator).Operand,
+		(*gogen.Generator).WhereClause,
+	}},
+	{name: BlockFindExpr, part: partRepository, enabled: enabledIf(ComponentFind | ComponentCount), methods: []tableMethod{
+		(*gogen.Generator).FindExpr,
+		(*gogen.Generator).ColumnSelector,
+		(*gogen.Generator).Join,
+	}},
+	{name: BlockCountExpr, part: partRepository, enabled: enabledIf(ComponentCount), methods: []tableMethod{
+		(*gogen.Generator).CountExpr,
+	}},
+	{name: BlockPatch, part: partEntity, enabled: enabledIf(ComponentUpdate | ComponentUpsert), methods: []tableMethod{
+		(*gogen.Generator).Patch,
+		(*gogen.Generator).PatchValidate,
+	}},
+	{name: BlockRepository, part: partRepository, enabled: enabledIf(ComponentRepository), methods: []tableMethod{
+		(*gogen.Generator).Repository,
+		(*gogen.Generator).RepositoryMethodTx,
+		(*gogen.Generator).RepositoryMethodBeginTx,
+		(*gogen.Generator).RepositoryMethodRunInTransaction,
+		(*gogen.Generator).ConstraintErrors,
+	}},
+	{name: BlockInsert, part: partRepository, enabled: enabledIf(ComponentInsert), component: ComponentInsert, methods: []tableMethod{
+		(*gogen.Generator).RepositoryMethodInsertQuery,
+		(*gogen.Generator).RepositoryMethodPrivateInsert,
+		(*gogen.Generator).RepositoryMethodInsert,
+	}},
+	{name: BlockFind, part: partRepository, enabled: enabledIf(ComponentFind), component: ComponentFind, methods: []tableMethod{
+		(*gogen.Generator).RepositoryMethodFindQuery,
+		(*gogen.Generator).RepositoryMethodPrivateFind,
+		(*gogen.Generator).RepositoryMethodFind,
+		(*gogen.Generator).RepositoryMethodPrivateFindIter,
+		(*gogen.Generator).RepositoryMethodFindIter,
+		(*gogen.Generator).RepositoryMethodPrivateFindProjection,
+		(*gogen.Generator).RepositoryMethodFindProjection,
+		(*gogen.Generator).RepositoryMethodPrivateFindOneByPrimaryKey,
+		(*gogen.Generator).RepositoryMethodFindOneByPrimaryKey,
+		(*gogen.Generator).RepositoryMethodPrivateFindOneByUniqueConstraint,
+		(*gogen.Generator).RepositoryMethodFindOneByUniqueConstraint,
+	}},
+	{name: BlockHistory, part: partRepository, enabled: enabledIfHistory(ComponentFind), methods: []tableMethod{
+		(*gogen.Generator).RepositoryMethodPrivateHistory,
+		(*gogen.Generator).RepositoryMethodHistory,
+		(*gogen.Generator).RepositoryMethodPrivateFindOneByPrimaryKeyAsOf,
+		(*gogen.Generator).RepositoryMethodFindOneByPrimaryKeyAsOf,
+	}},
+	{name: BlockUpdate, part: partRepository, enabled: enabledIf(ComponentUpdate), component: ComponentUpdate, methods: []tableMethod{
+		(*gogen.Generator).RepositoryMethodUpdateOneByPrimaryKeyQuery,
+		(*gogen.Generator).RepositoryMethodPrivateUpdateOneByPrimaryKey,
+		(*gogen.Generator).RepositoryMethodUpdateOneByPrimaryKey,
+		(*gogen.Generator).RepositoryMethodFindOneByPrimaryKeyAndUpdate,
+		(*gogen.Generator).RepositoryMethodUpdateOneByUniqueConstraintQuery,
+		(*gogen.Generator).RepositoryMethodPrivateUpdateOneByUniqueConstraint,
+		(*gogen.Generator).RepositoryMethodUpdateOneByUniqueConstraint,
+	}},
+	{name: BlockUpsert, part: partRepository, enabled: enabledIf(ComponentUpsert), component: ComponentUpsert, methods: []tableMethod{
+		(*gogen.Generator).RepositoryMethodUpsertQuery,
+		(*gogen.Generator).RepositoryMethodPrivateUpsert,
+		(*gogen.Generator).RepositoryMethodUpsert,
+		(*gogen.Generator).UpsertExpr,
+		(*gogen.Generator).RepositoryMethodUpsertManyQuery,
+		(*gogen.Generator).RepositoryMethodPrivateUpsertMany,
+		(*gogen.Generator).RepositoryMethodUpsertMany,
+	}},
+	{name: BlockCount, part: partRepository, enabled: enabledIf(ComponentCount), component: ComponentCount, methods: []tableMethod{
+		(*gogen.Generator).RepositoryMethodPrivateCount,
+		(*gogen.Generator).RepositoryMethodCount,
+	}},
+	{name: BlockDelete, part: partRepository, enabled: enabledIf(ComponentDelete), component: ComponentDelete, methods: []tableMethod{
+		(*gogen.Generator).RepositoryMethodPrivateDeleteOneByPrimaryKey,
+		(*gogen.Generator).RepositoryMethodDeleteOneByPrimaryKey,
+	}},
+	{name: BlockTx, part: partRepository, enabled: enabledIf(ComponentRepository), methods: []tableMethod{
+		(*gogen.Generator).RepositoryTx,
+		(*gogen.Generator).RepositoryTxMethodCommitMethod,
+		(*gogen.Generator).RepositoryTxMethodRollbackMethod,
+		(*gogen.Generator).RepositoryTxMethodSavepoint,
+	}},
+	{name: BlockTxInsert, part: partRepository, enabled: enabledIf(ComponentInsert), methods: []tableMethod{
+		(*gogen.Generator).RepositoryTxMethodInsert,
+	}},
+	{name: BlockTxFind, part: partRepository, enabled: enabledIf(ComponentFind), methods: []tableMethod{
+		(*gogen.Generator).RepositoryTxMethodFind,
+		(*gogen.Generator).RepositoryTxMethodFindIter,
+		(*gogen.Generator).RepositoryTxMethodFindProjection,
+		(*gogen.Generator).RepositoryTxMethodFindOneByPrimaryKey,
+		(*gogen.Generator).RepositoryTxMethodFindOneByUniqueConstraint,
+	}},
+	{name: BlockTxHistory, part: partRepository, enabled: enabledIfHistory(ComponentFind), methods: []tableMethod{
+		(*gogen.Generator).RepositoryTxMethodHistory,
+		(*gogen.Generator).RepositoryTxMethodFindOneByPrimaryKeyAsOf,
+	}},
+	{name: BlockTxUpdate, part: partRepository, enabled: enabledIf(ComponentUpdate), methods: []tableMethod{
+		(*gogen.Generator).RepositoryTxMethodUpdateOneByPrimaryKey,
+		(*gogen.Generator).RepositoryTxMethodUpdateOneByUniqueConstraint,
+	}},
+	{name: BlockTxUpsert, part: partRepository, enabled: enabledIf(ComponentUpsert), methods: []tableMethod{
+		(*gogen.Generator).RepositoryTxMethodUpsert,
+		(*gogen.Generator).RepositoryTxMethodUpsertMany,
+	}},
+	{name: BlockTxCount, part: partRepository, enabled: enabledIf(ComponentCount), methods: []tableMethod{
+		(*gogen.Generator).RepositoryTxMethodCount,
+	}},
+	{name: BlockTxDelete, part: partRepository, enabled: enabledIf(ComponentDelete), methods: []tableMethod{
+		(*gogen.Generator).RepositoryTxMethodDeleteOneByPrimaryKey,
+	}},
+	{name: BlockFixture, part: partRepository, enabled: enabledIfFixtures, methods: []tableMethod{
+		(*gogen.Generator).Fixture,
+	}},
+}
+
+// generateHead generates code shared by all tables that single file output puts in front of them.
+func (g *Generator) generateHead(s *pqt.Schema) {
+	g.block(BlockHead, g.schemaView(s), func() {
+		if g.Components&ComponentRepository != 0 {
+			g.g.Funcs()
+			g.g.NewLine()
+			g.g.SchemaFuncs()
+			g.g.NewLine()
+			g.g.Errors()
+			g.g.NewLine()
+			g.g.ConstraintErrorStatics(s)
+			g.g.NewLine()
+			g.g.RunInTransaction()
+			g.g.NewLine()
+			g.g.Session(s)
+			g.g.NewLine()
+			g.g.Repositories(s)
+			g.g.NewLine()
+			g.g.StmtCache()
+			g.g.NewLine()
+		}
+		if g.Components&ComponentFind != 0 || g.Components&ComponentCount != 0 || g.Components&ComponentHelpers != 0 {
+			g.g.Interfaces()
+			g.g.NewLine()
+		}
+		if g.Components&ComponentFind != 0 || g.Components&ComponentCount != 0 {
+			g.g.JoinClause()
+			g.g.NewLine()
+		}
+		if g.Components&ComponentFind != 0 {
+			g.g.LockClause()
+			g.g.NewLine()
+			g.g.Projection()
+			g.g.NewLine()
+		}
+		for _, t := range s.Tables {
+			if t.Notify {
+				g.g.Listener()
+				g.g.NewLine()
+				break
+			}
+		}
+	})
+}
+
+// generateTable generates blocks of given parts for a single table.
+func (g *Generator) generateTable(t *pqt.Table, p part) {
+	view := g.tableView(t)
+	for _, b := range tableBlocks {
+		if b.part&p == 0 || !b.enabled(t, g.Components) {
+			continue
+		}
+		if b.component != 0 {
+			g.pluginsBeforeComponent(t, b.component)
+		}
+		methods := b.methods
+		g.block(b.name, view, func() {
+			for _, m := range methods {
+				m(g.g, t)
+				g.g.NewLine()
+			}
+		})
+		if b.component != 0 {
+			g.pluginsAfterComponent(t, b.component)
+		}
+		if b.name == BlockEntity {
+			g.pluginsEntityMethods(t)
+		}
+	}
+	if p&partRepository != 0 {
+		if g.Components&ComponentRepository != 0 {
+			g.pluginsRepositoryMethods(t)
+		}
+		g.pluginsTableStatics(t)
+	}
+}
+
+// generateTail generates code shared by all tables that single file output puts after them.
+func (g *Generator) generateTail(s *pqt.Schema, p part) {
+	g.block(BlockStatics, g.schemaView(s), func() {
+		switch p {
+		case partAll:
+			g.g.Statics()
+		case partEntity:
+			g.g.ArrayTypes()
+		case partRepository:
+			g.g.RepositoryStatics()
+		}
+		if p&partEntity != 0 {
+			g.g.TypeStatics(s)
+			g.g.ValidationStatics(s)
+		}
+		if p&partRepository != 0 {
+			g.g.PluginsStatics(s)
+			g.g.TextSearchStatics(s)
+			g.g.JSONStatics(s)
+			if enabledIf(ComponentFind|ComponentCount|ComponentUpsert)(nil, g.Components) {
+				g.g.RelationshipStatics(s)
+			}
+			if enabledIfFixtures(nil, g.Components) {
+				g.g.FixtureStatics()
+			}
+		}
+		g.g.NewLine()
+	})
+}
@@ -0,0 +1,66 @@
+package pqtgogen
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/piotrkowalczuk/pqt"
+)
+
+// GenerateDatabase works like Generate, but code for tables of all schemas of the database is generated into a single package.
+// Table names have to be unique across schemas, since generated identifiers are not prefixed with a schema name.
+func (g *Generator) GenerateDatabase(d *pqt.Database) ([]byte, error) {
+	s, err := mergeSchemas(d)
+	if err != nil {
+		return nil, err
+	}
+
+	return g.Generate(s)
+}
+
+// GenerateDatabaseTo works like GenerateDatabase but it writes into io Writer instead.
+func (g *Generator) GenerateDatabaseTo(d *pqt.Database, w io.Writer) error {
+	s, err := mergeSchemas(d)
+	if err != nil {
+		return err
+	}
+
+	return g.GenerateTo(s, w)
+}
+
+// GenerateDatabaseDir works like GenerateDir, but for all schemas of the database.
+func (g *Generator) GenerateDatabaseDir(d *pqt.Database, dir string) error {
+	s, err := mergeSchemas(d)
+	if err != nil {
+		return err
+	}
+
+	return g.GenerateDir(s, dir)
+}
+
+// mergeSchemas returns schema that holds objects of all schemas of the database, in dependency order.
+// Objects are not moved, so each table keeps reference to the schema it belongs to.
+func mergeSchemas(d *pqt.Database) (*pqt.Schema, error) {
+	schemas, err := d.SortedSchemas()
+	if err != nil {
+		return nil, err
+	}
+
+	merged := &pqt.Schema{Name: d.Name}
+	owners := make(map[string]*pqt.Schema)
+	for _, s := range schemas {
+		for _, t := range s.Tables {
+			if owner, ok := owners[t.Name]; ok {
+				return nil, fmt.Errorf("table %s is defined in both %s and %s schemas", t.Name, owner.Name, s.Name)
+			}
+			owners[t.Name] = s
+		}
+		merged.Tables = append(merged.Tables, s.Tables...)
+		merged.Functions = append(merged.Functions, s.Functions...)
+		merged.Sequences = append(merged.Sequences, s.Sequences...)
+		merged.Roles = append(merged.Roles, s.Roles...)
+		merged.Types = append(merged.Types, s.Types...)
+	}
+
+	return merged, nil
+}
@@ -0,0 +1,59 @@
+package pqtgogen_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/piotrkowalczuk/pqt"
+	"github.com/piotrkowalczuk/pqt/pqtgo/pqtgogen"
+)
+
+func TestGenerator_GenerateDatabase(t *testing.T) {
+	customer := pqt.NewTable("customer").
+		AddColumn(pqt.NewColumn("id", pqt.TypeSerialBig(), pqt.WithPrimaryKey()))
+	address := pqt.NewTable("address").
+		AddColumn(pqt.NewColumn("id", pqt.TypeSerialBig(), pqt.WithPrimaryKey()))
+	invoice := pqt.NewTable("invoice").
+		AddColumn(pqt.NewColumn("id", pqt.TypeSerialBig(), pqt.WithPrimaryKey())).
+		AddRelationship(pqt.ManyToOne(customer)).
+		AddRelationship(pqt.ManyToOne(address))
+
+	d := pqt.NewDatabase("app").
+		AddSchema(pqt.NewSchema("billing").AddTable(invoice).AddTable(address)).
+		AddSchema(pqt.NewSchema("public").AddTable(customer))
+
+	g := pqtgogen.Generator{
+		Version:    9.5,
+		Pkg:        "app",
+		Components: pqtgogen.ComponentAll,
+	}
+	buf, err := g.GenerateDatabase(d)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	got := strings.Join(strings.Fields(string(buf)), " ")
+	for _, expected := range []string{
+		`TableCustomer = "public.customer"`,
+		`TableInvoice = "billing.invoice"`,
+		`joinClause(comp, fe.JoinCustomer.Kind, TableCustomer+" AS t1 ON t0.customer_id=t1.id")`,
+		`joinClause(comp, fe.JoinAddress.Kind, inSchemaOf(TableAddress, r.Table)+" AS t2 ON t0.address_id=t2.id")`,
+	} {
+		if !strings.Contains(got, expected) {
+			t.Errorf("generated code is missing:\n%s", expected)
+		}
+	}
+	if strings.Index(got, "type CustomerEntity struct") > strings.Index(got, "type InvoiceEntity struct") {
+		t.Error("referenced schema tables expected to be generated first")
+	}
+}
+
+func TestGenerator_GenerateDatabase_duplicateTable(t *testing.T) {
+	d := pqt.NewDatabase("app").
+		AddSchema(pqt.NewSchema("billing").AddTable(pqt.NewTable("event").AddColumn(pqt.NewColumn("id", pqt.TypeSerialBig(), pqt.WithPrimaryKey())))).
+		AddSchema(pqt.NewSchema("audit").AddTable(pqt.NewTable("event").AddColumn(pqt.NewColumn("id", pqt.TypeSerialBig(), pqt.WithPrimaryKey()))))
+
+	g := pqtgogen.Generator{Version: 9.5, Components: pqtgogen.ComponentAll}
+	if _, err := g.GenerateDatabase(d); err == nil {
+		t.Fatal("expected error")
+	}
+}
@@ -0,0 +1,320 @@
+package pqtgogen
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/piotrkowalczuk/pqt"
+	"github.com/piotrkowalczuk/pqt/pqtgo"
+)
+
+type part uint8
+
+const (
+	partEntity part = 1 << iota
+	partRepository
+	partAll = partEntity | partRepository
+)
+
+// SharedFile is the name of the file that holds code shared by all tables.
+const SharedFile = "schema.pqt.go"
+
+// File is a single Go source file produced by GenerateFiles.
+type File struct {
+	// Path is relative to the output directory.
+	Path    string
+	Content []byte
+}
+
+// GenerateDir works like Generate, but instead of a single file,
+// it writes code shared by all tables into SharedFile and code specific to each table into <table>.pqt.go file.
+// If EntitiesPkg is set, entities are written into the subdirectory.
+func (g *Generator) GenerateDir(s *pqt.Schema, dir string) error {
+	files, err := g.GenerateFiles(s)
+	if err != nil {
+		return err
+	}
+	for _, f := range files {
+		p := filepath.Join(dir, filepath.FromSlash(f.Path))
+		if err := os.MkdirAll(filepath.Dir(p), 0755); err != nil {
+			return err
+		}
+		if err := ioutil.WriteFile(p, f.Content, 0644); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GenerateFiles works like GenerateDir, but returns files instead of writing them.
+func (g *Generator) GenerateFiles(s *pqt.Schema) ([]File, error) {
+	if err := g.checkSchema(s); err != nil {
+		return nil, err
+	}
+	if g.EntitiesPkg == "" {
+		return g.generateFiles(s, "", g.Pkg, partAll, nil)
+	}
+
+	entitiesPkg := path.Base(g.EntitiesPkg)
+	entities, err := g.generateFiles(s, entitiesPkg, entitiesPkg, partEntity, nil)
+	if err != nil {
+		return nil, err
+	}
+	decls := make(map[string]bool)
+	for _, f := range entities {
+		if err := collectDecls(f.Content, decls); err != nil {
+			return nil, err
+		}
+	}
+	repositories, err := g.generateFiles(s, "", g.Pkg, partRepository, decls)
+	if err != nil {
+		return nil, err
+	}
+
+	return append(repositories, entities...), nil
+}
+
+// generateFiles generates files of given parts into dir.
+// Identifiers declared by entities package (if any) are qualified with its name.
+func (g *Generator) generateFiles(s *pqt.Schema, dir, pkg string, p part, entities map[string]bool) ([]File, error) {
+	var files []File
+
+	g.reset()
+	if p&partRepository != 0 {
+		g.generateHead(s)
+	}
+	g.generateTail(s, p)
+	f, err := g.file(s, pkg, entities)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %s", SharedFile, err.Error())
+	}
+	files = append(files, File{Path: path.Join(dir, SharedFile), Content: f})
+
+	for _, t := range s.Tables {
+		name := t.Name + ".pqt.go"
+
+		g.reset()
+		g.generateTable(t, p)
+		f, err := g.file(s, pkg, entities)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %s", name, err.Error())
+		}
+		files = append(files, File{Path: path.Join(dir, name), Content: f})
+	}
+
+	return files, nil
+}
+
+// file turns printer content into formatted Go file with package clause and imports the code uses.
+func (g *Generator) file(s *pqt.Schema, pkg string, entities map[string]bool) ([]byte, error) {
+	if g.p.Err != nil {
+		return nil, g.p.Err
+	}
+
+	fset := token.NewFileSet()
+	src := append([]byte("package "+pkg+"\n"), g.p.Bytes()...)
+	f, err := parser.ParseFile(fset, "", src, parser.ParseComments)
+	if err != nil {
+		return nil, err
+	}
+
+	imports := make(map[string]bool)
+	if entities != nil && qualify(f, path.Base(g.EntitiesPkg), entities) {
+		imports[g.EntitiesPkg] = true
+	}
+	known := g.knownImports(s)
+	ast.Inspect(f, func(n ast.Node) bool {
+		if sel, ok := n.(*ast.SelectorExpr); ok {
+			if id, ok := sel.X.(*ast.Ident); ok && id.Obj == nil {
+				if imp, ok := known[id.Name]; ok {
+					imports[imp] = true
+				}
+			}
+		}
+		return true
+	})
+
+	var body bytes.Buffer
+	if err := format.Node(&body, fset, f); err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "// Code generated by pqt. DO NOT EDIT.\n\npackage %s\n\n", pkg)
+	if len(imports) > 0 {
+		var std, other []string
+		for imp := range imports {
+			if strings.Contains(strings.SplitN(imp, "/", 2)[0], ".") {
+				other = append(other, imp)
+			} else {
+				std = append(std, imp)
+			}
+		}
+		sort.Strings(std)
+		sort.Strings(other)
+
+		buf.WriteString("import (\n")
+		for _, imp := range std {
+			fmt.Fprintf(&buf, "%q\n", imp)
+		}
+		if len(std) > 0 && len(other) > 0 {
+			buf.WriteString("\n")
+		}
+		for _, imp := range other {
+			fmt.Fprintf(&buf, "%q\n", imp)
+		}
+		buf.WriteString(")\n")
+	}
+	buf.Write(bytes.TrimPrefix(body.Bytes(), []byte("package "+pkg+"\n")))
+
+	return format.Source(buf.Bytes())
+}
+
+// knownImports maps package names to import paths generated code can refer to.
+func (g *Generator) knownImports(s *pqt.Schema) map[string]string {
+	imports := []string{
+		"bytes",
+		"container/list",
+		"context",
+		"database/sql",
+		"database/sql/driver",
+		"encoding/json",
+		"errors",
+		"fmt",
+		"io",
+		"math",
+		"math/rand",
+		"regexp",
+		"strconv",
+		"strings",
+		"sync",
+		"sync/atomic",
+		"time",
+		"unicode/utf8",
+		"github.com/lib/pq",
+		"github.com/lib/pq/hstore",
+	}
+	imports = append(imports, g.Imports...)
+	imports = append(imports, g.pluginImports(s)...)
+	for _, t := range s.Tables {
+		for _, c := range t.Columns {
+			if ct, ok := c.Type.(pqtgo.CustomType); ok {
+				for _, m := range []int32{pqtgo.ModeMandatory, pqtgo.ModeOptional, pqtgo.ModeCriteria} {
+					if typ := ct.TypeOf(m); typ != nil && typ.PkgPath() != "" {
+						imports = append(imports, typ.PkgPath())
+					}
+				}
+			}
+		}
+	}
+
+	known := make(map[string]string, len(imports))
+	for _, imp := range imports {
+		known[path.Base(imp)] = imp
+	}
+	return known
+}
+
+// collectDecls collects names of top level declarations of given Go source.
+func collectDecls(src []byte, decls map[string]bool) error {
+	f, err := parser.ParseFile(token.NewFileSet(), "", src, 0)
+	if err != nil {
+		return err
+	}
+	for _, d := range f.Decls {
+		switch d := d.(type) {
+		case *ast.FuncDecl:
+			if d.Recv == nil {
+				decls[d.Name.Name] = true
+			}
+		case *ast.GenDecl:
+			for _, spec := range d.Specs {
+				switch spec := spec.(type) {
+				case *ast.TypeSpec:
+					decls[spec.Name.Name] = true
+				case *ast.ValueSpec:
+					for _, n := range spec.Names {
+						decls[n.Name] = true
+					}
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// qualify prefixes with package name every unresolved reference to given declarations.
+// It returns true if anything was qualified.
+func qualify(f *ast.File, pkg string, decls map[string]bool) bool {
+	var (
+		qualified bool
+		visit     func(ast.Node) bool
+		literal   func(*ast.CompositeLit, ast.Expr)
+		element   func(ast.Expr, ast.Expr)
+	)
+	visit = func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.SelectorExpr:
+			ast.Inspect(n.X, visit)
+			return false
+		case *ast.CompositeLit:
+			literal(n, n.Type)
+			return false
+		case *ast.Ident:
+			if n.Obj == nil && decls[n.Name] {
+				n.Name = pkg + "." + n.Name
+				qualified = true
+			}
+		}
+		return true
+	}
+	// literal visits composite literal of given type.
+	// Keys of struct literals are field names, keys of map, slice and array literals are expressions.
+	literal = func(lit *ast.CompositeLit, typ ast.Expr) {
+		if lit.Type != nil {
+			ast.Inspect(lit.Type, visit)
+		}
+		var (
+			key, elem ast.Expr
+			fields    = true
+		)
+		switch t := typ.(type) {
+		case *ast.MapType:
+			key, elem, fields = t.Key, t.Value, false
+		case *ast.ArrayType:
+			elem, fields = t.Elt, false
+		}
+		for _, e := range lit.Elts {
+			if kv, ok := e.(*ast.KeyValueExpr); ok {
+				if _, ok := kv.Key.(*ast.Ident); !ok || !fields {
+					element(kv.Key, key)
+				}
+				e = kv.Value
+			}
+			element(e, elem)
+		}
+	}
+	// element visits expression of given type, type of nested composite literal can be elided.
+	element = func(e, typ ast.Expr) {
+		if lit, ok := e.(*ast.CompositeLit); ok && lit.Type == nil {
+			if star, ok := typ.(*ast.StarExpr); ok {
+				typ = star.X
+			}
+			literal(lit, typ)
+			return
+		}
+		ast.Inspect(e, visit)
+	}
+	ast.Inspect(f, visit)
+	return qualified
+}
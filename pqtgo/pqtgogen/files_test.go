@@ -0,0 +1,252 @@
+package pqtgogen_test
+
+import (
+	"bytes"
+	"go/build"
+	"go/parser"
+	"go/token"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"testing"
+
+	"github.com/piotrkowalczuk/pqt"
+	"github.com/piotrkowalczuk/pqt/pqtgo/pqtgogen"
+)
+
+func filesSchema() *pqt.Schema {
+	userID := pqt.NewColumn("id", pqt.TypeSerialBig(), pqt.WithPrimaryKey())
+	user := pqt.NewTable("user").
+		AddColumn(userID).
+		AddColumn(pqt.NewColumn("name", pqt.TypeText(), pqt.WithNotNull(), pqt.WithUnique())).
+		AddColumn(pqt.NewColumn("scores", pqt.TypeIntegerArray(0)))
+	comment := pqt.NewTable("comment").
+		AddColumn(pqt.NewColumn("id", pqt.TypeSerialBig(), pqt.WithPrimaryKey())).
+		AddColumn(pqt.NewColumn("user_id", pqt.TypeIntegerBig(), pqt.WithReference(userID)))
+
+	return pqt.NewSchema("example").AddTable(user).AddTable(comment)
+}
+
+// componentsSchema returns schema that makes the generator emit every optional component:
+// text search, JSONB operators, history, notifications (composite key included) and relationship predicates.
+func componentsSchema() *pqt.Schema {
+	title := pqt.NewColumn("title", pqt.TypeText(), pqt.WithNotNull(), pqt.WithUnique())
+	content := pqt.NewColumn("content", pqt.TypeText(), pqt.WithNotNull())
+	news := pqt.NewTable("news", pqt.WithNotify("news"), pqt.WithHistory()).
+		AddColumn(pqt.NewColumn("id", pqt.TypeSerialBig(), pqt.WithPrimaryKey())).
+		AddColumn(title).
+		AddColumn(content).
+		AddColumn(pqt.NewColumn("meta_data", pqt.TypeJSONB())).
+		AddColumn(pqt.NewColumn("scores", pqt.TypeDoubleArray(0))).
+		AddColumn(pqt.NewColumn("document", pqt.TypeTSVector(), pqt.WithTextSearch("english", title, content)))
+	comment := pqt.NewTable("comment").
+		AddColumn(pqt.NewColumn("id", pqt.TypeSerialBig(), pqt.WithPrimaryKey())).
+		AddColumn(pqt.NewColumn("content", pqt.TypeText(), pqt.WithNotNull())).
+		AddRelationship(pqt.ManyToOne(news, pqt.WithBidirectional()), pqt.WithNotNull())
+	category := pqt.NewTable("category").
+		AddColumn(pqt.NewColumn("id", pqt.TypeSerialBig(), pqt.WithPrimaryKey())).
+		AddColumn(pqt.NewColumn("name", pqt.TypeText(), pqt.WithNotNull()))
+	categoryNews := pqt.NewTable("category_news", pqt.WithNotify("category_news")).
+		AddRelationship(pqt.ManyToMany(category, news, pqt.WithBidirectional(), pqt.WithThroughPrimaryKey()), pqt.WithNotNull())
+
+	return pqt.NewSchema("example").AddTable(news).AddTable(comment).AddTable(category).AddTable(categoryNews)
+}
+
+func fileImports(t *testing.T, f pqtgogen.File) map[string]bool {
+	t.Helper()
+
+	af, err := parser.ParseFile(token.NewFileSet(), f.Path, f.Content, parser.ImportsOnly)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	imports := make(map[string]bool)
+	for _, imp := range af.Imports {
+		p, _ := strconv.Unquote(imp.Path.Value)
+		imports[p] = true
+	}
+	return imports
+}
+
+func TestGenerator_GenerateFiles(t *testing.T) {
+	g := pqtgogen.Generator{
+		Version:    9.5,
+		Pkg:        "example",
+		Components: pqtgogen.ComponentAll,
+	}
+	files, err := g.GenerateFiles(filesSchema())
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	expected := []string{pqtgogen.SharedFile, "user.pqt.go", "comment.pqt.go"}
+	if len(files) != len(expected) {
+		t.Fatalf("wrong number of files, expected %d but got %d", len(expected), len(files))
+	}
+	for i, f := range files {
+		if f.Path != expected[i] {
+			t.Errorf("wrong path of file #%d, expected %s but got %s", i, expected[i], f.Path)
+		}
+		if !bytes.Contains(f.Content, []byte("\npackage example\n")) {
+			t.Errorf("file %s has wrong package clause", f.Path)
+		}
+	}
+	if !bytes.Contains(files[0].Content, []byte("type Composer struct")) {
+		t.Error("shared file should contain composer")
+	}
+	if bytes.Contains(files[1].Content, []byte("type Composer struct")) {
+		t.Error("table file should not contain composer")
+	}
+	if !bytes.Contains(files[1].Content, []byte("type UserEntity struct")) || !bytes.Contains(files[1].Content, []byte("type UserRepositoryBase struct")) {
+		t.Error("table file should contain entity and repository")
+	}
+	if imports := fileImports(t, files[0]); !imports["github.com/lib/pq"] || imports["github.com/m4rw3r/uuid"] {
+		t.Errorf("wrong shared file imports: %v", imports)
+	}
+	if imports := fileImports(t, files[2]); !imports["context"] || !imports["database/sql"] || imports["github.com/lib/pq"] {
+		t.Errorf("wrong table file imports: %v", imports)
+	}
+}
+
+func TestGenerator_GenerateFiles_entitiesPkg(t *testing.T) {
+	g := pqtgogen.Generator{
+		Version:     9.5,
+		Pkg:         "example",
+		Components:  pqtgogen.ComponentAll,
+		EntitiesPkg: "github.com/example/example/entity",
+	}
+	files, err := g.GenerateFiles(filesSchema())
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	expected := []string{
+		pqtgogen.SharedFile, "user.pqt.go", "comment.pqt.go",
+		"entity/" + pqtgogen.SharedFile, "entity/user.pqt.go", "entity/comment.pqt.go",
+	}
+	if len(files) != len(expected) {
+		t.Fatalf("wrong number of files, expected %d but got %d", len(expected), len(files))
+	}
+	for i, f := range files {
+		if f.Path != expected[i] {
+			t.Errorf("wrong path of file #%d, expected %s but got %s", i, expected[i], f.Path)
+		}
+	}
+
+	user, userEntity := files[1], files[4]
+	if !bytes.Contains(userEntity.Content, []byte("\npackage entity\n")) {
+		t.Error("entity file has wrong package clause")
+	}
+	if !bytes.Contains(userEntity.Content, []byte("type UserEntity struct")) || bytes.Contains(userEntity.Content, []byte("type UserRepositoryBase struct")) {
+		t.Error("entity file should contain entity only")
+	}
+	if !bytes.Contains(files[3].Content, []byte("type NullInt64Array struct")) {
+		t.Error("entity shared file should contain array types")
+	}
+	if bytes.Contains(files[0].Content, []byte("type NullInt64Array struct")) {
+		t.Error("shared file should not contain array types")
+	}
+	if !bytes.Contains(user.Content, []byte("\npackage example\n")) {
+		t.Error("repository file has wrong package clause")
+	}
+	if !fileImports(t, user)[g.EntitiesPkg] {
+		t.Error("repository file should import entities package")
+	}
+	for _, ref := range []string{"*entity.UserEntity", "entity.TableUserColumnID", "entity.NullInt64Array"} {
+		if !bytes.Contains(user.Content, []byte(ref)) {
+			t.Errorf("repository file should refer to %s", ref)
+		}
+	}
+}
+
+type literalsPlugin struct {
+	basePlugin
+}
+
+func (literalsPlugin) PluginVersion() int { return pqtgogen.PluginVersion }
+
+func (literalsPlugin) RepositoryMethods(t *pqt.Table) string {
+	if t.Name != "user" {
+		return ""
+	}
+	return `func (r *UserRepositoryBase) Literals() (map[string]string, map[string][]string, []*UserEntity) {
+	return map[string]string{
+			TableUserColumnID: "identifier",
+		}, map[string][]string{
+			TableUserColumnName: {TableUserColumnID},
+		}, []*UserEntity{{ID: 1}}
+}`
+}
+
+func TestGenerator_GenerateFiles_entitiesPkgCompositeLiterals(t *testing.T) {
+	g := pqtgogen.Generator{
+		Version:     9.5,
+		Pkg:         "example",
+		Components:  pqtgogen.ComponentAll,
+		EntitiesPkg: "github.com/example/example/entity",
+		Plugins:     []pqtgogen.Plugin{literalsPlugin{}},
+	}
+	files, err := g.GenerateFiles(filesSchema())
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	user := files[1]
+	for _, exp := range []string{
+		"entity.TableUserColumnID: \"identifier\"",
+		"entity.TableUserColumnName: {entity.TableUserColumnID}",
+		"[]*entity.UserEntity{{ID: 1}}",
+	} {
+		if !bytes.Contains(user.Content, []byte(exp)) {
+			t.Errorf("repository file should contain: %s", exp)
+		}
+	}
+}
+
+func TestGenerator_GenerateDir_entitiesPkgBuilds(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping build of generated code in short mode")
+	}
+	gobin, err := exec.LookPath("go")
+	if err != nil {
+		t.Skip("go command not found")
+	}
+	gopath, err := ioutil.TempDir("", "pqtgogen")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	defer os.RemoveAll(gopath)
+
+	const pkg = "github.com/example/example"
+	g := pqtgogen.Generator{
+		Version:     11,
+		Pkg:         "example",
+		Components:  pqtgogen.ComponentAll | pqtgogen.ComponentFixtures,
+		EntitiesPkg: pkg + "/entity",
+	}
+	dir := filepath.Join(gopath, "src", filepath.FromSlash(pkg))
+	if err := g.GenerateDir(componentsSchema(), dir); err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	// Dependencies of the generated code are resolved from GOPATH the test runs with.
+	env := append(os.Environ(),
+		"GOPATH="+gopath+string(os.PathListSeparator)+build.Default.GOPATH,
+		"GO111MODULE=off",
+		"GOFLAGS=",
+	)
+	deps := exec.Command(gobin, "list", "github.com/lib/pq")
+	deps.Dir = dir
+	deps.Env = env
+	if out, err := deps.CombinedOutput(); err != nil {
+		t.Skipf("dependencies of the generated code are not available in GOPATH: %s", bytes.TrimSpace(out))
+	}
+
+	cmd := exec.Command(gobin, "vet", "./...")
+	cmd.Dir = dir
+	cmd.Env = env
+	if out, err := cmd.CombinedOutput(); err != nil {
+		t.Fatalf("generated packages do not type-check: %s\n%s", err.Error(), out)
+	}
+}
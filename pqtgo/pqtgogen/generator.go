@@ -1,12 +1,15 @@
 package pqtgogen
 
 import (
+	"fmt"
 	"go/format"
 	"io"
+	"text/template"
 
 	"github.com/piotrkowalczuk/pqt"
 	"github.com/piotrkowalczuk/pqt/internal/gogen"
 	"github.com/piotrkowalczuk/pqt/internal/print"
+	"github.com/piotrkowalczuk/pqt/pqtgo"
 )
 
 // Component bits represents single component that can be generated by the generator.
@@ -27,10 +30,13 @@ const (
 	ComponentDelete
 	// ComponentHelpers represents all helpers.
 	ComponentHelpers
+	// ComponentFixtures represents Fixtures type and NewXxxFixture, InsertXxxFixture builders meant for tests.
+	// Fixtures are inserted using repositories, so it requires ComponentInsert as well.
+	ComponentFixtures
 
 	// ComponentRepository is a bit mask that group all repository methods.
 	ComponentRepository = ComponentInsert | ComponentFind | ComponentUpdate | ComponentUpsert | ComponentCount | ComponentDelete
-	// ComponentAll is a bit mask that groups all components.
+	// ComponentAll is a bit mask that groups all components, except fixtures that production code does not need.
 	ComponentAll = ComponentRepository | ComponentHelpers
 )
 
@@ -47,9 +53,18 @@ type Generator struct {
 	Plugins []Plugin
 	// Components ...
 	Components Component
+	// EntitiesPkg is the import path of the package entities are generated into by GenerateDir.
+	// Package is written into the subdirectory named after the last element of the path.
+	// By default, entities are generated into the same package as repositories.
+	EntitiesPkg string
+	// Templates are text/template sources that can redefine any of the named blocks (BlockEntity, BlockFind, ...).
+	// Block templates are executed with *TableView or *SchemaView, see the Block constants.
+	// Blocks that are not defined are generated as usual.
+	Templates []string
 
-	g *gogen.Generator
-	p *print.Printer
+	g    *gogen.Generator
+	p    *print.Printer
+	tmpl *template.Template
 }
 
 // Generate generates formatted Go code for given schema.
@@ -76,184 +91,55 @@ func (g *Generator) GenerateTo(s *pqt.Schema, w io.Writer) error {
 }
 
 func (g *Generator) generate(s *pqt.Schema) error {
-	g.g = &gogen.Generator{
-		Version: g.Version,
-	}
-	for _, p := range g.Plugins {
-		g.g.Plugins = append(g.g.Plugins, p)
+	if err := g.checkSchema(s); err != nil {
+		return err
 	}
-	g.p = &g.g.Printer
-
+	g.reset()
 	g.g.Package(g.Pkg)
-	g.g.Imports(s, "github.com/m4rw3r/uuid")
-	if g.Components&ComponentRepository != 0 {
-		g.g.Funcs()
-		g.g.NewLine()
-		g.g.Errors()
-		g.g.NewLine()
-		g.g.RunInTransaction()
-		g.g.NewLine()
-	}
-	if g.Components&ComponentFind != 0 || g.Components&ComponentCount != 0 || g.Components&ComponentHelpers != 0 {
-		g.g.Interfaces()
-		g.g.NewLine()
-	}
-	if g.Components&ComponentFind != 0 || g.Components&ComponentCount != 0 {
-		g.g.JoinClause()
-		g.g.NewLine()
+	g.g.Imports(s, append([]string{"github.com/m4rw3r/uuid"}, g.pluginImports(s)...)...)
+	g.generateHead(s)
+	for _, t := range s.Tables {
+		g.generateTable(t, partAll)
 	}
+	g.generateTail(s, partAll)
+
+	return g.p.Err
+}
+
+// checkSchema returns an error if the schema cannot be represented in Go:
+// a table with notifications has no primary key to identify changes with,
+// or a column has a type that has no Go counterpart and no plugin provides its property type.
+func (g *Generator) checkSchema(s *pqt.Schema) error {
 	for _, t := range s.Tables {
-		g.g.Constraints(t)
-		g.g.NewLine()
-		g.g.Columns(t)
-		g.g.NewLine()
-		g.g.Entity(t)
-		g.g.NewLine()
-		g.g.EntityProp(t)
-		g.g.NewLine()
-		g.g.EntityProps(t)
-		g.g.NewLine()
-		if g.Components&ComponentHelpers != 0 {
-			g.g.ScanRows(t)
-			g.g.NewLine()
+		if t.Notify && len(t.PrimaryKeyColumns()) == 0 {
+			return fmt.Errorf("pqtgogen: table %s has no primary key, notifications require one", t.Name)
 		}
-		if g.Components&ComponentFind != 0 || g.Components&ComponentCount != 0 {
-			g.g.Iterator(t)
-			g.g.NewLine()
-			g.g.Criteria(t)
-			g.g.NewLine()
-			g.g.Operand(t)
-			g.g.NewLine()
-			g.g.FindExpr(t)
-			g.g.NewLine()
-			g.g.Join(t)
-			g.g.NewLine()
-		}
-		if g.Components&ComponentCount != 0 {
-			g.g.CountExpr(t)
-			g.g.NewLine()
-		}
-		if g.Components&ComponentUpdate != 0 || g.Components&ComponentUpsert != 0 {
-			g.g.Patch(t)
-			g.g.NewLine()
-		}
-		if g.Components&ComponentRepository != 0 {
-			g.g.Repository(t)
-			g.g.NewLine()
-			g.g.RepositoryMethodTx(t)
-			g.g.NewLine()
-			g.g.RepositoryMethodBeginTx(t)
-			g.g.NewLine()
-			g.g.RepositoryMethodRunInTransaction(t)
-			g.g.NewLine()
-
-			if g.Components&ComponentInsert != 0 {
-				g.g.RepositoryMethodInsertQuery(t)
-				g.g.NewLine()
-				g.g.RepositoryMethodPrivateInsert(t)
-				g.g.NewLine()
-				g.g.RepositoryMethodInsert(t)
-				g.g.NewLine()
-			}
-			if g.Components&ComponentFind != 0 {
-				g.g.WhereClause(t)
-				g.g.NewLine()
-				g.g.RepositoryMethodFindQuery(t)
-				g.g.NewLine()
-				g.g.RepositoryMethodPrivateFind(t)
-				g.g.NewLine()
-				g.g.RepositoryMethodFind(t)
-				g.g.NewLine()
-				g.g.RepositoryMethodPrivateFindIter(t)
-				g.g.NewLine()
-				g.g.RepositoryMethodFindIter(t)
-				g.g.NewLine()
-				g.g.RepositoryMethodPrivateFindOneByPrimaryKey(t)
-				g.g.NewLine()
-				g.g.RepositoryMethodFindOneByPrimaryKey(t)
-				g.g.NewLine()
-				g.g.RepositoryMethodPrivateFindOneByUniqueConstraint(t)
-				g.g.NewLine()
-				g.g.RepositoryMethodFindOneByUniqueConstraint(t)
-				g.g.NewLine()
+	ColumnsLoop:
+		for _, c := range t.Columns {
+			at, ok := c.Type.(pqt.ArrayType)
+			if !ok || at.Dimensions() < 2 {
+				continue
 			}
-			if g.Components&ComponentUpdate != 0 {
-				g.g.RepositoryMethodUpdateOneByPrimaryKeyQuery(t)
-				g.g.NewLine()
-				g.g.RepositoryMethodPrivateUpdateOneByPrimaryKey(t)
-				g.g.NewLine()
-				g.g.RepositoryMethodUpdateOneByPrimaryKey(t)
-				g.g.NewLine()
-				g.g.RepositoryMethodFindOneByPrimaryKeyAndUpdate(t)
-				g.g.NewLine()
-				g.g.RepositoryMethodUpdateOneByUniqueConstraintQuery(t)
-				g.g.NewLine()
-				g.g.RepositoryMethodPrivateUpdateOneByUniqueConstraint(t)
-				g.g.NewLine()
-				g.g.RepositoryMethodUpdateOneByUniqueConstraint(t)
-				g.g.NewLine()
-			}
-			if g.Components&ComponentUpsert != 0 {
-				g.g.RepositoryMethodUpsertQuery(t)
-				g.g.NewLine()
-				g.g.RepositoryMethodPrivateUpsert(t)
-				g.g.NewLine()
-				g.g.RepositoryMethodUpsert(t)
-				g.g.NewLine()
-			}
-			if g.Components&ComponentCount != 0 {
-				g.g.RepositoryMethodPrivateCount(t)
-				g.g.NewLine()
-				g.g.RepositoryMethodCount(t)
-				g.g.NewLine()
-			}
-			if g.Components&ComponentDelete != 0 {
-				g.g.RepositoryMethodPrivateDeleteOneByPrimaryKey(t)
-				g.g.NewLine()
-				g.g.RepositoryMethodDeleteOneByPrimaryKey(t)
-				g.g.NewLine()
-			}
-			g.g.RepositoryTx(t)
-			g.g.NewLine()
-			g.g.RepositoryTxMethodCommitMethod(t)
-			g.g.NewLine()
-			g.g.RepositoryTxMethodRollbackMethod(t)
-			g.g.NewLine()
-			if g.Components&ComponentInsert != 0 {
-				g.g.RepositoryTxMethodInsert(t)
-				g.g.NewLine()
-			}
-			if g.Components&ComponentFind != 0 {
-				g.g.RepositoryTxMethodFind(t)
-				g.g.NewLine()
-				g.g.RepositoryTxMethodFindIter(t)
-				g.g.NewLine()
-				g.g.RepositoryTxMethodFindOneByPrimaryKey(t)
-				g.g.NewLine()
-			}
-			if g.Components&ComponentUpdate != 0 {
-				g.g.RepositoryTxMethodUpdateOneByPrimaryKey(t)
-				g.g.NewLine()
-				g.g.RepositoryTxMethodUpdateOneByUniqueConstraint(t)
-				g.g.NewLine()
-			}
-			if g.Components&ComponentUpsert != 0 {
-				g.g.RepositoryTxMethodUpsert(t)
-				g.g.NewLine()
-			}
-			if g.Components&ComponentCount != 0 {
-				g.g.RepositoryTxMethodCount(t)
-				g.g.NewLine()
-			}
-			if g.Components&ComponentDelete != 0 {
-				g.g.RepositoryTxMethodDeleteOneByPrimaryKey(t)
-				g.g.NewLine()
+			for _, p := range g.Plugins {
+				if p.PropertyType(c, pqtgo.ModeDefault) != "" {
+					continue ColumnsLoop
+				}
 			}
+			return fmt.Errorf("pqtgogen: column %s.%s: multidimensional array %s cannot be mapped to a Go type, its property type can be provided by a plugin", t.Name, c.Name, at)
 		}
 	}
-	g.g.Statics()
-	g.g.PluginsStatics(s)
-	g.g.NewLine()
+	return nil
+}
 
-	return g.p.Err
+func (g *Generator) reset() {
+	g.g = &gogen.Generator{
+		Version: g.Version,
+	}
+	for _, p := range g.Plugins {
+		g.g.Plugins = append(g.g.Plugins, p)
+	}
+	g.p = &g.g.Printer
+	if g.p.Err = g.checkPlugins(); g.p.Err == nil {
+		g.tmpl, g.p.Err = g.parseTemplates()
+	}
 }
@@ -3,6 +3,7 @@ package pqtgogen_test
 import (
 	"bytes"
 	"go/format"
+	"strings"
 	"testing"
 
 	"github.com/piotrkowalczuk/pqt/internal/testutil"
@@ -82,19 +83,206 @@ func normalize(t *testing.T, in []byte) string {
 var expectedSimple = `package example
 import(
 "github.com/m4rw3r/uuid"
-"github.com/m4rw3r/uuid"
 )
 
 	// LogFunc represents function that can be passed into repository to log query result.
 	type LogFunc func(err error, ent, fnc, sql string, args ...interface{})
 
+// WithSchema returns table name qualified with the given schema instead of the one it was generated with,
+// e.g. Table: WithSchema(TableNews, "tenant") makes a repository query tenant.news.
+// Joined tables of the same schema follow the repository table.
+func WithSchema(table, schema string) string {
+	if i := strings.LastIndex(table, "."); i >= 0 {
+		table = table[i+1:]
+	}
+	if schema == "" {
+		return table
+	}
+	return schema + "." + table
+}
+
+// inSchemaOf returns table name qualified with the schema of the other table.
+func inSchemaOf(table, other string) string {
+	if i := strings.LastIndex(other, "."); i >= 0 {
+		return WithSchema(table, other[:i])
+	}
+	return WithSchema(table, "")
+}
+
 // RetryTransaction can be returned by user defined function when a transaction is rolled back and logic repeated.
 var RetryTransaction = errors.New("retry transaction")
 
-func RunInTransaction(db *sql.DB, ctx context.Context, f func(tx *sql.Tx) error, attempts int) (err error) {
+// ErrRowLockOutsideTransaction is returned when row locking clause is requested outside of a transaction.
+var ErrRowLockOutsideTransaction = errors.New("row locking clause requires a transaction")
+
+// ErrMultipleRowLocks is returned when more than one row locking clause is passed to a finder.
+var ErrMultipleRowLocks = errors.New("only one row locking clause can be requested")
+
+// Constraint describes constraint of a table.
+// Generated constraints are sentinel errors, so their violations can be matched using errors.Is.
+type Constraint struct {
+	Name string
+	// Type is one of primary key, unique, unique index, foreign key, check or exclusion.
+	Type    string
+	Table   string
+	Columns []string
+}
+
+// Error implements error interface.
+func (c *Constraint) Error() string {
+	return "violation of " + c.Type + " constraint " + c.Name
+}
+
+// ConstraintError is returned by repositories if a statement violates a constraint.
+type ConstraintError struct {
+	Constraint *Constraint
+	// Values maps columns of the constraint to the values that violated it, if the database reported them.
+	Values map[string]string
+	Err    *pq.Error
+}
+
+// Error implements error interface.
+func (e *ConstraintError) Error() string {
+	return e.Err.Error()
+}
+
+// Unwrap returns the driver error.
+func (e *ConstraintError) Unwrap() error {
+	return e.Err
+}
+
+// Is returns true if target is the violated constraint.
+func (e *ConstraintError) Is(target error) bool {
+	c, ok := target.(*Constraint)
+	return ok && c.Name == e.Constraint.Name
+}
+
+var constraintDetail = regexp.MustCompile("^Key \\((.+?)\\)=\\((.*)\\) (already exists|is not present|is still referenced|conflicts with)")
+
+var constraints = map[string]*Constraint{
+	"example.user_id_pkey":         ErrUserConstraintPrimaryKey,
+	"example.user_name_key":        ErrUserConstraintNameUnique,
+	"example.user_name_check":      ErrUserConstraintNameCheck,
+	"example.comment_user_id_fkey": ErrCommentConstraintUserIDForeignKey,
+}
+
+// wrapError turns constraint violation reported by the pq library into ConstraintError, other errors are returned unchanged.
+func wrapError(err error) error {
+	pqErr, ok := err.(*pq.Error)
+	if !ok || pqErr.Constraint == "" {
+		return err
+	}
+	c, ok := constraints[pqErr.Constraint]
+	if !ok {
+		c = &Constraint{Name: pqErr.Constraint, Table: pqErr.Table}
+	}
+	ce := &ConstraintError{Constraint: c, Err: pqErr}
+	if m := constraintDetail.FindStringSubmatch(pqErr.Detail); m != nil {
+		columns, values := strings.Split(m[1], ", "), strings.Split(m[2], ", ")
+		if len(columns) == len(values) {
+			ce.Values = make(map[string]string, len(columns))
+			for i, column := range columns {
+				ce.Values[column] = values[i]
+			}
+		}
+	}
+	return ce
+}
+
+// TxOptions configures transactions started by BeginTx and RunInTransaction.
+type TxOptions struct {
+	// Isolation is isolation level of the transaction, if zero, the database default is used.
+	Isolation sql.IsolationLevel
+	// ReadOnly makes the transaction read-only.
+	ReadOnly bool
+	// Backoff returns delay before n-th retry of RunInTransaction, if nil, the transaction is retried immediately.
+	Backoff func(n int) time.Duration
+}
+
+// TxOption configures transactions started by BeginTx and RunInTransaction.
+type TxOption func(*TxOptions)
+
+// WithIsolation sets isolation level of the transaction.
+func WithIsolation(level sql.IsolationLevel) TxOption {
+	return func(o *TxOptions) {
+		o.Isolation = level
+	}
+}
+
+// WithReadOnly makes the transaction read-only.
+func WithReadOnly() TxOption {
+	return func(o *TxOptions) {
+		o.ReadOnly = true
+	}
+}
+
+// WithBackoff sets function that returns delay before n-th retry of the transaction, see ExponentialBackoff.
+func WithBackoff(backoff func(n int) time.Duration) TxOption {
+	return func(o *TxOptions) {
+		o.Backoff = backoff
+	}
+}
+
+// ExponentialBackoff returns backoff that starts with base delay and doubles it before each following retry, but not above max.
+// Random jitter of up to given fraction of the delay is added, so transactions that failed together do not retry together.
+func ExponentialBackoff(base, max time.Duration, jitter float64) func(n int) time.Duration {
+	return func(n int) time.Duration {
+		d := base
+		for i := 1; i < n && d < max; i++ {
+			d *= 2
+		}
+		if d > max {
+			d = max
+		}
+		if jitter > 0 {
+			d += time.Duration(rand.Float64() * jitter * float64(d))
+		}
+		return d
+	}
+}
+
+func newTxOptions(opts []TxOption) *TxOptions {
+	o := &TxOptions{}
+	for _, opt := range opts {
+		opt(o)
+	}
+	return o
+}
+
+func (o *TxOptions) sql() *sql.TxOptions {
+	return &sql.TxOptions{Isolation: o.Isolation, ReadOnly: o.ReadOnly}
+}
+
+// IsRetryable returns true if the transaction that failed with given error can succeed if run again.
+// It is true for serialization failures (40001), deadlocks (40P01) and RetryTransaction.
+func IsRetryable(err error) bool {
+	if errors.Is(err, RetryTransaction) {
+		return true
+	}
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) {
+		switch pqErr.Code {
+		case "40001", "40P01":
+			return true
+		}
+	}
+	return false
+}
+
+// RunInTransaction runs given function within a transaction, which is committed if the function returns no error, otherwise it is rolled back.
+// The transaction is run up to attempts times, as long as it fails with an error IsRetryable reports.
+func RunInTransaction(db *sql.DB, ctx context.Context, f func(tx *sql.Tx) error, attempts int, opts ...TxOption) (err error) {
+	o := newTxOptions(opts)
 	for n := 0; n < attempts; n++ {
+		if n > 0 && o.Backoff != nil {
+			select {
+			case <-time.After(o.Backoff(n)):
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		}
 		if err = func () error {
-			tx, err := db.BeginTx(ctx, nil)
+			tx, err := db.BeginTx(ctx, o.sql())
 			if err != nil {
 				return err
 			}
@@ -114,7 +302,7 @@ func RunInTransaction(db *sql.DB, ctx context.Context, f func(tx *sql.Tx) error,
 			}
 
 			return tx.Commit()
-		}(); err == RetryTransaction {
+		}(); IsRetryable(err) {
 			continue
 		}
 		return err
@@ -122,6 +310,318 @@ func RunInTransaction(db *sql.DB, ctx context.Context, f func(tx *sql.Tx) error,
 	return err
 }
 
+var savepointSeq uint64
+
+func savepoint(ctx context.Context, tx *sql.Tx, name string) error {
+	_, err := tx.ExecContext(ctx, "SAVEPOINT "+pq.QuoteIdentifier(name))
+	return err
+}
+
+func rollbackToSavepoint(ctx context.Context, tx *sql.Tx, name string) error {
+	_, err := tx.ExecContext(ctx, "ROLLBACK TO SAVEPOINT "+pq.QuoteIdentifier(name))
+	return err
+}
+
+func releaseSavepoint(ctx context.Context, tx *sql.Tx, name string) error {
+	_, err := tx.ExecContext(ctx, "RELEASE SAVEPOINT "+pq.QuoteIdentifier(name))
+	return err
+}
+
+// RunInSavepoint runs given function within a savepoint of the tx transaction.
+// If the function returns an error, only changes it made are rolled back and the transaction can be continued,
+// otherwise the savepoint is released.
+func RunInSavepoint(ctx context.Context, tx *sql.Tx, f func(tx *sql.Tx) error) error {
+	name := "pqt_savepoint_" + strconv.FormatUint(atomic.AddUint64(&savepointSeq, 1), 10)
+	if err := savepoint(ctx, tx, name); err != nil {
+		return err
+	}
+	if err := f(tx); err != nil {
+		_ = rollbackToSavepoint(ctx, tx, name)
+		return err
+	}
+	return releaseSavepoint(ctx, tx, name)
+}
+
+// Repositories holds repositories of all tables, they share database handle and log function.
+type Repositories struct {
+	DB      *sql.DB
+	Log     LogFunc
+	User    *UserRepositoryBase
+	Comment *CommentRepositoryBase
+}
+
+// NewRepositories allocates repositories of all tables using given database handle and log function.
+func NewRepositories(db *sql.DB, log LogFunc) *Repositories {
+	return &Repositories{
+		DB:  db,
+		Log: log,
+		User: &UserRepositoryBase{
+			Table:   TableUser,
+			Columns: TableUserColumns,
+			DB:      db,
+			Log:     log,
+		},
+		Comment: &CommentRepositoryBase{
+			Table:   TableComment,
+			Columns: TableCommentColumns,
+			DB:      db,
+			Log:     log,
+		},
+	}
+}
+
+// RepositoriesTx holds repositories of all tables that share single transaction.
+type RepositoriesTx struct {
+	tx      *sql.Tx
+	User    *UserRepositoryBaseTx
+	Comment *CommentRepositoryBaseTx
+}
+
+// Tx returns repositories that run queries within given transaction.
+func (r *Repositories) Tx(tx *sql.Tx) (*RepositoriesTx, error) {
+	rtx := &RepositoriesTx{tx: tx}
+	if r.User != nil {
+		repo, err := r.User.Tx(tx)
+		if err != nil {
+			return nil, err
+		}
+		rtx.User = repo
+	}
+	if r.Comment != nil {
+		repo, err := r.Comment.Tx(tx)
+		if err != nil {
+			return nil, err
+		}
+		rtx.Comment = repo
+	}
+	return rtx, nil
+}
+
+// BeginTx starts transaction using given options and returns repositories that share it.
+func (r *Repositories) BeginTx(ctx context.Context, opts ...TxOption) (*RepositoriesTx, error) {
+	tx, err := r.DB.BeginTx(ctx, newTxOptions(opts).sql())
+	if err != nil {
+		return nil, err
+	}
+	rtx, err := r.Tx(tx)
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, err
+	}
+	return rtx, nil
+}
+
+// RunInTransaction runs given function within a transaction started using given options.
+// The transaction is committed if the function returns no error, otherwise it is rolled back.
+// It is repeated up to attempts times as long as it fails with an error IsRetryable reports.
+func (r *Repositories) RunInTransaction(ctx context.Context, fn func(rtx *RepositoriesTx) error, attempts int, opts ...TxOption) error {
+	return RunInTransaction(r.DB, ctx, func(tx *sql.Tx) error {
+		rtx, err := r.Tx(tx)
+		if err != nil {
+			return err
+		}
+		return fn(rtx)
+	}, attempts, opts...)
+}
+
+// Commit commits the transaction.
+func (r *RepositoriesTx) Commit() error {
+	return r.tx.Commit()
+}
+
+// Rollback aborts the transaction.
+func (r *RepositoriesTx) Rollback() error {
+	return r.tx.Rollback()
+}
+
+// Savepoint establishes new savepoint within the transaction.
+func (r *RepositoriesTx) Savepoint(ctx context.Context, name string) error {
+	return savepoint(ctx, r.tx, name)
+}
+
+// RollbackTo rolls back all changes made after the savepoint was established, the transaction can be continued.
+func (r *RepositoriesTx) RollbackTo(ctx context.Context, name string) error {
+	return rollbackToSavepoint(ctx, r.tx, name)
+}
+
+// Release destroys the savepoint, changes made after it was established are kept.
+func (r *RepositoriesTx) Release(ctx context.Context, name string) error {
+	return releaseSavepoint(ctx, r.tx, name)
+}
+
+// RunInTransaction runs given function within a savepoint of the transaction, see RunInSavepoint.
+// It allows functions that start their own transaction to be composed into a bigger one.
+func (r *RepositoriesTx) RunInTransaction(ctx context.Context, fn func(rtx *RepositoriesTx) error) error {
+	return RunInSavepoint(ctx, r.tx, func(*sql.Tx) error {
+		return fn(r)
+	})
+}
+
+// StmtCache is a bounded cache of prepared statements, keyed by the query.
+// Repositories that share it have to use the same connection pool the cache was created for.
+// Once the cache is full, the least recently used statement is closed.
+type StmtCache struct {
+	db    *sql.DB
+	size  int
+	mu    sync.Mutex
+	lru   *list.List
+	stmts map[string]*list.Element
+}
+
+type cachedStmt struct {
+	query   string
+	stmt    *sql.Stmt
+	refs    int
+	evicted bool
+}
+
+// NewStmtCache allocates StmtCache that holds up to size statements prepared on the db.
+func NewStmtCache(db *sql.DB, size int) *StmtCache {
+	if size < 1 {
+		size = 1
+	}
+	return &StmtCache{
+		db:    db,
+		size:  size,
+		lru:   list.New(),
+		stmts: make(map[string]*list.Element, size),
+	}
+}
+
+// Len returns number of cached statements.
+func (c *StmtCache) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.lru.Len()
+}
+
+// Close closes all cached statements. Statements that are in use are closed once released.
+func (c *StmtCache) Close() (err error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for el := c.lru.Front(); el != nil; el = el.Next() {
+		if cerr := c.evict(el.Value.(*cachedStmt)); cerr != nil && err == nil {
+			err = cerr
+		}
+	}
+	c.lru.Init()
+	c.stmts = make(map[string]*list.Element, c.size)
+	return err
+}
+
+// acquire returns statement of the query, preparing it if it is not cached yet.
+// Statement has to be released once it is not used anymore.
+func (c *StmtCache) acquire(ctx context.Context, query string) (*cachedStmt, error) {
+	if cs := c.get(query); cs != nil {
+		return cs, nil
+	}
+	stmt, err := c.db.PrepareContext(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if el, ok := c.stmts[query]; ok {
+		// Prepared concurrently, the cached one wins.
+		_ = stmt.Close()
+		c.lru.MoveToFront(el)
+		cs := el.Value.(*cachedStmt)
+		cs.refs++
+		return cs, nil
+	}
+	cs := &cachedStmt{query: query, stmt: stmt, refs: 1}
+	c.stmts[query] = c.lru.PushFront(cs)
+	for c.lru.Len() > c.size {
+		el := c.lru.Back()
+		c.lru.Remove(el)
+		delete(c.stmts, el.Value.(*cachedStmt).query)
+		_ = c.evict(el.Value.(*cachedStmt))
+	}
+	return cs, nil
+}
+
+func (c *StmtCache) get(query string) *cachedStmt {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	el, ok := c.stmts[query]
+	if !ok {
+		return nil
+	}
+	c.lru.MoveToFront(el)
+	cs := el.Value.(*cachedStmt)
+	cs.refs++
+	return cs
+}
+
+func (c *StmtCache) release(cs *cachedStmt) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	cs.refs--
+	if cs.evicted && cs.refs == 0 {
+		_ = cs.stmt.Close()
+	}
+}
+
+// evict marks statement as removed from the cache, it is closed right away if it is not in use.
+func (c *StmtCache) evict(cs *cachedStmt) error {
+	cs.evicted = true
+	if cs.refs == 0 {
+		return cs.stmt.Close()
+	}
+	return nil
+}
+
+// queryRow executes query that is expected to return at most one row and scans it into dest.
+// If cache is given, the query is executed as a prepared statement, bound to the tx transaction if it is not nil.
+func queryRow(ctx context.Context, db *sql.DB, tx *sql.Tx, cache *StmtCache, query string, args []interface{}, dest ...interface{}) error {
+	if cache == nil {
+		if tx == nil {
+			return db.QueryRowContext(ctx, query, args...).Scan(dest...)
+		}
+		return tx.QueryRowContext(ctx, query, args...).Scan(dest...)
+	}
+	cs, err := cache.acquire(ctx, query)
+	if err != nil {
+		return err
+	}
+	defer cache.release(cs)
+
+	stmt := cs.stmt
+	if tx != nil {
+		stmt = tx.StmtContext(ctx, stmt)
+		defer stmt.Close()
+	}
+	return stmt.QueryRowContext(ctx, args...).Scan(dest...)
+}
+
+// exec works like queryRow, but for queries that do not return rows.
+func exec(ctx context.Context, db *sql.DB, tx *sql.Tx, cache *StmtCache, query string, args ...interface{}) (sql.Result, error) {
+	if cache == nil {
+		if tx == nil {
+			return db.ExecContext(ctx, query, args...)
+		}
+		return tx.ExecContext(ctx, query, args...)
+	}
+	cs, err := cache.acquire(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer cache.release(cs)
+
+	stmt := cs.stmt
+	if tx != nil {
+		stmt = tx.StmtContext(ctx, stmt)
+		defer stmt.Close()
+	}
+	return stmt.ExecContext(ctx, args...)
+}
+
 	// Rows ...
 	type Rows interface {
 		io.Closer
@@ -165,6 +665,43 @@ func RunInTransaction(db *sql.DB, ctx context.Context, f func(tx *sql.Tx) error,
 		return
 	}
 
+func lockClause(comp *Composer, rl RowLock, of string) error {
+	if !rl.Actionable() {
+		return nil
+	}
+	if _, err := comp.WriteString(" "); err != nil {
+		return err
+	}
+	if _, err := comp.WriteString(rl.Strength.String()); err != nil {
+		return err
+	}
+	if of != "" {
+		if _, err := comp.WriteString(" OF "); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(of); err != nil {
+			return err
+		}
+	}
+	switch rl.Wait {
+	case LockSkipLocked:
+		if _, err := comp.WriteString(" SKIP LOCKED"); err != nil {
+			return err
+		}
+	case LockNoWait:
+		if _, err := comp.WriteString(" NOWAIT"); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Projection is implemented by caller defined structs that selected columns can be scanned into.
+// Props returns pointers to fields of given columns, entities implement it as well.
+type Projection interface {
+	Props(cns ...string) ([]interface{}, error)
+}
+
 const (
 TableUserConstraintPrimaryKey = "example.user_id_pkey"
 TableUserConstraintNameUnique = "example.user_name_key"
@@ -187,7 +724,10 @@ type UserEntity struct{
 // ID ...
 ID int64
 // Name ...
-Name string}
+	Name string
+	// fields holds fields loaded by a projection, see Loaded.
+	fields UserFields
+}
 
 		func (e *UserEntity) Prop(cn string) (interface{}, bool) {
 		switch cn {
@@ -202,9 +742,11 @@ Name string}
 }
 
 		func (e *UserEntity) Props(cns ...string) ([]interface{}, error) {
-		if len(cns) == 0 {
+	projection := len(cns) > 0
+	if !projection {
 			cns = TableUserColumns
 		}
+	e.fields = UserFields{}
 		res := make([]interface{}, 0, len(cns))
 		for _, cn := range cns {
 			if prop, ok := e.Prop(cn); ok {
@@ -212,10 +754,61 @@ Name string}
 			} else {
 				return nil, fmt.Errorf("unexpected column provided: %s", cn)
 			}
+		if projection {
+			e.fields.add(cn)
+		}
 		}
 		return res, nil
+}
+
+// UserFields is a set of UserEntity fields, identified by their columns.
+type UserFields [1]uint64
+
+// Has reports whether the set contains field of given column.
+func (f UserFields) Has(cn string) bool {
+	i := userFieldIndex(cn)
+	return i >= 0 && f[i/64]&(1<<uint(i%64)) != 0
+}
+
+func (f *UserFields) add(cn string) {
+	if i := userFieldIndex(cn); i >= 0 {
+		f[i/64] |= 1 << uint(i%64)
+	}
+}
+
+// userFieldIndex returns position of the column in TableUserColumns, or -1 if there is no such column.
+func userFieldIndex(cn string) int {
+	switch cn {
+	case TableUserColumnID:
+		return 0
+	case TableUserColumnName:
+		return 1
+	}
+	return -1
+}
+
+// Loaded reports whether field of given column was loaded by the query that returned the entity.
+// Entities loaded with all columns, as well as entities built by hand, report every field as loaded.
+func (e *UserEntity) Loaded(cn string) bool {
+	if e.fields == (UserFields{}) {
+		return userFieldIndex(cn) >= 0
+	}
+	return e.fields.Has(cn)
 		}
 
+// Validate checks if entity meets NOT NULL, length, precision, enum, array size and simple check constraints of the table.
+// It returns ValidationError that lists all violations, if any.
+func (e *UserEntity) Validate() error {
+	var errs ValidationError
+	if e.Name == "something" {
+		errs = append(errs, &FieldError{Field: "Name", Column: TableUserColumnName, Reason: "violates check name <> 'something'"})
+	}
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
 		// ScanUserRows helps to scan rows straight to the slice of entities.
 		func ScanUserRows(rows Rows) (entities []*UserEntity, err error) {
 		for rows.Next() {
@@ -243,6 +836,7 @@ type UserIterator struct {
 	cols []string
 	expr *UserFindExpr
 }
+
 func (i *UserIterator) Next() bool {
 	return i.rows.Next()
 }
@@ -324,11 +918,131 @@ func UserAnd(operands ...*UserCriteria) *UserCriteria {
 	return UserOperand("AND", operands...)
 }
 
+// UserCriteriaWhereClause writes conditions of the criteria tree into the composer.
+// The id is the alias of the table named table, tables of related rows are resolved relative to its schema.
+func UserCriteriaWhereClause(comp *Composer, c *UserCriteria, id int, table string) error {
+	if c.child == nil {
+		return _UserCriteriaWhereClause(comp, c, id, table)
+	}
+	node := c
+	sibling := false
+	for {
+		if !sibling {
+			if node.child != nil {
+				if node.parent != nil {
+					comp.WriteString("(")
+				}
+				node = node.child
+				continue
+			} else {
+				comp.Dirty = false
+				comp.WriteString("(")
+				if err := _UserCriteriaWhereClause(comp, node, id, table); err != nil {
+					return err
+				}
+				comp.WriteString(")")
+			}
+		}
+		if node.sibling != nil {
+			sibling = false
+			comp.WriteString(" ")
+			comp.WriteString(node.parent.operator)
+			comp.WriteString(" ")
+			node = node.sibling
+			continue
+		}
+		if node.parent != nil {
+			sibling = true
+			if node.parent.parent != nil {
+				comp.WriteString(")")
+			}
+			node = node.parent
+			continue
+		}
+
+		break
+	}
+	return nil
+}
+
+func _UserCriteriaWhereClause(comp *Composer, c *UserCriteria, id int, table string) error {
+	if c.ID.Valid {
+		if comp.Dirty {
+			comp.WriteString(" AND ")
+		}
+		if err := comp.WriteAlias(id); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(TableUserColumnID); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString("="); err != nil {
+			return err
+		}
+		if err := comp.WritePlaceholder(); err != nil {
+			return err
+		}
+		comp.Add(c.ID)
+		comp.Dirty = true
+	}
+	if c.Name.Valid {
+		if comp.Dirty {
+			comp.WriteString(" AND ")
+		}
+		if err := comp.WriteAlias(id); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(TableUserColumnName); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString("="); err != nil {
+			return err
+		}
+		if err := comp.WritePlaceholder(); err != nil {
+			return err
+		}
+		comp.Add(c.Name)
+		comp.Dirty = true
+	}
+	return nil
+}
+
 type UserFindExpr struct {
 Where *UserCriteria
 Offset, Limit int64
-Columns []string
+	Columns       []UserColumn
 OrderBy []RowOrder
+	Lock          RowLock
+}
+
+// UserColumn is a column of the user table, one of TableUserColumns, that find expression selects.
+type UserColumn string
+
+// userColumnCount selects number of rows instead of columns, it is used by count queries.
+const userColumnCount UserColumn = "COUNT(*)"
+
+// selectExpr returns select list expression of the column, or an error if the table has no such column.
+func (c UserColumn) selectExpr() (string, error) {
+	switch c {
+	case userColumnCount:
+		return string(c), nil
+	case TableUserColumnID:
+		return "t0.id", nil
+	case TableUserColumnName:
+		return "t0.name", nil
+	}
+	return "", fmt.Errorf("unexpected column provided: %q", string(c))
+}
+
+func userColumnNames(cs []UserColumn) []string {
+	if len(cs) == 0 {
+		return nil
+	}
+	names := make([]string, 0, len(cs))
+	for _, c := range cs {
+		names = append(names, string(c))
+	}
+	return names
 }
 
 type UserJoin struct {
@@ -345,11 +1059,30 @@ type UserPatch struct {
 Name sql.NullString
 }
 
+// Validate checks if values set by the patch meet length, precision, enum, array size and simple check constraints of the table.
+// It returns ValidationError that lists all violations, if any.
+func (p *UserPatch) Validate() error {
+	var errs ValidationError
+	if p.Name.Valid {
+		if p.Name.String == "something" {
+			errs = append(errs, &FieldError{Field: "Name", Column: TableUserColumnName, Reason: "violates check name <> 'something'"})
+		}
+	}
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
 type UserRepositoryBase struct {
 	Table string
 	Columns []string
 	DB *sql.DB
 	Log LogFunc
+	// Validate, if true, makes insert, update and upsert methods validate entities and patches before querying the database.
+	Validate bool
+	// StmtCache, if set, is used to prepare queries of fixed shape (insert, delete and find by key) once and reuse them.
+	StmtCache *StmtCache
 }
 
 		func (r *UserRepositoryBase) Tx(tx *sql.Tx) (*UserRepositoryBaseTx, error) {
@@ -359,25 +1092,54 @@ type UserRepositoryBase struct {
 	}, nil
 }
 
-		func (r *UserRepositoryBase) BeginTx(ctx context.Context) (*UserRepositoryBaseTx, error) {
-	tx, err := r.DB.BeginTx(ctx, nil)
+func (r *UserRepositoryBase) BeginTx(ctx context.Context, opts ...TxOption) (*UserRepositoryBaseTx, error) {
+	tx, err := r.DB.BeginTx(ctx, newTxOptions(opts).sql())
 	if err != nil {
 		return nil, err
 	}
 	return r.Tx(tx)
 }
 
-func (r UserRepositoryBase) RunInTransaction(ctx context.Context, fn func(rtx *UserRepositoryBaseTx) error, attempts int) (err error) {
+func (r UserRepositoryBase) RunInTransaction(ctx context.Context, fn func(rtx *UserRepositoryBaseTx) error, attempts int, opts ...TxOption) (err error) {
 	return RunInTransaction(r.DB, ctx, func(tx *sql.Tx) error {
 		rtx, err := r.Tx(tx)
 		if err != nil {
 			return err
 		}
 		return fn(rtx)
-	}, attempts)
+	}, attempts, opts...)
 }
 
+var (
+	// ErrUserConstraintPrimaryKey is returned if primary key constraint example.user_id_pkey is violated.
+	ErrUserConstraintPrimaryKey = &Constraint{
+		Name:    "example.user_id_pkey",
+		Type:    "primary key",
+		Table:   "example.user",
+		Columns: []string{"id"},
+	}
+	// ErrUserConstraintNameUnique is returned if unique constraint example.user_name_key is violated.
+	ErrUserConstraintNameUnique = &Constraint{
+		Name:    "example.user_name_key",
+		Type:    "unique",
+		Table:   "example.user",
+		Columns: []string{"name"},
+	}
+	// ErrUserConstraintNameCheck is returned if check constraint example.user_name_check is violated.
+	ErrUserConstraintNameCheck = &Constraint{
+		Name:    "example.user_name_check",
+		Type:    "check",
+		Table:   "example.user",
+		Columns: []string{"name"},
+	}
+)
+
 		func (r *UserRepositoryBase) InsertQuery(e *UserEntity, read bool) (string, []interface{}, error) {
+	if r.Validate {
+		if err := e.Validate(); err != nil {
+			return "", nil, err
+		}
+	}
 		insert := NewComposer(2)
 		columns := bytes.NewBuffer(nil)
 		buf := bytes.NewBufferString("INSERT INTO ")
@@ -426,13 +1188,7 @@ func (r UserRepositoryBase) RunInTransaction(ctx context.Context, fn func(rtx *U
 				return nil, err
 			}
 
-			var row *sql.Row
-			if tx == nil {
-				row = r.DB.QueryRowContext(ctx, query, args...)
-			} else {
-				row = tx.QueryRowContext(ctx, query, args...)
-			}
-			err = row.Scan(
+	err = queryRow(ctx, r.DB, tx, r.StmtCache, query, args,
 &e.ID,
 &e.Name,
 )
@@ -444,7 +1200,7 @@ func (r UserRepositoryBase) RunInTransaction(ctx context.Context, fn func(rtx *U
 			}
 		}
 		if err != nil {
-			return nil, err
+		return nil, wrapError(err)
 		}
 		return e, nil
 	}
@@ -453,98 +1209,22 @@ func (r UserRepositoryBase) RunInTransaction(ctx context.Context, fn func(rtx *U
 			return r.insert(ctx, nil, e)
 		}
 
-		func UserCriteriaWhereClause(comp *Composer, c *UserCriteria, id int) (error) {
-	if c.child == nil {
-		return _UserCriteriaWhereClause(comp, c, id)
-	}
-	node := c
-	sibling := false
-	for {
-		if !sibling {
-			if node.child != nil {
-				if node.parent != nil {
-					comp.WriteString("(")
-				}
-				node = node.child
-				continue
-			} else {
-				comp.Dirty = false
-				comp.WriteString("(")
-				if err := _UserCriteriaWhereClause(comp, node, id); err != nil {
-					return err
-				}
-				comp.WriteString(")")
-			}
-		}
-		if node.sibling != nil {
-			sibling = false
-			comp.WriteString(" ")
-			comp.WriteString(node.parent.operator)
-			comp.WriteString(" ")
-			node = node.sibling
-			continue
-		}
-		if node.parent != nil {
-			sibling = true
-			if node.parent.parent != nil {
-				comp.WriteString(")")
-			}
-			node = node.parent
-			continue
-		}
-
-		break
-	}
-	return nil
-	}
-
-		func _UserCriteriaWhereClause(comp *Composer, c *UserCriteria, id int) (error) {
-				if c.ID.Valid {if comp.Dirty {
-				comp.WriteString(" AND ")
-			}
-				if err := comp.WriteAlias(id); err != nil {
-					return err
-				}
-				if _, err := comp.WriteString(TableUserColumnID); err != nil {
-					return err
-				}
-			if _, err := comp.WriteString("="); err != nil {
-				return err
-			}
-			if err := comp.WritePlaceholder(); err != nil {
-				return err
-			}
-			comp.Add(c.ID)
-			comp.Dirty=true
-		}
-				if c.Name.Valid {if comp.Dirty {
-				comp.WriteString(" AND ")
-			}
-				if err := comp.WriteAlias(id); err != nil {
-					return err
-				}
-				if _, err := comp.WriteString(TableUserColumnName); err != nil {
-					return err
-				}
-			if _, err := comp.WriteString("="); err != nil {
-				return err
-			}
-			if err := comp.WritePlaceholder(); err != nil {
-				return err
-			}
-			comp.Add(c.Name)
-			comp.Dirty=true
-		}
-	return nil
-		}
-
 		func (r *UserRepositoryBase) FindQuery(fe *UserFindExpr) (string, []interface{}, error) {
 		comp := NewComposer(2)
 		buf := bytes.NewBufferString("SELECT ")
 		if len(fe.Columns) == 0 {
 		buf.WriteString("t0.id, t0.name")
 		} else {
-			buf.WriteString(strings.Join(fe.Columns, ", "))
+		for i, c := range fe.Columns {
+			if i > 0 {
+				buf.WriteString(", ")
+			}
+			expr, err := c.selectExpr()
+			if err != nil {
+				return "", nil, err
+			}
+			buf.WriteString(expr)
+		}
 		}
 		buf.WriteString(" FROM ")
 		buf.WriteString(r.Table)
@@ -554,7 +1234,7 @@ func (r UserRepositoryBase) RunInTransaction(ctx context.Context, fn func(rtx *U
 		comp.Dirty = false
 	}
 	if fe.Where != nil {
-		if err := UserCriteriaWhereClause(comp, fe.Where, 0); err != nil {
+		if err := UserCriteriaWhereClause(comp, fe.Where, 0, r.Table); err != nil {
 			return "", nil, err
 		}
 	}
@@ -616,6 +1296,12 @@ func (r UserRepositoryBase) RunInTransaction(ctx context.Context, fn func(rtx *U
 		}
 		comp.Add(fe.Limit)
 	}
+	if fe.Lock.Actionable() {
+		var of string
+		if err := lockClause(comp, fe.Lock, of); err != nil {
+			return "", nil, err
+		}
+	}
 
 	buf.ReadFrom(comp)
 
@@ -623,6 +1309,9 @@ func (r UserRepositoryBase) RunInTransaction(ctx context.Context, fn func(rtx *U
 }
 
 		func (r *UserRepositoryBase) find(ctx context.Context, tx *sql.Tx, fe *UserFindExpr) ([]*UserEntity, error) {
+	if tx == nil && fe.Lock.Actionable() {
+		return nil, ErrRowLockOutsideTransaction
+	}
 			query, args, err := r.FindQuery(fe)
 			if err != nil {
 				return nil, err
@@ -648,9 +1337,10 @@ func (r UserRepositoryBase) RunInTransaction(ctx context.Context, fn func(rtx *U
 			entities []*UserEntity
 			props []interface{}
 		)
+	cols := userColumnNames(fe.Columns)
 		for rows.Next() {
 			var ent UserEntity
-			if props, err = ent.Props(); err != nil {
+		if props, err = ent.Props(cols...); err != nil {
 				return nil, err
 			}
 			err = rows.Scan(props...)
@@ -675,6 +1365,9 @@ func (r UserRepositoryBase) RunInTransaction(ctx context.Context, fn func(rtx *U
 		}
 
 		func (r *UserRepositoryBase) findIter(ctx context.Context, tx *sql.Tx, fe *UserFindExpr) (*UserIterator, error) {
+	if tx == nil && fe.Lock.Actionable() {
+		return nil, ErrRowLockOutsideTransaction
+	}
 			query, args, err := r.FindQuery(fe)
 			if err != nil {
 				return nil, err
@@ -698,15 +1391,63 @@ func (r UserRepositoryBase) RunInTransaction(ctx context.Context, fn func(rtx *U
 			return &UserIterator{
 				rows: rows,
 				expr: fe,
-				cols: fe.Columns,
+		cols: userColumnNames(fe.Columns),
 		}, nil
 	}
 
 		func (r *UserRepositoryBase) FindIter(ctx context.Context, fe *UserFindExpr) (*UserIterator, error) {
 			return r.findIter(ctx, nil, fe)
+}
+
+func (r *UserRepositoryBase) findProjection(ctx context.Context, tx *sql.Tx, fe *UserFindExpr, next func() Projection) error {
+	if tx == nil && fe.Lock.Actionable() {
+		return ErrRowLockOutsideTransaction
+	}
+	query, args, err := r.FindQuery(fe)
+	if err != nil {
+		return err
+	}
+	var rows *sql.Rows
+	if tx == nil {
+		rows, err = r.DB.QueryContext(ctx, query, args...)
+	} else {
+		rows, err = tx.QueryContext(ctx, query, args...)
+	}
+	if r.Log != nil {
+		if tx == nil {
+			r.Log(err, TableUser, "find projection", query, args...)
+		} else {
+			r.Log(err, TableUser, "find projection tx", query, args...)
+		}
+	}
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	cols, err := rows.Columns()
+	if err != nil {
+		return err
+	}
+	for rows.Next() {
+		props, err := next().Props(cols...)
+		if err != nil {
+			return err
+		}
+		if err := rows.Scan(props...); err != nil {
+			return err
+		}
+	}
+	return rows.Err()
+}
+
+// FindProjection works like Find, but scans each row into the projection returned by next.
+// Selected columns, see UserFindExpr.Columns, are passed to its Props method.
+func (r *UserRepositoryBase) FindProjection(ctx context.Context, fe *UserFindExpr, next func() Projection) error {
+	return r.findProjection(ctx, nil, fe, next)
 		}
 
-		func (r *UserRepositoryBase) findOneByID(ctx context.Context, tx *sql.Tx, pk int64) (*UserEntity, error) {
+func (r *UserRepositoryBase) findOneByID(ctx context.Context, tx *sql.Tx, pk int64, lock RowLock) (*UserEntity, error) {
 		find := NewComposer(2)
 		find.WriteString("SELECT ")
 		if len(r.Columns) == 0 {
@@ -715,12 +1456,20 @@ func (r UserRepositoryBase) RunInTransaction(ctx context.Context, fn func(rtx *U
 			find.WriteString(strings.Join(r.Columns, ", "))
 		}
 		find.WriteString(" FROM ")
-		find.WriteString(TableUser)
+	find.WriteString(r.Table)
 		find.WriteString(" WHERE ")
 		find.WriteString(TableUserColumnID)
 		find.WriteString("=")
 		find.WritePlaceholder()
 		find.Add(pk)
+	if lock.Actionable() {
+		if tx == nil {
+			return nil, ErrRowLockOutsideTransaction
+		}
+		if err := lockClause(find, lock, ""); err != nil {
+			return nil, err
+		}
+	}
 		var (
 			ent UserEntity
 		)
@@ -728,11 +1477,7 @@ func (r UserRepositoryBase) RunInTransaction(ctx context.Context, fn func(rtx *U
 		if err != nil {
 			return nil, err
 		}
-		if tx == nil {
-			err = r.DB.QueryRowContext(ctx, find.String(), find.Args()...).Scan(props...)
-		} else {
-			err = tx.QueryRowContext(ctx, find.String(), find.Args()...).Scan(props...)
-		}
+	err = queryRow(ctx, r.DB, tx, r.StmtCache, find.String(), find.Args(), props...)
 		if r.Log != nil {
 			if tx == nil {
 				r.Log(err, TableUser, "find by primary key", find.String(), find.Args()...)
@@ -747,10 +1492,10 @@ func (r UserRepositoryBase) RunInTransaction(ctx context.Context, fn func(rtx *U
 	}
 
 		func (r *UserRepositoryBase) FindOneByID(ctx context.Context, pk int64) (*UserEntity, error) {
-			return r.findOneByID(ctx, nil, pk)
+	return r.findOneByID(ctx, nil, pk, RowLock{})
 		}
 
-			func (r *UserRepositoryBase) findOneByName(ctx context.Context, tx *sql.Tx, userName string) (*UserEntity, error) {
+func (r *UserRepositoryBase) findOneByName(ctx context.Context, tx *sql.Tx, userName string, lock RowLock) (*UserEntity, error) {
 			find := NewComposer(2)
 			find.WriteString("SELECT ")
 					if len(r.Columns) == 0 {
@@ -759,13 +1504,22 @@ func (r UserRepositoryBase) RunInTransaction(ctx context.Context, fn func(rtx *U
 			find.WriteString(strings.Join(r.Columns, ", "))
 		}
 			find.WriteString(" FROM ")
-			find.WriteString(TableUser)
+	find.WriteString(r.Table)
 			find.WriteString(" WHERE ")
 		find.WriteString(TableUserColumnName)
 		find.WriteString("=")
 		find.WritePlaceholder()
 		find.Add(userName)
 		
+	if lock.Actionable() {
+		if tx == nil {
+			return nil, ErrRowLockOutsideTransaction
+		}
+		if err := lockClause(find, lock, ""); err != nil {
+			return nil, err
+		}
+	}
+
 			var (
 				ent UserEntity
 			)
@@ -773,11 +1527,7 @@ func (r UserRepositoryBase) RunInTransaction(ctx context.Context, fn func(rtx *U
 			if err != nil {
 				return nil, err
 			}
-			if tx == nil {
-				err = r.DB.QueryRowContext(ctx, find.String(), find.Args()...).Scan(props...)
-			} else {
-				err = tx.QueryRowContext(ctx, find.String(), find.Args()...).Scan(props...)
-			}
+	err = queryRow(ctx, r.DB, tx, r.StmtCache, find.String(), find.Args(), props...)
 			if err != nil {
 				return nil, err
 			}
@@ -786,10 +1536,15 @@ func (r UserRepositoryBase) RunInTransaction(ctx context.Context, fn func(rtx *U
 		}
 
 			func (r *UserRepositoryBase) FindOneByName(ctx context.Context, userName string) (*UserEntity, error) {
-				return r.findOneByName(ctx, nil, userName)
+	return r.findOneByName(ctx, nil, userName, RowLock{})
 			}
 
 		func (r *UserRepositoryBase) UpdateOneByIDQuery(pk int64, p *UserPatch) (string, []interface{}, error) {
+	if r.Validate {
+		if err := p.Validate(); err != nil {
+			return "", nil, err
+		}
+	}
 		buf := bytes.NewBufferString("UPDATE ")
 		buf.WriteString(r.Table)
 		update := NewComposer(2)
@@ -857,7 +1612,7 @@ func (r UserRepositoryBase) RunInTransaction(ctx context.Context, fn func(rtx *U
 			}
 		}
 		if err != nil {
-			return nil, err
+		return nil, wrapError(err)
 		}
 		return &ent, nil
 	}
@@ -875,7 +1630,7 @@ func (r UserRepositoryBase) RunInTransaction(ctx context.Context, fn func(rtx *U
 			find.WriteString(strings.Join(r.Columns, ", "))
 		}
 		find.WriteString(" FROM ")
-		find.WriteString(TableUser)
+	find.WriteString(r.Table)
 		find.WriteString(" WHERE ")
 		find.WriteString(TableUserColumnID)
 		find.WriteString("=")
@@ -915,6 +1670,7 @@ func (r UserRepositoryBase) RunInTransaction(ctx context.Context, fn func(rtx *U
 		}
 		if err != nil {
 			tx.Rollback()
+		err = wrapError(err)
 			return
 		}
 		err = tx.Commit()
@@ -925,6 +1681,11 @@ func (r UserRepositoryBase) RunInTransaction(ctx context.Context, fn func(rtx *U
 	}
 
 			func (r *UserRepositoryBase) UpdateOneByNameQuery(userName string, p *UserPatch) (string, []interface{}, error) {
+	if r.Validate {
+		if err := p.Validate(); err != nil {
+			return "", nil, err
+		}
+	}
 			buf := bytes.NewBufferString("UPDATE ")
 			buf.WriteString(r.Table)
 			update := NewComposer(1)
@@ -993,7 +1754,7 @@ func (r UserRepositoryBase) RunInTransaction(ctx context.Context, fn func(rtx *U
 					}
 				}
 				if err != nil {
-					return nil, err
+		return nil, wrapError(err)
 				}
 				return &ent, nil
 			}
@@ -1003,6 +1764,16 @@ func (r UserRepositoryBase) RunInTransaction(ctx context.Context, fn func(rtx *U
 			}
 
 		func (r *UserRepositoryBase) UpsertQuery(e *UserEntity, p *UserPatch, inf ...string) (string, []interface{}, error) {
+	if r.Validate {
+		if err := e.Validate(); err != nil {
+			return "", nil, err
+		}
+		if p != nil {
+			if err := p.Validate(); err != nil {
+				return "", nil, err
+			}
+		}
+	}
 		upsert := NewComposer(4)
 		columns := bytes.NewBuffer(nil)
 		buf := bytes.NewBufferString("INSERT INTO ")
@@ -1065,61 +1836,262 @@ func (r UserRepositoryBase) RunInTransaction(ctx context.Context, fn func(rtx *U
 				}
 				buf.WriteString(i)
 			}
-			buf.WriteString(")")
-			buf.WriteString(" DO UPDATE SET ")
-			buf.ReadFrom(upsert)
-		} else {
-			buf.WriteString(" DO NOTHING ")
+			buf.WriteString(")")
+			buf.WriteString(" DO UPDATE SET ")
+			buf.ReadFrom(upsert)
+		} else {
+			buf.WriteString(" DO NOTHING ")
+		}
+		if upsert.Dirty {
+			buf.WriteString(" RETURNING ")
+			if len(r.Columns) > 0 {
+				buf.WriteString(strings.Join(r.Columns, ", "))
+			} else {
+		buf.WriteString("id, name")
+	}
+		}
+		return buf.String(), upsert.Args(), nil
+	}
+
+		func (r *UserRepositoryBase) upsert(ctx context.Context, tx *sql.Tx, e *UserEntity, p *UserPatch, inf ...string) (*UserEntity, error) {
+			query, args, err := r.UpsertQuery(e, p, inf...)
+			if err != nil {
+				return nil, err
+			}
+
+			var row *sql.Row
+			if tx == nil {
+				row = r.DB.QueryRowContext(ctx, query, args...)
+			} else {
+				row = tx.QueryRowContext(ctx, query, args...)
+			}
+			err = row.Scan(
+&e.ID,
+&e.Name,
+	)
+		if r.Log != nil {
+			if tx == nil {
+				r.Log(err, TableUser, "upsert", query, args...)
+			} else {
+				r.Log(err, TableUser, "upsert tx", query, args...)
+			}
+		}
+		if err != nil {
+		return nil, wrapError(err)
+		}
+		return e, nil
+	}
+
+		func (r *UserRepositoryBase) Upsert(ctx context.Context, e *UserEntity, p *UserPatch, inf ...string) (*UserEntity, error) {
+			return r.upsert(ctx, nil, e, p, inf...)
+		}
+
+// UserConflictTarget is a conflict target of user upsert, only UserConflict* variables can be used.
+type UserConflictTarget struct {
+	constraint string
+	columns    []string
+	where      string
+}
+
+func (ct UserConflictTarget) write(buf *bytes.Buffer) {
+	switch {
+	case ct.constraint != "":
+		buf.WriteString(" ON CONSTRAINT \"")
+		buf.WriteString(ct.constraint)
+		buf.WriteString("\"")
+	case len(ct.columns) > 0:
+		buf.WriteString(" (")
+		buf.WriteString(strings.Join(ct.columns, ", "))
+		buf.WriteString(")")
+		if ct.where != "" {
+			buf.WriteString(" WHERE ")
+			buf.WriteString(ct.where)
+		}
+	}
+}
+
+var (
+	// UserConflictPrimaryKey targets primary key constraint.
+	UserConflictPrimaryKey = UserConflictTarget{constraint: TableUserConstraintPrimaryKey}
+	// UserConflictNameUnique targets unique constraint on name.
+	UserConflictNameUnique = UserConflictTarget{constraint: TableUserConstraintNameUnique}
+)
+
+// UserUpsertExpr describes how conflicts are resolved by UpsertOne and UpsertMany.
+type UserUpsertExpr struct {
+	// Target is required if Patch is set, DO NOTHING can be used without it.
+	Target UserConflictTarget
+	// Patch is applied to the conflicting row (DO UPDATE), if nil or empty the row is left untouched (DO NOTHING).
+	Patch *UserPatch
+	// Where limits DO UPDATE to the conflicting rows that match the criteria, other rows are left untouched.
+	Where *UserCriteria
+}
+
+func (r *UserRepositoryBase) UpsertManyQuery(ue *UserUpsertExpr, es ...*UserEntity) (string, []interface{}, error) {
+	if len(es) == 0 {
+		return "", nil, errors.New("upsert requires at least one entity")
+	}
+	if ue == nil {
+		ue = &UserUpsertExpr{}
+	}
+	if r.Validate {
+		for _, e := range es {
+			if err := e.Validate(); err != nil {
+				return "", nil, err
+			}
+		}
+		if ue.Patch != nil {
+			if err := ue.Patch.Validate(); err != nil {
+				return "", nil, err
+			}
+		}
+	}
+	upsert := NewComposer(1)
+	buf := bytes.NewBufferString("INSERT INTO ")
+	buf.WriteString(r.Table)
+	buf.WriteString(" AS t0 (")
+	buf.WriteString(TableUserColumnName)
+	buf.WriteString(") VALUES ")
+	for i, e := range es {
+		if i != 0 {
+			if _, err := upsert.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := upsert.WriteString("("); err != nil {
+			return "", nil, err
+		}
+		if err := upsert.WritePlaceholder(); err != nil {
+			return "", nil, err
+		}
+		upsert.Add(e.Name)
+		if _, err := upsert.WriteString(")"); err != nil {
+			return "", nil, err
+		}
+	}
+	buf.ReadFrom(upsert)
+	buf.WriteString(" ON CONFLICT")
+	ue.Target.write(buf)
+
+	upsert.Dirty = false
+	if p := ue.Patch; p != nil {
+		if p.Name.Valid {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TableUserColumnName); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("="); err != nil {
+				return "", nil, err
+			}
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(p.Name)
+			upsert.Dirty = true
+
+		}
+	}
+	if !upsert.Dirty {
+		buf.WriteString(" DO NOTHING")
+	} else {
+		if ue.Target.constraint == "" && len(ue.Target.columns) == 0 {
+			return "", nil, errors.New("upsert with patch requires conflict target")
+		}
+		buf.WriteString(" DO UPDATE SET ")
+		buf.ReadFrom(upsert)
+		if ue.Where != nil {
+			upsert.Dirty = false
+			if err := UserCriteriaWhereClause(upsert, ue.Where, 0, r.Table); err != nil {
+				return "", nil, err
+			}
+			if upsert.Dirty {
+				buf.WriteString(" WHERE ")
+				buf.ReadFrom(upsert)
+			}
 		}
-		if upsert.Dirty {
-			buf.WriteString(" RETURNING ")
-			if len(r.Columns) > 0 {
-				buf.WriteString(strings.Join(r.Columns, ", "))
-			} else {
+	}
+	buf.WriteString(" RETURNING ")
+	if len(r.Columns) > 0 {
+		buf.WriteString(strings.Join(r.Columns, ", "))
+	} else {
 		buf.WriteString("id, name")
 	}
+	return buf.String(), upsert.Args(), nil
+}
+
+func (r *UserRepositoryBase) upsertMany(ctx context.Context, tx *sql.Tx, ue *UserUpsertExpr, es ...*UserEntity) ([]*UserEntity, error) {
+	query, args, err := r.UpsertManyQuery(ue, es...)
+	if err != nil {
+		return nil, err
+	}
+	var rows *sql.Rows
+	if tx == nil {
+		rows, err = r.DB.QueryContext(ctx, query, args...)
+	} else {
+		rows, err = tx.QueryContext(ctx, query, args...)
+	}
+	if r.Log != nil {
+		if tx == nil {
+			r.Log(err, TableUser, "upsert many", query, args...)
+		} else {
+			r.Log(err, TableUser, "upsert many tx", query, args...)
 		}
-		return buf.String(), upsert.Args(), nil
 	}
+	if err != nil {
+		return nil, wrapError(err)
+	}
+	defer rows.Close()
 
-		func (r *UserRepositoryBase) upsert(ctx context.Context, tx *sql.Tx, e *UserEntity, p *UserPatch, inf ...string) (*UserEntity, error) {
-			query, args, err := r.UpsertQuery(e, p, inf...)
-			if err != nil {
-				return nil, err
-			}
-
-			var row *sql.Row
-			if tx == nil {
-				row = r.DB.QueryRowContext(ctx, query, args...)
-			} else {
-				row = tx.QueryRowContext(ctx, query, args...)
-			}
-			err = row.Scan(
-&e.ID,
-&e.Name,
-	)
-		if r.Log != nil {
-			if tx == nil {
-				r.Log(err, TableUser, "upsert", query, args...)
-			} else {
-				r.Log(err, TableUser, "upsert tx", query, args...)
-			}
-		}
+	var entities []*UserEntity
+	for rows.Next() {
+		var ent UserEntity
+		props, err := ent.Props(r.Columns...)
 		if err != nil {
 			return nil, err
 		}
-		return e, nil
+		if err = rows.Scan(props...); err != nil {
+			return nil, err
+		}
+		entities = append(entities, &ent)
 	}
+	if err = rows.Err(); err != nil {
+		return nil, wrapError(err)
+	}
+	return entities, nil
+}
 
-		func (r *UserRepositoryBase) Upsert(ctx context.Context, e *UserEntity, p *UserPatch, inf ...string) (*UserEntity, error) {
-			return r.upsert(ctx, nil, e, p, inf...)
-		}
+// UpsertMany inserts entities in a single statement, conflicts are resolved as described by the expression.
+// Returned entities are those inserted or updated, rows left untouched by DO NOTHING or Where are omitted.
+func (r *UserRepositoryBase) UpsertMany(ctx context.Context, ue *UserUpsertExpr, es ...*UserEntity) ([]*UserEntity, error) {
+	return r.upsertMany(ctx, nil, ue, es...)
+}
+
+// UpsertOne inserts the entity, conflict is resolved as described by the expression.
+// Returned flag is false if the row was neither inserted nor updated.
+func (r *UserRepositoryBase) UpsertOne(ctx context.Context, e *UserEntity, ue *UserUpsertExpr) (*UserEntity, bool, error) {
+	return r.upsertOne(ctx, nil, e, ue)
+}
+
+func (r *UserRepositoryBase) upsertOne(ctx context.Context, tx *sql.Tx, e *UserEntity, ue *UserUpsertExpr) (*UserEntity, bool, error) {
+	entities, err := r.upsertMany(ctx, tx, ue, e)
+	if err != nil {
+		return nil, false, err
+	}
+	if len(entities) == 0 {
+		return e, false, nil
+	}
+	*e = *entities[0]
+	return e, true, nil
+}
 
 		func (r *UserRepositoryBase) count(ctx context.Context, tx *sql.Tx, exp *UserCountExpr) (int64, error) {
 		query, args, err := r.FindQuery(&UserFindExpr{
 			Where: exp.Where,
-			Columns: []string{"COUNT(*)"},
-		
+		Columns: []UserColumn{userColumnCount},
 		})
 		if err != nil {
 			return 0, err
@@ -1150,23 +2122,15 @@ func (r UserRepositoryBase) RunInTransaction(ctx context.Context, fn func(rtx *U
 		func (r *UserRepositoryBase) deleteOneByID(ctx context.Context, tx *sql.Tx, pk int64) (int64, error) {
 		find := NewComposer(2)
 		find.WriteString("DELETE FROM ")
-		find.WriteString(TableUser)
+	find.WriteString(r.Table)
 		find.WriteString(" WHERE ")
 		find.WriteString(TableUserColumnID)
 		find.WriteString("=")
 		find.WritePlaceholder()
 		find.Add(pk)
-		var (
-			err error
-			res sql.Result
-		)
-		if tx == nil {
-			res, err = r.DB.ExecContext(ctx, find.String(), find.Args()...)
-		} else {
-			res, err = tx.ExecContext(ctx, find.String(), find.Args()...)
-		}
+	res, err := exec(ctx, r.DB, tx, r.StmtCache, find.String(), find.Args()...)
 		if err != nil {
-				return 0, err
+		return 0, wrapError(err)
 			}
 
 		return res.RowsAffected()
@@ -1189,6 +2153,28 @@ func (r UserRepositoryBaseTx) Rollback() error {
 	return r.tx.Rollback()
 }
 
+// Savepoint establishes new savepoint within the transaction.
+func (r *UserRepositoryBaseTx) Savepoint(ctx context.Context, name string) error {
+	return savepoint(ctx, r.tx, name)
+}
+
+// RollbackTo rolls back all changes made after the savepoint was established, the transaction can be continued.
+func (r *UserRepositoryBaseTx) RollbackTo(ctx context.Context, name string) error {
+	return rollbackToSavepoint(ctx, r.tx, name)
+}
+
+// Release destroys the savepoint, changes made after it was established are kept.
+func (r *UserRepositoryBaseTx) Release(ctx context.Context, name string) error {
+	return releaseSavepoint(ctx, r.tx, name)
+}
+
+// RunInTransaction runs given function within a savepoint of the transaction, see RunInSavepoint.
+func (r *UserRepositoryBaseTx) RunInTransaction(ctx context.Context, fn func(rtx *UserRepositoryBaseTx) error) error {
+	return RunInSavepoint(ctx, r.tx, func(*sql.Tx) error {
+		return fn(r)
+	})
+}
+
 		func (r *UserRepositoryBaseTx) Insert(ctx context.Context, e *UserEntity) (*UserEntity, error) {
 			return r.base.insert(ctx, r.tx, e)
 		}
@@ -1201,8 +2187,30 @@ func (r UserRepositoryBaseTx) Rollback() error {
 			return r.base.findIter(ctx, r.tx, fe)
 		}
 
-		func (r *UserRepositoryBaseTx) FindOneByID(ctx context.Context, pk int64) (*UserEntity, error) {
-			return r.base.findOneByID(ctx, r.tx, pk)
+func (r *UserRepositoryBaseTx) FindProjection(ctx context.Context, fe *UserFindExpr, next func() Projection) error {
+	return r.base.findProjection(ctx, r.tx, fe, next)
+}
+
+func (r *UserRepositoryBaseTx) FindOneByID(ctx context.Context, pk int64, lock ...RowLock) (*UserEntity, error) {
+	if len(lock) > 1 {
+		return nil, ErrMultipleRowLocks
+	}
+	var rl RowLock
+	if len(lock) > 0 {
+		rl = lock[0]
+	}
+	return r.base.findOneByID(ctx, r.tx, pk, rl)
+}
+
+func (r *UserRepositoryBaseTx) FindOneByName(ctx context.Context, userName string, lock ...RowLock) (*UserEntity, error) {
+	if len(lock) > 1 {
+		return nil, ErrMultipleRowLocks
+	}
+	var rl RowLock
+	if len(lock) > 0 {
+		rl = lock[0]
+	}
+	return r.base.findOneByName(ctx, r.tx, userName, rl)
 		}
 
 		func (r *UserRepositoryBaseTx) UpdateOneByID(ctx context.Context, pk int64, p *UserPatch) (*UserEntity, error) {
@@ -1215,6 +2223,14 @@ func (r UserRepositoryBaseTx) Rollback() error {
 
 		func (r *UserRepositoryBaseTx) Upsert(ctx context.Context, e *UserEntity, p *UserPatch, inf ...string) (*UserEntity, error) {
 			return r.base.upsert(ctx, r.tx, e, p, inf...)
+}
+
+func (r *UserRepositoryBaseTx) UpsertMany(ctx context.Context, ue *UserUpsertExpr, es ...*UserEntity) ([]*UserEntity, error) {
+	return r.base.upsertMany(ctx, r.tx, ue, es...)
+}
+
+func (r *UserRepositoryBaseTx) UpsertOne(ctx context.Context, e *UserEntity, ue *UserUpsertExpr) (*UserEntity, bool, error) {
+	return r.base.upsertOne(ctx, r.tx, e, ue)
 		}
 
 		func (r *UserRepositoryBaseTx) Count(ctx context.Context, exp *UserCountExpr) (int64, error) {
@@ -1245,7 +2261,10 @@ UserID sql.NullInt64
 // User ...
 User *UserEntity
 // Wpis ...
-Wpis *PostEntity}
+	Wpis *PostEntity
+	// fields holds fields loaded by a projection, see Loaded.
+	fields CommentFields
+}
 
 		func (e *CommentEntity) Prop(cn string) (interface{}, bool) {
 		switch cn {
@@ -1258,9 +2277,11 @@ Wpis *PostEntity}
 }
 
 		func (e *CommentEntity) Props(cns ...string) ([]interface{}, error) {
-		if len(cns) == 0 {
+	projection := len(cns) > 0
+	if !projection {
 			cns = TableCommentColumns
 		}
+	e.fields = CommentFields{}
 		res := make([]interface{}, 0, len(cns))
 		for _, cn := range cns {
 			if prop, ok := e.Prop(cn); ok {
@@ -1268,10 +2289,52 @@ Wpis *PostEntity}
 			} else {
 				return nil, fmt.Errorf("unexpected column provided: %s", cn)
 			}
+		if projection {
+			e.fields.add(cn)
+		}
 		}
 		return res, nil
+}
+
+// CommentFields is a set of CommentEntity fields, identified by their columns.
+type CommentFields [1]uint64
+
+// Has reports whether the set contains field of given column.
+func (f CommentFields) Has(cn string) bool {
+	i := commentFieldIndex(cn)
+	return i >= 0 && f[i/64]&(1<<uint(i%64)) != 0
+}
+
+func (f *CommentFields) add(cn string) {
+	if i := commentFieldIndex(cn); i >= 0 {
+		f[i/64] |= 1 << uint(i%64)
+	}
+}
+
+// commentFieldIndex returns position of the column in TableCommentColumns, or -1 if there is no such column.
+func commentFieldIndex(cn string) int {
+	switch cn {
+	case TableCommentColumnUserID:
+		return 0
+	}
+	return -1
+}
+
+// Loaded reports whether field of given column was loaded by the query that returned the entity.
+// Entities loaded with all columns, as well as entities built by hand, report every field as loaded.
+func (e *CommentEntity) Loaded(cn string) bool {
+	if e.fields == (CommentFields{}) {
+		return commentFieldIndex(cn) >= 0
+	}
+	return e.fields.Has(cn)
 		}
 
+// Validate checks if entity meets NOT NULL, length, precision, enum, array size and simple check constraints of the table.
+// It returns ValidationError that lists all violations, if any.
+func (e *CommentEntity) Validate() error {
+	return nil
+}
+
 		// ScanCommentRows helps to scan rows straight to the slice of entities.
 		func ScanCommentRows(rows Rows) (entities []*CommentEntity, err error) {
 		for rows.Next() {
@@ -1298,6 +2361,7 @@ type CommentIterator struct {
 	cols []string
 	expr *CommentFindExpr
 }
+
 func (i *CommentIterator) Next() bool {
 	return i.rows.Next()
 }
@@ -1393,15 +2457,114 @@ func CommentAnd(operands ...*CommentCriteria) *CommentCriteria {
 	return CommentOperand("AND", operands...)
 }
 
+// CommentCriteriaWhereClause writes conditions of the criteria tree into the composer.
+// The id is the alias of the table named table, tables of related rows are resolved relative to its schema.
+func CommentCriteriaWhereClause(comp *Composer, c *CommentCriteria, id int, table string) error {
+	if c.child == nil {
+		return _CommentCriteriaWhereClause(comp, c, id, table)
+	}
+	node := c
+	sibling := false
+	for {
+		if !sibling {
+			if node.child != nil {
+				if node.parent != nil {
+					comp.WriteString("(")
+				}
+				node = node.child
+				continue
+			} else {
+				comp.Dirty = false
+				comp.WriteString("(")
+				if err := _CommentCriteriaWhereClause(comp, node, id, table); err != nil {
+					return err
+				}
+				comp.WriteString(")")
+			}
+		}
+		if node.sibling != nil {
+			sibling = false
+			comp.WriteString(" ")
+			comp.WriteString(node.parent.operator)
+			comp.WriteString(" ")
+			node = node.sibling
+			continue
+		}
+		if node.parent != nil {
+			sibling = true
+			if node.parent.parent != nil {
+				comp.WriteString(")")
+			}
+			node = node.parent
+			continue
+		}
+
+		break
+	}
+	return nil
+}
+
+func _CommentCriteriaWhereClause(comp *Composer, c *CommentCriteria, id int, table string) error {
+	if c.UserID.Valid {
+		if comp.Dirty {
+			comp.WriteString(" AND ")
+		}
+		if err := comp.WriteAlias(id); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString(TableCommentColumnUserID); err != nil {
+			return err
+		}
+		if _, err := comp.WriteString("="); err != nil {
+			return err
+		}
+		if err := comp.WritePlaceholder(); err != nil {
+			return err
+		}
+		comp.Add(c.UserID)
+		comp.Dirty = true
+	}
+	return nil
+}
+
 type CommentFindExpr struct {
 Where *CommentCriteria
 Offset, Limit int64
-Columns []string
+	Columns       []CommentColumn
 OrderBy []RowOrder
+	Lock          RowLock
 JoinUser *UserJoin
 JoinWpis *PostJoin
 }
 
+// CommentColumn is a column of the comment table, one of TableCommentColumns, that find expression selects.
+type CommentColumn string
+
+// commentColumnCount selects number of rows instead of columns, it is used by count queries.
+const commentColumnCount CommentColumn = "COUNT(*)"
+
+// selectExpr returns select list expression of the column, or an error if the table has no such column.
+func (c CommentColumn) selectExpr() (string, error) {
+	switch c {
+	case commentColumnCount:
+		return string(c), nil
+	case TableCommentColumnUserID:
+		return "t0.user_id", nil
+	}
+	return "", fmt.Errorf("unexpected column provided: %q", string(c))
+}
+
+func commentColumnNames(cs []CommentColumn) []string {
+	if len(cs) == 0 {
+		return nil
+	}
+	names := make([]string, 0, len(cs))
+	for _, c := range cs {
+		names = append(names, string(c))
+	}
+	return names
+}
+
 type CommentJoin struct {
 On, Where *CommentCriteria
 Fetch bool
@@ -1420,11 +2583,21 @@ type CommentPatch struct {
 UserID sql.NullInt64
 }
 
+// Validate checks if values set by the patch meet length, precision, enum, array size and simple check constraints of the table.
+// It returns ValidationError that lists all violations, if any.
+func (p *CommentPatch) Validate() error {
+	return nil
+}
+
 type CommentRepositoryBase struct {
 	Table string
 	Columns []string
 	DB *sql.DB
 	Log LogFunc
+	// Validate, if true, makes insert, update and upsert methods validate entities and patches before querying the database.
+	Validate bool
+	// StmtCache, if set, is used to prepare queries of fixed shape (insert, delete and find by key) once and reuse them.
+	StmtCache *StmtCache
 }
 
 		func (r *CommentRepositoryBase) Tx(tx *sql.Tx) (*CommentRepositoryBaseTx, error) {
@@ -1434,25 +2607,40 @@ type CommentRepositoryBase struct {
 	}, nil
 }
 
-		func (r *CommentRepositoryBase) BeginTx(ctx context.Context) (*CommentRepositoryBaseTx, error) {
-	tx, err := r.DB.BeginTx(ctx, nil)
+func (r *CommentRepositoryBase) BeginTx(ctx context.Context, opts ...TxOption) (*CommentRepositoryBaseTx, error) {
+	tx, err := r.DB.BeginTx(ctx, newTxOptions(opts).sql())
 	if err != nil {
 		return nil, err
 	}
 	return r.Tx(tx)
 }
 
-func (r CommentRepositoryBase) RunInTransaction(ctx context.Context, fn func(rtx *CommentRepositoryBaseTx) error, attempts int) (err error) {
+func (r CommentRepositoryBase) RunInTransaction(ctx context.Context, fn func(rtx *CommentRepositoryBaseTx) error, attempts int, opts ...TxOption) (err error) {
 	return RunInTransaction(r.DB, ctx, func(tx *sql.Tx) error {
 		rtx, err := r.Tx(tx)
 		if err != nil {
 			return err
 		}
 		return fn(rtx)
-	}, attempts)
+	}, attempts, opts...)
 }
 
+var (
+	// ErrCommentConstraintUserIDForeignKey is returned if foreign key constraint example.comment_user_id_fkey is violated.
+	ErrCommentConstraintUserIDForeignKey = &Constraint{
+		Name:    "example.comment_user_id_fkey",
+		Type:    "foreign key",
+		Table:   "example.comment",
+		Columns: []string{"user_id"},
+	}
+)
+
 		func (r *CommentRepositoryBase) InsertQuery(e *CommentEntity, read bool) (string, []interface{}, error) {
+	if r.Validate {
+		if err := e.Validate(); err != nil {
+			return "", nil, err
+		}
+	}
 		insert := NewComposer(1)
 		columns := bytes.NewBuffer(nil)
 		buf := bytes.NewBufferString("INSERT INTO ")
@@ -1489,111 +2677,38 @@ func (r CommentRepositoryBase) RunInTransaction(ctx context.Context, fn func(rtx
 				buf.WriteString("RETURNING ")
 				if len(r.Columns) > 0 {
 					buf.WriteString(strings.Join(r.Columns, ", "))
-				} else {
-		buf.WriteString("user_id")
-	}
-			}
-		}
-		return buf.String(), insert.Args(), nil
-	}
-
-		func (r *CommentRepositoryBase) insert(ctx context.Context, tx *sql.Tx, e *CommentEntity) (*CommentEntity, error) {
-			query, args, err := r.InsertQuery(e, true)
-			if err != nil {
-				return nil, err
-			}
-
-			var row *sql.Row
-			if tx == nil {
-				row = r.DB.QueryRowContext(ctx, query, args...)
-			} else {
-				row = tx.QueryRowContext(ctx, query, args...)
-			}
-			err = row.Scan(
-&e.UserID,
-)
-		if r.Log != nil {
-			if tx == nil {
-				r.Log(err, TableComment, "insert", query, args...)
-			} else {
-				r.Log(err, TableComment, "insert tx", query, args...)
-			}
-		}
-		if err != nil {
-			return nil, err
-		}
-		return e, nil
-	}
-
-		func (r *CommentRepositoryBase) Insert(ctx context.Context, e *CommentEntity) (*CommentEntity, error) {
-			return r.insert(ctx, nil, e)
-		}
-
-		func CommentCriteriaWhereClause(comp *Composer, c *CommentCriteria, id int) (error) {
-	if c.child == nil {
-		return _CommentCriteriaWhereClause(comp, c, id)
-	}
-	node := c
-	sibling := false
-	for {
-		if !sibling {
-			if node.child != nil {
-				if node.parent != nil {
-					comp.WriteString("(")
-				}
-				node = node.child
-				continue
-			} else {
-				comp.Dirty = false
-				comp.WriteString("(")
-				if err := _CommentCriteriaWhereClause(comp, node, id); err != nil {
-					return err
-				}
-				comp.WriteString(")")
-			}
-		}
-		if node.sibling != nil {
-			sibling = false
-			comp.WriteString(" ")
-			comp.WriteString(node.parent.operator)
-			comp.WriteString(" ")
-			node = node.sibling
-			continue
-		}
-		if node.parent != nil {
-			sibling = true
-			if node.parent.parent != nil {
-				comp.WriteString(")")
+				} else {
+		buf.WriteString("user_id")
+	}
 			}
-			node = node.parent
-			continue
 		}
-
-		break
-	}
-	return nil
+		return buf.String(), insert.Args(), nil
 	}
 
-		func _CommentCriteriaWhereClause(comp *Composer, c *CommentCriteria, id int) (error) {
-				if c.UserID.Valid {if comp.Dirty {
-				comp.WriteString(" AND ")
-			}
-				if err := comp.WriteAlias(id); err != nil {
-					return err
-				}
-				if _, err := comp.WriteString(TableCommentColumnUserID); err != nil {
-					return err
-				}
-			if _, err := comp.WriteString("="); err != nil {
-				return err
+		func (r *CommentRepositoryBase) insert(ctx context.Context, tx *sql.Tx, e *CommentEntity) (*CommentEntity, error) {
+			query, args, err := r.InsertQuery(e, true)
+			if err != nil {
+				return nil, err
 			}
-			if err := comp.WritePlaceholder(); err != nil {
-				return err
+
+	err = queryRow(ctx, r.DB, tx, r.StmtCache, query, args,
+&e.UserID,
+)
+		if r.Log != nil {
+			if tx == nil {
+				r.Log(err, TableComment, "insert", query, args...)
+			} else {
+				r.Log(err, TableComment, "insert tx", query, args...)
 			}
-			comp.Add(c.UserID)
-			comp.Dirty=true
 		}
-	return nil
+		if err != nil {
+		return nil, wrapError(err)
+		}
+		return e, nil
+	}
+
+		func (r *CommentRepositoryBase) Insert(ctx context.Context, e *CommentEntity) (*CommentEntity, error) {
+			return r.insert(ctx, nil, e)
 		}
 
 		func (r *CommentRepositoryBase) FindQuery(fe *CommentFindExpr) (string, []interface{}, error) {
@@ -1602,7 +2717,16 @@ func (r CommentRepositoryBase) RunInTransaction(ctx context.Context, fn func(rtx
 		if len(fe.Columns) == 0 {
 		buf.WriteString("t0.user_id")
 		} else {
-			buf.WriteString(strings.Join(fe.Columns, ", "))
+		for i, c := range fe.Columns {
+			if i > 0 {
+				buf.WriteString(", ")
+			}
+			expr, err := c.selectExpr()
+			if err != nil {
+				return "", nil, err
+			}
+			buf.WriteString(expr)
+		}
 		}
 			if fe.JoinUser != nil && fe.JoinUser.Kind.Actionable() && fe.JoinUser.Fetch {
 		buf.WriteString(", t1.id, t1.name")
@@ -1614,19 +2738,19 @@ func (r CommentRepositoryBase) RunInTransaction(ctx context.Context, fn func(rtx
 		buf.WriteString(r.Table)
 		buf.WriteString(" AS t0")
 			if fe.JoinUser != nil && fe.JoinUser.Kind.Actionable() {
-			joinClause(comp, fe.JoinUser.Kind, "example.user AS t1 ON t0.user_id=t1.id")
+		joinClause(comp, fe.JoinUser.Kind, inSchemaOf(TableUser, r.Table)+" AS t1 ON t0.user_id=t1.id")
 		if fe.JoinUser.On != nil {
 			comp.Dirty = true
-			if err := UserCriteriaWhereClause(comp, fe.JoinUser.On, 1); err != nil {
+			if err := UserCriteriaWhereClause(comp, fe.JoinUser.On, 1, inSchemaOf(TableUser, r.Table)); err != nil {
 				return "", nil, err
 			}
 		}
 		}
 			if fe.JoinWpis != nil && fe.JoinWpis.Kind.Actionable() {
-			joinClause(comp, fe.JoinWpis.Kind, "post AS t2 ON ")
+		joinClause(comp, fe.JoinWpis.Kind, TablePost+" AS t2 ON ")
 		if fe.JoinWpis.On != nil {
 			comp.Dirty = true
-			if err := PostCriteriaWhereClause(comp, fe.JoinWpis.On, 2); err != nil {
+			if err := PostCriteriaWhereClause(comp, fe.JoinWpis.On, 2, TablePost); err != nil {
 				return "", nil, err
 			}
 		}
@@ -1636,17 +2760,17 @@ func (r CommentRepositoryBase) RunInTransaction(ctx context.Context, fn func(rtx
 		comp.Dirty = false
 	}
 	if fe.Where != nil {
-		if err := CommentCriteriaWhereClause(comp, fe.Where, 0); err != nil {
+		if err := CommentCriteriaWhereClause(comp, fe.Where, 0, r.Table); err != nil {
 			return "", nil, err
 		}
 	}
 		if fe.JoinUser != nil && fe.JoinUser.Kind.Actionable() && fe.JoinUser.Where != nil {
-			if err := UserCriteriaWhereClause(comp, fe.JoinUser.Where, 1); err != nil {
+		if err := UserCriteriaWhereClause(comp, fe.JoinUser.Where, 1, inSchemaOf(TableUser, r.Table)); err != nil {
 				return "", nil, err
 			}
 		}
 		if fe.JoinWpis != nil && fe.JoinWpis.Kind.Actionable() && fe.JoinWpis.Where != nil {
-			if err := PostCriteriaWhereClause(comp, fe.JoinWpis.Where, 2); err != nil {
+		if err := PostCriteriaWhereClause(comp, fe.JoinWpis.Where, 2, TablePost); err != nil {
 				return "", nil, err
 			}
 		}
@@ -1708,6 +2832,18 @@ func (r CommentRepositoryBase) RunInTransaction(ctx context.Context, fn func(rtx
 		}
 		comp.Add(fe.Limit)
 	}
+	if fe.Lock.Actionable() {
+		var of string
+		if fe.JoinUser != nil && fe.JoinUser.Kind.Actionable() {
+			of = "t0"
+		}
+		if fe.JoinWpis != nil && fe.JoinWpis.Kind.Actionable() {
+			of = "t0"
+		}
+		if err := lockClause(comp, fe.Lock, of); err != nil {
+			return "", nil, err
+		}
+	}
 
 	buf.ReadFrom(comp)
 
@@ -1715,6 +2851,9 @@ func (r CommentRepositoryBase) RunInTransaction(ctx context.Context, fn func(rtx
 }
 
 		func (r *CommentRepositoryBase) find(ctx context.Context, tx *sql.Tx, fe *CommentFindExpr) ([]*CommentEntity, error) {
+	if tx == nil && fe.Lock.Actionable() {
+		return nil, ErrRowLockOutsideTransaction
+	}
 			query, args, err := r.FindQuery(fe)
 			if err != nil {
 				return nil, err
@@ -1740,9 +2879,10 @@ func (r CommentRepositoryBase) RunInTransaction(ctx context.Context, fn func(rtx
 			entities []*CommentEntity
 			props []interface{}
 		)
+	cols := commentColumnNames(fe.Columns)
 		for rows.Next() {
 			var ent CommentEntity
-			if props, err = ent.Props(); err != nil {
+		if props, err = ent.Props(cols...); err != nil {
 				return nil, err
 			}
 		var prop []interface{}
@@ -1782,6 +2922,9 @@ func (r CommentRepositoryBase) RunInTransaction(ctx context.Context, fn func(rtx
 		}
 
 		func (r *CommentRepositoryBase) findIter(ctx context.Context, tx *sql.Tx, fe *CommentFindExpr) (*CommentIterator, error) {
+	if tx == nil && fe.Lock.Actionable() {
+		return nil, ErrRowLockOutsideTransaction
+	}
 			query, args, err := r.FindQuery(fe)
 			if err != nil {
 				return nil, err
@@ -1805,26 +2948,73 @@ func (r CommentRepositoryBase) RunInTransaction(ctx context.Context, fn func(rtx
 			return &CommentIterator{
 				rows: rows,
 				expr: fe,
-				cols: fe.Columns,
+		cols: commentColumnNames(fe.Columns),
 		}, nil
 	}
 
 		func (r *CommentRepositoryBase) FindIter(ctx context.Context, fe *CommentFindExpr) (*CommentIterator, error) {
 			return r.findIter(ctx, nil, fe)
-		}
-
-
-
-
-
-
-
-
+}
 
+func (r *CommentRepositoryBase) findProjection(ctx context.Context, tx *sql.Tx, fe *CommentFindExpr, next func() Projection) error {
+	if tx == nil && fe.Lock.Actionable() {
+		return ErrRowLockOutsideTransaction
+	}
+	query, args, err := r.FindQuery(fe)
+	if err != nil {
+		return err
+	}
+	var rows *sql.Rows
+	if tx == nil {
+		rows, err = r.DB.QueryContext(ctx, query, args...)
+	} else {
+		rows, err = tx.QueryContext(ctx, query, args...)
+	}
+	if r.Log != nil {
+		if tx == nil {
+			r.Log(err, TableComment, "find projection", query, args...)
+		} else {
+			r.Log(err, TableComment, "find projection tx", query, args...)
+		}
+	}
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
 
+	cols, err := rows.Columns()
+	if err != nil {
+		return err
+	}
+	for rows.Next() {
+		props, err := next().Props(cols...)
+		if err != nil {
+			return err
+		}
+		if err := rows.Scan(props...); err != nil {
+			return err
+		}
+	}
+	return rows.Err()
+}
 
+// FindProjection works like Find, but scans each row into the projection returned by next.
+// Selected columns, see CommentFindExpr.Columns, are passed to its Props method.
+func (r *CommentRepositoryBase) FindProjection(ctx context.Context, fe *CommentFindExpr, next func() Projection) error {
+	return r.findProjection(ctx, nil, fe, next)
+		}
 
 		func (r *CommentRepositoryBase) UpsertQuery(e *CommentEntity, p *CommentPatch, inf ...string) (string, []interface{}, error) {
+	if r.Validate {
+		if err := e.Validate(); err != nil {
+			return "", nil, err
+		}
+		if p != nil {
+			if err := p.Validate(); err != nil {
+				return "", nil, err
+			}
+		}
+	}
 		upsert := NewComposer(2)
 		columns := bytes.NewBuffer(nil)
 		buf := bytes.NewBufferString("INSERT INTO ")
@@ -1929,7 +3119,7 @@ func (r CommentRepositoryBase) RunInTransaction(ctx context.Context, fn func(rtx
 			}
 		}
 		if err != nil {
-			return nil, err
+		return nil, wrapError(err)
 		}
 		return e, nil
 	}
@@ -1938,10 +3128,209 @@ func (r CommentRepositoryBase) RunInTransaction(ctx context.Context, fn func(rtx
 			return r.upsert(ctx, nil, e, p, inf...)
 		}
 
+// CommentConflictTarget is a conflict target of comment upsert, only CommentConflict* variables can be used.
+type CommentConflictTarget struct {
+	constraint string
+	columns    []string
+	where      string
+}
+
+func (ct CommentConflictTarget) write(buf *bytes.Buffer) {
+	switch {
+	case ct.constraint != "":
+		buf.WriteString(" ON CONSTRAINT \"")
+		buf.WriteString(ct.constraint)
+		buf.WriteString("\"")
+	case len(ct.columns) > 0:
+		buf.WriteString(" (")
+		buf.WriteString(strings.Join(ct.columns, ", "))
+		buf.WriteString(")")
+		if ct.where != "" {
+			buf.WriteString(" WHERE ")
+			buf.WriteString(ct.where)
+		}
+	}
+}
+
+// CommentUpsertExpr describes how conflicts are resolved by UpsertOne and UpsertMany.
+type CommentUpsertExpr struct {
+	// Target is required if Patch is set, DO NOTHING can be used without it.
+	Target CommentConflictTarget
+	// Patch is applied to the conflicting row (DO UPDATE), if nil or empty the row is left untouched (DO NOTHING).
+	Patch *CommentPatch
+	// Where limits DO UPDATE to the conflicting rows that match the criteria, other rows are left untouched.
+	Where *CommentCriteria
+}
+
+func (r *CommentRepositoryBase) UpsertManyQuery(ue *CommentUpsertExpr, es ...*CommentEntity) (string, []interface{}, error) {
+	if len(es) == 0 {
+		return "", nil, errors.New("upsert requires at least one entity")
+	}
+	if ue == nil {
+		ue = &CommentUpsertExpr{}
+	}
+	if r.Validate {
+		for _, e := range es {
+			if err := e.Validate(); err != nil {
+				return "", nil, err
+			}
+		}
+		if ue.Patch != nil {
+			if err := ue.Patch.Validate(); err != nil {
+				return "", nil, err
+			}
+		}
+	}
+	upsert := NewComposer(1)
+	buf := bytes.NewBufferString("INSERT INTO ")
+	buf.WriteString(r.Table)
+	buf.WriteString(" AS t0 (")
+	buf.WriteString(TableCommentColumnUserID)
+	buf.WriteString(") VALUES ")
+	for i, e := range es {
+		if i != 0 {
+			if _, err := upsert.WriteString(", "); err != nil {
+				return "", nil, err
+			}
+		}
+		if _, err := upsert.WriteString("("); err != nil {
+			return "", nil, err
+		}
+		if e.UserID.Valid {
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(e.UserID)
+		} else if _, err := upsert.WriteString("DEFAULT"); err != nil {
+			return "", nil, err
+		}
+		if _, err := upsert.WriteString(")"); err != nil {
+			return "", nil, err
+		}
+	}
+	buf.ReadFrom(upsert)
+	buf.WriteString(" ON CONFLICT")
+	ue.Target.write(buf)
+
+	upsert.Dirty = false
+	if p := ue.Patch; p != nil {
+		if p.UserID.Valid {
+			if upsert.Dirty {
+				if _, err := upsert.WriteString(", "); err != nil {
+					return "", nil, err
+				}
+			}
+			if _, err := upsert.WriteString(TableCommentColumnUserID); err != nil {
+				return "", nil, err
+			}
+			if _, err := upsert.WriteString("="); err != nil {
+				return "", nil, err
+			}
+			if err := upsert.WritePlaceholder(); err != nil {
+				return "", nil, err
+			}
+			upsert.Add(p.UserID)
+			upsert.Dirty = true
+
+		}
+	}
+	if !upsert.Dirty {
+		buf.WriteString(" DO NOTHING")
+	} else {
+		if ue.Target.constraint == "" && len(ue.Target.columns) == 0 {
+			return "", nil, errors.New("upsert with patch requires conflict target")
+		}
+		buf.WriteString(" DO UPDATE SET ")
+		buf.ReadFrom(upsert)
+		if ue.Where != nil {
+			upsert.Dirty = false
+			if err := CommentCriteriaWhereClause(upsert, ue.Where, 0, r.Table); err != nil {
+				return "", nil, err
+			}
+			if upsert.Dirty {
+				buf.WriteString(" WHERE ")
+				buf.ReadFrom(upsert)
+			}
+		}
+	}
+	buf.WriteString(" RETURNING ")
+	if len(r.Columns) > 0 {
+		buf.WriteString(strings.Join(r.Columns, ", "))
+	} else {
+		buf.WriteString("user_id")
+	}
+	return buf.String(), upsert.Args(), nil
+}
+
+func (r *CommentRepositoryBase) upsertMany(ctx context.Context, tx *sql.Tx, ue *CommentUpsertExpr, es ...*CommentEntity) ([]*CommentEntity, error) {
+	query, args, err := r.UpsertManyQuery(ue, es...)
+	if err != nil {
+		return nil, err
+	}
+	var rows *sql.Rows
+	if tx == nil {
+		rows, err = r.DB.QueryContext(ctx, query, args...)
+	} else {
+		rows, err = tx.QueryContext(ctx, query, args...)
+	}
+	if r.Log != nil {
+		if tx == nil {
+			r.Log(err, TableComment, "upsert many", query, args...)
+		} else {
+			r.Log(err, TableComment, "upsert many tx", query, args...)
+		}
+	}
+	if err != nil {
+		return nil, wrapError(err)
+	}
+	defer rows.Close()
+
+	var entities []*CommentEntity
+	for rows.Next() {
+		var ent CommentEntity
+		props, err := ent.Props(r.Columns...)
+		if err != nil {
+			return nil, err
+		}
+		if err = rows.Scan(props...); err != nil {
+			return nil, err
+		}
+		entities = append(entities, &ent)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, wrapError(err)
+	}
+	return entities, nil
+}
+
+// UpsertMany inserts entities in a single statement, conflicts are resolved as described by the expression.
+// Returned entities are those inserted or updated, rows left untouched by DO NOTHING or Where are omitted.
+func (r *CommentRepositoryBase) UpsertMany(ctx context.Context, ue *CommentUpsertExpr, es ...*CommentEntity) ([]*CommentEntity, error) {
+	return r.upsertMany(ctx, nil, ue, es...)
+}
+
+// UpsertOne inserts the entity, conflict is resolved as described by the expression.
+// Returned flag is false if the row was neither inserted nor updated.
+func (r *CommentRepositoryBase) UpsertOne(ctx context.Context, e *CommentEntity, ue *CommentUpsertExpr) (*CommentEntity, bool, error) {
+	return r.upsertOne(ctx, nil, e, ue)
+}
+
+func (r *CommentRepositoryBase) upsertOne(ctx context.Context, tx *sql.Tx, e *CommentEntity, ue *CommentUpsertExpr) (*CommentEntity, bool, error) {
+	entities, err := r.upsertMany(ctx, tx, ue, e)
+	if err != nil {
+		return nil, false, err
+	}
+	if len(entities) == 0 {
+		return e, false, nil
+	}
+	*e = *entities[0]
+	return e, true, nil
+}
+
 		func (r *CommentRepositoryBase) count(ctx context.Context, tx *sql.Tx, exp *CommentCountExpr) (int64, error) {
 		query, args, err := r.FindQuery(&CommentFindExpr{
 			Where: exp.Where,
-			Columns: []string{"COUNT(*)"},
+		Columns: []CommentColumn{commentColumnCount},
 		
 		JoinUser: exp.JoinUser,
 		JoinWpis: exp.JoinWpis,
@@ -1972,8 +3361,6 @@ func (r CommentRepositoryBase) RunInTransaction(ctx context.Context, fn func(rtx
 			return r.count(ctx, nil, exp)
 		}
 
-
-
 type CommentRepositoryBaseTx struct {
 	base *CommentRepositoryBase
 	tx *sql.Tx
@@ -1987,6 +3374,28 @@ func (r CommentRepositoryBaseTx) Rollback() error {
 	return r.tx.Rollback()
 }
 
+// Savepoint establishes new savepoint within the transaction.
+func (r *CommentRepositoryBaseTx) Savepoint(ctx context.Context, name string) error {
+	return savepoint(ctx, r.tx, name)
+}
+
+// RollbackTo rolls back all changes made after the savepoint was established, the transaction can be continued.
+func (r *CommentRepositoryBaseTx) RollbackTo(ctx context.Context, name string) error {
+	return rollbackToSavepoint(ctx, r.tx, name)
+}
+
+// Release destroys the savepoint, changes made after it was established are kept.
+func (r *CommentRepositoryBaseTx) Release(ctx context.Context, name string) error {
+	return releaseSavepoint(ctx, r.tx, name)
+}
+
+// RunInTransaction runs given function within a savepoint of the transaction, see RunInSavepoint.
+func (r *CommentRepositoryBaseTx) RunInTransaction(ctx context.Context, fn func(rtx *CommentRepositoryBaseTx) error) error {
+	return RunInSavepoint(ctx, r.tx, func(*sql.Tx) error {
+		return fn(r)
+	})
+}
+
 		func (r *CommentRepositoryBaseTx) Insert(ctx context.Context, e *CommentEntity) (*CommentEntity, error) {
 			return r.base.insert(ctx, r.tx, e)
 		}
@@ -1997,20 +3406,28 @@ func (r CommentRepositoryBaseTx) Rollback() error {
 
 		func (r *CommentRepositoryBaseTx) FindIter(ctx context.Context, fe *CommentFindExpr) (*CommentIterator, error) {
 			return r.base.findIter(ctx, r.tx, fe)
-		}
-
-
+}
 
+func (r *CommentRepositoryBaseTx) FindProjection(ctx context.Context, fe *CommentFindExpr, next func() Projection) error {
+	return r.base.findProjection(ctx, r.tx, fe, next)
+		}
 
 		func (r *CommentRepositoryBaseTx) Upsert(ctx context.Context, e *CommentEntity, p *CommentPatch, inf ...string) (*CommentEntity, error) {
 			return r.base.upsert(ctx, r.tx, e, p, inf...)
+}
+
+func (r *CommentRepositoryBaseTx) UpsertMany(ctx context.Context, ue *CommentUpsertExpr, es ...*CommentEntity) ([]*CommentEntity, error) {
+	return r.base.upsertMany(ctx, r.tx, ue, es...)
+}
+
+func (r *CommentRepositoryBaseTx) UpsertOne(ctx context.Context, e *CommentEntity, ue *CommentUpsertExpr) (*CommentEntity, bool, error) {
+	return r.base.upsertOne(ctx, r.tx, e, ue)
 		}
 
 		func (r *CommentRepositoryBaseTx) Count(ctx context.Context, exp *CommentCountExpr) (int64, error) {
 			return r.base.count(ctx, r.tx, exp)
 		}
 
-
 const (
 	JoinInner = iota
 	JoinLeft
@@ -2047,13 +3464,71 @@ func (jt JoinType) Actionable() bool {
 	}
 }
 
+const (
+	LockDoNot LockStrength = iota
+	LockForUpdate
+	LockForNoKeyUpdate
+	LockForShare
+	LockForKeyShare
+)
+
+// LockStrength represents strength of a row level lock acquired by SELECT statement.
+type LockStrength int
+
+func (ls LockStrength) String() string {
+	switch ls {
+	case LockForUpdate:
+		return "FOR UPDATE"
+	case LockForNoKeyUpdate:
+		return "FOR NO KEY UPDATE"
+	case LockForShare:
+		return "FOR SHARE"
+	case LockForKeyShare:
+		return "FOR KEY SHARE"
+	default:
+		return ""
+	}
+}
+
+// Actionable returns true if LockStrength is one of the known strengths except LockDoNot.
+func (ls LockStrength) Actionable() bool {
+	switch ls {
+	case LockForUpdate, LockForNoKeyUpdate, LockForShare, LockForKeyShare:
+		return true
+	default:
+		return false
+	}
+}
+
+const (
+	LockWait LockWaitPolicy = iota
+	LockSkipLocked
+	LockNoWait
+)
+
+// LockWaitPolicy determines what happens if a row cannot be locked immediately.
+type LockWaitPolicy int
+
+// RowLock represents locking clause that can be attached to a SELECT statement.
+// It can be used only within a transaction.
+type RowLock struct {
+	Strength LockStrength
+	Wait     LockWaitPolicy
+}
+
+// Actionable returns true if lock strength is set.
+func (rl RowLock) Actionable() bool {
+	return rl.Strength.Actionable()
+}
+
 // ErrorConstraint returns the error constraint of err if it was produced by the pq library.
 // Otherwise, it returns empty string.
 func ErrorConstraint(err error) string {
 	if err == nil {
 		return ""
 	}
-	if pqerr, ok := err.(*pq.Error); ok {
+	var pqerr *pq.Error
+	if errors.As(err, &pqerr) {
 		return pqerr.Constraint
 	}
 
@@ -2135,7 +3610,6 @@ func (n *NullByteaArray) Scan(value interface{}) error {
 	return n.ByteaArray.Scan(value)
 }
 
-
 const (
 	jsonArraySeparator     = ","
 	jsonArrayBeginningChar = "["
@@ -2333,7 +3807,6 @@ func (a JSONArrayFloat64) Value() (driver.Value, error) {
 	return buffer.Bytes(), nil
 }
 
-
 var (
 	// Space is a shorthand composition option that holds space.
 	Space = &CompositionOpts{
@@ -2457,4 +3930,76 @@ func (c *Composer) Add(arg interface{}) {
 func (c *Composer) Args() []interface{} {
 	return c.args
 }
+
+// FieldError describes entity or patch field which value violates a constraint of the corresponding column.
+type FieldError struct {
+	// Field is the name of the struct field.
+	Field string
+	// Column is the name of the column the field maps to.
+	Column string
+	Reason string
+}
+
+// Error implements error interface.
+func (e *FieldError) Error() string {
+	return e.Field + " " + e.Reason
+}
+
+// ValidationError is returned by Validate methods, it lists all fields that did not pass the validation.
+type ValidationError []*FieldError
+
+// Error implements error interface.
+func (e ValidationError) Error() string {
+	msgs := make([]string, 0, len(e))
+	for _, fe := range e {
+		msgs = append(msgs, fe.Error())
+	}
+	return "validation failure: " + strings.Join(msgs, ", ")
+}
 `
+
+func TestGenerator_Generate_fixtures(t *testing.T) {
+	s := pqt.NewSchema("example").AddTable(
+		pqt.NewTable("user").AddColumn(pqt.NewColumn("id", pqt.TypeSerialBig(), pqt.WithPrimaryKey())),
+	)
+	cases := map[string]struct {
+		components pqtgogen.Component
+		expected   bool
+	}{
+		"all":               {components: pqtgogen.ComponentAll, expected: false},
+		"fixtures":          {components: pqtgogen.ComponentFixtures, expected: false},
+		"fixtures-insert":   {components: pqtgogen.ComponentFixtures | pqtgogen.ComponentInsert, expected: true},
+		"fixtures-with-all": {components: pqtgogen.ComponentAll | pqtgogen.ComponentFixtures, expected: true},
+	}
+
+	for hint, c := range cases {
+		t.Run(hint, func(t *testing.T) {
+			g := pqtgogen.Generator{
+				Pkg:        "example",
+				Components: c.components,
+			}
+			buf, err := g.Generate(s)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err.Error())
+			}
+			for _, decl := range []string{"func NewFixtures(", "func (f *Fixtures) InsertUserFixture("} {
+				if got := bytes.Contains(buf, []byte(decl)); got != c.expected {
+					t.Errorf("%s expected to be generated: %t", decl, c.expected)
+				}
+			}
+		})
+	}
+}
+
+func TestGenerator_Generate_notifyWithoutPrimaryKey(t *testing.T) {
+	s := pqt.NewSchema("example").AddTable(pqt.NewTable("event", pqt.WithNotify("")).
+		AddColumn(pqt.NewColumn("title", pqt.TypeText())))
+
+	g := pqtgogen.Generator{Pkg: "example", Components: pqtgogen.ComponentAll}
+	if _, err := g.Generate(s); err == nil || !strings.Contains(err.Error(), "event has no primary key") {
+		t.Errorf("expected missing primary key error, got: %v", err)
+	}
+	if _, err := g.GenerateFiles(s); err == nil {
+		t.Error("expected missing primary key error")
+	}
+}
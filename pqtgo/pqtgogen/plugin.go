@@ -1,6 +1,17 @@
 package pqtgogen
 
-import "github.com/piotrkowalczuk/pqt"
+import (
+	"fmt"
+
+	"github.com/piotrkowalczuk/pqt"
+)
+
+// PluginVersion is the version of the plugin API supported by the generator.
+//
+// Version 1 consists of the Plugin interface only.
+// Version 2 adds optional interfaces: EntityMethodsPlugin, FindExprFieldsPlugin, RepositoryMethodsPlugin,
+// ImportsPlugin, TableStaticPlugin and ComponentHooksPlugin.
+const PluginVersion = 2
 
 type Plugin interface {
 	PropertyType(*pqt.Column, int32) string
@@ -15,3 +26,118 @@ type Plugin interface {
 	ScanClause(*pqt.Column) string
 	Static(*pqt.Schema) string
 }
+
+// VersionedPlugin is implemented by plugins that depend on a particular version of the plugin API.
+// Plugins that do not implement it are treated as version 1.
+type VersionedPlugin interface {
+	Plugin
+	// PluginVersion returns the version of the plugin API plugin was written against.
+	PluginVersion() int
+}
+
+// EntityMethodsPlugin allows to add methods to XxxEntity.
+// Returned code is placed after the entity.
+type EntityMethodsPlugin interface {
+	Plugin
+	EntityMethods(*pqt.Table) string
+}
+
+// FindExprFieldsPlugin allows to add fields to XxxFindExpr.
+// Returned code is placed at the end of the struct body.
+type FindExprFieldsPlugin interface {
+	Plugin
+	FindExprFields(*pqt.Table) string
+}
+
+// RepositoryMethodsPlugin allows to add methods to XxxRepositoryBase and XxxRepositoryBaseTx.
+// Returned code is placed after the generated repository methods.
+type RepositoryMethodsPlugin interface {
+	Plugin
+	RepositoryMethods(*pqt.Table) string
+}
+
+// ImportsPlugin allows to register import paths the code generated by the plugin depends on.
+type ImportsPlugin interface {
+	Plugin
+	Imports(*pqt.Schema) []string
+}
+
+// TableStaticPlugin works like Static, but is called for each table.
+type TableStaticPlugin interface {
+	Plugin
+	TableStatic(*pqt.Table) string
+}
+
+// ComponentHooksPlugin allows to generate code before and after XxxRepositoryBase methods
+// of a single component (ComponentInsert, ComponentFind, ...).
+type ComponentHooksPlugin interface {
+	Plugin
+	BeforeComponent(*pqt.Table, Component) string
+	AfterComponent(*pqt.Table, Component) string
+}
+
+func (g *Generator) checkPlugins() error {
+	for _, p := range g.Plugins {
+		if vp, ok := p.(VersionedPlugin); ok && vp.PluginVersion() > PluginVersion {
+			return fmt.Errorf("pqtgogen: plugin %T requires plugin API version %d, but only %d is supported", p, vp.PluginVersion(), PluginVersion)
+		}
+	}
+	return nil
+}
+
+func (g *Generator) pluginImports(s *pqt.Schema) []string {
+	var imports []string
+	for _, p := range g.Plugins {
+		if ip, ok := p.(ImportsPlugin); ok {
+			imports = append(imports, ip.Imports(s)...)
+		}
+	}
+	return imports
+}
+
+func (g *Generator) pluginsEntityMethods(t *pqt.Table) {
+	for _, p := range g.Plugins {
+		if ep, ok := p.(EntityMethodsPlugin); ok {
+			g.pluginCode(ep.EntityMethods(t))
+		}
+	}
+}
+
+func (g *Generator) pluginsRepositoryMethods(t *pqt.Table) {
+	for _, p := range g.Plugins {
+		if rp, ok := p.(RepositoryMethodsPlugin); ok {
+			g.pluginCode(rp.RepositoryMethods(t))
+		}
+	}
+}
+
+func (g *Generator) pluginsTableStatics(t *pqt.Table) {
+	for _, p := range g.Plugins {
+		if sp, ok := p.(TableStaticPlugin); ok {
+			g.pluginCode(sp.TableStatic(t))
+		}
+	}
+}
+
+func (g *Generator) pluginsBeforeComponent(t *pqt.Table, c Component) {
+	for _, p := range g.Plugins {
+		if hp, ok := p.(ComponentHooksPlugin); ok {
+			g.pluginCode(hp.BeforeComponent(t, c))
+		}
+	}
+}
+
+func (g *Generator) pluginsAfterComponent(t *pqt.Table, c Component) {
+	for _, p := range g.Plugins {
+		if hp, ok := p.(ComponentHooksPlugin); ok {
+			g.pluginCode(hp.AfterComponent(t, c))
+		}
+	}
+}
+
+func (g *Generator) pluginCode(txt string) {
+	if txt != "" {
+		g.g.Print(txt)
+		g.g.Print("\n\n")
+	}
+}
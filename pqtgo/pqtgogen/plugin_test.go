@@ -0,0 +1,142 @@
+package pqtgogen_test
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/piotrkowalczuk/pqt"
+	"github.com/piotrkowalczuk/pqt/pqtfmt"
+	"github.com/piotrkowalczuk/pqt/pqtgo/pqtgogen"
+)
+
+type basePlugin struct{}
+
+func (basePlugin) PropertyType(*pqt.Column, int32) string { return "" }
+func (basePlugin) WhereClause(*pqt.Column) string         { return "" }
+func (basePlugin) SetClause(*pqt.Column) string           { return "" }
+func (basePlugin) ScanClause(*pqt.Column) string          { return "" }
+func (basePlugin) Static(*pqt.Schema) string              { return "" }
+
+type richPlugin struct {
+	basePlugin
+	version int
+}
+
+func (p richPlugin) PluginVersion() int { return p.version }
+
+func (richPlugin) Imports(*pqt.Schema) []string { return []string{"hash/crc32"} }
+
+func (richPlugin) EntityMethods(t *pqt.Table) string {
+	return fmt.Sprintf(`func (e *%sEntity) Checksum() uint32 {
+	return crc32.ChecksumIEEE([]byte(fmt.Sprint(*e)))
+}`, pqtfmt.Public(t.Name))
+}
+
+func (richPlugin) FindExprFields(*pqt.Table) string {
+	return "Tenant string"
+}
+
+func (richPlugin) RepositoryMethods(t *pqt.Table) string {
+	return fmt.Sprintf(`func (r *%sRepositoryBase) Name() string {
+	return r.Table
+}`, pqtfmt.Public(t.Name))
+}
+
+func (richPlugin) TableStatic(t *pqt.Table) string {
+	return fmt.Sprintf("const %sVersion = 1", pqtfmt.Private(t.Name))
+}
+
+func (richPlugin) BeforeComponent(t *pqt.Table, c pqtgogen.Component) string {
+	if c != pqtgogen.ComponentInsert {
+		return ""
+	}
+	return fmt.Sprintf("// before insert %s", t.Name)
+}
+
+func (richPlugin) AfterComponent(t *pqt.Table, c pqtgogen.Component) string {
+	if c != pqtgogen.ComponentInsert {
+		return ""
+	}
+	return fmt.Sprintf("// after insert %s", t.Name)
+}
+
+func TestGenerator_plugins(t *testing.T) {
+	g := pqtgogen.Generator{
+		Version:    9.5,
+		Pkg:        "example",
+		Components: pqtgogen.ComponentAll,
+		Plugins:    []pqtgogen.Plugin{basePlugin{}, richPlugin{version: pqtgogen.PluginVersion}},
+	}
+	buf, err := g.Generate(filesSchema())
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	for _, exp := range []string{
+		`"hash/crc32"`,
+		"func (e *UserEntity) Checksum() uint32 {",
+		"Lock          RowLock\n\tTenant        string\n}",
+		"func (r *CommentRepositoryBase) Name() string {",
+		"const userVersion = 1",
+		"// before insert user\n\nfunc (r *UserRepositoryBase) InsertQuery(",
+		") (*UserEntity, error) {\n\treturn r.insert(ctx, nil, e)\n}\n\n// after insert user",
+	} {
+		if !bytes.Contains(buf, []byte(exp)) {
+			t.Errorf("output should contain:\n%s", exp)
+		}
+	}
+}
+
+func TestGenerator_plugins_unsupportedVersion(t *testing.T) {
+	g := pqtgogen.Generator{
+		Pkg:        "example",
+		Components: pqtgogen.ComponentAll,
+		Plugins:    []pqtgogen.Plugin{richPlugin{version: pqtgogen.PluginVersion + 1}},
+	}
+	_, err := g.Generate(filesSchema())
+	if err == nil {
+		t.Fatal("error expected")
+	}
+	if !strings.Contains(err.Error(), "plugin API version") {
+		t.Errorf("unexpected error: %s", err.Error())
+	}
+}
+
+type matrixPlugin struct {
+	basePlugin
+}
+
+func (matrixPlugin) PropertyType(c *pqt.Column, _ int32) string {
+	if c.Name == "matrix" {
+		return "Matrix"
+	}
+	return ""
+}
+
+func TestGenerator_multidimensionalArray(t *testing.T) {
+	s := pqt.NewSchema("example").AddTable(pqt.NewTable("image").
+		AddColumn(pqt.NewColumn("id", pqt.TypeSerialBig(), pqt.WithPrimaryKey())).
+		AddColumn(pqt.NewColumn("matrix", pqt.TypeArray(pqt.TypeInteger(), 0, 0))))
+
+	g := pqtgogen.Generator{
+		Pkg:        "example",
+		Components: pqtgogen.ComponentAll,
+	}
+	if _, err := g.Generate(s); err == nil || !strings.Contains(err.Error(), "image.matrix: multidimensional array INTEGER[][]") {
+		t.Errorf("expected multidimensional array error, got: %v", err)
+	}
+	if _, err := g.GenerateFiles(s); err == nil {
+		t.Error("expected multidimensional array error")
+	}
+
+	g.Plugins = []pqtgogen.Plugin{matrixPlugin{}}
+	buf, err := g.Generate(s)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	if !bytes.Contains(buf, []byte("Matrix Matrix")) {
+		t.Error("plugin should provide property type of the column")
+	}
+}
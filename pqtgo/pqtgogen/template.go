@@ -0,0 +1,188 @@
+package pqtgogen
+
+import (
+	"bytes"
+	"text/template"
+
+	"github.com/piotrkowalczuk/pqt"
+	"github.com/piotrkowalczuk/pqt/pqtfmt"
+	"github.com/piotrkowalczuk/pqt/pqtgo"
+)
+
+// SchemaView is the data schema blocks (BlockHead and BlockStatics) are executed with.
+type SchemaView struct {
+	Schema *pqt.Schema
+	// Package is the name of the package code is generated into.
+	Package string
+	Tables  []*TableView
+}
+
+// TableView is the data table blocks are executed with.
+type TableView struct {
+	Table *pqt.Table
+	// Name is the name of the table, e.g. "news".
+	Name string
+	// FullName is the name of the table prefixed with the schema name, e.g. "example.news".
+	FullName string
+	// Entity is the Go name of the table, e.g. "News". Generated types are prefixed with it (NewsEntity, NewsRepositoryBase, ...).
+	Entity string
+	// Constant is the name of the constant that holds full name of the table, e.g. "TableNews".
+	Constant      string
+	Columns       []*ColumnView
+	PrimaryKey    *ColumnView
+	Relationships []*RelationshipView
+}
+
+// ColumnView describes a single column of a table.
+type ColumnView struct {
+	Column *pqt.Column
+	// Name is the name of the column, e.g. "created_at".
+	Name string
+	// Field is the name of the entity field, e.g. "CreatedAt".
+	Field string
+	// Constant is the name of the constant that holds the name of the column, e.g. "TableNewsColumnCreatedAt".
+	Constant string
+	// Type is the Go type of the entity field.
+	Type string
+	// CriteriaType is the Go type of the criteria field.
+	CriteriaType string
+	PrimaryKey   bool
+	NotNull      bool
+	Unique       bool
+	Dynamic      bool
+}
+
+// RelationshipView describes a single relationship field of an entity.
+type RelationshipView struct {
+	Relationship *pqt.Relationship
+	// Field is the name of the entity field, e.g. "Comments".
+	Field string
+	// Type is the Go type of the entity field, e.g. "[]*CommentEntity".
+	Type string
+	// Table is the view of the table on the other side of the relationship.
+	Table *TableView
+	// Many is true if entity field is a slice.
+	Many bool
+}
+
+// parseTemplates parses user provided templates.
+// Besides the functions defined by text/template, templates can use:
+//
+//	public  - joins arguments into exported Go identifier, e.g. {{ public "find" .Name }}
+//	private - joins arguments into unexported Go identifier
+//	default - renders built-in version of the block that is being executed
+func (g *Generator) parseTemplates() (*template.Template, error) {
+	if len(g.Templates) == 0 {
+		return nil, nil
+	}
+	tmpl := template.New("pqt").Funcs(template.FuncMap{
+		"public":  pqtfmt.Public,
+		"private": pqtfmt.Private,
+		"default": func() (string, error) { return "", nil },
+	})
+	for _, src := range g.Templates {
+		if _, err := tmpl.Parse(src); err != nil {
+			return nil, err
+		}
+	}
+	return tmpl, nil
+}
+
+// block generates block of given name.
+// If a template defines it, the template is executed instead of built-in generation.
+func (g *Generator) block(name string, data interface{}, builtin func()) {
+	if g.tmpl == nil || g.p.Err != nil {
+		builtin()
+		return
+	}
+	tmpl := g.tmpl.Lookup(name)
+	if tmpl == nil {
+		builtin()
+		return
+	}
+
+	tmpl.Funcs(template.FuncMap{
+		"default": func() (string, error) {
+			start := g.p.Len()
+			builtin()
+			out := string(g.p.Bytes()[start:])
+			g.p.Truncate(start)
+			return out, g.p.Err
+		},
+	})
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, data); err != nil {
+		g.p.Err = err
+		return
+	}
+	g.p.Print(buf.String())
+	g.g.NewLine()
+}
+
+func (g *Generator) schemaView(s *pqt.Schema) *SchemaView {
+	if g.tmpl == nil {
+		return nil
+	}
+	v := &SchemaView{
+		Schema:  s,
+		Package: g.Pkg,
+	}
+	for _, t := range s.Tables {
+		v.Tables = append(v.Tables, g.tableView(t))
+	}
+	return v
+}
+
+func (g *Generator) tableView(t *pqt.Table) *TableView {
+	if g.tmpl == nil {
+		return nil
+	}
+	v := g.tableViewColumns(t)
+	for _, f := range g.g.EntityFields(t) {
+		if f.Relationship == nil {
+			continue
+		}
+		other := f.Relationship.InversedTable
+		if other == t {
+			other = f.Relationship.OwnerTable
+		}
+		v.Relationships = append(v.Relationships, &RelationshipView{
+			Relationship: f.Relationship,
+			Field:        f.Name,
+			Type:         f.Type,
+			Table:        g.tableViewColumns(other),
+			Many:         f.Type[0] == '[',
+		})
+	}
+	return v
+}
+
+// tableViewColumns builds table view without relationships, which could otherwise lead to infinite recursion.
+func (g *Generator) tableViewColumns(t *pqt.Table) *TableView {
+	v := &TableView{
+		Table:    t,
+		Name:     t.Name,
+		FullName: t.FullName(),
+		Entity:   pqtfmt.Public(t.Name),
+		Constant: pqtfmt.Public("table", t.Name),
+	}
+	for _, c := range t.Columns {
+		cv := &ColumnView{
+			Column:       c,
+			Name:         c.Name,
+			Field:        pqtfmt.Public(c.Name),
+			Constant:     pqtfmt.Public("table", t.Name, "column", c.Name),
+			Type:         g.g.ColumnType(c, pqtgo.ModeDefault),
+			CriteriaType: g.g.ColumnType(c, pqtgo.ModeCriteria),
+			PrimaryKey:   c.PrimaryKey,
+			NotNull:      c.NotNull,
+			Unique:       c.Unique,
+			Dynamic:      c.IsDynamic,
+		}
+		if cv.PrimaryKey {
+			v.PrimaryKey = cv
+		}
+		v.Columns = append(v.Columns, cv)
+	}
+	return v
+}
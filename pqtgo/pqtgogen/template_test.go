@@ -0,0 +1,80 @@
+package pqtgogen_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/piotrkowalczuk/pqt/pqtgo/pqtgogen"
+)
+
+func TestGenerator_Templates(t *testing.T) {
+	g := pqtgogen.Generator{
+		Version:    9.5,
+		Pkg:        "example",
+		Components: pqtgogen.ComponentAll,
+		Templates: []string{
+			`{{define "entity"}}{{default}}
+// {{public "table" .Name}} ...
+func (e *{{.Entity}}Entity) {{public "table" "name"}}() string {
+	return {{.Constant}}
+}
+{{end}}`,
+			`{{define "delete"}}
+func (r *{{.Entity}}RepositoryBase) {{public "delete" "by" .PrimaryKey.Name}}(ctx context.Context, pk {{.PrimaryKey.Type}}) error {
+	_, err := r.DB.ExecContext(ctx, "DELETE FROM "+{{.Constant}}+" WHERE "+{{.PrimaryKey.Constant}}+"=$1", pk)
+	return err
+}
+{{range .Relationships}}
+// {{.Field}} {{.Type}} {{.Table.Entity}} {{.Many}}{{end}}
+{{end}}`,
+		},
+	}
+	buf, err := g.Generate(filesSchema())
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	for _, exp := range []string{
+		"type UserEntity struct",
+		"func (e *UserEntity) TableName() string {\n\treturn TableUser\n}",
+		"func (e *CommentEntity) TableName() string {\n\treturn TableComment\n}",
+		"func (r *UserRepositoryBase) DeleteByID(ctx context.Context, pk int64) error {",
+		"WHERE \"+TableUserColumnID+\"=$1\", pk)",
+		"// User *UserEntity User false",
+	} {
+		if !bytes.Contains(buf, []byte(exp)) {
+			t.Errorf("output should contain:\n%s", exp)
+		}
+	}
+	if bytes.Contains(buf, []byte("func (r *UserRepositoryBase) DeleteOneByID(")) {
+		t.Error("built-in delete block should be replaced")
+	}
+	if !bytes.Contains(buf, []byte("func (r *UserRepositoryBase) FindOneByID(")) {
+		t.Error("blocks without template should be generated as usual")
+	}
+}
+
+func TestGenerator_Templates_error(t *testing.T) {
+	cases := map[string]string{
+		"parse": `{{define "entity"}}{{.Entity}{{end}}`,
+		"exec":  `{{define "entity"}}{{.Unknown}}{{end}}`,
+	}
+
+	for hint, tmpl := range cases {
+		t.Run(hint, func(t *testing.T) {
+			g := pqtgogen.Generator{
+				Pkg:        "example",
+				Components: pqtgogen.ComponentAll,
+				Templates:  []string{tmpl},
+			}
+			_, err := g.Generate(filesSchema())
+			if err == nil {
+				t.Fatal("error expected")
+			}
+			if !strings.Contains(err.Error(), "template") {
+				t.Errorf("unexpected error: %s", err.Error())
+			}
+		})
+	}
+}
@@ -5,6 +5,9 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/piotrkowalczuk/pqt"
 )
@@ -39,21 +42,113 @@ func (g *Generator) GenerateTo(s *pqt.Schema, w io.Writer) error {
 func (g *Generator) generate(s *pqt.Schema) (*bytes.Buffer, error) {
 	code := bytes.NewBufferString("-- sql schema beginning\n")
 	code.WriteString("-- do not modify, generated by pqt\n\n")
-	if s.Name != "" {
-		fmt.Fprint(code, "CREATE SCHEMA ")
-		if s.IfNotExists {
-			fmt.Fprint(code, "IF NOT EXISTS ")
+	g.generateCreateSchema(code, s)
+	for _, r := range s.Roles {
+		g.generateCreateRole(code, r)
+	}
+	if err := g.generateSchema(code, s); err != nil {
+		return nil, err
+	}
+	code.WriteString("-- sql schema end\n")
+	return code, nil
+}
+
+// GenerateDatabase generates code based on given database.
+// Schemas are created first, then extensions and roles, then objects of each schema in dependency order.
+func (g *Generator) GenerateDatabase(d *pqt.Database) ([]byte, error) {
+	code, err := g.generateDatabase(d)
+	if err != nil {
+		return nil, err
+	}
+
+	return code.Bytes(), nil
+}
+
+// GenerateDatabaseTo works like GenerateDatabase, but writes directly into io.Writer.
+func (g *Generator) GenerateDatabaseTo(d *pqt.Database, w io.Writer) error {
+	code, err := g.generateDatabase(d)
+	if err != nil {
+		return err
+	}
+
+	_, err = code.WriteTo(w)
+	return err
+}
+
+func (g *Generator) generateDatabase(d *pqt.Database) (*bytes.Buffer, error) {
+	schemas, err := d.SortedSchemas()
+	if err != nil {
+		return nil, err
+	}
+
+	code := bytes.NewBufferString("-- sql database beginning\n")
+	code.WriteString("-- do not modify, generated by pqt\n\n")
+	for _, s := range schemas {
+		g.generateCreateSchema(code, s)
+	}
+	for _, e := range d.Extensions {
+		if err := g.generateCreateExtension(code, e); err != nil {
+			return nil, err
 		}
-		fmt.Fprintf(code, "%s; \n\n", s.Name)
 	}
+	seen := make(map[string]bool)
+	for _, s := range schemas {
+		for _, r := range s.Roles {
+			if !seen[r.Name] {
+				seen[r.Name] = true
+				g.generateCreateRole(code, r)
+			}
+		}
+	}
+	for _, s := range schemas {
+		if err := g.generateSchema(code, s); err != nil {
+			return nil, err
+		}
+	}
+	code.WriteString("-- sql database end\n")
+	return code, nil
+}
+
+func (g *Generator) generateCreateSchema(buf *bytes.Buffer, s *pqt.Schema) {
+	if s.Name == "" {
+		return
+	}
+	fmt.Fprint(buf, "CREATE SCHEMA ")
+	if s.IfNotExists {
+		fmt.Fprint(buf, "IF NOT EXISTS ")
+	}
+	fmt.Fprintf(buf, "%s; \n\n", s.Name)
+}
+
+func (g *Generator) generateCreateExtension(buf *bytes.Buffer, e *pqt.Extension) error {
+	if e.Name == "" {
+		return errors.New("missing extension name")
+	}
+	fmt.Fprintf(buf, "CREATE EXTENSION IF NOT EXISTS %s", e.Name)
+	if e.Schema != nil && e.Schema.Name != "" {
+		fmt.Fprintf(buf, " WITH SCHEMA %s", e.Schema.Name)
+	}
+	if e.Version != "" {
+		fmt.Fprintf(buf, " VERSION '%s'", e.Version)
+	}
+	buf.WriteString(";\n\n")
+	return nil
+}
+
+// generateSchema generates objects that belong to the schema, schema itself and roles have to be created already.
+func (g *Generator) generateSchema(code *bytes.Buffer, s *pqt.Schema) error {
+	g.generateSchemaUsage(code, s)
 	for _, f := range s.Functions {
 		if err := g.generateCreateFunction(code, f); err != nil {
-			return nil, err
+			return err
 		}
 	}
+	for _, seq := range s.Sequences {
+		g.generateCreateSequence(code, seq)
+	}
 	for _, t := range s.Tables {
 		if err := g.generateCreateTable(code, t); err != nil {
-			return nil, err
+			return err
 		}
 		for _, cnstr := range t.Constraints {
 			switch cnstr.Type {
@@ -63,10 +158,25 @@ func (g *Generator) generate(s *pqt.Schema) (*bytes.Buffer, error) {
 				uniqueIndexConstraintQuery(code, cnstr, g.Version)
 			}
 		}
+		if err := g.generateRows(code, t); err != nil {
+			return err
+		}
+		if t.Notify {
+			if err := g.generateNotifyTrigger(code, t); err != nil {
+				return err
+			}
+		}
+		if t.History {
+			if err := g.generateHistory(code, t); err != nil {
+				return err
+			}
+		}
+		if err := g.generateAccess(code, t); err != nil {
+			return err
+		}
 		fmt.Fprintln(code, "")
 	}
-	code.WriteString("-- sql schema end\n")
-	return code, nil
+	return nil
 }
 
 func (g *Generator) generateCreateFunction(buf *bytes.Buffer, f *pqt.Function) error {
@@ -164,9 +274,26 @@ func (g *Generator) generateCreateTable(buf *bytes.Buffer, t *pqt.Table) error {
 			buf.WriteRune(' ')
 			buf.WriteString(c.Collate)
 		}
-		if d, ok := c.DefaultOn(pqt.EventInsert); ok {
-			buf.WriteString(" DEFAULT ")
-			buf.WriteString(d)
+		switch {
+		case c.Generated != "":
+			if g.Version > 0 && g.Version < 12 {
+				return fmt.Errorf("column %s.%s: generated columns require Postgres 12 or newer", t.Name, c.Name)
+			}
+			buf.WriteString(" GENERATED ALWAYS AS (")
+			buf.WriteString(c.Generated)
+			buf.WriteString(") STORED")
+		case c.Identity != "":
+			if g.Version > 0 && g.Version < 10 {
+				return fmt.Errorf("column %s.%s: identity columns require Postgres 10 or newer", t.Name, c.Name)
+			}
+			buf.WriteString(" GENERATED ")
+			buf.WriteString(string(c.Identity))
+			buf.WriteString(" AS IDENTITY")
+		default:
+			if d, ok := c.DefaultOn(pqt.EventInsert); ok {
+				buf.WriteString(" DEFAULT ")
+				buf.WriteString(d)
+			}
 		}
 		if c.NotNull {
 			buf.WriteString(" NOT NULL")
@@ -204,6 +331,426 @@ func (g *Generator) generateCreateTable(buf *bytes.Buffer, t *pqt.Table) error {
 	return nil
 }
 
+func (g *Generator) generateCreateSequence(buf *bytes.Buffer, s *pqt.Sequence) {
+	buf.WriteString("CREATE SEQUENCE ")
+	if s.IfNotExists {
+		buf.WriteString("IF NOT EXISTS ")
+	}
+	buf.WriteString(s.FullName())
+	if s.Increment != 0 {
+		fmt.Fprintf(buf, " INCREMENT BY %d", s.Increment)
+	}
+	if s.MinValue != 0 {
+		fmt.Fprintf(buf, " MINVALUE %d", s.MinValue)
+	}
+	if s.MaxValue != 0 {
+		fmt.Fprintf(buf, " MAXVALUE %d", s.MaxValue)
+	}
+	if s.Start != 0 {
+		fmt.Fprintf(buf, " START WITH %d", s.Start)
+	}
+	if s.Cache != 0 {
+		fmt.Fprintf(buf, " CACHE %d", s.Cache)
+	}
+	if s.Cycle {
+		buf.WriteString(" CYCLE")
+	}
+	buf.WriteString(";\n\n")
+}
+
+// generateCreateRole creates role only if it does not exist, roles are shared by all databases of a cluster.
+func (g *Generator) generateCreateRole(buf *bytes.Buffer, r *pqt.Role) {
+	buf.WriteString("DO $$\nBEGIN\n	CREATE ROLE ")
+	buf.WriteString(r.Name)
+	if r.Login {
+		buf.WriteString(" LOGIN")
+	} else {
+		buf.WriteString(" NOLOGIN")
+	}
+	if len(r.InRole) > 0 {
+		buf.WriteString(" IN ROLE ")
+		buf.WriteString(joinRoles(r.InRole))
+	}
+	buf.WriteString(";\nEXCEPTION WHEN duplicate_object THEN NULL;\nEND\n$$;\n\n")
+}
+
+// generateSchemaUsage grants USAGE on the schema to every role that has privileges on any of its tables.
+func (g *Generator) generateSchemaUsage(buf *bytes.Buffer, s *pqt.Schema) {
+	if s.Name == "" {
+		return
+	}
+	var roles []*pqt.Role
+	seen := make(map[string]bool)
+	for _, t := range s.Tables {
+		for _, gr := range t.Grants {
+			for _, r := range gr.Roles {
+				if !seen[r.Name] {
+					seen[r.Name] = true
+					roles = append(roles, r)
+				}
+			}
+		}
+	}
+	if len(roles) == 0 {
+		return
+	}
+	fmt.Fprintf(buf, "GRANT USAGE ON SCHEMA %s TO %s;\n\n", s.Name, joinRoles(roles))
+}
+
+func (g *Generator) generateAccess(buf *bytes.Buffer, t *pqt.Table) error {
+	if t.RowLevelSecurity {
+		fmt.Fprintf(buf, "\nALTER TABLE %s ENABLE ROW LEVEL SECURITY;\n", t.FullName())
+	}
+	if t.ForceRowLevelSecurity {
+		fmt.Fprintf(buf, "ALTER TABLE %s FORCE ROW LEVEL SECURITY;\n", t.FullName())
+	}
+	for _, p := range t.Policies {
+		if err := g.generatePolicy(buf, t, p); err != nil {
+			return err
+		}
+	}
+	for _, gr := range t.Grants {
+		if err := g.generateGrant(buf, t, gr); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// generateRows generates statement that inserts static rows of the table.
+// It can be executed multiple times, rows that already exist are updated.
+func (g *Generator) generateRows(buf *bytes.Buffer, t *pqt.Table) error {
+	if len(t.Rows) == 0 {
+		return nil
+	}
+
+	target := rowsConflictTarget(t)
+	if target == nil {
+		return fmt.Errorf("table %s: static rows require values of the primary key or of an unique constraint columns", t.Name)
+	}
+
+	// Rows that set different columns are inserted by separate statements.
+	// Otherwise, on conflict, columns that a row leaves to their defaults would be overwritten with defaults again.
+	var (
+		sets   []pqt.Columns
+		groups = make(map[string][]*pqt.Row)
+	)
+	for _, r := range t.Rows {
+		columns := rowColumns(t, r)
+		key := pqt.JoinColumns(columns, ", ")
+		if _, ok := groups[key]; !ok {
+			sets = append(sets, columns)
+		}
+		groups[key] = append(groups[key], r)
+	}
+	for _, columns := range sets {
+		if err := generateRowsInsert(buf, t, columns, target, groups[pqt.JoinColumns(columns, ", ")]); err != nil {
+			return err
+		}
+	}
+
+	// Serial columns set explicitly leave their sequences behind.
+	for _, c := range t.Columns {
+		switch c.Type {
+		case pqt.TypeSerial(), pqt.TypeSerialBig(), pqt.TypeSerialSmall():
+		default:
+			if c.Identity != pqt.IdentityByDefault {
+				continue
+			}
+		}
+		for _, columns := range sets {
+			if containsColumn(columns, c) {
+				fmt.Fprintf(buf, "SELECT setval(pg_get_serial_sequence('%s', '%s'), (SELECT MAX(%s) FROM %s));\n", t.FullName(), c.Name, c.Name, t.FullName())
+				break
+			}
+		}
+	}
+	return nil
+}
+
+// generateRowsInsert writes upsert statement of the rows that all set given columns.
+func generateRowsInsert(buf *bytes.Buffer, t *pqt.Table, columns, target pqt.Columns, rows []*pqt.Row) error {
+	fmt.Fprintf(buf, "\nINSERT INTO %s (%s) VALUES", t.FullName(), pqt.JoinColumns(columns, ", "))
+	for i, r := range rows {
+		if i != 0 {
+			buf.WriteString(",")
+		}
+		buf.WriteString("\n	(")
+		for j, c := range columns {
+			if j != 0 {
+				buf.WriteString(", ")
+			}
+			v, _ := r.Value(c)
+			lit, err := literal(c, v)
+			if err != nil {
+				return fmt.Errorf("table %s, row %q: %s", t.Name, r.Name, err.Error())
+			}
+			buf.WriteString(lit)
+		}
+		buf.WriteString(")")
+	}
+	fmt.Fprintf(buf, "\nON CONFLICT (%s) DO ", pqt.JoinColumns(target, ", "))
+	var set []string
+	for _, c := range columns {
+		if !containsColumn(target, c) {
+			set = append(set, fmt.Sprintf("%s = EXCLUDED.%s", c.Name, c.Name))
+		}
+	}
+	if len(set) == 0 {
+		buf.WriteString("NOTHING;\n")
+	} else {
+		fmt.Fprintf(buf, "UPDATE SET %s;\n", strings.Join(set, ", "))
+	}
+	return nil
+}
+
+// rowColumns returns columns of the table that the row sets, in order of the table columns.
+func rowColumns(t *pqt.Table, r *pqt.Row) pqt.Columns {
+	var columns pqt.Columns
+	for _, c := range t.Columns {
+		if _, ok := r.Value(c); ok {
+			columns = append(columns, c)
+		}
+	}
+	return columns
+}
+
+// rowsConflictTarget returns columns of the primary key, or of the first unique constraint, that all static rows set.
+func rowsConflictTarget(t *pqt.Table) pqt.Columns {
+	for _, cnstr := range t.Constraints {
+		if cnstr.Type != pqt.ConstraintTypePrimaryKey && cnstr.Type != pqt.ConstraintTypeUnique {
+			continue
+		}
+		ok := true
+		for _, r := range t.Rows {
+			for _, c := range cnstr.PrimaryColumns {
+				if _, set := r.Value(c); !set {
+					ok = false
+				}
+			}
+		}
+		if ok {
+			return cnstr.PrimaryColumns
+		}
+	}
+	return nil
+}
+
+func containsColumn(columns pqt.Columns, c *pqt.Column) bool {
+	for _, cc := range columns {
+		if cc == c {
+			return true
+		}
+	}
+	return false
+}
+
+// literal renders value of static row as SQL literal.
+func literal(c *pqt.Column, v interface{}) (string, error) {
+	switch val := v.(type) {
+	case nil:
+		return "NULL", nil
+	case string:
+		return quoteLiteral(val), nil
+	case []byte:
+		typ := c.Type
+		if mt, ok := typ.(pqt.MappableType); ok {
+			typ = mt.From
+		}
+		if typ == pqt.TypeJSON() || typ == pqt.TypeJSONB() {
+			return quoteLiteral(string(val)), nil
+		}
+		return fmt.Sprintf("'\\x%x'", val), nil
+	case bool:
+		if val {
+			return "TRUE", nil
+		}
+		return "FALSE", nil
+	case time.Time:
+		return quoteLiteral(val.Format(time.RFC3339Nano)), nil
+	case float32:
+		return strconv.FormatFloat(float64(val), 'g', -1, 32), nil
+	case float64:
+		return strconv.FormatFloat(val, 'g', -1, 64), nil
+	case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64:
+		return fmt.Sprintf("%d", val), nil
+	}
+	return "", fmt.Errorf("unsupported value of column %s: %T", c.Name, v)
+}
+
+func quoteLiteral(s string) string {
+	return "'" + strings.Replace(s, "'", "''", -1) + "'"
+}
+
+func (g *Generator) generatePolicy(buf *bytes.Buffer, t *pqt.Table, p *pqt.Policy) error {
+	if p.Name == "" {
+		return fmt.Errorf("table %s has policy without name", t.Name)
+	}
+	if p.Using == "" && p.WithCheck == "" {
+		return fmt.Errorf("policy %s has neither USING nor WITH CHECK expression", p.Name)
+	}
+	switch p.Command {
+	case pqt.PolicyCommandSelect, pqt.PolicyCommandDelete:
+		if p.WithCheck != "" {
+			return fmt.Errorf("policy %s: WITH CHECK cannot be applied to %s", p.Name, p.Command)
+		}
+	case pqt.PolicyCommandInsert:
+		if p.Using != "" {
+			return fmt.Errorf("policy %s: USING cannot be applied to %s", p.Name, p.Command)
+		}
+	}
+
+	fmt.Fprintf(buf, "\nDROP POLICY IF EXISTS %s ON %s;\n", p.Name, t.FullName())
+	fmt.Fprintf(buf, "CREATE POLICY %s ON %s", p.Name, t.FullName())
+	if p.Restrictive {
+		buf.WriteString(" AS RESTRICTIVE")
+	}
+	if p.Command != "" {
+		buf.WriteString(" FOR ")
+		buf.WriteString(string(p.Command))
+	}
+	if len(p.Roles) > 0 {
+		buf.WriteString(" TO ")
+		buf.WriteString(joinRoles(p.Roles))
+	}
+	if p.Using != "" {
+		fmt.Fprintf(buf, "\n	USING (%s)", p.Using)
+	}
+	if p.WithCheck != "" {
+		fmt.Fprintf(buf, "\n	WITH CHECK (%s)", p.WithCheck)
+	}
+	buf.WriteString(";\n")
+	return nil
+}
+
+func (g *Generator) generateGrant(buf *bytes.Buffer, t *pqt.Table, gr *pqt.Grant) error {
+	if len(gr.Privileges) == 0 {
+		return fmt.Errorf("table %s has grant without privileges", t.Name)
+	}
+	if len(gr.Roles) == 0 {
+		return fmt.Errorf("table %s has grant without roles", t.Name)
+	}
+
+	buf.WriteString("\nGRANT ")
+	for i, p := range gr.Privileges {
+		if i != 0 {
+			buf.WriteString(", ")
+		}
+		buf.WriteString(string(p))
+		if len(gr.Columns) > 0 {
+			switch p {
+			case pqt.PrivilegeSelect, pqt.PrivilegeInsert, pqt.PrivilegeUpdate, pqt.PrivilegeReferences, pqt.PrivilegeAll:
+			default:
+				return fmt.Errorf("table %s: %s cannot be granted on columns", t.Name, p)
+			}
+			fmt.Fprintf(buf, " (%s)", pqt.JoinColumns(gr.Columns, ", "))
+		}
+	}
+	fmt.Fprintf(buf, " ON %s TO %s", t.FullName(), joinRoles(gr.Roles))
+	if gr.WithGrantOption {
+		buf.WriteString(" WITH GRANT OPTION")
+	}
+	buf.WriteString(";\n")
+	return nil
+}
+
+func joinRoles(roles []*pqt.Role) string {
+	names := make([]string, 0, len(roles))
+	for _, r := range roles {
+		names = append(names, r.Name)
+	}
+	return strings.Join(names, ", ")
+}
+
+func (g *Generator) generateNotifyTrigger(buf *bytes.Buffer, t *pqt.Table) error {
+	pk := t.PrimaryKeyColumns()
+	if len(pk) == 0 {
+		return fmt.Errorf("table %s has no primary key, notifications require one", t.Name)
+	}
+	key := func(row string) string {
+		args := make([]string, 0, len(pk))
+		for _, c := range pk {
+			args = append(args, fmt.Sprintf("'%s', %s.%s", c.Name, row, c.Name))
+		}
+		return "json_build_object(" + strings.Join(args, ", ") + ")"
+	}
+
+	columns := make([]*pqt.Column, 0, len(t.Columns))
+	for _, c := range t.Columns {
+		if c.IsDynamic {
+			continue
+		}
+		columns = append(columns, c)
+	}
+
+	fmt.Fprintf(buf, "\nCREATE OR REPLACE FUNCTION %s_notify() RETURNS TRIGGER\n	AS $$\n", t.FullName())
+	buf.WriteString("DECLARE\n	_columns TEXT[] := '{}';\n	_key JSON;\nBEGIN\n")
+	fmt.Fprintf(buf, "	IF TG_OP = 'DELETE' THEN\n		_key := %s;\n", key("OLD"))
+	fmt.Fprintf(buf, "	ELSE\n		_key := %s;\n	END IF;\n", key("NEW"))
+	buf.WriteString("	IF TG_OP = 'INSERT' THEN\n		_columns := ARRAY[")
+	for i, c := range columns {
+		if i != 0 {
+			buf.WriteString(", ")
+		}
+		fmt.Fprintf(buf, "'%s'", c.Name)
+	}
+	buf.WriteString("];\n	ELSIF TG_OP = 'UPDATE' THEN\n")
+	for _, c := range columns {
+		// Comparison is done on text representation, because some types (e.g. JSON) do not have an equality operator.
+		fmt.Fprintf(buf, "		IF NEW.%s::TEXT IS DISTINCT FROM OLD.%s::TEXT THEN\n", c.Name, c.Name)
+		fmt.Fprintf(buf, "			_columns := array_append(_columns, '%s');\n		END IF;\n", c.Name)
+	}
+	buf.WriteString("	END IF;\n")
+	fmt.Fprintf(buf, "	PERFORM pg_notify('%s', json_build_object('operation', TG_OP, 'key', _key, 'columns', _columns)::TEXT);\n", t.Channel())
+	buf.WriteString("	RETURN NULL;\nEND;\n$$\n	LANGUAGE plpgsql;\n\n")
+
+	fmt.Fprintf(buf, "DROP TRIGGER IF EXISTS %s_notify ON %s;\n", t.Name, t.FullName())
+	fmt.Fprintf(buf, "CREATE TRIGGER %s_notify AFTER INSERT OR UPDATE OR DELETE ON %s FOR EACH ROW EXECUTE PROCEDURE %s_notify();\n", t.Name, t.FullName(), t.FullName())
+
+	return nil
+}
+
+func (g *Generator) generateHistory(buf *bytes.Buffer, t *pqt.Table) error {
+	h := t.HistoryTable()
+
+	buf.WriteRune('\n')
+	if err := g.generateCreateTable(buf, h); err != nil {
+		return err
+	}
+	for _, cnstr := range h.Constraints {
+		if cnstr.Type == pqt.ConstraintTypeIndex {
+			indexConstraintQuery(buf, cnstr, g.Version)
+		}
+	}
+
+	columns := make([]string, 0, len(t.Columns))
+	for _, c := range t.Columns {
+		if c.IsDynamic {
+			continue
+		}
+		columns = append(columns, c.Name)
+	}
+	insert := func(row string) {
+		fmt.Fprintf(buf, "INSERT INTO %s (%s, %s) VALUES (", h.FullName(), strings.Join(columns, ", "), pqt.HistoryColumnOperation)
+		for _, c := range columns {
+			fmt.Fprintf(buf, "%s.%s, ", row, c)
+		}
+		buf.WriteString("TG_OP);\n")
+	}
+
+	fmt.Fprintf(buf, "\nCREATE OR REPLACE FUNCTION %s_audit() RETURNS TRIGGER\n	AS $$\nBEGIN\n", t.FullName())
+	buf.WriteString("	IF TG_OP = 'DELETE' THEN\n		")
+	insert("OLD")
+	buf.WriteString("	ELSE\n		")
+	insert("NEW")
+	buf.WriteString("	END IF;\n	RETURN NULL;\nEND;\n$$\n	LANGUAGE plpgsql;\n\n")
+
+	fmt.Fprintf(buf, "DROP TRIGGER IF EXISTS %s_audit ON %s;\n", t.Name, t.FullName())
+	fmt.Fprintf(buf, "CREATE TRIGGER %s_audit AFTER INSERT OR UPDATE OR DELETE ON %s FOR EACH ROW EXECUTE PROCEDURE %s_audit();\n", t.Name, t.FullName(), t.FullName())
+
+	return nil
+}
+
 func (g *Generator) generateConstraint(buf *bytes.Buffer, c *pqt.Constraint) error {
 	switch c.Type {
 	case pqt.ConstraintTypeUnique:
@@ -280,11 +827,14 @@ func checkConstraintQuery(buf *bytes.Buffer, c *pqt.Constraint) {
 func indexConstraintQuery(buf *bytes.Buffer, c *pqt.Constraint, ver float64) {
 	// TODO: change code so IF NOT EXISTS is optional
 	if ver >= 9.5 {
-		fmt.Fprintf(buf, `CREATE INDEX IF NOT EXISTS "%s" ON %s (%s);`, c.Name(), c.PrimaryTable.FullName(), c.PrimaryColumns.String())
+		fmt.Fprintf(buf, `CREATE INDEX IF NOT EXISTS "%s" ON %s`, c.Name(), c.PrimaryTable.FullName())
 	} else {
-		fmt.Fprintf(buf, `CREATE INDEX "%s" ON %s (%s);`, c.Name(), c.PrimaryTable.FullName(), c.PrimaryColumns.String())
+		fmt.Fprintf(buf, `CREATE INDEX "%s" ON %s`, c.Name(), c.PrimaryTable.FullName())
+	}
+	if c.Using != "" {
+		fmt.Fprintf(buf, " USING %s", c.Using)
 	}
-	fmt.Fprintln(buf, "")
+	fmt.Fprintf(buf, " (%s);\n", c.PrimaryColumns.String())
 }
 
 func uniqueIndexConstraintQuery(buf *bytes.Buffer, c *pqt.Constraint, ver float64) {
@@ -47,6 +47,9 @@ type Relationship struct {
 	OwnerColumns, InversedColumns           Columns
 	ColumnName                              string
 	OnDelete, OnUpdate                      int32
+	// ThroughPrimaryKey makes columns of many to many relationship primary key of the through table,
+	// by default they are covered by unique constraint.
+	ThroughPrimaryKey bool
 }
 
 func newRelationship(owner, inversed, through *Table, rt RelationshipType, opts ...RelationshipOption) *Relationship {
@@ -122,6 +125,17 @@ func WithInversedForeignKey(primaryColumns, referenceColumns Columns, opts ...Co
 	}
 }
 
+// WithThroughPrimaryKey makes columns of many to many relationship primary key of the through table.
+// Through table cannot have primary key of its own.
+func WithThroughPrimaryKey() RelationshipOption {
+	return func(r *Relationship) {
+		if r.Type != RelationshipTypeManyToMany {
+			panic("function WithThroughPrimaryKey can be used only with M2M relationships")
+		}
+		r.ThroughPrimaryKey = true
+	}
+}
+
 // WithForeignKey ...
 func WithForeignKey(primaryColumns, referenceColumns Columns, opts ...ConstraintOption) RelationshipOption {
 	return func(r *Relationship) {
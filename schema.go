@@ -6,6 +6,8 @@ type Schema struct {
 	IfNotExists bool
 	Tables      []*Table
 	Functions   []*Function
+	Sequences   []*Sequence
+	Roles       []*Role
 	Types       []Type
 }
 
@@ -42,6 +44,21 @@ func (s *Schema) AddFunction(f *Function) *Schema {
 	return s
 }
 
+// AddSequence ...
+func (s *Schema) AddSequence(seq *Sequence) *Schema {
+	seq.Schema = s
+	s.Sequences = append(s.Sequences, seq)
+
+	return s
+}
+
+// AddRole ...
+func (s *Schema) AddRole(r *Role) *Schema {
+	s.Roles = append(s.Roles, r)
+
+	return s
+}
+
 // SchemaOption configures how we set up a schema.
 type SchemaOption func(*Schema)
 
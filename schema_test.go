@@ -29,3 +29,14 @@ func TestSchema_AddFunction(t *testing.T) {
 		t.Errorf("wrong number of functions: %d", len(tbl.Functions))
 	}
 }
+
+func TestSchema_AddSequence(t *testing.T) {
+	seq := pqt.NewSequence("sequence")
+	sch := pqt.NewSchema("schema").AddSequence(seq)
+	if len(sch.Sequences) != 1 {
+		t.Fatalf("wrong number of sequences: %d", len(sch.Sequences))
+	}
+	if seq.Schema != sch {
+		t.Error("wrong schema assigned to the sequence")
+	}
+}
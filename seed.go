@@ -0,0 +1,200 @@
+package pqt
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// Row is a static row of the table, such as an entry of a lookup table.
+// Rows are inserted right after the table is created, see Table.AddRow.
+type Row struct {
+	// Name, if set, is used to name constants that hold primary key of the row.
+	Name   string
+	Values map[string]interface{}
+}
+
+// Value returns value of given column and true if the row sets it.
+func (r *Row) Value(c *Column) (interface{}, bool) {
+	v, ok := r.Values[c.Name]
+	return v, ok
+}
+
+// AddRow adds static row to the table. Values are keyed by column names.
+// Name is optional, if given it has to be unique within the table.
+// It panics if values do not fit the table columns, so it has to be called after all columns and the primary key are defined.
+func (t *Table) AddRow(name string, values map[string]interface{}) *Table {
+	r := &Row{Name: name, Values: values}
+	if err := t.validateRow(r); err != nil {
+		panic(fmt.Sprintf("table %s: invalid row %q: %s", t.Name, name, err.Error()))
+	}
+
+	t.Rows = append(t.Rows, r)
+
+	return t
+}
+
+func (t *Table) validateRow(r *Row) error {
+	if r.Name != "" {
+		for _, rr := range t.Rows {
+			if rr.Name == r.Name {
+				return fmt.Errorf("row name is not unique")
+			}
+		}
+	}
+	pk := t.PrimaryKeyColumns()
+	for name, v := range r.Values {
+		c := t.column(name)
+		if c == nil {
+			return fmt.Errorf("unknown column %s", name)
+		}
+		if c.IsDynamic || c.IsGeneratedAlways() {
+			return fmt.Errorf("column %s is not writable", name)
+		}
+		if v == nil {
+			if c.NotNull || containsColumn(pk, c) {
+				return fmt.Errorf("column %s is not nullable", name)
+			}
+			continue
+		}
+		if err := validateRowValue(c.Type, v); err != nil {
+			return fmt.Errorf("column %s: %s", name, err.Error())
+		}
+	}
+	for _, c := range t.Columns {
+		if _, ok := r.Values[c.Name]; ok || c.IsDynamic || c.IsGeneratedAlways() {
+			continue
+		}
+		if _, ok := c.DefaultOn(EventInsert); ok || c.Identity != "" || c.Sequence != nil || isSerial(c.Type) {
+			continue
+		}
+		if c.NotNull {
+			return fmt.Errorf("missing value of column %s", c.Name)
+		}
+	}
+	for _, c := range pk {
+		if _, ok := r.Values[c.Name]; !ok {
+			return fmt.Errorf("missing value of primary key column %s", c.Name)
+		}
+	}
+	return nil
+}
+
+func (t *Table) column(name string) *Column {
+	for _, c := range t.Columns {
+		if c.Name == name {
+			return c
+		}
+	}
+	return nil
+}
+
+func containsColumn(columns Columns, c *Column) bool {
+	for _, cc := range columns {
+		if cc == c {
+			return true
+		}
+	}
+	return false
+}
+
+func isSerial(t Type) bool {
+	switch t {
+	case TypeSerial(), TypeSerialBig(), TypeSerialSmall():
+		return true
+	}
+	return false
+}
+
+// validateRowValue checks if Go value can be stored in a column of given type.
+// Types that are not known are expected to be given as their string representation.
+func validateRowValue(t Type, v interface{}) error {
+	if mt, ok := t.(MappableType); ok {
+		t = mt.From
+	}
+	if et, ok := t.(EnumeratedType); ok {
+		s, ok := v.(string)
+		if !ok {
+			return fmt.Errorf("expected string, got %T", v)
+		}
+		for _, e := range et.Enums {
+			if e == s {
+				return nil
+			}
+		}
+		return fmt.Errorf("%q is not one of %s", s, strings.Join(et.Enums, ", "))
+	}
+
+	rv := reflect.ValueOf(v)
+	name := t.String()
+	switch {
+	case name == "SMALLINT" || name == "SMALLSERIAL":
+		return validateRowInteger(rv, 16)
+	case name == "INTEGER" || name == "SERIAL":
+		return validateRowInteger(rv, 32)
+	case name == "BIGINT" || name == "BIGSERIAL":
+		return validateRowInteger(rv, 64)
+	case name == "REAL" || name == "DOUBLE PRECISION" || strings.HasPrefix(name, "NUMERIC") || strings.HasPrefix(name, "DECIMAL"):
+		switch rv.Kind() {
+		case reflect.Float32, reflect.Float64:
+			return nil
+		}
+		return validateRowInteger(rv, 64)
+	case name == "BOOL":
+		if rv.Kind() != reflect.Bool {
+			return fmt.Errorf("expected bool, got %T", v)
+		}
+	case name == "TIMESTAMP" || name == "TIMESTAMPTZ" || name == "DATE":
+		if _, ok := v.(time.Time); !ok {
+			return fmt.Errorf("expected time.Time, got %T", v)
+		}
+	case name == "BYTEA":
+		if _, ok := v.([]byte); !ok {
+			return fmt.Errorf("expected []byte, got %T", v)
+		}
+	case name == "JSON" || name == "JSONB":
+		var raw []byte
+		switch j := v.(type) {
+		case string:
+			raw = []byte(j)
+		case []byte:
+			raw = j
+		default:
+			return fmt.Errorf("expected string or []byte, got %T", v)
+		}
+		if !json.Valid(raw) {
+			return fmt.Errorf("invalid json")
+		}
+	default:
+		s, ok := v.(string)
+		if !ok {
+			return fmt.Errorf("expected string, got %T", v)
+		}
+		var length int
+		for _, format := range []string{"VARCHAR(%d)", "CHARACTER[%d]"} {
+			if _, err := fmt.Sscanf(name, format, &length); err == nil && utf8.RuneCountInString(s) > length {
+				return fmt.Errorf("value is longer than %d characters", length)
+			}
+		}
+	}
+	return nil
+}
+
+func validateRowInteger(rv reflect.Value, bits uint) error {
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if i := rv.Int(); i < -1<<(bits-1) || i > 1<<(bits-1)-1 {
+			return fmt.Errorf("value %d out of range", i)
+		}
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		if u := rv.Uint(); u > 1<<(bits-1)-1 {
+			return fmt.Errorf("value %d out of range", u)
+		}
+	default:
+		return fmt.Errorf("expected integer, got %s", rv.Type())
+	}
+	return nil
+}
@@ -0,0 +1,75 @@
+package pqt_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/piotrkowalczuk/pqt"
+)
+
+func seedTable() *pqt.Table {
+	return pqt.NewTable("status").
+		AddColumn(pqt.NewColumn("id", pqt.TypeSerial(), pqt.WithPrimaryKey())).
+		AddColumn(pqt.NewColumn("name", pqt.TypeVarchar(5), pqt.WithNotNull())).
+		AddColumn(pqt.NewColumn("kind", pqt.TypeEnumerated("kind", "open", "closed"), pqt.WithNotNull(), pqt.WithDefault("'open'"))).
+		AddColumn(pqt.NewColumn("weight", pqt.TypeIntegerSmall())).
+		AddColumn(pqt.NewColumn("created_at", pqt.TypeTimestampTZ())).
+		AddColumn(pqt.NewColumn("meta", pqt.TypeJSONB()))
+}
+
+func TestTable_AddRow(t *testing.T) {
+	tbl := seedTable().
+		AddRow("new", map[string]interface{}{"id": 1, "name": "new"}).
+		AddRow("", map[string]interface{}{
+			"id":         int64(2),
+			"name":       "żółw",
+			"kind":       "closed",
+			"weight":     nil,
+			"created_at": time.Now(),
+			"meta":       `{"a": 1}`,
+		})
+
+	if len(tbl.Rows) != 2 {
+		t.Fatalf("wrong number of rows: %d", len(tbl.Rows))
+	}
+	if tbl.Rows[0].Name != "new" {
+		t.Errorf("wrong row name: %s", tbl.Rows[0].Name)
+	}
+}
+
+func TestTable_AddRow_invalid(t *testing.T) {
+	cases := map[string]map[string]interface{}{
+		"unknown-column":   {"id": 1, "name": "a", "unknown": 1},
+		"missing-pk":       {"name": "a"},
+		"missing-not-null": {"id": 1},
+		"null-not-null":    {"id": 1, "name": nil},
+		"too-long":         {"id": 1, "name": "abcdef"},
+		"wrong-type":       {"id": "1", "name": "a"},
+		"out-of-range":     {"id": 1, "name": "a", "weight": 1 << 16},
+		"not-enum":         {"id": 1, "name": "a", "kind": "pending"},
+		"invalid-json":     {"id": 1, "name": "a", "meta": "{"},
+		"wrong-time":       {"id": 1, "name": "a", "created_at": "2020-01-01"},
+	}
+
+	for hint, values := range cases {
+		t.Run(hint, func(t *testing.T) {
+			defer func() {
+				if recover() == nil {
+					t.Error("expected panic")
+				}
+			}()
+			seedTable().AddRow("", values)
+		})
+	}
+}
+
+func TestTable_AddRow_duplicatedName(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("expected panic")
+		}
+	}()
+	seedTable().
+		AddRow("a", map[string]interface{}{"id": 1, "name": "a"}).
+		AddRow("a", map[string]interface{}{"id": 2, "name": "b"})
+}
@@ -0,0 +1,90 @@
+package pqt
+
+import "fmt"
+
+// Sequence is a standalone sequence number generator.
+type Sequence struct {
+	Name                                        string
+	Schema                                      *Schema
+	IfNotExists, Cycle                          bool
+	Increment, MinValue, MaxValue, Start, Cache int64
+}
+
+// NewSequence ...
+func NewSequence(name string, opts ...SequenceOption) *Sequence {
+	s := &Sequence{
+		Name: name,
+	}
+
+	for _, opt := range opts {
+		opt(s)
+	}
+
+	return s
+}
+
+// FullName returns name of the sequence prefixed with schema name, if available.
+func (s *Sequence) FullName() string {
+	if s.Schema != nil && s.Schema.Name != "" {
+		return s.Schema.Name + "." + s.Name
+	}
+
+	return s.Name
+}
+
+// NextVal returns SQL expression that advances the sequence and returns its new value.
+func (s *Sequence) NextVal() string {
+	return fmt.Sprintf("nextval('%s')", s.FullName())
+}
+
+// SequenceOption configures how we set up a sequence.
+type SequenceOption func(*Sequence)
+
+// WithSequenceIfNotExists ...
+func WithSequenceIfNotExists() SequenceOption {
+	return func(s *Sequence) {
+		s.IfNotExists = true
+	}
+}
+
+// WithIncrement sets value that is added to the current sequence value to create a new value.
+func WithIncrement(i int64) SequenceOption {
+	return func(s *Sequence) {
+		s.Increment = i
+	}
+}
+
+// WithMinValue ...
+func WithMinValue(v int64) SequenceOption {
+	return func(s *Sequence) {
+		s.MinValue = v
+	}
+}
+
+// WithMaxValue ...
+func WithMaxValue(v int64) SequenceOption {
+	return func(s *Sequence) {
+		s.MaxValue = v
+	}
+}
+
+// WithStart ...
+func WithStart(v int64) SequenceOption {
+	return func(s *Sequence) {
+		s.Start = v
+	}
+}
+
+// WithCache specifies how many sequence numbers are to be preallocated and stored in memory for faster access.
+func WithCache(c int64) SequenceOption {
+	return func(s *Sequence) {
+		s.Cache = c
+	}
+}
+
+// WithCycle allows the sequence to wrap around when the max value or min value has been reached.
+func WithCycle() SequenceOption {
+	return func(s *Sequence) {
+		s.Cycle = true
+	}
+}
@@ -0,0 +1,45 @@
+package pqt_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/piotrkowalczuk/pqt"
+)
+
+func TestNewSequence(t *testing.T) {
+	got := pqt.NewSequence("sequence",
+		pqt.WithSequenceIfNotExists(),
+		pqt.WithIncrement(2),
+		pqt.WithMinValue(10),
+		pqt.WithMaxValue(100),
+		pqt.WithStart(20),
+		pqt.WithCache(5),
+		pqt.WithCycle(),
+	)
+	expected := &pqt.Sequence{
+		Name:        "sequence",
+		IfNotExists: true,
+		Cycle:       true,
+		Increment:   2,
+		MinValue:    10,
+		MaxValue:    100,
+		Start:       20,
+		Cache:       5,
+	}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("wrong sequence, expected %v but got %v", expected, got)
+	}
+}
+
+func TestSequence_NextVal(t *testing.T) {
+	seq := pqt.NewSequence("sequence")
+	if got := seq.NextVal(); got != "nextval('sequence')" {
+		t.Errorf("wrong expression: %s", got)
+	}
+
+	pqt.NewSchema("schema").AddSequence(seq)
+	if got := seq.NextVal(); got != "nextval('schema.sequence')" {
+		t.Errorf("wrong expression: %s", got)
+	}
+}
@@ -5,17 +5,38 @@ import (
 	"sort"
 )
 
+const (
+	// HistoryColumnOperation is a name of the history table column that holds type of the change (INSERT, UPDATE or DELETE).
+	HistoryColumnOperation = "history_operation"
+	// HistoryColumnAt is a name of the history table column that holds time of the change.
+	HistoryColumnAt = "history_at"
+	// HistoryColumnActor is a name of the history table column that holds author of the change.
+	HistoryColumnActor = "history_actor"
+	// HistoryActorSetting is a name of the configuration parameter the history actor is taken from.
+	// It can be set for the duration of a transaction using SET LOCAL.
+	HistoryActorSetting = "pqt.actor"
+)
+
 // Table is partially implemented postgres table synopsis.
 type Table struct {
 	self                                 bool
 	Name, ShortName, Collate, TableSpace string
 	IfNotExists, Temporary               bool
+	Notify, History                      bool
+	NotifyChannel                        string
 	Schema                               *Schema
 	Columns                              Columns
 	Constraints                          Constraints
 	OwnedRelationships                   []*Relationship
 	InversedRelationships                []*Relationship
 	ManyToManyRelationships              []*Relationship
+	Grants                               []*Grant
+	Policies                             []*Policy
+	// Rows are static rows inserted right after the table is created.
+	Rows []*Row
+
+	// RowLevelSecurity enables policies, ForceRowLevelSecurity applies them to the table owner as well.
+	RowLevelSecurity, ForceRowLevelSecurity bool
 }
 
 // NewTable allocates new table using given name and options.
@@ -208,7 +229,14 @@ func (t *Table) addRelationshipManyToMany(r *Relationship, opts ...ColumnOption)
 		inversedColumns = append(inversedColumns, ic)
 	}
 
-	r.ThroughTable.AddUnique(append(ownerColumns, inversedColumns...)...)
+	if !r.ThroughPrimaryKey {
+		r.ThroughTable.AddUnique(append(ownerColumns, inversedColumns...)...)
+		return t
+	}
+	if len(r.ThroughTable.PrimaryKeyColumns()) > 0 {
+		panic(fmt.Sprintf("through table (%s) already has primary key", r.ThroughTable.Name))
+	}
+	r.ThroughTable.AddPrimaryKey(append(ownerColumns, inversedColumns...)...)
 	return t
 }
 
@@ -225,6 +253,12 @@ func (t *Table) AddConstraint(c *Constraint) *Table {
 	return t
 }
 
+// AddPrimaryKey adds primary key constraint to the table.
+// Unlike WithPrimaryKey column option, it allows the key to span multiple columns.
+func (t *Table) AddPrimaryKey(columns ...*Column) *Table {
+	return t.AddConstraint(PrimaryKey(t, columns...))
+}
+
 // AddCheck adds check constraint to the table.
 func (t *Table) AddCheck(check string, columns ...*Column) *Table {
 	return t.AddConstraint(Check(t, check, columns...))
@@ -240,11 +274,33 @@ func (t *Table) AddIndex(columns ...*Column) *Table {
 	return t.AddConstraint(Index(t, columns...))
 }
 
+// AddIndexUsing adds index that uses given method to the table.
+func (t *Table) AddIndexUsing(method IndexMethod, columns ...*Column) *Table {
+	return t.AddConstraint(IndexUsing(t, method, columns...))
+}
+
 // AddUniqueIndex ...
 func (t *Table) AddUniqueIndex(methodSuffix, where string, columns ...*Column) *Table {
 	return t.AddConstraint(UniqueIndex(t, methodSuffix, where, columns...))
 }
 
+// AddGrant adds grant of privileges on the table.
+func (t *Table) AddGrant(g *Grant) *Table {
+	t.Grants = append(t.Grants, g)
+
+	return t
+}
+
+// AddPolicy adds row-level security policy to the table.
+// Policies take effect only if row-level security is enabled, so it is enabled as well.
+func (t *Table) AddPolicy(p *Policy) *Table {
+	p.Table = t
+	t.RowLevelSecurity = true
+	t.Policies = append(t.Policies, p)
+
+	return t
+}
+
 // SetIfNotExists sets IfNotExists flag.
 func (t *Table) SetIfNotExists(ine bool) *Table {
 	t.IfNotExists = ine
@@ -258,15 +314,79 @@ func (t *Table) SetSchema(s *Schema) *Table {
 	return t
 }
 
-// PrimaryKey returns column that is primary key, or false if none.
-func (t *Table) PrimaryKey() (*Column, bool) {
+// Channel returns name of the notification channel table changes are published on.
+// If NotifyChannel is not set, it is <schema>_<name> or just <name> if schema is not set.
+func (t *Table) Channel() string {
+	if t.NotifyChannel != "" {
+		return t.NotifyChannel
+	}
+	if t.Schema != nil && t.Schema.Name != "" {
+		return t.Schema.Name + "_" + t.Name
+	}
+
+	return t.Name
+}
+
+// HistoryTable derives <name>_history table out of the table columns.
+// Besides copies of the columns, it has operation, timestamp and actor columns.
+// Returns nil if history is not enabled.
+func (t *Table) HistoryTable() *Table {
+	if !t.History {
+		return nil
+	}
+
+	h := NewTable(t.Name + "_history")
+	h.IfNotExists = t.IfNotExists
+	h.Schema = t.Schema
+
+	copies := make(map[*Column]*Column, len(t.Columns))
 	for _, c := range t.Columns {
-		if c.PrimaryKey {
-			return c, true
+		if c.IsDynamic {
+			continue
+		}
+		hc := NewColumn(c.Name, fkType(c.Type))
+		hc.NotNull = c.NotNull
+		copies[c] = hc
+		h.addColumn(hc)
+	}
+
+	var pk Columns
+	for _, c := range t.PrimaryKeyColumns() {
+		copies[c].NotNull = true
+		pk = append(pk, copies[c])
+	}
+
+	at := NewColumn(HistoryColumnAt, TypeTimestampTZ(), WithNotNull(), WithDefault("clock_timestamp()"))
+	h.addColumn(NewColumn(HistoryColumnOperation, TypeText(), WithNotNull()))
+	h.addColumn(at)
+	h.addColumn(NewColumn(HistoryColumnActor, TypeText(), WithDefault("current_setting('"+HistoryActorSetting+"', true)")))
+
+	if len(pk) > 0 {
+		h.AddIndex(append(pk, at)...)
+	}
+
+	return h
+}
+
+// PrimaryKey returns column that is primary key, or false if none or if primary key spans multiple columns.
+func (t *Table) PrimaryKey() (*Column, bool) {
+	pk := t.PrimaryKeyColumns()
+	if len(pk) != 1 {
+		return nil, false
+	}
+
+	return pk[0], true
+}
+
+// PrimaryKeyColumns returns columns of the primary key constraint, no matter if it was defined on a column or on the table.
+func (t *Table) PrimaryKeyColumns() Columns {
+	for _, c := range t.Constraints {
+		if c.Type == ConstraintTypePrimaryKey {
+			return c.PrimaryColumns
 		}
 	}
 
-	return nil, false
+	return nil
 }
 
 // TableOption configures how we set up the table.
@@ -304,6 +424,38 @@ func WithTableShortName(s string) TableOption {
 	}
 }
 
+// WithNotify is table option that makes every INSERT, UPDATE and DELETE publish an event through pg_notify.
+// Event carries primary key and names of changed columns. If channel is empty, default one is used (see Table.Channel).
+func WithNotify(channel string) TableOption {
+	return func(t *Table) {
+		t.Notify = true
+		t.NotifyChannel = channel
+	}
+}
+
+// WithHistory is table option that makes every version of a row to be stored in <name>_history table (see Table.HistoryTable).
+func WithHistory() TableOption {
+	return func(t *Table) {
+		t.History = true
+	}
+}
+
+// WithRowLevelSecurity is table option that enables row-level security.
+// Until a policy is added, rows are not visible to anyone but the table owner.
+func WithRowLevelSecurity() TableOption {
+	return func(t *Table) {
+		t.RowLevelSecurity = true
+	}
+}
+
+// WithForceRowLevelSecurity is table option that enables row-level security and applies it to the table owner as well.
+func WithForceRowLevelSecurity() TableOption {
+	return func(t *Table) {
+		t.RowLevelSecurity = true
+		t.ForceRowLevelSecurity = true
+	}
+}
+
 func fkType(t Type) Type {
 	switch t {
 	case TypeSerial():
@@ -2,6 +2,7 @@ package pqt_test
 
 import (
 	"reflect"
+	"strings"
 	"testing"
 
 	"github.com/piotrkowalczuk/pqt"
@@ -280,6 +281,36 @@ func TestTable_AddRelationship_manyToMany(t *testing.T) {
 	if userGroups.OwnedRelationships[0].Type != pqt.RelationshipTypeManyToMany {
 		t.Errorf("user relationship to group should be many to many")
 	}
+	if got := len(userGroups.PrimaryKeyColumns()); got != 0 {
+		t.Errorf("user groups should not have primary key, but it has %d columns", got)
+	}
+	if c := userGroups.Constraints[len(userGroups.Constraints)-1]; c.Type != pqt.ConstraintTypeUnique || len(c.PrimaryColumns) != 2 {
+		t.Errorf("relationship columns should be unique, got %s constraint on %d columns", c.Type, len(c.PrimaryColumns))
+	}
+}
+
+func TestTable_AddRelationship_manyToManyThroughPrimaryKey(t *testing.T) {
+	user := pqt.NewTable("user").AddColumn(pqt.NewColumn("id", pqt.TypeSerial(), pqt.WithPrimaryKey()))
+	group := pqt.NewTable("group").AddColumn(pqt.NewColumn("id", pqt.TypeSerial(), pqt.WithPrimaryKey()))
+	userGroups := pqt.NewTable("user_groups").AddRelationship(pqt.ManyToMany(user, group, pqt.WithThroughPrimaryKey()))
+
+	if got := len(userGroups.PrimaryKeyColumns()); got != 2 {
+		t.Errorf("user groups should be keyed by both relationship columns, but primary key has %d columns", got)
+	}
+	for _, c := range userGroups.Constraints {
+		if c.Type == pqt.ConstraintTypeUnique {
+			t.Error("relationship columns should not be covered by unique constraint")
+		}
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Error("expected panic for through table with primary key")
+		}
+	}()
+	pqt.NewTable("user_roles").
+		AddColumn(pqt.NewColumn("id", pqt.TypeSerial(), pqt.WithPrimaryKey())).
+		AddRelationship(pqt.ManyToMany(user, group, pqt.WithThroughPrimaryKey()))
 }
 
 func TestTable_FullName(t *testing.T) {
@@ -324,6 +355,36 @@ func TestTable_AddUnique(t *testing.T) {
 	}
 }
 
+func TestTable_AddPrimaryKey(t *testing.T) {
+	a := pqt.NewColumn("a", pqt.TypeInteger())
+	b := pqt.NewColumn("b", pqt.TypeInteger())
+
+	tbl := pqt.NewTable("table").
+		AddColumn(a).
+		AddColumn(b).
+		AddPrimaryKey(a, b)
+
+	if got := tbl.PrimaryKeyColumns(); !reflect.DeepEqual(got, pqt.Columns{a, b}) {
+		t.Errorf("wrong primary key columns: %v", got)
+	}
+	if _, ok := tbl.PrimaryKey(); ok {
+		t.Error("multi-column primary key is not expected to be returned as a single column")
+	}
+}
+
+func TestTable_PrimaryKey(t *testing.T) {
+	id := pqt.NewColumn("id", pqt.TypeSerial(), pqt.WithPrimaryKey())
+	tbl := pqt.NewTable("table").AddColumn(id)
+
+	got, ok := tbl.PrimaryKey()
+	if !ok || got != id {
+		t.Errorf("wrong primary key: %v", got)
+	}
+	if got := tbl.PrimaryKeyColumns(); !reflect.DeepEqual(got, pqt.Columns{id}) {
+		t.Errorf("wrong primary key columns: %v", got)
+	}
+}
+
 func TestTable_AddIndex(t *testing.T) {
 	a := pqt.NewColumn("a", pqt.TypeInteger())
 	b := pqt.NewColumn("b", pqt.TypeInteger())
@@ -338,3 +399,107 @@ func TestTable_AddIndex(t *testing.T) {
 		t.Errorf("wrong number of index constraints: %d", got)
 	}
 }
+
+func TestTable_AddIndexUsing(t *testing.T) {
+	a := pqt.NewColumn("a", pqt.TypeTSVector())
+
+	tbl := pqt.NewTable("table").
+		AddColumn(a).
+		AddIndexUsing(pqt.IndexMethodGIN, a)
+
+	if len(tbl.Constraints) != 1 {
+		t.Fatalf("wrong number of constraints: %d", len(tbl.Constraints))
+	}
+	if got := tbl.Constraints[0]; got.Type != pqt.ConstraintTypeIndex || got.Using != pqt.IndexMethodGIN {
+		t.Errorf("wrong index constraint: %s using %s", got.Type, got.Using)
+	}
+}
+
+func TestWithNotify(t *testing.T) {
+	tbl := pqt.NewTable("table", pqt.WithNotify(""))
+	if !tbl.Notify {
+		t.Error("notify expected to be true")
+	}
+	if tbl.Channel() != "table" {
+		t.Errorf("wrong channel: %s", tbl.Channel())
+	}
+
+	pqt.NewSchema("schema").AddTable(tbl)
+	if tbl.Channel() != "schema_table" {
+		t.Errorf("wrong channel: %s", tbl.Channel())
+	}
+
+	tbl = pqt.NewTable("table", pqt.WithNotify("changes"))
+	if tbl.Channel() != "changes" {
+		t.Errorf("wrong channel: %s", tbl.Channel())
+	}
+}
+
+func TestTable_HistoryTable(t *testing.T) {
+	tbl := pqt.NewTable("table").
+		AddColumn(pqt.NewColumn("id", pqt.TypeSerial(), pqt.WithPrimaryKey())).
+		AddColumn(pqt.NewColumn("name", pqt.TypeText(), pqt.WithUnique()))
+	if tbl.HistoryTable() != nil {
+		t.Fatal("history table should not be derived if history is not enabled")
+	}
+
+	pqt.WithHistory()(tbl)
+	pqt.NewSchema("schema").AddTable(tbl)
+
+	h := tbl.HistoryTable()
+	if h.FullName() != "schema.table_history" {
+		t.Errorf("wrong full name: %s", h.FullName())
+	}
+	expected := []string{pqt.HistoryColumnActor, pqt.HistoryColumnAt, pqt.HistoryColumnOperation, "id", "name"}
+	if len(h.Columns) != len(expected) {
+		t.Fatalf("wrong number of columns, expected %d but got %d", len(expected), len(h.Columns))
+	}
+	for i, c := range h.Columns {
+		if c.Name != expected[i] {
+			t.Errorf("wrong column name at %d, expected %s but got %s", i, expected[i], c.Name)
+		}
+		if c.PrimaryKey || c.Unique {
+			t.Errorf("column %s should not be constrained", c.Name)
+		}
+	}
+	if h.Columns[3].Type != pqt.TypeInteger() {
+		t.Errorf("serial column should be copied as integer, got %s", h.Columns[3].Type)
+	}
+	if len(h.Constraints) != 1 || h.Constraints[0].Type != pqt.ConstraintTypeIndex {
+		t.Errorf("expected single index, got %v", h.Constraints)
+	}
+}
+
+func TestTable_HistoryTable_compositeKey(t *testing.T) {
+	tenant := pqt.NewColumn("tenant", pqt.TypeText())
+	id := pqt.NewColumn("id", pqt.TypeInteger())
+	tbl := pqt.NewTable("table", pqt.WithHistory()).
+		AddColumn(tenant).
+		AddColumn(id).
+		AddColumn(pqt.NewColumn("name", pqt.TypeText())).
+		AddPrimaryKey(tenant, id)
+
+	h := tbl.HistoryTable()
+	for _, c := range h.Columns {
+		switch c.Name {
+		case "tenant", "id":
+			if !c.NotNull {
+				t.Errorf("key column %s should be not null", c.Name)
+			}
+		case "name":
+			if c.NotNull {
+				t.Errorf("column %s should be nullable", c.Name)
+			}
+		}
+	}
+	if len(h.Constraints) != 1 || h.Constraints[0].Type != pqt.ConstraintTypeIndex {
+		t.Fatalf("expected single index, got %v", h.Constraints)
+	}
+	var names []string
+	for _, c := range h.Constraints[0].PrimaryColumns {
+		names = append(names, c.Name)
+	}
+	if strings.Join(names, ",") != "tenant,id,"+pqt.HistoryColumnAt {
+		t.Errorf("wrong index columns: %v", names)
+	}
+}
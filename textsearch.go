@@ -0,0 +1,43 @@
+package pqt
+
+import (
+	"fmt"
+	"strings"
+)
+
+// TextSearch describes a tsvector column used for full-text search.
+type TextSearch struct {
+	// Config is the text search configuration, e.g. english or simple.
+	Config string
+	// Columns are the source columns the document is built from.
+	Columns []*Column
+}
+
+// Document returns SQL expression that concatenates source columns into a single text.
+// Each column name is prefixed with given prefix, e.g. table alias.
+func (ts *TextSearch) Document(prefix string) string {
+	parts := make([]string, 0, len(ts.Columns))
+	for _, c := range ts.Columns {
+		parts = append(parts, fmt.Sprintf("coalesce(%s%s, '')", prefix, c.Name))
+	}
+	return strings.Join(parts, " || ' ' || ")
+}
+
+// Vector returns SQL expression that builds tsvector from source columns.
+func (ts *TextSearch) Vector() string {
+	return fmt.Sprintf("to_tsvector('%s'::regconfig, %s)", ts.Config, ts.Document(""))
+}
+
+// WithTextSearch marks column as a full-text search document that uses given configuration.
+// If source columns are provided, the column is generated from them, otherwise it has to be maintained by the application.
+func WithTextSearch(config string, columns ...*Column) ColumnOption {
+	return func(c *Column) {
+		c.TextSearch = &TextSearch{
+			Config:  config,
+			Columns: columns,
+		}
+		if len(columns) > 0 {
+			c.Generated = c.TextSearch.Vector()
+		}
+	}
+}
@@ -0,0 +1,36 @@
+package pqt_test
+
+import (
+	"testing"
+
+	"github.com/piotrkowalczuk/pqt"
+)
+
+func TestWithTextSearch(t *testing.T) {
+	title := pqt.NewColumn("title", pqt.TypeText())
+	lead := pqt.NewColumn("lead", pqt.TypeText())
+
+	c := pqt.NewColumn("document", pqt.TypeTSVector(), pqt.WithTextSearch("english", title, lead))
+	if c.TextSearch == nil {
+		t.Fatal("text search expected to be set")
+	}
+	if got := c.TextSearch.Document("t0."); got != "coalesce(t0.title, '') || ' ' || coalesce(t0.lead, '')" {
+		t.Errorf("wrong document: %s", got)
+	}
+	if !c.IsGeneratedAlways() {
+		t.Error("column expected to be generated")
+	}
+	if exp := "to_tsvector('english'::regconfig, coalesce(title, '') || ' ' || coalesce(lead, ''))"; c.Generated != exp {
+		t.Errorf("wrong generated expression, expected %s but got %s", exp, c.Generated)
+	}
+}
+
+func TestWithTextSearch_maintained(t *testing.T) {
+	c := pqt.NewColumn("document", pqt.TypeTSVector(), pqt.WithTextSearch("simple"))
+	if c.TextSearch == nil || c.TextSearch.Config != "simple" {
+		t.Fatal("text search expected to be set")
+	}
+	if c.IsGeneratedAlways() {
+		t.Error("column should not be generated")
+	}
+}
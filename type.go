@@ -1,6 +1,9 @@
 package pqt
 
-import "fmt"
+import (
+	"fmt"
+	"strings"
+)
 
 // Type is a common interface that needs to be implemented so a type can be considered the Type in PQT sense.
 type Type interface {
@@ -195,6 +198,148 @@ func TypeJSONB() BaseType {
 	return BaseType{name: "JSONB"}
 }
 
+// TypeInterval is a time span.
+func TypeInterval() BaseType {
+	return BaseType{name: "INTERVAL"}
+}
+
+// TypeTime is a time of day (no date, no time zone).
+func TypeTime() BaseType {
+	return BaseType{name: "TIME"}
+}
+
+// TypeTimeTZ is a time of day (no date), including time zone.
+func TypeTimeTZ() BaseType {
+	return BaseType{name: "TIMETZ"}
+}
+
+// TypeInet holds an IPv4 or IPv6 host address, and optionally its subnet.
+func TypeInet() BaseType {
+	return BaseType{name: "INET"}
+}
+
+// TypeCIDR holds an IPv4 or IPv6 network specification.
+func TypeCIDR() BaseType {
+	return BaseType{name: "CIDR"}
+}
+
+// TypeMacAddr stores MAC addresses.
+func TypeMacAddr() BaseType {
+	return BaseType{name: "MACADDR"}
+}
+
+// TypeMoney stores a currency amount with a fixed fractional precision.
+// Output format depends on the lc_monetary setting.
+func TypeMoney() BaseType {
+	return BaseType{name: "MONEY"}
+}
+
+// TypeIntegerRange is a range of integer.
+func TypeIntegerRange() BaseType {
+	return BaseType{name: "INT4RANGE"}
+}
+
+// TypeIntegerBigRange is a range of bigint.
+func TypeIntegerBigRange() BaseType {
+	return BaseType{name: "INT8RANGE"}
+}
+
+// TypeNumericRange is a range of numeric.
+func TypeNumericRange() BaseType {
+	return BaseType{name: "NUMRANGE"}
+}
+
+// TypeTimestampRange is a range of timestamp without time zone.
+func TypeTimestampRange() BaseType {
+	return BaseType{name: "TSRANGE"}
+}
+
+// TypeTimestampTZRange is a range of timestamp with time zone.
+func TypeTimestampTZRange() BaseType {
+	return BaseType{name: "TSTZRANGE"}
+}
+
+// TypeDateRange is a range of date.
+func TypeDateRange() BaseType {
+	return BaseType{name: "DATERANGE"}
+}
+
+// TypeBit is a fixed-length bit string.
+func TypeBit(l int) BaseType {
+	if l == 0 {
+		return BaseType{name: "BIT"}
+	}
+	return BaseType{name: fmt.Sprintf("BIT(%d)", l)}
+}
+
+// TypeVarbit is a variable-length bit string, with an optional maximum length.
+func TypeVarbit(l int) BaseType {
+	if l == 0 {
+		return BaseType{name: "VARBIT"}
+	}
+	return BaseType{name: fmt.Sprintf("VARBIT(%d)", l)}
+}
+
+// TypeXML stores XML data.
+func TypeXML() BaseType {
+	return BaseType{name: "XML"}
+}
+
+// TypeHstore stores sets of key/value pairs, it requires hstore extension.
+func TypeHstore() BaseType {
+	return BaseType{name: "HSTORE"}
+}
+
+// TypeCitext is a case-insensitive character string, it requires citext extension.
+func TypeCitext() BaseType {
+	return BaseType{name: "CITEXT"}
+}
+
+// TypeTSVector is a sorted list of distinct lexemes, a document optimized for text search.
+func TypeTSVector() BaseType {
+	return BaseType{name: "TSVECTOR"}
+}
+
+// TypeTSQuery stores lexemes that are to be searched for.
+func TypeTSQuery() BaseType {
+	return BaseType{name: "TSQUERY"}
+}
+
+// TypePoint is a point on a plane.
+func TypePoint() BaseType {
+	return BaseType{name: "POINT"}
+}
+
+// TypeLine is an infinite line.
+func TypeLine() BaseType {
+	return BaseType{name: "LINE"}
+}
+
+// TypeLineSegment is a finite line segment.
+func TypeLineSegment() BaseType {
+	return BaseType{name: "LSEG"}
+}
+
+// TypeBox is a rectangular box.
+func TypeBox() BaseType {
+	return BaseType{name: "BOX"}
+}
+
+// TypePath is an open or closed path.
+func TypePath() BaseType {
+	return BaseType{name: "PATH"}
+}
+
+// TypePolygon is a polygon, similar to closed path.
+func TypePolygon() BaseType {
+	return BaseType{name: "POLYGON"}
+}
+
+// TypeCircle is a circle.
+func TypeCircle() BaseType {
+	return BaseType{name: "CIRCLE"}
+}
+
 // CompositeType represents the structure of a row or record.
 // It is essentially just a list of field names and their data types.
 // PostgreSQL allows composite types to be used in many of the same ways that simple types can be used.
@@ -272,6 +417,53 @@ func TypePseudo(name string) PseudoType {
 	}
 }
 
+// ArrayType represents variable-length multidimensional array of any built-in or user-defined type.
+type ArrayType struct {
+	Elem Type
+	// dims is kept as a string, so the type remains comparable.
+	dims string
+}
+
+// String implements Stringer interface.
+func (at ArrayType) String() string {
+	return at.Elem.String() + at.dims
+}
+
+// Fingerprint implements Type interface.
+func (at ArrayType) Fingerprint() string {
+	return fmt.Sprintf("array: %s", at)
+}
+
+// Dimensions returns number of array dimensions.
+func (at ArrayType) Dimensions() int {
+	return strings.Count(at.dims, "[")
+}
+
+// TypeArray allocates ArrayType of given element type.
+// Each dimension can declare its size, zero means unspecified size.
+// If no dimensions are given, single dimension of unspecified size is assumed.
+//
+//	TypeArray(TypeUUID())          // UUID[]
+//	TypeArray(TypeInteger(), 3)    // INTEGER[3]
+//	TypeArray(TypeText(), 0, 0)    // TEXT[][]
+func TypeArray(elem Type, dims ...int) ArrayType {
+	if len(dims) == 0 {
+		dims = []int{0}
+	}
+	var buf string
+	for _, d := range dims {
+		if d == 0 {
+			buf += "[]"
+		} else {
+			buf += fmt.Sprintf("[%d]", d)
+		}
+	}
+	return ArrayType{
+		Elem: elem,
+		dims: buf,
+	}
+}
+
 // MappableType ...
 type MappableType struct {
 	From    Type
@@ -212,3 +212,69 @@ func TestTypeMappable(t *testing.T) {
 		t.Errorf("wrong fingerprint: %s", given.Fingerprint())
 	}
 }
+
+func TestTypeInterval(t *testing.T) {
+	assertType(t, "INTERVAL", pqt.TypeInterval())
+}
+
+func TestTypeTime(t *testing.T) {
+	assertType(t, "TIME", pqt.TypeTime())
+	assertType(t, "TIMETZ", pqt.TypeTimeTZ())
+}
+
+func TestTypeNetwork(t *testing.T) {
+	assertType(t, "INET", pqt.TypeInet())
+	assertType(t, "CIDR", pqt.TypeCIDR())
+	assertType(t, "MACADDR", pqt.TypeMacAddr())
+}
+
+func TestTypeRange(t *testing.T) {
+	assertType(t, "INT4RANGE", pqt.TypeIntegerRange())
+	assertType(t, "INT8RANGE", pqt.TypeIntegerBigRange())
+	assertType(t, "NUMRANGE", pqt.TypeNumericRange())
+	assertType(t, "TSRANGE", pqt.TypeTimestampRange())
+	assertType(t, "TSTZRANGE", pqt.TypeTimestampTZRange())
+	assertType(t, "DATERANGE", pqt.TypeDateRange())
+}
+
+func TestTypeBit(t *testing.T) {
+	assertType(t, "BIT", pqt.TypeBit(0))
+	assertType(t, "BIT(8)", pqt.TypeBit(8))
+	assertType(t, "VARBIT", pqt.TypeVarbit(0))
+	assertType(t, "VARBIT(8)", pqt.TypeVarbit(8))
+}
+
+func TestTypeGeometric(t *testing.T) {
+	assertType(t, "POINT", pqt.TypePoint())
+	assertType(t, "LINE", pqt.TypeLine())
+	assertType(t, "LSEG", pqt.TypeLineSegment())
+	assertType(t, "BOX", pqt.TypeBox())
+	assertType(t, "PATH", pqt.TypePath())
+	assertType(t, "POLYGON", pqt.TypePolygon())
+	assertType(t, "CIRCLE", pqt.TypeCircle())
+}
+
+func TestTypeArray(t *testing.T) {
+	cases := map[string]struct {
+		given pqt.ArrayType
+		dims  int
+	}{
+		"UUID[]":           {given: pqt.TypeArray(pqt.TypeUUID()), dims: 1},
+		"INTEGER[3]":       {given: pqt.TypeArray(pqt.TypeInteger(), 3), dims: 1},
+		"TEXT[][]":         {given: pqt.TypeArray(pqt.TypeText(), 0, 0), dims: 2},
+		"TIMESTAMPTZ[2][]": {given: pqt.TypeArray(pqt.TypeTimestampTZ(), 2, 0), dims: 2},
+		"pets[]":           {given: pqt.TypeArray(pqt.TypeEnumerated("pets", "cat", "dog")), dims: 1},
+	}
+
+	for expected, c := range cases {
+		t.Run(expected, func(t *testing.T) {
+			assertType(t, expected, c.given)
+			if c.given.Dimensions() != c.dims {
+				t.Errorf("wrong number of dimensions, expected %d but got %d", c.dims, c.given.Dimensions())
+			}
+			if c.given.Fingerprint() != "array: "+expected {
+				t.Errorf("wrong fingerprint: %s", c.given.Fingerprint())
+			}
+		})
+	}
+}